@@ -30,7 +30,9 @@ import (
 	icq "github.com/strangelove-ventures/async-icq/v4"
 
 	_ "github.com/osmosis-labs/osmosis/v15/client/docs/statik"
+	concentratedliquidityclient "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/client"
 	concentratedliquidity "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/clmodule"
+	cosmwasmpoolmodule "github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/cosmwasmpoolmodule"
 	downtimemodule "github.com/osmosis-labs/osmosis/v15/x/downtime-detector/module"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm"
 	gammclient "github.com/osmosis-labs/osmosis/v15/x/gamm/client"
@@ -40,11 +42,13 @@ import (
 	"github.com/osmosis-labs/osmosis/v15/x/mint"
 	poolincentives "github.com/osmosis-labs/osmosis/v15/x/pool-incentives"
 	poolincentivesclient "github.com/osmosis-labs/osmosis/v15/x/pool-incentives/client"
+	poolmanagerclient "github.com/osmosis-labs/osmosis/v15/x/poolmanager/client"
 	poolmanager "github.com/osmosis-labs/osmosis/v15/x/poolmanager/module"
 	"github.com/osmosis-labs/osmosis/v15/x/protorev"
 	superfluid "github.com/osmosis-labs/osmosis/v15/x/superfluid"
 	superfluidclient "github.com/osmosis-labs/osmosis/v15/x/superfluid/client"
 	"github.com/osmosis-labs/osmosis/v15/x/tokenfactory"
+	twapclient "github.com/osmosis-labs/osmosis/v15/x/twap/client"
 	"github.com/osmosis-labs/osmosis/v15/x/twap/twapmodule"
 	"github.com/osmosis-labs/osmosis/v15/x/txfees"
 	valsetprefmodule "github.com/osmosis-labs/osmosis/v15/x/valset-pref/valpref-module"
@@ -78,6 +82,10 @@ var AppModuleBasics = []module.AppModuleBasic{
 			superfluidclient.UpdateUnpoolWhitelistProposalHandler,
 			gammclient.ReplaceMigrationRecordsProposalHandler,
 			gammclient.UpdateMigrationRecordsProposalHandler,
+			gammclient.UpdatePoolWeightsProposalHandler,
+			concentratedliquidityclient.UpdateSwapFeeProposalHandler,
+			poolmanagerclient.SetPoolsPausedProposalHandler,
+			twapclient.SetPoolRecordHistoryKeepPeriodsProposalHandler,
 		)...,
 	),
 	params.AppModuleBasic{},
@@ -93,6 +101,7 @@ var AppModuleBasics = []module.AppModuleBasic{
 	poolmanager.AppModuleBasic{},
 	twapmodule.AppModuleBasic{},
 	concentratedliquidity.AppModuleBasic{},
+	cosmwasmpoolmodule.AppModuleBasic{},
 	protorev.AppModuleBasic{},
 	txfees.AppModuleBasic{},
 	incentives.AppModuleBasic{},
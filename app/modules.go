@@ -63,6 +63,8 @@ import (
 	"github.com/osmosis-labs/osmosis/v15/simulation/simtypes"
 	concentratedliquidity "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/clmodule"
 	concentratedliquiditytypes "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	cosmwasmpoolmodule "github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/cosmwasmpoolmodule"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/types"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm"
 	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v15/x/ibc-rate-limit/ibcratelimitmodule"
@@ -118,6 +120,7 @@ var moduleAccountPermissions = map[string][]string{
 	wasm.ModuleName:                          {authtypes.Burner},
 	tokenfactorytypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
 	valsetpreftypes.ModuleName:               {authtypes.Staking},
+	cosmwasmpooltypes.ModuleName:             nil,
 }
 
 // appModules return modules to initialize module manager.
@@ -158,6 +161,7 @@ func appModules(
 		poolmanager.NewAppModule(*app.PoolManagerKeeper, app.GAMMKeeper),
 		twapmodule.NewAppModule(*app.TwapKeeper),
 		concentratedliquidity.NewAppModule(appCodec, *app.ConcentratedLiquidityKeeper),
+		cosmwasmpoolmodule.NewAppModule(appCodec, *app.CosmwasmPoolKeeper),
 		protorev.NewAppModule(appCodec, *app.ProtoRevKeeper, app.AccountKeeper, app.BankKeeper, app.EpochsKeeper, app.GAMMKeeper),
 		txfees.NewAppModule(*app.TxFeesKeeper),
 		incentives.NewAppModule(*app.IncentivesKeeper, app.AccountKeeper, app.BankKeeper, app.EpochsKeeper),
@@ -262,6 +266,8 @@ func OrderInitGenesis(allModuleNames []string) []string {
 		ibcratelimittypes.ModuleName,
 		// wasm after ibc transfer
 		wasm.ModuleName,
+		// cosmwasmpool after wasm so that pool contracts exist
+		cosmwasmpooltypes.ModuleName,
 		// ibc_hooks after auth keeper
 		ibchookstypes.ModuleName,
 		icqtypes.ModuleName,
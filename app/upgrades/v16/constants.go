@@ -0,0 +1,20 @@
+package v16
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/osmosis-labs/osmosis/v15/app/upgrades"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/types"
+)
+
+// UpgradeName defines the on-chain upgrade name for the Osmosis v16 upgrade.
+const UpgradeName = "v16"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added:   []string{cosmwasmpooltypes.StoreKey},
+		Deleted: []string{},
+	},
+}
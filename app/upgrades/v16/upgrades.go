@@ -0,0 +1,47 @@
+package v16
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/osmosis-labs/osmosis/v15/app/keepers"
+	"github.com/osmosis-labs/osmosis/v15/app/upgrades"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v15/x/twap/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	bpm upgrades.BaseAppParamManager,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// The taker fee params are a new key in the existing poolmanager
+		// subspace, so they must be set before the params are read.
+		// The defaults charge no taker fee.
+		keepers.PoolManagerKeeper.SetTakerFeeParams(ctx, poolmanagertypes.DefaultTakerFeeParams())
+
+		// The emergency pause admin is a new key as well. It is left empty,
+		// disabling the emergency pause path until set by governance.
+		keepers.PoolManagerKeeper.SetEmergencyPauseAdmin(ctx, "")
+
+		// The twap checkpoint params are new keys in the existing twap subspace.
+		twapDefaultParams := twaptypes.DefaultParams()
+		keepers.TwapKeeper.SetCheckpointParams(ctx, twapDefaultParams.CheckpointInterval, twapDefaultParams.CheckpointKeepPeriod)
+
+		// The existing twap records start the new harmonic accumulator at zero.
+		// Mark them as errored at the upgrade time, so that twaps of windows starting
+		// before the upgrade return the spot price error instead of a wrong harmonic twap.
+		// This applies to the arithmetic and geometric twaps of those windows as well.
+		if err := keepers.TwapKeeper.SetLastErrorTimeForRecordsBefore(ctx, ctx.BlockTime()); err != nil {
+			return nil, err
+		}
+
+		// N.B.: the cosmwasmpool module is not in fromVM, so RunMigrations
+		// initializes it with its default genesis. No code ids are
+		// whitelisted until governance enables them.
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
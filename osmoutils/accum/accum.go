@@ -295,6 +295,35 @@ func (accum AccumulatorObject) SetPositionCustomAcc(name string, customAccumulat
 	return nil
 }
 
+// MoveUnclaimedRewards moves the given fraction of the unclaimed rewards of the position
+// with name fromName into the unclaimed rewards of the position with name toName.
+// Only rewards that have already been moved into UnclaimedRewards are considered. As a result,
+// callers are expected to update both positions prior to calling this method.
+// Does not update shares or the positions' accumulator values.
+// Returns error if either position does not exist or if the fraction is not in the [0, 1] range.
+func (accum AccumulatorObject) MoveUnclaimedRewards(fromName, toName string, fraction sdk.Dec) error {
+	if fraction.IsNegative() || fraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("fraction of unclaimed rewards to move must be in the [0, 1] range, was (%s)", fraction)
+	}
+
+	fromPosition, err := GetPosition(accum, fromName)
+	if err != nil {
+		return err
+	}
+
+	toPosition, err := GetPosition(accum, toName)
+	if err != nil {
+		return err
+	}
+
+	rewardsToMove := fromPosition.UnclaimedRewards.MulDecTruncate(fraction)
+
+	initOrUpdatePosition(accum, fromPosition.InitAccumValue, fromName, fromPosition.NumShares, fromPosition.UnclaimedRewards.Sub(rewardsToMove), fromPosition.Options)
+	initOrUpdatePosition(accum, toPosition.InitAccumValue, toName, toPosition.NumShares, toPosition.UnclaimedRewards.Add(rewardsToMove...), toPosition.Options)
+
+	return nil
+}
+
 func (accum AccumulatorObject) deletePosition(name string) {
 	accum.store.Delete(formatPositionPrefixKey(accum.name, name))
 }
@@ -1510,3 +1510,104 @@ func (suite *AccumTestSuite) TestGetTotalShares() {
 	suite.Require().Equal(expectedShares[0], accumOneShares)
 	suite.Require().Equal(expectedShares[1], accumTwoShares)
 }
+
+func (suite *AccumTestSuite) TestMoveUnclaimedRewards() {
+	var (
+		fromPositionName = testAddressOne
+		toPositionName   = testAddressTwo
+		fromRewards      = sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(100)), sdk.NewDecCoin(denomTwo, sdk.NewInt(50)))
+		toRewards        = sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(10)))
+	)
+
+	tests := map[string]struct {
+		fromName                string
+		toName                  string
+		fraction                sdk.Dec
+		expectedFromUnclaimed   sdk.DecCoins
+		expectedToUnclaimed     sdk.DecCoins
+		expectedError           error
+		expectedErrorIsNotTyped bool
+	}{
+		"move all rewards": {
+			fromName:              fromPositionName,
+			toName:                toPositionName,
+			fraction:              sdk.OneDec(),
+			expectedFromUnclaimed: sdk.NewDecCoins(),
+			expectedToUnclaimed:   toRewards.Add(fromRewards...),
+		},
+		"move a fraction of rewards": {
+			fromName:              fromPositionName,
+			toName:                toPositionName,
+			fraction:              sdk.NewDecWithPrec(4, 1),
+			expectedFromUnclaimed: sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(60)), sdk.NewDecCoin(denomTwo, sdk.NewInt(30))),
+			expectedToUnclaimed:   sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(50)), sdk.NewDecCoin(denomTwo, sdk.NewInt(20))),
+		},
+		"move no rewards": {
+			fromName:              fromPositionName,
+			toName:                toPositionName,
+			fraction:              sdk.ZeroDec(),
+			expectedFromUnclaimed: fromRewards,
+			expectedToUnclaimed:   toRewards,
+		},
+		"error: source position does not exist": {
+			fromName:      testAddressThree,
+			toName:        toPositionName,
+			fraction:      sdk.OneDec(),
+			expectedError: accumPackage.NoPositionError{Name: testAddressThree},
+		},
+		"error: destination position does not exist": {
+			fromName:      fromPositionName,
+			toName:        testAddressThree,
+			fraction:      sdk.OneDec(),
+			expectedError: accumPackage.NoPositionError{Name: testAddressThree},
+		},
+		"error: fraction greater than one": {
+			fromName:                fromPositionName,
+			toName:                  toPositionName,
+			fraction:                sdk.NewDecWithPrec(11, 1),
+			expectedErrorIsNotTyped: true,
+		},
+		"error: negative fraction": {
+			fromName:                fromPositionName,
+			toName:                  toPositionName,
+			fraction:                sdk.OneDec().Neg(),
+			expectedErrorIsNotTyped: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+
+			accObject := accumPackage.MakeTestAccumulator(suite.store, testNameOne, initialCoinsDenomOne, sdk.NewDec(300))
+			accumPackage.CreateRawPosition(accObject, fromPositionName, sdk.NewDec(100), fromRewards, nil)
+			accumPackage.CreateRawPosition(accObject, toPositionName, sdk.NewDec(200), toRewards, nil)
+
+			// System under test.
+			err := accObject.MoveUnclaimedRewards(tc.fromName, tc.toName, tc.fraction)
+
+			if tc.expectedErrorIsNotTyped {
+				suite.Require().Error(err)
+				return
+			}
+			if tc.expectedError != nil {
+				suite.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			suite.Require().NoError(err)
+
+			fromPosition := accObject.MustGetPosition(tc.fromName)
+			toPosition := accObject.MustGetPosition(tc.toName)
+
+			suite.Require().Equal(tc.expectedFromUnclaimed.String(), fromPosition.UnclaimedRewards.String())
+			suite.Require().Equal(tc.expectedToUnclaimed.String(), toPosition.UnclaimedRewards.String())
+
+			// Shares and accumulator values are unchanged.
+			suite.Require().Equal(sdk.NewDec(100), fromPosition.NumShares)
+			suite.Require().Equal(sdk.NewDec(200), toPosition.NumShares)
+			suite.Require().Equal(initialCoinsDenomOne, fromPosition.InitAccumValue)
+			suite.Require().Equal(initialCoinsDenomOne, toPosition.InitAccumValue)
+		})
+	}
+}
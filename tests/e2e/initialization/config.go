@@ -511,6 +511,7 @@ func updateTWAPGenesis(appGenState map[string]json.RawMessage) func(twapGenState
 					P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
 					P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
 					GeometricTwapAccumulator:    sdk.ZeroDec(),
+					HarmonicTwapAccumulator:     sdk.ZeroDec(),
 					LastErrorTime:               time.Time{}, // no previous error
 				}
 				twapGenState.Twaps = append(twapGenState.Twaps, twapRecord)
@@ -750,6 +750,728 @@ func (mr *MockPoolAmountOutExtensionMockRecorder) SwapOutAmtGivenIn(ctx, tokenIn
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapOutAmtGivenIn", reflect.TypeOf((*MockPoolAmountOutExtension)(nil).SwapOutAmtGivenIn), ctx, tokenIn, tokenOutDenom, swapFee)
 }
 
+// MockSingleAssetExitPoolExtension is a mock of SingleAssetExitPoolExtension interface.
+type MockSingleAssetExitPoolExtension struct {
+	ctrl     *gomock.Controller
+	recorder *MockSingleAssetExitPoolExtensionMockRecorder
+}
+
+// MockSingleAssetExitPoolExtensionMockRecorder is the mock recorder for MockSingleAssetExitPoolExtension.
+type MockSingleAssetExitPoolExtensionMockRecorder struct {
+	mock *MockSingleAssetExitPoolExtension
+}
+
+// NewMockSingleAssetExitPoolExtension creates a new mock instance.
+func NewMockSingleAssetExitPoolExtension(ctrl *gomock.Controller) *MockSingleAssetExitPoolExtension {
+	mock := &MockSingleAssetExitPoolExtension{ctrl: ctrl}
+	mock.recorder = &MockSingleAssetExitPoolExtensionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSingleAssetExitPoolExtension) EXPECT() *MockSingleAssetExitPoolExtensionMockRecorder {
+	return m.recorder
+}
+
+// CalcExitPoolCoinsFromShares mocks base method.
+func (m *MockSingleAssetExitPoolExtension) CalcExitPoolCoinsFromShares(ctx types.Context, numShares types.Int, exitFee types.Dec) (types.Coins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalcExitPoolCoinsFromShares", ctx, numShares, exitFee)
+	ret0, _ := ret[0].(types.Coins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalcExitPoolCoinsFromShares indicates an expected call of CalcExitPoolCoinsFromShares.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) CalcExitPoolCoinsFromShares(ctx, numShares, exitFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalcExitPoolCoinsFromShares", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).CalcExitPoolCoinsFromShares), ctx, numShares, exitFee)
+}
+
+// CalcInAmtGivenOut mocks base method.
+func (m *MockSingleAssetExitPoolExtension) CalcInAmtGivenOut(ctx types.Context, tokenOut types.Coins, tokenInDenom string, swapFee types.Dec) (types.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalcInAmtGivenOut", ctx, tokenOut, tokenInDenom, swapFee)
+	ret0, _ := ret[0].(types.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalcInAmtGivenOut indicates an expected call of CalcInAmtGivenOut.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) CalcInAmtGivenOut(ctx, tokenOut, tokenInDenom, swapFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalcInAmtGivenOut", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).CalcInAmtGivenOut), ctx, tokenOut, tokenInDenom, swapFee)
+}
+
+// CalcJoinPoolNoSwapShares mocks base method.
+func (m *MockSingleAssetExitPoolExtension) CalcJoinPoolNoSwapShares(ctx types.Context, tokensIn types.Coins, swapFee types.Dec) (types.Int, types.Coins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalcJoinPoolNoSwapShares", ctx, tokensIn, swapFee)
+	ret0, _ := ret[0].(types.Int)
+	ret1, _ := ret[1].(types.Coins)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CalcJoinPoolNoSwapShares indicates an expected call of CalcJoinPoolNoSwapShares.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) CalcJoinPoolNoSwapShares(ctx, tokensIn, swapFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalcJoinPoolNoSwapShares", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).CalcJoinPoolNoSwapShares), ctx, tokensIn, swapFee)
+}
+
+// CalcJoinPoolShares mocks base method.
+func (m *MockSingleAssetExitPoolExtension) CalcJoinPoolShares(ctx types.Context, tokensIn types.Coins, swapFee types.Dec) (types.Int, types.Coins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalcJoinPoolShares", ctx, tokensIn, swapFee)
+	ret0, _ := ret[0].(types.Int)
+	ret1, _ := ret[1].(types.Coins)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CalcJoinPoolShares indicates an expected call of CalcJoinPoolShares.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) CalcJoinPoolShares(ctx, tokensIn, swapFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalcJoinPoolShares", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).CalcJoinPoolShares), ctx, tokensIn, swapFee)
+}
+
+// CalcOutAmtGivenIn mocks base method.
+func (m *MockSingleAssetExitPoolExtension) CalcOutAmtGivenIn(ctx types.Context, tokenIn types.Coins, tokenOutDenom string, swapFee types.Dec) (types.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalcOutAmtGivenIn", ctx, tokenIn, tokenOutDenom, swapFee)
+	ret0, _ := ret[0].(types.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalcOutAmtGivenIn indicates an expected call of CalcOutAmtGivenIn.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) CalcOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, swapFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalcOutAmtGivenIn", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).CalcOutAmtGivenIn), ctx, tokenIn, tokenOutDenom, swapFee)
+}
+
+// ExitPool mocks base method.
+func (m *MockSingleAssetExitPoolExtension) ExitPool(ctx types.Context, numShares types.Int, exitFee types.Dec) (types.Coins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExitPool", ctx, numShares, exitFee)
+	ret0, _ := ret[0].(types.Coins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExitPool indicates an expected call of ExitPool.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) ExitPool(ctx, numShares, exitFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExitPool", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).ExitPool), ctx, numShares, exitFee)
+}
+
+// ExitSwapExactAmountOut mocks base method.
+func (m *MockSingleAssetExitPoolExtension) ExitSwapExactAmountOut(ctx types.Context, tokenOut types.Coin, shareInMaxAmount types.Int) (types.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExitSwapExactAmountOut", ctx, tokenOut, shareInMaxAmount)
+	ret0, _ := ret[0].(types.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExitSwapExactAmountOut indicates an expected call of ExitSwapExactAmountOut.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) ExitSwapExactAmountOut(ctx, tokenOut, shareInMaxAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExitSwapExactAmountOut", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).ExitSwapExactAmountOut), ctx, tokenOut, shareInMaxAmount)
+}
+
+// ExitSwapShareAmountIn mocks base method.
+func (m *MockSingleAssetExitPoolExtension) ExitSwapShareAmountIn(ctx types.Context, tokenOutDenom string, shareInAmount types.Int) (types.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExitSwapShareAmountIn", ctx, tokenOutDenom, shareInAmount)
+	ret0, _ := ret[0].(types.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExitSwapShareAmountIn indicates an expected call of ExitSwapShareAmountIn.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) ExitSwapShareAmountIn(ctx, tokenOutDenom, shareInAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExitSwapShareAmountIn", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).ExitSwapShareAmountIn), ctx, tokenOutDenom, shareInAmount)
+}
+
+// GetAddress mocks base method.
+func (m *MockSingleAssetExitPoolExtension) GetAddress() types.AccAddress {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddress")
+	ret0, _ := ret[0].(types.AccAddress)
+	return ret0
+}
+
+// GetAddress indicates an expected call of GetAddress.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) GetAddress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddress", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).GetAddress))
+}
+
+// GetExitFee mocks base method.
+func (m *MockSingleAssetExitPoolExtension) GetExitFee(ctx types.Context) types.Dec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExitFee", ctx)
+	ret0, _ := ret[0].(types.Dec)
+	return ret0
+}
+
+// GetExitFee indicates an expected call of GetExitFee.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) GetExitFee(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExitFee", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).GetExitFee), ctx)
+}
+
+// GetId mocks base method.
+func (m *MockSingleAssetExitPoolExtension) GetId() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetId")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetId indicates an expected call of GetId.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) GetId() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetId", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).GetId))
+}
+
+// GetSwapFee mocks base method.
+func (m *MockSingleAssetExitPoolExtension) GetSwapFee(ctx types.Context) types.Dec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSwapFee", ctx)
+	ret0, _ := ret[0].(types.Dec)
+	return ret0
+}
+
+// GetSwapFee indicates an expected call of GetSwapFee.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) GetSwapFee(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSwapFee", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).GetSwapFee), ctx)
+}
+
+// GetTotalPoolLiquidity mocks base method.
+func (m *MockSingleAssetExitPoolExtension) GetTotalPoolLiquidity(ctx types.Context) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalPoolLiquidity", ctx)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// GetTotalPoolLiquidity indicates an expected call of GetTotalPoolLiquidity.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) GetTotalPoolLiquidity(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPoolLiquidity", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).GetTotalPoolLiquidity), ctx)
+}
+
+// GetTotalShares mocks base method.
+func (m *MockSingleAssetExitPoolExtension) GetTotalShares() types.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalShares")
+	ret0, _ := ret[0].(types.Int)
+	return ret0
+}
+
+// GetTotalShares indicates an expected call of GetTotalShares.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) GetTotalShares() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalShares", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).GetTotalShares))
+}
+
+// GetType mocks base method.
+func (m *MockSingleAssetExitPoolExtension) GetType() types0.PoolType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetType")
+	ret0, _ := ret[0].(types0.PoolType)
+	return ret0
+}
+
+// GetType indicates an expected call of GetType.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) GetType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetType", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).GetType))
+}
+
+// IsActive mocks base method.
+func (m *MockSingleAssetExitPoolExtension) IsActive(ctx types.Context) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsActive", ctx)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsActive indicates an expected call of IsActive.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) IsActive(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsActive", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).IsActive), ctx)
+}
+
+// JoinPool mocks base method.
+func (m *MockSingleAssetExitPoolExtension) JoinPool(ctx types.Context, tokensIn types.Coins, swapFee types.Dec) (types.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinPool", ctx, tokensIn, swapFee)
+	ret0, _ := ret[0].(types.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinPool indicates an expected call of JoinPool.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) JoinPool(ctx, tokensIn, swapFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinPool", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).JoinPool), ctx, tokensIn, swapFee)
+}
+
+// JoinPoolNoSwap mocks base method.
+func (m *MockSingleAssetExitPoolExtension) JoinPoolNoSwap(ctx types.Context, tokensIn types.Coins, swapFee types.Dec) (types.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinPoolNoSwap", ctx, tokensIn, swapFee)
+	ret0, _ := ret[0].(types.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinPoolNoSwap indicates an expected call of JoinPoolNoSwap.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) JoinPoolNoSwap(ctx, tokensIn, swapFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinPoolNoSwap", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).JoinPoolNoSwap), ctx, tokensIn, swapFee)
+}
+
+// ProtoMessage mocks base method.
+func (m *MockSingleAssetExitPoolExtension) ProtoMessage() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ProtoMessage")
+}
+
+// ProtoMessage indicates an expected call of ProtoMessage.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) ProtoMessage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProtoMessage", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).ProtoMessage))
+}
+
+// Reset mocks base method.
+func (m *MockSingleAssetExitPoolExtension) Reset() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Reset")
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) Reset() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).Reset))
+}
+
+// SpotPrice mocks base method.
+func (m *MockSingleAssetExitPoolExtension) SpotPrice(ctx types.Context, quoteAssetDenom, baseAssetDenom string) (types.Dec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpotPrice", ctx, quoteAssetDenom, baseAssetDenom)
+	ret0, _ := ret[0].(types.Dec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SpotPrice indicates an expected call of SpotPrice.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) SpotPrice(ctx, quoteAssetDenom, baseAssetDenom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpotPrice", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).SpotPrice), ctx, quoteAssetDenom, baseAssetDenom)
+}
+
+// String mocks base method.
+func (m *MockSingleAssetExitPoolExtension) String() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "String")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// String indicates an expected call of String.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) String() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "String", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).String))
+}
+
+// SwapInAmtGivenOut mocks base method.
+func (m *MockSingleAssetExitPoolExtension) SwapInAmtGivenOut(ctx types.Context, tokenOut types.Coins, tokenInDenom string, swapFee types.Dec) (types.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwapInAmtGivenOut", ctx, tokenOut, tokenInDenom, swapFee)
+	ret0, _ := ret[0].(types.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SwapInAmtGivenOut indicates an expected call of SwapInAmtGivenOut.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) SwapInAmtGivenOut(ctx, tokenOut, tokenInDenom, swapFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapInAmtGivenOut", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).SwapInAmtGivenOut), ctx, tokenOut, tokenInDenom, swapFee)
+}
+
+// SwapOutAmtGivenIn mocks base method.
+func (m *MockSingleAssetExitPoolExtension) SwapOutAmtGivenIn(ctx types.Context, tokenIn types.Coins, tokenOutDenom string, swapFee types.Dec) (types.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwapOutAmtGivenIn", ctx, tokenIn, tokenOutDenom, swapFee)
+	ret0, _ := ret[0].(types.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SwapOutAmtGivenIn indicates an expected call of SwapOutAmtGivenIn.
+func (mr *MockSingleAssetExitPoolExtensionMockRecorder) SwapOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, swapFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapOutAmtGivenIn", reflect.TypeOf((*MockSingleAssetExitPoolExtension)(nil).SwapOutAmtGivenIn), ctx, tokenIn, tokenOutDenom, swapFee)
+}
+
+// MockPokablePoolExtension is a mock of PokablePoolExtension interface.
+type MockPokablePoolExtension struct {
+	ctrl     *gomock.Controller
+	recorder *MockPokablePoolExtensionMockRecorder
+}
+
+// MockPokablePoolExtensionMockRecorder is the mock recorder for MockPokablePoolExtension.
+type MockPokablePoolExtensionMockRecorder struct {
+	mock *MockPokablePoolExtension
+}
+
+// NewMockPokablePoolExtension creates a new mock instance.
+func NewMockPokablePoolExtension(ctrl *gomock.Controller) *MockPokablePoolExtension {
+	mock := &MockPokablePoolExtension{ctrl: ctrl}
+	mock.recorder = &MockPokablePoolExtensionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPokablePoolExtension) EXPECT() *MockPokablePoolExtensionMockRecorder {
+	return m.recorder
+}
+
+// CalcExitPoolCoinsFromShares mocks base method.
+func (m *MockPokablePoolExtension) CalcExitPoolCoinsFromShares(ctx types.Context, numShares types.Int, exitFee types.Dec) (types.Coins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalcExitPoolCoinsFromShares", ctx, numShares, exitFee)
+	ret0, _ := ret[0].(types.Coins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalcExitPoolCoinsFromShares indicates an expected call of CalcExitPoolCoinsFromShares.
+func (mr *MockPokablePoolExtensionMockRecorder) CalcExitPoolCoinsFromShares(ctx, numShares, exitFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalcExitPoolCoinsFromShares", reflect.TypeOf((*MockPokablePoolExtension)(nil).CalcExitPoolCoinsFromShares), ctx, numShares, exitFee)
+}
+
+// CalcInAmtGivenOut mocks base method.
+func (m *MockPokablePoolExtension) CalcInAmtGivenOut(ctx types.Context, tokenOut types.Coins, tokenInDenom string, swapFee types.Dec) (types.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalcInAmtGivenOut", ctx, tokenOut, tokenInDenom, swapFee)
+	ret0, _ := ret[0].(types.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalcInAmtGivenOut indicates an expected call of CalcInAmtGivenOut.
+func (mr *MockPokablePoolExtensionMockRecorder) CalcInAmtGivenOut(ctx, tokenOut, tokenInDenom, swapFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalcInAmtGivenOut", reflect.TypeOf((*MockPokablePoolExtension)(nil).CalcInAmtGivenOut), ctx, tokenOut, tokenInDenom, swapFee)
+}
+
+// CalcJoinPoolNoSwapShares mocks base method.
+func (m *MockPokablePoolExtension) CalcJoinPoolNoSwapShares(ctx types.Context, tokensIn types.Coins, swapFee types.Dec) (types.Int, types.Coins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalcJoinPoolNoSwapShares", ctx, tokensIn, swapFee)
+	ret0, _ := ret[0].(types.Int)
+	ret1, _ := ret[1].(types.Coins)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CalcJoinPoolNoSwapShares indicates an expected call of CalcJoinPoolNoSwapShares.
+func (mr *MockPokablePoolExtensionMockRecorder) CalcJoinPoolNoSwapShares(ctx, tokensIn, swapFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalcJoinPoolNoSwapShares", reflect.TypeOf((*MockPokablePoolExtension)(nil).CalcJoinPoolNoSwapShares), ctx, tokensIn, swapFee)
+}
+
+// CalcJoinPoolShares mocks base method.
+func (m *MockPokablePoolExtension) CalcJoinPoolShares(ctx types.Context, tokensIn types.Coins, swapFee types.Dec) (types.Int, types.Coins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalcJoinPoolShares", ctx, tokensIn, swapFee)
+	ret0, _ := ret[0].(types.Int)
+	ret1, _ := ret[1].(types.Coins)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CalcJoinPoolShares indicates an expected call of CalcJoinPoolShares.
+func (mr *MockPokablePoolExtensionMockRecorder) CalcJoinPoolShares(ctx, tokensIn, swapFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalcJoinPoolShares", reflect.TypeOf((*MockPokablePoolExtension)(nil).CalcJoinPoolShares), ctx, tokensIn, swapFee)
+}
+
+// CalcOutAmtGivenIn mocks base method.
+func (m *MockPokablePoolExtension) CalcOutAmtGivenIn(ctx types.Context, tokenIn types.Coins, tokenOutDenom string, swapFee types.Dec) (types.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalcOutAmtGivenIn", ctx, tokenIn, tokenOutDenom, swapFee)
+	ret0, _ := ret[0].(types.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalcOutAmtGivenIn indicates an expected call of CalcOutAmtGivenIn.
+func (mr *MockPokablePoolExtensionMockRecorder) CalcOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, swapFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalcOutAmtGivenIn", reflect.TypeOf((*MockPokablePoolExtension)(nil).CalcOutAmtGivenIn), ctx, tokenIn, tokenOutDenom, swapFee)
+}
+
+// ExitPool mocks base method.
+func (m *MockPokablePoolExtension) ExitPool(ctx types.Context, numShares types.Int, exitFee types.Dec) (types.Coins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExitPool", ctx, numShares, exitFee)
+	ret0, _ := ret[0].(types.Coins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExitPool indicates an expected call of ExitPool.
+func (mr *MockPokablePoolExtensionMockRecorder) ExitPool(ctx, numShares, exitFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExitPool", reflect.TypeOf((*MockPokablePoolExtension)(nil).ExitPool), ctx, numShares, exitFee)
+}
+
+// GetAddress mocks base method.
+func (m *MockPokablePoolExtension) GetAddress() types.AccAddress {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddress")
+	ret0, _ := ret[0].(types.AccAddress)
+	return ret0
+}
+
+// GetAddress indicates an expected call of GetAddress.
+func (mr *MockPokablePoolExtensionMockRecorder) GetAddress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddress", reflect.TypeOf((*MockPokablePoolExtension)(nil).GetAddress))
+}
+
+// GetExitFee mocks base method.
+func (m *MockPokablePoolExtension) GetExitFee(ctx types.Context) types.Dec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExitFee", ctx)
+	ret0, _ := ret[0].(types.Dec)
+	return ret0
+}
+
+// GetExitFee indicates an expected call of GetExitFee.
+func (mr *MockPokablePoolExtensionMockRecorder) GetExitFee(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExitFee", reflect.TypeOf((*MockPokablePoolExtension)(nil).GetExitFee), ctx)
+}
+
+// GetId mocks base method.
+func (m *MockPokablePoolExtension) GetId() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetId")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetId indicates an expected call of GetId.
+func (mr *MockPokablePoolExtensionMockRecorder) GetId() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetId", reflect.TypeOf((*MockPokablePoolExtension)(nil).GetId))
+}
+
+// GetSwapFee mocks base method.
+func (m *MockPokablePoolExtension) GetSwapFee(ctx types.Context) types.Dec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSwapFee", ctx)
+	ret0, _ := ret[0].(types.Dec)
+	return ret0
+}
+
+// GetSwapFee indicates an expected call of GetSwapFee.
+func (mr *MockPokablePoolExtensionMockRecorder) GetSwapFee(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSwapFee", reflect.TypeOf((*MockPokablePoolExtension)(nil).GetSwapFee), ctx)
+}
+
+// GetTotalPoolLiquidity mocks base method.
+func (m *MockPokablePoolExtension) GetTotalPoolLiquidity(ctx types.Context) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalPoolLiquidity", ctx)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// GetTotalPoolLiquidity indicates an expected call of GetTotalPoolLiquidity.
+func (mr *MockPokablePoolExtensionMockRecorder) GetTotalPoolLiquidity(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPoolLiquidity", reflect.TypeOf((*MockPokablePoolExtension)(nil).GetTotalPoolLiquidity), ctx)
+}
+
+// GetTotalShares mocks base method.
+func (m *MockPokablePoolExtension) GetTotalShares() types.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalShares")
+	ret0, _ := ret[0].(types.Int)
+	return ret0
+}
+
+// GetTotalShares indicates an expected call of GetTotalShares.
+func (mr *MockPokablePoolExtensionMockRecorder) GetTotalShares() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalShares", reflect.TypeOf((*MockPokablePoolExtension)(nil).GetTotalShares))
+}
+
+// GetType mocks base method.
+func (m *MockPokablePoolExtension) GetType() types0.PoolType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetType")
+	ret0, _ := ret[0].(types0.PoolType)
+	return ret0
+}
+
+// GetType indicates an expected call of GetType.
+func (mr *MockPokablePoolExtensionMockRecorder) GetType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetType", reflect.TypeOf((*MockPokablePoolExtension)(nil).GetType))
+}
+
+// IsActive mocks base method.
+func (m *MockPokablePoolExtension) IsActive(ctx types.Context) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsActive", ctx)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsActive indicates an expected call of IsActive.
+func (mr *MockPokablePoolExtensionMockRecorder) IsActive(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsActive", reflect.TypeOf((*MockPokablePoolExtension)(nil).IsActive), ctx)
+}
+
+// JoinPool mocks base method.
+func (m *MockPokablePoolExtension) JoinPool(ctx types.Context, tokensIn types.Coins, swapFee types.Dec) (types.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinPool", ctx, tokensIn, swapFee)
+	ret0, _ := ret[0].(types.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinPool indicates an expected call of JoinPool.
+func (mr *MockPokablePoolExtensionMockRecorder) JoinPool(ctx, tokensIn, swapFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinPool", reflect.TypeOf((*MockPokablePoolExtension)(nil).JoinPool), ctx, tokensIn, swapFee)
+}
+
+// JoinPoolNoSwap mocks base method.
+func (m *MockPokablePoolExtension) JoinPoolNoSwap(ctx types.Context, tokensIn types.Coins, swapFee types.Dec) (types.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinPoolNoSwap", ctx, tokensIn, swapFee)
+	ret0, _ := ret[0].(types.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinPoolNoSwap indicates an expected call of JoinPoolNoSwap.
+func (mr *MockPokablePoolExtensionMockRecorder) JoinPoolNoSwap(ctx, tokensIn, swapFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinPoolNoSwap", reflect.TypeOf((*MockPokablePoolExtension)(nil).JoinPoolNoSwap), ctx, tokensIn, swapFee)
+}
+
+// PokePool mocks base method.
+func (m *MockPokablePoolExtension) PokePool(blockTime time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PokePool", blockTime)
+}
+
+// PokePool indicates an expected call of PokePool.
+func (mr *MockPokablePoolExtensionMockRecorder) PokePool(blockTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PokePool", reflect.TypeOf((*MockPokablePoolExtension)(nil).PokePool), blockTime)
+}
+
+// ProtoMessage mocks base method.
+func (m *MockPokablePoolExtension) ProtoMessage() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ProtoMessage")
+}
+
+// ProtoMessage indicates an expected call of ProtoMessage.
+func (mr *MockPokablePoolExtensionMockRecorder) ProtoMessage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProtoMessage", reflect.TypeOf((*MockPokablePoolExtension)(nil).ProtoMessage))
+}
+
+// Reset mocks base method.
+func (m *MockPokablePoolExtension) Reset() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Reset")
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockPokablePoolExtensionMockRecorder) Reset() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockPokablePoolExtension)(nil).Reset))
+}
+
+// SpotPrice mocks base method.
+func (m *MockPokablePoolExtension) SpotPrice(ctx types.Context, quoteAssetDenom, baseAssetDenom string) (types.Dec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpotPrice", ctx, quoteAssetDenom, baseAssetDenom)
+	ret0, _ := ret[0].(types.Dec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SpotPrice indicates an expected call of SpotPrice.
+func (mr *MockPokablePoolExtensionMockRecorder) SpotPrice(ctx, quoteAssetDenom, baseAssetDenom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpotPrice", reflect.TypeOf((*MockPokablePoolExtension)(nil).SpotPrice), ctx, quoteAssetDenom, baseAssetDenom)
+}
+
+// String mocks base method.
+func (m *MockPokablePoolExtension) String() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "String")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// String indicates an expected call of String.
+func (mr *MockPokablePoolExtensionMockRecorder) String() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "String", reflect.TypeOf((*MockPokablePoolExtension)(nil).String))
+}
+
+// SwapInAmtGivenOut mocks base method.
+func (m *MockPokablePoolExtension) SwapInAmtGivenOut(ctx types.Context, tokenOut types.Coins, tokenInDenom string, swapFee types.Dec) (types.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwapInAmtGivenOut", ctx, tokenOut, tokenInDenom, swapFee)
+	ret0, _ := ret[0].(types.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SwapInAmtGivenOut indicates an expected call of SwapInAmtGivenOut.
+func (mr *MockPokablePoolExtensionMockRecorder) SwapInAmtGivenOut(ctx, tokenOut, tokenInDenom, swapFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapInAmtGivenOut", reflect.TypeOf((*MockPokablePoolExtension)(nil).SwapInAmtGivenOut), ctx, tokenOut, tokenInDenom, swapFee)
+}
+
+// SwapOutAmtGivenIn mocks base method.
+func (m *MockPokablePoolExtension) SwapOutAmtGivenIn(ctx types.Context, tokenIn types.Coins, tokenOutDenom string, swapFee types.Dec) (types.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwapOutAmtGivenIn", ctx, tokenIn, tokenOutDenom, swapFee)
+	ret0, _ := ret[0].(types.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SwapOutAmtGivenIn indicates an expected call of SwapOutAmtGivenIn.
+func (mr *MockPokablePoolExtensionMockRecorder) SwapOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, swapFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapOutAmtGivenIn", reflect.TypeOf((*MockPokablePoolExtension)(nil).SwapOutAmtGivenIn), ctx, tokenIn, tokenOutDenom, swapFee)
+}
+
 // MockWeightedPoolExtension is a mock of WeightedPoolExtension interface.
 type MockWeightedPoolExtension struct {
 	ctrl     *gomock.Controller
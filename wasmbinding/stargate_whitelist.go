@@ -99,6 +99,9 @@ func init() {
 
 	// concentrated liquidity
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.QueryPoolsRequest", &conentratedtypes.QueryPoolsResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulator", &conentratedtypes.QueryTickAccumulatorResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection", &conentratedtypes.QueryLiquidityNetInDirectionResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/SimulateSwapExactAmountIn", &conentratedtypes.QuerySimulateSwapExactAmountInResponse{})
 
 	// incentives
 	setWhitelistedQuery("/osmosis.incentives.Query/ModuleToDistributeCoins", &incentivestypes.ModuleToDistributeCoinsResponse{})
@@ -130,6 +133,12 @@ func init() {
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/NumPools", &poolmanagerqueryproto.NumPoolsResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn", &poolmanagerqueryproto.EstimateSwapExactAmountInResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut", &poolmanagerqueryproto.EstimateSwapExactAmountOutRequest{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateBestRouteSwapExactAmountIn", &poolmanagerqueryproto.EstimateBestRouteSwapExactAmountInResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/TakerFee", &poolmanagerqueryproto.TakerFeeResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/TakerFeeTotals", &poolmanagerqueryproto.TakerFeeTotalsResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/PoolVolume", &poolmanagerqueryproto.PoolVolumeResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/PoolVolumeInWindow", &poolmanagerqueryproto.PoolVolumeInWindowResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/PausedPools", &poolmanagerqueryproto.PausedPoolsResponse{})
 
 	// txfees
 	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/FeeTokens", &txfeestypes.QueryFeeTokensResponse{})
@@ -147,6 +156,12 @@ func init() {
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow", &twapquerytypes.ArithmeticTwapToNowResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwap", &twapquerytypes.GeometricTwapResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwapToNow", &twapquerytypes.GeometricTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/HarmonicTwap", &twapquerytypes.HarmonicTwapResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/HarmonicTwapToNow", &twapquerytypes.HarmonicTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/MultihopArithmeticTwap", &twapquerytypes.MultihopArithmeticTwapResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/MultihopGeometricTwap", &twapquerytypes.MultihopGeometricTwapResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Volatility", &twapquerytypes.VolatilityResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/MinMaxSpotPrice", &twapquerytypes.MinMaxSpotPriceResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Params", &twapquerytypes.ParamsResponse{})
 
 	// downtime-detector
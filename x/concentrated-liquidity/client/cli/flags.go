@@ -5,7 +5,13 @@ import (
 )
 
 const (
-	FlagPoolId = "pool-id"
+	FlagPoolId    = "pool-id"
+	FlagBoundTick = "bound-tick"
+	// Names of fields in the update swap fee proposal
+	FlagSwapFee          = "swap-fee"
+	FlagMaxSwapFee       = "max-swap-fee"
+	FlagFeePerTick       = "fee-per-tick"
+	FlagVolatilityWindow = "volatility-window"
 )
 
 func FlagSetJustPoolId() *flag.FlagSet {
@@ -13,3 +19,9 @@ func FlagSetJustPoolId() *flag.FlagSet {
 	fs.Uint64(FlagPoolId, 0, "The id of pool")
 	return fs
 }
+
+func FlagSetBoundTick() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagBoundTick, "", "The last tick to walk to, inclusive. Negative ticks must be wrapped in square brackets")
+	return fs
+}
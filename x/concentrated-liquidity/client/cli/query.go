@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
@@ -14,7 +17,12 @@ func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetCmdPools)
 	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetUserPositions)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetPositionById)
 	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetClaimableFees)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetLimitOrders)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetTickAccumulator)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetLiquidityNetInDirection)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetSimulateSwapExactAmountIn)
 	cmd.AddCommand(
 		osmocli.GetParams[*query.QueryParamsRequest](
 			types.ModuleName, query.NewQueryClient),
@@ -33,6 +41,14 @@ func GetUserPositions() (*osmocli.QueryDescriptor, *query.QueryUserPositionsRequ
 		&query.QueryUserPositionsRequest{}
 }
 
+func GetPositionById() (*osmocli.QueryDescriptor, *query.QueryPositionByIdRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "position-by-id [positionID]",
+		Short: "Query position by ID",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} position-by-id 53`}, &query.QueryPositionByIdRequest{}
+}
+
 func GetCmdPools() (*osmocli.QueryDescriptor, *query.QueryPoolsRequest) {
 	return &osmocli.QueryDescriptor{
 		Use:   "pools",
@@ -48,3 +64,56 @@ func GetClaimableFees() (*osmocli.QueryDescriptor, *query.QueryClaimableFeesRequ
 		Long: `{{.Short}}{{.ExampleHeader}}
 {{.CommandPrefix}} claimable-fees 1 osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj [-100] 100`}, &query.QueryClaimableFeesRequest{}
 }
+
+func GetLimitOrders() (*osmocli.QueryDescriptor, *query.QueryLimitOrdersRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "limit-orders [poolID] [owner]",
+		Short: "Query limit orders placed by owner in a pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} limit-orders 1 osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj`}, &query.QueryLimitOrdersRequest{}
+}
+
+func GetTickAccumulator() (*osmocli.QueryDescriptor, *query.QueryTickAccumulatorRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "tick-accumulator [poolID]",
+		Short: "Query the tick accumulator of a pool at the current block time",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} tick-accumulator 1`}, &query.QueryTickAccumulatorRequest{}
+}
+
+func GetLiquidityNetInDirection() (*osmocli.QueryDescriptor, *query.QueryLiquidityNetInDirectionRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "liquidity-net-in-direction [poolID] [tokenIn]",
+		Short: "Query the liquidity net of initialized ticks in the direction of a swap of token in",
+		Long: `{{.Short}}
+The walk starts from the current tick and ends at the tick given by --bound-tick, or at the min or max tick of the pool if unset.{{.ExampleHeader}}
+{{.CommandPrefix}} liquidity-net-in-direction 1 uosmo --bound-tick [-69082] --limit 100`,
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetBoundTick()}},
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"BoundTick":  parseBoundTick,
+			"UseNoBound": parseUseNoBound,
+		},
+	}, &query.QueryLiquidityNetInDirectionRequest{}
+}
+
+func parseBoundTick(_ string, fs *flag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	boundTickStr, err := fs.GetString(FlagBoundTick)
+	if err != nil || boundTickStr == "" {
+		return sdk.ZeroInt(), osmocli.UsedFlag, err
+	}
+	boundTick, err := osmocli.ParseSdkInt(strings.TrimSuffix(strings.TrimPrefix(boundTickStr, "["), "]"), FlagBoundTick)
+	return boundTick, osmocli.UsedFlag, err
+}
+
+func parseUseNoBound(_ string, fs *flag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	boundTickStr, err := fs.GetString(FlagBoundTick)
+	return boundTickStr == "", osmocli.UsedFlag, err
+}
+
+func GetSimulateSwapExactAmountIn() (*osmocli.QueryDescriptor, *query.QuerySimulateSwapExactAmountInRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "simulate-swap-exact-amount-in [poolID] [tokenIn] [tokenOutDenom]",
+		Short: "Simulate a swap of an exact amount in and trace every swap step",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} simulate-swap-exact-amount-in 1 1000000uosmo uion`}, &query.QuerySimulateSwapExactAmountInRequest{}
+}
@@ -1,10 +1,19 @@
 package cli
 
 import (
+	"strings"
+
 	flag "github.com/spf13/pflag"
 
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	clmodel "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
@@ -14,9 +23,16 @@ func NewTxCmd() *cobra.Command {
 	txCmd := osmocli.TxIndexCmd(types.ModuleName)
 	osmocli.AddTxCmd(txCmd, NewCreatePositionCmd)
 	osmocli.AddTxCmd(txCmd, NewWithdrawPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewWithdrawPositionByIdCmd)
+	osmocli.AddTxCmd(txCmd, NewAddToPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewTransferPositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewPlaceLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewClaimLimitOrderCmd)
 	osmocli.AddTxCmd(txCmd, NewCreateConcentratedPoolCmd)
 	osmocli.AddTxCmd(txCmd, NewCollectFeesCmd)
 	osmocli.AddTxCmd(txCmd, NewCollectIncentivesCmd)
+	osmocli.AddTxCmd(txCmd, NewCollectFeesByIdCmd)
+	osmocli.AddTxCmd(txCmd, NewCollectIncentivesByIdCmd)
 	osmocli.AddTxCmd(txCmd, NewCreateIncentiveCmd)
 	return txCmd
 }
@@ -53,6 +69,63 @@ func NewWithdrawPositionCmd() (*osmocli.TxCliDesc, *types.MsgWithdrawPosition) {
 	}, &types.MsgWithdrawPosition{}
 }
 
+func NewWithdrawPositionByIdCmd() (*osmocli.TxCliDesc, *types.MsgWithdrawPositionById) {
+	return &osmocli.TxCliDesc{
+		Use:     "withdraw-position-by-id [position-id] [liquidity-out]",
+		Short:   "withdraw from an existing concentrated liquidity position given by its id",
+		Example: "withdraw-position-by-id 1 100317215 --from val --chain-id osmosis-1",
+	}, &types.MsgWithdrawPositionById{}
+}
+
+func NewAddToPositionCmd() (*osmocli.TxCliDesc, *types.MsgAddToPosition) {
+	return &osmocli.TxCliDesc{
+		Use:                 "add-to-position [position-id] [lower-tick] [upper-tick] [join-time] [freeze-duration] [token-0] [token-1] [token-0-min-amount] [token-1-min-amount]",
+		Short:               "add liquidity to an existing concentrated liquidity position",
+		Example:             "add-to-position 1 [-69082] 69082 2023-03-03 03:20:35.419543805 24h 1000000000uosmo 10000000uion 0 0 --pool-id 1 --from val --chain-id osmosis-1",
+		CustomFlagOverrides: poolIdFlagOverride,
+		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
+	}, &types.MsgAddToPosition{}
+}
+
+func NewTransferPositionsCmd() (*osmocli.TxCliDesc, *types.MsgTransferPositions) {
+	return &osmocli.TxCliDesc{
+		Use:     "transfer-positions [position-ids] [new-owner]",
+		Short:   "transfer concentrated liquidity positions, including their accrued fees and incentives, to a new owner",
+		Example: "transfer-positions 1,2,5 osmo1q8tq5qhrhw6t970egemuuwywhlhpnmdmts6xnu --from val --chain-id osmosis-1",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"PositionIds": parsePositionIds,
+		},
+	}, &types.MsgTransferPositions{}
+}
+
+func parsePositionIds(arg string, _ *flag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	positionIds, err := osmoutils.ParseUint64SliceFromString(arg, ",")
+	if err != nil {
+		return nil, osmocli.UsedArg, err
+	}
+	return positionIds, osmocli.UsedArg, nil
+}
+
+func NewPlaceLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgPlaceLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:                 "place-limit-order [tick-index] [token-in]",
+		Short:               "place a limit order spanning a single tick spacing starting at the given tick",
+		Example:             "place-limit-order 69100 1000000uosmo --pool-id 1 --from val --chain-id osmosis-1",
+		CustomFlagOverrides: poolIdFlagOverride,
+		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
+	}, &types.MsgPlaceLimitOrder{}
+}
+
+func NewClaimLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgClaimLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:                 "claim-limit-order [position-id]",
+		Short:               "claim the proceeds of a filled limit order",
+		Example:             "claim-limit-order 1 --pool-id 1 --from val --chain-id osmosis-1",
+		CustomFlagOverrides: poolIdFlagOverride,
+		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
+	}, &types.MsgClaimLimitOrder{}
+}
+
 func NewCollectFeesCmd() (*osmocli.TxCliDesc, *types.MsgCollectFees) {
 	return &osmocli.TxCliDesc{
 		Use:                 "collect-fees [lower-tick] [upper-tick]",
@@ -63,6 +136,17 @@ func NewCollectFeesCmd() (*osmocli.TxCliDesc, *types.MsgCollectFees) {
 	}, &types.MsgCollectFees{}
 }
 
+func NewCollectFeesByIdCmd() (*osmocli.TxCliDesc, *types.MsgCollectFeesById) {
+	return &osmocli.TxCliDesc{
+		Use:     "collect-fees-by-id [position-ids]",
+		Short:   "collect fees from liquidity positions given by their ids",
+		Example: "collect-fees-by-id 1,5,7 --from val --chain-id osmosis-1",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"PositionIds": parsePositionIds,
+		},
+	}, &types.MsgCollectFeesById{}
+}
+
 func NewCollectIncentivesCmd() (*osmocli.TxCliDesc, *types.MsgCollectIncentives) {
 	return &osmocli.TxCliDesc{
 		Use:                 "collect-incentives [lower-tick] [upper-tick]",
@@ -73,6 +157,17 @@ func NewCollectIncentivesCmd() (*osmocli.TxCliDesc, *types.MsgCollectIncentives)
 	}, &types.MsgCollectIncentives{}
 }
 
+func NewCollectIncentivesByIdCmd() (*osmocli.TxCliDesc, *types.MsgCollectIncentivesById) {
+	return &osmocli.TxCliDesc{
+		Use:     "collect-incentives-by-id [position-ids]",
+		Short:   "collect incentives from liquidity positions given by their ids",
+		Example: "collect-incentives-by-id 1,5,7 --from val --chain-id osmosis-1",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"PositionIds": parsePositionIds,
+		},
+	}, &types.MsgCollectIncentivesById{}
+}
+
 func NewCreateIncentiveCmd() (*osmocli.TxCliDesc, *types.MsgCreateIncentive) {
 	return &osmocli.TxCliDesc{
 		Use:                 "create-incentive [incentive-denom] [incentive-amount] [emission-rate] [start-time] [min-uptime]",
@@ -82,3 +177,130 @@ func NewCreateIncentiveCmd() (*osmocli.TxCliDesc, *types.MsgCreateIncentive) {
 		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
 	}, &types.MsgCreateIncentive{}
 }
+
+// NewCmdSubmitUpdateSwapFeeProposal implements a command handler for the update swap fee proposal
+func NewCmdSubmitUpdateSwapFeeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-cl-swap-fee-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a proposal to update the swap fee of a concentrated liquidity pool",
+		Long: strings.TrimSpace(`Submit a proposal to update the swap fee of a concentrated liquidity pool.
+
+The swap fee must be one of the authorized swap fees.
+Dynamic swap fees are enabled by passing --max-swap-fee, --fee-per-tick and --volatility-window,
+and disabled otherwise.
+Ex) --pool-id 1 --swap-fee 0.003 --max-swap-fee 0.01 --fee-per-tick 0.000001 --volatility-window 1h
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			content, err := parseUpdateSwapFeeArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().AddFlagSet(FlagSetJustPoolId())
+	cmd.Flags().String(FlagSwapFee, "", "The new base swap fee of the pool")
+	cmd.Flags().String(FlagMaxSwapFee, "", "The maximum swap fee charged by the dynamic swap fee")
+	cmd.Flags().String(FlagFeePerTick, "", "The fee added to the base swap fee for every tick moved within the volatility window")
+	cmd.Flags().Duration(FlagVolatilityWindow, 0, "The duration of the window over which tick movement is measured")
+
+	return cmd
+}
+
+func parseUpdateSwapFeeArgsToContent(cmd *cobra.Command) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	poolId, err := cmd.Flags().GetUint64(FlagPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	swapFeeStr, err := cmd.Flags().GetString(FlagSwapFee)
+	if err != nil {
+		return nil, err
+	}
+	swapFee, err := sdk.NewDecFromStr(swapFeeStr)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicSwapFee, err := parseDynamicSwapFee(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewUpdateSwapFeeProposal(title, description, poolId, swapFee, dynamicSwapFee), nil
+}
+
+// parseDynamicSwapFee returns the dynamic swap fee given by the flags, or nil if no max swap fee is given.
+func parseDynamicSwapFee(cmd *cobra.Command) (*types.DynamicSwapFee, error) {
+	maxSwapFeeStr, err := cmd.Flags().GetString(FlagMaxSwapFee)
+	if err != nil {
+		return nil, err
+	}
+	if maxSwapFeeStr == "" {
+		return nil, nil
+	}
+	maxSwapFee, err := sdk.NewDecFromStr(maxSwapFeeStr)
+	if err != nil {
+		return nil, err
+	}
+
+	feePerTickStr, err := cmd.Flags().GetString(FlagFeePerTick)
+	if err != nil {
+		return nil, err
+	}
+	feePerTick, err := sdk.NewDecFromStr(feePerTickStr)
+	if err != nil {
+		return nil, err
+	}
+
+	volatilityWindow, err := cmd.Flags().GetDuration(FlagVolatilityWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DynamicSwapFee{
+		MaxSwapFee:       maxSwapFee,
+		FeePerTick:       feePerTick,
+		VolatilityWindow: volatilityWindow,
+	}, nil
+}
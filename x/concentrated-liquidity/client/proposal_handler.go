@@ -0,0 +1,10 @@
+package client
+
+import (
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/client/cli"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/client/rest"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var UpdateSwapFeeProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateSwapFeeProposal, rest.ProposalUpdateSwapFeeRESTHandler)
@@ -160,7 +160,14 @@ func (am AppModule) Actions() []simtypes.Action {
 		//simtypes.NewMsgBasedAction("CLSwapExactAmountIn", am.keeper, simulation.RandomSwapExactAmountIn),
 		//simtypes.NewMsgBasedAction("CLSwapExactAmountOut", am.keeper, simulation.RandomSwapExactAmountOut),
 		simtypes.NewMsgBasedAction("WithdrawPosition", am.keeper, simulation.RandMsgWithdrawPosition),
+		simtypes.NewMsgBasedAction("WithdrawPositionById", am.keeper, simulation.RandMsgWithdrawPositionById),
+		simtypes.NewMsgBasedAction("AddToPosition", am.keeper, simulation.RandMsgAddToPosition),
+		simtypes.NewMsgBasedAction("TransferPositions", am.keeper, simulation.RandMsgTransferPositions),
+		simtypes.NewMsgBasedAction("PlaceLimitOrder", am.keeper, simulation.RandMsgPlaceLimitOrder),
+		simtypes.NewMsgBasedAction("ClaimLimitOrder", am.keeper, simulation.RandMsgClaimLimitOrder),
 		simtypes.NewMsgBasedAction("CollectFees", am.keeper, simulation.RandMsgCollectFees),
 		simtypes.NewMsgBasedAction("CollectIncentives", am.keeper, simulation.RandMsgCollectIncentives),
+		simtypes.NewMsgBasedAction("CollectFeesById", am.keeper, simulation.RandMsgCollectFeesById),
+		simtypes.NewMsgBasedAction("CollectIncentivesById", am.keeper, simulation.RandMsgCollectIncentivesById),
 	}
 }
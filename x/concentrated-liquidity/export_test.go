@@ -41,6 +41,10 @@ func (k Keeper) DeletePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	return k.deletePosition(ctx, poolId, owner, lowerTick, upperTick, joinTime, freezeDuration, positionId)
 }
 
+func (k Keeper) UpdatePoolSwapFee(ctx sdk.Context, poolId uint64, swapFee sdk.Dec, dynamicSwapFee *types.DynamicSwapFee) error {
+	return k.updatePoolSwapFee(ctx, poolId, swapFee, dynamicSwapFee)
+}
+
 func (k Keeper) GetPoolById(ctx sdk.Context, poolId uint64) (types.ConcentratedPoolExtension, error) {
 	return k.getPoolById(ctx, poolId)
 }
@@ -170,14 +174,26 @@ func PreparePositionAccumulator(feeAccumulator accum.AccumulatorObject, position
 	return preparePositionAccumulator(feeAccumulator, positionKey, feeGrowthOutside)
 }
 
-func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, amount0Desired, amount1Desired, amount0Min, amount1Min sdk.Int, lowerTick, upperTick int64, freezeDuration time.Duration) (uint64, sdk.Int, sdk.Int, sdk.Dec, time.Time, error) {
-	return k.createPosition(ctx, poolId, owner, amount0Desired, amount1Desired, amount0Min, amount1Min, lowerTick, upperTick, freezeDuration)
-}
-
 func (k Keeper) WithdrawPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, joinTime time.Time, freezeDuration time.Duration, positionId uint64, requestedLiquidityAmountToWithdraw sdk.Dec) (amtDenom0, amtDenom1 sdk.Int, err error) {
 	return k.withdrawPosition(ctx, poolId, owner, lowerTick, upperTick, joinTime, freezeDuration, positionId, requestedLiquidityAmountToWithdraw)
 }
 
+func (k Keeper) AddToPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, joinTime time.Time, freezeDuration time.Duration, positionId uint64, amount0Desired, amount1Desired, amount0Min, amount1Min sdk.Int) (sdk.Int, sdk.Int, sdk.Dec, error) {
+	return k.addToPosition(ctx, poolId, owner, lowerTick, upperTick, joinTime, freezeDuration, positionId, amount0Desired, amount1Desired, amount0Min, amount1Min)
+}
+
+func (k Keeper) TransferPositions(ctx sdk.Context, positionIds []uint64, sender, newOwner sdk.AccAddress) error {
+	return k.transferPositions(ctx, positionIds, sender, newOwner)
+}
+
+func (k Keeper) PlaceLimitOrder(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, tickIndex int64, tokenIn sdk.Coin) (uint64, sdk.Coin, sdk.Dec, error) {
+	return k.placeLimitOrder(ctx, poolId, owner, tickIndex, tokenIn)
+}
+
+func (k Keeper) ClaimLimitOrder(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, positionId uint64) (sdk.Coin, sdk.Coins, error) {
+	return k.claimLimitOrder(ctx, poolId, owner, positionId)
+}
+
 func (ss *SwapState) UpdateFeeGrowthGlobal(feeChargeTotal sdk.Dec) {
 	ss.updateFeeGrowthGlobal(feeChargeTotal)
 }
@@ -230,6 +230,34 @@ func (k Keeper) collectFees(ctx sdk.Context, poolId uint64, owner sdk.AccAddress
 	return feesClaimed, nil
 }
 
+// collectFeesForPositions collects the fees accrued by the positions with the given ids owned by owner.
+// Fee accumulator records are shared by all positions of an owner in the same pool and tick range, so fees
+// are collected once per distinct range. As a result, collecting fees for a position also collects the fees
+// of the owner's other positions in the same range.
+// Returns the total fees collected. Emits a collect fees event per range.
+// Returns error if:
+// - any of the positions does not exist or is owned by another account
+// - other internal database or math errors.
+func (k Keeper) collectFeesForPositions(ctx sdk.Context, owner sdk.AccAddress, positionIds []uint64) (sdk.Coins, error) {
+	positionRanges, err := k.getPositionRangesForOwner(ctx, owner, positionIds)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	totalCollectedFees := sdk.NewCoins()
+	for _, positionRange := range positionRanges {
+		collectedFees, err := k.collectFees(ctx, positionRange.poolId, owner, positionRange.lowerTick, positionRange.upperTick)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+
+		totalCollectedFees = totalCollectedFees.Add(collectedFees...)
+		emitCollectEvent(ctx, cltypes.TypeEvtCollectFees, owner, positionRange, collectedFees)
+	}
+
+	return totalCollectedFees, nil
+}
+
 // queryClaimableFees queries the fee accumulator for the position given by pool id, owner, lower tick and upper tick.
 // It returns the outstanding fees that can be claimed by the owner.
 // Returns error if:
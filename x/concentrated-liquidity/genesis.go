@@ -6,6 +6,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
 	types "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types/genesis"
 )
@@ -42,6 +43,23 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 		}
 		k.setPosition(ctx, position.PoolId, sdk.MustAccAddressFromBech32(position.Address), position.LowerTick, position.UpperTick, position.JoinTime, position.FreezeDuration, position.Liquidity, position.PositionId)
 	}
+
+	for _, limitOrder := range genState.LimitOrders {
+		if _, ok := seenPoolIds[limitOrder.PoolId]; !ok {
+			panic(fmt.Sprintf("found limit order with pool id (%d) but there is no pool with such id that exists", limitOrder.PoolId))
+		}
+		owner := sdk.MustAccAddressFromBech32(limitOrder.Owner)
+		k.setLimitOrder(ctx, owner, limitOrder)
+
+		// Open limit orders are re-indexed so that they continue to be filled by swaps.
+		if limitOrder.Status == model.LimitOrderOpen {
+			pool, err := k.getPoolById(ctx, limitOrder.PoolId)
+			if err != nil {
+				panic(err)
+			}
+			k.setOpenLimitOrderIndex(ctx, owner, limitOrder, limitOrder.TokenIn.Denom == pool.GetToken0())
+		}
+	}
 }
 
 // ExportGenesis returns the concentrated-liquidity module's exported genesis state.
@@ -76,10 +94,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 		panic(err)
 	}
 
+	limitOrders, err := k.getAllLimitOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &genesis.GenesisState{
 		Params:         k.GetParams(ctx),
 		PoolData:       poolData,
 		Positions:      positions,
 		NextPositionId: k.GetNextPositionId(ctx),
+		LimitOrders:    limitOrders,
 	}
 }
@@ -0,0 +1,27 @@
+package concentrated_liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+// NewConcentratedLiquidityProposalHandler is a handler for governance proposals on concentrated liquidity pools.
+func NewConcentratedLiquidityProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdateSwapFeeProposal:
+			return k.HandleUpdateSwapFeeProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized concentrated liquidity proposal content type: %T", c)
+		}
+	}
+}
+
+// HandleUpdateSwapFeeProposal is a handler for updating the swap fee of a concentrated liquidity pool.
+func (k Keeper) HandleUpdateSwapFeeProposal(ctx sdk.Context, p *types.UpdateSwapFeeProposal) error {
+	return k.updatePoolSwapFee(ctx, p.PoolId, p.SwapFee, p.DynamicSwapFee)
+}
@@ -81,6 +81,38 @@ func (q Querier) UserPositions(ctx context.Context, req *clquery.QueryUserPositi
 	}, nil
 }
 
+// PositionById returns a position with the specified id, along with its underlying asset breakdown.
+func (q Querier) PositionById(ctx context.Context, req *clquery.QueryPositionByIdRequest) (*clquery.QueryPositionByIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	position, err := q.Keeper.GetPosition(sdkCtx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	pool, err := q.Keeper.getPoolById(sdkCtx, position.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	asset0, asset1, err := CalculateUnderlyingAssetsFromPosition(sdkCtx, position, pool)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryPositionByIdResponse{
+		Position: model.PositionWithUnderlyingAssetBreakdown{
+			Position: position,
+			Asset0:   asset0,
+			Asset1:   asset1,
+		},
+	}, nil
+}
+
 // Pools returns all concentrated pools in existence.
 func (q Querier) Pools(
 	ctx context.Context,
@@ -161,6 +193,64 @@ func (q Querier) LiquidityDepthsForRange(goCtx context.Context, req *clquery.Que
 	}, nil
 }
 
+// LiquidityNetInDirection returns the liquidity net of the initialized ticks of a pool in the direction of a swap
+// of the given token in, together with the pool's current tick, liquidity and square root price.
+func (q Querier) LiquidityNetInDirection(goCtx context.Context, req *clquery.QueryLiquidityNetInDirectionRequest) (*clquery.QueryLiquidityNetInDirectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "token in is empty")
+	}
+	if !req.UseNoBound && req.BoundTick.IsNil() {
+		return nil, status.Error(codes.InvalidArgument, types.ErrBoundTickNotSet.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	liquidityDepths, pageRes, err := q.Keeper.GetTickLiquidityNetInDirection(ctx, req.PoolId, req.TokenIn, req.BoundTick, req.UseNoBound, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pool, err := q.Keeper.getPoolById(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryLiquidityNetInDirectionResponse{
+		LiquidityDepths:  liquidityDepths,
+		CurrentTick:      pool.GetCurrentTick(),
+		CurrentLiquidity: pool.GetLiquidity(),
+		CurrentSqrtPrice: pool.GetCurrentSqrtPrice(),
+		Pagination:       pageRes,
+	}, nil
+}
+
+// SimulateSwapExactAmountIn runs a swap of an exact amount in against the current state of a pool without persisting it,
+// and returns the trace of every swap step together with the price impact of the swap.
+func (q Querier) SimulateSwapExactAmountIn(goCtx context.Context, req *clquery.QuerySimulateSwapExactAmountInRequest) (*clquery.QuerySimulateSwapExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if !req.TokenIn.IsValid() || !req.TokenIn.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "token in must be positive")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tokenIn, tokenOut, swapFee, priceImpact, steps, err := q.Keeper.SimulateSwapExactAmountIn(ctx, req.PoolId, req.TokenIn, req.TokenOutDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QuerySimulateSwapExactAmountInResponse{
+		TokenIn:     tokenIn,
+		TokenOut:    tokenOut,
+		SwapFee:     swapFee,
+		PriceImpact: priceImpact,
+		Steps:       steps,
+	}, nil
+}
+
 // TotalLiquidityForRange returns an array of LiquidityDepthWithRange, which contains the range(lower tick and upper tick) and the liquidity amount in the range.
 func (q Querier) TotalLiquidityForRange(goCtx context.Context, req *clquery.QueryTotalLiquidityForRangeRequest) (*clquery.QueryTotalLiquidityForRangeResponse, error) {
 	if req == nil {
@@ -199,3 +289,56 @@ func (q Querier) ClaimableFees(ctx context.Context, req *clquery.QueryClaimableF
 		ClaimableFees: claimableFees,
 	}, nil
 }
+
+// LimitOrders returns the open and filled limit orders placed by the given owner in the given pool.
+func (q Querier) LimitOrders(ctx context.Context, req *clquery.QueryLimitOrdersRequest) (*clquery.QueryLimitOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkAddr, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	store := sdkCtx.KVStore(q.Keeper.storeKey)
+	limitOrderStore := prefix.NewStore(store, types.KeyPoolOwnerLimitOrders(req.PoolId, sdkAddr))
+
+	limitOrders := []model.LimitOrder{}
+	pageRes, err := query.Paginate(limitOrderStore, req.Pagination, func(_, value []byte) error {
+		limitOrder, err := ParseLimitOrderFromBz(value)
+		if err != nil {
+			return err
+		}
+
+		limitOrders = append(limitOrders, limitOrder)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryLimitOrdersResponse{
+		LimitOrders: limitOrders,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// TickAccumulator returns the tick accumulator of the given pool extrapolated to the current block time.
+func (q Querier) TickAccumulator(ctx context.Context, req *clquery.QueryTickAccumulatorRequest) (*clquery.QueryTickAccumulatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	tickAccumulator, err := q.Keeper.GetTickAccumulator(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryTickAccumulatorResponse{
+		TickAccumulator: tickAccumulator,
+		Time:            sdkCtx.BlockTime(),
+	}, nil
+}
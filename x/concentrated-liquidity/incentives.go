@@ -604,6 +604,33 @@ func (k Keeper) collectIncentives(ctx sdk.Context, poolId uint64, owner sdk.AccA
 	return collectedIncentives, nil
 }
 
+// collectIncentivesForPositions collects the incentives accrued by the positions with the given ids owned by owner.
+// Similarly to collectFeesForPositions, incentives are collected once per distinct pool and tick range, so collecting
+// incentives for a position also collects the incentives of the owner's other positions in the same range.
+// Returns the total incentives collected. Emits a collect incentives event per range.
+// Returns error if:
+// - any of the positions does not exist or is owned by another account
+// - other internal database or math errors.
+func (k Keeper) collectIncentivesForPositions(ctx sdk.Context, owner sdk.AccAddress, positionIds []uint64) (sdk.Coins, error) {
+	positionRanges, err := k.getPositionRangesForOwner(ctx, owner, positionIds)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	totalCollectedIncentives := sdk.NewCoins()
+	for _, positionRange := range positionRanges {
+		collectedIncentives, err := k.collectIncentives(ctx, positionRange.poolId, owner, positionRange.lowerTick, positionRange.upperTick)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+
+		totalCollectedIncentives = totalCollectedIncentives.Add(collectedIncentives...)
+		emitCollectEvent(ctx, types.TypeEvtCollectIncentives, owner, positionRange, collectedIncentives)
+	}
+
+	return totalCollectedIncentives, nil
+}
+
 // createIncentive creates an incentive record in state for the given pool
 func (k Keeper) createIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, incentiveDenom string, incentiveAmount sdk.Int, emissionRate sdk.Dec, startTime time.Time, minUptime time.Duration) (types.IncentiveRecord, error) {
 	pool, err := k.getPoolById(ctx, poolId)
@@ -36,7 +36,26 @@ func TicksToSqrtPrice(lowerTick, upperTick int64, exponentAtPriceOne sdk.Int) (s
 //   - exponentAtPriceOne: the value of the exponent (and therefore the precision) at which the starting price of 1 is set
 //
 // If tickIndex is zero, the function returns sdk.OneDec().
-func TickToSqrtPrice(tickIndex, exponentAtPriceOne sdk.Int) (price sdk.Dec, err error) {
+func TickToSqrtPrice(tickIndex, exponentAtPriceOne sdk.Int) (sdk.Dec, error) {
+	price, err := TickToPrice(tickIndex, exponentAtPriceOne)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// Determine the sqrtPrice from the price
+	sqrtPrice, err := price.ApproxSqrt()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return sqrtPrice, nil
+}
+
+// TickToPrice returns the price given the following two arguments:
+//   - tickIndex: the tick index to calculate the price for
+//   - exponentAtPriceOne: the value of the exponent (and therefore the precision) at which the starting price of 1 is set
+//
+// If tickIndex is zero, the function returns sdk.OneDec().
+func TickToPrice(tickIndex, exponentAtPriceOne sdk.Int) (price sdk.Dec, err error) {
 	if tickIndex.IsZero() {
 		return sdk.OneDec(), nil
 	}
@@ -83,12 +102,7 @@ func TickToSqrtPrice(tickIndex, exponentAtPriceOne sdk.Int) (price sdk.Dec, err
 		return sdk.Dec{}, types.PriceBoundError{ProvidedPrice: price, MinSpotPrice: types.MinSpotPrice, MaxSpotPrice: types.MaxSpotPrice}
 	}
 
-	// Determine the sqrtPrice from the price
-	sqrtPrice, err := price.ApproxSqrt()
-	if err != nil {
-		return sdk.Dec{}, err
-	}
-	return sqrtPrice, nil
+	return price, nil
 }
 
 // PriceToTick takes a price and returns the corresponding tick index
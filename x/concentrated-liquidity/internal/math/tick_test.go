@@ -157,6 +157,9 @@ func (suite *ConcentratedMathTestSuite) TestTickToSqrtPrice() {
 			suite.Require().NoError(err)
 			suite.Require().Equal(expectedSqrtPrice.String(), sqrtPrice.String())
 
+			price, err := math.TickToPrice(tc.tickIndex, tc.exponentAtPriceOne)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedPrice.String(), price.String())
 		})
 	}
 }
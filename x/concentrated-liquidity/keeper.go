@@ -9,6 +9,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
 
 type Keeper struct {
@@ -20,6 +21,9 @@ type Keeper struct {
 	// keepers
 	poolmanagerKeeper types.PoolManagerKeeper
 	bankKeeper        types.BankKeeper
+
+	// listeners
+	poolListeners poolmanagertypes.PoolListeners
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, bankKeeper types.BankKeeper, paramSpace paramtypes.Subspace) *Keeper {
@@ -46,6 +50,17 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
+// SetPoolListeners sets the listeners notified of swaps and liquidity changes in concentrated liquidity pools.
+func (k *Keeper) SetPoolListeners(poolListeners poolmanagertypes.PoolListeners) *Keeper {
+	if k.poolListeners != nil {
+		panic("cannot set concentrated liquidity pool listeners twice")
+	}
+
+	k.poolListeners = poolListeners
+
+	return k
+}
+
 // Set the poolmanager keeper.
 func (k *Keeper) SetPoolManagerKeeper(poolmanagerKeeper types.PoolManagerKeeper) {
 	k.poolmanagerKeeper = poolmanagerKeeper
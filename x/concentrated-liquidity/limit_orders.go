@@ -0,0 +1,378 @@
+package concentrated_liquidity
+
+import (
+	"errors"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	db "github.com/tendermint/tm-db"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
+	types "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+// openLimitOrderRef references an open limit order from the open limit order index.
+type openLimitOrderRef struct {
+	owner      sdk.AccAddress
+	positionId uint64
+}
+
+// placeLimitOrder places a limit order in the given pool by creating a single-sided position that spans a single tick spacing
+// starting at tickIndex. The position must be entirely on one side of the current tick so that it only holds tokenIn:
+// - orders depositing token0 must be above the current tick. They are filled once the current tick reaches their upper tick.
+// - orders depositing token1 must be below the current tick. They are filled once the current tick moves below their lower tick.
+// Limit orders are not frozen so that they can be cancelled at any time prior to being filled by withdrawing the underlying position.
+// On success, returns the id of the underlying position, the actual amount of tokenIn deposited and the liquidity created.
+// Returns error if:
+// - the pool provided does not exist or has no initial position
+// - tokenIn is not one of the pool's assets
+// - the tick range is invalid or not entirely on one side of the current tick as described above
+// - the position fails to be created
+func (k Keeper) placeLimitOrder(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, tickIndex int64, tokenIn sdk.Coin) (uint64, sdk.Coin, sdk.Dec, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return 0, sdk.Coin{}, sdk.Dec{}, err
+	}
+
+	// A limit order is priced relative to the current tick so the pool must have its price set.
+	if k.isInitialPositionForPool(pool.GetCurrentSqrtPrice(), pool.GetCurrentTick()) {
+		return 0, sdk.Coin{}, sdk.Dec{}, types.PoolNotInitializedError{PoolId: poolId}
+	}
+
+	isTokenInZero := tokenIn.Denom == pool.GetToken0()
+	if !isTokenInZero && tokenIn.Denom != pool.GetToken1() {
+		return 0, sdk.Coin{}, sdk.Dec{}, types.TokenInDenomNotInPoolError{TokenInDenom: tokenIn.Denom}
+	}
+
+	lowerTick := tickIndex
+	upperTick := tickIndex + int64(pool.GetTickSpacing())
+	currentTick := pool.GetCurrentTick().Int64()
+
+	// Note that the active range of a position is [lowerTick, upperTick).
+	if (isTokenInZero && currentTick >= lowerTick) || (!isTokenInZero && currentTick < upperTick) {
+		return 0, sdk.Coin{}, sdk.Dec{}, types.LimitOrderInRangeError{LowerTick: lowerTick, UpperTick: upperTick, CurrentTick: currentTick, TokenIn: tokenIn.Denom}
+	}
+
+	amount0Desired, amount1Desired := sdk.ZeroInt(), sdk.ZeroInt()
+	tokenOutDenom := pool.GetToken0()
+	if isTokenInZero {
+		amount0Desired = tokenIn.Amount
+		tokenOutDenom = pool.GetToken1()
+	} else {
+		amount1Desired = tokenIn.Amount
+	}
+
+	positionId, actualAmount0, actualAmount1, liquidityCreated, joinTime, err := k.CreatePosition(ctx, poolId, owner, amount0Desired, amount1Desired, sdk.ZeroInt(), sdk.ZeroInt(), lowerTick, upperTick, 0)
+	if err != nil {
+		return 0, sdk.Coin{}, sdk.Dec{}, err
+	}
+
+	actualTokenIn := sdk.NewCoin(tokenIn.Denom, actualAmount1)
+	if isTokenInZero {
+		actualTokenIn = sdk.NewCoin(tokenIn.Denom, actualAmount0)
+	}
+
+	limitOrder := model.LimitOrder{
+		PositionId: positionId,
+		PoolId:     poolId,
+		Owner:      owner.String(),
+		LowerTick:  lowerTick,
+		UpperTick:  upperTick,
+		JoinTime:   joinTime,
+		TokenIn:    actualTokenIn,
+		Status:     model.LimitOrderOpen,
+		TokenOut:   sdk.NewCoin(tokenOutDenom, sdk.ZeroInt()),
+	}
+	k.setLimitOrder(ctx, owner, limitOrder)
+	k.setOpenLimitOrderIndex(ctx, owner, limitOrder, isTokenInZero)
+
+	emitLimitOrderEvent(ctx, types.TypeEvtPlaceLimitOrder, owner, limitOrder, liquidityCreated)
+
+	return positionId, actualTokenIn, liquidityCreated, nil
+}
+
+// fillLimitOrders fills up to maxFills open limit orders in the given pool that the current tick has moved past.
+// It is called after every swap so that orders are filled as soon as the price crosses them. The orders that the
+// price moved past the furthest are filled first. Crossed orders beyond maxFills are left open, to be filled by
+// a subsequent swap or by their owner when claiming them, so that the gas of a swap does not grow with the number
+// of orders it crosses.
+// Filling an order withdraws the entire liquidity of the underlying position and deletes it. The withdrawn
+// tokens remain in the pool account until the order is claimed by its owner.
+func (k Keeper) fillLimitOrders(ctx sdk.Context, poolId uint64, maxFills int) error {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	currentTick := pool.GetCurrentTick().Int64()
+	store := ctx.KVStore(k.storeKey)
+
+	// Orders depositing token0 are filled once the current tick is at or above their upper tick.
+	// Orders depositing token1 are filled once the current tick is below their lower tick.
+	// The orders are gathered prior to filling them since filling mutates the index being iterated over.
+	ordersToFill := gatherOpenLimitOrderRefs(store.Iterator(
+		types.KeyOpenLimitOrdersByTokenIn(poolId, true),
+		types.KeyOpenLimitOrder(poolId, true, currentTick+1, 0)), maxFills)
+	ordersToFill = append(ordersToFill, gatherOpenLimitOrderRefs(store.ReverseIterator(
+		types.KeyOpenLimitOrder(poolId, false, currentTick+1, 0),
+		sdk.PrefixEndBytes(types.KeyOpenLimitOrdersByTokenIn(poolId, false))), maxFills-len(ordersToFill))...)
+
+	for _, orderRef := range ordersToFill {
+		if err := k.fillLimitOrder(ctx, pool, orderRef.owner, orderRef.positionId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fillLimitOrder fills the open limit order backed by the given position. The position's liquidity is removed
+// from the pool and the position is deleted. The order is marked as filled with the proceeds in the other token
+// left in the pool account for the owner to claim.
+func (k Keeper) fillLimitOrder(ctx sdk.Context, pool types.ConcentratedPoolExtension, owner sdk.AccAddress, positionId uint64) error {
+	ctx.GasMeter().ConsumeGas(types.LimitOrderFillGasFee, "cl limit order fill")
+
+	poolId := pool.GetId()
+	limitOrder, err := k.getLimitOrder(ctx, poolId, owner, positionId)
+	if err != nil {
+		return err
+	}
+
+	liquidity, err := k.GetPositionLiquidity(ctx, poolId, owner, limitOrder.LowerTick, limitOrder.UpperTick, limitOrder.JoinTime, 0, positionId)
+	if err != nil {
+		return err
+	}
+
+	actualAmount0, actualAmount1, err := k.updatePosition(ctx, poolId, owner, limitOrder.LowerTick, limitOrder.UpperTick, liquidity.Neg(), limitOrder.JoinTime, 0, positionId)
+	if err != nil {
+		return err
+	}
+
+	if err := k.deletePosition(ctx, poolId, owner, limitOrder.LowerTick, limitOrder.UpperTick, limitOrder.JoinTime, 0, positionId); err != nil {
+		return err
+	}
+
+	isTokenInZero := limitOrder.TokenIn.Denom == pool.GetToken0()
+	k.deleteOpenLimitOrderIndex(ctx, owner, limitOrder, isTokenInZero)
+
+	tokenOutAmount := actualAmount0.Abs()
+	if isTokenInZero {
+		tokenOutAmount = actualAmount1.Abs()
+	}
+
+	limitOrder.Status = model.LimitOrderFilled
+	limitOrder.TokenOut = sdk.NewCoin(limitOrder.TokenOut.Denom, tokenOutAmount)
+	k.setLimitOrder(ctx, owner, limitOrder)
+
+	emitLimitOrderEvent(ctx, types.TypeEvtFillLimitOrder, owner, limitOrder, liquidity.Neg())
+	return nil
+}
+
+// claimLimitOrder sends the proceeds of the filled limit order backed by the given position from the pool to its owner
+// and removes the order from state. An open order that the current tick has moved past but that was not filled by the
+// swap that crossed it, due to the limit on the fills per swap, is filled first. The fees accrued by the order while it was open are collected as well, unless the
+// owner has other positions in the same tick range. In that case, the fees remain claimable via MsgCollectFees.
+// Returns error if:
+// - the limit order does not exist for the owner
+// - the limit order is not filled yet
+// - other internal database or bank errors.
+func (k Keeper) claimLimitOrder(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, positionId uint64) (sdk.Coin, sdk.Coins, error) {
+	limitOrder, err := k.getLimitOrder(ctx, poolId, owner, positionId)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coins{}, err
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coins{}, err
+	}
+
+	if limitOrder.Status == model.LimitOrderOpen && isLimitOrderCrossed(limitOrder, limitOrder.TokenIn.Denom == pool.GetToken0(), pool.GetCurrentTick().Int64()) {
+		if err := k.fillLimitOrder(ctx, pool, owner, positionId); err != nil {
+			return sdk.Coin{}, sdk.Coins{}, err
+		}
+		limitOrder, err = k.getLimitOrder(ctx, poolId, owner, positionId)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coins{}, err
+		}
+	}
+
+	if limitOrder.Status != model.LimitOrderFilled {
+		return sdk.Coin{}, sdk.Coins{}, types.LimitOrderNotFilledError{PositionId: positionId}
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), owner, sdk.NewCoins(limitOrder.TokenOut)); err != nil {
+		return sdk.Coin{}, sdk.Coins{}, err
+	}
+
+	collectedFees := sdk.Coins{}
+	otherPositions, err := k.getAllPositionsWithVaryingFreezeTimes(ctx, poolId, owner, limitOrder.LowerTick, limitOrder.UpperTick)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coins{}, err
+	}
+
+	if len(otherPositions) == 0 {
+		feeAccumulator, err := k.getFeeAccumulator(ctx, poolId)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coins{}, err
+		}
+
+		hasFeeRecord, err := feeAccumulator.HasPosition(formatFeePositionAccumulatorKey(poolId, owner, limitOrder.LowerTick, limitOrder.UpperTick))
+		if err != nil {
+			return sdk.Coin{}, sdk.Coins{}, err
+		}
+
+		if hasFeeRecord {
+			collectedFees, err = k.collectFees(ctx, poolId, owner, limitOrder.LowerTick, limitOrder.UpperTick)
+			if err != nil {
+				return sdk.Coin{}, sdk.Coins{}, err
+			}
+		}
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.KeyLimitOrder(poolId, owner, positionId))
+
+	emitLimitOrderEvent(ctx, types.TypeEvtClaimLimitOrder, owner, limitOrder, sdk.ZeroDec())
+
+	return limitOrder.TokenOut, collectedFees, nil
+}
+
+// removeOpenLimitOrder removes the open limit order backed by the given position, if any.
+// It is called when the underlying position is fully withdrawn, effectively cancelling the order.
+func (k Keeper) removeOpenLimitOrder(ctx sdk.Context, pool types.ConcentratedPoolExtension, owner sdk.AccAddress, positionId uint64) error {
+	limitOrder, found, err := k.getLimitOrderIfExists(ctx, pool.GetId(), owner, positionId)
+	if err != nil || !found || limitOrder.Status != model.LimitOrderOpen {
+		return err
+	}
+
+	k.deleteOpenLimitOrderIndex(ctx, owner, limitOrder, limitOrder.TokenIn.Denom == pool.GetToken0())
+	ctx.KVStore(k.storeKey).Delete(types.KeyLimitOrder(pool.GetId(), owner, positionId))
+	return nil
+}
+
+// transferOpenLimitOrder moves the open limit order backed by the given position, if any, from owner to newOwner.
+func (k Keeper) transferOpenLimitOrder(ctx sdk.Context, poolId uint64, owner, newOwner sdk.AccAddress, positionId uint64) error {
+	limitOrder, found, err := k.getLimitOrderIfExists(ctx, poolId, owner, positionId)
+	if err != nil || !found || limitOrder.Status != model.LimitOrderOpen {
+		return err
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	isTokenInZero := limitOrder.TokenIn.Denom == pool.GetToken0()
+
+	k.deleteOpenLimitOrderIndex(ctx, owner, limitOrder, isTokenInZero)
+	ctx.KVStore(k.storeKey).Delete(types.KeyLimitOrder(poolId, owner, positionId))
+
+	limitOrder.Owner = newOwner.String()
+	k.setLimitOrder(ctx, newOwner, limitOrder)
+	k.setOpenLimitOrderIndex(ctx, newOwner, limitOrder, isTokenInZero)
+	return nil
+}
+
+// GetLimitOrdersForOwner returns all limit orders placed by owner in the given pool, both open and filled.
+func (k Keeper) GetLimitOrdersForOwner(ctx sdk.Context, poolId uint64, owner sdk.AccAddress) ([]model.LimitOrder, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPoolOwnerLimitOrders(poolId, owner), ParseLimitOrderFromBz)
+}
+
+// getAllLimitOrders returns all limit orders for export genesis.
+func (k Keeper) getAllLimitOrders(ctx sdk.Context) ([]model.LimitOrder, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.LimitOrderPrefix, ParseLimitOrderFromBz)
+}
+
+// getLimitOrder returns the limit order backed by the given position.
+// Returns error if the limit order does not exist.
+func (k Keeper) getLimitOrder(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, positionId uint64) (model.LimitOrder, error) {
+	limitOrder, found, err := k.getLimitOrderIfExists(ctx, poolId, owner, positionId)
+	if err != nil {
+		return model.LimitOrder{}, err
+	}
+	if !found {
+		return model.LimitOrder{}, types.LimitOrderNotFoundError{PoolId: poolId, PositionId: positionId, Owner: owner.String()}
+	}
+	return limitOrder, nil
+}
+
+func (k Keeper) getLimitOrderIfExists(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, positionId uint64) (model.LimitOrder, bool, error) {
+	limitOrder := model.LimitOrder{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyLimitOrder(poolId, owner, positionId), &limitOrder)
+	return limitOrder, found, err
+}
+
+func (k Keeper) setLimitOrder(ctx sdk.Context, owner sdk.AccAddress, limitOrder model.LimitOrder) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyLimitOrder(limitOrder.PoolId, owner, limitOrder.PositionId), &limitOrder)
+}
+
+// setOpenLimitOrderIndex indexes the given open limit order by the tick at which it gets filled.
+func (k Keeper) setOpenLimitOrderIndex(ctx sdk.Context, owner sdk.AccAddress, limitOrder model.LimitOrder, isTokenInZero bool) {
+	key := types.KeyOpenLimitOrder(limitOrder.PoolId, isTokenInZero, limitOrderFillTick(limitOrder, isTokenInZero), limitOrder.PositionId)
+	ctx.KVStore(k.storeKey).Set(key, owner.Bytes())
+}
+
+func (k Keeper) deleteOpenLimitOrderIndex(ctx sdk.Context, owner sdk.AccAddress, limitOrder model.LimitOrder, isTokenInZero bool) {
+	key := types.KeyOpenLimitOrder(limitOrder.PoolId, isTokenInZero, limitOrderFillTick(limitOrder, isTokenInZero), limitOrder.PositionId)
+	ctx.KVStore(k.storeKey).Delete(key)
+}
+
+// limitOrderFillTick returns the tick that the open limit order is indexed by.
+// Orders depositing token0 are indexed by their upper tick, and orders depositing token1 by their lower tick.
+func limitOrderFillTick(limitOrder model.LimitOrder, isTokenInZero bool) int64 {
+	if isTokenInZero {
+		return limitOrder.UpperTick
+	}
+	return limitOrder.LowerTick
+}
+
+// isLimitOrderCrossed returns true if the current tick has moved past the given open limit order.
+func isLimitOrderCrossed(limitOrder model.LimitOrder, isTokenInZero bool, currentTick int64) bool {
+	if isTokenInZero {
+		return currentTick >= limitOrder.UpperTick
+	}
+	return currentTick < limitOrder.LowerTick
+}
+
+// ParseLimitOrderFromBz parses and returns a limit order from a byte array.
+// Returns an error if the byte array is empty.
+// Returns an error if fails to parse.
+func ParseLimitOrderFromBz(bz []byte) (limitOrder model.LimitOrder, err error) {
+	if len(bz) == 0 {
+		return model.LimitOrder{}, errors.New("limit order not found")
+	}
+	err = proto.Unmarshal(bz, &limitOrder)
+	return limitOrder, err
+}
+
+// gatherOpenLimitOrderRefs returns references to at most limit open limit orders from the given open limit order index iterator.
+// The position id is the suffix of each key, and the value is the owner of the order.
+func gatherOpenLimitOrderRefs(iterator db.Iterator, limit int) []openLimitOrderRef {
+	defer iterator.Close()
+
+	orderRefs := []openLimitOrderRef{}
+	for ; iterator.Valid() && len(orderRefs) < limit; iterator.Next() {
+		key := iterator.Key()
+		orderRefs = append(orderRefs, openLimitOrderRef{
+			owner:      sdk.AccAddress(iterator.Value()),
+			positionId: sdk.BigEndianToUint64(key[len(key)-uint64Bytes:]),
+		})
+	}
+	return orderRefs
+}
+
+func emitLimitOrderEvent(ctx sdk.Context, eventType string, owner sdk.AccAddress, limitOrder model.LimitOrder, liquidityDelta sdk.Dec) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(limitOrder.PositionId, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(limitOrder.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(limitOrder.LowerTick, 10)),
+		sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(limitOrder.UpperTick, 10)),
+		sdk.NewAttribute(types.AttributeLiquidity, liquidityDelta.String()),
+		sdk.NewAttribute(types.AttributeKeyTokensIn, limitOrder.TokenIn.String()),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, limitOrder.TokenOut.String()),
+	))
+}
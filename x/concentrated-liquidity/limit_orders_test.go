@@ -0,0 +1,264 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+var (
+	// token0 limit orders are placed above the default current tick.
+	defaultToken0LimitOrderTick = DefaultCurrTick.Int64() + 100
+	// token1 limit orders are placed below the default current tick.
+	defaultToken1LimitOrderTick = DefaultCurrTick.Int64() - 100
+	defaultToken0LimitOrderCoin = sdk.NewCoin(ETH, sdk.NewInt(1000))
+	defaultToken1LimitOrderCoin = sdk.NewCoin(USDC, sdk.NewInt(5000000))
+)
+
+func (s *KeeperTestSuite) TestPlaceLimitOrder() {
+	tests := map[string]struct {
+		skipInitialPosition bool
+		tickIndex           int64
+		tokenIn             sdk.Coin
+		expectedErr         error
+	}{
+		"token0 order above the current tick": {
+			tickIndex: defaultToken0LimitOrderTick,
+			tokenIn:   defaultToken0LimitOrderCoin,
+		},
+		"token1 order below the current tick": {
+			tickIndex: defaultToken1LimitOrderTick,
+			tokenIn:   defaultToken1LimitOrderCoin,
+		},
+		"error: token0 order at the current tick": {
+			tickIndex:   DefaultCurrTick.Int64(),
+			tokenIn:     defaultToken0LimitOrderCoin,
+			expectedErr: types.LimitOrderInRangeError{LowerTick: DefaultCurrTick.Int64(), UpperTick: DefaultCurrTick.Int64() + 1, CurrentTick: DefaultCurrTick.Int64(), TokenIn: ETH},
+		},
+		"error: token1 order with upper tick above the current tick": {
+			tickIndex:   DefaultCurrTick.Int64(),
+			tokenIn:     defaultToken1LimitOrderCoin,
+			expectedErr: types.LimitOrderInRangeError{LowerTick: DefaultCurrTick.Int64(), UpperTick: DefaultCurrTick.Int64() + 1, CurrentTick: DefaultCurrTick.Int64(), TokenIn: USDC},
+		},
+		"error: token in denom not in pool": {
+			tickIndex:   defaultToken0LimitOrderTick,
+			tokenIn:     sdk.NewCoin("foo", sdk.NewInt(1000)),
+			expectedErr: types.TokenInDenomNotInPoolError{TokenInDenom: "foo"},
+		},
+		"error: pool has no initial position": {
+			skipInitialPosition: true,
+			tickIndex:           defaultToken0LimitOrderTick,
+			tokenIn:             defaultToken0LimitOrderCoin,
+			expectedErr:         types.PoolNotInitializedError{PoolId: 1},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			owner := s.TestAccs[1]
+
+			pool := s.PrepareConcentratedPool()
+			if !tc.skipInitialPosition {
+				s.SetupDefaultPosition(pool.GetId())
+			}
+			s.FundAcc(owner, sdk.NewCoins(tc.tokenIn))
+
+			positionId, tokenIn, liquidity, err := clKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, tc.tickIndex, tc.tokenIn)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(liquidity.IsPositive())
+			s.Require().True(tokenIn.IsPositive())
+			s.Require().True(tokenIn.Amount.LTE(tc.tokenIn.Amount))
+			s.Require().Equal(tc.tokenIn.Denom, tokenIn.Denom)
+
+			// The order is backed by a regular position that is not frozen.
+			positionLiquidity, err := clKeeper.GetPositionLiquidity(s.Ctx, pool.GetId(), owner, tc.tickIndex, tc.tickIndex+int64(pool.GetTickSpacing()), s.Ctx.BlockTime(), 0, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(liquidity, positionLiquidity)
+
+			limitOrders, err := clKeeper.GetLimitOrdersForOwner(s.Ctx, pool.GetId(), owner)
+			s.Require().NoError(err)
+			s.Require().Len(limitOrders, 1)
+			s.Require().Equal(positionId, limitOrders[0].PositionId)
+			s.Require().Equal(model.LimitOrderOpen, limitOrders[0].Status)
+			s.Require().Equal(tokenIn, limitOrders[0].TokenIn)
+			s.Require().True(limitOrders[0].TokenOut.IsZero())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestFillAndClaimLimitOrder() {
+	tests := map[string]struct {
+		tickIndex   int64
+		tokenIn     sdk.Coin
+		swapTokenIn sdk.Coin
+	}{
+		"token0 order filled by swapping token1 in": {
+			tickIndex:   defaultToken0LimitOrderTick,
+			tokenIn:     defaultToken0LimitOrderCoin,
+			swapTokenIn: sdk.NewCoin(USDC, sdk.NewInt(200000000)),
+		},
+		"token1 order filled by swapping token0 in": {
+			tickIndex:   defaultToken1LimitOrderTick,
+			tokenIn:     defaultToken1LimitOrderCoin,
+			swapTokenIn: sdk.NewCoin(ETH, sdk.NewInt(100000)),
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			owner, swapper := s.TestAccs[1], s.TestAccs[2]
+
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+			s.FundAcc(owner, sdk.NewCoins(tc.tokenIn))
+			s.FundAcc(swapper, sdk.NewCoins(tc.swapTokenIn))
+
+			positionId, _, _, err := clKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, tc.tickIndex, tc.tokenIn)
+			s.Require().NoError(err)
+
+			// The order cannot be claimed while open.
+			_, _, err = clKeeper.ClaimLimitOrder(s.Ctx, pool.GetId(), owner, positionId)
+			s.Require().ErrorIs(err, types.LimitOrderNotFilledError{PositionId: positionId})
+
+			tokenOutDenom := pool.GetToken0()
+			if tc.swapTokenIn.Denom == pool.GetToken0() {
+				tokenOutDenom = pool.GetToken1()
+			}
+
+			pool, err = clKeeper.GetPoolFromPoolIdAndConvertToConcentrated(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			_, err = clKeeper.SwapExactAmountIn(s.Ctx, swapper, pool, tc.swapTokenIn, tokenOutDenom, sdk.OneInt(), DefaultZeroSwapFee)
+			s.Require().NoError(err)
+
+			// The swap moved the current tick past the order, filling it.
+			limitOrders, err := clKeeper.GetLimitOrdersForOwner(s.Ctx, pool.GetId(), owner)
+			s.Require().NoError(err)
+			s.Require().Len(limitOrders, 1)
+			filledOrder := limitOrders[0]
+			s.Require().Equal(model.LimitOrderFilled, filledOrder.Status)
+			s.Require().Equal(tc.swapTokenIn.Denom, filledOrder.TokenOut.Denom)
+			s.Require().True(filledOrder.TokenOut.IsPositive())
+
+			// The underlying position is removed once filled.
+			positions, err := clKeeper.GetUserPositions(s.Ctx, owner, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Empty(positions)
+
+			balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			tokenOut, _, err := clKeeper.ClaimLimitOrder(s.Ctx, pool.GetId(), owner, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(filledOrder.TokenOut, tokenOut)
+
+			balanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			s.Require().Equal(tokenOut.Amount, balanceAfter.AmountOf(tokenOut.Denom).Sub(balanceBefore.AmountOf(tokenOut.Denom)))
+
+			// The order is removed once claimed.
+			limitOrders, err = clKeeper.GetLimitOrdersForOwner(s.Ctx, pool.GetId(), owner)
+			s.Require().NoError(err)
+			s.Require().Empty(limitOrders)
+
+			_, _, err = clKeeper.ClaimLimitOrder(s.Ctx, pool.GetId(), owner, positionId)
+			s.Require().ErrorIs(err, types.LimitOrderNotFoundError{PoolId: pool.GetId(), PositionId: positionId, Owner: owner.String()})
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelLimitOrder() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	owner, swapper := s.TestAccs[1], s.TestAccs[2]
+	swapTokenIn := sdk.NewCoin(USDC, sdk.NewInt(200000000))
+
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	s.FundAcc(owner, sdk.NewCoins(defaultToken0LimitOrderCoin))
+	s.FundAcc(swapper, sdk.NewCoins(swapTokenIn))
+
+	positionId, _, liquidity, err := clKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, defaultToken0LimitOrderTick, defaultToken0LimitOrderCoin)
+	s.Require().NoError(err)
+
+	// Fully withdrawing the underlying position cancels the order.
+	upperTick := defaultToken0LimitOrderTick + int64(pool.GetTickSpacing())
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, pool.GetId(), owner, defaultToken0LimitOrderTick, upperTick, s.Ctx.BlockTime(), 0, positionId, liquidity)
+	s.Require().NoError(err)
+
+	limitOrders, err := clKeeper.GetLimitOrdersForOwner(s.Ctx, pool.GetId(), owner)
+	s.Require().NoError(err)
+	s.Require().Empty(limitOrders)
+
+	// Swapping past the cancelled order does not attempt to fill it.
+	pool, err = clKeeper.GetPoolFromPoolIdAndConvertToConcentrated(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	_, err = clKeeper.SwapExactAmountIn(s.Ctx, swapper, pool, swapTokenIn, ETH, sdk.OneInt(), DefaultZeroSwapFee)
+	s.Require().NoError(err)
+
+	limitOrders, err = clKeeper.GetLimitOrdersForOwner(s.Ctx, pool.GetId(), owner)
+	s.Require().NoError(err)
+	s.Require().Empty(limitOrders)
+}
+
+// TestFillLimitOrders_MaxFillsPerSwap tests that a swap fills at most MaxLimitOrderFillsPerSwap orders,
+// consuming gas for every fill, and that a crossed order left open is filled when claimed.
+func (s *KeeperTestSuite) TestFillLimitOrders_MaxFillsPerSwap() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	owner, swapper := s.TestAccs[1], s.TestAccs[2]
+	limitOrderCoin := sdk.NewCoin(ETH, sdk.NewInt(100))
+	swapTokenIn := sdk.NewCoin(USDC, sdk.NewInt(400000000))
+
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	s.FundAcc(swapper, sdk.NewCoins(swapTokenIn))
+
+	positionIds := []uint64{}
+	for i := 0; i < types.MaxLimitOrderFillsPerSwap+1; i++ {
+		s.FundAcc(owner, sdk.NewCoins(limitOrderCoin))
+		positionId, _, _, err := clKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, defaultToken0LimitOrderTick, limitOrderCoin)
+		s.Require().NoError(err)
+		positionIds = append(positionIds, positionId)
+	}
+
+	pool, err := clKeeper.GetPoolFromPoolIdAndConvertToConcentrated(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	gasBefore := s.Ctx.GasMeter().GasConsumed()
+	_, err = clKeeper.SwapExactAmountIn(s.Ctx, swapper, pool, swapTokenIn, ETH, sdk.OneInt(), DefaultZeroSwapFee)
+	s.Require().NoError(err)
+	s.Require().GreaterOrEqual(s.Ctx.GasMeter().GasConsumed()-gasBefore, uint64(types.MaxLimitOrderFillsPerSwap*types.LimitOrderFillGasFee))
+
+	// Orders at the same tick are filled in the order they were placed, leaving the last one open.
+	limitOrders, err := clKeeper.GetLimitOrdersForOwner(s.Ctx, pool.GetId(), owner)
+	s.Require().NoError(err)
+	s.Require().Len(limitOrders, types.MaxLimitOrderFillsPerSwap+1)
+	lastPositionId := positionIds[len(positionIds)-1]
+	for _, limitOrder := range limitOrders {
+		if limitOrder.PositionId == lastPositionId {
+			s.Require().Equal(model.LimitOrderOpen, limitOrder.Status)
+		} else {
+			s.Require().Equal(model.LimitOrderFilled, limitOrder.Status)
+		}
+	}
+
+	// Claiming the crossed order that was left open fills it first.
+	gasBefore = s.Ctx.GasMeter().GasConsumed()
+	tokenOut, _, err := clKeeper.ClaimLimitOrder(s.Ctx, pool.GetId(), owner, lastPositionId)
+	s.Require().NoError(err)
+	s.Require().GreaterOrEqual(s.Ctx.GasMeter().GasConsumed()-gasBefore, uint64(types.LimitOrderFillGasFee))
+	s.Require().Equal(USDC, tokenOut.Denom)
+	s.Require().True(tokenOut.IsPositive())
+
+	positions, err := clKeeper.GetUserPositions(s.Ctx, owner, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Empty(positions)
+}
@@ -10,9 +10,10 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/internal/math"
 	types "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
 
-// createPosition creates a concentrated liquidity position in range between lowerTick and upperTick
+// CreatePosition creates a concentrated liquidity position in range between lowerTick and upperTick
 // in a given `PoolId with the desired amount of each token. Since LPs are only allowed to provide
 // liquidity proportional to the existing reserves, the actual amount of tokens used might differ from requested.
 // As a result, LPs may also provide the minimum amount of each token to be used so that the system fails
@@ -21,10 +22,11 @@ import (
 // Returns error if:
 // - the provided ticks are out of range / invalid
 // - the pool provided does not exist
+// - joins are paused on the pool
 // - the liquidity delta is zero
 // - the amount0 or amount1 returned from the position update is less than the given minimums
 // - the pool or user does not have enough tokens to satisfy the requested amount
-func (k Keeper) createPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, amount0Desired, amount1Desired, amount0Min, amount1Min sdk.Int, lowerTick, upperTick int64, freezeDuration time.Duration) (uint64, sdk.Int, sdk.Int, sdk.Dec, time.Time, error) {
+func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, amount0Desired, amount1Desired, amount0Min, amount1Min sdk.Int, lowerTick, upperTick int64, freezeDuration time.Duration) (uint64, sdk.Int, sdk.Int, sdk.Dec, time.Time, error) {
 	// get current blockTime that user joins the position
 	joinTime := ctx.BlockTime()
 
@@ -33,6 +35,9 @@ func (k Keeper) createPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	if err != nil {
 		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, err
 	}
+	if k.poolmanagerKeeper.IsPoolPaused(ctx, poolId) {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, poolmanagertypes.PoolPausedError{PoolId: poolId}
+	}
 	// Check if the provided tick range is valid according to the pool's tick spacing and module parameters.
 	if err := validateTickRangeIsValid(pool.GetTickSpacing(), pool.GetPrecisionFactorAtPriceOne(), lowerTick, upperTick); err != nil {
 		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, err
@@ -104,6 +109,7 @@ func (k Keeper) createPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	writeCacheCtx()
 
 	emitLiquidityChangeEvent(ctx, types.TypeEvtCreatePosition, positionId, owner, poolId, lowerTick, upperTick, joinTime, freezeDuration, liquidityDelta, actualAmount0, actualAmount1)
+	k.poolListeners.AfterLiquidityAdded(ctx, owner, poolId, sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), actualAmount0), sdk.NewCoin(pool.GetToken1(), actualAmount1)))
 
 	return positionId, actualAmount0, actualAmount1, liquidityDelta, joinTime, nil
 }
@@ -113,7 +119,7 @@ func (k Keeper) createPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 // Returns error if
 // - there is no position in the given tick ranges
 // - if tick ranges are invalid
-// - if attempts to withdraw an amount higher than originally provided in createPosition for a given range.
+// - if attempts to withdraw an amount higher than originally provided in CreatePosition for a given range.
 func (k Keeper) withdrawPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, joinTime time.Time, freezeDuration time.Duration, positionId uint64, requestedLiquidityAmountToWithdraw sdk.Dec) (amtDenom0, amtDenom1 sdk.Int, err error) {
 	// Retrieve the pool associated with the given pool ID.
 	pool, err := k.getPoolById(ctx, poolId)
@@ -184,13 +190,107 @@ func (k Keeper) withdrawPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAd
 		if err := k.deletePosition(ctx, poolId, owner, lowerTick, upperTick, joinTime, freezeDuration, positionId); err != nil {
 			return sdk.Int{}, sdk.Int{}, err
 		}
+
+		// Fully withdrawing the position backing an open limit order cancels the order.
+		if err := k.removeOpenLimitOrder(ctx, pool, owner, positionId); err != nil {
+			return sdk.Int{}, sdk.Int{}, err
+		}
 	}
 
 	emitLiquidityChangeEvent(ctx, types.TypeEvtWithdrawPosition, positionId, owner, poolId, lowerTick, upperTick, joinTime, freezeDuration, liquidityDelta, actualAmount0, actualAmount1)
+	k.poolListeners.AfterLiquidityRemoved(ctx, owner, poolId, sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), actualAmount0.Abs()), sdk.NewCoin(pool.GetToken1(), actualAmount1.Abs())))
 
 	return actualAmount0.Neg(), actualAmount1.Neg(), nil
 }
 
+// withdrawPositionById withdraws liquidityAmount from the position with the given id owned by owner.
+// See withdrawPosition for details.
+// Returns error if the position does not exist or is owned by another account.
+func (k Keeper) withdrawPositionById(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, requestedLiquidityAmountToWithdraw sdk.Dec) (amtDenom0, amtDenom1 sdk.Int, err error) {
+	position, err := k.getPositionForOwner(ctx, positionId, owner)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	return k.withdrawPosition(ctx, position.PoolId, owner, position.LowerTick, position.UpperTick, position.JoinTime, position.FreezeDuration, positionId, requestedLiquidityAmountToWithdraw)
+}
+
+// addToPosition adds liquidity to an existing position given by pool id, owner, tick range, join time, freeze duration and position id.
+// Similarly to CreatePosition, LPs are only allowed to provide liquidity proportional to the existing reserves, so the actual amount
+// of tokens used might differ from requested. The position keeps its id and join time. Any fees and incentives accrued by the position
+// prior to this update remain claimable since the fee and uptime accumulator records are updated rather than re-initialized.
+// On success, returns an actual amount of each token used and liquidity added.
+// Returns error if:
+// - the provided ticks are out of range / invalid
+// - the pool provided does not exist
+// - joins are paused on the pool
+// - there is no position matching the given parameters
+// - the liquidity delta is zero
+// - the amount0 or amount1 returned from the position update is less than the given minimums
+// - the user does not have enough tokens to satisfy the requested amount
+func (k Keeper) addToPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, joinTime time.Time, freezeDuration time.Duration, positionId uint64, amount0Desired, amount1Desired, amount0Min, amount1Min sdk.Int) (sdk.Int, sdk.Int, sdk.Dec, error) {
+	// Retrieve the pool associated with the given pool ID.
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+	if k.poolmanagerKeeper.IsPoolPaused(ctx, poolId) {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, poolmanagertypes.PoolPausedError{PoolId: poolId}
+	}
+
+	// Check if the provided tick range is valid according to the pool's tick spacing and module parameters.
+	if err := validateTickRangeIsValid(pool.GetTickSpacing(), pool.GetPrecisionFactorAtPriceOne(), lowerTick, upperTick); err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	// Ensure that the position exists and belongs to the owner.
+	if _, err := k.GetPositionLiquidity(ctx, poolId, owner, lowerTick, upperTick, joinTime, freezeDuration, positionId); err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	// Transform the provided ticks into their corresponding sqrtPrices.
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(lowerTick, upperTick, pool.GetPrecisionFactorAtPriceOne())
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	// Calculate the amount of liquidity that will be added to the position.
+	liquidityDelta := math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, amount0Desired, amount1Desired)
+	if liquidityDelta.IsZero() {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, errors.New("liquidityDelta calculated equals zero")
+	}
+
+	// We only persist the changes if the actual amounts are greater than or equal to the given minimum amounts.
+	cacheCtx, writeCacheCtx := ctx.CacheContext()
+
+	// Update the existing position. This settles the position's fee and uptime accumulator records
+	// with the liquidity delta while preserving the position's join time and id.
+	actualAmount0, actualAmount1, err := k.updatePosition(cacheCtx, poolId, owner, lowerTick, upperTick, liquidityDelta, joinTime, freezeDuration, positionId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	if actualAmount0.LT(amount0Min) {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, types.InsufficientLiquidityCreatedError{Actual: actualAmount0, Minimum: amount0Min, IsTokenZero: true}
+	}
+	if actualAmount1.LT(amount1Min) {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, types.InsufficientLiquidityCreatedError{Actual: actualAmount1, Minimum: amount1Min}
+	}
+
+	// Transfer the actual amounts of tokens 0 and 1 from the position owner to the pool.
+	err = k.sendCoinsBetweenPoolAndUser(cacheCtx, pool.GetToken0(), pool.GetToken1(), actualAmount0, actualAmount1, owner, pool.GetAddress())
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	writeCacheCtx()
+
+	emitLiquidityChangeEvent(ctx, types.TypeEvtAddToPosition, positionId, owner, poolId, lowerTick, upperTick, joinTime, freezeDuration, liquidityDelta, actualAmount0, actualAmount1)
+	k.poolListeners.AfterLiquidityAdded(ctx, owner, poolId, sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), actualAmount0), sdk.NewCoin(pool.GetToken1(), actualAmount1)))
+
+	return actualAmount0, actualAmount1, liquidityDelta, nil
+}
+
 // updatePosition updates the position in the given pool id and in the given tick range and liquidityAmount.
 // Negative liquidityDelta implies withdrawing liquidity.
 // Positive liquidityDelta implies adding liquidity.
@@ -302,6 +402,9 @@ func (k Keeper) initializeInitialPositionForPool(ctx sdk.Context, pool types.Con
 	}
 
 	// Set the pool's current sqrt price and current tick to the above calculated values
+	// The tick accumulator is updated before the initial tick is set so that
+	// the time before the first position is accumulated at tick zero.
+	pool.UpdateTickAccumulator(ctx.BlockTime())
 	pool.SetCurrentSqrtPrice(initialSqrtPrice)
 	pool.SetCurrentTick(initialTick)
 	err = k.setPool(ctx, pool)
@@ -9,6 +9,7 @@ import (
 	cl "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity"
 	clmodel "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
 	types "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
 
 type lpTest struct {
@@ -545,6 +546,100 @@ func (s *KeeperTestSuite) TestWithdrawPosition() {
 	}
 }
 
+func (s *KeeperTestSuite) TestAddToPosition() {
+	defaultJoinTime := defaultBlockTime
+
+	tests := map[string]struct {
+		poolId        uint64
+		positionId    uint64
+		amount0Min    sdk.Int
+		amount1Min    sdk.Int
+		expectedError error
+	}{
+		"add to existing position": {
+			poolId:     1,
+			positionId: 1,
+		},
+		"error: position does not exist": {
+			poolId:        1,
+			positionId:    2,
+			expectedError: types.PositionNotFoundError{PoolId: 1, LowerTick: DefaultLowerTick, UpperTick: DefaultUpperTick, JoinTime: defaultJoinTime, FreezeDuration: DefaultFreezeDuration},
+		},
+		"error: pool id for pool that does not exist": {
+			poolId:        2,
+			positionId:    1,
+			expectedError: types.PoolNotFoundError{PoolId: 2},
+		},
+		"error: amount0 min is greater than actual amount": {
+			poolId:        1,
+			positionId:    1,
+			amount0Min:    DefaultAmt0Expected.Add(sdk.OneInt()),
+			expectedError: types.InsufficientLiquidityCreatedError{Actual: DefaultAmt0Expected, Minimum: DefaultAmt0Expected.Add(sdk.OneInt()), IsTokenZero: true},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(defaultJoinTime)
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			owner := s.TestAccs[0]
+
+			pool := s.PrepareConcentratedPool()
+			liquidityCreated := s.SetupPosition(pool.GetId(), owner, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, defaultJoinTime, DefaultFreezeDuration)
+
+			// Accrue one unit of eth fee growth per unit of liquidity prior to adding to the position.
+			err := clKeeper.ChargeFee(s.Ctx, pool.GetId(), sdk.NewDecCoin(ETH, sdk.OneInt()))
+			s.Require().NoError(err)
+
+			amount0Min, amount1Min := sdk.ZeroInt(), sdk.ZeroInt()
+			if !tc.amount0Min.IsNil() {
+				amount0Min = tc.amount0Min
+			}
+			if !tc.amount1Min.IsNil() {
+				amount1Min = tc.amount1Min
+			}
+
+			s.FundAcc(owner, sdk.NewCoins(DefaultCoin0, DefaultCoin1))
+			ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			// System under test.
+			amount0, amount1, liquidityAdded, err := clKeeper.AddToPosition(s.Ctx, tc.poolId, owner, DefaultLowerTick, DefaultUpperTick, defaultJoinTime, DefaultFreezeDuration, tc.positionId, DefaultAmt0, DefaultAmt1, amount0Min, amount1Min)
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().ErrorContains(err, tc.expectedError.Error())
+
+				// Ensure that no state was changed.
+				s.Require().Equal(ownerBalanceBefore.String(), s.App.BankKeeper.GetAllBalances(s.Ctx, owner).String())
+				s.validatePositionUpdate(s.Ctx, pool.GetId(), owner, DefaultLowerTick, DefaultUpperTick, defaultJoinTime, DefaultFreezeDuration, 1, liquidityCreated)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(DefaultAmt0Expected.String(), amount0.String())
+			s.Require().Equal(DefaultAmt1Expected.String(), amount1.String())
+			s.Require().Equal(liquidityCreated.String(), liquidityAdded.String())
+
+			// The position keeps its id and join time while its liquidity is increased.
+			s.validatePositionUpdate(s.Ctx, pool.GetId(), owner, DefaultLowerTick, DefaultUpperTick, defaultJoinTime, DefaultFreezeDuration, tc.positionId, liquidityCreated.Add(liquidityAdded))
+			s.Require().Equal(uint64(2), clKeeper.GetNextPositionId(s.Ctx))
+
+			// The tokens were transferred from the owner to the pool.
+			ownerBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			s.Require().Equal(sdk.NewCoins(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1)).String(), ownerBalanceBefore.Sub(ownerBalanceAfter).String())
+
+			// The fees accrued prior to adding to the position are still claimable.
+			claimableFees, err := clKeeper.QueryClaimableFees(s.Ctx, pool.GetId(), owner, DefaultLowerTick, DefaultUpperTick)
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewCoins(sdk.NewCoin(ETH, liquidityCreated.TruncateInt())).String(), claimableFees.String())
+
+			// Validate event emitted.
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtAddToPosition, 1)
+		})
+	}
+}
+
 // mergeConfigs merges every desired non-zero field from overwrite
 // into dst. dst is mutated due to being a pointer.
 func mergeConfigs(dst *lpTest, overwrite *lpTest) {
@@ -1068,3 +1163,28 @@ func (s *KeeperTestSuite) TestInverseRelation_CreatePosition_WithdrawPosition()
 		})
 	}
 }
+
+// TestPositionsOnPausedPool tests that creating and adding to positions fails on a pool paused by the
+// poolmanager, while withdrawing from positions succeeds.
+func (s *KeeperTestSuite) TestPositionsOnPausedPool() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	owner := s.TestAccs[0]
+	joinTime := s.Ctx.BlockTime()
+	liquidity := s.SetupPosition(pool.GetId(), owner, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, joinTime, 0)
+
+	err := s.App.PoolManagerKeeper.SetPoolsPaused(s.Ctx, []uint64{pool.GetId()}, nil, true)
+	s.Require().NoError(err)
+	pausedErr := poolmanagertypes.PoolPausedError{PoolId: pool.GetId()}
+
+	s.FundAcc(owner, sdk.NewCoins(DefaultCoin0, DefaultCoin1))
+	_, _, _, _, _, err = clKeeper.CreatePosition(s.Ctx, pool.GetId(), owner, DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), DefaultLowerTick, DefaultUpperTick, 0)
+	s.Require().ErrorIs(err, pausedErr)
+
+	_, _, _, err = clKeeper.AddToPosition(s.Ctx, pool.GetId(), owner, DefaultLowerTick, DefaultUpperTick, joinTime, 0, 1, DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt())
+	s.Require().ErrorIs(err, pausedErr)
+
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, pool.GetId(), owner, DefaultLowerTick, DefaultUpperTick, joinTime, 0, 1, liquidity)
+	s.Require().NoError(err)
+}
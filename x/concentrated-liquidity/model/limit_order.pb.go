@@ -0,0 +1,721 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentrated-liquidity/limit_order.proto
+
+// this is a legacy package that requires additional migration logic
+// in order to use the correct packge. Decision made to use legacy package path
+// until clear steps for migration logic and the unknowns for state breaking are
+// investigated for changing proto package.
+
+package model
+
+import (
+	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LimitOrderStatus is the status of a limit order.
+type LimitOrderStatus int32
+
+const (
+	// LimitOrderOpen is an order that is waiting for the price to move past it.
+	LimitOrderOpen LimitOrderStatus = 0
+	// LimitOrderFilled is an order that was converted to the other token of the
+	// pool and can be claimed by its owner.
+	LimitOrderFilled LimitOrderStatus = 1
+)
+
+var LimitOrderStatus_name = map[int32]string{
+	0: "LimitOrderOpen",
+	1: "LimitOrderFilled",
+}
+
+var LimitOrderStatus_value = map[string]int32{
+	"LimitOrderOpen":   0,
+	"LimitOrderFilled": 1,
+}
+
+func (x LimitOrderStatus) String() string {
+	return proto.EnumName(LimitOrderStatus_name, int32(x))
+}
+
+func (LimitOrderStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c3b1b18600aca6b9, []int{0}
+}
+
+// LimitOrder is a position spanning a single tick spacing that is placed out
+// of range. Once the pool's current tick moves past it, its liquidity is
+// automatically withdrawn, converting the deposited token into the other
+// token of the pool.
+type LimitOrder struct {
+	PositionId uint64    `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	PoolId     uint64    `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Owner      string    `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	LowerTick  int64     `protobuf:"varint,4,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick  int64     `protobuf:"varint,5,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	JoinTime   time.Time `protobuf:"bytes,6,opt,name=join_time,json=joinTime,proto3,stdtime" json:"join_time" yaml:"join_time"`
+	// token_in is the token deposited when placing the order.
+	TokenIn types1.Coin      `protobuf:"bytes,7,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	Status  LimitOrderStatus `protobuf:"varint,8,opt,name=status,proto3,enum=osmosis.concentratedliquidity.v1beta1.LimitOrderStatus" json:"status,omitempty" yaml:"status"`
+	// token_out is the token received once the order is filled. It is unset
+	// while the order is open.
+	TokenOut types1.Coin `protobuf:"bytes,9,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+}
+
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3b1b18600aca6b9, []int{0}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+func (m *LimitOrder) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetJoinTime() time.Time {
+	if m != nil {
+		return m.JoinTime
+	}
+	return time.Time{}
+}
+
+func (m *LimitOrder) GetTokenIn() types1.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types1.Coin{}
+}
+
+func (m *LimitOrder) GetStatus() LimitOrderStatus {
+	if m != nil {
+		return m.Status
+	}
+	return LimitOrderOpen
+}
+
+func (m *LimitOrder) GetTokenOut() types1.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types1.Coin{}
+}
+
+func init() {
+	proto.RegisterEnum("osmosis.concentratedliquidity.v1beta1.LimitOrderStatus", LimitOrderStatus_name, LimitOrderStatus_value)
+	proto.RegisterType((*LimitOrder)(nil), "osmosis.concentratedliquidity.v1beta1.LimitOrder")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/limit_order.proto", fileDescriptor_c3b1b18600aca6b9)
+}
+
+var fileDescriptor_c3b1b18600aca6b9 = []byte{
+	// 552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xdd, 0x6a, 0xd4, 0x40,
+	0x14, 0xc7, 0x13, 0xbb, 0xdd, 0x36, 0x53, 0xad, 0xdb, 0xa1, 0x6a, 0x5c, 0x24, 0x59, 0x02, 0xca,
+	0xa2, 0x74, 0x62, 0xab, 0x52, 0xf0, 0x32, 0x82, 0x50, 0x50, 0x56, 0x62, 0xbd, 0x11, 0x61, 0xc9,
+	0xc7, 0xb8, 0x8e, 0x9b, 0xe4, 0xc4, 0x64, 0xd2, 0xda, 0x37, 0xf0, 0xb2, 0xef, 0xe0, 0xcb, 0xf4,
+	0xb2, 0x97, 0x5e, 0x45, 0xd9, 0x7d, 0x83, 0x3c, 0x81, 0x64, 0x26, 0xd9, 0x5d, 0x0b, 0xa2, 0x77,
+	0xe7, 0xeb, 0xf7, 0x9f, 0xb3, 0xff, 0xb3, 0x41, 0x8f, 0x21, 0x8f, 0x21, 0x67, 0xb9, 0x1d, 0x40,
+	0x12, 0xd0, 0x84, 0x67, 0x1e, 0xa7, 0xe1, 0x5e, 0xc4, 0xbe, 0x14, 0x2c, 0x64, 0xfc, 0xcc, 0x8e,
+	0x58, 0xcc, 0xf8, 0x18, 0xb2, 0x90, 0x66, 0x24, 0xcd, 0x80, 0x03, 0xbe, 0xdf, 0x10, 0x64, 0x95,
+	0x58, 0x00, 0xe4, 0x64, 0xdf, 0xa7, 0xdc, 0xdb, 0xef, 0x1b, 0x81, 0x98, 0xb3, 0x7d, 0x2f, 0xa7,
+	0x76, 0x53, 0xb4, 0x03, 0x60, 0x89, 0x94, 0xe9, 0x9b, 0x13, 0x80, 0x49, 0x44, 0x6d, 0x91, 0xf9,
+	0xc5, 0x47, 0x9b, 0xb3, 0x98, 0xe6, 0xdc, 0x8b, 0xd3, 0x66, 0x60, 0x77, 0x02, 0x13, 0x10, 0xa1,
+	0x5d, 0x47, 0xb2, 0x6a, 0xcd, 0x3a, 0x08, 0xbd, 0xaa, 0x77, 0x1a, 0xd5, 0x2b, 0xe1, 0x43, 0xb4,
+	0x95, 0x42, 0xce, 0x38, 0x83, 0x64, 0xcc, 0x42, 0x5d, 0x1d, 0xa8, 0xc3, 0x8e, 0x73, 0xbb, 0x2a,
+	0x4d, 0x7c, 0xe6, 0xc5, 0xd1, 0x73, 0x6b, 0xa5, 0x69, 0xb9, 0xa8, 0xcd, 0x8e, 0x42, 0xfc, 0x08,
+	0x6d, 0xa4, 0x00, 0x51, 0x0d, 0x5d, 0x13, 0x10, 0xae, 0x4a, 0x73, 0xbb, 0x85, 0x44, 0xc3, 0x72,
+	0xbb, 0x75, 0x74, 0x14, 0xe2, 0x07, 0x68, 0x1d, 0x4e, 0x13, 0x9a, 0xe9, 0x6b, 0x03, 0x75, 0xa8,
+	0x39, 0xbd, 0xaa, 0x34, 0xaf, 0xcb, 0x51, 0x51, 0xb6, 0x5c, 0xd9, 0xc6, 0x4f, 0x11, 0x8a, 0xe0,
+	0x94, 0x66, 0x63, 0xce, 0x82, 0xa9, 0xde, 0x19, 0xa8, 0xc3, 0x35, 0xe7, 0x56, 0x55, 0x9a, 0x3b,
+	0x72, 0x78, 0xd9, 0xb3, 0x5c, 0x4d, 0x24, 0xc7, 0x2c, 0x98, 0xd6, 0x54, 0x91, 0xa6, 0x2d, 0xb5,
+	0x7e, 0x95, 0x5a, 0xf6, 0x2c, 0x57, 0x13, 0x89, 0xa0, 0xde, 0x21, 0xed, 0x33, 0xb0, 0x64, 0x5c,
+	0xdb, 0xa6, 0x77, 0x07, 0xea, 0x70, 0xeb, 0xa0, 0x4f, 0xa4, 0xa7, 0xa4, 0xf5, 0x94, 0x1c, 0xb7,
+	0x9e, 0x3a, 0xf7, 0x2e, 0x4a, 0x53, 0xa9, 0x4a, 0xb3, 0x27, 0x45, 0x17, 0xa8, 0x75, 0xfe, 0xd3,
+	0x54, 0xdd, 0xcd, 0x3a, 0xaf, 0x87, 0xf1, 0x6b, 0xb4, 0xc9, 0x61, 0x4a, 0x93, 0x31, 0x4b, 0xf4,
+	0x0d, 0xa1, 0x7a, 0x97, 0xc8, 0x4b, 0x92, 0xfa, 0x92, 0xed, 0x79, 0xc9, 0x0b, 0x60, 0x89, 0x73,
+	0xa7, 0x11, 0xbd, 0x29, 0x45, 0x5b, 0xd0, 0x72, 0x37, 0x44, 0x78, 0x94, 0x60, 0x1f, 0x75, 0x73,
+	0xee, 0xf1, 0x22, 0xd7, 0x37, 0x07, 0xea, 0x70, 0xfb, 0xe0, 0x90, 0xfc, 0xd7, 0xbf, 0x87, 0x2c,
+	0x4f, 0xfc, 0x56, 0xe0, 0xce, 0x4e, 0x55, 0x9a, 0x37, 0xe4, 0x33, 0x52, 0xd0, 0x72, 0x1b, 0x65,
+	0xfc, 0x06, 0x69, 0xf2, 0x65, 0x28, 0xb8, 0xae, 0xfd, 0x6b, 0x67, 0xfd, 0x4f, 0x23, 0x16, 0xa4,
+	0xe5, 0xca, 0x1f, 0x3e, 0x2a, 0xf8, 0x43, 0x07, 0xf5, 0xae, 0x2e, 0x80, 0x31, 0xda, 0x5e, 0xd6,
+	0x46, 0x29, 0x4d, 0x7a, 0x0a, 0xde, 0x5d, 0x9d, 0x7b, 0xc9, 0xa2, 0x88, 0x86, 0x3d, 0xb5, 0xdf,
+	0xf9, 0xf6, 0xdd, 0x50, 0x9c, 0x0f, 0x17, 0x33, 0x43, 0xbd, 0x9c, 0x19, 0xea, 0xaf, 0x99, 0xa1,
+	0x9e, 0xcf, 0x0d, 0xe5, 0x72, 0x6e, 0x28, 0x3f, 0xe6, 0x86, 0xf2, 0xde, 0x99, 0x30, 0xfe, 0xa9,
+	0xf0, 0x49, 0x00, 0xb1, 0xdd, 0xb8, 0xb1, 0x17, 0x79, 0x7e, 0xde, 0x26, 0xf6, 0xc9, 0xfe, 0x33,
+	0xfb, 0xeb, 0xdf, 0x3e, 0xc8, 0x18, 0x42, 0x1a, 0xf9, 0x5d, 0x71, 0xe2, 0x27, 0xbf, 0x07, 0x00,
+	0xd0, 0xb8, 0x69, 0xd0, 0xbf, 0x03, 0x00, 0x00,
+}
+
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Status != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JoinTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintLimitOrder(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	if m.UpperTick != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLimitOrder(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLimitOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLimitOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovLimitOrder(uint64(m.PositionId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLimitOrder(uint64(m.PoolId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLimitOrder(uint64(l))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovLimitOrder(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovLimitOrder(uint64(m.UpperTick))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime)
+	n += 1 + l + sovLimitOrder(uint64(l))
+	l = m.TokenIn.Size()
+	n += 1 + l + sovLimitOrder(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovLimitOrder(uint64(m.Status))
+	}
+	l = m.TokenOut.Size()
+	n += 1 + l + sovLimitOrder(uint64(l))
+	return n
+}
+
+func sovLimitOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLimitOrder(x uint64) (n int) {
+	return sovLimitOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLimitOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JoinTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= LimitOrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLimitOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLimitOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLimitOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLimitOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLimitOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLimitOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLimitOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLimitOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLimitOrder = fmt.Errorf("proto: unexpected end of group")
+)
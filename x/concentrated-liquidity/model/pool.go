@@ -49,6 +49,7 @@ func NewConcentratedLiquidityPool(poolId uint64, denom0, denom1 string, tickSpac
 		TickSpacing:               tickSpacing,
 		PrecisionFactorAtPriceOne: exponentAtPriceOne,
 		SwapFee:                   swapFee,
+		TickAccumulator:           sdk.ZeroInt(),
 	}
 
 	return pool, nil
@@ -77,9 +78,20 @@ func (p Pool) String() string {
 	return string(out)
 }
 
-// GetSwapFee returns the swap fee of the pool
+// GetSwapFee returns the swap fee of the pool.
+// If the pool has a dynamic swap fee, the base swap fee is increased by the number
+// of ticks the current tick moved within the current volatility window.
 func (p Pool) GetSwapFee(ctx sdk.Context) sdk.Dec {
-	return p.SwapFee
+	if p.DynamicSwapFee == nil {
+		return p.SwapFee
+	}
+	return p.DynamicSwapFee.SwapFee(p.SwapFee, p.getVolatilityTicksMoved(ctx.BlockTime()))
+}
+
+// GetDynamicSwapFee returns the dynamic swap fee configuration of the pool.
+// Returns nil if dynamic swap fees are disabled for the pool.
+func (p Pool) GetDynamicSwapFee() *types.DynamicSwapFee {
+	return p.DynamicSwapFee
 }
 
 // GetExitFee returns the exit fee of the pool
@@ -156,6 +168,38 @@ func (p Pool) GetLastLiquidityUpdate() time.Time {
 	return p.LastLiquidityUpdate
 }
 
+// GetTickAccumulator returns the pool's tick accumulator as of its last update.
+func (p Pool) GetTickAccumulator() sdk.Int {
+	return p.TickAccumulator
+}
+
+// GetLastTickAccumulatorUpdate returns the last time the tick accumulator was updated.
+func (p Pool) GetLastTickAccumulatorUpdate() time.Time {
+	return p.LastTickAccumulatorUpdate
+}
+
+// GetTickAccumulatorAtTime returns the tick accumulator extrapolated to blockTime,
+// accumulating the current tick for every millisecond elapsed since the last update.
+// If the accumulator has never been updated or blockTime is not after the last update,
+// the stored accumulator is returned as is.
+func (p Pool) GetTickAccumulatorAtTime(blockTime time.Time) sdk.Int {
+	tickAccumulator := p.TickAccumulator
+	if tickAccumulator.IsNil() {
+		tickAccumulator = sdk.ZeroInt()
+	}
+
+	if p.LastTickAccumulatorUpdate.IsZero() {
+		return tickAccumulator
+	}
+
+	elapsedMs := blockTime.Sub(p.LastTickAccumulatorUpdate).Milliseconds()
+	if elapsedMs <= 0 {
+		return tickAccumulator
+	}
+
+	return tickAccumulator.Add(p.CurrentTick.MulRaw(elapsedMs))
+}
+
 func (p Pool) GetType() poolmanagertypes.PoolType {
 	return poolmanagertypes.Concentrated
 }
@@ -180,6 +224,53 @@ func (p *Pool) SetLastLiquidityUpdate(newTime time.Time) {
 	p.LastLiquidityUpdate = newTime
 }
 
+// UpdateTickAccumulator accumulates the current tick up until blockTime
+// and sets the pool's LastTickAccumulatorUpdate to blockTime.
+// Must be called before the current tick is changed. Note that this method is mutative.
+func (p *Pool) UpdateTickAccumulator(blockTime time.Time) {
+	p.TickAccumulator = p.GetTickAccumulatorAtTime(blockTime)
+	p.LastTickAccumulatorUpdate = blockTime
+}
+
+// SetSwapFee updates the base swap fee and the dynamic swap fee configuration of the pool.
+// A nil dynamicSwapFee disables dynamic swap fees. The volatility window is reset
+// so that the new configuration only applies to tick movements from now on.
+func (p *Pool) SetSwapFee(swapFee sdk.Dec, dynamicSwapFee *types.DynamicSwapFee) {
+	p.SwapFee = swapFee
+	p.DynamicSwapFee = dynamicSwapFee
+	p.VolatilityWindowStart = time.Time{}
+}
+
+// UpdateVolatilityWindow starts a new volatility window at blockTime with the current tick
+// as its reference tick if the pool has a dynamic swap fee and the current window has elapsed.
+// Must be called before the current tick is changed. Note that this method is mutative.
+func (p *Pool) UpdateVolatilityWindow(blockTime time.Time) {
+	if p.DynamicSwapFee == nil || !p.isVolatilityWindowElapsed(blockTime) {
+		return
+	}
+	p.VolatilityReferenceTick = p.CurrentTick
+	p.VolatilityWindowStart = blockTime
+}
+
+// isVolatilityWindowElapsed returns true if there is no current volatility window
+// or if it has elapsed at blockTime. False otherwise.
+func (p Pool) isVolatilityWindowElapsed(blockTime time.Time) bool {
+	if p.VolatilityWindowStart.IsZero() {
+		return true
+	}
+	return !blockTime.Before(p.VolatilityWindowStart.Add(p.DynamicSwapFee.VolatilityWindow))
+}
+
+// getVolatilityTicksMoved returns the number of ticks the current tick moved away
+// from the reference tick of the current volatility window.
+// Returns zero if the volatility window has elapsed at blockTime.
+func (p Pool) getVolatilityTicksMoved(blockTime time.Time) sdk.Int {
+	if p.isVolatilityWindowElapsed(blockTime) {
+		return sdk.ZeroInt()
+	}
+	return p.CurrentTick.Sub(p.VolatilityReferenceTick).Abs()
+}
+
 // updateLiquidityIfActivePosition updates the pool's liquidity if the position is active.
 // Returns true if updated, false otherwise.
 // TODO: add tests.
@@ -16,6 +16,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types1 "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -52,6 +53,22 @@ type Pool struct {
 	// last_liquidity_update is the last time either the pool liquidity or the
 	// active tick changed
 	LastLiquidityUpdate time.Time `protobuf:"bytes,11,opt,name=last_liquidity_update,json=lastLiquidityUpdate,proto3,stdtime" json:"last_liquidity_update" yaml:"last_liquidity_update"`
+	// tick_accumulator is the sum of the active tick weighted by the number of
+	// milliseconds it was active for, since the pool was created. The time
+	// weighted average tick between two observations can be computed by dividing
+	// the difference in accumulators by the elapsed milliseconds.
+	TickAccumulator github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=tick_accumulator,json=tickAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tick_accumulator" yaml:"tick_accumulator"`
+	// last_tick_accumulator_update is the last time the tick accumulator was
+	// updated
+	LastTickAccumulatorUpdate time.Time `protobuf:"bytes,13,opt,name=last_tick_accumulator_update,json=lastTickAccumulatorUpdate,proto3,stdtime" json:"last_tick_accumulator_update" yaml:"last_tick_accumulator_update"`
+	// dynamic_swap_fee is the dynamic swap fee configuration of the pool. If
+	// unset, swap_fee is charged on every swap.
+	DynamicSwapFee *types1.DynamicSwapFee `protobuf:"bytes,14,opt,name=dynamic_swap_fee,json=dynamicSwapFee,proto3" json:"dynamic_swap_fee,omitempty" yaml:"dynamic_swap_fee"`
+	// volatility_reference_tick is the current tick at the start of the
+	// current volatility window
+	VolatilityReferenceTick github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,15,opt,name=volatility_reference_tick,json=volatilityReferenceTick,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"volatility_reference_tick" yaml:"volatility_reference_tick"`
+	// volatility_window_start is the start time of the current volatility window
+	VolatilityWindowStart time.Time `protobuf:"bytes,16,opt,name=volatility_window_start,json=volatilityWindowStart,proto3,stdtime" json:"volatility_window_start" yaml:"volatility_window_start"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -95,46 +112,58 @@ func init() {
 }
 
 var fileDescriptor_3526ea5373d96c9a = []byte{
-	// 610 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0xde, 0x22, 0xb0, 0x30, 0x4b, 0x50, 0x06, 0xc4, 0x42, 0x64, 0x4b, 0x1a, 0x35, 0x6b, 0xe2,
-	0xb6, 0xae, 0xc6, 0x0b, 0x37, 0x56, 0x25, 0x21, 0x31, 0x81, 0x14, 0xf4, 0x60, 0x48, 0x9a, 0xd9,
-	0xe9, 0x50, 0x26, 0xdb, 0x76, 0xba, 0x9d, 0x59, 0x84, 0x1f, 0x60, 0xe2, 0x91, 0x23, 0x89, 0x17,
-	0x7e, 0x84, 0x3f, 0x82, 0x78, 0xe2, 0x68, 0x3c, 0x54, 0xc3, 0xfe, 0x83, 0xfd, 0x05, 0xa6, 0xd3,
-	0xe9, 0xd2, 0x44, 0x34, 0xd9, 0x53, 0xfb, 0xde, 0xf7, 0xe6, 0x7b, 0xdf, 0x7b, 0x6f, 0xde, 0x80,
-	0xa7, 0x8c, 0x87, 0x8c, 0x53, 0x6e, 0x63, 0x16, 0x61, 0x12, 0x89, 0x04, 0x09, 0xe2, 0x35, 0x03,
-	0xda, 0xeb, 0x53, 0x8f, 0x8a, 0x53, 0x3b, 0x66, 0x2c, 0xb0, 0xe2, 0x84, 0x09, 0x06, 0x1f, 0xab,
-	0x50, 0xab, 0x1c, 0x3a, 0x8a, 0xb4, 0x8e, 0x5b, 0x1d, 0x22, 0x50, 0x6b, 0x75, 0x05, 0xcb, 0x38,
-	0x57, 0x1e, 0xb2, 0x73, 0x23, 0x67, 0x58, 0x5d, 0xf2, 0x99, 0xcf, 0x72, 0x7f, 0xf6, 0xa7, 0xbc,
-	0x86, 0xcf, 0x98, 0x1f, 0x10, 0x5b, 0x5a, 0x9d, 0xfe, 0xa1, 0x2d, 0x68, 0x48, 0xb8, 0x40, 0x61,
-	0x9c, 0x07, 0x98, 0x5f, 0xab, 0x60, 0x72, 0x97, 0xb1, 0x00, 0x3e, 0x03, 0x55, 0xe4, 0x79, 0x09,
-	0xe1, 0x5c, 0xd7, 0xd6, 0xb5, 0xc6, 0x6c, 0x1b, 0x0e, 0x53, 0x63, 0xfe, 0x14, 0x85, 0xc1, 0x86,
-	0xa9, 0x00, 0xd3, 0x29, 0x42, 0xe0, 0x3c, 0x98, 0xa0, 0x9e, 0x3e, 0xb1, 0xae, 0x35, 0x26, 0x9d,
-	0x09, 0xea, 0xc1, 0xcf, 0x1a, 0x58, 0xc6, 0xfd, 0x24, 0x21, 0x91, 0x70, 0x05, 0xc5, 0x5d, 0x77,
-	0xa4, 0x5d, 0xbf, 0x23, 0xd9, 0x76, 0x2e, 0x53, 0xa3, 0xf2, 0x33, 0x35, 0x9e, 0xf8, 0x54, 0x1c,
-	0xf5, 0x3b, 0x16, 0x66, 0xa1, 0xd2, 0xaf, 0x3e, 0x4d, 0xee, 0x75, 0x6d, 0x71, 0x1a, 0x13, 0x6e,
-	0xbd, 0x21, 0x78, 0x98, 0x1a, 0x6b, 0x79, 0xee, 0xdb, 0x59, 0x4d, 0x67, 0x49, 0x01, 0xfb, 0x14,
-	0x77, 0xdf, 0x15, 0x6e, 0xb8, 0x0c, 0xa6, 0x05, 0xeb, 0x92, 0xe8, 0xb9, 0x3e, 0x99, 0xa5, 0x75,
-	0x94, 0x35, 0xf2, 0xb7, 0xf4, 0xa9, 0x92, 0xbf, 0x05, 0x7b, 0x00, 0x16, 0x09, 0x78, 0x2f, 0x11,
-	0x6e, 0x9c, 0x50, 0x4c, 0xf4, 0x69, 0x29, 0xf9, 0xf5, 0xd8, 0x92, 0x17, 0x72, 0xc9, 0x3c, 0x66,
-	0x8a, 0xc9, 0x74, 0xee, 0x29, 0xfa, 0xbd, 0x5e, 0x22, 0x76, 0x33, 0x17, 0x3c, 0x02, 0x73, 0xe5,
-	0x9a, 0xf4, 0xaa, 0x4c, 0xf6, 0x76, 0x8c, 0x64, 0xdb, 0x91, 0x18, 0xa6, 0xc6, 0xe2, 0xdf, 0xfd,
-	0x31, 0x9d, 0x5a, 0xa9, 0x2b, 0x70, 0x03, 0xcc, 0xc9, 0xae, 0xf1, 0x18, 0x61, 0x1a, 0xf9, 0xfa,
-	0x4c, 0x36, 0xae, 0xf6, 0x83, 0x9b, 0xb3, 0x65, 0xd4, 0x74, 0x6a, 0x99, 0xb9, 0x97, 0x5b, 0xf0,
-	0x5c, 0x03, 0x6b, 0x71, 0x42, 0x30, 0xe5, 0x94, 0x45, 0xee, 0x21, 0xc2, 0x82, 0x25, 0x2e, 0x52,
-	0x65, 0xb9, 0x2c, 0x22, 0xfa, 0xac, 0xd4, 0xfd, 0x61, 0x6c, 0xdd, 0x8f, 0xf2, 0xdc, 0xff, 0x25,
-	0x37, 0x9d, 0x95, 0x11, 0xbe, 0x25, 0xe1, 0xcd, 0xbc, 0x7b, 0x3b, 0x11, 0x81, 0x07, 0x60, 0x86,
-	0x7f, 0x42, 0xb1, 0x7b, 0x48, 0x88, 0x0e, 0xa4, 0x88, 0xcd, 0xb1, 0x27, 0x75, 0x57, 0x4d, 0x4a,
-	0xf1, 0x98, 0x4e, 0x35, 0xfb, 0xdd, 0x22, 0x04, 0x9e, 0x80, 0xfb, 0x01, 0xe2, 0xe2, 0xe6, 0xaa,
-	0xb9, 0xfd, 0xd8, 0x43, 0x82, 0xe8, 0xb5, 0x75, 0xad, 0x51, 0x7b, 0xb1, 0x6a, 0xe5, 0x1b, 0x65,
-	0x15, 0x1b, 0x65, 0xed, 0x17, 0x1b, 0xd5, 0x6e, 0x64, 0x32, 0x86, 0xa9, 0xf1, 0x30, 0x27, 0xbf,
-	0x95, 0xc6, 0x3c, 0xfb, 0x65, 0x68, 0xce, 0x62, 0x86, 0x8d, 0x6e, 0xed, 0x7b, 0x89, 0x6c, 0x2c,
-	0x7c, 0xb9, 0x30, 0x2a, 0xe7, 0x17, 0x46, 0xe5, 0xfb, 0xb7, 0xe6, 0x54, 0xb6, 0x93, 0xdb, 0xed,
-	0x83, 0xcb, 0xeb, 0xba, 0x76, 0x75, 0x5d, 0xd7, 0x7e, 0x5f, 0xd7, 0xb5, 0xb3, 0x41, 0xbd, 0x72,
-	0x35, 0xa8, 0x57, 0x7e, 0x0c, 0xea, 0x95, 0x8f, 0xed, 0x52, 0xa9, 0xea, 0xed, 0x68, 0x06, 0xa8,
-	0xc3, 0x0b, 0xc3, 0x3e, 0x6e, 0xbd, 0xb2, 0x4f, 0xfe, 0xf5, 0xf2, 0x84, 0xcc, 0x23, 0x41, 0x67,
-	0x5a, 0xd6, 0xf0, 0xf2, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x45, 0x5e, 0xb2, 0xa8, 0x04,
-	0x00, 0x00,
+	// 805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcb, 0x6e, 0xdb, 0x46,
+	0x14, 0x15, 0x5d, 0xc7, 0x4e, 0x46, 0xaa, 0xac, 0x4c, 0x1e, 0xa6, 0xdc, 0x44, 0x14, 0xd8, 0x07,
+	0xd4, 0xa2, 0x22, 0xab, 0x16, 0xde, 0x78, 0x67, 0x35, 0x0d, 0x60, 0xa0, 0x40, 0x02, 0xca, 0x6d,
+	0x81, 0x22, 0x00, 0x31, 0x1a, 0x8e, 0x94, 0x81, 0x48, 0x0e, 0xc5, 0x19, 0xd9, 0x11, 0x50, 0x74,
+	0x57, 0xa0, 0x8b, 0xa2, 0xc8, 0x32, 0xcb, 0xfc, 0x41, 0x37, 0xfd, 0x88, 0xa0, 0xab, 0x2c, 0x8b,
+	0x2e, 0xd4, 0xc2, 0xfe, 0x03, 0x7d, 0x41, 0xc1, 0x99, 0xa1, 0x44, 0xbf, 0xea, 0x6a, 0x25, 0xdd,
+	0x7b, 0xee, 0x9c, 0x7b, 0xee, 0x3d, 0x1c, 0x12, 0x7c, 0xcc, 0x78, 0xc4, 0x38, 0xe5, 0x2e, 0x66,
+	0x31, 0x26, 0xb1, 0x48, 0x91, 0x20, 0x41, 0x3b, 0xa4, 0xe3, 0x09, 0x0d, 0xa8, 0x98, 0xba, 0x09,
+	0x63, 0xa1, 0x93, 0xa4, 0x4c, 0x30, 0xf8, 0xa1, 0x2e, 0x75, 0x8a, 0xa5, 0x8b, 0x4a, 0xe7, 0xa8,
+	0xd3, 0x27, 0x02, 0x75, 0x76, 0xea, 0x58, 0xd6, 0xf9, 0xf2, 0x90, 0xab, 0x02, 0xc5, 0xb0, 0x73,
+	0x77, 0xc8, 0x86, 0x4c, 0xe5, 0xb3, 0x7f, 0x3a, 0x6b, 0x0d, 0x19, 0x1b, 0x86, 0xc4, 0x95, 0x51,
+	0x7f, 0x32, 0x70, 0x05, 0x8d, 0x08, 0x17, 0x28, 0x4a, 0x74, 0xc1, 0xee, 0x35, 0x1a, 0x83, 0x69,
+	0x8c, 0x22, 0x8a, 0x7d, 0x7e, 0x8c, 0x12, 0x7f, 0x40, 0x88, 0x3a, 0x66, 0xff, 0x56, 0x01, 0xeb,
+	0x4f, 0x19, 0x0b, 0xe1, 0xa7, 0x60, 0x13, 0x05, 0x41, 0x4a, 0x38, 0x37, 0x8d, 0xa6, 0xd1, 0xba,
+	0xd5, 0x85, 0xf3, 0x99, 0x55, 0x9d, 0xa2, 0x28, 0xdc, 0xb3, 0x35, 0x60, 0x7b, 0x79, 0x09, 0xac,
+	0x82, 0x35, 0x1a, 0x98, 0x6b, 0x4d, 0xa3, 0xb5, 0xee, 0xad, 0xd1, 0x00, 0xfe, 0x64, 0x80, 0xfb,
+	0x78, 0x92, 0xa6, 0x24, 0x16, 0xbe, 0xa0, 0x78, 0xe4, 0x2f, 0x1a, 0x9b, 0xef, 0x48, 0xb6, 0x27,
+	0x6f, 0x66, 0x56, 0xe9, 0xaf, 0x99, 0xf5, 0xd1, 0x90, 0x8a, 0xe7, 0x93, 0xbe, 0x83, 0x59, 0xa4,
+	0xc7, 0xd6, 0x3f, 0x6d, 0x1e, 0x8c, 0x5c, 0x31, 0x4d, 0x08, 0x77, 0x1e, 0x11, 0x3c, 0x9f, 0x59,
+	0x0f, 0x55, 0xef, 0xcb, 0x59, 0x6d, 0xef, 0xae, 0x06, 0x0e, 0x29, 0x1e, 0x7d, 0x9d, 0xa7, 0xe1,
+	0x7d, 0xb0, 0x21, 0xd8, 0x88, 0xc4, 0x9f, 0x99, 0xeb, 0x59, 0x5b, 0x4f, 0x47, 0x8b, 0x7c, 0xc7,
+	0xbc, 0x51, 0xc8, 0x77, 0xe0, 0x18, 0xc0, 0xbc, 0x01, 0x1f, 0xa7, 0xc2, 0x4f, 0x52, 0x8a, 0x89,
+	0xb9, 0x21, 0x25, 0x7f, 0xb9, 0xb2, 0xe4, 0xdb, 0x4a, 0x32, 0x4f, 0x98, 0x66, 0xb2, 0xbd, 0x9a,
+	0xa6, 0xef, 0x8d, 0x53, 0xf1, 0x34, 0x4b, 0xc1, 0xe7, 0xa0, 0x52, 0x9c, 0xc9, 0xdc, 0x94, 0xcd,
+	0xbe, 0x5a, 0xa1, 0xd9, 0x41, 0x2c, 0xe6, 0x33, 0xeb, 0xce, 0xc5, 0xfd, 0xd8, 0x5e, 0xb9, 0xb0,
+	0x15, 0xb8, 0x07, 0x2a, 0x72, 0x6b, 0x3c, 0x41, 0x98, 0xc6, 0x43, 0xf3, 0x66, 0x66, 0x57, 0x77,
+	0x7b, 0x79, 0xb6, 0x88, 0xda, 0x5e, 0x39, 0x0b, 0x7b, 0x2a, 0x82, 0xaf, 0x0c, 0xf0, 0x30, 0x49,
+	0x09, 0xa6, 0x9c, 0xb2, 0xd8, 0x1f, 0x20, 0x2c, 0x58, 0xea, 0x23, 0x3d, 0x96, 0xcf, 0x62, 0x62,
+	0xde, 0x92, 0xba, 0xbf, 0x5d, 0x59, 0xf7, 0x07, 0xaa, 0xf7, 0x7f, 0x92, 0xdb, 0x5e, 0x7d, 0x81,
+	0x3f, 0x96, 0xf0, 0xbe, 0xda, 0xde, 0x93, 0x98, 0xc0, 0x67, 0xe0, 0x66, 0xfe, 0x10, 0x9b, 0x40,
+	0x8a, 0xd8, 0x5f, 0xd9, 0xa9, 0x2d, 0xed, 0x94, 0xe6, 0xb1, 0xbd, 0xcd, 0xec, 0xef, 0x63, 0x42,
+	0xe0, 0x0b, 0x70, 0x2f, 0x44, 0x5c, 0x2c, 0x1f, 0x35, 0x7f, 0x92, 0x04, 0x48, 0x10, 0xb3, 0xdc,
+	0x34, 0x5a, 0xe5, 0xcf, 0x77, 0x1c, 0x75, 0x11, 0x9d, 0xfc, 0x22, 0x3a, 0x87, 0xf9, 0x45, 0xec,
+	0xb6, 0x32, 0x19, 0xf3, 0x99, 0xf5, 0x40, 0x91, 0x5f, 0x4a, 0x63, 0xbf, 0xfc, 0xdb, 0x32, 0xbc,
+	0x3b, 0x19, 0xb6, 0x78, 0x6a, 0xbf, 0x91, 0x08, 0x14, 0xa0, 0x26, 0x0d, 0x41, 0x18, 0x4f, 0xa2,
+	0x49, 0x88, 0x04, 0x4b, 0xcd, 0x8a, 0x9c, 0xef, 0x60, 0xe5, 0x25, 0x6f, 0x17, 0x0c, 0x2e, 0xf0,
+	0xd9, 0xde, 0x56, 0x96, 0xda, 0x5f, 0x66, 0xe0, 0x2f, 0x06, 0x78, 0x20, 0x95, 0x9e, 0xaf, 0xcd,
+	0xe7, 0x7e, 0xf7, 0xda, 0xb9, 0x5d, 0x3d, 0xf7, 0xfb, 0x85, 0xb9, 0xaf, 0x60, 0x53, 0xe3, 0xd7,
+	0xb3, 0x92, 0xc3, 0xb3, 0x42, 0xf4, 0x12, 0x7e, 0x00, 0xb5, 0xf3, 0x6f, 0x2a, 0xb3, 0x2a, 0x15,
+	0xec, 0x3a, 0xff, 0xeb, 0xd5, 0xea, 0x3c, 0x52, 0xc7, 0x7b, 0xca, 0xcf, 0xee, 0x7b, 0xcb, 0x6d,
+	0x9c, 0x27, 0xb6, 0xbd, 0x6a, 0x70, 0xa6, 0x18, 0xfe, 0x6a, 0x80, 0xfa, 0x11, 0x0b, 0x91, 0xa0,
+	0x61, 0x66, 0x59, 0x4a, 0x06, 0x24, 0x25, 0x31, 0x26, 0xea, 0xa6, 0x6e, 0x49, 0x33, 0xbc, 0x95,
+	0xcd, 0x68, 0xaa, 0xf6, 0x57, 0x12, 0xdb, 0xde, 0xf6, 0x12, 0xf3, 0x72, 0x48, 0x5e, 0xe1, 0x1f,
+	0x41, 0x01, 0xf2, 0x8f, 0x69, 0x1c, 0xb0, 0x63, 0x9f, 0x0b, 0x94, 0x0a, 0xb3, 0x76, 0xad, 0x2f,
+	0x9f, 0x68, 0x5f, 0x1a, 0x17, 0xfa, 0x17, 0x89, 0x94, 0x25, 0xf7, 0x96, 0xe8, 0x77, 0x12, 0xec,
+	0x65, 0xd8, 0xde, 0xed, 0x9f, 0x5f, 0x5b, 0xa5, 0x57, 0xaf, 0xad, 0xd2, 0x1f, 0xbf, 0xb7, 0x6f,
+	0x64, 0xdf, 0x89, 0x83, 0xee, 0xb3, 0x37, 0x27, 0x0d, 0xe3, 0xed, 0x49, 0xc3, 0xf8, 0xe7, 0xa4,
+	0x61, 0xbc, 0x3c, 0x6d, 0x94, 0xde, 0x9e, 0x36, 0x4a, 0x7f, 0x9e, 0x36, 0x4a, 0xdf, 0x77, 0x0b,
+	0x1b, 0xd1, 0x5e, 0xb5, 0x43, 0xd4, 0xe7, 0x79, 0xe0, 0x1e, 0x75, 0x76, 0xdd, 0x17, 0x57, 0x7d,
+	0xa0, 0x22, 0x16, 0x90, 0xb0, 0xbf, 0x21, 0xe7, 0xf8, 0xe2, 0xdf, 0x01, 0x00, 0x6b, 0xb6, 0x01,
+	0x04, 0x73, 0x07, 0x00, 0x00,
 }
 
 func (m *Pool) Marshal() (dAtA []byte, err error) {
@@ -157,13 +186,63 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastLiquidityUpdate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastLiquidityUpdate):])
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VolatilityWindowStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VolatilityWindowStart):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintPool(dAtA, i, uint64(n1))
 	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.VolatilityReferenceTick.Size()
+		i -= size
+		if _, err := m.VolatilityReferenceTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.DynamicSwapFee != nil {
+		{
+			size, err := m.DynamicSwapFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastTickAccumulatorUpdate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastTickAccumulatorUpdate):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintPool(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.TickAccumulator.Size()
+		i -= size
+		if _, err := m.TickAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastLiquidityUpdate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastLiquidityUpdate):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintPool(dAtA, i, uint64(n4))
+	i--
 	dAtA[i] = 0x5a
 	{
 		size := m.SwapFee.Size()
@@ -296,6 +375,18 @@ func (m *Pool) Size() (n int) {
 	n += 1 + l + sovPool(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastLiquidityUpdate)
 	n += 1 + l + sovPool(uint64(l))
+	l = m.TickAccumulator.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastTickAccumulatorUpdate)
+	n += 1 + l + sovPool(uint64(l))
+	if m.DynamicSwapFee != nil {
+		l = m.DynamicSwapFee.Size()
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = m.VolatilityReferenceTick.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VolatilityWindowStart)
+	n += 2 + l + sovPool(uint64(l))
 	return n
 }
 
@@ -671,6 +762,176 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTickAccumulatorUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastTickAccumulatorUpdate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicSwapFee == nil {
+				m.DynamicSwapFee = &types1.DynamicSwapFee{}
+			}
+			if err := m.DynamicSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityReferenceTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolatilityReferenceTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityWindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VolatilityWindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
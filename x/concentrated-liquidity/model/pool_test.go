@@ -3,6 +3,7 @@ package model_test
 import (
 	fmt "fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
@@ -162,6 +163,208 @@ func (s *ConcentratedPoolTestSuite) TestApplySwap() {
 	s.Require().Equal(mock_pool.CurrentSqrtPrice, newCurrSqrtPrice)
 }
 
+func (s *ConcentratedPoolTestSuite) TestUpdateTickAccumulator() {
+	lastUpdate := time.Unix(1000, 0).UTC()
+
+	tests := map[string]struct {
+		lastUpdate          time.Time
+		tickAccumulator     sdk.Int
+		blockTime           time.Time
+		expectedAccumulator sdk.Int
+	}{
+		"one second elapsed": {
+			lastUpdate:          lastUpdate,
+			tickAccumulator:     sdk.ZeroInt(),
+			blockTime:           lastUpdate.Add(time.Second),
+			expectedAccumulator: DefaultCurrTick.MulRaw(1000),
+		},
+		"one second elapsed with existing accumulator": {
+			lastUpdate:          lastUpdate,
+			tickAccumulator:     sdk.NewInt(10),
+			blockTime:           lastUpdate.Add(time.Second),
+			expectedAccumulator: DefaultCurrTick.MulRaw(1000).AddRaw(10),
+		},
+		"no time elapsed": {
+			lastUpdate:          lastUpdate,
+			tickAccumulator:     sdk.NewInt(10),
+			blockTime:           lastUpdate,
+			expectedAccumulator: sdk.NewInt(10),
+		},
+		"block time before last update": {
+			lastUpdate:          lastUpdate,
+			tickAccumulator:     sdk.NewInt(10),
+			blockTime:           lastUpdate.Add(-time.Second),
+			expectedAccumulator: sdk.NewInt(10),
+		},
+		"never updated": {
+			tickAccumulator:     sdk.ZeroInt(),
+			blockTime:           lastUpdate,
+			expectedAccumulator: sdk.ZeroInt(),
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			mock_pool := model.Pool{
+				CurrentTick:               DefaultCurrTick,
+				TickAccumulator:           tc.tickAccumulator,
+				LastTickAccumulatorUpdate: tc.lastUpdate,
+			}
+
+			s.Require().Equal(tc.expectedAccumulator, mock_pool.GetTickAccumulatorAtTime(tc.blockTime))
+
+			mock_pool.UpdateTickAccumulator(tc.blockTime)
+
+			s.Require().Equal(tc.expectedAccumulator, mock_pool.GetTickAccumulator())
+			s.Require().Equal(tc.blockTime, mock_pool.GetLastTickAccumulatorUpdate())
+		})
+	}
+}
+
+func (s *ConcentratedPoolTestSuite) TestGetSwapFeeDynamic() {
+	windowStart := time.Unix(1000, 0).UTC()
+	dynamicSwapFee := &types.DynamicSwapFee{
+		MaxSwapFee:       sdk.MustNewDecFromStr("0.05"),
+		FeePerTick:       sdk.MustNewDecFromStr("0.0001"),
+		VolatilityWindow: time.Hour,
+	}
+
+	tests := map[string]struct {
+		dynamicSwapFee  *types.DynamicSwapFee
+		windowStart     time.Time
+		ticksMoved      int64
+		blockTime       time.Time
+		expectedSwapFee sdk.Dec
+	}{
+		"dynamic swap fee disabled": {
+			windowStart:     windowStart,
+			ticksMoved:      100,
+			blockTime:       windowStart,
+			expectedSwapFee: DefaultSwapFee,
+		},
+		"no ticks moved": {
+			dynamicSwapFee:  dynamicSwapFee,
+			windowStart:     windowStart,
+			blockTime:       windowStart,
+			expectedSwapFee: DefaultSwapFee,
+		},
+		"ticks moved up within window": {
+			dynamicSwapFee:  dynamicSwapFee,
+			windowStart:     windowStart,
+			ticksMoved:      100,
+			blockTime:       windowStart.Add(time.Minute),
+			expectedSwapFee: sdk.MustNewDecFromStr("0.02"),
+		},
+		"ticks moved down within window": {
+			dynamicSwapFee:  dynamicSwapFee,
+			windowStart:     windowStart,
+			ticksMoved:      -100,
+			blockTime:       windowStart.Add(time.Minute),
+			expectedSwapFee: sdk.MustNewDecFromStr("0.02"),
+		},
+		"capped at max swap fee": {
+			dynamicSwapFee:  dynamicSwapFee,
+			windowStart:     windowStart,
+			ticksMoved:      1000,
+			blockTime:       windowStart.Add(time.Minute),
+			expectedSwapFee: dynamicSwapFee.MaxSwapFee,
+		},
+		"window elapsed": {
+			dynamicSwapFee:  dynamicSwapFee,
+			windowStart:     windowStart,
+			ticksMoved:      100,
+			blockTime:       windowStart.Add(time.Hour),
+			expectedSwapFee: DefaultSwapFee,
+		},
+		"no window started": {
+			dynamicSwapFee:  dynamicSwapFee,
+			ticksMoved:      100,
+			blockTime:       windowStart,
+			expectedSwapFee: DefaultSwapFee,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.Setup()
+			mock_pool := model.Pool{
+				SwapFee:                 DefaultSwapFee,
+				DynamicSwapFee:          tc.dynamicSwapFee,
+				CurrentTick:             DefaultCurrTick.AddRaw(tc.ticksMoved),
+				VolatilityReferenceTick: DefaultCurrTick,
+				VolatilityWindowStart:   tc.windowStart,
+			}
+
+			s.Require().Equal(tc.expectedSwapFee, mock_pool.GetSwapFee(s.Ctx.WithBlockTime(tc.blockTime)))
+		})
+	}
+}
+
+func (s *ConcentratedPoolTestSuite) TestUpdateVolatilityWindow() {
+	windowStart := time.Unix(1000, 0).UTC()
+	dynamicSwapFee := &types.DynamicSwapFee{
+		MaxSwapFee:       sdk.MustNewDecFromStr("0.05"),
+		FeePerTick:       sdk.MustNewDecFromStr("0.0001"),
+		VolatilityWindow: time.Hour,
+	}
+	referenceTick := DefaultCurrTick.SubRaw(100)
+
+	tests := map[string]struct {
+		dynamicSwapFee        *types.DynamicSwapFee
+		windowStart           time.Time
+		blockTime             time.Time
+		expectedWindowStart   time.Time
+		expectedReferenceTick sdk.Int
+	}{
+		"dynamic swap fee disabled": {
+			windowStart:           windowStart,
+			blockTime:             windowStart.Add(2 * time.Hour),
+			expectedWindowStart:   windowStart,
+			expectedReferenceTick: referenceTick,
+		},
+		"window not elapsed": {
+			dynamicSwapFee:        dynamicSwapFee,
+			windowStart:           windowStart,
+			blockTime:             windowStart.Add(time.Minute),
+			expectedWindowStart:   windowStart,
+			expectedReferenceTick: referenceTick,
+		},
+		"window elapsed": {
+			dynamicSwapFee:        dynamicSwapFee,
+			windowStart:           windowStart,
+			blockTime:             windowStart.Add(time.Hour),
+			expectedWindowStart:   windowStart.Add(time.Hour),
+			expectedReferenceTick: DefaultCurrTick,
+		},
+		"no window started": {
+			dynamicSwapFee:        dynamicSwapFee,
+			blockTime:             windowStart,
+			expectedWindowStart:   windowStart,
+			expectedReferenceTick: DefaultCurrTick,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			mock_pool := model.Pool{
+				SwapFee:                 DefaultSwapFee,
+				DynamicSwapFee:          tc.dynamicSwapFee,
+				CurrentTick:             DefaultCurrTick,
+				VolatilityReferenceTick: referenceTick,
+				VolatilityWindowStart:   tc.windowStart,
+			}
+
+			mock_pool.UpdateVolatilityWindow(tc.blockTime)
+
+			s.Require().Equal(tc.expectedWindowStart, mock_pool.VolatilityWindowStart)
+			s.Require().Equal(tc.expectedReferenceTick, mock_pool.VolatilityReferenceTick)
+		})
+	}
+}
+
 // TestNewConcentratedLiquidityPool is a test suite that tests the NewConcentratedLiquidityPool function.
 func (s *ConcentratedPoolTestSuite) TestNewConcentratedLiquidityPool() {
 	type param struct {
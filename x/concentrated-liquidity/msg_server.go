@@ -65,7 +65,7 @@ func (server msgServer) CreatePosition(goCtx context.Context, msg *types.MsgCrea
 		return nil, err
 	}
 
-	_, actualAmount0, actualAmount1, liquidityCreated, joinTime, err := server.keeper.createPosition(ctx, msg.PoolId, sender, msg.TokenDesired0.Amount, msg.TokenDesired1.Amount, msg.TokenMinAmount0, msg.TokenMinAmount1, msg.LowerTick, msg.UpperTick, msg.FreezeDuration)
+	_, actualAmount0, actualAmount1, liquidityCreated, joinTime, err := server.keeper.CreatePosition(ctx, msg.PoolId, sender, msg.TokenDesired0.Amount, msg.TokenDesired1.Amount, msg.TokenMinAmount0, msg.TokenMinAmount1, msg.LowerTick, msg.UpperTick, msg.FreezeDuration)
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +78,7 @@ func (server msgServer) CreatePosition(goCtx context.Context, msg *types.MsgCrea
 		),
 	})
 
-	// Note: create position event is emitted in keeper.createPosition(...)
+	// Note: create position event is emitted in keeper.CreatePosition(...)
 
 	return &types.MsgCreatePositionResponse{Amount0: actualAmount0, Amount1: actualAmount1, LiquidityCreated: liquidityCreated, JoinTime: joinTime}, nil
 }
@@ -110,6 +110,147 @@ func (server msgServer) WithdrawPosition(goCtx context.Context, msg *types.MsgWi
 	return &types.MsgWithdrawPositionResponse{Amount0: amount0, Amount1: amount1}, nil
 }
 
+// WithdrawPositionById withdraws liquidity from the position with the given id owned by the sender.
+func (server msgServer) WithdrawPositionById(goCtx context.Context, msg *types.MsgWithdrawPositionById) (*types.MsgWithdrawPositionByIdResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	amount0, amount1, err := server.keeper.withdrawPositionById(ctx, sender, msg.PositionId, msg.LiquidityAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: wthdraw position event is emitted in keeper.withdrawPosition(...)
+
+	return &types.MsgWithdrawPositionByIdResponse{Amount0: amount0, Amount1: amount1}, nil
+}
+
+// AddToPosition adds liquidity to an existing position owned by the sender, keeping its position id and join time.
+func (server msgServer) AddToPosition(goCtx context.Context, msg *types.MsgAddToPosition) (*types.MsgAddToPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	actualAmount0, actualAmount1, liquidityAdded, err := server.keeper.addToPosition(ctx, msg.PoolId, sender, msg.LowerTick, msg.UpperTick, msg.JoinTime, msg.FreezeDuration, msg.PositionId, msg.TokenDesired0.Amount, msg.TokenDesired1.Amount, msg.TokenMinAmount0, msg.TokenMinAmount1)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: add to position event is emitted in keeper.addToPosition(...)
+
+	return &types.MsgAddToPositionResponse{Amount0: actualAmount0, Amount1: actualAmount1, LiquidityAdded: liquidityAdded}, nil
+}
+
+// TransferPositions transfers the positions with the given ids from the sender to the new owner,
+// together with the fees and incentives accrued by them.
+func (server msgServer) TransferPositions(goCtx context.Context, msg *types.MsgTransferPositions) (*types.MsgTransferPositionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.transferPositions(ctx, msg.PositionIds, sender, newOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: transfer position events are emitted in keeper.transferPositions(...)
+
+	return &types.MsgTransferPositionsResponse{}, nil
+}
+
+// PlaceLimitOrder places a limit order backed by a single-sided position that spans a single tick spacing.
+func (server msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	positionId, tokenIn, liquidityCreated, err := server.keeper.placeLimitOrder(ctx, msg.PoolId, sender, msg.TickIndex, msg.TokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: place limit order event is emitted in keeper.placeLimitOrder(...)
+
+	return &types.MsgPlaceLimitOrderResponse{PositionId: positionId, TokenIn: tokenIn, LiquidityCreated: liquidityCreated}, nil
+}
+
+// ClaimLimitOrder claims the proceeds of a filled limit order together with the fees accrued by it.
+func (server msgServer) ClaimLimitOrder(goCtx context.Context, msg *types.MsgClaimLimitOrder) (*types.MsgClaimLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOut, collectedFees, err := server.keeper.claimLimitOrder(ctx, msg.PoolId, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: claim limit order event is emitted in keeper.claimLimitOrder(...)
+
+	return &types.MsgClaimLimitOrderResponse{TokenOut: tokenOut, CollectedFees: collectedFees}, nil
+}
+
 func (server msgServer) CollectFees(goCtx context.Context, msg *types.MsgCollectFees) (*types.MsgCollectFeesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -177,6 +318,60 @@ func (server msgServer) CollectIncentives(goCtx context.Context, msg *types.MsgC
 	return &types.MsgCollectIncentivesResponse{CollectedIncentives: collectedIncentives}, nil
 }
 
+// CollectFeesById collects fees for the given positions that belong to sender.
+func (server msgServer) CollectFeesById(goCtx context.Context, msg *types.MsgCollectFeesById) (*types.MsgCollectFeesByIdResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	collectedFees, err := server.keeper.collectFeesForPositions(ctx, sender, msg.PositionIds)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: collect fees events are emitted in keeper.collectFeesForPositions(...)
+
+	return &types.MsgCollectFeesByIdResponse{CollectedFees: collectedFees}, nil
+}
+
+// CollectIncentivesById collects incentives for the given positions that belong to sender.
+func (server msgServer) CollectIncentivesById(goCtx context.Context, msg *types.MsgCollectIncentivesById) (*types.MsgCollectIncentivesByIdResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	collectedIncentives, err := server.keeper.collectIncentivesForPositions(ctx, sender, msg.PositionIds)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: collect incentives events are emitted in keeper.collectIncentivesForPositions(...)
+
+	return &types.MsgCollectIncentivesByIdResponse{CollectedIncentives: collectedIncentives}, nil
+}
+
 func (server msgServer) CreateIncentive(goCtx context.Context, msg *types.MsgCreateIncentive) (*types.MsgCreateIncentiveResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
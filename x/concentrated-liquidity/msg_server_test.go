@@ -290,3 +290,172 @@ func (suite *KeeperTestSuite) TestCollectIncentives_Events() {
 		})
 	}
 }
+
+// TestCollectFeesById_Events tests that events are correctly emitted
+// when calling CollectFeesById.
+func (suite *KeeperTestSuite) TestCollectFeesById_Events() {
+	testcases := map[string]struct {
+		numPositions               int
+		positionIds                []uint64
+		expectedCollectFeesEvent   int
+		expectedMessageEvents      int
+		expectedPositionIdNotFound uint64
+		errorFromValidateBasic     error
+	}{
+		"happy path": {
+			numPositions:             1,
+			positionIds:              []uint64{1},
+			expectedCollectFeesEvent: 1,
+			expectedMessageEvents:    2, // 1 for collect fees, 1 for message
+		},
+		"two positions in the same range": {
+			numPositions:             2,
+			positionIds:              []uint64{1, 2},
+			expectedCollectFeesEvent: 1, // accumulator records are shared by all positions in the same range
+			expectedMessageEvents:    2, // 1 for collect fees, 1 for message
+		},
+		"error: position does not exist": {
+			numPositions:               1,
+			positionIds:                []uint64{2},
+			expectedPositionIdNotFound: 2,
+		},
+		"error: duplicate position ids": {
+			numPositions:             1,
+			positionIds:              []uint64{1, 1},
+			expectedCollectFeesEvent: 1,
+			expectedMessageEvents:    2, // 1 for collect fees, 1 for message
+			errorFromValidateBasic:   fmt.Errorf("Duplicate position id (1)"),
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			suite.Setup()
+			ctx := suite.Ctx
+
+			// Create a cl pool with default positions
+			pool := suite.PrepareConcentratedPool()
+			for i := 0; i < tc.numPositions; i++ {
+				suite.SetupDefaultPosition(pool.GetId())
+			}
+
+			msgServer := cl.NewMsgServerImpl(suite.App.ConcentratedLiquidityKeeper)
+
+			// Reset event counts to 0 by creating a new manager.
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			suite.Equal(0, len(ctx.EventManager().Events()))
+
+			msg := &cltypes.MsgCollectFeesById{
+				PositionIds: tc.positionIds,
+				Sender:      suite.TestAccs[0].String(),
+			}
+
+			response, err := msgServer.CollectFeesById(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expectedPositionIdNotFound == 0 {
+				suite.NoError(err)
+				suite.NotNil(response)
+				suite.AssertEventEmitted(ctx, cltypes.TypeEvtCollectFees, tc.expectedCollectFeesEvent)
+				suite.AssertEventEmitted(ctx, sdk.EventTypeMessage, tc.expectedMessageEvents)
+			} else {
+				suite.Require().ErrorIs(err, types.PositionIdNotFoundForOwnerError{PositionId: tc.expectedPositionIdNotFound, Owner: suite.TestAccs[0].String()})
+				suite.Require().Nil(response)
+			}
+
+			// Some validate basic checks are defense in depth so they would normally not be possible to reach
+			// This check allows us to still test these cases
+			if tc.errorFromValidateBasic != nil {
+				suite.Require().Error(msg.ValidateBasic())
+				suite.Require().ErrorAs(msg.ValidateBasic(), &tc.errorFromValidateBasic)
+			}
+		})
+	}
+}
+
+// TestCollectIncentivesById_Events tests that events are correctly emitted
+// when calling CollectIncentivesById.
+func (suite *KeeperTestSuite) TestCollectIncentivesById_Events() {
+	uptimeHelper := getExpectedUptimes()
+	testcases := map[string]struct {
+		numPositions                   int
+		positionIds                    []uint64
+		expectedCollectIncentivesEvent int
+		expectedMessageEvents          int
+		expectedPositionIdNotFound     uint64
+		errorFromValidateBasic         error
+	}{
+		"happy path": {
+			numPositions:                   1,
+			positionIds:                    []uint64{1},
+			expectedCollectIncentivesEvent: 1,
+			expectedMessageEvents:          2, // 1 for collect incentives, 1 for message
+		},
+		"two positions in the same range": {
+			numPositions:                   2,
+			positionIds:                    []uint64{1, 2},
+			expectedCollectIncentivesEvent: 1, // accumulator records are shared by all positions in the same range
+			expectedMessageEvents:          2, // 1 for collect incentives, 1 for message
+		},
+		"error: position does not exist": {
+			numPositions:               1,
+			positionIds:                []uint64{2},
+			expectedPositionIdNotFound: 2,
+		},
+		"error: duplicate position ids": {
+			numPositions:                   1,
+			positionIds:                    []uint64{1, 1},
+			expectedCollectIncentivesEvent: 1,
+			expectedMessageEvents:          2, // 1 for collect incentives, 1 for message
+			errorFromValidateBasic:         fmt.Errorf("Duplicate position id (1)"),
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			suite.Setup()
+			ctx := suite.Ctx
+
+			// Create a cl pool with default positions
+			pool := suite.PrepareConcentratedPool()
+			for i := 0; i < tc.numPositions; i++ {
+				suite.SetupDefaultPosition(pool.GetId())
+			}
+
+			// Set up accrued incentives
+			err := addToUptimeAccums(ctx, pool.GetId(), suite.App.ConcentratedLiquidityKeeper, uptimeHelper.hundredTokensMultiDenom)
+			suite.Require().NoError(err)
+			suite.FundAcc(pool.GetAddress(), expectedIncentivesFromUptimeGrowth(uptimeHelper.hundredTokensMultiDenom, DefaultLiquidityAmt.MulInt64(int64(tc.numPositions)), DefaultFreezeDuration, sdk.OneInt()))
+
+			msgServer := cl.NewMsgServerImpl(suite.App.ConcentratedLiquidityKeeper)
+
+			// Reset event counts to 0 by creating a new manager.
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			suite.Equal(0, len(ctx.EventManager().Events()))
+
+			msg := &cltypes.MsgCollectIncentivesById{
+				PositionIds: tc.positionIds,
+				Sender:      suite.TestAccs[0].String(),
+			}
+
+			response, err := msgServer.CollectIncentivesById(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expectedPositionIdNotFound == 0 {
+				suite.NoError(err)
+				suite.NotNil(response)
+				suite.AssertEventEmitted(ctx, cltypes.TypeEvtCollectIncentives, tc.expectedCollectIncentivesEvent)
+				suite.AssertEventEmitted(ctx, sdk.EventTypeMessage, tc.expectedMessageEvents)
+			} else {
+				suite.Require().ErrorIs(err, types.PositionIdNotFoundForOwnerError{PositionId: tc.expectedPositionIdNotFound, Owner: suite.TestAccs[0].String()})
+				suite.Require().Nil(response)
+				suite.AssertEventEmitted(ctx, sdk.EventTypeMessage, 0)
+			}
+
+			// Some validate basic checks are defense in depth so they would normally not be possible to reach
+			// This check allows us to still test these cases
+			if tc.errorFromValidateBasic != nil {
+				suite.Require().Error(msg.ValidateBasic())
+				suite.Require().ErrorAs(msg.ValidateBasic(), &tc.errorFromValidateBasic)
+			}
+		})
+	}
+}
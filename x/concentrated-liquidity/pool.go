@@ -3,6 +3,7 @@ package concentrated_liquidity
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -28,6 +29,7 @@ func (k Keeper) InitializePool(ctx sdk.Context, poolI poolmanagertypes.PoolI, cr
 	}
 
 	concentratedPool.SetLastLiquidityUpdate(ctx.BlockTime())
+	concentratedPool.UpdateTickAccumulator(ctx.BlockTime())
 
 	params := k.GetParams(ctx)
 	tickSpacing := concentratedPool.GetTickSpacing()
@@ -119,6 +121,61 @@ func (k Keeper) GetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error)
 	return denoms, nil
 }
 
+// GetTickAccumulator returns the tick accumulator of the pool with the given id
+// extrapolated to the current block time.
+// The time weighted average tick between two block times t0 and t1 is
+// (accumulator(t1) - accumulator(t0)) / (t1 - t0) with the elapsed time in milliseconds.
+// Returns error if the pool does not exist.
+func (k Keeper) GetTickAccumulator(ctx sdk.Context, poolId uint64) (sdk.Int, error) {
+	concentratedPool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return concentratedPool.GetTickAccumulatorAtTime(ctx.BlockTime()), nil
+}
+
+// updatePoolSwapFee updates the base swap fee and the dynamic swap fee configuration of the pool with the given id.
+// A nil dynamicSwapFee disables dynamic swap fees for the pool.
+// Returns error if:
+// - the pool does not exist
+// - the swap fee is not one of the authorized swap fees
+// - the dynamic swap fee is invalid for the swap fee
+func (k Keeper) updatePoolSwapFee(ctx sdk.Context, poolId uint64, swapFee sdk.Dec, dynamicSwapFee *types.DynamicSwapFee) error {
+	concentratedPool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	if !k.validateSwapFee(ctx, params, swapFee) {
+		return types.UnauthorizedSwapFeeError{ProvidedSwapFee: swapFee, AuthorizedSwapFees: params.AuthorizedSwapFees}
+	}
+
+	dynamicSwapFeeStr := ""
+	if dynamicSwapFee != nil {
+		if err := dynamicSwapFee.Validate(swapFee); err != nil {
+			return err
+		}
+		dynamicSwapFeeStr = dynamicSwapFee.String()
+	}
+
+	concentratedPool.SetSwapFee(swapFee, dynamicSwapFee)
+	if err := k.setPool(ctx, concentratedPool); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtUpdateSwapFee,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeySwapFee, swapFee.String()),
+		sdk.NewAttribute(types.AttributeKeyDynamicSwapFee, dynamicSwapFeeStr),
+	))
+
+	return nil
+}
+
 func (k Keeper) CalculateSpotPrice(
 	ctx sdk.Context,
 	poolId uint64,
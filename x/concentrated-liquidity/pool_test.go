@@ -2,6 +2,7 @@ package concentrated_liquidity_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -257,6 +258,173 @@ func (s *KeeperTestSuite) TestCalculateSpotPrice() {
 	s.Require().True(spotPrice.IsNil())
 }
 
+func (s *KeeperTestSuite) TestGetTickAccumulator() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	// Create default CL pool
+	concentratedPool := s.PrepareConcentratedPool()
+
+	// The current tick is zero before the first position, so nothing is accumulated.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+	tickAccumulator, err := clKeeper.GetTickAccumulator(s.Ctx, concentratedPool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.ZeroInt(), tickAccumulator)
+
+	// set up default position to initialize the current tick
+	s.SetupDefaultPosition(concentratedPool.GetId())
+
+	// The current tick is accumulated for every millisecond elapsed.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+	tickAccumulator, err = clKeeper.GetTickAccumulator(s.Ctx, concentratedPool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(DefaultCurrTick.MulRaw(1000), tickAccumulator)
+
+	// A swap persists the accumulator up until the swap before moving the current tick.
+	swapTokenIn := sdk.NewCoin(USDC, sdk.NewInt(100000000))
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(swapTokenIn))
+	poolBeforeSwap, err := clKeeper.GetPool(s.Ctx, concentratedPool.GetId())
+	s.Require().NoError(err)
+	_, err = clKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[1], poolBeforeSwap, swapTokenIn, ETH, sdk.OneInt(), DefaultZeroSwapFee)
+	s.Require().NoError(err)
+
+	poolAfterSwap, err := clKeeper.GetPoolFromPoolIdAndConvertToConcentrated(s.Ctx, concentratedPool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(DefaultCurrTick.MulRaw(1000), poolAfterSwap.GetTickAccumulator())
+	s.Require().Equal(s.Ctx.BlockTime(), poolAfterSwap.GetLastTickAccumulatorUpdate())
+	s.Require().False(poolAfterSwap.GetCurrentTick().Equal(DefaultCurrTick))
+
+	// Afterwards, the new current tick is accumulated.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+	tickAccumulator, err = clKeeper.GetTickAccumulator(s.Ctx, concentratedPool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(DefaultCurrTick.MulRaw(1000).Add(poolAfterSwap.GetCurrentTick().MulRaw(1000)), tickAccumulator)
+
+	// try getting the tick accumulator of a non-existent pool
+	_, err = clKeeper.GetTickAccumulator(s.Ctx, concentratedPool.GetId()+1)
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: concentratedPool.GetId() + 1})
+}
+
+func (s *KeeperTestSuite) TestUpdatePoolSwapFee() {
+	validDynamicSwapFee := &types.DynamicSwapFee{
+		MaxSwapFee:       sdk.MustNewDecFromStr("0.05"),
+		FeePerTick:       sdk.MustNewDecFromStr("0.000001"),
+		VolatilityWindow: time.Hour,
+	}
+
+	tests := map[string]struct {
+		poolId         uint64
+		swapFee        sdk.Dec
+		dynamicSwapFee *types.DynamicSwapFee
+		expectedErr    error
+	}{
+		"update swap fee": {
+			poolId:  1,
+			swapFee: sdk.MustNewDecFromStr("0.003"),
+		},
+		"update swap fee with dynamic swap fee": {
+			poolId:         1,
+			swapFee:        sdk.MustNewDecFromStr("0.003"),
+			dynamicSwapFee: validDynamicSwapFee,
+		},
+		"error: pool does not exist": {
+			poolId:      2,
+			swapFee:     sdk.MustNewDecFromStr("0.003"),
+			expectedErr: types.PoolNotFoundError{PoolId: 2},
+		},
+		"error: unauthorized swap fee": {
+			poolId:      1,
+			swapFee:     sdk.MustNewDecFromStr("0.002"),
+			expectedErr: types.UnauthorizedSwapFeeError{ProvidedSwapFee: sdk.MustNewDecFromStr("0.002"), AuthorizedSwapFees: types.DefaultParams().AuthorizedSwapFees},
+		},
+		"error: max swap fee below swap fee": {
+			poolId:  1,
+			swapFee: sdk.MustNewDecFromStr("0.003"),
+			dynamicSwapFee: &types.DynamicSwapFee{
+				MaxSwapFee:       sdk.MustNewDecFromStr("0.001"),
+				FeePerTick:       validDynamicSwapFee.FeePerTick,
+				VolatilityWindow: validDynamicSwapFee.VolatilityWindow,
+			},
+			expectedErr: types.InvalidMaxSwapFeeError{MaxSwapFee: sdk.MustNewDecFromStr("0.001"), BaseSwapFee: sdk.MustNewDecFromStr("0.003")},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+
+			// Create default CL pool with a zero swap fee
+			concentratedPool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(concentratedPool.GetId())
+
+			err := clKeeper.UpdatePoolSwapFee(s.Ctx, tc.poolId, tc.swapFee, tc.dynamicSwapFee)
+			if tc.expectedErr != nil {
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+
+				// the pool remains unchanged
+				pool, err := clKeeper.GetPoolById(s.Ctx, concentratedPool.GetId())
+				s.Require().NoError(err)
+				s.Require().True(pool.GetSwapFee(s.Ctx).IsZero())
+				s.Require().Nil(pool.GetDynamicSwapFee())
+				return
+			}
+			s.Require().NoError(err)
+
+			pool, err := clKeeper.GetPoolById(s.Ctx, tc.poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.swapFee, pool.GetSwapFee(s.Ctx))
+			s.Require().Equal(tc.dynamicSwapFee, pool.GetDynamicSwapFee())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestDynamicSwapFee() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	baseSwapFee := sdk.MustNewDecFromStr("0.003")
+	dynamicSwapFee := &types.DynamicSwapFee{
+		MaxSwapFee:       sdk.MustNewDecFromStr("0.05"),
+		FeePerTick:       sdk.MustNewDecFromStr("0.000001"),
+		VolatilityWindow: time.Hour,
+	}
+
+	concentratedPool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(concentratedPool.GetId())
+	err := clKeeper.UpdatePoolSwapFee(s.Ctx, concentratedPool.GetId(), baseSwapFee, dynamicSwapFee)
+	s.Require().NoError(err)
+
+	// The first swap starts the volatility window at the current tick.
+	swapTokenIn := sdk.NewCoin(USDC, sdk.NewInt(100000000))
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(swapTokenIn, swapTokenIn))
+	pool, err := clKeeper.GetPool(s.Ctx, concentratedPool.GetId())
+	s.Require().NoError(err)
+	_, err = clKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[1], pool, swapTokenIn, ETH, sdk.OneInt(), baseSwapFee)
+	s.Require().NoError(err)
+
+	// The swap fee grows with the ticks moved within the volatility window.
+	poolAfterSwap, err := clKeeper.GetPoolById(s.Ctx, concentratedPool.GetId())
+	s.Require().NoError(err)
+	ticksMoved := poolAfterSwap.GetCurrentTick().Sub(DefaultCurrTick).Abs()
+	s.Require().True(ticksMoved.IsPositive())
+	expectedSwapFee := dynamicSwapFee.SwapFee(baseSwapFee, ticksMoved)
+	s.Require().Equal(expectedSwapFee, poolAfterSwap.GetSwapFee(s.Ctx))
+	s.Require().True(expectedSwapFee.GT(baseSwapFee))
+
+	// The swap fee given to the swap is charged as is, so that callers such as the
+	// multihop router can discount the pool's swap fee.
+	_, _, tokenOutWithBaseFee, _, _, _, err := clKeeper.CalcOutAmtGivenInInternal(s.Ctx, swapTokenIn, ETH, baseSwapFee, sdk.ZeroDec(), concentratedPool.GetId())
+	s.Require().NoError(err)
+	_, _, tokenOutWithDynamicFee, _, _, _, err := clKeeper.CalcOutAmtGivenInInternal(s.Ctx, swapTokenIn, ETH, expectedSwapFee, sdk.ZeroDec(), concentratedPool.GetId())
+	s.Require().NoError(err)
+	s.Require().True(tokenOutWithBaseFee.Amount.GT(tokenOutWithDynamicFee.Amount))
+
+	// Once the volatility window elapses, the base swap fee applies again.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(dynamicSwapFee.VolatilityWindow))
+	s.Require().Equal(baseSwapFee, poolAfterSwap.GetSwapFee(s.Ctx))
+}
+
 func (s *KeeperTestSuite) TestValidateSwapFee() {
 	tests := []struct {
 		name        string
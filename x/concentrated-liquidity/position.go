@@ -1,6 +1,8 @@
 package concentrated_liquidity
 
 import (
+	"errors"
+	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -120,6 +122,9 @@ func (k Keeper) setPosition(ctx sdk.Context,
 	store := ctx.KVStore(k.storeKey)
 	key := types.KeyFullPosition(poolId, owner, lowerTick, upperTick, joinTime, freezeDuration, positionId)
 	osmoutils.MustSetDec(store, key, liquidity)
+
+	// Index the position by its id so that it can be looked up without knowing its full key.
+	store.Set(types.KeyPositionId(positionId), key)
 }
 
 func (k Keeper) deletePosition(ctx sdk.Context,
@@ -138,9 +143,70 @@ func (k Keeper) deletePosition(ctx sdk.Context,
 	}
 
 	store.Delete(key)
+	store.Delete(types.KeyPositionId(positionId))
 	return nil
 }
 
+// positionRange identifies the pool and tick range of a position.
+type positionRange struct {
+	poolId    uint64
+	lowerTick int64
+	upperTick int64
+}
+
+// getPositionRangesForOwner returns the distinct pool and tick ranges of the positions with the given ids,
+// in the order they first appear. Since fee and incentive accumulator records are shared by all positions
+// of an owner in the same range, this is the granularity at which they are collected.
+// Returns error if any of the positions does not exist or is owned by another account.
+func (k Keeper) getPositionRangesForOwner(ctx sdk.Context, owner sdk.AccAddress, positionIds []uint64) ([]positionRange, error) {
+	positionRanges := make([]positionRange, 0, len(positionIds))
+	seenPositionRanges := make(map[positionRange]struct{}, len(positionIds))
+	for _, positionId := range positionIds {
+		position, err := k.getPositionForOwner(ctx, positionId, owner)
+		if err != nil {
+			return nil, err
+		}
+
+		positionRange := positionRange{poolId: position.PoolId, lowerTick: position.LowerTick, upperTick: position.UpperTick}
+		if _, ok := seenPositionRanges[positionRange]; ok {
+			continue
+		}
+		seenPositionRanges[positionRange] = struct{}{}
+		positionRanges = append(positionRanges, positionRange)
+	}
+	return positionRanges, nil
+}
+
+// GetPosition returns the position with the given id.
+// Returns error if the position does not exist.
+func (k Keeper) GetPosition(ctx sdk.Context, positionId uint64) (model.Position, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := store.Get(types.KeyPositionId(positionId))
+	if key == nil {
+		return model.Position{}, types.PositionIdNotFoundError{PositionId: positionId}
+	}
+
+	return ParseFullPositionFromBytes(key, store.Get(key))
+}
+
+// getPositionForOwner returns the position with the given id if it is owned by owner.
+// Returns error if the position does not exist or is owned by another account.
+func (k Keeper) getPositionForOwner(ctx sdk.Context, positionId uint64, owner sdk.AccAddress) (model.Position, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		if errors.Is(err, types.PositionIdNotFoundError{PositionId: positionId}) {
+			return model.Position{}, types.PositionIdNotFoundForOwnerError{PositionId: positionId, Owner: owner.String()}
+		}
+		return model.Position{}, err
+	}
+
+	if position.Address != owner.String() {
+		return model.Position{}, types.PositionIdNotFoundForOwnerError{PositionId: positionId, Owner: owner.String()}
+	}
+
+	return position, nil
+}
+
 // CreateFullRangePosition creates a full range (min to max tick) concentrated liquidity position for the given pool ID, owner, coins, and frozen until time.
 // The function returns the amounts of token 0 and token 1, and the liquidity created from the position.
 func (k Keeper) CreateFullRangePosition(ctx sdk.Context, concentratedPool types.ConcentratedPoolExtension, owner sdk.AccAddress, coins sdk.Coins, freezeDuration time.Duration) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, joinTime time.Time, err error) {
@@ -148,7 +214,7 @@ func (k Keeper) CreateFullRangePosition(ctx sdk.Context, concentratedPool types.
 	minTick, maxTick := GetMinAndMaxTicksFromExponentAtPriceOne(concentratedPool.GetPrecisionFactorAtPriceOne())
 
 	// Create a full range (min to max tick) concentrated liquidity position.
-	positionId, amount0, amount1, liquidity, joinTime, err = k.createPosition(ctx, concentratedPool.GetId(), owner, coins.AmountOf(concentratedPool.GetToken0()), coins.AmountOf(concentratedPool.GetToken1()), sdk.ZeroInt(), sdk.ZeroInt(), minTick, maxTick, freezeDuration)
+	positionId, amount0, amount1, liquidity, joinTime, err = k.CreatePosition(ctx, concentratedPool.GetId(), owner, coins.AmountOf(concentratedPool.GetToken0()), coins.AmountOf(concentratedPool.GetToken1()), sdk.ZeroInt(), sdk.ZeroInt(), minTick, maxTick, freezeDuration)
 	if err != nil {
 		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, err
 	}
@@ -174,3 +240,211 @@ func (k Keeper) getNextPositionIdAndIncrement(ctx sdk.Context) uint64 {
 	k.SetNextPositionId(ctx, nextPositionId+1)
 	return nextPositionId
 }
+
+// transferPositions transfers the ownership of the positions with the given ids from sender to newOwner.
+// Fees and incentives accrued by the positions prior to the transfer move together with them, so the
+// new owner is able to collect them. See transferPosition for details.
+// Returns error if:
+// - sender and new owner are the same
+// - any of the given position ids is not owned by sender
+// - other internal database or math errors.
+func (k Keeper) transferPositions(ctx sdk.Context, positionIds []uint64, sender, newOwner sdk.AccAddress) error {
+	if sender.Equals(newOwner) {
+		return types.TransferToSameOwnerError{Owner: sender.String()}
+	}
+
+	for _, positionId := range positionIds {
+		// A position transferred earlier in the loop is no longer owned by sender,
+		// which guards against the same position id being transferred twice.
+		position, err := k.getPositionForOwner(ctx, positionId, sender)
+		if err != nil {
+			return err
+		}
+
+		if err := k.transferPosition(ctx, position, sender, newOwner); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// transferPosition re-keys the given position from owner to newOwner. Specifically, it:
+// - moves the position record under the new owner, retaining its position id, join time and freeze duration.
+// - moves the position's uptime accumulator records, including their unclaimed rewards, under the new owner.
+// - moves the position's share of liquidity in the owner's fee accumulator record for the tick range to the new owner's
+// record together with the pro-rata share of the unclaimed fees. If the owner has no other positions in the range, the old
+// record is removed.
+// - moves the open limit order backed by the position, if any, to the new owner.
+// Since the position's liquidity is not changed, ticks and pool liquidity are left untouched.
+func (k Keeper) transferPosition(ctx sdk.Context, position model.Position, owner, newOwner sdk.AccAddress) error {
+	poolId, lowerTick, upperTick := position.PoolId, position.LowerTick, position.UpperTick
+
+	// Bring uptime accumulators up to date so that the incentives accrued until now move with the position.
+	if err := k.updateUptimeAccumulatorsToNow(ctx, poolId); err != nil {
+		return err
+	}
+
+	if err := k.transferPositionUptimeRecords(ctx, position, owner, newOwner); err != nil {
+		return err
+	}
+
+	if err := k.transferPositionFeeShare(ctx, poolId, owner, newOwner, lowerTick, upperTick, position.Liquidity); err != nil {
+		return err
+	}
+
+	if err := k.deletePosition(ctx, poolId, owner, lowerTick, upperTick, position.JoinTime, position.FreezeDuration, position.PositionId); err != nil {
+		return err
+	}
+	k.setPosition(ctx, poolId, newOwner, lowerTick, upperTick, position.JoinTime, position.FreezeDuration, position.Liquidity, position.PositionId)
+
+	// An open limit order backed by the position moves together with it.
+	if err := k.transferOpenLimitOrder(ctx, poolId, owner, newOwner, position.PositionId); err != nil {
+		return err
+	}
+
+	emitTransferPositionEvent(ctx, position, owner, newOwner)
+	return nil
+}
+
+// transferPositionUptimeRecords moves all of the position's uptime accumulator records from owner to newOwner.
+// Incentives accrued by the old records are moved into the unclaimed rewards of the new ones. The old records are removed.
+func (k Keeper) transferPositionUptimeRecords(ctx sdk.Context, position model.Position, owner, newOwner sdk.AccAddress) error {
+	poolId, lowerTick, upperTick := position.PoolId, position.LowerTick, position.UpperTick
+
+	uptimeAccumulators, err := k.getUptimeAccumulators(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	uptimeGrowthInside, err := k.GetUptimeGrowthInsideRange(ctx, poolId, lowerTick, upperTick)
+	if err != nil {
+		return err
+	}
+
+	uptimeGrowthOutside, err := k.GetUptimeGrowthOutsideRange(ctx, poolId, lowerTick, upperTick)
+	if err != nil {
+		return err
+	}
+
+	oldPositionName := string(types.KeyFullPosition(poolId, owner, lowerTick, upperTick, position.JoinTime, position.FreezeDuration, position.PositionId))
+	newPositionName := string(types.KeyFullPosition(poolId, newOwner, lowerTick, upperTick, position.JoinTime, position.FreezeDuration, position.PositionId))
+	for uptimeIndex, uptimeAccum := range uptimeAccumulators {
+		hasPosition, err := uptimeAccum.HasPosition(oldPositionName)
+		if err != nil {
+			return err
+		}
+
+		if !hasPosition {
+			continue
+		}
+
+		// Settle the rewards accrued so far into the old record's unclaimed rewards while emptying it.
+		err = preparePositionAccumulator(uptimeAccum, oldPositionName, uptimeGrowthOutside[uptimeIndex])
+		if err != nil {
+			return err
+		}
+
+		err = uptimeAccum.UpdatePositionCustomAcc(oldPositionName, position.Liquidity.Neg(), uptimeGrowthInside[uptimeIndex])
+		if err != nil {
+			return err
+		}
+
+		err = uptimeAccum.NewPositionCustomAcc(newPositionName, position.Liquidity, uptimeGrowthInside[uptimeIndex], emptyOptions)
+		if err != nil {
+			return err
+		}
+
+		err = uptimeAccum.MoveUnclaimedRewards(oldPositionName, newPositionName, sdk.OneDec())
+		if err != nil {
+			return err
+		}
+
+		// The old record has no shares and no rewards left. Claiming removes it from state.
+		if _, err := uptimeAccum.ClaimRewards(oldPositionName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// transferPositionFeeShare moves liquidity from the owner's fee accumulator record for the given tick range
+// to the new owner's record for the same range, initializing the latter if needed. The unclaimed fees of the
+// owner's record are moved in proportion to the transferred liquidity. If no liquidity remains in the owner's
+// record, it is removed.
+func (k Keeper) transferPositionFeeShare(ctx sdk.Context, poolId uint64, owner, newOwner sdk.AccAddress, lowerTick, upperTick int64, liquidity sdk.Dec) error {
+	feeAccumulator, err := k.getFeeAccumulator(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	oldPositionKey := formatFeePositionAccumulatorKey(poolId, owner, lowerTick, upperTick)
+	newPositionKey := formatFeePositionAccumulatorKey(poolId, newOwner, lowerTick, upperTick)
+
+	ownerLiquidityInRange, err := feeAccumulator.GetPositionSize(oldPositionKey)
+	if err != nil {
+		return err
+	}
+
+	hasNewOwnerRecord, err := feeAccumulator.HasPosition(newPositionKey)
+	if err != nil {
+		return err
+	}
+
+	if !hasNewOwnerRecord {
+		if err := k.initializeFeeAccumulatorPosition(ctx, poolId, newOwner, lowerTick, upperTick); err != nil {
+			return err
+		}
+	}
+
+	// Both updates settle the fees accrued so far into the records' unclaimed rewards.
+	if err := k.updateFeeAccumulatorPosition(ctx, poolId, owner, liquidity.Neg(), lowerTick, upperTick); err != nil {
+		return err
+	}
+
+	if err := k.updateFeeAccumulatorPosition(ctx, poolId, newOwner, liquidity, lowerTick, upperTick); err != nil {
+		return err
+	}
+
+	err = feeAccumulator.MoveUnclaimedRewards(oldPositionKey, newPositionKey, liquidity.Quo(ownerLiquidityInRange))
+	if err != nil {
+		return err
+	}
+
+	// If the owner has no liquidity left in the range, all unclaimed fees were moved. Claiming removes the empty record.
+	if liquidity.Equal(ownerLiquidityInRange) {
+		if _, err := feeAccumulator.ClaimRewards(oldPositionKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func emitTransferPositionEvent(ctx sdk.Context, position model.Position, owner, newOwner sdk.AccAddress) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtTransferPosition,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(position.PositionId, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(position.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(position.LowerTick, 10)),
+		sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(position.UpperTick, 10)),
+		sdk.NewAttribute(types.AttributeLiquidity, position.Liquidity.String()),
+	))
+}
+
+// emitCollectEvent emits an event for the fees or incentives collected by owner for the given position range.
+func emitCollectEvent(ctx sdk.Context, eventType string, owner sdk.AccAddress, positionRange positionRange, collected sdk.Coins) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(positionRange.poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, collected.String()),
+		sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(positionRange.lowerTick, 10)),
+		sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(positionRange.upperTick, 10)),
+	))
+}
@@ -370,6 +370,64 @@ func (s *KeeperTestSuite) TestGetPosition() {
 	}
 }
 
+func (s *KeeperTestSuite) TestGetPositionById() {
+	DefaultJoinTime := s.Ctx.BlockTime()
+
+	tests := []struct {
+		name             string
+		positionId       uint64
+		expectedPosition model.Position
+		expectedErr      error
+	}{
+		{
+			name:       "Get position by id on existing position",
+			positionId: 1,
+			expectedPosition: model.Position{
+				PositionId:     1,
+				PoolId:         validPoolId,
+				LowerTick:      DefaultLowerTick,
+				UpperTick:      DefaultUpperTick,
+				JoinTime:       DefaultJoinTime,
+				FreezeDuration: DefaultFreezeDuration,
+				Liquidity:      DefaultLiquidityAmt,
+			},
+		},
+		{
+			name:        "Get position by id on non-existent position",
+			positionId:  2,
+			expectedErr: types.PositionIdNotFoundError{PositionId: 2},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// Init suite for each test.
+			s.Setup()
+			s.Ctx = s.Ctx.WithBlockTime(DefaultJoinTime)
+			// Create a default CL pool
+			s.PrepareConcentratedPool()
+
+			// Set up a default initialized position
+			err := s.App.ConcentratedLiquidityKeeper.InitOrUpdatePosition(s.Ctx, validPoolId, s.TestAccs[0], DefaultLowerTick, DefaultUpperTick, DefaultLiquidityAmt, DefaultJoinTime, DefaultFreezeDuration, 1)
+			s.Require().NoError(err)
+
+			// System under test
+			position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, test.positionId)
+			if test.expectedErr != nil {
+				s.Require().Error(err)
+				s.Require().ErrorIs(err, test.expectedErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			test.expectedPosition.Address = s.TestAccs[0].String()
+			s.Require().True(test.expectedPosition.JoinTime.Equal(position.JoinTime))
+			position.JoinTime = test.expectedPosition.JoinTime
+			s.Require().Equal(test.expectedPosition, position)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestGetAllUserPositions() {
 	s.Setup()
 	defaultAddress := s.TestAccs[0]
@@ -563,6 +621,10 @@ func (s *KeeperTestSuite) TestDeletePosition() {
 				s.Require().Error(err)
 				s.Require().ErrorIs(err, types.PositionNotFoundError{PoolId: test.poolToGet, LowerTick: test.lowerTick, UpperTick: test.upperTick, JoinTime: test.joinTime, FreezeDuration: test.freezeDuration})
 				s.Require().Equal(sdk.Dec{}, positionLiquidity)
+
+				// The position id index is removed as well.
+				_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, test.positionId)
+				s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: test.positionId})
 			}
 		})
 	}
@@ -617,3 +679,170 @@ func (s *KeeperTestSuite) TestCalculateUnderlyingAssetsFromPosition() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestTransferPositions() {
+	defaultJoinTime := defaultBlockTime
+	uptimeGrowth := sdk.NewDecCoins(sdk.NewDecCoin(USDC, sdk.OneInt()))
+
+	tests := map[string]struct {
+		ownerPositions        int
+		newOwnerPositions     int
+		positionIdsToTransfer []uint64
+		transferToSelf        bool
+		// expectedPositionIdNotFound is the position id expected to not be found among the owner's positions, if any.
+		expectedPositionIdNotFound uint64
+	}{
+		"transfer the only position in range": {
+			ownerPositions:        1,
+			positionIdsToTransfer: []uint64{1},
+		},
+		"transfer one of two positions in the same range": {
+			ownerPositions:        2,
+			positionIdsToTransfer: []uint64{2},
+		},
+		"transfer all positions in the same range": {
+			ownerPositions:        2,
+			positionIdsToTransfer: []uint64{1, 2},
+		},
+		"new owner already has a position in the same range": {
+			ownerPositions:        2,
+			newOwnerPositions:     1,
+			positionIdsToTransfer: []uint64{1},
+		},
+		"error: transfer to self": {
+			ownerPositions:        1,
+			positionIdsToTransfer: []uint64{1},
+			transferToSelf:        true,
+		},
+		"error: position owned by another account": {
+			ownerPositions:             1,
+			newOwnerPositions:          1,
+			positionIdsToTransfer:      []uint64{2},
+			expectedPositionIdNotFound: 2,
+		},
+		"error: position does not exist": {
+			ownerPositions:             1,
+			positionIdsToTransfer:      []uint64{1, 3},
+			expectedPositionIdNotFound: 3,
+		},
+		"error: duplicate position ids": {
+			ownerPositions:             2,
+			positionIdsToTransfer:      []uint64{1, 1},
+			expectedPositionIdNotFound: 1,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(defaultJoinTime)
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			owner, newOwner := s.TestAccs[0], s.TestAccs[1]
+			if tc.transferToSelf {
+				newOwner = owner
+			}
+
+			pool := s.PrepareConcentratedPool()
+			var liquidity sdk.Dec
+			for i := 0; i < tc.ownerPositions; i++ {
+				liquidity = s.SetupPosition(pool.GetId(), owner, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, defaultJoinTime, DefaultFreezeDuration)
+			}
+			for i := 0; i < tc.newOwnerPositions; i++ {
+				s.SetupPosition(pool.GetId(), newOwner, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, defaultJoinTime, DefaultFreezeDuration)
+			}
+
+			// Accrue one unit of eth fee growth and one unit of usdc uptime growth per unit of liquidity.
+			err := clKeeper.ChargeFee(s.Ctx, pool.GetId(), sdk.NewDecCoin(ETH, sdk.OneInt()))
+			s.Require().NoError(err)
+
+			uptimeAccums, err := clKeeper.GetUptimeAccumulators(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			for _, uptimeAccum := range uptimeAccums {
+				uptimeAccum.AddToAccumulator(uptimeGrowth)
+			}
+
+			// Determine the incentives claimable by each position prior to the transfer.
+			incentivesBeforeTransfer := make(map[uint64]sdk.Coins, len(tc.positionIdsToTransfer))
+			for _, positionId := range tc.positionIdsToTransfer {
+				cacheCtx, _ := s.Ctx.CacheContext()
+				incentives, err := clKeeper.ClaimAllIncentivesForPosition(cacheCtx, pool.GetId(), owner, DefaultLowerTick, DefaultUpperTick, defaultJoinTime, DefaultFreezeDuration, positionId, false)
+				s.Require().NoError(err)
+				incentivesBeforeTransfer[positionId] = incentives
+			}
+
+			poolBeforeTransfer, err := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			// System under test.
+			err = clKeeper.TransferPositions(s.Ctx, tc.positionIdsToTransfer, owner, newOwner)
+			if tc.transferToSelf {
+				s.Require().ErrorIs(err, types.TransferToSameOwnerError{Owner: owner.String()})
+				return
+			}
+			if tc.expectedPositionIdNotFound != 0 {
+				s.Require().ErrorIs(err, types.PositionIdNotFoundForOwnerError{PositionId: tc.expectedPositionIdNotFound, Owner: owner.String()})
+				return
+			}
+			s.Require().NoError(err)
+
+			// The positions were re-keyed under the new owner.
+			for _, positionId := range tc.positionIdsToTransfer {
+				s.Require().False(clKeeper.HasFullPosition(s.Ctx, pool.GetId(), owner, DefaultLowerTick, DefaultUpperTick, defaultJoinTime, DefaultFreezeDuration, positionId))
+				s.validatePositionUpdate(s.Ctx, pool.GetId(), newOwner, DefaultLowerTick, DefaultUpperTick, defaultJoinTime, DefaultFreezeDuration, positionId, liquidity)
+			}
+
+			ownerPositions, err := clKeeper.GetUserPositions(s.Ctx, owner, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Len(ownerPositions, tc.ownerPositions-len(tc.positionIdsToTransfer))
+
+			newOwnerPositions, err := clKeeper.GetUserPositions(s.Ctx, newOwner, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Len(newOwnerPositions, tc.newOwnerPositions+len(tc.positionIdsToTransfer))
+
+			// Pool liquidity is unchanged.
+			poolAfterTransfer, err := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(poolBeforeTransfer.GetLiquidity().String(), poolAfterTransfer.GetLiquidity().String())
+
+			// The fees accrued by the transferred positions are claimable by the new owner.
+			newOwnerClaimableFees, err := clKeeper.QueryClaimableFees(s.Ctx, pool.GetId(), newOwner, DefaultLowerTick, DefaultUpperTick)
+			s.Require().NoError(err)
+			expectedNewOwnerFees := liquidity.MulInt64(int64(tc.newOwnerPositions + len(tc.positionIdsToTransfer))).TruncateInt()
+			s.Require().Equal(sdk.NewCoins(sdk.NewCoin(ETH, expectedNewOwnerFees)).String(), newOwnerClaimableFees.String())
+
+			// The owner keeps the fees of the remaining positions. If none remain, the owner's fee record is removed.
+			remainingOwnerPositions := tc.ownerPositions - len(tc.positionIdsToTransfer)
+			if remainingOwnerPositions > 0 {
+				ownerClaimableFees, err := clKeeper.QueryClaimableFees(s.Ctx, pool.GetId(), owner, DefaultLowerTick, DefaultUpperTick)
+				s.Require().NoError(err)
+				expectedOwnerFees := liquidity.MulInt64(int64(remainingOwnerPositions)).TruncateInt()
+				s.Require().Equal(sdk.NewCoins(sdk.NewCoin(ETH, expectedOwnerFees)).String(), ownerClaimableFees.String())
+			} else {
+				feeAccum, err := clKeeper.GetFeeAccumulator(s.Ctx, pool.GetId())
+				s.Require().NoError(err)
+				hasPosition, err := feeAccum.HasPosition(cl.FormatPositionAccumulatorKey(pool.GetId(), owner, DefaultLowerTick, DefaultUpperTick))
+				s.Require().NoError(err)
+				s.Require().False(hasPosition)
+			}
+
+			// The incentives accrued by the transferred positions are claimable by the new owner and
+			// no uptime records remain under the previous owner.
+			for _, positionId := range tc.positionIdsToTransfer {
+				s.Require().False(incentivesBeforeTransfer[positionId].IsZero())
+
+				cacheCtx, _ := s.Ctx.CacheContext()
+				newOwnerIncentives, err := clKeeper.ClaimAllIncentivesForPosition(cacheCtx, pool.GetId(), newOwner, DefaultLowerTick, DefaultUpperTick, defaultJoinTime, DefaultFreezeDuration, positionId, false)
+				s.Require().NoError(err)
+				s.Require().Equal(incentivesBeforeTransfer[positionId].String(), newOwnerIncentives.String())
+
+				ownerIncentives, err := clKeeper.ClaimAllIncentivesForPosition(cacheCtx, pool.GetId(), owner, DefaultLowerTick, DefaultUpperTick, defaultJoinTime, DefaultFreezeDuration, positionId, false)
+				s.Require().NoError(err)
+				s.Require().True(ownerIncentives.IsZero())
+			}
+
+			// Validate events emitted.
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtTransferPosition, len(tc.positionIdsToTransfer))
+		})
+	}
+}
@@ -152,6 +152,214 @@ func RandMsgWithdrawPosition(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sd
 	}, nil
 }
 
+func RandMsgWithdrawPositionById(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*cltypes.MsgWithdrawPositionById, error) {
+	rand := sim.GetRand()
+	// get random pool
+	_, poolDenoms, err := getRandCLPool(k, sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// get random user address with the pool denoms
+	sender, _, senderExists := sim.SelAddrWithDenoms(ctx, poolDenoms)
+	if !senderExists {
+		return nil, fmt.Errorf("no sender with denoms %s exists", poolDenoms)
+	}
+
+	positions, err := k.GetUserPositions(ctx, sender.Address, 0)
+	if err != nil {
+		return nil, fmt.Errorf("position does not exist")
+	}
+
+	if len(positions) == 0 {
+		return nil, fmt.Errorf("user does not have any position")
+	}
+
+	// pick a random position
+	randPosition := positions[rand.Intn(len(positions))]
+
+	// check if the position is still frozen
+	if randPosition.JoinTime.Add(randPosition.FreezeDuration).After(ctx.BlockTime()) {
+		return nil, fmt.Errorf("position is still frozen")
+	}
+
+	// get percentage amount from 1 to 100 to withdraw liquidity
+	randPerc := sim.RandomDecAmount(sdk.OneDec())
+
+	withdrawAmountInt := randPosition.Liquidity.Mul(randPerc)
+
+	return &cltypes.MsgWithdrawPositionById{
+		PositionId:      randPosition.PositionId,
+		Sender:          sender.Address.String(),
+		LiquidityAmount: withdrawAmountInt,
+	}, nil
+}
+
+func RandMsgAddToPosition(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*cltypes.MsgAddToPosition, error) {
+	rand := sim.GetRand()
+	// get random pool
+	clPool, poolDenoms, err := getRandCLPool(k, sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// get random user address with the pool denoms
+	sender, tokens, senderExists := sim.SelAddrWithDenoms(ctx, poolDenoms)
+	if !senderExists {
+		return nil, fmt.Errorf("no sender with denoms %s exists", poolDenoms)
+	}
+
+	positions, err := k.GetUserPositions(ctx, sender.Address, clPool.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("position does not exist")
+	}
+
+	if len(positions) == 0 {
+		return nil, fmt.Errorf("user does not have any position")
+	}
+
+	// pick a random position
+	randPosition := positions[rand.Intn(len(positions))]
+
+	return &cltypes.MsgAddToPosition{
+		PositionId:     randPosition.PositionId,
+		PoolId:         randPosition.PoolId,
+		Sender:         sender.Address.String(),
+		LowerTick:      randPosition.LowerTick,
+		UpperTick:      randPosition.UpperTick,
+		JoinTime:       randPosition.JoinTime,
+		FreezeDuration: randPosition.FreezeDuration,
+		TokenDesired0:  sdk.NewCoin(clPool.GetToken0(), tokens.AmountOf(clPool.GetToken0())),
+		TokenDesired1:  sdk.NewCoin(clPool.GetToken1(), tokens.AmountOf(clPool.GetToken1())),
+		// TODO: Randomize TokenMinAmount0 and TokenMinAmount1 in next iteration
+		TokenMinAmount0: sdk.NewInt(0),
+		TokenMinAmount1: sdk.NewInt(0),
+	}, nil
+}
+
+func RandMsgTransferPositions(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*cltypes.MsgTransferPositions, error) {
+	rand := sim.GetRand()
+	// get random pool
+	clPool, poolDenoms, err := getRandCLPool(k, sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// get random user address with the pool denoms
+	sender, _, senderExists := sim.SelAddrWithDenoms(ctx, poolDenoms)
+	if !senderExists {
+		return nil, fmt.Errorf("no sender with denoms %s exists", poolDenoms)
+	}
+
+	positions, err := k.GetUserPositions(ctx, sender.Address, clPool.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("position does not exist")
+	}
+
+	if len(positions) == 0 {
+		return nil, fmt.Errorf("user does not have any position")
+	}
+
+	// pick a random new owner different from the sender
+	newOwner, ok := sim.RandomSimAccountWithConstraint(func(account legacysimulationtype.Account) bool {
+		return !account.Address.Equals(sender.Address)
+	})
+	if !ok {
+		return nil, fmt.Errorf("no new owner different from the sender exists")
+	}
+
+	// pick a random position
+	randPosition := positions[rand.Intn(len(positions))]
+
+	return &cltypes.MsgTransferPositions{
+		PositionIds: []uint64{randPosition.PositionId},
+		Sender:      sender.Address.String(),
+		NewOwner:    newOwner.Address.String(),
+	}, nil
+}
+
+func RandMsgPlaceLimitOrder(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*cltypes.MsgPlaceLimitOrder, error) {
+	rand := sim.GetRand()
+	// get random pool
+	clPool, poolDenoms, err := getRandCLPool(k, sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// limit orders can only be placed once the pool has an initial position
+	if clPool.GetCurrentSqrtPrice().IsZero() {
+		return nil, fmt.Errorf("pool %d has no initial position", clPool.GetId())
+	}
+
+	// get random user address with the pool denoms
+	sender, tokens, senderExists := sim.SelAddrWithDenoms(ctx, poolDenoms)
+	if !senderExists {
+		return nil, fmt.Errorf("no sender with denoms %s exists", poolDenoms)
+	}
+
+	// round the current tick down to the closest multiple of the tick spacing
+	tickSpacing := int64(clPool.GetTickSpacing())
+	currentTick := clPool.GetCurrentTick().Int64()
+	baseTick := currentTick - currentTick%tickSpacing
+	if currentTick%tickSpacing < 0 {
+		baseTick -= tickSpacing
+	}
+
+	// place a token0 order above the current tick or a token1 order below it
+	tickOffset := tickSpacing * (1 + rand.Int63n(10))
+	tickIndex, tokenInDenom := baseTick+tickOffset, clPool.GetToken0()
+	if rand.Intn(2) == 0 {
+		tickIndex, tokenInDenom = baseTick-tickOffset, clPool.GetToken1()
+	}
+
+	return &cltypes.MsgPlaceLimitOrder{
+		PoolId:    clPool.GetId(),
+		Sender:    sender.Address.String(),
+		TickIndex: tickIndex,
+		TokenIn:   sdk.NewCoin(tokenInDenom, sim.RandPositiveInt(tokens.AmountOf(tokenInDenom))),
+	}, nil
+}
+
+func RandMsgClaimLimitOrder(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*cltypes.MsgClaimLimitOrder, error) {
+	rand := sim.GetRand()
+	// get random pool
+	clPool, poolDenoms, err := getRandCLPool(k, sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// get random user address with the pool denoms
+	sender, _, senderExists := sim.SelAddrWithDenoms(ctx, poolDenoms)
+	if !senderExists {
+		return nil, fmt.Errorf("no sender with denoms %s exists", poolDenoms)
+	}
+
+	limitOrders, err := k.GetLimitOrdersForOwner(ctx, clPool.GetId(), sender.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	filledLimitOrders := []clmodeltypes.LimitOrder{}
+	for _, limitOrder := range limitOrders {
+		if limitOrder.Status == clmodeltypes.LimitOrderFilled {
+			filledLimitOrders = append(filledLimitOrders, limitOrder)
+		}
+	}
+
+	if len(filledLimitOrders) == 0 {
+		return nil, fmt.Errorf("user does not have any filled limit order")
+	}
+
+	// pick a random filled limit order
+	randLimitOrder := filledLimitOrders[rand.Intn(len(filledLimitOrders))]
+
+	return &cltypes.MsgClaimLimitOrder{
+		PoolId:     randLimitOrder.PoolId,
+		Sender:     sender.Address.String(),
+		PositionId: randLimitOrder.PositionId,
+	}, nil
+}
+
 func RandMsgCollectFees(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*cltypes.MsgCollectFees, error) {
 	rand := sim.GetRand()
 	// get random pool
@@ -186,6 +394,42 @@ func RandMsgCollectFees(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Con
 	}, nil
 }
 
+func RandMsgCollectFeesById(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*cltypes.MsgCollectFeesById, error) {
+	rand := sim.GetRand()
+	// get random pool
+	_, poolDenoms, err := getRandCLPool(k, sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// get random user address with the pool denoms
+	sender, _, senderExists := sim.SelAddrWithDenoms(ctx, poolDenoms)
+	if !senderExists {
+		return nil, fmt.Errorf("no sender with denoms %s exists", poolDenoms)
+	}
+
+	positions, err := k.GetUserPositions(ctx, sender.Address, 0)
+	if err != nil {
+		return nil, fmt.Errorf("position does not exist")
+	}
+
+	if len(positions) == 0 {
+		return nil, fmt.Errorf("user does not have any position")
+	}
+
+	// pick a random subset of positions
+	rand.Shuffle(len(positions), func(i, j int) { positions[i], positions[j] = positions[j], positions[i] })
+	positionIds := make([]uint64, 0, len(positions))
+	for _, position := range positions[:1+rand.Intn(len(positions))] {
+		positionIds = append(positionIds, position.PositionId)
+	}
+
+	return &cltypes.MsgCollectFeesById{
+		PositionIds: positionIds,
+		Sender:      sender.Address.String(),
+	}, nil
+}
+
 func RandMsgCollectIncentives(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*cltypes.MsgCollectIncentives, error) {
 	rand := sim.GetRand()
 	// get random pool
@@ -220,6 +464,42 @@ func RandMsgCollectIncentives(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx s
 	}, nil
 }
 
+func RandMsgCollectIncentivesById(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*cltypes.MsgCollectIncentivesById, error) {
+	rand := sim.GetRand()
+	// get random pool
+	_, poolDenoms, err := getRandCLPool(k, sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// get random user address with the pool denoms
+	sender, _, senderExists := sim.SelAddrWithDenoms(ctx, poolDenoms)
+	if !senderExists {
+		return nil, fmt.Errorf("no sender with denoms %s exists", poolDenoms)
+	}
+
+	positions, err := k.GetUserPositions(ctx, sender.Address, 0)
+	if err != nil {
+		return nil, fmt.Errorf("position does not exist")
+	}
+
+	if len(positions) == 0 {
+		return nil, fmt.Errorf("user does not have any position")
+	}
+
+	// pick a random subset of positions
+	rand.Shuffle(len(positions), func(i, j int) { positions[i], positions[j] = positions[j], positions[i] })
+	positionIds := make([]uint64, 0, len(positions))
+	for _, position := range positions[:1+rand.Intn(len(positions))] {
+		positionIds = append(positionIds, position.PositionId)
+	}
+
+	return &cltypes.MsgCollectIncentivesById{
+		PositionIds: positionIds,
+		Sender:      sender.Address.String(),
+	}, nil
+}
+
 // createPoolRestriction creates specific restriction for the creation of a pool.
 func createPoolRestriction(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) osmosimtypes.SimAccountConstraint {
 	return func(acc legacysimulationtype.Account) bool {
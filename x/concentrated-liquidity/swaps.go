@@ -10,6 +10,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/internal/math"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/internal/swapstrategy"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types/query"
 	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
@@ -265,6 +266,19 @@ func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
 	swapFee sdk.Dec,
 	priceLimit sdk.Dec,
 	poolId uint64,
+) (writeCtx func(), tokenIn, tokenOut sdk.Coin, updatedTick sdk.Int, updatedLiquidity, updatedSqrtPrice sdk.Dec, err error) {
+	return k.calcOutAmtGivenInWithTrace(ctx, tokenInMin, tokenOutDenom, swapFee, priceLimit, poolId, nil)
+}
+
+// calcOutAmtGivenInWithTrace is calcOutAmtGivenIn that additionally calls recordStep with the trace
+// of every swap step, if recordStep is non-nil.
+func (k Keeper) calcOutAmtGivenInWithTrace(ctx sdk.Context,
+	tokenInMin sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+	priceLimit sdk.Dec,
+	poolId uint64,
+	recordStep func(step query.SwapStepTrace),
 ) (writeCtx func(), tokenIn, tokenOut sdk.Coin, updatedTick sdk.Int, updatedLiquidity, updatedSqrtPrice sdk.Dec, err error) {
 	ctx, writeCtx = ctx.CacheContext()
 	p, err := k.getPoolById(ctx, poolId)
@@ -329,8 +343,9 @@ func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
 	// amount may be extremely small, and that small amount cannot generate and amountIn/amountOut and we are therefore left
 	// in an infinite loop.
 	for swapState.amountSpecifiedRemaining.GT(sdk.SmallestDec()) && !swapState.sqrtPrice.Equal(sqrtPriceLimit) {
-		// log the sqrtPrice we start the iteration with
+		// log the sqrtPrice and liquidity we start the iteration with
 		sqrtPriceStart := swapState.sqrtPrice
+		liquidityStart := swapState.liquidity
 
 		// we first check to see what the position of the nearest initialized tick is
 		// if zeroForOneStrategy, we look to the left of the tick the current sqrt price is at
@@ -399,6 +414,21 @@ func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
 				return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
 			}
 		}
+
+		if recordStep != nil {
+			recordStep(query.SwapStepTrace{
+				SqrtPriceStart:  sqrtPriceStart,
+				SqrtPriceTarget: sqrtPriceTarget,
+				SqrtPriceEnd:    sqrtPrice,
+				NextTick:        nextTick,
+				TickCrossed:     nextTickSqrtPrice.Equal(sqrtPrice),
+				TickEnd:         swapState.tick,
+				Liquidity:       liquidityStart,
+				AmountIn:        amountIn,
+				AmountOut:       amountOut,
+				FeeCharge:       feeCharge,
+			})
+		}
 	}
 
 	if err := k.chargeFee(ctx, poolId, sdk.NewDecCoinFromDec(tokenInMin.Denom, swapState.feeGrowthGlobal)); err != nil {
@@ -597,6 +627,11 @@ func (k *Keeper) applySwap(
 		return err
 	}
 
+	// Accumulate the tick that was active up until this swap before it is moved.
+	pool.UpdateTickAccumulator(ctx.BlockTime())
+	// Start a new volatility window from the tick before this swap if the current one elapsed.
+	pool.UpdateVolatilityWindow(ctx.BlockTime())
+
 	if err := pool.ApplySwap(newLiquidity, newCurrentTick, newCurrentSqrtPrice); err != nil {
 		return err
 	}
@@ -608,6 +643,63 @@ func (k *Keeper) applySwap(
 	return nil
 }
 
+// SimulateSwapExactAmountIn runs a swap of tokenIn for tokenOutDenom against the current state of the given pool,
+// charging the pool's current swap fee, without persisting it. Along with the resulting amounts and swap fee,
+// it returns the trace of every swap step and the price impact of the swap.
+// The price impact is one minus the ratio of the execution price, excluding fees, to the spot price before the swap.
+func (k Keeper) SimulateSwapExactAmountIn(
+	ctx sdk.Context,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+) (tokenInSwapped, tokenOut sdk.Coin, swapFee, priceImpact sdk.Dec, steps []query.SwapStepTrace, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Dec{}, sdk.Dec{}, nil, err
+	}
+	swapFee = pool.GetSwapFee(ctx)
+
+	steps = []query.SwapStepTrace{}
+	recordStep := func(step query.SwapStepTrace) {
+		steps = append(steps, step)
+	}
+
+	// writeCtx is never called so that the swap is not persisted.
+	_, tokenInSwapped, tokenOut, _, _, _, err = k.calcOutAmtGivenInWithTrace(ctx, tokenIn, tokenOutDenom, swapFee, sdk.ZeroDec(), poolId, recordStep)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Dec{}, sdk.Dec{}, nil, err
+	}
+
+	priceImpact = calcPriceImpact(pool.GetCurrentSqrtPrice(), tokenIn.Denom == pool.GetToken0(), steps)
+	return tokenInSwapped, tokenOut, swapFee, priceImpact, steps, nil
+}
+
+// calcPriceImpact returns one minus the ratio of the execution price of the given swap steps, excluding fees,
+// to the spot price given by sqrtPriceBefore. Returns zero if no amount was swapped.
+func calcPriceImpact(sqrtPriceBefore sdk.Dec, zeroForOne bool, steps []query.SwapStepTrace) sdk.Dec {
+	amountIn, amountOut := sdk.ZeroDec(), sdk.ZeroDec()
+	for _, step := range steps {
+		amountIn = amountIn.Add(step.AmountIn)
+		amountOut = amountOut.Add(step.AmountOut)
+	}
+
+	// the spot price is the amount of token1 per token0.
+	spotPrice := sqrtPriceBefore.Power(2)
+	if amountIn.IsZero() || spotPrice.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	expectedAmountOut := amountIn.Mul(spotPrice)
+	if !zeroForOne {
+		expectedAmountOut = amountIn.Quo(spotPrice)
+	}
+	if expectedAmountOut.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	return sdk.OneDec().Sub(amountOut.Quo(expectedAmountOut))
+}
+
 // updatePoolForSwap takes a pool, sender, and tokenIn, tokenOut amounts
 // It then updates the pool's balances to the new reserve amounts, and
 // sends the in tokens from the sender to the pool, and the out tokens from the pool to the sender.
@@ -641,9 +733,13 @@ func (k Keeper) updatePoolForSwap(
 		return err
 	}
 
-	// TODO: implement hooks
+	// Fill the limit orders that the swap moved the current tick past.
+	if err := k.fillLimitOrders(ctx, pool.GetId(), types.MaxLimitOrderFillsPerSwap); err != nil {
+		return err
+	}
+
 	events.EmitSwapEvent(ctx, sender, pool.GetId(), sdk.Coins{tokenIn}, sdk.Coins{tokenOut})
-	// k.hooks.AfterSwap(ctx, sender, pool.GetId(), tokenIn, tokenOut)
+	k.poolListeners.AfterSwap(ctx, sender, pool.GetId(), sdk.Coins{tokenIn}, sdk.Coins{tokenOut})
 
 	return err
 }
@@ -2298,6 +2298,84 @@ func (s *KeeperTestSuite) TestCalcOutAmtGivenInWriteCtx() {
 	}
 }
 
+// TestSimulateSwapExactAmountIn tests that simulating a swap returns the same amounts as calculating it,
+// that the returned steps trace the swap from the current sqrt price to the final one, and that the pool is not modified.
+func (s *KeeperTestSuite) TestSimulateSwapExactAmountIn() {
+	for name, test := range swapOutGivenInCases {
+		test := test
+		s.Run(name, func() {
+			s.Setup()
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewCoin("eth", sdk.NewInt(10000000000000)), sdk.NewCoin("usdc", sdk.NewInt(1000000000000))))
+			s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin("eth", sdk.NewInt(10000000000000)), sdk.NewCoin("usdc", sdk.NewInt(1000000000000))))
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+
+			// Create default CL pool
+			pool := s.PrepareConcentratedPool()
+
+			// add default position
+			s.SetupDefaultPosition(pool.GetId())
+
+			// add second position depending on the test
+			if !test.secondPositionLowerPrice.IsNil() {
+				newLowerTick, err := math.PriceToTick(test.secondPositionLowerPrice, DefaultExponentAtPriceOne)
+				s.Require().NoError(err)
+				newUpperTick, err := math.PriceToTick(test.secondPositionUpperPrice, DefaultExponentAtPriceOne)
+				s.Require().NoError(err)
+
+				_, _, _, _, _, err = clKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[1], DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), newLowerTick.Int64(), newUpperTick.Int64(), DefaultFreezeDuration)
+				s.Require().NoError(err)
+			}
+
+			poolBeforeCalc, err := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			// System under test
+			tokenIn, tokenOut, swapFee, priceImpact, steps, err := clKeeper.SimulateSwapExactAmountIn(s.Ctx, pool.GetId(), test.tokenIn, test.tokenOutDenom)
+
+			_, expectedTokenIn, expectedTokenOut, expectedTick, _, expectedSqrtPrice, expectedErr := clKeeper.CalcOutAmtGivenInInternal(
+				s.Ctx,
+				test.tokenIn, test.tokenOutDenom,
+				poolBeforeCalc.GetSwapFee(s.Ctx), sdk.ZeroDec(), pool.GetId())
+			if expectedErr != nil {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(expectedTokenIn, tokenIn)
+			s.Require().Equal(expectedTokenOut, tokenOut)
+			s.Require().Equal(poolBeforeCalc.GetSwapFee(s.Ctx), swapFee)
+			s.Require().False(priceImpact.IsNegative())
+
+			// the steps are contiguous, starting at the current sqrt price and ending at the final sqrt price.
+			s.Require().NotEmpty(steps)
+			s.Require().Equal(poolBeforeCalc.GetCurrentSqrtPrice(), steps[0].SqrtPriceStart)
+			for i := 1; i < len(steps); i++ {
+				s.Require().Equal(steps[i-1].SqrtPriceEnd, steps[i].SqrtPriceStart)
+			}
+			lastStep := steps[len(steps)-1]
+			s.Require().Equal(expectedSqrtPrice, lastStep.SqrtPriceEnd)
+			s.Require().Equal(expectedTick, lastStep.TickEnd)
+
+			// the steps account for the entire amounts swapped.
+			amountIn, amountOut := sdk.ZeroDec(), sdk.ZeroDec()
+			for _, step := range steps {
+				amountIn = amountIn.Add(step.AmountIn).Add(step.FeeCharge)
+				amountOut = amountOut.Add(step.AmountOut)
+			}
+			s.Require().Equal(tokenIn.Amount, amountIn.RoundInt())
+			s.Require().Equal(tokenOut.Amount, amountOut.TruncateInt())
+
+			// check that the pool has not been modified after simulating the swap
+			poolAfterCalc, err := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(poolBeforeCalc.GetCurrentSqrtPrice(), poolAfterCalc.GetCurrentSqrtPrice())
+			s.Require().Equal(poolBeforeCalc.GetCurrentTick(), poolAfterCalc.GetCurrentTick())
+			s.Require().Equal(poolBeforeCalc.GetLiquidity(), poolAfterCalc.GetLiquidity())
+		})
+	}
+}
+
 // TestCalcInAmtGivenOutWriteCtx tests that writeCtx succesfully perfroms state changes as expected.
 // We expect writeCtx to only change fee accum state, since pool state change is not handled via writeCtx function.
 func (s *KeeperTestSuite) TestCalcInAmtGivenOutWriteCtx() {
@@ -1,9 +1,12 @@
 package concentrated_liquidity
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/internal/math"
@@ -297,6 +300,149 @@ func (k Keeper) GetPerTickLiquidityDepthFromRange(ctx sdk.Context, poolId uint64
 	return liquidityDepths, nil
 }
 
+// GetTickLiquidityNetInDirection returns the liquidity net, tick index and price of the initialized ticks of the given pool,
+// walking from the current tick in the direction of a swap of tokenIn up to and including boundTick.
+// Swapping token0 in moves the current tick down, so the walk starts at the current tick inclusive
+// and moves towards lower ticks. Swapping token1 in moves the current tick up, so the walk starts
+// right above the current tick and moves towards higher ticks. If useNoBound is true, the pool's
+// min or max tick is used as the bound.
+// Results are paginated, with the pagination key being the encoding of the tick index to continue from.
+// Returns error if:
+// - the pool does not exist
+// - tokenIn is not one of the pool's assets
+// - boundTick is outside of the pool's tick range or not in the direction of the swap
+// - the pagination key is not within the walked range
+func (k Keeper) GetTickLiquidityNetInDirection(ctx sdk.Context, poolId uint64, tokenIn string, boundTick sdk.Int, useNoBound bool, pagination *sdkquery.PageRequest) ([]query.TickLiquidityNet, *sdkquery.PageResponse, error) {
+	p, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return []query.TickLiquidityNet{}, nil, err
+	}
+
+	if tokenIn != p.GetToken0() && tokenIn != p.GetToken1() {
+		return []query.TickLiquidityNet{}, nil, types.TokenInDenomNotInPoolError{TokenInDenom: tokenIn}
+	}
+	// if swapping token0 in, the current tick moves down.
+	zeroForOne := tokenIn == p.GetToken0()
+
+	exponentAtPriceOne := p.GetPrecisionFactorAtPriceOne()
+	minTick, maxTick := math.GetMinAndMaxTicksFromExponentAtPriceOneInternal(exponentAtPriceOne)
+	currentTick := p.GetCurrentTick().Int64()
+
+	// determine the inclusive range of ticks to walk over.
+	var lowerTick, upperTick int64
+	if zeroForOne {
+		lowerTick, upperTick = minTick, currentTick
+	} else {
+		lowerTick, upperTick = currentTick+1, maxTick
+	}
+	if !useNoBound {
+		if boundTick.IsNil() {
+			return []query.TickLiquidityNet{}, nil, types.ErrBoundTickNotSet
+		}
+		if boundTick.LT(sdk.NewInt(minTick)) || boundTick.GT(sdk.NewInt(maxTick)) {
+			return []query.TickLiquidityNet{}, nil, types.InvalidTickError{Tick: boundTick.Int64(), IsLower: zeroForOne, MinTick: minTick, MaxTick: maxTick}
+		}
+		if zeroForOne && boundTick.GT(p.GetCurrentTick()) || !zeroForOne && boundTick.LT(p.GetCurrentTick()) {
+			return []query.TickLiquidityNet{}, nil, types.BoundTickDirectionError{BoundTick: boundTick.Int64(), CurrentTick: currentTick, ZeroForOne: zeroForOne}
+		}
+		if zeroForOne {
+			lowerTick = boundTick.Int64()
+		} else {
+			upperTick = boundTick.Int64()
+		}
+	}
+
+	var (
+		key    []byte
+		offset uint64
+		limit  uint64
+	)
+	if pagination != nil {
+		key, offset, limit = pagination.Key, pagination.Offset, pagination.Limit
+	}
+	if len(key) != 0 && offset > 0 {
+		return []query.TickLiquidityNet{}, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	if limit == 0 {
+		limit = sdkquery.DefaultLimit
+	} else if limit > liquidityDepthRangeQueryLimit {
+		limit = liquidityDepthRangeQueryLimit
+	}
+
+	// continue from the tick given by the pagination key.
+	if len(key) != 0 {
+		keyTick, err := types.TickIndexFromBytes(key)
+		if err != nil {
+			return []query.TickLiquidityNet{}, nil, err
+		}
+		if keyTick < lowerTick || keyTick > upperTick {
+			return []query.TickLiquidityNet{}, nil, types.InvalidTickError{Tick: keyTick, IsLower: zeroForOne, MinTick: lowerTick, MaxTick: upperTick}
+		}
+		if zeroForOne {
+			upperTick = keyTick
+		} else {
+			lowerTick = keyTick
+		}
+	}
+
+	if lowerTick > upperTick {
+		return []query.TickLiquidityNet{}, &sdkquery.PageResponse{}, nil
+	}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyTickPrefixByPoolId(poolId))
+	lowerKey := types.TickIndexToBytes(lowerTick)
+	upperKey := storetypes.InclusiveEndBytes(types.TickIndexToBytes(upperTick))
+
+	var iterator sdk.Iterator
+	if zeroForOne {
+		iterator = prefixStore.ReverseIterator(lowerKey, upperKey)
+	} else {
+		iterator = prefixStore.Iterator(lowerKey, upperKey)
+	}
+	defer iterator.Close()
+
+	liquidityDepths := []query.TickLiquidityNet{}
+	var nextKey []byte
+	for skipped := uint64(0); iterator.Valid(); iterator.Next() {
+		if skipped < offset {
+			skipped++
+			continue
+		}
+		if uint64(len(liquidityDepths)) == limit {
+			nextKey = iterator.Key()
+			break
+		}
+
+		tickIndex, err := types.TickIndexFromBytes(iterator.Key())
+		if err != nil {
+			return []query.TickLiquidityNet{}, nil, err
+		}
+
+		tickInfo, err := ParseTickFromBz(iterator.Value())
+		if err != nil {
+			return []query.TickLiquidityNet{}, nil, err
+		}
+
+		price, err := math.TickToPrice(sdk.NewInt(tickIndex), exponentAtPriceOne)
+		if err != nil {
+			return []query.TickLiquidityNet{}, nil, err
+		}
+		sqrtPrice, err := price.ApproxSqrt()
+		if err != nil {
+			return []query.TickLiquidityNet{}, nil, err
+		}
+
+		liquidityDepths = append(liquidityDepths, query.TickLiquidityNet{
+			LiquidityNet: tickInfo.LiquidityNet,
+			TickIndex:    sdk.NewInt(tickIndex),
+			Price:        price,
+			SqrtPrice:    sqrtPrice,
+		})
+	}
+
+	return liquidityDepths, &sdkquery.PageResponse{NextKey: nextKey}, nil
+}
+
 func (k Keeper) getTickByTickIndex(ctx sdk.Context, poolId uint64, tickIndex sdk.Int) (model.TickInfo, error) {
 	store := ctx.KVStore(k.storeKey)
 	keyTick := types.KeyTick(poolId, tickIndex.Int64())
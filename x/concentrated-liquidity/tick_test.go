@@ -6,8 +6,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	cl "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/internal/math"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types/genesis"
@@ -878,6 +882,178 @@ func (s *KeeperTestSuite) TestGetLiquidityDepthFromIterator() {
 	}
 }
 
+func (s *KeeperTestSuite) TestGetTickLiquidityNetInDirection() {
+	// Initialized tickIndex -> liquidity net as following:
+	// -3 -> -30, 1 -> 10, 2 -> 20, 4 -> 40
+	// with the current tick at 1.
+	ticks := map[int64]sdk.Dec{
+		-3: sdk.NewDec(-30),
+		1:  sdk.NewDec(10),
+		2:  sdk.NewDec(20),
+		4:  sdk.NewDec(40),
+	}
+	currentTick := int64(1)
+
+	tests := map[string]struct {
+		poolId          uint64
+		tokenIn         string
+		boundTick       sdk.Int
+		useNoBound      bool
+		pagination      *sdkquery.PageRequest
+		expectedTicks   []int64
+		expectedNextKey []byte
+		expectedErr     error
+	}{
+		"zero for one, no bound: starts at the current tick inclusive": {
+			poolId:        1,
+			tokenIn:       ETH,
+			useNoBound:    true,
+			expectedTicks: []int64{1, -3},
+		},
+		"one for zero, no bound: starts above the current tick": {
+			poolId:        1,
+			tokenIn:       USDC,
+			useNoBound:    true,
+			expectedTicks: []int64{2, 4},
+		},
+		"zero for one, bound tick": {
+			poolId:        1,
+			tokenIn:       ETH,
+			boundTick:     sdk.ZeroInt(),
+			expectedTicks: []int64{1},
+		},
+		"one for zero, bound tick is inclusive": {
+			poolId:        1,
+			tokenIn:       USDC,
+			boundTick:     sdk.NewInt(2),
+			expectedTicks: []int64{2},
+		},
+		"one for zero, bound tick at the current tick": {
+			poolId:        1,
+			tokenIn:       USDC,
+			boundTick:     sdk.NewInt(currentTick),
+			expectedTicks: []int64{},
+		},
+		"one for zero, limit": {
+			poolId:          1,
+			tokenIn:         USDC,
+			useNoBound:      true,
+			pagination:      &sdkquery.PageRequest{Limit: 1},
+			expectedTicks:   []int64{2},
+			expectedNextKey: types.TickIndexToBytes(4),
+		},
+		"one for zero, key": {
+			poolId:        1,
+			tokenIn:       USDC,
+			useNoBound:    true,
+			pagination:    &sdkquery.PageRequest{Key: types.TickIndexToBytes(4), Limit: 1},
+			expectedTicks: []int64{4},
+		},
+		"zero for one, limit": {
+			poolId:          1,
+			tokenIn:         ETH,
+			useNoBound:      true,
+			pagination:      &sdkquery.PageRequest{Limit: 1},
+			expectedTicks:   []int64{1},
+			expectedNextKey: types.TickIndexToBytes(-3),
+		},
+		"zero for one, offset": {
+			poolId:        1,
+			tokenIn:       ETH,
+			useNoBound:    true,
+			pagination:    &sdkquery.PageRequest{Offset: 1},
+			expectedTicks: []int64{-3},
+		},
+		"error: token in not in pool": {
+			poolId:      1,
+			tokenIn:     "foo",
+			useNoBound:  true,
+			expectedErr: types.TokenInDenomNotInPoolError{TokenInDenom: "foo"},
+		},
+		"error: zero for one, bound tick above the current tick": {
+			poolId:      1,
+			tokenIn:     ETH,
+			boundTick:   sdk.NewInt(2),
+			expectedErr: types.BoundTickDirectionError{BoundTick: 2, CurrentTick: currentTick, ZeroForOne: true},
+		},
+		"error: one for zero, bound tick below the current tick": {
+			poolId:      1,
+			tokenIn:     USDC,
+			boundTick:   sdk.ZeroInt(),
+			expectedErr: types.BoundTickDirectionError{BoundTick: 0, CurrentTick: currentTick, ZeroForOne: false},
+		},
+		"error: bound tick not set": {
+			poolId:      1,
+			tokenIn:     ETH,
+			expectedErr: types.ErrBoundTickNotSet,
+		},
+		"error: one for zero, key outside of range": {
+			poolId:      1,
+			tokenIn:     USDC,
+			useNoBound:  true,
+			pagination:  &sdkquery.PageRequest{Key: types.TickIndexToBytes(-3)},
+			expectedErr: types.InvalidTickError{Tick: -3, IsLower: false, MinTick: currentTick + 1, MaxTick: DefaultMaxTick},
+		},
+		"error: pool does not exist": {
+			poolId:      2,
+			tokenIn:     ETH,
+			useNoBound:  true,
+			expectedErr: types.PoolNotFoundError{PoolId: 2},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+
+			pool := s.PrepareConcentratedPool()
+			pool.SetCurrentTick(sdk.NewInt(currentTick))
+			s.Require().NoError(clKeeper.SetPool(s.Ctx, pool))
+			for tickIndex, liquidityNet := range ticks {
+				clKeeper.SetTickInfo(s.Ctx, pool.GetId(), tickIndex, model.TickInfo{LiquidityNet: liquidityNet})
+			}
+
+			liquidityDepths, pageRes, err := clKeeper.GetTickLiquidityNetInDirection(s.Ctx, test.poolId, test.tokenIn, test.boundTick, test.useNoBound, test.pagination)
+			if test.expectedErr != nil {
+				s.Require().ErrorIs(err, test.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedNextKey, pageRes.NextKey)
+
+			s.Require().Len(liquidityDepths, len(test.expectedTicks))
+			for i, expectedTick := range test.expectedTicks {
+				expectedPrice, err := math.TickToPrice(sdk.NewInt(expectedTick), pool.GetPrecisionFactorAtPriceOne())
+				s.Require().NoError(err)
+				expectedSqrtPrice, err := math.TickToSqrtPrice(sdk.NewInt(expectedTick), pool.GetPrecisionFactorAtPriceOne())
+				s.Require().NoError(err)
+
+				s.Require().Equal(sdk.NewInt(expectedTick), liquidityDepths[i].TickIndex)
+				s.Require().Equal(ticks[expectedTick], liquidityDepths[i].LiquidityNet)
+				s.Require().Equal(expectedPrice, liquidityDepths[i].Price)
+				s.Require().Equal(expectedSqrtPrice, liquidityDepths[i].SqrtPrice)
+			}
+		})
+	}
+}
+
+// TestLiquidityNetInDirection_BoundTickNotSet tests that the query rejects requests that
+// neither set the bound tick nor use no bound.
+func (s *KeeperTestSuite) TestLiquidityNetInDirection_BoundTickNotSet() {
+	s.SetupTest()
+	s.PrepareConcentratedPool()
+	querier := cl.NewQuerier(*s.App.ConcentratedLiquidityKeeper)
+
+	_, err := querier.LiquidityNetInDirection(sdk.WrapSDKContext(s.Ctx), &query.QueryLiquidityNetInDirectionRequest{
+		PoolId:  1,
+		TokenIn: ETH,
+	})
+	s.Require().Error(err)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
 func (s *KeeperTestSuite) TestValidateTickRangeIsValid() {
 	// use 2 as default tick spacing
 	defaultTickSpacing := uint64(2)
@@ -6,15 +6,24 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*ConcentratedPoolExtension)(nil), nil)
 	cdc.RegisterConcrete(&MsgCreatePosition{}, "osmosis/cl-create-position", nil)
 	cdc.RegisterConcrete(&MsgWithdrawPosition{}, "osmosis/cl-withdraw-position", nil)
+	cdc.RegisterConcrete(&MsgWithdrawPositionById{}, "osmosis/cl-withdraw-position-by-id", nil)
+	cdc.RegisterConcrete(&MsgAddToPosition{}, "osmosis/cl-add-to-position", nil)
+	cdc.RegisterConcrete(&MsgTransferPositions{}, "osmosis/cl-transfer-positions", nil)
+	cdc.RegisterConcrete(&MsgPlaceLimitOrder{}, "osmosis/cl-place-limit-order", nil)
+	cdc.RegisterConcrete(&MsgClaimLimitOrder{}, "osmosis/cl-claim-limit-order", nil)
 	cdc.RegisterConcrete(&MsgCollectFees{}, "osmosis/cl-collect-fees", nil)
 	cdc.RegisterConcrete(&MsgCollectIncentives{}, "osmosis/cl-collect-incentives", nil)
+	cdc.RegisterConcrete(&MsgCollectFeesById{}, "osmosis/cl-collect-fees-by-id", nil)
+	cdc.RegisterConcrete(&MsgCollectIncentivesById{}, "osmosis/cl-collect-incentives-by-id", nil)
 	cdc.RegisterConcrete(&MsgCreateIncentive{}, "osmosis/cl-create-incentive", nil)
+	cdc.RegisterConcrete(&UpdateSwapFeeProposal{}, "osmosis/UpdateSwapFeeProposal", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -27,11 +36,23 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreatePosition{},
 		&MsgWithdrawPosition{},
+		&MsgWithdrawPositionById{},
+		&MsgAddToPosition{},
+		&MsgTransferPositions{},
+		&MsgPlaceLimitOrder{},
+		&MsgClaimLimitOrder{},
 		&MsgCollectFees{},
 		&MsgCollectIncentives{},
+		&MsgCollectFeesById{},
+		&MsgCollectIncentivesById{},
 		&MsgCreateIncentive{},
 	)
 
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&UpdateSwapFeeProposal{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
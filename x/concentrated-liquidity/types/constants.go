@@ -20,6 +20,11 @@ const (
 	MinTickNegThree, MaxTickNegThree   int64 = -162000, 342000
 	MinTickNegTwo, MaxTickNegTwo       int64 = -16200, 34200
 	MinTickNegOne, MaxTickNegOne       int64 = -1620, 3420
+
+	// MaxLimitOrderFillsPerSwap is the maximum number of limit orders filled at the end of a swap.
+	MaxLimitOrderFillsPerSwap = 100
+	// LimitOrderFillGasFee is the gas consumed for every limit order filled.
+	LimitOrderFillGasFee = 10_000
 )
 
 var (
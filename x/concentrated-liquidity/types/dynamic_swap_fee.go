@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate returns an error if the dynamic swap fee cannot be charged on top of
+// the given base swap fee.
+func (d DynamicSwapFee) Validate(baseSwapFee sdk.Dec) error {
+	if d.MaxSwapFee.IsNil() || d.MaxSwapFee.LT(baseSwapFee) || d.MaxSwapFee.GTE(sdk.OneDec()) {
+		return InvalidMaxSwapFeeError{MaxSwapFee: d.MaxSwapFee, BaseSwapFee: baseSwapFee}
+	}
+	if d.FeePerTick.IsNil() || d.FeePerTick.IsNegative() {
+		return NegativeFeePerTickError{FeePerTick: d.FeePerTick}
+	}
+	if d.VolatilityWindow <= 0 {
+		return NonPositiveVolatilityWindowError{VolatilityWindow: d.VolatilityWindow}
+	}
+	return nil
+}
+
+// SwapFee returns the base swap fee increased by the fee per tick for every tick moved,
+// capped at the max swap fee.
+func (d DynamicSwapFee) SwapFee(baseSwapFee sdk.Dec, ticksMoved sdk.Int) sdk.Dec {
+	swapFee := baseSwapFee.Add(d.FeePerTick.MulInt(ticksMoved.Abs()))
+	if swapFee.GT(d.MaxSwapFee) {
+		return d.MaxSwapFee
+	}
+	return swapFee
+}
@@ -0,0 +1,438 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentrated-liquidity/dynamic_swap_fee.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DynamicSwapFee configures a swap fee that increases with the tick movement
+// of a pool within a volatility window. The pool's swap_fee is the base fee
+// that is charged when the current tick has not moved.
+type DynamicSwapFee struct {
+	// max_swap_fee is the upper bound of the dynamic swap fee.
+	MaxSwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_swap_fee,json=maxSwapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_swap_fee" yaml:"max_swap_fee"`
+	// fee_per_tick is the swap fee added to the base fee for every tick the
+	// current tick moved away from the reference tick of the volatility window.
+	FeePerTick github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fee_per_tick,json=feePerTick,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_per_tick" yaml:"fee_per_tick"`
+	// volatility_window is the duration of a volatility window. The reference
+	// tick is reset to the current tick on the first swap after the window
+	// elapses.
+	VolatilityWindow time.Duration `protobuf:"bytes,3,opt,name=volatility_window,json=volatilityWindow,proto3,stdduration" json:"volatility_window" yaml:"volatility_window"`
+}
+
+func (m *DynamicSwapFee) Reset()         { *m = DynamicSwapFee{} }
+func (m *DynamicSwapFee) String() string { return proto.CompactTextString(m) }
+func (*DynamicSwapFee) ProtoMessage()    {}
+func (*DynamicSwapFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecec25c48b508419, []int{0}
+}
+func (m *DynamicSwapFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSwapFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSwapFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSwapFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSwapFee.Merge(m, src)
+}
+func (m *DynamicSwapFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSwapFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSwapFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSwapFee proto.InternalMessageInfo
+
+func (m *DynamicSwapFee) GetVolatilityWindow() time.Duration {
+	if m != nil {
+		return m.VolatilityWindow
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DynamicSwapFee)(nil), "osmosis.concentratedliquidity.v1beta1.DynamicSwapFee")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/dynamic_swap_fee.proto", fileDescriptor_ecec25c48b508419)
+}
+
+var fileDescriptor_ecec25c48b508419 = []byte{
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x4f, 0x4b, 0xe3, 0x40,
+	0x14, 0x4f, 0xba, 0xb0, 0xb0, 0xd9, 0x65, 0xd9, 0xad, 0x1e, 0x62, 0x0f, 0x49, 0x09, 0x2a, 0xbd,
+	0x74, 0x86, 0x2a, 0xbd, 0x78, 0x0c, 0xd5, 0xb3, 0x54, 0x41, 0x10, 0x21, 0x4c, 0x26, 0x2f, 0x71,
+	0x68, 0x92, 0x89, 0xc9, 0xa4, 0x69, 0xbe, 0x85, 0x47, 0x3f, 0x52, 0x8f, 0x3d, 0x8a, 0x87, 0x28,
+	0xed, 0x37, 0xe8, 0x27, 0x90, 0x26, 0x69, 0x0d, 0x88, 0x27, 0x4f, 0x33, 0xef, 0x0d, 0xbf, 0x3f,
+	0xef, 0x37, 0x4f, 0x19, 0xf2, 0x24, 0xe0, 0x09, 0x4b, 0x30, 0xe5, 0x21, 0x85, 0x50, 0xc4, 0x44,
+	0x80, 0xd3, 0xf7, 0xd9, 0x43, 0xca, 0x1c, 0x26, 0x72, 0xec, 0xe4, 0x21, 0x09, 0x18, 0xb5, 0x92,
+	0x8c, 0x44, 0x96, 0x0b, 0x80, 0xa2, 0x98, 0x0b, 0xde, 0x3e, 0xaa, 0x61, 0xa8, 0x09, 0xdb, 0xa1,
+	0xd0, 0x74, 0x60, 0x83, 0x20, 0x83, 0xce, 0xbe, 0xc7, 0x3d, 0x5e, 0x22, 0xf0, 0xe6, 0x56, 0x81,
+	0x3b, 0x9a, 0xc7, 0xb9, 0xe7, 0x03, 0x2e, 0x2b, 0x3b, 0x75, 0xb1, 0x93, 0xc6, 0x44, 0x30, 0x1e,
+	0x56, 0xef, 0xc6, 0xa2, 0xa5, 0xfc, 0x1d, 0x55, 0xba, 0x57, 0x19, 0x89, 0x2e, 0x00, 0xda, 0x9e,
+	0xf2, 0x27, 0x20, 0xb3, 0x9d, 0x0b, 0x55, 0xee, 0xca, 0xbd, 0x5f, 0xe6, 0xf9, 0xbc, 0xd0, 0xa5,
+	0x97, 0x42, 0x3f, 0xf6, 0x98, 0xb8, 0x4f, 0x6d, 0x44, 0x79, 0x80, 0x69, 0xe9, 0xac, 0x3e, 0xfa,
+	0x89, 0x33, 0xc1, 0x22, 0x8f, 0x20, 0x41, 0x23, 0xa0, 0xeb, 0x42, 0xdf, 0xcb, 0x49, 0xe0, 0x9f,
+	0x19, 0x4d, 0x2e, 0x63, 0xac, 0x04, 0x64, 0xd6, 0x10, 0x72, 0x01, 0xac, 0x08, 0x62, 0x4b, 0x30,
+	0x3a, 0x51, 0x5b, 0xdf, 0x13, 0x6a, 0x72, 0x19, 0x63, 0xc5, 0x05, 0xb8, 0x84, 0xf8, 0x9a, 0xd1,
+	0x49, 0xdb, 0x57, 0xfe, 0x4f, 0xb9, 0x4f, 0x04, 0xf3, 0x99, 0xc8, 0xad, 0x8c, 0x85, 0x0e, 0xcf,
+	0xd4, 0x1f, 0x5d, 0xb9, 0xf7, 0xfb, 0xe4, 0x00, 0x55, 0x01, 0xa1, 0x6d, 0x40, 0x68, 0x54, 0x07,
+	0x64, 0x1e, 0x6e, 0x8c, 0xac, 0x0b, 0x5d, 0xad, 0xe8, 0x3f, 0x31, 0x18, 0x4f, 0xaf, 0xba, 0x3c,
+	0xfe, 0xf7, 0xd1, 0xbf, 0x29, 0xdb, 0xe6, 0xdd, 0x7c, 0xa9, 0xc9, 0x8b, 0xa5, 0x26, 0xbf, 0x2d,
+	0x35, 0xf9, 0x71, 0xa5, 0x49, 0x8b, 0x95, 0x26, 0x3d, 0xaf, 0x34, 0xe9, 0xd6, 0x6c, 0x8c, 0x54,
+	0x7f, 0x6a, 0xdf, 0x27, 0x76, 0xb2, 0x2d, 0xf0, 0x74, 0x30, 0xc4, 0xb3, 0xaf, 0xd6, 0xa3, 0x1c,
+	0xd9, 0xfe, 0x59, 0x1a, 0x3d, 0x7d, 0x1f, 0x00, 0x0a, 0x5d, 0x5c, 0xad, 0x4d, 0x02, 0x00, 0x00,
+}
+
+func (m *DynamicSwapFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSwapFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSwapFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VolatilityWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VolatilityWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDynamicSwapFee(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FeePerTick.Size()
+		i -= size
+		if _, err := m.FeePerTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSwapFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxSwapFee.Size()
+		i -= size
+		if _, err := m.MaxSwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSwapFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDynamicSwapFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDynamicSwapFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DynamicSwapFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSwapFee.Size()
+	n += 1 + l + sovDynamicSwapFee(uint64(l))
+	l = m.FeePerTick.Size()
+	n += 1 + l + sovDynamicSwapFee(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VolatilityWindow)
+	n += 1 + l + sovDynamicSwapFee(uint64(l))
+	return n
+}
+
+func sovDynamicSwapFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDynamicSwapFee(x uint64) (n int) {
+	return sovDynamicSwapFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DynamicSwapFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDynamicSwapFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSwapFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSwapFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSwapFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSwapFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSwapFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSwapFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSwapFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSwapFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePerTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSwapFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDynamicSwapFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSwapFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VolatilityWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDynamicSwapFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDynamicSwapFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDynamicSwapFee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDynamicSwapFee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicSwapFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicSwapFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDynamicSwapFee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDynamicSwapFee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDynamicSwapFee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDynamicSwapFee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDynamicSwapFee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDynamicSwapFee = fmt.Errorf("proto: unexpected end of group")
+)
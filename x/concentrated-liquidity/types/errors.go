@@ -11,6 +11,8 @@ import (
 var (
 	ErrKeyNotFound = errors.New("key not found")
 	ErrValueParse  = errors.New("value parse error")
+
+	ErrBoundTickNotSet = errors.New("bound tick must be set when use no bound is false")
 )
 
 // x/concentrated-liquidity module sentinel errors.
@@ -381,3 +383,112 @@ type InvalidNextPositionIdError struct {
 func (e InvalidNextPositionIdError) Error() string {
 	return fmt.Sprintf("invalid next position id (%d), must be positive", e.NextPositionId)
 }
+
+type PositionIdNotFoundError struct {
+	PositionId uint64
+}
+
+func (e PositionIdNotFoundError) Error() string {
+	return fmt.Sprintf("position not found. position id (%d)", e.PositionId)
+}
+
+type PositionIdNotFoundForOwnerError struct {
+	PositionId uint64
+	Owner      string
+}
+
+func (e PositionIdNotFoundForOwnerError) Error() string {
+	return fmt.Sprintf("position id (%d) not found for owner (%s)", e.PositionId, e.Owner)
+}
+
+type TransferToSameOwnerError struct {
+	Owner string
+}
+
+func (e TransferToSameOwnerError) Error() string {
+	return fmt.Sprintf("cannot transfer positions to their current owner (%s)", e.Owner)
+}
+
+type LimitOrderNotFoundError struct {
+	PoolId     uint64
+	PositionId uint64
+	Owner      string
+}
+
+func (e LimitOrderNotFoundError) Error() string {
+	return fmt.Sprintf("limit order with position id (%d) not found in pool (%d) for owner (%s)", e.PositionId, e.PoolId, e.Owner)
+}
+
+type LimitOrderNotFilledError struct {
+	PositionId uint64
+}
+
+func (e LimitOrderNotFilledError) Error() string {
+	return fmt.Sprintf("limit order with position id (%d) is not filled yet", e.PositionId)
+}
+
+type LimitOrderInRangeError struct {
+	LowerTick   int64
+	UpperTick   int64
+	CurrentTick int64
+	TokenIn     string
+}
+
+func (e LimitOrderInRangeError) Error() string {
+	return fmt.Sprintf("limit order depositing (%s) must be entirely on one side of the current tick (%d) such that it only holds the deposited token, was lower tick (%d), upper tick (%d)", e.TokenIn, e.CurrentTick, e.LowerTick, e.UpperTick)
+}
+
+type PoolNotInitializedError struct {
+	PoolId uint64
+}
+
+func (e PoolNotInitializedError) Error() string {
+	return fmt.Sprintf("pool (%d) has no initial position and its price is not set", e.PoolId)
+}
+
+type UnauthorizedSwapFeeError struct {
+	ProvidedSwapFee    sdk.Dec
+	AuthorizedSwapFees []sdk.Dec
+}
+
+func (e UnauthorizedSwapFeeError) Error() string {
+	return fmt.Sprintf("provided swap fee (%s) is not one of the authorized swap fees (%s)", e.ProvidedSwapFee, e.AuthorizedSwapFees)
+}
+
+type InvalidMaxSwapFeeError struct {
+	MaxSwapFee  sdk.Dec
+	BaseSwapFee sdk.Dec
+}
+
+func (e InvalidMaxSwapFeeError) Error() string {
+	return fmt.Sprintf("max swap fee (%s) must be greater than or equal to the base swap fee (%s) and less than 1", e.MaxSwapFee, e.BaseSwapFee)
+}
+
+type NegativeFeePerTickError struct {
+	FeePerTick sdk.Dec
+}
+
+func (e NegativeFeePerTickError) Error() string {
+	return fmt.Sprintf("fee per tick (%s) must not be negative", e.FeePerTick)
+}
+
+type NonPositiveVolatilityWindowError struct {
+	VolatilityWindow time.Duration
+}
+
+func (e NonPositiveVolatilityWindowError) Error() string {
+	return fmt.Sprintf("volatility window (%s) must be positive", e.VolatilityWindow)
+}
+
+type BoundTickDirectionError struct {
+	BoundTick   int64
+	CurrentTick int64
+	ZeroForOne  bool
+}
+
+func (e BoundTickDirectionError) Error() string {
+	if e.ZeroForOne {
+		return fmt.Sprintf("bound tick (%d) must be less than or equal to the current tick (%d) when swapping token0 in", e.BoundTick, e.CurrentTick)
+	}
+	return fmt.Sprintf("bound tick (%d) must be greater than or equal to the current tick (%d) when swapping token1 in", e.BoundTick, e.CurrentTick)
+}
@@ -3,16 +3,24 @@ package types
 const (
 	TypeEvtCreatePosition    = "create_position"
 	TypeEvtWithdrawPosition  = "withdraw_position"
+	TypeEvtAddToPosition     = "add_to_position"
+	TypeEvtTransferPosition  = "transfer_position"
+	TypeEvtPlaceLimitOrder   = "place_limit_order"
+	TypeEvtFillLimitOrder    = "fill_limit_order"
+	TypeEvtClaimLimitOrder   = "claim_limit_order"
 	TypeEvtCollectFees       = "collect_fees"
 	TypeEvtCollectIncentives = "collect_incentives"
 	TypeEvtCreateIncentive   = "create_incentive"
+	TypeEvtUpdateSwapFee     = "update_swap_fee"
 
 	AttributeValueCategory         = ModuleName
 	AttributeKeyPositionId         = "position_id"
 	AttributeKeyPoolId             = "pool_id"
+	AttributeKeyNewOwner           = "new_owner"
 	AttributeAmount0               = "amount0"
 	AttributeAmount1               = "amount1"
 	AttributeKeySwapFee            = "swap_fee"
+	AttributeKeyDynamicSwapFee     = "dynamic_swap_fee"
 	AttributeKeyTokensIn           = "tokens_in"
 	AttributeKeyTokensOut          = "tokens_out"
 	AttributeLiquidity             = "liquidity"
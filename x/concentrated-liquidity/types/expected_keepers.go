@@ -20,4 +20,5 @@ type BankKeeper interface {
 type PoolManagerKeeper interface {
 	CreatePool(ctx sdk.Context, msg poolmanagertypes.CreatePoolMsg) (uint64, error)
 	GetNextPoolId(ctx sdk.Context) uint64
+	IsPoolPaused(ctx sdk.Context, poolId uint64) bool
 }
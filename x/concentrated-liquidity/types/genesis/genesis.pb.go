@@ -153,9 +153,10 @@ type GenesisState struct {
 	// params are all the parameters of the module
 	Params types1.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// pool data containining serialized pool struct and ticks.
-	PoolData       []*PoolData      `protobuf:"bytes,2,rep,name=pool_data,json=poolData,proto3" json:"pool_data,omitempty"`
-	Positions      []model.Position `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions"`
-	NextPositionId uint64           `protobuf:"varint,4,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
+	PoolData       []*PoolData        `protobuf:"bytes,2,rep,name=pool_data,json=poolData,proto3" json:"pool_data,omitempty"`
+	Positions      []model.Position   `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions"`
+	NextPositionId uint64             `protobuf:"varint,4,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
+	LimitOrders    []model.LimitOrder `protobuf:"bytes,5,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders" yaml:"limit_orders"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -219,6 +220,13 @@ func (m *GenesisState) GetNextPositionId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetLimitOrders() []model.LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*FullTick)(nil), "osmosis.concentratedliquidity.v1beta1.FullTick")
 	proto.RegisterType((*PoolData)(nil), "osmosis.concentratedliquidity.v1beta1.PoolData")
@@ -230,41 +238,43 @@ func init() {
 }
 
 var fileDescriptor_5c140d686ee6724a = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x93, 0x34, 0x5f, 0x32, 0xad, 0xaa, 0x7e, 0xa3, 0x20, 0x4c, 0x91, 0x9c, 0xc8, 0x52,
-	0xa5, 0x48, 0x25, 0xb6, 0xd2, 0xc2, 0x86, 0x1d, 0xe6, 0x4f, 0x41, 0x2c, 0x2a, 0xb7, 0x2b, 0x10,
-	0x8a, 0x26, 0xf6, 0xc4, 0x8c, 0xea, 0xcc, 0x84, 0xcc, 0xa4, 0x4a, 0xde, 0x82, 0x07, 0xe0, 0x31,
-	0xd8, 0xb2, 0xaf, 0x10, 0x8b, 0x2e, 0x59, 0x45, 0x28, 0x79, 0x83, 0x3c, 0x01, 0x9a, 0x3f, 0x02,
-	0x48, 0x28, 0xcd, 0x6e, 0xee, 0xdc, 0x73, 0xce, 0x3d, 0x73, 0xef, 0xb5, 0xc1, 0x03, 0xc6, 0x87,
-	0x8c, 0x13, 0x1e, 0x26, 0x8c, 0x26, 0x98, 0x8a, 0x31, 0x12, 0x38, 0x6d, 0xe7, 0xe4, 0xc3, 0x84,
-	0xa4, 0x44, 0xcc, 0xc2, 0x0c, 0x53, 0xcc, 0x09, 0x0f, 0x46, 0x63, 0x26, 0x18, 0x3c, 0x32, 0xe8,
-	0xe0, 0x77, 0xf4, 0x2f, 0x70, 0x70, 0xd5, 0xe9, 0x63, 0x81, 0x3a, 0x87, 0xf5, 0x8c, 0x65, 0x4c,
-	0x31, 0x42, 0x79, 0xd2, 0xe4, 0xc3, 0x7b, 0x89, 0x62, 0xf7, 0x74, 0x42, 0x07, 0x36, 0x95, 0x31,
-	0x96, 0xe5, 0x38, 0x54, 0x51, 0x7f, 0x32, 0x08, 0x11, 0x9d, 0x99, 0xd4, 0xf1, 0x06, 0x83, 0x23,
-	0x34, 0x46, 0x43, 0xab, 0xd3, 0xde, 0x04, 0x66, 0x9c, 0x08, 0xc2, 0xe8, 0x2d, 0xe1, 0x82, 0x24,
-	0x97, 0x5d, 0x3a, 0x30, 0x0f, 0xf0, 0xbf, 0x39, 0xa0, 0xfa, 0x62, 0x92, 0xe7, 0x17, 0x24, 0xb9,
-	0x84, 0xc7, 0xe0, 0xbf, 0x11, 0x63, 0x79, 0x8f, 0xa4, 0xae, 0xd3, 0x74, 0x5a, 0xe5, 0x08, 0xae,
-	0xe6, 0x8d, 0xfd, 0x19, 0x1a, 0xe6, 0x8f, 0x7d, 0x93, 0xf0, 0xe3, 0x8a, 0x3c, 0x75, 0x53, 0xf8,
-	0x10, 0x00, 0xa9, 0xd5, 0x23, 0x34, 0xc5, 0x53, 0xb7, 0xd8, 0x74, 0x5a, 0xa5, 0xe8, 0xce, 0x6a,
-	0xde, 0xf8, 0x5f, 0xe3, 0xd7, 0x39, 0x3f, 0xae, 0xe9, 0xa2, 0x29, 0x9e, 0xc2, 0x77, 0xa0, 0x4c,
-	0xe8, 0x80, 0xb9, 0xa5, 0xa6, 0xd3, 0xda, 0x3d, 0x09, 0x83, 0x5b, 0x35, 0x3f, 0xb8, 0x30, 0xa6,
-	0x23, 0xf7, 0x7a, 0xde, 0x28, 0xac, 0xe6, 0x8d, 0x83, 0x3f, 0x8a, 0x0c, 0x98, 0x1f, 0x2b, 0x59,
-	0xff, 0x93, 0x03, 0xaa, 0x67, 0x8c, 0xe5, 0xcf, 0x90, 0x40, 0xf0, 0x14, 0x94, 0xa5, 0x57, 0xf5,
-	0x96, 0xdd, 0x93, 0x7a, 0xa0, 0x07, 0x12, 0xd8, 0x81, 0x04, 0x4f, 0xe8, 0x2c, 0xaa, 0x7d, 0xfd,
-	0xdc, 0xde, 0x91, 0x8c, 0x6e, 0xac, 0xc0, 0xf0, 0x2d, 0xd8, 0x91, 0xaa, 0xdc, 0x2d, 0x36, 0x4b,
-	0x5b, 0x38, 0xb4, 0x3d, 0x8c, 0xea, 0xc6, 0xe1, 0xde, 0xda, 0x21, 0xf7, 0x63, 0xad, 0xe9, 0x7f,
-	0x29, 0x82, 0xbd, 0x97, 0x7a, 0xfb, 0xce, 0x05, 0x12, 0x18, 0x3e, 0x05, 0x15, 0x3d, 0x6c, 0x63,
-	0xf2, 0x68, 0x43, 0xb9, 0x33, 0x05, 0x8e, 0xca, 0xb2, 0x48, 0x6c, 0xa8, 0xf0, 0x35, 0xa8, 0xa9,
-	0xe9, 0xa4, 0x48, 0xa0, 0x2d, 0x6d, 0xdb, 0x5e, 0xc5, 0xd5, 0x91, 0xed, 0xda, 0xb9, 0x54, 0xd3,
-	0x2b, 0xc5, 0xdd, 0xd2, 0x96, 0x6a, 0x9a, 0x67, 0xfc, 0xad, 0x75, 0xe0, 0x73, 0x70, 0x40, 0xf1,
-	0x54, 0xf4, 0xec, 0x8d, 0x5c, 0xb1, 0xb2, 0x5a, 0xb1, 0xfb, 0xab, 0x79, 0xe3, 0xae, 0xee, 0xd5,
-	0xdf, 0x08, 0x3f, 0xde, 0x97, 0x57, 0x56, 0xb5, 0x9b, 0x46, 0xe9, 0xf5, 0xc2, 0x73, 0x6e, 0x16,
-	0x9e, 0xf3, 0x63, 0xe1, 0x39, 0x1f, 0x97, 0x5e, 0xe1, 0x66, 0xe9, 0x15, 0xbe, 0x2f, 0xbd, 0xc2,
-	0x9b, 0x57, 0x19, 0x11, 0xef, 0x27, 0xfd, 0x20, 0x61, 0xc3, 0xd0, 0x98, 0x6d, 0xe7, 0xa8, 0xcf,
-	0x6d, 0x10, 0x5e, 0x75, 0x1e, 0x85, 0xd3, 0x7f, 0x7e, 0x14, 0xb3, 0x11, 0xe6, 0xf6, 0xbf, 0xd0,
-	0xaf, 0xa8, 0x0d, 0x39, 0xfd, 0x19, 0x00, 0x00, 0xff, 0xff, 0x87, 0x02, 0xd1, 0xd3, 0x48, 0x04,
-	0x00, 0x00,
+	// 574 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xda, 0x4c,
+	0x14, 0xc5, 0xe1, 0xe7, 0x83, 0x01, 0x45, 0xf9, 0x5c, 0xaa, 0xba, 0x89, 0x64, 0x90, 0xa5, 0x48,
+	0x48, 0x29, 0x76, 0x49, 0xda, 0x4d, 0x77, 0x75, 0xff, 0x44, 0x15, 0xa9, 0x91, 0x93, 0x55, 0xab,
+	0x0a, 0x0d, 0xf6, 0xe0, 0x8e, 0x62, 0x3c, 0x84, 0x19, 0x22, 0x78, 0x8b, 0x3e, 0x40, 0x1f, 0xa2,
+	0x8b, 0x3e, 0x44, 0x54, 0x75, 0x91, 0x65, 0x57, 0xa8, 0x82, 0x37, 0xe0, 0x09, 0xaa, 0xf9, 0x0b,
+	0xb4, 0x52, 0x05, 0xec, 0x7c, 0xe7, 0x9e, 0x73, 0xee, 0x99, 0x7b, 0xef, 0x18, 0x3c, 0x22, 0xb4,
+	0x4f, 0x28, 0xa6, 0x5e, 0x48, 0xd2, 0x10, 0xa5, 0x6c, 0x08, 0x19, 0x8a, 0x9a, 0x09, 0xbe, 0x1a,
+	0xe1, 0x08, 0xb3, 0x89, 0x17, 0xa3, 0x14, 0x51, 0x4c, 0xdd, 0xc1, 0x90, 0x30, 0x62, 0x1e, 0x2a,
+	0xb4, 0xbb, 0x8a, 0xbe, 0x03, 0xbb, 0xd7, 0xad, 0x2e, 0x62, 0xb0, 0xb5, 0x5f, 0x8d, 0x49, 0x4c,
+	0x04, 0xc3, 0xe3, 0x5f, 0x92, 0xbc, 0xff, 0x30, 0x14, 0xec, 0x8e, 0x4c, 0xc8, 0x40, 0xa7, 0x62,
+	0x42, 0xe2, 0x04, 0x79, 0x22, 0xea, 0x8e, 0x7a, 0x1e, 0x4c, 0x27, 0x2a, 0x75, 0xb4, 0xc6, 0xe0,
+	0x00, 0x0e, 0x61, 0x5f, 0xeb, 0x3c, 0x5e, 0x03, 0x4e, 0x70, 0x1f, 0xb3, 0x0e, 0x19, 0x46, 0x68,
+	0xa8, 0x18, 0xcd, 0x75, 0xf2, 0x84, 0x62, 0x86, 0x49, 0xba, 0x21, 0x9c, 0xe1, 0xf0, 0xb2, 0x9d,
+	0xf6, 0xd4, 0x95, 0x9d, 0x1f, 0x06, 0x28, 0xbe, 0x1e, 0x25, 0xc9, 0x05, 0x0e, 0x2f, 0xcd, 0x23,
+	0xf0, 0xdf, 0x80, 0x90, 0xa4, 0x83, 0x23, 0xcb, 0xa8, 0x1b, 0x8d, 0x9c, 0x6f, 0x2e, 0xa6, 0xb5,
+	0xdd, 0x09, 0xec, 0x27, 0xcf, 0x1c, 0x95, 0x70, 0x82, 0x02, 0xff, 0x6a, 0x47, 0xe6, 0x13, 0x00,
+	0xb8, 0x56, 0x07, 0xa7, 0x11, 0x1a, 0x5b, 0x3b, 0x75, 0xa3, 0x91, 0xf5, 0xef, 0x2f, 0xa6, 0xb5,
+	0xff, 0x25, 0x7e, 0x99, 0x73, 0x82, 0x92, 0x2c, 0x1a, 0xa1, 0xb1, 0xf9, 0x11, 0xe4, 0x70, 0xda,
+	0x23, 0x56, 0xb6, 0x6e, 0x34, 0xca, 0xc7, 0x9e, 0xbb, 0xd1, 0xb8, 0xdc, 0x0b, 0x65, 0xda, 0xb7,
+	0x6e, 0xa6, 0xb5, 0xcc, 0x62, 0x5a, 0xdb, 0xfb, 0xa3, 0x48, 0x8f, 0x38, 0x81, 0x90, 0x75, 0xbe,
+	0x18, 0xa0, 0x78, 0x46, 0x48, 0xf2, 0x12, 0x32, 0x68, 0x9e, 0x80, 0x1c, 0xf7, 0x2a, 0xee, 0x52,
+	0x3e, 0xae, 0xba, 0x72, 0x84, 0xae, 0x1e, 0xa1, 0xfb, 0x3c, 0x9d, 0xf8, 0xa5, 0xef, 0xdf, 0x9a,
+	0x79, 0xce, 0x68, 0x07, 0x02, 0x6c, 0x7e, 0x00, 0x79, 0xae, 0x4a, 0xad, 0x9d, 0x7a, 0x76, 0x0b,
+	0x87, 0xba, 0x87, 0x7e, 0x55, 0x39, 0xac, 0x2c, 0x1d, 0x52, 0x27, 0x90, 0x9a, 0xce, 0xd7, 0x2c,
+	0xa8, 0xbc, 0x91, 0xfb, 0x7a, 0xce, 0x20, 0x43, 0xe6, 0x0b, 0x50, 0x90, 0xeb, 0xa1, 0x4c, 0x1e,
+	0xae, 0x29, 0x77, 0x26, 0xc0, 0x7e, 0x8e, 0x17, 0x09, 0x14, 0xd5, 0x3c, 0x05, 0x25, 0x31, 0x9d,
+	0x08, 0x32, 0xb8, 0xa5, 0x6d, 0xdd, 0xab, 0xa0, 0x38, 0xd0, 0x5d, 0x3b, 0x07, 0x25, 0xbd, 0x52,
+	0xd4, 0xca, 0x6e, 0xa9, 0x26, 0x79, 0xca, 0xdf, 0x52, 0xc7, 0x7c, 0x05, 0xf6, 0x52, 0x34, 0x66,
+	0x1d, 0x7d, 0xc2, 0x57, 0x2c, 0x27, 0x56, 0xec, 0x60, 0x31, 0xad, 0x3d, 0x90, 0xbd, 0xfa, 0x1b,
+	0xe1, 0x04, 0xbb, 0xfc, 0x48, 0xab, 0xb6, 0x23, 0xf3, 0x0a, 0x54, 0x56, 0x1e, 0x08, 0xb5, 0xf2,
+	0xc2, 0x5e, 0x6b, 0x43, 0x7b, 0xa7, 0x9c, 0xfa, 0x8e, 0x33, 0xfd, 0x03, 0x35, 0xa5, 0x7b, 0xb2,
+	0xf2, 0xaa, 0xa8, 0x13, 0x94, 0x93, 0x3b, 0x20, 0xf5, 0xa3, 0x9b, 0x99, 0x6d, 0xdc, 0xce, 0x6c,
+	0xe3, 0xd7, 0xcc, 0x36, 0x3e, 0xcf, 0xed, 0xcc, 0xed, 0xdc, 0xce, 0xfc, 0x9c, 0xdb, 0x99, 0xf7,
+	0x6f, 0x63, 0xcc, 0x3e, 0x8d, 0xba, 0x6e, 0x48, 0xfa, 0x9e, 0x32, 0xd0, 0x4c, 0x60, 0x97, 0xea,
+	0xc0, 0xbb, 0x6e, 0x3d, 0xf5, 0xc6, 0xff, 0x7c, 0x87, 0x93, 0x01, 0xa2, 0xfa, 0xe7, 0xd5, 0x2d,
+	0x88, 0xa5, 0x3c, 0xf9, 0x3d, 0x00, 0x08, 0xc8, 0xdb, 0xbc, 0xed, 0x04, 0x00, 0x00,
 }
 
 func (m *FullTick) Marshal() (dAtA []byte, err error) {
@@ -379,6 +389,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.NextPositionId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPositionId))
 		i--
@@ -495,6 +519,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextPositionId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextPositionId))
 	}
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -894,6 +924,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, model.LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
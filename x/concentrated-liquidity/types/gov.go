@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeUpdateSwapFee = "UpdateSwapFee"
+)
+
+// Init registers the proposal to update the swap fee of a concentrated liquidity pool.
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateSwapFee)
+	govtypes.RegisterProposalTypeCodec(&UpdateSwapFeeProposal{}, "osmosis/UpdateSwapFeeProposal")
+}
+
+var _ govtypes.Content = &UpdateSwapFeeProposal{}
+
+// NewUpdateSwapFeeProposal returns a new instance of an update swap fee proposal struct.
+func NewUpdateSwapFeeProposal(title, description string, poolId uint64, swapFee sdk.Dec, dynamicSwapFee *DynamicSwapFee) govtypes.Content {
+	return &UpdateSwapFeeProposal{
+		Title:          title,
+		Description:    description,
+		PoolId:         poolId,
+		SwapFee:        swapFee,
+		DynamicSwapFee: dynamicSwapFee,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *UpdateSwapFeeProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *UpdateSwapFeeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *UpdateSwapFeeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *UpdateSwapFeeProposal) ProposalType() string {
+	return ProposalTypeUpdateSwapFee
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *UpdateSwapFeeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.PoolId == 0 {
+		return fmt.Errorf("pool id must be positive")
+	}
+	if p.SwapFee.IsNil() || p.SwapFee.IsNegative() || p.SwapFee.GTE(sdk.OneDec()) {
+		return InvalidSwapFeeError{ActualFee: p.SwapFee}
+	}
+	if p.DynamicSwapFee != nil {
+		return p.DynamicSwapFee.Validate(p.SwapFee)
+	}
+
+	return nil
+}
+
+// String returns a string containing the update swap fee proposal.
+func (p UpdateSwapFeeProposal) String() string {
+	dynamicSwapFeeStr := "disabled"
+	if p.DynamicSwapFee != nil {
+		dynamicSwapFeeStr = fmt.Sprintf("(MaxSwapFee: %s, FeePerTick: %s, VolatilityWindow: %s)", p.DynamicSwapFee.MaxSwapFee, p.DynamicSwapFee.FeePerTick, p.DynamicSwapFee.VolatilityWindow)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Swap Fee Proposal:
+  Title:          %s
+  Description:    %s
+  PoolId:         %d
+  SwapFee:        %s
+  DynamicSwapFee: %s
+`, p.Title, p.Description, p.PoolId, p.SwapFee, dynamicSwapFeeStr))
+	return b.String()
+}
@@ -0,0 +1,538 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentrated-liquidity/gov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UpdateSwapFeeProposal is a gov Content type for updating the swap fee of a
+// concentrated liquidity pool. The swap fee must be one of the authorized swap
+// fees. If dynamic_swap_fee is set, the pool charges a dynamic swap fee with
+// swap_fee as its base fee. Otherwise, dynamic swap fees are disabled for the
+// pool.
+type UpdateSwapFeeProposal struct {
+	Title          string                                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolId         uint64                                 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	SwapFee        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	DynamicSwapFee *DynamicSwapFee                        `protobuf:"bytes,5,opt,name=dynamic_swap_fee,json=dynamicSwapFee,proto3" json:"dynamic_swap_fee,omitempty" yaml:"dynamic_swap_fee"`
+}
+
+func (m *UpdateSwapFeeProposal) Reset()      { *m = UpdateSwapFeeProposal{} }
+func (*UpdateSwapFeeProposal) ProtoMessage() {}
+func (*UpdateSwapFeeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6d167276ceeedc2, []int{0}
+}
+func (m *UpdateSwapFeeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateSwapFeeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateSwapFeeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateSwapFeeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSwapFeeProposal.Merge(m, src)
+}
+func (m *UpdateSwapFeeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateSwapFeeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSwapFeeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSwapFeeProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*UpdateSwapFeeProposal)(nil), "osmosis.concentratedliquidity.v1beta1.UpdateSwapFeeProposal")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/gov.proto", fileDescriptor_e6d167276ceeedc2)
+}
+
+var fileDescriptor_e6d167276ceeedc2 = []byte{
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0x4f, 0xeb, 0xd3, 0x40,
+	0x10, 0xcd, 0xd6, 0xfe, 0xd1, 0x54, 0xaa, 0x84, 0x8a, 0xa1, 0x42, 0x36, 0x04, 0x94, 0x80, 0x34,
+	0xa1, 0x4a, 0x2f, 0xbd, 0x19, 0x8a, 0xe0, 0x4d, 0x22, 0x5e, 0xa4, 0x50, 0x36, 0xd9, 0x35, 0x2e,
+	0x26, 0xd9, 0x35, 0xbb, 0x6d, 0x0d, 0xf8, 0x01, 0x3c, 0x7a, 0xf4, 0xd8, 0x8f, 0xd3, 0x63, 0x8f,
+	0xe2, 0x21, 0x48, 0x0b, 0xe2, 0xb9, 0x9f, 0x40, 0x9a, 0xa4, 0x52, 0x0b, 0x3f, 0x7e, 0xbf, 0xd3,
+	0xce, 0xec, 0xbc, 0xf7, 0x66, 0xde, 0x8c, 0x6a, 0x33, 0x91, 0x30, 0x41, 0x85, 0x1b, 0xb2, 0x34,
+	0x24, 0xa9, 0xcc, 0x90, 0x24, 0x78, 0x18, 0xd3, 0x4f, 0x0b, 0x8a, 0xa9, 0xcc, 0xdd, 0x88, 0x2d,
+	0x1d, 0x9e, 0x31, 0xc9, 0xb4, 0xc7, 0x35, 0xd2, 0x39, 0x47, 0xfe, 0x03, 0x3a, 0xcb, 0x51, 0x40,
+	0x24, 0x1a, 0x0d, 0xfa, 0x11, 0x8b, 0x58, 0xc9, 0x70, 0x8f, 0x51, 0x45, 0x1e, 0x8c, 0xaf, 0x69,
+	0x83, 0xf3, 0x14, 0x25, 0x34, 0x9c, 0x8b, 0x15, 0xe2, 0xf3, 0xf7, 0x84, 0x54, 0x34, 0xeb, 0x77,
+	0x43, 0x7d, 0xf0, 0x96, 0x63, 0x24, 0xc9, 0x9b, 0x15, 0xe2, 0x2f, 0x09, 0x79, 0x9d, 0x31, 0xce,
+	0x04, 0x8a, 0xb5, 0xbe, 0xda, 0x92, 0x54, 0xc6, 0x44, 0x07, 0x26, 0xb0, 0xef, 0xf8, 0x55, 0xa2,
+	0x99, 0x6a, 0x17, 0x13, 0x11, 0x66, 0x94, 0x4b, 0xca, 0x52, 0xbd, 0x51, 0xd6, 0xce, 0xbf, 0xb4,
+	0xa7, 0x6a, 0x87, 0x33, 0x16, 0xcf, 0x29, 0xd6, 0x6f, 0x99, 0xc0, 0x6e, 0x7a, 0xda, 0xa1, 0x80,
+	0xbd, 0x1c, 0x25, 0xf1, 0xc4, 0xaa, 0x0b, 0x96, 0xdf, 0x3e, 0x46, 0xaf, 0xb0, 0x36, 0x53, 0x6f,
+	0x9f, 0x06, 0xd2, 0x9b, 0x47, 0x2d, 0xef, 0xc5, 0xa6, 0x80, 0xca, 0xcf, 0x02, 0x3e, 0x89, 0xa8,
+	0xfc, 0xb0, 0x08, 0x9c, 0x90, 0x25, 0x6e, 0x58, 0x7a, 0xab, 0x9f, 0xa1, 0xc0, 0x1f, 0x5d, 0x99,
+	0x73, 0x22, 0x9c, 0x29, 0x09, 0x0f, 0x05, 0xbc, 0x57, 0x69, 0x9f, 0x74, 0x2c, 0xbf, 0x23, 0x2a,
+	0x2b, 0xda, 0x17, 0xf5, 0xfe, 0xa5, 0x6d, 0xbd, 0x65, 0x02, 0xbb, 0xfb, 0x6c, 0xec, 0xdc, 0x68,
+	0xd7, 0xce, 0xb4, 0xa2, 0xd7, 0xbb, 0xf1, 0x1e, 0x1d, 0x0a, 0xf8, 0xb0, 0x6a, 0x77, 0x29, 0x6c,
+	0xf9, 0x3d, 0xfc, 0x1f, 0x78, 0x72, 0xf7, 0xeb, 0x1a, 0x2a, 0xdf, 0xd7, 0x50, 0xf9, 0xb3, 0x86,
+	0xc0, 0x9b, 0x6d, 0x76, 0x06, 0xd8, 0xee, 0x0c, 0xf0, 0x6b, 0x67, 0x80, 0x6f, 0x7b, 0x43, 0xd9,
+	0xee, 0x0d, 0xe5, 0xc7, 0xde, 0x50, 0xde, 0x79, 0x67, 0x4e, 0xeb, 0xa9, 0x86, 0x31, 0x0a, 0xc4,
+	0x29, 0x71, 0x97, 0xa3, 0xb1, 0xfb, 0xf9, 0xaa, 0xbb, 0x96, 0x9b, 0x08, 0xda, 0xe5, 0x35, 0x9f,
+	0xff, 0x1d, 0x00, 0x1c, 0x99, 0xf3, 0x4a, 0x6d, 0x02, 0x00, 0x00,
+}
+
+func (this *UpdateSwapFeeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateSwapFeeProposal)
+	if !ok {
+		that2, ok := that.(UpdateSwapFeeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.SwapFee.Equal(that1.SwapFee) {
+		return false
+	}
+	if !this.DynamicSwapFee.Equal(that1.DynamicSwapFee) {
+		return false
+	}
+	return true
+}
+func (m *UpdateSwapFeeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateSwapFeeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateSwapFeeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DynamicSwapFee != nil {
+		{
+			size, err := m.DynamicSwapFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpdateSwapFeeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if m.DynamicSwapFee != nil {
+		l = m.DynamicSwapFee.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdateSwapFeeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateSwapFeeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateSwapFeeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicSwapFee == nil {
+				m.DynamicSwapFee = &DynamicSwapFee{}
+			}
+			if err := m.DynamicSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+var validDynamicSwapFee = &types.DynamicSwapFee{
+	MaxSwapFee:       sdk.MustNewDecFromStr("0.05"),
+	FeePerTick:       sdk.MustNewDecFromStr("0.000001"),
+	VolatilityWindow: time.Hour,
+}
+
+func TestUpdateSwapFeeProposalValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		proposal    *types.UpdateSwapFeeProposal
+		expectedErr error
+	}{
+		"valid without dynamic swap fee": {
+			proposal: &types.UpdateSwapFeeProposal{Title: "title", Description: "description", PoolId: 1, SwapFee: sdk.MustNewDecFromStr("0.003")},
+		},
+		"valid with dynamic swap fee": {
+			proposal: &types.UpdateSwapFeeProposal{Title: "title", Description: "description", PoolId: 1, SwapFee: sdk.MustNewDecFromStr("0.003"), DynamicSwapFee: validDynamicSwapFee},
+		},
+		"invalid: negative swap fee": {
+			proposal:    &types.UpdateSwapFeeProposal{Title: "title", Description: "description", PoolId: 1, SwapFee: sdk.MustNewDecFromStr("-0.003")},
+			expectedErr: types.InvalidSwapFeeError{ActualFee: sdk.MustNewDecFromStr("-0.003")},
+		},
+		"invalid: swap fee of one": {
+			proposal:    &types.UpdateSwapFeeProposal{Title: "title", Description: "description", PoolId: 1, SwapFee: sdk.OneDec()},
+			expectedErr: types.InvalidSwapFeeError{ActualFee: sdk.OneDec()},
+		},
+		"invalid: max swap fee below swap fee": {
+			proposal:    &types.UpdateSwapFeeProposal{Title: "title", Description: "description", PoolId: 1, SwapFee: sdk.MustNewDecFromStr("0.1"), DynamicSwapFee: validDynamicSwapFee},
+			expectedErr: types.InvalidMaxSwapFeeError{MaxSwapFee: validDynamicSwapFee.MaxSwapFee, BaseSwapFee: sdk.MustNewDecFromStr("0.1")},
+		},
+		"invalid: negative fee per tick": {
+			proposal: &types.UpdateSwapFeeProposal{Title: "title", Description: "description", PoolId: 1, SwapFee: sdk.MustNewDecFromStr("0.003"), DynamicSwapFee: &types.DynamicSwapFee{
+				MaxSwapFee:       validDynamicSwapFee.MaxSwapFee,
+				FeePerTick:       sdk.MustNewDecFromStr("-0.000001"),
+				VolatilityWindow: validDynamicSwapFee.VolatilityWindow,
+			}},
+			expectedErr: types.NegativeFeePerTickError{FeePerTick: sdk.MustNewDecFromStr("-0.000001")},
+		},
+		"invalid: zero volatility window": {
+			proposal: &types.UpdateSwapFeeProposal{Title: "title", Description: "description", PoolId: 1, SwapFee: sdk.MustNewDecFromStr("0.003"), DynamicSwapFee: &types.DynamicSwapFee{
+				MaxSwapFee: validDynamicSwapFee.MaxSwapFee,
+				FeePerTick: validDynamicSwapFee.FeePerTick,
+			}},
+			expectedErr: types.NonPositiveVolatilityWindowError{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// missing pool id
+	err := (&types.UpdateSwapFeeProposal{Title: "title", Description: "description", SwapFee: sdk.ZeroDec()}).ValidateBasic()
+	require.Error(t, err)
+
+	// missing title
+	err = (&types.UpdateSwapFeeProposal{Description: "description", PoolId: 1, SwapFee: sdk.ZeroDec()}).ValidateBasic()
+	require.Error(t, err)
+}
+
+func TestUpdateSwapFeeProposalMarshalUnmarshal(t *testing.T) {
+	tests := []struct {
+		proposal *types.UpdateSwapFeeProposal
+	}{
+		{ // without dynamic swap fee
+			proposal: &types.UpdateSwapFeeProposal{
+				Title:       "title",
+				Description: "proposal to update swap fee",
+				PoolId:      1,
+				SwapFee:     sdk.MustNewDecFromStr("0.003"),
+			},
+		},
+		{ // with dynamic swap fee
+			proposal: &types.UpdateSwapFeeProposal{
+				Title:          "title",
+				Description:    "proposal to update swap fee",
+				PoolId:         1,
+				SwapFee:        sdk.MustNewDecFromStr("0.003"),
+				DynamicSwapFee: validDynamicSwapFee,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		bz, err := proto.Marshal(test.proposal)
+		require.NoError(t, err)
+		decoded := types.UpdateSwapFeeProposal{}
+		err = proto.Unmarshal(bz, &decoded)
+		require.NoError(t, err)
+		require.Equal(t, *test.proposal, decoded)
+	}
+}
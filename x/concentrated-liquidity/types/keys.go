@@ -33,6 +33,11 @@ var (
 
 	KeyNextGlobalPositionId = []byte{0x07}
 
+	LimitOrderPrefix         = []byte{0x08}
+	OpenLimitOrderTickPrefix = []byte{0x09}
+
+	PositionIdPrefix = []byte{0x0A}
+
 	// prefix, pool id, sign byte, tick index
 	TickKeyLengthBytes = len(TickPrefix) + uint64ByteSize + 1 + uint64ByteSize
 )
@@ -119,6 +124,12 @@ func KeyFullPosition(poolId uint64, addr sdk.AccAddress, lowerTick, upperTick in
 	return []byte(fmt.Sprintf("%s%s%x%s%d%s%d%s%d%s%s%s%d%s%d", PositionPrefix, KeySeparator, addr.Bytes(), KeySeparator, poolId, KeySeparator, lowerTick, KeySeparator, upperTick, KeySeparator, joinTimeKey, KeySeparator, uint64(freezeDuration), KeySeparator, positionId))
 }
 
+// KeyPositionId returns the key of the position id index.
+// The value stored under this key is the full position key of the position with the given id.
+func KeyPositionId(positionId uint64) []byte {
+	return append(PositionIdPrefix, sdk.Uint64ToBigEndian(positionId)...)
+}
+
 // KeyPosition uses pool Id, owner, lower tick and upper tick for keys
 func KeyPosition(poolId uint64, addr sdk.AccAddress, lowerTick, upperTick int64) []byte {
 	return []byte(fmt.Sprintf("%s%s%x%s%d%s%d%s%d", PositionPrefix, KeySeparator, addr.Bytes(), KeySeparator, poolId, KeySeparator, lowerTick, KeySeparator, upperTick))
@@ -148,3 +159,44 @@ func KeyPoolIncentiveRecords(poolId uint64) []byte {
 func KeyUptimeIncentiveRecords(poolId uint64, minUptimeIndex int) []byte {
 	return []byte(fmt.Sprintf("%s%s%d%s%d", IncentivePrefix, KeySeparator, poolId, KeySeparator, minUptimeIndex))
 }
+
+// KeyLimitOrder returns the key of the limit order backed by the position with the given id.
+// Limit orders are prefixed by pool id and owner so that they can be listed per pool and owner.
+func KeyLimitOrder(poolId uint64, owner sdk.AccAddress, positionId uint64) []byte {
+	return append(KeyPoolOwnerLimitOrders(poolId, owner), sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// KeyPoolOwnerLimitOrders returns the prefix of all limit orders placed by owner in the given pool.
+func KeyPoolOwnerLimitOrders(poolId uint64, owner sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(LimitOrderPrefix)+uint64ByteSize+1+len(owner))
+	key = append(key, LimitOrderPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	key = append(key, address.MustLengthPrefix(owner.Bytes())...)
+	return key
+}
+
+// KeyOpenLimitOrder returns the key that indexes an open limit order by the tick at which it gets filled.
+// The value stored under this key is the owner of the order.
+// Orders depositing token0 are filled once the current tick reaches their upper tick.
+// Orders depositing token1 are filled once the current tick moves below their lower tick.
+func KeyOpenLimitOrder(poolId uint64, isTokenInZero bool, fillTick int64, positionId uint64) []byte {
+	key := KeyOpenLimitOrdersByTokenIn(poolId, isTokenInZero)
+	key = append(key, TickIndexToBytes(fillTick)...)
+	key = append(key, sdk.Uint64ToBigEndian(positionId)...)
+	return key
+}
+
+// KeyOpenLimitOrdersByTokenIn returns the prefix of all open limit orders in the given pool
+// depositing token0 if isTokenInZero is true, and token1 otherwise.
+func KeyOpenLimitOrdersByTokenIn(poolId uint64, isTokenInZero bool) []byte {
+	tokenInByte := byte(0)
+	if isTokenInZero {
+		tokenInByte = 1
+	}
+
+	key := make([]byte, 0, len(OpenLimitOrderTickPrefix)+uint64ByteSize+1+9+uint64ByteSize)
+	key = append(key, OpenLimitOrderTickPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	key = append(key, tokenInByte)
+	return key
+}
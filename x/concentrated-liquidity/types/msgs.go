@@ -8,10 +8,17 @@ import (
 
 // constants.
 const (
-	TypeMsgCreatePosition    = "create-position"
-	TypeMsgWithdrawPosition  = "withdraw-position"
-	TypeMsgCollectFees       = "collect-fees"
-	TypeMsgCollectIncentives = "collect-incentives"
+	TypeMsgCreatePosition        = "create-position"
+	TypeMsgWithdrawPosition      = "withdraw-position"
+	TypeMsgWithdrawPositionById  = "withdraw-position-by-id"
+	TypeMsgAddToPosition         = "add-to-position"
+	TypeMsgTransferPositions     = "transfer-positions"
+	TypeMsgPlaceLimitOrder       = "place-limit-order"
+	TypeMsgClaimLimitOrder       = "claim-limit-order"
+	TypeMsgCollectFees           = "collect-fees"
+	TypeMsgCollectFeesById       = "collect-fees-by-id"
+	TypeMsgCollectIncentives     = "collect-incentives"
+	TypeMsgCollectIncentivesById = "collect-incentives-by-id"
 )
 
 var _ sdk.Msg = &MsgCreatePosition{}
@@ -100,6 +107,176 @@ func (msg MsgWithdrawPosition) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgWithdrawPositionById{}
+
+func (msg MsgWithdrawPositionById) Route() string { return RouterKey }
+func (msg MsgWithdrawPositionById) Type() string  { return TypeMsgWithdrawPositionById }
+func (msg MsgWithdrawPositionById) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if !msg.LiquidityAmount.IsPositive() {
+		return NotPositiveRequireAmountError{Amount: msg.LiquidityAmount.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawPositionById) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawPositionById) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgAddToPosition{}
+
+func (msg MsgAddToPosition) Route() string { return RouterKey }
+func (msg MsgAddToPosition) Type() string  { return TypeMsgAddToPosition }
+func (msg MsgAddToPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return InvalidLowerUpperTickError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
+	}
+
+	if !msg.TokenDesired0.IsValid() {
+		return fmt.Errorf("Invalid coins (%s)", msg.TokenDesired0.String())
+	}
+
+	if !msg.TokenDesired1.IsValid() {
+		return fmt.Errorf("Invalid coins (%s)", msg.TokenDesired1.String())
+	}
+
+	if msg.TokenDesired0.IsZero() && msg.TokenDesired1.IsZero() {
+		return fmt.Errorf("Invalid coins, both amounts are zero (%s, %s)", msg.TokenDesired0.String(), msg.TokenDesired1.String())
+	}
+
+	if msg.TokenMinAmount0.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount0.String()}
+	}
+
+	if msg.TokenMinAmount1.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount1.String()}
+	}
+
+	if msg.FreezeDuration < 0 {
+		return fmt.Errorf("Invalid freeze duration")
+	}
+
+	return nil
+}
+
+func (msg MsgAddToPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAddToPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgTransferPositions{}
+
+func (msg MsgTransferPositions) Route() string { return RouterKey }
+func (msg MsgTransferPositions) Type() string  { return TypeMsgTransferPositions }
+func (msg MsgTransferPositions) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return fmt.Errorf("Invalid new owner address (%s)", err)
+	}
+
+	if sender.Equals(newOwner) {
+		return TransferToSameOwnerError{Owner: msg.Sender}
+	}
+
+	return validatePositionIds(msg.PositionIds)
+}
+
+func (msg MsgTransferPositions) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgTransferPositions) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgPlaceLimitOrder{}
+
+func (msg MsgPlaceLimitOrder) Route() string { return RouterKey }
+func (msg MsgPlaceLimitOrder) Type() string  { return TypeMsgPlaceLimitOrder }
+func (msg MsgPlaceLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenIn.IsValid() || msg.TokenIn.IsZero() {
+		return fmt.Errorf("Invalid coins (%s)", msg.TokenIn.String())
+	}
+
+	return nil
+}
+
+func (msg MsgPlaceLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgClaimLimitOrder{}
+
+func (msg MsgClaimLimitOrder) Route() string { return RouterKey }
+func (msg MsgClaimLimitOrder) Type() string  { return TypeMsgClaimLimitOrder }
+func (msg MsgClaimLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgClaimLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClaimLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgCollectFees{}
 
 func (msg MsgCollectFees) Route() string { return RouterKey }
@@ -129,6 +306,31 @@ func (msg MsgCollectFees) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgCollectFeesById{}
+
+func (msg MsgCollectFeesById) Route() string { return RouterKey }
+func (msg MsgCollectFeesById) Type() string  { return TypeMsgCollectFeesById }
+func (msg MsgCollectFeesById) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return validatePositionIds(msg.PositionIds)
+}
+
+func (msg MsgCollectFeesById) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCollectFeesById) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgCollectIncentives{}
 
 func (msg MsgCollectIncentives) Route() string { return RouterKey }
@@ -158,6 +360,31 @@ func (msg MsgCollectIncentives) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgCollectIncentivesById{}
+
+func (msg MsgCollectIncentivesById) Route() string { return RouterKey }
+func (msg MsgCollectIncentivesById) Type() string  { return TypeMsgCollectIncentivesById }
+func (msg MsgCollectIncentivesById) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return validatePositionIds(msg.PositionIds)
+}
+
+func (msg MsgCollectIncentivesById) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCollectIncentivesById) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgCreateIncentive{}
 
 func (msg MsgCreateIncentive) Route() string { return RouterKey }
@@ -190,3 +417,20 @@ func (msg MsgCreateIncentive) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// validatePositionIds returns an error if no position ids are given or if any of them is duplicated.
+func validatePositionIds(positionIds []uint64) error {
+	if len(positionIds) == 0 {
+		return fmt.Errorf("No position ids provided")
+	}
+
+	seenPositionIds := make(map[uint64]struct{}, len(positionIds))
+	for _, positionId := range positionIds {
+		if _, ok := seenPositionIds[positionId]; ok {
+			return fmt.Errorf("Duplicate position id (%d)", positionId)
+		}
+		seenPositionIds[positionId] = struct{}{}
+	}
+
+	return nil
+}
@@ -239,9 +239,530 @@ func TestMsgWithdrawPosition(t *testing.T) {
 	}
 }
 
+func TestMsgWithdrawPositionById(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	tests := []struct {
+		name       string
+		msg        types.MsgWithdrawPositionById
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgWithdrawPositionById{
+				PositionId:      1,
+				Sender:          addr1,
+				LiquidityAmount: sdk.OneDec(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgWithdrawPositionById{
+				PositionId:      1,
+				Sender:          invalidAddr.String(),
+				LiquidityAmount: sdk.OneDec(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative amount",
+			msg: types.MsgWithdrawPositionById{
+				PositionId:      1,
+				Sender:          addr1,
+				LiquidityAmount: sdk.NewDec(-10),
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: types.MsgWithdrawPositionById{
+				PositionId:      1,
+				Sender:          addr1,
+				LiquidityAmount: sdk.ZeroDec(),
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "withdraw-position-by-id")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgAddToPosition(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	tests := []struct {
+		name       string
+		msg        types.MsgAddToPosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgAddToPosition{
+				PositionId:      1,
+				PoolId:          1,
+				Sender:          addr1,
+				LowerTick:       1,
+				UpperTick:       10,
+				TokenDesired0:   sdk.NewCoin("stake", sdk.OneInt()),
+				TokenDesired1:   sdk.NewCoin("osmo", sdk.OneInt()),
+				TokenMinAmount0: sdk.OneInt(),
+				TokenMinAmount1: sdk.OneInt(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "single sided, token 1 is zero",
+			msg: types.MsgAddToPosition{
+				PositionId:      1,
+				PoolId:          1,
+				Sender:          addr1,
+				LowerTick:       1,
+				UpperTick:       10,
+				TokenDesired0:   sdk.NewCoin("stake", sdk.OneInt()),
+				TokenDesired1:   sdk.NewCoin("osmo", sdk.ZeroInt()),
+				TokenMinAmount0: sdk.ZeroInt(),
+				TokenMinAmount1: sdk.ZeroInt(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgAddToPosition{
+				PositionId:      1,
+				PoolId:          1,
+				Sender:          invalidAddr.String(),
+				LowerTick:       1,
+				UpperTick:       10,
+				TokenDesired0:   sdk.NewCoin("stake", sdk.OneInt()),
+				TokenDesired1:   sdk.NewCoin("osmo", sdk.OneInt()),
+				TokenMinAmount0: sdk.OneInt(),
+				TokenMinAmount1: sdk.OneInt(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid price range, lower tick > upper",
+			msg: types.MsgAddToPosition{
+				PositionId:      1,
+				PoolId:          1,
+				Sender:          addr1,
+				LowerTick:       10,
+				UpperTick:       1,
+				TokenDesired0:   sdk.NewCoin("stake", sdk.OneInt()),
+				TokenDesired1:   sdk.NewCoin("osmo", sdk.OneInt()),
+				TokenMinAmount0: sdk.OneInt(),
+				TokenMinAmount1: sdk.OneInt(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "both token amounts are zero",
+			msg: types.MsgAddToPosition{
+				PositionId:      1,
+				PoolId:          1,
+				Sender:          addr1,
+				LowerTick:       1,
+				UpperTick:       10,
+				TokenDesired0:   sdk.NewCoin("stake", sdk.ZeroInt()),
+				TokenDesired1:   sdk.NewCoin("osmo", sdk.ZeroInt()),
+				TokenMinAmount0: sdk.ZeroInt(),
+				TokenMinAmount1: sdk.ZeroInt(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative token 0 desire",
+			msg: types.MsgAddToPosition{
+				PositionId:      1,
+				PoolId:          1,
+				Sender:          addr1,
+				LowerTick:       1,
+				UpperTick:       10,
+				TokenDesired0:   sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-10)},
+				TokenDesired1:   sdk.NewCoin("osmo", sdk.OneInt()),
+				TokenMinAmount0: sdk.OneInt(),
+				TokenMinAmount1: sdk.OneInt(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative token 1 min amount",
+			msg: types.MsgAddToPosition{
+				PositionId:      1,
+				PoolId:          1,
+				Sender:          addr1,
+				LowerTick:       1,
+				UpperTick:       10,
+				TokenDesired0:   sdk.NewCoin("stake", sdk.OneInt()),
+				TokenDesired1:   sdk.NewCoin("osmo", sdk.OneInt()),
+				TokenMinAmount0: sdk.OneInt(),
+				TokenMinAmount1: sdk.NewInt(-1),
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "add-to-position")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgTransferPositions(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	tests := []struct {
+		name       string
+		msg        types.MsgTransferPositions
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgTransferPositions{
+				PositionIds: []uint64{1, 2},
+				Sender:      addr1,
+				NewOwner:    addr2,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgTransferPositions{
+				PositionIds: []uint64{1},
+				Sender:      invalidAddr.String(),
+				NewOwner:    addr2,
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid new owner",
+			msg: types.MsgTransferPositions{
+				PositionIds: []uint64{1},
+				Sender:      addr1,
+				NewOwner:    invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "new owner is the sender",
+			msg: types.MsgTransferPositions{
+				PositionIds: []uint64{1},
+				Sender:      addr1,
+				NewOwner:    addr1,
+			},
+			expectPass: false,
+		},
+		{
+			name: "no position ids",
+			msg: types.MsgTransferPositions{
+				Sender:   addr1,
+				NewOwner: addr2,
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate position ids",
+			msg: types.MsgTransferPositions{
+				PositionIds: []uint64{1, 2, 1},
+				Sender:      addr1,
+				NewOwner:    addr2,
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "transfer-positions")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCollectFeesById(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	tests := []struct {
+		name       string
+		msg        types.MsgCollectFeesById
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCollectFeesById{
+				PositionIds: []uint64{1, 2},
+				Sender:      addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgCollectFeesById{
+				PositionIds: []uint64{1},
+				Sender:      invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "no position ids",
+			msg: types.MsgCollectFeesById{
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate position ids",
+			msg: types.MsgCollectFeesById{
+				PositionIds: []uint64{1, 2, 1},
+				Sender:      addr1,
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "collect-fees-by-id")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCollectIncentivesById(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	tests := []struct {
+		name       string
+		msg        types.MsgCollectIncentivesById
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCollectIncentivesById{
+				PositionIds: []uint64{1, 2},
+				Sender:      addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgCollectIncentivesById{
+				PositionIds: []uint64{1},
+				Sender:      invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "no position ids",
+			msg: types.MsgCollectIncentivesById{
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate position ids",
+			msg: types.MsgCollectIncentivesById{
+				PositionIds: []uint64{1, 2, 1},
+				Sender:      addr1,
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "collect-incentives-by-id")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgPlaceLimitOrder(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	tests := []struct {
+		name       string
+		msg        types.MsgPlaceLimitOrder
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgPlaceLimitOrder{
+				PoolId:    1,
+				Sender:    addr1,
+				TickIndex: -10,
+				TokenIn:   sdk.NewCoin("foo", sdk.NewInt(1000)),
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgPlaceLimitOrder{
+				PoolId:    1,
+				Sender:    invalidAddr.String(),
+				TickIndex: 10,
+				TokenIn:   sdk.NewCoin("foo", sdk.NewInt(1000)),
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero token in",
+			msg: types.MsgPlaceLimitOrder{
+				PoolId:    1,
+				Sender:    addr1,
+				TickIndex: 10,
+				TokenIn:   sdk.NewCoin("foo", sdk.ZeroInt()),
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid token in denom",
+			msg: types.MsgPlaceLimitOrder{
+				PoolId:    1,
+				Sender:    addr1,
+				TickIndex: 10,
+				TokenIn:   sdk.Coin{Denom: "1foo", Amount: sdk.NewInt(1000)},
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "place-limit-order")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgClaimLimitOrder(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	tests := []struct {
+		name       string
+		msg        types.MsgClaimLimitOrder
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgClaimLimitOrder{
+				PoolId:     1,
+				Sender:     addr1,
+				PositionId: 1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgClaimLimitOrder{
+				PoolId:     1,
+				Sender:     invalidAddr.String(),
+				PositionId: 1,
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "claim-limit-order")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func TestConcentratedLiquiditySerialization(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
 	addr1 := sdk.AccAddress(pk1.Address()).String()
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address()).String()
 	defaultPoolId := uint64(1)
 
 	testCases := []struct {
@@ -267,6 +788,28 @@ func TestConcentratedLiquiditySerialization(t *testing.T) {
 				LiquidityAmount: sdk.NewDec(100),
 			},
 		},
+		{
+			name: "MsgWithdrawPositionById",
+			clMsg: &types.MsgWithdrawPositionById{
+				PositionId:      1,
+				Sender:          addr1,
+				LiquidityAmount: sdk.NewDec(100),
+			},
+		},
+		{
+			name: "MsgCollectFeesById",
+			clMsg: &types.MsgCollectFeesById{
+				PositionIds: []uint64{1, 2},
+				Sender:      addr1,
+			},
+		},
+		{
+			name: "MsgCollectIncentivesById",
+			clMsg: &types.MsgCollectIncentivesById{
+				PositionIds: []uint64{1, 2},
+				Sender:      addr1,
+			},
+		},
 		{
 			name: "MsgCreatePosition",
 			clMsg: &types.MsgCreatePosition{
@@ -280,6 +823,45 @@ func TestConcentratedLiquiditySerialization(t *testing.T) {
 				TokenMinAmount1: sdk.OneInt(),
 			},
 		},
+		{
+			name: "MsgAddToPosition",
+			clMsg: &types.MsgAddToPosition{
+				PositionId:      1,
+				PoolId:          defaultPoolId,
+				Sender:          addr1,
+				LowerTick:       int64(10000),
+				UpperTick:       int64(20000),
+				TokenDesired0:   sdk.NewCoin("foo", sdk.NewInt(1000)),
+				TokenDesired1:   sdk.NewCoin("bar", sdk.NewInt(1000)),
+				TokenMinAmount0: sdk.OneInt(),
+				TokenMinAmount1: sdk.OneInt(),
+			},
+		},
+		{
+			name: "MsgTransferPositions",
+			clMsg: &types.MsgTransferPositions{
+				PositionIds: []uint64{1, 2},
+				Sender:      addr1,
+				NewOwner:    addr2,
+			},
+		},
+		{
+			name: "MsgPlaceLimitOrder",
+			clMsg: &types.MsgPlaceLimitOrder{
+				PoolId:    defaultPoolId,
+				Sender:    addr1,
+				TickIndex: int64(10000),
+				TokenIn:   sdk.NewCoin("foo", sdk.NewInt(1000)),
+			},
+		},
+		{
+			name: "MsgClaimLimitOrder",
+			clMsg: &types.MsgClaimLimitOrder{
+				PoolId:     defaultPoolId,
+				Sender:     addr1,
+				PositionId: 1,
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
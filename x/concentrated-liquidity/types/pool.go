@@ -20,11 +20,18 @@ type ConcentratedPoolExtension interface {
 	GetTickSpacing() uint64
 	GetLiquidity() sdk.Dec
 	GetLastLiquidityUpdate() time.Time
+	GetTickAccumulator() sdk.Int
+	GetLastTickAccumulatorUpdate() time.Time
+	GetTickAccumulatorAtTime(blockTime time.Time) sdk.Int
+	GetDynamicSwapFee() *DynamicSwapFee
 	SetCurrentSqrtPrice(newSqrtPrice sdk.Dec)
 	SetCurrentTick(newTick sdk.Int)
 	SetLastLiquidityUpdate(newTime time.Time)
+	SetSwapFee(swapFee sdk.Dec, dynamicSwapFee *DynamicSwapFee)
 
 	UpdateLiquidity(newLiquidity sdk.Dec)
+	UpdateTickAccumulator(blockTime time.Time)
+	UpdateVolatilityWindow(blockTime time.Time)
 	ApplySwap(newLiquidity sdk.Dec, newCurrentTick sdk.Int, newCurrentSqrtPrice sdk.Dec) error
 	CalcActualAmounts(ctx sdk.Context, lowerTick, upperTick int64, sqrtRatioLowerTick, sqrtRatioUpperTick sdk.Dec, liquidityDelta sdk.Dec) (actualAmountDenom0 sdk.Dec, actualAmountDenom1 sdk.Dec)
 	UpdateLiquidityIfActivePosition(ctx sdk.Context, lowerTick, upperTick int64, liquidityDelta sdk.Dec) bool
@@ -15,6 +15,7 @@ import (
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	model "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
 	types1 "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -24,12 +25,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -134,6 +137,95 @@ func (m *QueryUserPositionsResponse) GetPositions() []model.PositionWithUnderlyi
 	return nil
 }
 
+// =============================== PositionById
+type QueryPositionByIdRequest struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+}
+
+func (m *QueryPositionByIdRequest) Reset()         { *m = QueryPositionByIdRequest{} }
+func (m *QueryPositionByIdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionByIdRequest) ProtoMessage()    {}
+func (*QueryPositionByIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{2}
+}
+func (m *QueryPositionByIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionByIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionByIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionByIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionByIdRequest.Merge(m, src)
+}
+func (m *QueryPositionByIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionByIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionByIdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionByIdRequest proto.InternalMessageInfo
+
+func (m *QueryPositionByIdRequest) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+type QueryPositionByIdResponse struct {
+	Position model.PositionWithUnderlyingAssetBreakdown `protobuf:"bytes,1,opt,name=position,proto3" json:"position"`
+}
+
+func (m *QueryPositionByIdResponse) Reset()         { *m = QueryPositionByIdResponse{} }
+func (m *QueryPositionByIdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionByIdResponse) ProtoMessage()    {}
+func (*QueryPositionByIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{3}
+}
+func (m *QueryPositionByIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionByIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionByIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionByIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionByIdResponse.Merge(m, src)
+}
+func (m *QueryPositionByIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionByIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionByIdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionByIdResponse proto.InternalMessageInfo
+
+func (m *QueryPositionByIdResponse) GetPosition() model.PositionWithUnderlyingAssetBreakdown {
+	if m != nil {
+		return m.Position
+	}
+	return model.PositionWithUnderlyingAssetBreakdown{}
+}
+
 // =============================== Pools
 type QueryPoolsRequest struct {
 	// pagination defines an optional pagination for the request.
@@ -144,7 +236,7 @@ func (m *QueryPoolsRequest) Reset()         { *m = QueryPoolsRequest{} }
 func (m *QueryPoolsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolsRequest) ProtoMessage()    {}
 func (*QueryPoolsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce34c1e206115391, []int{2}
+	return fileDescriptor_ce34c1e206115391, []int{4}
 }
 func (m *QueryPoolsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,7 +282,7 @@ func (m *QueryPoolsResponse) Reset()         { *m = QueryPoolsResponse{} }
 func (m *QueryPoolsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolsResponse) ProtoMessage()    {}
 func (*QueryPoolsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce34c1e206115391, []int{3}
+	return fileDescriptor_ce34c1e206115391, []int{5}
 }
 func (m *QueryPoolsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +333,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce34c1e206115391, []int{4}
+	return fileDescriptor_ce34c1e206115391, []int{6}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,7 +370,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce34c1e206115391, []int{5}
+	return fileDescriptor_ce34c1e206115391, []int{7}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,7 +417,7 @@ func (m *QueryLiquidityDepthsForRangeRequest) Reset()         { *m = QueryLiquid
 func (m *QueryLiquidityDepthsForRangeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLiquidityDepthsForRangeRequest) ProtoMessage()    {}
 func (*QueryLiquidityDepthsForRangeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce34c1e206115391, []int{6}
+	return fileDescriptor_ce34c1e206115391, []int{8}
 }
 func (m *QueryLiquidityDepthsForRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -369,7 +461,7 @@ func (m *QueryLiquidityDepthsForRangeResponse) Reset()         { *m = QueryLiqui
 func (m *QueryLiquidityDepthsForRangeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLiquidityDepthsForRangeResponse) ProtoMessage()    {}
 func (*QueryLiquidityDepthsForRangeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce34c1e206115391, []int{7}
+	return fileDescriptor_ce34c1e206115391, []int{9}
 }
 func (m *QueryLiquidityDepthsForRangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,7 +506,7 @@ func (m *LiquidityDepth) Reset()         { *m = LiquidityDepth{} }
 func (m *LiquidityDepth) String() string { return proto.CompactTextString(m) }
 func (*LiquidityDepth) ProtoMessage()    {}
 func (*LiquidityDepth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce34c1e206115391, []int{8}
+	return fileDescriptor_ce34c1e206115391, []int{10}
 }
 func (m *LiquidityDepth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +545,7 @@ func (m *LiquidityDepthWithRange) Reset()         { *m = LiquidityDepthWithRange
 func (m *LiquidityDepthWithRange) String() string { return proto.CompactTextString(m) }
 func (*LiquidityDepthWithRange) ProtoMessage()    {}
 func (*LiquidityDepthWithRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce34c1e206115391, []int{9}
+	return fileDescriptor_ce34c1e206115391, []int{11}
 }
 func (m *LiquidityDepthWithRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -482,23 +574,33 @@ func (m *LiquidityDepthWithRange) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LiquidityDepthWithRange proto.InternalMessageInfo
 
-// =============================== TickLiquidityInBatches
-type QueryTotalLiquidityForRangeRequest struct {
+// =============================== LiquidityNetInDirection
+type QueryLiquidityNetInDirectionRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// token_in is the denom of the token swapped in, which determines the
+	// direction to walk in. Swapping token0 in walks towards lower ticks, and
+	// swapping token1 in walks towards higher ticks.
+	TokenIn string `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	// bound_tick is the last tick to walk to, inclusive. Ignored if use_no_bound
+	// is set, in which case the min or max tick of the pool is used.
+	BoundTick  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=bound_tick,json=boundTick,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bound_tick" yaml:"bound_tick"`
+	UseNoBound bool                                   `protobuf:"varint,4,opt,name=use_no_bound,json=useNoBound,proto3" json:"use_no_bound,omitempty" yaml:"use_no_bound"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTotalLiquidityForRangeRequest) Reset()         { *m = QueryTotalLiquidityForRangeRequest{} }
-func (m *QueryTotalLiquidityForRangeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalLiquidityForRangeRequest) ProtoMessage()    {}
-func (*QueryTotalLiquidityForRangeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce34c1e206115391, []int{10}
+func (m *QueryLiquidityNetInDirectionRequest) Reset()         { *m = QueryLiquidityNetInDirectionRequest{} }
+func (m *QueryLiquidityNetInDirectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityNetInDirectionRequest) ProtoMessage()    {}
+func (*QueryLiquidityNetInDirectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{12}
 }
-func (m *QueryTotalLiquidityForRangeRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryLiquidityNetInDirectionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalLiquidityForRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLiquidityNetInDirectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalLiquidityForRangeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLiquidityNetInDirectionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -508,41 +610,67 @@ func (m *QueryTotalLiquidityForRangeRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalLiquidityForRangeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalLiquidityForRangeRequest.Merge(m, src)
+func (m *QueryLiquidityNetInDirectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityNetInDirectionRequest.Merge(m, src)
 }
-func (m *QueryTotalLiquidityForRangeRequest) XXX_Size() int {
+func (m *QueryLiquidityNetInDirectionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalLiquidityForRangeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalLiquidityForRangeRequest.DiscardUnknown(m)
+func (m *QueryLiquidityNetInDirectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityNetInDirectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalLiquidityForRangeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLiquidityNetInDirectionRequest proto.InternalMessageInfo
 
-func (m *QueryTotalLiquidityForRangeRequest) GetPoolId() uint64 {
+func (m *QueryLiquidityNetInDirectionRequest) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-type QueryTotalLiquidityForRangeResponse struct {
-	Liquidity []LiquidityDepthWithRange `protobuf:"bytes,1,rep,name=liquidity,proto3" json:"liquidity"`
+func (m *QueryLiquidityNetInDirectionRequest) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
+	}
+	return ""
 }
 
-func (m *QueryTotalLiquidityForRangeResponse) Reset()         { *m = QueryTotalLiquidityForRangeResponse{} }
-func (m *QueryTotalLiquidityForRangeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalLiquidityForRangeResponse) ProtoMessage()    {}
-func (*QueryTotalLiquidityForRangeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce34c1e206115391, []int{11}
+func (m *QueryLiquidityNetInDirectionRequest) GetUseNoBound() bool {
+	if m != nil {
+		return m.UseNoBound
+	}
+	return false
 }
-func (m *QueryTotalLiquidityForRangeResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryLiquidityNetInDirectionRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryLiquidityNetInDirectionResponse struct {
+	LiquidityDepths  []TickLiquidityNet                     `protobuf:"bytes,1,rep,name=liquidity_depths,json=liquidityDepths,proto3" json:"liquidity_depths"`
+	CurrentTick      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=current_tick,json=currentTick,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"current_tick" yaml:"current_tick"`
+	CurrentLiquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=current_liquidity,json=currentLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_liquidity" yaml:"current_liquidity"`
+	CurrentSqrtPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=current_sqrt_price,json=currentSqrtPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_sqrt_price" yaml:"current_sqrt_price"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLiquidityNetInDirectionResponse) Reset()         { *m = QueryLiquidityNetInDirectionResponse{} }
+func (m *QueryLiquidityNetInDirectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityNetInDirectionResponse) ProtoMessage()    {}
+func (*QueryLiquidityNetInDirectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{13}
+}
+func (m *QueryLiquidityNetInDirectionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalLiquidityForRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLiquidityNetInDirectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalLiquidityForRangeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLiquidityNetInDirectionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -552,45 +680,51 @@ func (m *QueryTotalLiquidityForRangeResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalLiquidityForRangeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalLiquidityForRangeResponse.Merge(m, src)
+func (m *QueryLiquidityNetInDirectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityNetInDirectionResponse.Merge(m, src)
 }
-func (m *QueryTotalLiquidityForRangeResponse) XXX_Size() int {
+func (m *QueryLiquidityNetInDirectionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalLiquidityForRangeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalLiquidityForRangeResponse.DiscardUnknown(m)
+func (m *QueryLiquidityNetInDirectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityNetInDirectionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalLiquidityForRangeResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryLiquidityNetInDirectionResponse proto.InternalMessageInfo
 
-func (m *QueryTotalLiquidityForRangeResponse) GetLiquidity() []LiquidityDepthWithRange {
+func (m *QueryLiquidityNetInDirectionResponse) GetLiquidityDepths() []TickLiquidityNet {
 	if m != nil {
-		return m.Liquidity
+		return m.LiquidityDepths
 	}
 	return nil
 }
 
-// ===================== MsgQueryClaimableFees
-type QueryClaimableFeesRequest struct {
-	PoolId    uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
-	Sender    string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	LowerTick int64  `protobuf:"varint,3,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
-	UpperTick int64  `protobuf:"varint,4,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+func (m *QueryLiquidityNetInDirectionResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryClaimableFeesRequest) Reset()         { *m = QueryClaimableFeesRequest{} }
-func (m *QueryClaimableFeesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryClaimableFeesRequest) ProtoMessage()    {}
-func (*QueryClaimableFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce34c1e206115391, []int{12}
+type TickLiquidityNet struct {
+	LiquidityNet github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_net" yaml:"liquidity_net"`
+	TickIndex    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=tick_index,json=tickIndex,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tick_index" yaml:"tick_index"`
+	Price        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	SqrtPrice    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=sqrt_price,json=sqrtPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sqrt_price" yaml:"sqrt_price"`
 }
-func (m *QueryClaimableFeesRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *TickLiquidityNet) Reset()         { *m = TickLiquidityNet{} }
+func (m *TickLiquidityNet) String() string { return proto.CompactTextString(m) }
+func (*TickLiquidityNet) ProtoMessage()    {}
+func (*TickLiquidityNet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{14}
+}
+func (m *TickLiquidityNet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryClaimableFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TickLiquidityNet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryClaimableFeesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TickLiquidityNet.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -600,62 +734,104 @@ func (m *QueryClaimableFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryClaimableFeesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryClaimableFeesRequest.Merge(m, src)
+func (m *TickLiquidityNet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickLiquidityNet.Merge(m, src)
 }
-func (m *QueryClaimableFeesRequest) XXX_Size() int {
+func (m *TickLiquidityNet) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryClaimableFeesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryClaimableFeesRequest.DiscardUnknown(m)
+func (m *TickLiquidityNet) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickLiquidityNet.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryClaimableFeesRequest proto.InternalMessageInfo
+var xxx_messageInfo_TickLiquidityNet proto.InternalMessageInfo
 
-func (m *QueryClaimableFeesRequest) GetPoolId() uint64 {
-	if m != nil {
-		return m.PoolId
+// =============================== SimulateSwapExactAmountIn
+type QuerySimulateSwapExactAmountInRequest struct {
+	PoolId        uint64      `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenIn       types2.Coin `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	TokenOutDenom string      `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+}
+
+func (m *QuerySimulateSwapExactAmountInRequest) Reset()         { *m = QuerySimulateSwapExactAmountInRequest{} }
+func (m *QuerySimulateSwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSwapExactAmountInRequest) ProtoMessage()    {}
+func (*QuerySimulateSwapExactAmountInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{15}
+}
+func (m *QuerySimulateSwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateSwapExactAmountInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSwapExactAmountInRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *QuerySimulateSwapExactAmountInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSwapExactAmountInRequest.Merge(m, src)
+}
+func (m *QuerySimulateSwapExactAmountInRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSwapExactAmountInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSwapExactAmountInRequest.DiscardUnknown(m)
 }
 
-func (m *QueryClaimableFeesRequest) GetSender() string {
+var xxx_messageInfo_QuerySimulateSwapExactAmountInRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateSwapExactAmountInRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.Sender
+		return m.PoolId
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryClaimableFeesRequest) GetLowerTick() int64 {
+func (m *QuerySimulateSwapExactAmountInRequest) GetTokenIn() types2.Coin {
 	if m != nil {
-		return m.LowerTick
+		return m.TokenIn
 	}
-	return 0
+	return types2.Coin{}
 }
 
-func (m *QueryClaimableFeesRequest) GetUpperTick() int64 {
+func (m *QuerySimulateSwapExactAmountInRequest) GetTokenOutDenom() string {
 	if m != nil {
-		return m.UpperTick
+		return m.TokenOutDenom
 	}
-	return 0
+	return ""
 }
 
-type QueryClaimableFeesResponse struct {
-	ClaimableFees []types2.Coin `protobuf:"bytes,1,rep,name=claimable_fees,json=claimableFees,proto3" json:"claimable_fees" yaml:"claimable_fees"`
+type QuerySimulateSwapExactAmountInResponse struct {
+	TokenIn  types2.Coin `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	TokenOut types2.Coin `protobuf:"bytes,2,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+	// swap_fee is the swap fee charged, including any dynamic component.
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	// price_impact is one minus the ratio of the execution price, excluding
+	// fees, to the spot price before the swap.
+	PriceImpact github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price_impact,json=priceImpact,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_impact" yaml:"price_impact"`
+	Steps       []SwapStepTrace                        `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps"`
 }
 
-func (m *QueryClaimableFeesResponse) Reset()         { *m = QueryClaimableFeesResponse{} }
-func (m *QueryClaimableFeesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryClaimableFeesResponse) ProtoMessage()    {}
-func (*QueryClaimableFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce34c1e206115391, []int{13}
+func (m *QuerySimulateSwapExactAmountInResponse) Reset() {
+	*m = QuerySimulateSwapExactAmountInResponse{}
 }
-func (m *QueryClaimableFeesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySimulateSwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSwapExactAmountInResponse) ProtoMessage()    {}
+func (*QuerySimulateSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{16}
+}
+func (m *QuerySimulateSwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryClaimableFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySimulateSwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryClaimableFeesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySimulateSwapExactAmountInResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -665,1158 +841,4731 @@ func (m *QueryClaimableFeesResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryClaimableFeesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryClaimableFeesResponse.Merge(m, src)
+func (m *QuerySimulateSwapExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSwapExactAmountInResponse.Merge(m, src)
 }
-func (m *QueryClaimableFeesResponse) XXX_Size() int {
+func (m *QuerySimulateSwapExactAmountInResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryClaimableFeesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryClaimableFeesResponse.DiscardUnknown(m)
+func (m *QuerySimulateSwapExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSwapExactAmountInResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryClaimableFeesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySimulateSwapExactAmountInResponse proto.InternalMessageInfo
 
-func (m *QueryClaimableFeesResponse) GetClaimableFees() []types2.Coin {
+func (m *QuerySimulateSwapExactAmountInResponse) GetTokenIn() types2.Coin {
 	if m != nil {
-		return m.ClaimableFees
+		return m.TokenIn
 	}
-	return nil
+	return types2.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*QueryUserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryUserPositionsRequest")
-	proto.RegisterType((*QueryUserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryUserPositionsResponse")
-	proto.RegisterType((*QueryPoolsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolsRequest")
-	proto.RegisterType((*QueryPoolsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryLiquidityDepthsForRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryLiquidityDepthsForRangeRequest")
-	proto.RegisterType((*QueryLiquidityDepthsForRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryLiquidityDepthsForRangeResponse")
-	proto.RegisterType((*LiquidityDepth)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepth")
-	proto.RegisterType((*LiquidityDepthWithRange)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepthWithRange")
-	proto.RegisterType((*QueryTotalLiquidityForRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryTotalLiquidityForRangeRequest")
-	proto.RegisterType((*QueryTotalLiquidityForRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryTotalLiquidityForRangeResponse")
-	proto.RegisterType((*QueryClaimableFeesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryClaimableFeesRequest")
-	proto.RegisterType((*QueryClaimableFeesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryClaimableFeesResponse")
+func (m *QuerySimulateSwapExactAmountInResponse) GetTokenOut() types2.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types2.Coin{}
 }
 
-func init() {
-	proto.RegisterFile("osmosis/concentrated-liquidity/pool-model/query.proto", fileDescriptor_ce34c1e206115391)
+func (m *QuerySimulateSwapExactAmountInResponse) GetSteps() []SwapStepTrace {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
 }
 
-var fileDescriptor_ce34c1e206115391 = []byte{
-	// 1173 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xe6, 0x5f, 0xe5, 0x09, 0x09, 0x64, 0x48, 0xa1, 0xb1, 0xc0, 0xae, 0x06, 0x5a, 0x02,
-	0xad, 0x77, 0x95, 0x10, 0x0b, 0x88, 0x28, 0x6a, 0x9c, 0x2a, 0xd4, 0x2d, 0x42, 0xed, 0xaa, 0x15,
-	0x52, 0xa9, 0x64, 0x8d, 0xbd, 0x13, 0x67, 0x95, 0xf5, 0x8e, 0xb3, 0x33, 0x4e, 0x6b, 0xa1, 0x5e,
-	0xb8, 0x71, 0x00, 0x21, 0xc1, 0x89, 0x23, 0x1f, 0x01, 0x21, 0x3e, 0x43, 0xc4, 0xa9, 0x52, 0x2f,
-	0x15, 0x48, 0x06, 0x25, 0x7c, 0x82, 0xdc, 0x38, 0x20, 0xa1, 0x9d, 0x7d, 0xfb, 0xcf, 0x75, 0x62,
-	0x3b, 0xce, 0xc9, 0x1e, 0xbf, 0x3f, 0xbf, 0xf7, 0x7b, 0xef, 0xcd, 0x7b, 0x63, 0x54, 0xe4, 0xa2,
-	0xc1, 0x85, 0x2d, 0x8c, 0x1a, 0x77, 0x6b, 0xcc, 0x95, 0x1e, 0x95, 0xcc, 0x2a, 0x38, 0xf6, 0x6e,
-	0xcb, 0xb6, 0x6c, 0xd9, 0x36, 0x9a, 0x9c, 0x3b, 0x85, 0x06, 0xb7, 0x98, 0x63, 0xec, 0xb6, 0x98,
-	0xd7, 0xd6, 0x9b, 0x1e, 0x97, 0x1c, 0x5f, 0x02, 0x33, 0x3d, 0x69, 0x16, 0x59, 0xe9, 0x7b, 0xcb,
-	0x55, 0x26, 0xe9, 0x72, 0x76, 0xa1, 0xce, 0xeb, 0x5c, 0x59, 0x18, 0xfe, 0xb7, 0xc0, 0x38, 0x7b,
-	0xa5, 0x1f, 0x26, 0xf5, 0x68, 0x43, 0x80, 0x72, 0xae, 0xa6, 0xb4, 0x8d, 0x2a, 0x15, 0xcc, 0x00,
-	0xbf, 0x46, 0x8d, 0xdb, 0x2e, 0xc8, 0xdf, 0x4b, 0xca, 0x55, 0x88, 0x91, 0x56, 0x93, 0xd6, 0x6d,
-	0x97, 0x4a, 0x9b, 0x87, 0xba, 0x6f, 0xd4, 0x39, 0xaf, 0x3b, 0xcc, 0xa0, 0x4d, 0xdb, 0xa0, 0xae,
-	0xcb, 0xa5, 0x12, 0x86, 0x48, 0x8b, 0x20, 0x55, 0xa7, 0x6a, 0x6b, 0xcb, 0xa0, 0x6e, 0x3b, 0x14,
-	0x05, 0x20, 0x95, 0x80, 0x4a, 0x70, 0x00, 0x51, 0xbe, 0xdb, 0x4a, 0xda, 0x0d, 0x26, 0x24, 0x6d,
-	0x34, 0x43, 0x02, 0xdd, 0x0a, 0x56, 0xcb, 0x4b, 0x06, 0x55, 0xe8, 0x5b, 0x01, 0x61, 0xc7, 0xea,
-	0x64, 0x0f, 0x2d, 0xde, 0xf5, 0x59, 0xde, 0x17, 0xcc, 0xbb, 0x03, 0x22, 0x61, 0xb2, 0xdd, 0x16,
-	0x13, 0x12, 0x5f, 0x45, 0xe7, 0xa8, 0x65, 0x79, 0x4c, 0x88, 0x0b, 0xda, 0x45, 0x6d, 0x29, 0x53,
-	0xc2, 0x47, 0x9d, 0xfc, 0x5c, 0x9b, 0x36, 0x9c, 0x35, 0x02, 0x02, 0x62, 0x86, 0x2a, 0xf8, 0x0a,
-	0x3a, 0xe7, 0x97, 0xb7, 0x62, 0x5b, 0x17, 0xc6, 0x2f, 0x6a, 0x4b, 0x93, 0x49, 0x6d, 0x10, 0x10,
-	0x73, 0xda, 0xff, 0x56, 0xb6, 0xc8, 0xb7, 0x1a, 0xca, 0xf6, 0x02, 0x16, 0x4d, 0xee, 0x0a, 0x86,
-	0x39, 0xca, 0x84, 0x81, 0xfa, 0xd8, 0x13, 0x4b, 0x33, 0x2b, 0xb7, 0xf5, 0x81, 0x9a, 0x44, 0x0f,
-	0x9d, 0x7d, 0x61, 0xcb, 0xed, 0xfb, 0xae, 0xc5, 0x3c, 0xa7, 0x6d, 0xbb, 0xf5, 0x75, 0x21, 0x98,
-	0x2c, 0x79, 0x8c, 0xee, 0x58, 0xfc, 0x91, 0x5b, 0x9a, 0xdc, 0xef, 0xe4, 0xc7, 0xcc, 0x18, 0x83,
-	0x7c, 0x89, 0xe6, 0x55, 0x38, 0x77, 0x38, 0x77, 0x22, 0xfe, 0x9b, 0x08, 0xc5, 0x45, 0x57, 0xa4,
-	0x66, 0x56, 0x2e, 0xeb, 0x50, 0x2f, 0xbf, 0x43, 0xf4, 0xa0, 0x89, 0x23, 0x68, 0x5a, 0x67, 0x60,
-	0x6b, 0x26, 0x2c, 0xc9, 0x8f, 0x1a, 0xc2, 0x49, 0xef, 0x40, 0xb2, 0x88, 0xa6, 0xfc, 0x6c, 0x84,
-	0x04, 0x17, 0xf4, 0xa0, 0xb4, 0x7a, 0x58, 0x5a, 0x7d, 0xdd, 0x6d, 0x97, 0x32, 0xbf, 0xff, 0x5a,
-	0x98, 0xf2, 0xed, 0xca, 0x66, 0xa0, 0x8d, 0x3f, 0xed, 0x11, 0xd5, 0x3b, 0x7d, 0xa3, 0x0a, 0x30,
-	0x53, 0x61, 0x2d, 0x84, 0x51, 0xa9, 0x0b, 0x02, 0x81, 0x93, 0x07, 0xe8, 0xd5, 0xd4, 0xaf, 0x10,
-	0xec, 0x06, 0x9a, 0x0e, 0x2e, 0x92, 0x6a, 0x85, 0x99, 0x95, 0x4b, 0x7d, 0xca, 0x11, 0x98, 0x43,
-	0xa2, 0xc1, 0x94, 0xfc, 0x34, 0x8e, 0xde, 0x52, 0xce, 0x3f, 0x0b, 0xf5, 0x6e, 0xb0, 0xa6, 0xdc,
-	0x16, 0x9b, 0xdc, 0x33, 0xa9, 0x1b, 0x25, 0x2f, 0xd9, 0x4a, 0x5a, 0xbf, 0x56, 0xc2, 0x55, 0x84,
-	0x1c, 0xfe, 0x88, 0x79, 0x15, 0x69, 0xd7, 0x76, 0x54, 0x3e, 0x32, 0xa5, 0x0d, 0x1f, 0xf6, 0x8f,
-	0x4e, 0xfe, 0x72, 0xdd, 0x96, 0xdb, 0xad, 0xaa, 0x5e, 0xe3, 0x0d, 0xb8, 0x67, 0xf0, 0x51, 0x10,
-	0xd6, 0x8e, 0x21, 0xdb, 0x4d, 0x26, 0xf4, 0xb2, 0x2b, 0x8f, 0x3a, 0xf9, 0xf9, 0xc0, 0x7b, 0xec,
-	0x89, 0x98, 0x19, 0x75, 0xb8, 0x67, 0xd7, 0x76, 0x7c, 0x8c, 0x56, 0xb3, 0x19, 0x62, 0x4c, 0x8c,
-	0x86, 0x11, 0x7b, 0x22, 0x66, 0x46, 0x1d, 0x7c, 0x0c, 0xf2, 0x9d, 0x86, 0xde, 0x3e, 0x39, 0x39,
-	0x50, 0x8a, 0x2d, 0xf4, 0x4a, 0x94, 0xe7, 0x8a, 0xa5, 0x74, 0xa0, 0x85, 0x8a, 0x03, 0xde, 0x91,
-	0x34, 0x02, 0x14, 0xe9, 0x65, 0x27, 0x8d, 0x4b, 0xfe, 0xd4, 0xd0, 0x5c, 0x5a, 0x13, 0xef, 0xa0,
-	0xd9, 0x18, 0xda, 0x65, 0x12, 0xe6, 0xc2, 0xe6, 0x10, 0xa9, 0xb8, 0xc1, 0x6a, 0x47, 0x9d, 0xfc,
-	0x02, 0xa4, 0x3b, 0xe9, 0x8c, 0x98, 0x2f, 0x45, 0xe7, 0xcf, 0x99, 0xc4, 0x0f, 0x11, 0xf2, 0x93,
-	0x54, 0xb1, 0x5d, 0x8b, 0x3d, 0x86, 0xc2, 0x5e, 0x1b, 0x3a, 0xe9, 0x33, 0x01, 0x12, 0xa4, 0xdb,
-	0xff, 0x28, 0xfb, 0xfe, 0xc8, 0xfe, 0x38, 0x7a, 0x3d, 0xcd, 0xce, 0x9f, 0x18, 0x2a, 0xd3, 0x78,
-	0x37, 0x99, 0x61, 0xda, 0xe0, 0x2d, 0xf7, 0xac, 0x99, 0xc6, 0xc9, 0x5e, 0x57, 0xee, 0x7d, 0xb2,
-	0x2f, 0x74, 0xf1, 0xa8, 0x64, 0xe3, 0xfe, 0x7d, 0xd8, 0xa3, 0x7f, 0x47, 0xf5, 0x1e, 0x77, 0xee,
-	0x5d, 0x44, 0x54, 0xe3, 0xde, 0xe3, 0x92, 0x3a, 0x51, 0x4e, 0x47, 0xb9, 0xd4, 0xe4, 0x1b, 0x0d,
-	0x26, 0xc5, 0x71, 0x3e, 0xe1, 0x2e, 0x54, 0x51, 0x26, 0xca, 0x24, 0x5c, 0x82, 0x4f, 0x4e, 0x75,
-	0x09, 0xa2, 0xe2, 0x87, 0xbb, 0x21, 0x32, 0x20, 0x7f, 0x69, 0xb0, 0x24, 0x37, 0x1c, 0x6a, 0x37,
-	0x68, 0xd5, 0x61, 0x9b, 0x8c, 0x89, 0x53, 0xcd, 0xaa, 0x77, 0xd1, 0xb4, 0x60, 0xfe, 0x52, 0x82,
-	0x0a, 0xcf, 0x1f, 0x75, 0xf2, 0xb3, 0x81, 0x6e, 0xf0, 0x3b, 0x31, 0x41, 0x01, 0xaf, 0xa6, 0x1a,
-	0xc2, 0x2f, 0xd9, 0x44, 0xe9, 0x7c, 0xdf, 0x41, 0xb5, 0x9a, 0x2a, 0xf4, 0x64, 0xb7, 0xd5, 0x31,
-	0xa3, 0xe7, 0x09, 0x2c, 0xe3, 0x2e, 0x82, 0x90, 0xe3, 0x0a, 0x9a, 0xab, 0x85, 0x82, 0xca, 0x16,
-	0x63, 0xe1, 0xb4, 0x59, 0x4c, 0x2d, 0x9d, 0x30, 0xad, 0x1b, 0xdc, 0x76, 0x4b, 0x6f, 0xfa, 0x39,
-	0x3c, 0xea, 0xe4, 0xcf, 0x07, 0xb0, 0x69, 0x73, 0x62, 0xce, 0xd6, 0x92, 0x40, 0x2b, 0x3f, 0x23,
-	0x34, 0xa5, 0xf0, 0xf1, 0x2f, 0x1a, 0x52, 0xcb, 0x4e, 0xe0, 0x0f, 0x07, 0xac, 0xe2, 0x0b, 0x5b,
-	0x3b, 0xfb, 0xd1, 0x29, 0x2c, 0x03, 0xa6, 0x64, 0xf5, 0xeb, 0x67, 0xff, 0xfc, 0x30, 0xae, 0xe3,
-	0xab, 0x46, 0xaf, 0x57, 0x54, 0xfc, 0x88, 0x8a, 0x9e, 0x84, 0x2a, 0xd4, 0xdf, 0x34, 0x34, 0x1d,
-	0xac, 0x3b, 0x3c, 0x1c, 0x76, 0x72, 0xef, 0x66, 0xd7, 0x4e, 0x63, 0x0a, 0x71, 0x17, 0x55, 0xdc,
-	0x06, 0x2e, 0x0c, 0x1a, 0x77, 0x10, 0xed, 0x7f, 0x5a, 0xf7, 0x08, 0x8c, 0x96, 0x0d, 0xbe, 0x35,
-	0x4c, 0x38, 0x27, 0xaf, 0xf3, 0xec, 0xed, 0x33, 0xf1, 0x05, 0x5c, 0xcb, 0x8a, 0xeb, 0x06, 0x5e,
-	0x1f, 0x90, 0x6b, 0xf7, 0xaa, 0xac, 0x6c, 0x71, 0xaf, 0xe2, 0x29, 0x8e, 0xcf, 0x35, 0x34, 0x9b,
-	0x7a, 0x7f, 0xe2, 0xeb, 0xc3, 0x44, 0xda, 0xeb, 0xcd, 0x9c, 0x5d, 0x1f, 0xc1, 0x03, 0x30, 0x2c,
-	0x29, 0x86, 0x1f, 0xe3, 0xb5, 0x81, 0xbb, 0x10, 0x3c, 0x18, 0x5f, 0xc1, 0x5b, 0xfc, 0x09, 0xfe,
-	0x57, 0x43, 0xaf, 0xf5, 0x1e, 0x9d, 0xb8, 0x3c, 0x4c, 0x84, 0x27, 0x8e, 0xf4, 0xec, 0xad, 0xb3,
-	0x70, 0x05, 0xac, 0x6f, 0x2a, 0xd6, 0x25, 0x7c, 0x7d, 0x40, 0xd6, 0xd2, 0x77, 0x57, 0x89, 0xab,
-	0x1b, 0x97, 0xf5, 0x99, 0x86, 0x66, 0x53, 0x93, 0x6c, 0xb8, 0xb2, 0xf6, 0x9a, 0xf2, 0xc3, 0x95,
-	0xb5, 0xe7, 0x18, 0x25, 0xd7, 0x14, 0xc1, 0x0f, 0x70, 0x71, 0x40, 0x82, 0xe9, 0xa1, 0x59, 0xaa,
-	0xee, 0x1f, 0xe4, 0xb4, 0xa7, 0x07, 0x39, 0xed, 0xef, 0x83, 0x9c, 0xf6, 0xfd, 0x61, 0x6e, 0xec,
-	0xe9, 0x61, 0x6e, 0xec, 0xf9, 0x61, 0x6e, 0xec, 0xc1, 0xcd, 0xc4, 0x02, 0x07, 0xd7, 0x05, 0x87,
-	0x56, 0x45, 0x84, 0xb3, 0xb7, 0x5c, 0x34, 0x1e, 0x1f, 0xf7, 0x87, 0x50, 0x2d, 0xf8, 0xe0, 0xaf,
-	0x6e, 0x75, 0x5a, 0xfd, 0xf5, 0x78, 0xff, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x31, 0x15, 0x79,
-	0xed, 0xc7, 0x0f, 0x00, 0x00,
+// SwapStepTrace is the trace of a single step of a swap, which moves the
+// square root price towards the next initialized tick within a constant
+// liquidity range.
+type SwapStepTrace struct {
+	SqrtPriceStart  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=sqrt_price_start,json=sqrtPriceStart,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sqrt_price_start" yaml:"sqrt_price_start"`
+	SqrtPriceTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=sqrt_price_target,json=sqrtPriceTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sqrt_price_target" yaml:"sqrt_price_target"`
+	SqrtPriceEnd    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=sqrt_price_end,json=sqrtPriceEnd,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sqrt_price_end" yaml:"sqrt_price_end"`
+	// next_tick is the next initialized tick in the direction of the swap.
+	NextTick github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=next_tick,json=nextTick,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"next_tick" yaml:"next_tick"`
+	// tick_crossed is true if the step consumed all liquidity up to next_tick
+	// and crossed it.
+	TickCrossed bool `protobuf:"varint,5,opt,name=tick_crossed,json=tickCrossed,proto3" json:"tick_crossed,omitempty" yaml:"tick_crossed"`
+	// tick_end is the current tick after the step.
+	TickEnd github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=tick_end,json=tickEnd,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tick_end" yaml:"tick_end"`
+	// liquidity is the liquidity swapped against during the step.
+	Liquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
+	// amount_in is the amount of token in consumed by the step, excluding fees.
+	AmountIn  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=amount_in,json=amountIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"amount_in" yaml:"amount_in"`
+	AmountOut github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=amount_out,json=amountOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"amount_out" yaml:"amount_out"`
+	FeeCharge github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=fee_charge,json=feeCharge,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_charge" yaml:"fee_charge"`
+}
+
+func (m *SwapStepTrace) Reset()         { *m = SwapStepTrace{} }
+func (m *SwapStepTrace) String() string { return proto.CompactTextString(m) }
+func (*SwapStepTrace) ProtoMessage()    {}
+func (*SwapStepTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{17}
+}
+func (m *SwapStepTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapStepTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapStepTrace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapStepTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapStepTrace.Merge(m, src)
+}
+func (m *SwapStepTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapStepTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapStepTrace.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_SwapStepTrace proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *SwapStepTrace) GetTickCrossed() bool {
+	if m != nil {
+		return m.TickCrossed
+	}
+	return false
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Pools returns all concentrated liquidity pools
-	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
-	// Params returns concentrated liquidity module params.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// LiquidityDepthsForRange returns Liqiudity Depths for given range
-	LiquidityDepthsForRange(ctx context.Context, in *QueryLiquidityDepthsForRangeRequest, opts ...grpc.CallOption) (*QueryLiquidityDepthsForRangeResponse, error)
-	// UserPositions returns all concentrated postitions of some address.
-	UserPositions(ctx context.Context, in *QueryUserPositionsRequest, opts ...grpc.CallOption) (*QueryUserPositionsResponse, error)
-	// TotalLiquidityForRange the amount of liquidity existing within given range.
-	TotalLiquidityForRange(ctx context.Context, in *QueryTotalLiquidityForRangeRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityForRangeResponse, error)
-	ClaimableFees(ctx context.Context, in *QueryClaimableFeesRequest, opts ...grpc.CallOption) (*QueryClaimableFeesResponse, error)
+// =============================== TickLiquidityInBatches
+type QueryTotalLiquidityForRangeRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryTotalLiquidityForRangeRequest) Reset()         { *m = QueryTotalLiquidityForRangeRequest{} }
+func (m *QueryTotalLiquidityForRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidityForRangeRequest) ProtoMessage()    {}
+func (*QueryTotalLiquidityForRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{18}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryTotalLiquidityForRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
-	out := new(QueryPoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Pools", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTotalLiquidityForRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidityForRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryTotalLiquidityForRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidityForRangeRequest.Merge(m, src)
 }
-
-func (c *queryClient) LiquidityDepthsForRange(ctx context.Context, in *QueryLiquidityDepthsForRangeRequest, opts ...grpc.CallOption) (*QueryLiquidityDepthsForRangeResponse, error) {
-	out := new(QueryLiquidityDepthsForRangeResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityDepthsForRange", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryTotalLiquidityForRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidityForRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidityForRangeRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) UserPositions(ctx context.Context, in *QueryUserPositionsRequest, opts ...grpc.CallOption) (*QueryUserPositionsResponse, error) {
-	out := new(QueryUserPositionsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTotalLiquidityForRangeRequest proto.InternalMessageInfo
+
+func (m *QueryTotalLiquidityForRangeRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) TotalLiquidityForRange(ctx context.Context, in *QueryTotalLiquidityForRangeRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityForRangeResponse, error) {
-	out := new(QueryTotalLiquidityForRangeResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/TotalLiquidityForRange", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryTotalLiquidityForRangeResponse struct {
+	Liquidity []LiquidityDepthWithRange `protobuf:"bytes,1,rep,name=liquidity,proto3" json:"liquidity"`
 }
 
-func (c *queryClient) ClaimableFees(ctx context.Context, in *QueryClaimableFeesRequest, opts ...grpc.CallOption) (*QueryClaimableFeesResponse, error) {
-	out := new(QueryClaimableFeesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableFees", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTotalLiquidityForRangeResponse) Reset()         { *m = QueryTotalLiquidityForRangeResponse{} }
+func (m *QueryTotalLiquidityForRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidityForRangeResponse) ProtoMessage()    {}
+func (*QueryTotalLiquidityForRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{19}
+}
+func (m *QueryTotalLiquidityForRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalLiquidityForRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidityForRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTotalLiquidityForRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidityForRangeResponse.Merge(m, src)
+}
+func (m *QueryTotalLiquidityForRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidityForRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidityForRangeResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Pools returns all concentrated liquidity pools
-	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
-	// Params returns concentrated liquidity module params.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// LiquidityDepthsForRange returns Liqiudity Depths for given range
-	LiquidityDepthsForRange(context.Context, *QueryLiquidityDepthsForRangeRequest) (*QueryLiquidityDepthsForRangeResponse, error)
-	// UserPositions returns all concentrated postitions of some address.
-	UserPositions(context.Context, *QueryUserPositionsRequest) (*QueryUserPositionsResponse, error)
-	// TotalLiquidityForRange the amount of liquidity existing within given range.
-	TotalLiquidityForRange(context.Context, *QueryTotalLiquidityForRangeRequest) (*QueryTotalLiquidityForRangeResponse, error)
-	ClaimableFees(context.Context, *QueryClaimableFeesRequest) (*QueryClaimableFeesResponse, error)
+var xxx_messageInfo_QueryTotalLiquidityForRangeResponse proto.InternalMessageInfo
+
+func (m *QueryTotalLiquidityForRangeResponse) GetLiquidity() []LiquidityDepthWithRange {
+	if m != nil {
+		return m.Liquidity
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// ===================== MsgQueryClaimableFees
+type QueryClaimableFeesRequest struct {
+	PoolId    uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Sender    string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LowerTick int64  `protobuf:"varint,3,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64  `protobuf:"varint,4,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
 }
 
-func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+func (m *QueryClaimableFeesRequest) Reset()         { *m = QueryClaimableFeesRequest{} }
+func (m *QueryClaimableFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableFeesRequest) ProtoMessage()    {}
+func (*QueryClaimableFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{20}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryClaimableFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) LiquidityDepthsForRange(ctx context.Context, req *QueryLiquidityDepthsForRangeRequest) (*QueryLiquidityDepthsForRangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityDepthsForRange not implemented")
+func (m *QueryClaimableFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) UserPositions(ctx context.Context, req *QueryUserPositionsRequest) (*QueryUserPositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserPositions not implemented")
+func (m *QueryClaimableFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableFeesRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TotalLiquidityForRange(ctx context.Context, req *QueryTotalLiquidityForRangeRequest) (*QueryTotalLiquidityForRangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidityForRange not implemented")
+func (m *QueryClaimableFeesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ClaimableFees(ctx context.Context, req *QueryClaimableFeesRequest) (*QueryClaimableFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimableFees not implemented")
+func (m *QueryClaimableFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableFeesRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryClaimableFeesRequest proto.InternalMessageInfo
 
-func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Pools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
+func (m *QueryClaimableFeesRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryClaimableFeesRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_LiquidityDepthsForRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidityDepthsForRangeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityDepthsForRange(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityDepthsForRange",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityDepthsForRange(ctx, req.(*QueryLiquidityDepthsForRangeRequest))
+func (m *QueryClaimableFeesRequest) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_UserPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUserPositionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserPositions(ctx, in)
+func (m *QueryClaimableFeesRequest) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserPositions(ctx, req.(*QueryUserPositionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_TotalLiquidityForRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalLiquidityForRangeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalLiquidityForRange(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/TotalLiquidityForRange",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalLiquidityForRange(ctx, req.(*QueryTotalLiquidityForRangeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryClaimableFeesResponse struct {
+	ClaimableFees []types2.Coin `protobuf:"bytes,1,rep,name=claimable_fees,json=claimableFees,proto3" json:"claimable_fees" yaml:"claimable_fees"`
 }
 
-func _Query_ClaimableFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClaimableFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimableFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableFees",
+func (m *QueryClaimableFeesResponse) Reset()         { *m = QueryClaimableFeesResponse{} }
+func (m *QueryClaimableFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableFeesResponse) ProtoMessage()    {}
+func (*QueryClaimableFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{21}
+}
+func (m *QueryClaimableFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimableFees(ctx, req.(*QueryClaimableFeesRequest))
+}
+func (m *QueryClaimableFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableFeesResponse.Merge(m, src)
+}
+func (m *QueryClaimableFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableFeesResponse proto.InternalMessageInfo
+
+func (m *QueryClaimableFeesResponse) GetClaimableFees() []types2.Coin {
+	if m != nil {
+		return m.ClaimableFees
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Pools",
-			Handler:    _Query_Pools_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "LiquidityDepthsForRange",
-			Handler:    _Query_LiquidityDepthsForRange_Handler,
-		},
-		{
-			MethodName: "UserPositions",
-			Handler:    _Query_UserPositions_Handler,
-		},
-		{
-			MethodName: "TotalLiquidityForRange",
-			Handler:    _Query_TotalLiquidityForRange_Handler,
-		},
-		{
-			MethodName: "ClaimableFees",
-			Handler:    _Query_ClaimableFees_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/concentrated-liquidity/pool-model/query.proto",
+// =============================== LimitOrders
+type QueryLimitOrdersRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Owner  string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryUserPositionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLimitOrdersRequest) Reset()         { *m = QueryLimitOrdersRequest{} }
+func (m *QueryLimitOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLimitOrdersRequest) ProtoMessage()    {}
+func (*QueryLimitOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{22}
+}
+func (m *QueryLimitOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLimitOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLimitOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryUserPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLimitOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLimitOrdersRequest.Merge(m, src)
+}
+func (m *QueryLimitOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLimitOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLimitOrdersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUserPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_QueryLimitOrdersRequest proto.InternalMessageInfo
+
+func (m *QueryLimitOrdersRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *QueryLimitOrdersRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryUserPositionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLimitOrdersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryUserPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryLimitOrdersResponse struct {
+	LimitOrders []model.LimitOrder `protobuf:"bytes,1,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryUserPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Positions) > 0 {
-		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryLimitOrdersResponse) Reset()         { *m = QueryLimitOrdersResponse{} }
+func (m *QueryLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLimitOrdersResponse) ProtoMessage()    {}
+func (*QueryLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{23}
+}
+func (m *QueryLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLimitOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLimitOrdersResponse.Merge(m, src)
 }
-
-func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLimitOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLimitOrdersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryLimitOrdersResponse proto.InternalMessageInfo
+
+func (m *QueryLimitOrdersResponse) GetLimitOrders() []model.LimitOrder {
+	if m != nil {
+		return m.LimitOrders
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLimitOrdersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// =============================== TickAccumulator
+type QueryTickAccumulatorRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTickAccumulatorRequest) Reset()         { *m = QueryTickAccumulatorRequest{} }
+func (m *QueryTickAccumulatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTickAccumulatorRequest) ProtoMessage()    {}
+func (*QueryTickAccumulatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{24}
+}
+func (m *QueryTickAccumulatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTickAccumulatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTickAccumulatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+}
+func (m *QueryTickAccumulatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTickAccumulatorRequest.Merge(m, src)
+}
+func (m *QueryTickAccumulatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTickAccumulatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTickAccumulatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTickAccumulatorRequest proto.InternalMessageInfo
+
+func (m *QueryTickAccumulatorRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryTickAccumulatorResponse struct {
+	TickAccumulator github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=tick_accumulator,json=tickAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tick_accumulator" yaml:"tick_accumulator"`
+	Time            time.Time                              `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+}
+
+func (m *QueryTickAccumulatorResponse) Reset()         { *m = QueryTickAccumulatorResponse{} }
+func (m *QueryTickAccumulatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTickAccumulatorResponse) ProtoMessage()    {}
+func (*QueryTickAccumulatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{25}
+}
+func (m *QueryTickAccumulatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTickAccumulatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTickAccumulatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTickAccumulatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTickAccumulatorResponse.Merge(m, src)
+}
+func (m *QueryTickAccumulatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTickAccumulatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTickAccumulatorResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTickAccumulatorResponse proto.InternalMessageInfo
+
+func (m *QueryTickAccumulatorResponse) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryUserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryUserPositionsRequest")
+	proto.RegisterType((*QueryUserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryUserPositionsResponse")
+	proto.RegisterType((*QueryPositionByIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionByIdRequest")
+	proto.RegisterType((*QueryPositionByIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionByIdResponse")
+	proto.RegisterType((*QueryPoolsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolsRequest")
+	proto.RegisterType((*QueryPoolsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryLiquidityDepthsForRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryLiquidityDepthsForRangeRequest")
+	proto.RegisterType((*QueryLiquidityDepthsForRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryLiquidityDepthsForRangeResponse")
+	proto.RegisterType((*LiquidityDepth)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepth")
+	proto.RegisterType((*LiquidityDepthWithRange)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepthWithRange")
+	proto.RegisterType((*QueryLiquidityNetInDirectionRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryLiquidityNetInDirectionRequest")
+	proto.RegisterType((*QueryLiquidityNetInDirectionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryLiquidityNetInDirectionResponse")
+	proto.RegisterType((*TickLiquidityNet)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquidityNet")
+	proto.RegisterType((*QuerySimulateSwapExactAmountInRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QuerySimulateSwapExactAmountInRequest")
+	proto.RegisterType((*QuerySimulateSwapExactAmountInResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QuerySimulateSwapExactAmountInResponse")
+	proto.RegisterType((*SwapStepTrace)(nil), "osmosis.concentratedliquidity.v1beta1.SwapStepTrace")
+	proto.RegisterType((*QueryTotalLiquidityForRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryTotalLiquidityForRangeRequest")
+	proto.RegisterType((*QueryTotalLiquidityForRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryTotalLiquidityForRangeResponse")
+	proto.RegisterType((*QueryClaimableFeesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryClaimableFeesRequest")
+	proto.RegisterType((*QueryClaimableFeesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryClaimableFeesResponse")
+	proto.RegisterType((*QueryLimitOrdersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryLimitOrdersRequest")
+	proto.RegisterType((*QueryLimitOrdersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryLimitOrdersResponse")
+	proto.RegisterType((*QueryTickAccumulatorRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryTickAccumulatorRequest")
+	proto.RegisterType((*QueryTickAccumulatorResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryTickAccumulatorResponse")
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/pool-model/query.proto", fileDescriptor_ce34c1e206115391)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_ce34c1e206115391 = []byte{
+	// 2255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xcb, 0x6f, 0x1b, 0xd7,
+	0xd5, 0xf7, 0xe8, 0xcd, 0x43, 0xc9, 0x92, 0xae, 0x1c, 0x8b, 0xe2, 0x97, 0x4f, 0x34, 0x6e, 0x62,
+	0xd7, 0x6d, 0x22, 0xb2, 0x76, 0x2d, 0xb8, 0x31, 0x1a, 0xc7, 0x22, 0xf5, 0x08, 0x2d, 0xdb, 0x71,
+	0x46, 0x0a, 0x0a, 0xb8, 0x06, 0xa6, 0xc3, 0x99, 0x2b, 0x69, 0x20, 0x72, 0x86, 0x9a, 0xb9, 0xb4,
+	0x4c, 0x18, 0xda, 0x74, 0x51, 0xa0, 0x05, 0x5a, 0x04, 0x68, 0x57, 0x05, 0xfa, 0x4f, 0x14, 0x45,
+	0xba, 0xec, 0xd6, 0xe8, 0x2a, 0x40, 0xba, 0x08, 0x5a, 0x84, 0x29, 0xec, 0x16, 0x45, 0x97, 0xd5,
+	0xae, 0x8b, 0x02, 0xc5, 0x7d, 0xcc, 0x8b, 0xa2, 0x44, 0x0e, 0xa9, 0x76, 0x25, 0xde, 0xd7, 0xef,
+	0x9c, 0xdf, 0xb9, 0xe7, 0x9c, 0x7b, 0xee, 0x1d, 0xc1, 0xb2, 0xe3, 0xd5, 0x1c, 0xcf, 0xf2, 0x0a,
+	0x86, 0x63, 0x1b, 0xc4, 0xa6, 0xae, 0x4e, 0x89, 0xb9, 0x54, 0xb5, 0x0e, 0x1a, 0x96, 0x69, 0xd1,
+	0x66, 0xa1, 0xee, 0x38, 0xd5, 0xa5, 0x9a, 0x63, 0x92, 0x6a, 0xe1, 0xa0, 0x41, 0xdc, 0x66, 0xbe,
+	0xee, 0x3a, 0xd4, 0x41, 0x57, 0xe5, 0xb2, 0x7c, 0x74, 0x59, 0xb0, 0x2a, 0xff, 0xec, 0x46, 0x85,
+	0x50, 0xfd, 0x46, 0xf6, 0xd2, 0xae, 0xb3, 0xeb, 0xf0, 0x15, 0x05, 0xf6, 0x4b, 0x2c, 0xce, 0xbe,
+	0xd3, 0x4d, 0xa6, 0xee, 0xea, 0x35, 0x4f, 0x4e, 0x5e, 0x34, 0xf8, 0xec, 0x42, 0x45, 0xf7, 0x48,
+	0x41, 0xe2, 0x16, 0x0c, 0xc7, 0xb2, 0xe5, 0xf8, 0xb7, 0xa2, 0xe3, 0x5c, 0xc5, 0x60, 0x56, 0x5d,
+	0xdf, 0xb5, 0x6c, 0x9d, 0x5a, 0x8e, 0x3f, 0xf7, 0xcd, 0x5d, 0xc7, 0xd9, 0xad, 0x92, 0x82, 0x5e,
+	0xb7, 0x0a, 0xba, 0x6d, 0x3b, 0x94, 0x0f, 0xfa, 0x92, 0x16, 0xe4, 0x28, 0x6f, 0x55, 0x1a, 0x3b,
+	0x05, 0xdd, 0x6e, 0xfa, 0x43, 0x42, 0x88, 0x26, 0xa8, 0x88, 0x86, 0x1c, 0xca, 0xb5, 0xaf, 0xa2,
+	0x56, 0x8d, 0x78, 0x54, 0xaf, 0xd5, 0x7d, 0x02, 0xed, 0x13, 0xcc, 0x86, 0x1b, 0x55, 0x6a, 0xa9,
+	0xeb, 0x0e, 0x78, 0x56, 0x64, 0xfa, 0xb7, 0xbb, 0x4c, 0xaf, 0x5a, 0x35, 0x8b, 0x6a, 0x8e, 0x6b,
+	0x12, 0x57, 0xac, 0xc0, 0xcf, 0x60, 0xe1, 0x63, 0x66, 0x97, 0x4f, 0x3c, 0xe2, 0x3e, 0x96, 0x60,
+	0x9e, 0x4a, 0x0e, 0x1a, 0xc4, 0xa3, 0xe8, 0x5d, 0x18, 0xd7, 0x4d, 0xd3, 0x25, 0x9e, 0x97, 0x51,
+	0xae, 0x28, 0xd7, 0x53, 0x45, 0x74, 0xdc, 0xca, 0x5d, 0x6c, 0xea, 0xb5, 0xea, 0x1d, 0x2c, 0x07,
+	0xb0, 0xea, 0x4f, 0x41, 0xef, 0xc0, 0x38, 0x73, 0x08, 0xcd, 0x32, 0x33, 0x43, 0x57, 0x94, 0xeb,
+	0x23, 0xd1, 0xd9, 0x72, 0x00, 0xab, 0x63, 0xec, 0x57, 0xd9, 0xc4, 0x3f, 0x53, 0x20, 0xdb, 0x49,
+	0xb0, 0x57, 0x77, 0x6c, 0x8f, 0x20, 0x07, 0x52, 0x3e, 0x35, 0x26, 0x7b, 0xf8, 0x7a, 0xfa, 0xe6,
+	0x66, 0xbe, 0x27, 0xb7, 0xca, 0xfb, 0x60, 0xdf, 0xb7, 0xe8, 0xde, 0x27, 0xb6, 0x49, 0xdc, 0x6a,
+	0xd3, 0xb2, 0x77, 0x57, 0x3c, 0x8f, 0xd0, 0xa2, 0x4b, 0xf4, 0x7d, 0xd3, 0x39, 0xb4, 0x8b, 0x23,
+	0x2f, 0x5b, 0xb9, 0x0b, 0x6a, 0x28, 0x03, 0x6f, 0x41, 0x86, 0xab, 0xe3, 0xaf, 0x2e, 0x36, 0xcb,
+	0xa6, 0x6f, 0x86, 0xdb, 0x90, 0xf6, 0x27, 0x32, 0x72, 0x0a, 0x27, 0x77, 0xf9, 0xb8, 0x95, 0x43,
+	0x3e, 0xb9, 0x60, 0x10, 0xab, 0xe0, 0xb7, 0xca, 0x26, 0xfe, 0xa9, 0x02, 0x0b, 0x1d, 0x50, 0x25,
+	0xc7, 0x1a, 0x4c, 0xf8, 0x73, 0x39, 0xe6, 0x7f, 0x85, 0x62, 0x20, 0x02, 0xff, 0x00, 0x66, 0xa5,
+	0x2e, 0x4e, 0x35, 0xd8, 0xe1, 0x75, 0x80, 0x30, 0x10, 0xf8, 0xb6, 0xa5, 0x6f, 0x5e, 0xcb, 0x4b,
+	0x1f, 0x66, 0x51, 0x93, 0x17, 0x81, 0x1d, 0x48, 0xd6, 0x77, 0x89, 0x5c, 0xab, 0x46, 0x56, 0xe2,
+	0x5f, 0x2a, 0x80, 0xa2, 0xe8, 0x92, 0xe2, 0x32, 0x8c, 0xb2, 0xfd, 0xf6, 0xb7, 0xf0, 0x52, 0x5e,
+	0xb8, 0x7b, 0xde, 0x77, 0xf7, 0xfc, 0x8a, 0xdd, 0x2c, 0xa6, 0xfe, 0xf0, 0xdb, 0xa5, 0x51, 0xb6,
+	0xae, 0xac, 0x8a, 0xd9, 0x68, 0xa3, 0x83, 0x56, 0xdf, 0xe8, 0xaa, 0x95, 0x90, 0x19, 0x53, 0xeb,
+	0x92, 0xaf, 0x15, 0x4f, 0x1a, 0x52, 0x71, 0xfc, 0x04, 0xe6, 0x62, 0xbd, 0x52, 0xd9, 0x12, 0x8c,
+	0x89, 0xe4, 0x22, 0x77, 0xe3, 0x6a, 0x97, 0xdd, 0x10, 0xcb, 0xa5, 0x9d, 0xe5, 0x52, 0xfc, 0xab,
+	0x21, 0x78, 0x8b, 0x83, 0x3f, 0xf0, 0xe7, 0xad, 0x92, 0x3a, 0xdd, 0xf3, 0xd6, 0x1d, 0x57, 0xd5,
+	0xed, 0xc0, 0x78, 0xd1, 0x60, 0x51, 0xba, 0x05, 0x0b, 0xaa, 0x00, 0x54, 0x9d, 0x43, 0xe2, 0x6a,
+	0xd4, 0x32, 0xf6, 0xb9, 0x3d, 0x52, 0xc5, 0x12, 0x13, 0xfb, 0xa7, 0x56, 0xee, 0xda, 0xae, 0x45,
+	0xf7, 0x1a, 0x95, 0xbc, 0xe1, 0xd4, 0x64, 0xee, 0x91, 0x7f, 0x96, 0x3c, 0x73, 0xbf, 0x40, 0x9b,
+	0x75, 0xe2, 0xe5, 0xcb, 0x36, 0x3d, 0x6e, 0xe5, 0x66, 0x05, 0x7a, 0x88, 0x84, 0xd5, 0x14, 0x6f,
+	0x6c, 0x5b, 0xc6, 0x3e, 0x93, 0xd1, 0xa8, 0xd7, 0x7d, 0x19, 0xc3, 0x83, 0xc9, 0x08, 0x91, 0xb0,
+	0x9a, 0xe2, 0x0d, 0x26, 0x03, 0xff, 0x5c, 0x81, 0xb7, 0xcf, 0x36, 0x8e, 0xdc, 0x8a, 0x1d, 0x98,
+	0x09, 0xec, 0xac, 0x99, 0x7c, 0x8e, 0x74, 0xa1, 0xe5, 0x1e, 0x43, 0x24, 0x2e, 0x41, 0x6e, 0xd2,
+	0x74, 0x35, 0x2e, 0x17, 0xff, 0x59, 0x81, 0x8b, 0xf1, 0x99, 0x68, 0x1f, 0xa6, 0x42, 0xd1, 0x36,
+	0xa1, 0x32, 0xf3, 0xad, 0x27, 0x30, 0xc5, 0x2a, 0x31, 0x8e, 0x5b, 0xb9, 0x4b, 0xd2, 0xdc, 0x51,
+	0x30, 0xac, 0x4e, 0x06, 0xed, 0x47, 0x84, 0xa2, 0xa7, 0x00, 0xcc, 0x48, 0x9a, 0x65, 0x9b, 0xe4,
+	0xb9, 0xdc, 0xd8, 0xf7, 0x13, 0x1b, 0x3d, 0x2d, 0x24, 0x49, 0x73, 0xb3, 0x3f, 0x65, 0x86, 0x87,
+	0x5f, 0x0e, 0xc1, 0x7c, 0x9c, 0x1d, 0x4b, 0x18, 0xdc, 0xd2, 0xe8, 0x20, 0x6a, 0x61, 0xbd, 0xe6,
+	0x34, 0xec, 0xf3, 0x66, 0x1a, 0x1a, 0x7b, 0x85, 0xc3, 0x33, 0xb2, 0x27, 0xbc, 0x78, 0x50, 0xb2,
+	0xa1, 0xff, 0x3e, 0xed, 0xe0, 0xbf, 0x83, 0xa2, 0x87, 0x9e, 0xfb, 0x8f, 0x13, 0x61, 0xfd, 0x88,
+	0xd0, 0xb2, 0xbd, 0x6a, 0xb9, 0xc4, 0x60, 0x99, 0xa6, 0xaf, 0xb0, 0xce, 0xc3, 0x04, 0x75, 0xf6,
+	0x89, 0xad, 0x59, 0xb6, 0x34, 0xc7, 0xdc, 0x71, 0x2b, 0x37, 0x2d, 0x55, 0x90, 0x23, 0x58, 0x1d,
+	0xe7, 0x3f, 0xcb, 0x36, 0x0b, 0xd1, 0x8a, 0xd3, 0xb0, 0xcd, 0x73, 0x09, 0xd1, 0x10, 0x09, 0xab,
+	0x29, 0xde, 0xe0, 0x66, 0x7c, 0x0f, 0x26, 0x1b, 0x1e, 0xd1, 0x6c, 0x47, 0xe3, 0x7d, 0x99, 0x91,
+	0x2b, 0xca, 0xf5, 0x89, 0xe2, 0xfc, 0x71, 0x2b, 0x37, 0x27, 0x43, 0x3b, 0x32, 0x8a, 0x55, 0x68,
+	0x78, 0xe4, 0x91, 0x53, 0x64, 0x8d, 0xb6, 0xb3, 0x64, 0xb4, 0xef, 0xb3, 0xe4, 0x77, 0x23, 0xf0,
+	0xf6, 0xd9, 0xb6, 0x96, 0x59, 0x62, 0xef, 0xd4, 0x2c, 0x71, 0xbb, 0xc7, 0x2c, 0xc1, 0x28, 0x47,
+	0xa5, 0x9c, 0x92, 0x27, 0xd0, 0x1e, 0x4c, 0x1a, 0x0d, 0xd7, 0x25, 0x36, 0x8d, 0x3a, 0xef, 0x5a,
+	0x62, 0xdb, 0x4b, 0x1b, 0x46, 0xb1, 0xb0, 0x9a, 0x96, 0x4d, 0x6e, 0xff, 0x43, 0x98, 0xf5, 0x47,
+	0x03, 0x25, 0xe4, 0x56, 0xdf, 0x4f, 0x1c, 0x98, 0x99, 0xb8, 0xb8, 0x00, 0x10, 0xab, 0x33, 0xb2,
+	0x2f, 0x20, 0x8f, 0x9a, 0x80, 0xfc, 0x79, 0xde, 0x81, 0x4b, 0xb5, 0xba, 0x6b, 0x19, 0x84, 0x6f,
+	0x7f, 0xaa, 0xb8, 0x99, 0x58, 0xf2, 0x42, 0x5c, 0x72, 0x88, 0x18, 0x8a, 0xde, 0x3a, 0x70, 0xe9,
+	0x63, 0xd6, 0x85, 0x36, 0x3a, 0x38, 0x4e, 0x5f, 0xc7, 0xfd, 0xaf, 0x87, 0x61, 0xa6, 0x7d, 0x4b,
+	0xff, 0xb7, 0x09, 0xbd, 0xd2, 0x21, 0xa1, 0xf7, 0x1d, 0xa2, 0x21, 0x52, 0x34, 0xad, 0xa3, 0x6d,
+	0x18, 0x15, 0x9b, 0x23, 0xdc, 0xe2, 0x6e, 0x62, 0x22, 0x93, 0x32, 0x1f, 0x89, 0xfd, 0x10, 0x60,
+	0x4c, 0xf3, 0x13, 0xfb, 0x5e, 0x4a, 0x0c, 0x2d, 0x35, 0x8f, 0xee, 0x77, 0xca, 0xf3, 0x37, 0x1a,
+	0xff, 0x5d, 0x81, 0xab, 0x3c, 0xb2, 0xb7, 0xac, 0x5a, 0xa3, 0xaa, 0x53, 0xb2, 0x75, 0xa8, 0xd7,
+	0xd7, 0x9e, 0xeb, 0x06, 0x15, 0x47, 0x44, 0xb9, 0xbf, 0x3c, 0xfa, 0xb0, 0x2d, 0x8f, 0xa6, 0x6f,
+	0x2e, 0xc4, 0xbc, 0xc7, 0xf7, 0x9b, 0x92, 0x63, 0xd9, 0xc5, 0x79, 0xc6, 0xe9, 0xcc, 0x34, 0x5b,
+	0x84, 0x69, 0xd1, 0xeb, 0x34, 0xa8, 0x66, 0x12, 0xdb, 0xa9, 0x49, 0x4b, 0x67, 0x8f, 0x5b, 0xb9,
+	0xcb, 0xd1, 0x65, 0xc1, 0x04, 0xac, 0x4e, 0xf1, 0x9e, 0x8f, 0x1a, 0x74, 0x95, 0xb7, 0x5f, 0x0f,
+	0xc3, 0xb5, 0x6e, 0x4c, 0x65, 0x16, 0x8b, 0x6a, 0xaf, 0x0c, 0xae, 0xfd, 0x63, 0x48, 0x05, 0xca,
+	0x75, 0xb7, 0x46, 0x46, 0xe2, 0xcd, 0xb4, 0xd1, 0xc2, 0xea, 0x84, 0x4f, 0x08, 0x3d, 0x85, 0x09,
+	0xef, 0x50, 0xaf, 0x6b, 0x3b, 0xc4, 0x77, 0xb9, 0x95, 0xc4, 0x7e, 0x21, 0xf5, 0xf5, 0x71, 0xb0,
+	0x3a, 0xce, 0x7e, 0xae, 0x13, 0x96, 0xc4, 0x27, 0xb9, 0xa3, 0x68, 0x56, 0xad, 0xae, 0x1b, 0x34,
+	0x33, 0x92, 0x38, 0xb5, 0x0a, 0x09, 0x73, 0x11, 0xa7, 0x96, 0x58, 0x58, 0x4d, 0xf3, 0x66, 0x99,
+	0xb7, 0xd0, 0x63, 0x18, 0xf5, 0x28, 0xa9, 0x7b, 0x99, 0x51, 0x7e, 0x46, 0xdc, 0xea, 0xf1, 0x8c,
+	0x60, 0x3b, 0xb7, 0x45, 0x49, 0x7d, 0xdb, 0xd5, 0x0d, 0x22, 0x0f, 0x08, 0x01, 0x84, 0xff, 0x39,
+	0x0e, 0x53, 0xb1, 0x61, 0xe4, 0xc1, 0x4c, 0xe8, 0xfb, 0x9a, 0x47, 0x75, 0xd7, 0xcf, 0x37, 0xe5,
+	0xc4, 0x8c, 0xe6, 0xdb, 0x63, 0x49, 0xe0, 0x61, 0xf5, 0x62, 0x10, 0x51, 0x5b, 0xac, 0x03, 0x3d,
+	0x83, 0xd9, 0xc8, 0x24, 0xaa, 0xbb, 0xbb, 0x84, 0x66, 0x86, 0x06, 0x3b, 0x33, 0x4e, 0x00, 0x62,
+	0x75, 0x3a, 0x10, 0xbb, 0xcd, 0x7b, 0x50, 0x0d, 0x2e, 0x46, 0xa6, 0x11, 0xdb, 0x94, 0xee, 0xb1,
+	0x91, 0x58, 0xe8, 0x1b, 0x27, 0x84, 0x12, 0x56, 0x5d, 0x4c, 0x06, 0x12, 0xd7, 0x6c, 0x13, 0x69,
+	0x90, 0xb2, 0xc9, 0x73, 0x79, 0x02, 0x0b, 0x37, 0x29, 0x26, 0x4e, 0xad, 0xd2, 0xd1, 0x03, 0x20,
+	0xac, 0x4e, 0xb0, 0xdf, 0xfc, 0xec, 0xbd, 0x03, 0x93, 0xac, 0x4b, 0x33, 0x5c, 0xc7, 0xf3, 0x88,
+	0x99, 0x19, 0x6d, 0xaf, 0x7d, 0xa2, 0xa3, 0x58, 0x4d, 0xb3, 0x66, 0x49, 0xb4, 0x58, 0x90, 0xf0,
+	0x51, 0x66, 0x85, 0xb1, 0xc4, 0x41, 0x22, 0x74, 0x9b, 0x8e, 0x48, 0xe1, 0xfc, 0xc7, 0xd9, 0x4f,
+	0x46, 0xfd, 0x87, 0x90, 0x0a, 0xab, 0x81, 0xf1, 0xc4, 0xd4, 0x85, 0x91, 0x67, 0xda, 0xce, 0x2f,
+	0x56, 0x3e, 0xfb, 0xbf, 0x99, 0x71, 0xc5, 0x2d, 0x80, 0xa5, 0xa1, 0x89, 0xc1, 0x24, 0x04, 0x40,
+	0x58, 0x9d, 0xd0, 0x65, 0xba, 0x63, 0xe7, 0x8b, 0xec, 0x67, 0x89, 0x29, 0x35, 0xd8, 0xf9, 0x12,
+	0x22, 0x61, 0x55, 0xea, 0xcd, 0x32, 0x55, 0x05, 0x60, 0x87, 0x10, 0xcd, 0xd8, 0x63, 0xfe, 0x99,
+	0x81, 0xc1, 0x64, 0x84, 0x48, 0x58, 0x4d, 0xed, 0x10, 0x52, 0x12, 0xbf, 0x3f, 0x06, 0xcc, 0x13,
+	0xfb, 0xb6, 0x43, 0xf5, 0x6a, 0x50, 0x68, 0x0c, 0x72, 0xbd, 0xc7, 0x3f, 0x51, 0xe0, 0xad, 0x33,
+	0x31, 0xe5, 0x49, 0x51, 0x89, 0x7a, 0x81, 0x28, 0x74, 0xef, 0xf6, 0x75, 0x1d, 0x0e, 0xae, 0x81,
+	0xfe, 0x3b, 0x58, 0xe8, 0x13, 0x5f, 0xfb, 0x4f, 0x56, 0xa5, 0xaa, 0x6e, 0xd5, 0xf4, 0x4a, 0x95,
+	0xac, 0x13, 0xe2, 0xf5, 0x75, 0x2c, 0x7f, 0x13, 0xc6, 0x3c, 0xc2, 0x5e, 0xa7, 0x64, 0x2e, 0x9a,
+	0x3d, 0x6e, 0xe5, 0xa6, 0xc4, 0x5c, 0xd1, 0x8f, 0x55, 0x39, 0x01, 0xdd, 0x8a, 0x5d, 0x0d, 0x59,
+	0x16, 0x19, 0x2e, 0xbe, 0xd1, 0xf5, 0xc9, 0xe2, 0x56, 0xec, 0xca, 0x37, 0xd2, 0xbe, 0xea, 0x94,
+	0x47, 0x88, 0x23, 0xc8, 0x76, 0x22, 0x28, 0x6d, 0xac, 0xc1, 0x45, 0xc3, 0x1f, 0x60, 0x27, 0x95,
+	0x7f, 0xa3, 0x38, 0xe3, 0x0c, 0xfd, 0x7f, 0x79, 0x86, 0xca, 0x24, 0x16, 0x5f, 0x8e, 0xd5, 0x29,
+	0x23, 0x2a, 0x08, 0x7f, 0xa6, 0xc0, 0xbc, 0xbc, 0xdd, 0xd4, 0x2c, 0xfa, 0x11, 0x7b, 0x8a, 0xed,
+	0xcf, 0xbc, 0xd7, 0x60, 0xd4, 0x39, 0xb4, 0x03, 0xeb, 0xce, 0x84, 0x85, 0x1d, 0xef, 0xc6, 0xaa,
+	0x18, 0x6e, 0xbb, 0x96, 0x0d, 0xf7, 0x7d, 0x2d, 0xfb, 0xbd, 0x02, 0x99, 0x93, 0x8a, 0x4b, 0xb3,
+	0x3d, 0x81, 0xc9, 0xc8, 0xdb, 0xb2, 0x6f, 0xb4, 0x1b, 0x3d, 0x7b, 0xa7, 0x8f, 0x28, 0x1d, 0x32,
+	0x5d, 0x0d, 0x65, 0x9c, 0xdf, 0x6b, 0xe0, 0x7d, 0xf8, 0x3f, 0x11, 0x66, 0x96, 0xb1, 0xbf, 0x62,
+	0x18, 0x0d, 0x5e, 0x99, 0x39, 0x6e, 0x5f, 0x31, 0xfb, 0x95, 0x02, 0x6f, 0x76, 0x06, 0x93, 0x16,
+	0xa1, 0x30, 0xc3, 0x13, 0xb9, 0x1e, 0x8e, 0xf5, 0x51, 0x09, 0x88, 0x83, 0x61, 0x3e, 0x72, 0x30,
+	0x44, 0xf0, 0xb0, 0x3a, 0x4d, 0xe3, 0xd2, 0xd1, 0x06, 0x8c, 0x50, 0xab, 0x46, 0xa4, 0x95, 0xb2,
+	0x27, 0xde, 0x5b, 0xb7, 0xfd, 0xef, 0x0f, 0x41, 0x25, 0x19, 0xbc, 0x78, 0xd4, 0x08, 0xfe, 0xf4,
+	0xeb, 0x9c, 0xa2, 0x72, 0x80, 0x9b, 0x7f, 0x9c, 0x83, 0x51, 0xce, 0x0f, 0xfd, 0x46, 0x01, 0xfe,
+	0x3a, 0xeb, 0xa1, 0xef, 0xf6, 0xb8, 0x9d, 0x27, 0x9e, 0x99, 0xb3, 0xef, 0xf5, 0xb1, 0x52, 0xd8,
+	0x11, 0xdf, 0xfa, 0xd1, 0x17, 0x7f, 0xfd, 0xc5, 0x50, 0x1e, 0xbd, 0x5b, 0xe8, 0xf4, 0x6d, 0x23,
+	0x80, 0x08, 0xbf, 0xeb, 0x70, 0x55, 0x3f, 0x53, 0x60, 0x4c, 0xbc, 0xcf, 0xa2, 0x64, 0xb2, 0xa3,
+	0x0f, 0xc5, 0xd9, 0x3b, 0xfd, 0x2c, 0x95, 0x7a, 0x2f, 0x73, 0xbd, 0x0b, 0x68, 0xa9, 0x57, 0xbd,
+	0x85, 0xb6, 0xff, 0x56, 0x60, 0xfe, 0x94, 0xd7, 0x51, 0x74, 0x3f, 0x89, 0x3a, 0x67, 0xbf, 0x3f,
+	0x67, 0x37, 0xcf, 0x05, 0x4b, 0x72, 0x2d, 0x73, 0xae, 0x25, 0xb4, 0xd2, 0x23, 0xd7, 0xf6, 0x57,
+	0x1b, 0x6d, 0xc7, 0x71, 0x35, 0x97, 0x73, 0xfc, 0x52, 0x81, 0xa9, 0xd8, 0x27, 0x21, 0x74, 0x2f,
+	0x89, 0xa6, 0x9d, 0x3e, 0x63, 0x65, 0x57, 0x06, 0x40, 0x90, 0x0c, 0x8b, 0x9c, 0xe1, 0xf7, 0xd0,
+	0x9d, 0x9e, 0xbd, 0x50, 0x22, 0x14, 0x5e, 0xc8, 0xcf, 0x63, 0x47, 0xa8, 0xa5, 0xc0, 0x64, 0xf4,
+	0x43, 0x10, 0xfa, 0x20, 0x59, 0x54, 0x9c, 0xf8, 0x30, 0x95, 0xbd, 0xd7, 0x3f, 0x80, 0xe4, 0xb5,
+	0xc9, 0x79, 0xad, 0xa1, 0x52, 0x42, 0x5e, 0x5a, 0xa5, 0xa9, 0x59, 0x66, 0xe1, 0x45, 0x3d, 0xfc,
+	0xf4, 0x75, 0x14, 0xf7, 0xdd, 0xf8, 0x9b, 0x5d, 0x9f, 0xbe, 0xdb, 0xf1, 0x91, 0x35, 0xbb, 0x79,
+	0x2e, 0x58, 0x03, 0xfb, 0xae, 0x4d, 0x58, 0x8d, 0xab, 0x99, 0x01, 0xc7, 0x1f, 0x0f, 0xc1, 0xc2,
+	0xa9, 0xf7, 0x7d, 0xf4, 0x20, 0x89, 0xd6, 0xdd, 0x1e, 0x48, 0xb2, 0x0f, 0xcf, 0x09, 0x4d, 0x5a,
+	0xe1, 0x01, 0xb7, 0xc2, 0x3a, 0x5a, 0xed, 0xd1, 0x0a, 0x9e, 0x44, 0xd4, 0xf8, 0x8d, 0x9e, 0x30,
+	0x4c, 0x2d, 0x28, 0xfb, 0xd1, 0xbf, 0x14, 0xb8, 0xdc, 0xb9, 0x96, 0x45, 0xe5, 0x24, 0x7a, 0x9f,
+	0x59, 0x63, 0x67, 0xef, 0x9f, 0x07, 0x94, 0xe4, 0xff, 0x21, 0xe7, 0x5f, 0x44, 0xf7, 0x7a, 0xe4,
+	0x4f, 0x19, 0x5c, 0xf8, 0xa0, 0x1a, 0x49, 0x60, 0x5f, 0x28, 0x30, 0x15, 0x2b, 0x2d, 0x93, 0x25,
+	0xb0, 0x4e, 0x65, 0x77, 0x76, 0x65, 0x00, 0x04, 0x49, 0xf0, 0x7d, 0x4e, 0xf0, 0x36, 0x5a, 0xee,
+	0x91, 0x60, 0xbc, 0x8a, 0x45, 0x5f, 0x29, 0x90, 0x8e, 0xd4, 0x7d, 0xe8, 0x6e, 0xb2, 0x10, 0x6c,
+	0xaf, 0x74, 0xb3, 0x1f, 0xf4, 0xbd, 0x5e, 0xf2, 0x79, 0xc8, 0xf9, 0x6c, 0xa0, 0xb5, 0x9e, 0xc3,
+	0x36, 0xac, 0x4e, 0x0b, 0x2f, 0x64, 0x39, 0x77, 0x54, 0x78, 0xc1, 0x6b, 0xe4, 0x23, 0xf4, 0x37,
+	0x05, 0xa6, 0xdb, 0x2a, 0x39, 0x54, 0x4c, 0xe4, 0x5f, 0x1d, 0x6b, 0xca, 0x6c, 0x69, 0x20, 0x8c,
+	0x3e, 0x53, 0x54, 0x7b, 0x9d, 0x18, 0xf2, 0x2d, 0x56, 0x5e, 0xbe, 0x5a, 0x54, 0x3e, 0x7f, 0xb5,
+	0xa8, 0xfc, 0xe5, 0xd5, 0xa2, 0xf2, 0xe9, 0xeb, 0xc5, 0x0b, 0x9f, 0xbf, 0x5e, 0xbc, 0xf0, 0xe5,
+	0xeb, 0xc5, 0x0b, 0x4f, 0x3e, 0x8c, 0x54, 0xa3, 0x52, 0xcc, 0x52, 0x55, 0xaf, 0x78, 0x81, 0xcc,
+	0x67, 0x37, 0x96, 0x0b, 0xcf, 0x4f, 0xfb, 0xc7, 0x12, 0x5e, 0xad, 0x8a, 0xff, 0xb0, 0xa9, 0x8c,
+	0xf1, 0x6a, 0xf3, 0x3b, 0xff, 0x19, 0x00, 0x9b, 0x58, 0x2c, 0x57, 0x3e, 0x24, 0x00, 0x00,
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Pools returns all concentrated liquidity pools
+	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
+	// Params returns concentrated liquidity module params.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// LiquidityDepthsForRange returns Liqiudity Depths for given range
+	LiquidityDepthsForRange(ctx context.Context, in *QueryLiquidityDepthsForRangeRequest, opts ...grpc.CallOption) (*QueryLiquidityDepthsForRangeResponse, error)
+	// UserPositions returns all concentrated postitions of some address.
+	UserPositions(ctx context.Context, in *QueryUserPositionsRequest, opts ...grpc.CallOption) (*QueryUserPositionsResponse, error)
+	// PositionById returns a position with the given id together with its
+	// underlying assets.
+	PositionById(ctx context.Context, in *QueryPositionByIdRequest, opts ...grpc.CallOption) (*QueryPositionByIdResponse, error)
+	// LiquidityNetInDirection returns the liquidity net of the initialized ticks
+	// of a pool, walking from the current tick in the direction of a swap of the
+	// given token in up to a bound tick. Results are paginated by tick.
+	LiquidityNetInDirection(ctx context.Context, in *QueryLiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*QueryLiquidityNetInDirectionResponse, error)
+	// SimulateSwapExactAmountIn runs a swap of an exact amount in against the
+	// current state of a pool without persisting it, and returns a trace of
+	// every step of the swap.
+	SimulateSwapExactAmountIn(ctx context.Context, in *QuerySimulateSwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySimulateSwapExactAmountInResponse, error)
+	// TotalLiquidityForRange the amount of liquidity existing within given range.
+	TotalLiquidityForRange(ctx context.Context, in *QueryTotalLiquidityForRangeRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityForRangeResponse, error)
+	ClaimableFees(ctx context.Context, in *QueryClaimableFeesRequest, opts ...grpc.CallOption) (*QueryClaimableFeesResponse, error)
+	// LimitOrders returns the open and filled limit orders of an address in a
+	// pool.
+	LimitOrders(ctx context.Context, in *QueryLimitOrdersRequest, opts ...grpc.CallOption) (*QueryLimitOrdersResponse, error)
+	// TickAccumulator returns the tick accumulator of a pool as of the current
+	// block time. The time weighted average tick between two observations is the
+	// difference in accumulators divided by the elapsed milliseconds.
+	TickAccumulator(ctx context.Context, in *QueryTickAccumulatorRequest, opts ...grpc.CallOption) (*QueryTickAccumulatorResponse, error)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryLiquidityDepthsForRangeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
+	out := new(QueryPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Pools", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLiquidityDepthsForRangeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLiquidityDepthsForRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.UpperTick.Size()
-		i -= size
-		if _, err := m.UpperTick.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.LowerTick.Size()
-		i -= size
-		if _, err := m.LowerTick.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) LiquidityDepthsForRange(ctx context.Context, in *QueryLiquidityDepthsForRangeRequest, opts ...grpc.CallOption) (*QueryLiquidityDepthsForRangeResponse, error) {
+	out := new(QueryLiquidityDepthsForRangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityDepthsForRange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *queryClient) UserPositions(ctx context.Context, in *QueryUserPositionsRequest, opts ...grpc.CallOption) (*QueryUserPositionsResponse, error) {
+	out := new(QueryUserPositionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryLiquidityDepthsForRangeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PositionById(ctx context.Context, in *QueryPositionByIdRequest, opts ...grpc.CallOption) (*QueryPositionByIdResponse, error) {
+	out := new(QueryPositionByIdResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionById", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLiquidityDepthsForRangeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LiquidityNetInDirection(ctx context.Context, in *QueryLiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*QueryLiquidityNetInDirectionResponse, error) {
+	out := new(QueryLiquidityNetInDirectionResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLiquidityDepthsForRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LiquidityDepths) > 0 {
-		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) SimulateSwapExactAmountIn(ctx context.Context, in *QuerySimulateSwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySimulateSwapExactAmountInResponse, error) {
+	out := new(QuerySimulateSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/SimulateSwapExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LiquidityDepth) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TotalLiquidityForRange(ctx context.Context, in *QueryTotalLiquidityForRangeRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityForRangeResponse, error) {
+	out := new(QueryTotalLiquidityForRangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/TotalLiquidityForRange", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *LiquidityDepth) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *LiquidityDepth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TickIndex.Size()
-		i -= size
-		if _, err := m.TickIndex.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.LiquidityNet.Size()
-		i -= size
-		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *LiquidityDepthWithRange) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ClaimableFees(ctx context.Context, in *QueryClaimableFeesRequest, opts ...grpc.CallOption) (*QueryClaimableFeesResponse, error) {
+	out := new(QueryClaimableFeesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableFees", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LiquidityDepthWithRange) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *LiquidityDepthWithRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.UpperTick.Size()
-		i -= size
-		if _, err := m.UpperTick.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.LowerTick.Size()
-		i -= size
-		if _, err := m.LowerTick.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.LiquidityAmount.Size()
-		i -= size
-		if _, err := m.LiquidityAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) LimitOrders(ctx context.Context, in *QueryLimitOrdersRequest, opts ...grpc.CallOption) (*QueryLimitOrdersResponse, error) {
+	out := new(QueryLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LimitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTotalLiquidityForRangeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TickAccumulator(ctx context.Context, in *QueryTickAccumulatorRequest, opts ...grpc.CallOption) (*QueryTickAccumulatorResponse, error) {
+	out := new(QueryTickAccumulatorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTotalLiquidityForRangeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Pools returns all concentrated liquidity pools
+	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
+	// Params returns concentrated liquidity module params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// LiquidityDepthsForRange returns Liqiudity Depths for given range
+	LiquidityDepthsForRange(context.Context, *QueryLiquidityDepthsForRangeRequest) (*QueryLiquidityDepthsForRangeResponse, error)
+	// UserPositions returns all concentrated postitions of some address.
+	UserPositions(context.Context, *QueryUserPositionsRequest) (*QueryUserPositionsResponse, error)
+	// PositionById returns a position with the given id together with its
+	// underlying assets.
+	PositionById(context.Context, *QueryPositionByIdRequest) (*QueryPositionByIdResponse, error)
+	// LiquidityNetInDirection returns the liquidity net of the initialized ticks
+	// of a pool, walking from the current tick in the direction of a swap of the
+	// given token in up to a bound tick. Results are paginated by tick.
+	LiquidityNetInDirection(context.Context, *QueryLiquidityNetInDirectionRequest) (*QueryLiquidityNetInDirectionResponse, error)
+	// SimulateSwapExactAmountIn runs a swap of an exact amount in against the
+	// current state of a pool without persisting it, and returns a trace of
+	// every step of the swap.
+	SimulateSwapExactAmountIn(context.Context, *QuerySimulateSwapExactAmountInRequest) (*QuerySimulateSwapExactAmountInResponse, error)
+	// TotalLiquidityForRange the amount of liquidity existing within given range.
+	TotalLiquidityForRange(context.Context, *QueryTotalLiquidityForRangeRequest) (*QueryTotalLiquidityForRangeResponse, error)
+	ClaimableFees(context.Context, *QueryClaimableFeesRequest) (*QueryClaimableFeesResponse, error)
+	// LimitOrders returns the open and filled limit orders of an address in a
+	// pool.
+	LimitOrders(context.Context, *QueryLimitOrdersRequest) (*QueryLimitOrdersResponse, error)
+	// TickAccumulator returns the tick accumulator of a pool as of the current
+	// block time. The time weighted average tick between two observations is the
+	// difference in accumulators divided by the elapsed milliseconds.
+	TickAccumulator(context.Context, *QueryTickAccumulatorRequest) (*QueryTickAccumulatorResponse, error)
 }
 
-func (m *QueryTotalLiquidityForRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryTotalLiquidityForRangeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
 }
-
-func (m *QueryTotalLiquidityForRangeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityDepthsForRange(ctx context.Context, req *QueryLiquidityDepthsForRangeRequest) (*QueryLiquidityDepthsForRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityDepthsForRange not implemented")
+}
+func (*UnimplementedQueryServer) UserPositions(ctx context.Context, req *QueryUserPositionsRequest) (*QueryUserPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPositions not implemented")
+}
+func (*UnimplementedQueryServer) PositionById(ctx context.Context, req *QueryPositionByIdRequest) (*QueryPositionByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionById not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityNetInDirection(ctx context.Context, req *QueryLiquidityNetInDirectionRequest) (*QueryLiquidityNetInDirectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityNetInDirection not implemented")
+}
+func (*UnimplementedQueryServer) SimulateSwapExactAmountIn(ctx context.Context, req *QuerySimulateSwapExactAmountInRequest) (*QuerySimulateSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSwapExactAmountIn not implemented")
+}
+func (*UnimplementedQueryServer) TotalLiquidityForRange(ctx context.Context, req *QueryTotalLiquidityForRangeRequest) (*QueryTotalLiquidityForRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidityForRange not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableFees(ctx context.Context, req *QueryClaimableFeesRequest) (*QueryClaimableFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableFees not implemented")
+}
+func (*UnimplementedQueryServer) LimitOrders(ctx context.Context, req *QueryLimitOrdersRequest) (*QueryLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrders not implemented")
+}
+func (*UnimplementedQueryServer) TickAccumulator(ctx context.Context, req *QueryTickAccumulatorRequest) (*QueryTickAccumulatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TickAccumulator not implemented")
 }
 
-func (m *QueryTotalLiquidityForRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryClaimableFeesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryClaimableFeesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).Pools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Pools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryClaimableFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpperTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
-		i--
-		dAtA[i] = 0x20
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.LowerTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Params",
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryClaimableFeesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LiquidityDepthsForRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityDepthsForRangeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityDepthsForRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityDepthsForRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityDepthsForRange(ctx, req.(*QueryLiquidityDepthsForRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryClaimableFeesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryClaimableFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClaimableFees) > 0 {
-		for iNdEx := len(m.ClaimableFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClaimableFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_UserPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).UserPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserPositions(ctx, req.(*QueryUserPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Query_PositionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryUserPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).PositionById(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionById",
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionById(ctx, req.(*QueryPositionByIdRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryUserPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LiquidityNetInDirection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityNetInDirectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityNetInDirection(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection",
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityNetInDirection(ctx, req.(*QueryLiquidityNetInDirectionRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SimulateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateSwapExactAmountInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateSwapExactAmountIn(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/SimulateSwapExactAmountIn",
 	}
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateSwapExactAmountIn(ctx, req.(*QuerySimulateSwapExactAmountInRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TotalLiquidityForRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidityForRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryLiquidityDepthsForRangeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidityForRange(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/TotalLiquidityForRange",
 	}
-	l = m.LowerTick.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.UpperTick.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidityForRange(ctx, req.(*QueryTotalLiquidityForRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLiquidityDepthsForRangeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ClaimableFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.LiquidityDepths) > 0 {
-		for _, e := range m.LiquidityDepths {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableFees(ctx, in)
 	}
-	return n
-}
-
-func (m *LiquidityDepth) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableFees",
 	}
-	var l int
-	_ = l
-	l = m.LiquidityNet.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TickIndex.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *LiquidityDepthWithRange) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableFees(ctx, req.(*QueryClaimableFeesRequest))
 	}
-	var l int
-	_ = l
-	l = m.LiquidityAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.LowerTick.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.UpperTick.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalLiquidityForRangeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLimitOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrders(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryTotalLiquidityForRangeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LimitOrders",
 	}
-	var l int
-	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrders(ctx, req.(*QueryLimitOrdersRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryClaimableFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TickAccumulator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTickAccumulatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).TickAccumulator(ctx, in)
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulator",
 	}
-	if m.LowerTick != 0 {
-		n += 1 + sovQuery(uint64(m.LowerTick))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TickAccumulator(ctx, req.(*QueryTickAccumulatorRequest))
 	}
-	if m.UpperTick != 0 {
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "LiquidityDepthsForRange",
+			Handler:    _Query_LiquidityDepthsForRange_Handler,
+		},
+		{
+			MethodName: "UserPositions",
+			Handler:    _Query_UserPositions_Handler,
+		},
+		{
+			MethodName: "PositionById",
+			Handler:    _Query_PositionById_Handler,
+		},
+		{
+			MethodName: "LiquidityNetInDirection",
+			Handler:    _Query_LiquidityNetInDirection_Handler,
+		},
+		{
+			MethodName: "SimulateSwapExactAmountIn",
+			Handler:    _Query_SimulateSwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "TotalLiquidityForRange",
+			Handler:    _Query_TotalLiquidityForRange_Handler,
+		},
+		{
+			MethodName: "ClaimableFees",
+			Handler:    _Query_ClaimableFees_Handler,
+		},
+		{
+			MethodName: "LimitOrders",
+			Handler:    _Query_LimitOrders_Handler,
+		},
+		{
+			MethodName: "TickAccumulator",
+			Handler:    _Query_TickAccumulator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/concentrated-liquidity/pool-model/query.proto",
+}
+
+func (m *QueryUserPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityDepthsForRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityDepthsForRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityDepthsForRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UpperTick.Size()
+		i -= size
+		if _, err := m.UpperTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LowerTick.Size()
+		i -= size
+		if _, err := m.LowerTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityDepthsForRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityDepthsForRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityDepthsForRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LiquidityDepths) > 0 {
+		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityDepth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityDepth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityDepth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TickIndex.Size()
+		i -= size
+		if _, err := m.TickIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityDepthWithRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityDepthWithRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityDepthWithRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UpperTick.Size()
+		i -= size
+		if _, err := m.UpperTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LowerTick.Size()
+		i -= size
+		if _, err := m.LowerTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.LiquidityAmount.Size()
+		i -= size
+		if _, err := m.LiquidityAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityNetInDirectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityNetInDirectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityNetInDirectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UseNoBound {
+		i--
+		if m.UseNoBound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.BoundTick.Size()
+		i -= size
+		if _, err := m.BoundTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityNetInDirectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityNetInDirectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityNetInDirectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.CurrentSqrtPrice.Size()
+		i -= size
+		if _, err := m.CurrentSqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CurrentLiquidity.Size()
+		i -= size
+		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CurrentTick.Size()
+		i -= size
+		if _, err := m.CurrentTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.LiquidityDepths) > 0 {
+		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TickLiquidityNet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickLiquidityNet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickLiquidityNet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SqrtPrice.Size()
+		i -= size
+		if _, err := m.SqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TickIndex.Size()
+		i -= size
+		if _, err := m.TickIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapStepTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapStepTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapStepTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeCharge.Size()
+		i -= size
+		if _, err := m.FeeCharge.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.AmountOut.Size()
+		i -= size
+		if _, err := m.AmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TickEnd.Size()
+		i -= size
+		if _, err := m.TickEnd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.TickCrossed {
+		i--
+		if m.TickCrossed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.NextTick.Size()
+		i -= size
+		if _, err := m.NextTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SqrtPriceEnd.Size()
+		i -= size
+		if _, err := m.SqrtPriceEnd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SqrtPriceTarget.Size()
+		i -= size
+		if _, err := m.SqrtPriceTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SqrtPriceStart.Size()
+		i -= size
+		if _, err := m.SqrtPriceStart.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidityForRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidityForRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidityForRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidityForRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidityForRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidityForRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimableFees) > 0 {
+		for iNdEx := len(m.ClaimableFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLimitOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLimitOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLimitOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTickAccumulatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTickAccumulatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTickAccumulatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTickAccumulatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTickAccumulatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTickAccumulatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TickAccumulator.Size()
+		i -= size
+		if _, err := m.TickAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryUserPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryUserPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPositionByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *QueryPositionByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidityDepthsForRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = m.LowerTick.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UpperTick.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidityDepthsForRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityDepths) > 0 {
+		for _, e := range m.LiquidityDepths {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LiquidityDepth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TickIndex.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LiquidityDepthWithRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LowerTick.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UpperTick.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidityNetInDirectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.BoundTick.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.UseNoBound {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityNetInDirectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityDepths) > 0 {
+		for _, e := range m.LiquidityDepths {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.CurrentTick.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentLiquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentSqrtPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TickLiquidityNet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TickIndex.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SqrtPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulateSwapExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TokenOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SwapFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SwapStepTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SqrtPriceStart.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SqrtPriceTarget.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SqrtPriceEnd.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NextTick.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TickCrossed {
+		n += 2
+	}
+	l = m.TickEnd.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Liquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AmountIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AmountOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FeeCharge.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalLiquidityForRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryTotalLiquidityForRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryClaimableFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
 		n += 1 + sovQuery(uint64(m.UpperTick))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryClaimableFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimableFees) > 0 {
+		for _, e := range m.ClaimableFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLimitOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTickAccumulatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryTickAccumulatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TickAccumulator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryUserPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, model.PositionWithUnderlyingAssetBreakdown{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityDepthsForRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityDepthsForRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityDepthsForRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LowerTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpperTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityDepthsForRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityDepthsForRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityDepthsForRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityDepths = append(m.LiquidityDepths, LiquidityDepth{})
+			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityDepth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityDepth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityDepth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityDepthWithRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityDepthWithRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LowerTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpperTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityNetInDirectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityNetInDirectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BoundTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseNoBound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseNoBound = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityNetInDirectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityNetInDirectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
+			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickLiquidityNet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickLiquidityNet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryClaimableFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.ClaimableFees) > 0 {
-		for _, e := range m.ClaimableFees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QuerySimulateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateSwapExactAmountInRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryUserPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1839,15 +5588,115 @@ func (m *QueryUserPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateSwapExactAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1875,13 +5724,15 @@ func (m *QueryUserPositionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1891,11 +5742,26 @@ func (m *QueryUserPositionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, SwapStepTrace{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1917,7 +5783,7 @@ func (m *QueryUserPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *SwapStepTrace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1940,17 +5806,17 @@ func (m *QueryUserPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapStepTrace: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapStepTrace: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SqrtPriceStart", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1960,81 +5826,99 @@ func (m *QueryUserPositionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, model.PositionWithUnderlyingAssetBreakdown{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SqrtPriceStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqrtPriceTarget", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.SqrtPriceTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqrtPriceEnd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SqrtPriceEnd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTick", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2044,83 +5928,153 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NextTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickCrossed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TickCrossed = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickEnd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickEnd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2130,31 +6084,31 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCharge", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2164,25 +6118,23 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeeCharge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2207,7 +6159,7 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalLiquidityForRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2230,12 +6182,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalLiquidityForRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalLiquidityForRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2257,7 +6228,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalLiquidityForRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2280,15 +6251,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalLiquidityForRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalLiquidityForRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2315,7 +6286,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Liquidity = append(m.Liquidity, LiquidityDepthWithRange{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2340,7 +6312,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityDepthsForRangeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimableFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2363,10 +6335,10 @@ func (m *QueryLiquidityDepthsForRangeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityDepthsForRangeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimableFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityDepthsForRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimableFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2390,7 +6362,7 @@ func (m *QueryLiquidityDepthsForRangeRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2418,15 +6390,13 @@ func (m *QueryLiquidityDepthsForRangeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LowerTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
 			}
-			var stringLen uint64
+			m.LowerTick = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2436,81 +6406,16 @@ func (m *QueryLiquidityDepthsForRangeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LowerTick |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.UpperTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryLiquidityDepthsForRangeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityDepthsForRangeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityDepthsForRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
 			}
-			var msglen int
+			m.UpperTick = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2520,26 +6425,11 @@ func (m *QueryLiquidityDepthsForRangeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UpperTick |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LiquidityDepths = append(m.LiquidityDepths, LiquidityDepth{})
-			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2561,7 +6451,7 @@ func (m *QueryLiquidityDepthsForRangeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityDepth) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimableFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2578,57 +6468,23 @@ func (m *LiquidityDepth) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityDepth: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityDepth: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableFees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2638,23 +6494,23 @@ func (m *LiquidityDepth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TickIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimableFees = append(m.ClaimableFees, types2.Coin{})
+			if err := m.ClaimableFees[len(m.ClaimableFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2679,7 +6535,7 @@ func (m *LiquidityDepth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
+func (m *QueryLimitOrdersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2702,17 +6558,17 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityDepthWithRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLimitOrdersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityDepthWithRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLimitOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2722,29 +6578,14 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2772,15 +6613,13 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LowerTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2790,23 +6629,25 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UpperTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2831,7 +6672,7 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalLiquidityForRangeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLimitOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2854,17 +6695,17 @@ func (m *QueryTotalLiquidityForRangeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalLiquidityForRangeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLimitOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalLiquidityForRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2874,64 +6715,29 @@ func (m *QueryTotalLiquidityForRangeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalLiquidityForRangeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.LimitOrders = append(m.LimitOrders, model.LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalLiquidityForRangeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalLiquidityForRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2958,8 +6764,10 @@ func (m *QueryTotalLiquidityForRangeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, LiquidityDepthWithRange{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2984,7 +6792,7 @@ func (m *QueryTotalLiquidityForRangeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimableFeesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTickAccumulatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3007,10 +6815,10 @@ func (m *QueryClaimableFeesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimableFeesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTickAccumulatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimableFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTickAccumulatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3032,76 +6840,6 @@ func (m *QueryClaimableFeesRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
-			}
-			m.LowerTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LowerTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
-			}
-			m.UpperTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpperTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3123,7 +6861,7 @@ func (m *QueryClaimableFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimableFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTickAccumulatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3146,15 +6884,49 @@ func (m *QueryClaimableFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimableFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTickAccumulatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimableFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTickAccumulatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TickAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3181,8 +6953,7 @@ func (m *QueryClaimableFeesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimableFees = append(m.ClaimableFees, types2.Coin{})
-			if err := m.ClaimableFees[len(m.ClaimableFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,15 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagInstantiateMsg = "instantiate-msg"
+)
+
+func FlagSetCreatePool() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagInstantiateMsg, "", "The json message the pool contract is instantiated with")
+	return fs
+}
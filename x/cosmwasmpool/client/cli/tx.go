@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/model"
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/types"
+)
+
+func NewTxCmd() *cobra.Command {
+	txCmd := osmocli.TxIndexCmd(types.ModuleName)
+	osmocli.AddTxCmd(txCmd, NewCreateCosmWasmPoolCmd)
+	return txCmd
+}
+
+func NewCreateCosmWasmPoolCmd() (*osmocli.TxCliDesc, *model.MsgCreateCosmWasmPool) {
+	return &osmocli.TxCliDesc{
+		Use:     "create-pool [code-id]",
+		Short:   "create a cosmwasm pool by instantiating a whitelisted pool contract",
+		Example: "create-pool 1 --instantiate-msg '{\"pool_asset_denoms\":[\"uion\",\"uosmo\"]}' --from val --chain-id osmosis-1",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"InstantiateMsg": osmocli.FlagOnlyParser(parseInstantiateMsg),
+		},
+		Flags: osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetCreatePool()}},
+	}, &model.MsgCreateCosmWasmPool{}
+}
+
+func parseInstantiateMsg(fs *flag.FlagSet) ([]byte, error) {
+	instantiateMsg, err := fs.GetString(FlagInstantiateMsg)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid([]byte(instantiateMsg)) {
+		return nil, fmt.Errorf("--%s must be valid json", FlagInstantiateMsg)
+	}
+	return []byte(instantiateMsg), nil
+}
@@ -0,0 +1,72 @@
+package msg
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/types"
+)
+
+// Query marshals the given query message to json, queries the contract at
+// the given address and unmarshals the response into the response type K.
+func Query[T any, K any](ctx sdk.Context, wasmKeeper types.WasmKeeper, contractAddress string, queryMsg T) (K, error) {
+	var response K
+
+	address, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		return response, err
+	}
+
+	bz, err := json.Marshal(queryMsg)
+	if err != nil {
+		return response, err
+	}
+
+	responseBz, err := wasmKeeper.QuerySmart(ctx, address, bz)
+	if err != nil {
+		return response, err
+	}
+
+	if err := json.Unmarshal(responseBz, &response); err != nil {
+		return response, types.ContractResponseError{ContractAddress: contractAddress, Err: err}
+	}
+
+	return response, nil
+}
+
+// MustQuery is the same as Query but panics on error.
+func MustQuery[T any, K any](ctx sdk.Context, wasmKeeper types.WasmKeeper, contractAddress string, queryMsg T) K {
+	response, err := Query[T, K](ctx, wasmKeeper, contractAddress, queryMsg)
+	if err != nil {
+		panic(err)
+	}
+	return response
+}
+
+// Sudo marshals the given sudo message to json, sends it to the contract at
+// the given address and unmarshals the response into the response type K.
+func Sudo[T any, K any](ctx sdk.Context, contractKeeper types.ContractKeeper, contractAddress string, sudoMsg T) (K, error) {
+	var response K
+
+	address, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		return response, err
+	}
+
+	bz, err := json.Marshal(sudoMsg)
+	if err != nil {
+		return response, err
+	}
+
+	responseBz, err := contractKeeper.Sudo(ctx, address, bz)
+	if err != nil {
+		return response, err
+	}
+
+	if err := json.Unmarshal(responseBz, &response); err != nil {
+		return response, types.ContractResponseError{ContractAddress: contractAddress, Err: err}
+	}
+
+	return response, nil
+}
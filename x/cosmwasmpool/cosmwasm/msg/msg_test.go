@@ -0,0 +1,71 @@
+package msg_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/cosmwasm/msg"
+)
+
+// TestMsgJSON ensures that the messages sent to pool contracts are
+// serialized in the format the contracts expect.
+func TestMsgJSON(t *testing.T) {
+	tests := map[string]struct {
+		msg      interface{}
+		expected string
+	}{
+		"get swap fee query": {
+			msg:      msg.GetSwapFeeQueryMsg{},
+			expected: `{"get_swap_fee":{}}`,
+		},
+		"spot price query": {
+			msg: msg.SpotPriceQueryMsg{
+				SpotPrice: msg.SpotPrice{QuoteAssetDenom: "uosmo", BaseAssetDenom: "uion"},
+			},
+			expected: `{"spot_price":{"quote_asset_denom":"uosmo","base_asset_denom":"uion"}}`,
+		},
+		"calc out amount given in query": {
+			msg: msg.CalcOutAmtGivenInQueryMsg{
+				CalcOutAmtGivenIn: msg.CalcOutAmtGivenIn{
+					TokenIn:       sdk.NewCoin("uion", sdk.NewInt(100)),
+					TokenOutDenom: "uosmo",
+					SwapFee:       sdk.MustNewDecFromStr("0.01"),
+				},
+			},
+			expected: `{"calc_out_amt_given_in":{"token_in":{"denom":"uion","amount":"100"},"token_out_denom":"uosmo","swap_fee":"0.010000000000000000"}}`,
+		},
+		"swap exact amount in sudo": {
+			msg: msg.SwapExactAmountInSudoMsg{
+				SwapExactAmountIn: msg.SwapExactAmountIn{
+					Sender:            "osmo1sender",
+					TokenIn:           sdk.NewCoin("uion", sdk.NewInt(100)),
+					TokenOutDenom:     "uosmo",
+					TokenOutMinAmount: sdk.NewInt(90),
+					SwapFee:           sdk.ZeroDec(),
+				},
+			},
+			expected: `{"swap_exact_amount_in":{"sender":"osmo1sender","token_in":{"denom":"uion","amount":"100"},"token_out_denom":"uosmo","token_out_min_amount":"90","swap_fee":"0.000000000000000000"}}`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			bz, err := json.Marshal(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(bz))
+		})
+	}
+}
+
+func TestMsgResponseJSON(t *testing.T) {
+	var swapResponse msg.SwapExactAmountInSudoMsgResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"token_out_amount":"95"}`), &swapResponse))
+	require.Equal(t, sdk.NewInt(95), swapResponse.TokenOutAmount)
+
+	var spotPriceResponse msg.SpotPriceQueryMsgResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"spot_price":"1.5"}`), &spotPriceResponse))
+	require.Equal(t, sdk.MustNewDecFromStr("1.5"), spotPriceResponse.SpotPrice)
+}
@@ -0,0 +1,95 @@
+package msg
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// The messages in this file are the json queries understood by cosmwasm
+// pool contracts. Each query is wrapped in an object keyed by its snake
+// case name, e.g. {"get_swap_fee": {}}.
+
+// ===================== GetSwapFee
+type GetSwapFee struct{}
+
+type GetSwapFeeQueryMsg struct {
+	GetSwapFee GetSwapFee `json:"get_swap_fee"`
+}
+
+type GetSwapFeeQueryMsgResponse struct {
+	SwapFee sdk.Dec `json:"swap_fee"`
+}
+
+// ===================== IsActive
+type IsActive struct{}
+
+type IsActiveQueryMsg struct {
+	IsActive IsActive `json:"is_active"`
+}
+
+type IsActiveQueryMsgResponse struct {
+	IsActive bool `json:"is_active"`
+}
+
+// ===================== GetTotalPoolLiquidity
+type GetTotalPoolLiquidity struct{}
+
+type GetTotalPoolLiquidityQueryMsg struct {
+	GetTotalPoolLiquidity GetTotalPoolLiquidity `json:"get_total_pool_liquidity"`
+}
+
+type GetTotalPoolLiquidityQueryMsgResponse struct {
+	TotalPoolLiquidity sdk.Coins `json:"total_pool_liquidity"`
+}
+
+// ===================== GetPoolDenoms
+type GetPoolDenoms struct{}
+
+type GetPoolDenomsQueryMsg struct {
+	GetPoolDenoms GetPoolDenoms `json:"get_pool_denoms"`
+}
+
+type GetPoolDenomsQueryMsgResponse struct {
+	PoolDenoms []string `json:"pool_denoms"`
+}
+
+// ===================== SpotPrice
+type SpotPrice struct {
+	QuoteAssetDenom string `json:"quote_asset_denom"`
+	BaseAssetDenom  string `json:"base_asset_denom"`
+}
+
+type SpotPriceQueryMsg struct {
+	SpotPrice SpotPrice `json:"spot_price"`
+}
+
+type SpotPriceQueryMsgResponse struct {
+	SpotPrice sdk.Dec `json:"spot_price"`
+}
+
+// ===================== CalcOutAmtGivenIn
+type CalcOutAmtGivenIn struct {
+	TokenIn       sdk.Coin `json:"token_in"`
+	TokenOutDenom string   `json:"token_out_denom"`
+	SwapFee       sdk.Dec  `json:"swap_fee"`
+}
+
+type CalcOutAmtGivenInQueryMsg struct {
+	CalcOutAmtGivenIn CalcOutAmtGivenIn `json:"calc_out_amt_given_in"`
+}
+
+type CalcOutAmtGivenInQueryMsgResponse struct {
+	TokenOut sdk.Coin `json:"token_out"`
+}
+
+// ===================== CalcInAmtGivenOut
+type CalcInAmtGivenOut struct {
+	TokenOut     sdk.Coin `json:"token_out"`
+	TokenInDenom string   `json:"token_in_denom"`
+	SwapFee      sdk.Dec  `json:"swap_fee"`
+}
+
+type CalcInAmtGivenOutQueryMsg struct {
+	CalcInAmtGivenOut CalcInAmtGivenOut `json:"calc_in_amt_given_out"`
+}
+
+type CalcInAmtGivenOutQueryMsgResponse struct {
+	TokenIn sdk.Coin `json:"token_in"`
+}
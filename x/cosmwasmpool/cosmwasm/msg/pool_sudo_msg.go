@@ -0,0 +1,40 @@
+package msg
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// The messages in this file are the json sudo messages understood by
+// cosmwasm pool contracts. They can only be sent by the cosmwasmpool module.
+
+// ===================== SwapExactAmountIn
+type SwapExactAmountIn struct {
+	Sender            string   `json:"sender"`
+	TokenIn           sdk.Coin `json:"token_in"`
+	TokenOutDenom     string   `json:"token_out_denom"`
+	TokenOutMinAmount sdk.Int  `json:"token_out_min_amount"`
+	SwapFee           sdk.Dec  `json:"swap_fee"`
+}
+
+type SwapExactAmountInSudoMsg struct {
+	SwapExactAmountIn SwapExactAmountIn `json:"swap_exact_amount_in"`
+}
+
+type SwapExactAmountInSudoMsgResponse struct {
+	TokenOutAmount sdk.Int `json:"token_out_amount"`
+}
+
+// ===================== SwapExactAmountOut
+type SwapExactAmountOut struct {
+	Sender           string   `json:"sender"`
+	TokenInDenom     string   `json:"token_in_denom"`
+	TokenInMaxAmount sdk.Int  `json:"token_in_max_amount"`
+	TokenOut         sdk.Coin `json:"token_out"`
+	SwapFee          sdk.Dec  `json:"swap_fee"`
+}
+
+type SwapExactAmountOutSudoMsg struct {
+	SwapExactAmountOut SwapExactAmountOut `json:"swap_exact_amount_out"`
+}
+
+type SwapExactAmountOutSudoMsgResponse struct {
+	TokenInAmount sdk.Int `json:"token_in_amount"`
+}
@@ -0,0 +1,54 @@
+package cosmwasmpool
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/model"
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// InitGenesis initializes the cosmwasmpool module with the provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
+	var unpacker codectypes.AnyUnpacker = k.cdc
+	for _, any := range genState.Pools {
+		var pool poolmanagertypes.PoolI
+		if err := unpacker.UnpackAny(any, &pool); err != nil {
+			panic(err)
+		}
+		cosmWasmPool, ok := pool.(*model.CosmWasmPool)
+		if !ok {
+			panic(types.InvalidPoolTypeError{ActualPool: pool})
+		}
+		k.setPool(ctx, &model.Pool{CosmWasmPool: *cosmWasmPool, WasmKeeper: k.wasmKeeper})
+	}
+}
+
+// ExportGenesis returns the cosmwasmpool module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	pools, err := k.GetPools(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	poolAnys := make([]*codectypes.Any, 0, len(pools))
+	for _, poolI := range pools {
+		cosmWasmPool, err := asCosmWasmPool(poolI)
+		if err != nil {
+			panic(err)
+		}
+		any, err := codectypes.NewAnyWithValue(cosmWasmPool.GetStoreModel())
+		if err != nil {
+			panic(err)
+		}
+		poolAnys = append(poolAnys, any)
+	}
+
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+		Pools:  poolAnys,
+	}
+}
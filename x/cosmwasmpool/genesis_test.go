@@ -0,0 +1,38 @@
+package cosmwasmpool_test
+
+import (
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/types"
+)
+
+// TestGenesisRoundTrip tests that exported pools and params are restored by InitGenesis,
+// and that the restored pools query their contract.
+func (suite *KeeperTestSuite) TestGenesisRoundTrip() {
+	suite.SetupTest()
+	pool := suite.createTestPool()
+	contract := suite.contract
+
+	genesis := suite.App.CosmwasmPoolKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Equal(types.NewParams([]uint64{testPoolCodeId}), genesis.Params)
+	suite.Require().Len(genesis.Pools, 1)
+
+	// Import the genesis into a fresh app that runs the same contracts.
+	suite.SetupTest()
+	contract.bankKeeper = suite.App.BankKeeper
+	suite.contract = contract
+	suite.App.CosmwasmPoolKeeper.SetContractKeeper(contract)
+	suite.App.CosmwasmPoolKeeper.SetWasmKeeper(contract)
+	suite.FundAcc(pool.GetAddress(), defaultPoolLiquidity)
+	suite.App.CosmwasmPoolKeeper.InitGenesis(suite.Ctx, *genesis)
+
+	importedPool, err := suite.App.CosmwasmPoolKeeper.GetPool(suite.Ctx, pool.GetId())
+	suite.Require().NoError(err)
+	importedCosmWasmPool, ok := importedPool.(types.CosmWasmExtension)
+	suite.Require().True(ok)
+	suite.Require().Equal(pool.GetContractAddress(), importedCosmWasmPool.GetContractAddress())
+	suite.Require().Equal(pool.GetCodeId(), importedCosmWasmPool.GetCodeId())
+	suite.Require().Equal(pool.GetInstantiateMsg(), importedCosmWasmPool.GetInstantiateMsg())
+	suite.Require().Equal(defaultPoolSwapFee, importedPool.GetSwapFee(suite.Ctx))
+	suite.Require().Equal(defaultPoolLiquidity.String(), importedPool.GetTotalPoolLiquidity(suite.Ctx).String())
+
+	suite.Require().Equal(genesis, suite.App.CosmwasmPoolKeeper.ExportGenesis(suite.Ctx))
+}
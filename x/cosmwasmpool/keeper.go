@@ -0,0 +1,80 @@
+package cosmwasmpool
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+
+	paramSpace paramtypes.Subspace
+
+	// keepers
+	accountKeeper     types.AccountKeeper
+	bankKeeper        types.BankKeeper
+	poolmanagerKeeper types.PoolManagerKeeper
+	// wasm keepers are set after the wasm keeper is constructed.
+	contractKeeper types.ContractKeeper
+	wasmKeeper     types.WasmKeeper
+
+	// listeners
+	poolListeners poolmanagertypes.PoolListeners
+}
+
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper) *Keeper {
+	// ParamSubspace must be initialized within app/keepers/keepers.go
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+	return &Keeper{
+		storeKey:      storeKey,
+		cdc:           cdc,
+		paramSpace:    paramSpace,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+	}
+}
+
+// GetParams returns the total set of cosmwasmpool module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the cosmwasmpool module's parameters with the provided parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// SetPoolListeners sets the listeners notified of swaps in cosmwasm pools.
+func (k *Keeper) SetPoolListeners(poolListeners poolmanagertypes.PoolListeners) *Keeper {
+	if k.poolListeners != nil {
+		panic("cannot set cosmwasm pool listeners twice")
+	}
+
+	k.poolListeners = poolListeners
+
+	return k
+}
+
+// Set the poolmanager keeper.
+func (k *Keeper) SetPoolManagerKeeper(poolmanagerKeeper types.PoolManagerKeeper) {
+	k.poolmanagerKeeper = poolmanagerKeeper
+}
+
+// Set the contract keeper.
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
+	k.contractKeeper = contractKeeper
+}
+
+// Set the wasm keeper.
+func (k *Keeper) SetWasmKeeper(wasmKeeper types.WasmKeeper) {
+	k.wasmKeeper = wasmKeeper
+}
@@ -0,0 +1,67 @@
+package cosmwasmpool_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v15/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/model"
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/types"
+)
+
+const (
+	denomA = "denoma"
+	denomB = "denomb"
+
+	testPoolCodeId = uint64(1)
+)
+
+var (
+	defaultPoolSwapFee     = sdk.NewDecWithPrec(1, 2)
+	defaultPoolLiquidity   = sdk.NewCoins(sdk.NewInt64Coin(denomA, 1_000_000_000), sdk.NewInt64Coin(denomB, 1_000_000_000))
+	defaultInstantiateMsg  = testPoolInstantiateMsg{PoolAssetDenoms: []string{denomA, denomB}, SwapFee: defaultPoolSwapFee}
+	defaultSwapAmount      = sdk.NewInt(1_000_000)
+	defaultSwapAmountAfter = sdk.NewInt(990_000) // defaultSwapAmount minus the 1% swap fee.
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	contract *testPoolContract
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// SetupTest sets up the app with the test pool contract in place of the wasm keepers
+// of the cosmwasmpool module, and whitelists the test pool code id.
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+
+	suite.contract = newTestPoolContract(suite.App.BankKeeper)
+	suite.App.CosmwasmPoolKeeper.SetContractKeeper(suite.contract)
+	suite.App.CosmwasmPoolKeeper.SetWasmKeeper(suite.contract)
+	suite.App.CosmwasmPoolKeeper.SetParams(suite.Ctx, types.NewParams([]uint64{testPoolCodeId}))
+}
+
+// createTestPool creates a test pool contract through the poolmanager and funds it with the default liquidity.
+func (suite *KeeperTestSuite) createTestPool() types.CosmWasmExtension {
+	instantiateMsg, err := json.Marshal(defaultInstantiateMsg)
+	suite.Require().NoError(err)
+
+	suite.FundAcc(suite.TestAccs[0], suite.App.PoolManagerKeeper.GetParams(suite.Ctx).PoolCreationFee)
+	poolId, err := suite.App.PoolManagerKeeper.CreatePool(suite.Ctx, model.NewMsgCreateCosmWasmPool(testPoolCodeId, suite.TestAccs[0], instantiateMsg))
+	suite.Require().NoError(err)
+
+	pool, err := suite.App.CosmwasmPoolKeeper.GetPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	cosmWasmPool, ok := pool.(types.CosmWasmExtension)
+	suite.Require().True(ok)
+
+	suite.FundAcc(cosmWasmPool.GetAddress(), defaultPoolLiquidity)
+	return cosmWasmPool
+}
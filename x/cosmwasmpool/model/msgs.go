@@ -0,0 +1,93 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// constants.
+const (
+	TypeMsgCreateCosmWasmPool = "create_cosmwasm_pool"
+)
+
+var (
+	_ sdk.Msg                        = &MsgCreateCosmWasmPool{}
+	_ poolmanagertypes.CreatePoolMsg = &MsgCreateCosmWasmPool{}
+)
+
+func NewMsgCreateCosmWasmPool(
+	codeId uint64,
+	sender sdk.AccAddress,
+	instantiateMsg []byte,
+) MsgCreateCosmWasmPool {
+	return MsgCreateCosmWasmPool{
+		CodeId:         codeId,
+		Sender:         sender.String(),
+		InstantiateMsg: instantiateMsg,
+	}
+}
+
+func (msg MsgCreateCosmWasmPool) Route() string { return types.RouterKey }
+func (msg MsgCreateCosmWasmPool) Type() string  { return TypeMsgCreateCosmWasmPool }
+func (msg MsgCreateCosmWasmPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.CodeId == 0 {
+		return fmt.Errorf("code id must be positive")
+	}
+
+	if !json.Valid(msg.InstantiateMsg) {
+		return fmt.Errorf("instantiate msg must be valid json")
+	}
+
+	return nil
+}
+
+func (msg MsgCreateCosmWasmPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateCosmWasmPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+/// Implement the CreatePoolMsg interface
+
+func (msg MsgCreateCosmWasmPool) PoolCreator() sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return sender
+}
+
+func (msg MsgCreateCosmWasmPool) Validate(ctx sdk.Context) error {
+	return msg.ValidateBasic()
+}
+
+// InitialLiquidity returns no coins since liquidity is provided to the
+// pool contract directly after it is instantiated.
+func (msg MsgCreateCosmWasmPool) InitialLiquidity() sdk.Coins {
+	return sdk.Coins{}
+}
+
+func (msg MsgCreateCosmWasmPool) CreatePool(ctx sdk.Context, poolID uint64) (poolmanagertypes.PoolI, error) {
+	return NewCosmWasmPool(poolID, msg.CodeId, msg.InstantiateMsg), nil
+}
+
+func (msg MsgCreateCosmWasmPool) GetPoolType() poolmanagertypes.PoolType {
+	return poolmanagertypes.CosmWasm
+}
@@ -0,0 +1,118 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	appParams "github.com/osmosis-labs/osmosis/v15/app/params"
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/model"
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+func TestMsgCreateCosmWasmPool(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+	validInstantiateMsg := []byte(`{"pool_asset_denoms":["uion","uosmo"]}`)
+
+	tests := []struct {
+		name       string
+		msg        model.MsgCreateCosmWasmPool
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: model.MsgCreateCosmWasmPool{
+				Sender:         addr1,
+				CodeId:         1,
+				InstantiateMsg: validInstantiateMsg,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: model.MsgCreateCosmWasmPool{
+				Sender:         invalidAddr.String(),
+				CodeId:         1,
+				InstantiateMsg: validInstantiateMsg,
+			},
+			expectPass: false,
+		},
+		{
+			name: "missing sender",
+			msg: model.MsgCreateCosmWasmPool{
+				CodeId:         1,
+				InstantiateMsg: validInstantiateMsg,
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero code id",
+			msg: model.MsgCreateCosmWasmPool{
+				Sender:         addr1,
+				InstantiateMsg: validInstantiateMsg,
+			},
+			expectPass: false,
+		},
+		{
+			name: "missing instantiate msg",
+			msg: model.MsgCreateCosmWasmPool{
+				Sender: addr1,
+				CodeId: 1,
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid json instantiate msg",
+			msg: model.MsgCreateCosmWasmPool{
+				Sender:         addr1,
+				CodeId:         1,
+				InstantiateMsg: []byte(`{"pool_asset_denoms":`),
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "create_cosmwasm_pool")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCreateCosmWasmPool_CreatePool(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	instantiateMsg := []byte(`{"pool_asset_denoms":["uion","uosmo"]}`)
+
+	msg := model.NewMsgCreateCosmWasmPool(1, addr1, instantiateMsg)
+	require.Equal(t, poolmanagertypes.CosmWasm, msg.GetPoolType())
+	require.Equal(t, addr1, msg.PoolCreator())
+	require.True(t, msg.InitialLiquidity().Empty())
+
+	pool, err := msg.CreatePool(sdk.Context{}, 5)
+	require.NoError(t, err)
+
+	cosmWasmPool, ok := pool.(types.CosmWasmExtension)
+	require.True(t, ok)
+	require.Equal(t, uint64(5), cosmWasmPool.GetId())
+	require.Equal(t, uint64(1), cosmWasmPool.GetCodeId())
+	require.Equal(t, instantiateMsg, cosmWasmPool.GetInstantiateMsg())
+	require.Equal(t, poolmanagertypes.CosmWasm, cosmWasmPool.GetType())
+	// The contract address is only known once the contract is instantiated.
+	require.Empty(t, cosmWasmPool.GetContractAddress())
+}
@@ -0,0 +1,110 @@
+package model
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/cosmwasm/msg"
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/types"
+)
+
+var _ types.CosmWasmExtension = &Pool{}
+
+// Pool wraps the CosmWasmPool state model with the wasm keeper so that
+// its state dependent methods can be answered by querying the pool contract.
+type Pool struct {
+	CosmWasmPool
+	WasmKeeper types.WasmKeeper
+}
+
+// NewCosmWasmPool creates a new CosmWasm pool with the specified parameters.
+// The contract address is set once the contract is instantiated.
+func NewCosmWasmPool(poolId uint64, codeId uint64, instantiateMsg []byte) *Pool {
+	return &Pool{
+		CosmWasmPool: CosmWasmPool{
+			ContractAddress: "", // N.B. This is to be set in InitializePool()
+			PoolId:          poolId,
+			CodeId:          codeId,
+			InstantiateMsg:  instantiateMsg,
+		},
+	}
+}
+
+// XXX_MessageName returns the proto name of the wrapped model so that
+// the pool can be packed into Any.
+func (p Pool) XXX_MessageName() string {
+	return proto.MessageName(&p.CosmWasmPool)
+}
+
+// GetSwapFee returns the swap fee of the pool as reported by the contract.
+// If the contract query fails, the error is logged and zero is returned.
+// Such a pool is also reported as inactive, so it can not be swapped through.
+func (p Pool) GetSwapFee(ctx sdk.Context) sdk.Dec {
+	request := msg.GetSwapFeeQueryMsg{}
+	response, err := msg.Query[msg.GetSwapFeeQueryMsg, msg.GetSwapFeeQueryMsgResponse](ctx, p.WasmKeeper, p.ContractAddress, request)
+	if err != nil || response.SwapFee.IsNil() {
+		p.logQueryError(ctx, "get_swap_fee", err)
+		return sdk.ZeroDec()
+	}
+	return response.SwapFee
+}
+
+// IsActive returns true if the contract reports that swaps are enabled.
+// If the contract query fails, the error is logged and the pool is reported as inactive.
+func (p Pool) IsActive(ctx sdk.Context) bool {
+	request := msg.IsActiveQueryMsg{}
+	response, err := msg.Query[msg.IsActiveQueryMsg, msg.IsActiveQueryMsgResponse](ctx, p.WasmKeeper, p.ContractAddress, request)
+	if err != nil {
+		p.logQueryError(ctx, "is_active", err)
+		return false
+	}
+	return response.IsActive
+}
+
+// GetTotalPoolLiquidity returns the liquidity of the pool as reported by the contract.
+// If the contract query fails, the error is logged and no liquidity is returned.
+func (p Pool) GetTotalPoolLiquidity(ctx sdk.Context) sdk.Coins {
+	request := msg.GetTotalPoolLiquidityQueryMsg{}
+	response, err := msg.Query[msg.GetTotalPoolLiquidityQueryMsg, msg.GetTotalPoolLiquidityQueryMsgResponse](ctx, p.WasmKeeper, p.ContractAddress, request)
+	if err != nil {
+		p.logQueryError(ctx, "get_total_pool_liquidity", err)
+		return sdk.Coins{}
+	}
+	return response.TotalPoolLiquidity
+}
+
+// SpotPrice returns the spot price of the base asset in terms of the quote asset
+// as reported by the contract.
+func (p Pool) SpotPrice(ctx sdk.Context, quoteAssetDenom string, baseAssetDenom string) (sdk.Dec, error) {
+	request := msg.SpotPriceQueryMsg{
+		SpotPrice: msg.SpotPrice{
+			QuoteAssetDenom: quoteAssetDenom,
+			BaseAssetDenom:  baseAssetDenom,
+		},
+	}
+	response, err := msg.Query[msg.SpotPriceQueryMsg, msg.SpotPriceQueryMsgResponse](ctx, p.WasmKeeper, p.ContractAddress, request)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return response.SpotPrice, nil
+}
+
+// GetStoreModel returns the model persisted in state.
+func (p Pool) GetStoreModel() proto.Message {
+	return &p.CosmWasmPool
+}
+
+// logQueryError logs the error of a contract query whose result is replaced by a default value.
+func (p Pool) logQueryError(ctx sdk.Context, query string, err error) {
+	if err == nil {
+		err = errors.New("empty response")
+	}
+	ctx.Logger().Error("cosmwasm pool contract query failed", "pool_id", p.PoolId, "contract_address", p.ContractAddress, "query", query, "error", err)
+}
+
+// SetWasmKeeper sets the wasm keeper used to query the contract.
+func (p *Pool) SetWasmKeeper(wasmKeeper types.WasmKeeper) {
+	p.WasmKeeper = wasmKeeper
+}
@@ -0,0 +1,90 @@
+package model
+
+import (
+	"encoding/json"
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+var _ poolmanagertypes.PoolI = &CosmWasmPool{}
+
+// CosmWasmPool is the model persisted in state. It is registered as a PoolI
+// implementation so that it can be packed into and unpacked from Any.
+// Its state dependent methods require the pool contract and must
+// not be called directly. Use Pool instead, which wraps CosmWasmPool and
+// queries the contract.
+
+// GetAddress returns the address of the pool contract.
+func (p CosmWasmPool) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(p.ContractAddress)
+	if err != nil {
+		panic(fmt.Sprintf("could not bech32 decode address of pool with id: %d", p.GetId()))
+	}
+	return addr
+}
+
+// GetId returns the id of the pool.
+func (p CosmWasmPool) GetId() uint64 {
+	return p.PoolId
+}
+
+// String returns the json marshalled string of the pool
+func (p CosmWasmPool) String() string {
+	out, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return string(out)
+}
+
+func (p CosmWasmPool) GetSwapFee(ctx sdk.Context) sdk.Dec {
+	panic("CosmWasmPool.GetSwapFee is not implemented, use Pool instead")
+}
+
+// GetExitFee returns the exit fee of the pool. CosmWasm pools do not
+// have exit fees.
+func (p CosmWasmPool) GetExitFee(ctx sdk.Context) sdk.Dec {
+	return sdk.ZeroDec()
+}
+
+func (p CosmWasmPool) IsActive(ctx sdk.Context) bool {
+	panic("CosmWasmPool.IsActive is not implemented, use Pool instead")
+}
+
+// GetTotalShares returns zero since LP shares, if any, are managed by the
+// pool contract.
+func (p CosmWasmPool) GetTotalShares() sdk.Int {
+	return sdk.ZeroInt()
+}
+
+func (p CosmWasmPool) GetTotalPoolLiquidity(ctx sdk.Context) sdk.Coins {
+	panic("CosmWasmPool.GetTotalPoolLiquidity is not implemented, use Pool instead")
+}
+
+func (p CosmWasmPool) SpotPrice(ctx sdk.Context, quoteAssetDenom string, baseAssetDenom string) (sdk.Dec, error) {
+	panic("CosmWasmPool.SpotPrice is not implemented, use Pool instead")
+}
+
+// GetType returns the type of the pool.
+func (p CosmWasmPool) GetType() poolmanagertypes.PoolType {
+	return poolmanagertypes.CosmWasm
+}
+
+func (p CosmWasmPool) GetCodeId() uint64 {
+	return p.CodeId
+}
+
+func (p CosmWasmPool) GetInstantiateMsg() []byte {
+	return p.InstantiateMsg
+}
+
+func (p CosmWasmPool) GetContractAddress() string {
+	return p.ContractAddress
+}
+
+func (p *CosmWasmPool) SetContractAddress(contractAddress string) {
+	p.ContractAddress = contractAddress
+}
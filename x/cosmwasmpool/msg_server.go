@@ -0,0 +1,35 @@
+package cosmwasmpool
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/model"
+)
+
+type msgServer struct {
+	keeper *Keeper
+}
+
+func NewMsgCreatorServerImpl(keeper *Keeper) model.MsgCreatorServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ model.MsgCreatorServer = msgServer{}
+
+// CreateCosmWasmPool attempts to create a pool returning a MsgCreateCosmWasmPoolResponse or an error upon failure.
+// The pool creation fee is used to fund the community pool.
+// The pool contract is instantiated from the given code id, which must be whitelisted.
+func (server msgServer) CreateCosmWasmPool(goCtx context.Context, msg *model.MsgCreateCosmWasmPool) (*model.MsgCreateCosmWasmPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	poolId, err := server.keeper.poolmanagerKeeper.CreatePool(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.MsgCreateCosmWasmPoolResponse{PoolID: poolId}, nil
+}
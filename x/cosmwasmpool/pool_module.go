@@ -0,0 +1,307 @@
+package cosmwasmpool
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/cosmwasm/msg"
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+var _ poolmanagertypes.PoolModuleI = &Keeper{}
+
+// InitializePool instantiates the pool contract and persists the pool in state.
+// The module account is both the creator and the admin of the contract.
+// The contract address becomes the address of the pool.
+// Returns error if:
+// - the given pool is not a cosmwasm pool.
+// - the code id of the pool is not whitelisted.
+// - the contract fails to instantiate.
+func (k Keeper) InitializePool(ctx sdk.Context, pool poolmanagertypes.PoolI, creatorAddress sdk.AccAddress) error {
+	cosmWasmPool, err := asCosmWasmPool(pool)
+	if err != nil {
+		return err
+	}
+
+	codeId := cosmWasmPool.GetCodeId()
+	if !k.GetParams(ctx).IsCodeIdWhitelisted(codeId) {
+		return types.CodeIdNotWhitelistedError{CodeId: codeId}
+	}
+
+	moduleAddress := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
+	label := fmt.Sprintf("%s-%d", types.ModuleName, pool.GetId())
+	contractAddress, _, err := k.contractKeeper.Instantiate(ctx, codeId, moduleAddress, moduleAddress, cosmWasmPool.GetInstantiateMsg(), label, sdk.Coins{})
+	if err != nil {
+		return err
+	}
+
+	cosmWasmPool.SetContractAddress(contractAddress.String())
+	cosmWasmPool.SetWasmKeeper(k.wasmKeeper)
+	k.setPool(ctx, cosmWasmPool)
+
+	return nil
+}
+
+// GetPool returns the pool with the given id.
+// Returns error if the pool is not found.
+func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error) {
+	return k.getPoolById(ctx, poolId)
+}
+
+// GetPools returns all cosmwasm pools.
+func (k Keeper) GetPools(ctx sdk.Context) ([]poolmanagertypes.PoolI, error) {
+	return k.getAllPools(ctx)
+}
+
+// GetPoolDenoms returns the denoms of the pool with the given id as reported by the contract.
+// Returns error if the pool is not found or the contract query fails.
+func (k Keeper) GetPoolDenoms(ctx sdk.Context, poolId uint64) (denoms []string, err error) {
+	cosmWasmPool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	request := msg.GetPoolDenomsQueryMsg{}
+	response, err := msg.Query[msg.GetPoolDenomsQueryMsg, msg.GetPoolDenomsQueryMsgResponse](ctx, k.wasmKeeper, cosmWasmPool.GetContractAddress(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.PoolDenoms, nil
+}
+
+// CalculateSpotPrice returns the spot price of the base asset in terms of the quote asset
+// as reported by the contract.
+// Returns error if the pool is not found or the contract query fails.
+func (k Keeper) CalculateSpotPrice(
+	ctx sdk.Context,
+	poolId uint64,
+	quoteAssetDenom string,
+	baseAssetDenom string,
+) (price sdk.Dec, err error) {
+	cosmWasmPool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return cosmWasmPool.SpotPrice(ctx, quoteAssetDenom, baseAssetDenom)
+}
+
+// SwapExactAmountIn sends tokenIn from the sender to the pool contract and
+// executes the swap through a sudo call. The contract is responsible for
+// sending the token out to the sender.
+// Returns error if:
+// - the given pool is not a cosmwasm pool.
+// - the sender does not have enough funds.
+// - the contract fails to execute the swap.
+// - the sender did not receive exactly the token out amount reported by the contract,
+// or was refunded any token in.
+// - the token out amount is less than tokenOutMinAmount.
+func (k Keeper) SwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+	swapFee sdk.Dec,
+) (sdk.Int, error) {
+	cosmWasmPool, err := asCosmWasmPool(pool)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, cosmWasmPool.GetAddress(), sdk.NewCoins(tokenIn)); err != nil {
+		return sdk.Int{}, err
+	}
+
+	// The amounts reported by the contract are checked against the sender's balances.
+	tokenInBalanceBefore := k.bankKeeper.GetBalance(ctx, sender, tokenIn.Denom)
+	tokenOutBalanceBefore := k.bankKeeper.GetBalance(ctx, sender, tokenOutDenom)
+
+	request := msg.SwapExactAmountInSudoMsg{
+		SwapExactAmountIn: msg.SwapExactAmountIn{
+			Sender:            sender.String(),
+			TokenIn:           tokenIn,
+			TokenOutDenom:     tokenOutDenom,
+			TokenOutMinAmount: tokenOutMinAmount,
+			SwapFee:           swapFee,
+		},
+	}
+	response, err := msg.Sudo[msg.SwapExactAmountInSudoMsg, msg.SwapExactAmountInSudoMsgResponse](ctx, k.contractKeeper, cosmWasmPool.GetContractAddress(), request)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if response.TokenOutAmount.IsNil() {
+		return sdk.Int{}, types.ContractResponseError{ContractAddress: cosmWasmPool.GetContractAddress(), Err: errors.New("token out amount is not set")}
+	}
+
+	if err := k.checkSwapBalanceChange(ctx, sender, cosmWasmPool, tokenInBalanceBefore, sdk.ZeroInt()); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.checkSwapBalanceChange(ctx, sender, cosmWasmPool, tokenOutBalanceBefore, response.TokenOutAmount); err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenOut := sdk.NewCoin(tokenOutDenom, response.TokenOutAmount)
+	if tokenOut.Amount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, types.TokenOutLessThanMinError{TokenOut: tokenOut, TokenOutMinAmount: tokenOutMinAmount}
+	}
+
+	k.poolListeners.AfterSwap(ctx, sender, pool.GetId(), sdk.NewCoins(tokenIn), sdk.NewCoins(tokenOut))
+
+	return tokenOut.Amount, nil
+}
+
+// CalcOutAmtGivenIn returns the amount of tokenOut the contract would
+// return for the given tokenIn.
+func (k Keeper) CalcOutAmtGivenIn(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+) (tokenOut sdk.Coin, err error) {
+	cosmWasmPool, err := asCosmWasmPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	request := msg.CalcOutAmtGivenInQueryMsg{
+		CalcOutAmtGivenIn: msg.CalcOutAmtGivenIn{
+			TokenIn:       tokenIn,
+			TokenOutDenom: tokenOutDenom,
+			SwapFee:       swapFee,
+		},
+	}
+	response, err := msg.Query[msg.CalcOutAmtGivenInQueryMsg, msg.CalcOutAmtGivenInQueryMsgResponse](ctx, k.wasmKeeper, cosmWasmPool.GetContractAddress(), request)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return response.TokenOut, nil
+}
+
+// SwapExactAmountOut calculates the amount of token in required for tokenOut,
+// sends it from the sender to the pool contract and executes the swap through
+// a sudo call. The contract is responsible for sending tokenOut to the sender
+// and refunding any token in it did not use.
+// Returns error if:
+// - the given pool is not a cosmwasm pool.
+// - the token in amount required is greater than tokenInMaxAmount.
+// - the sender does not have enough funds.
+// - the contract fails to execute the swap or charges more than calculated.
+// - the sender did not receive exactly tokenOut, or was not refunded the token in
+// the contract did not use.
+func (k Keeper) SwapExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool poolmanagertypes.PoolI,
+	tokenInDenom string,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
+) (tokenInAmount sdk.Int, err error) {
+	cosmWasmPool, err := asCosmWasmPool(pool)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenIn, err := k.CalcInAmtGivenOut(ctx, pool, tokenOut, tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if tokenIn.Amount.GT(tokenInMaxAmount) {
+		return sdk.Int{}, types.TokenInGreaterThanMaxError{TokenIn: tokenIn, TokenInMaxAmount: tokenInMaxAmount}
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, cosmWasmPool.GetAddress(), sdk.NewCoins(tokenIn)); err != nil {
+		return sdk.Int{}, err
+	}
+
+	// The amounts reported by the contract are checked against the sender's balances.
+	tokenInBalanceBefore := k.bankKeeper.GetBalance(ctx, sender, tokenInDenom)
+	tokenOutBalanceBefore := k.bankKeeper.GetBalance(ctx, sender, tokenOut.Denom)
+
+	request := msg.SwapExactAmountOutSudoMsg{
+		SwapExactAmountOut: msg.SwapExactAmountOut{
+			Sender:           sender.String(),
+			TokenInDenom:     tokenInDenom,
+			TokenInMaxAmount: tokenIn.Amount,
+			TokenOut:         tokenOut,
+			SwapFee:          swapFee,
+		},
+	}
+	response, err := msg.Sudo[msg.SwapExactAmountOutSudoMsg, msg.SwapExactAmountOutSudoMsgResponse](ctx, k.contractKeeper, cosmWasmPool.GetContractAddress(), request)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if response.TokenInAmount.IsNil() {
+		return sdk.Int{}, types.ContractResponseError{ContractAddress: cosmWasmPool.GetContractAddress(), Err: errors.New("token in amount is not set")}
+	}
+
+	// The contract must not charge more than the amount it was sent.
+	if response.TokenInAmount.GT(tokenIn.Amount) {
+		return sdk.Int{}, types.TokenInGreaterThanMaxError{TokenIn: sdk.NewCoin(tokenInDenom, response.TokenInAmount), TokenInMaxAmount: tokenIn.Amount}
+	}
+
+	// The token in the contract did not use must be refunded to the sender.
+	if err := k.checkSwapBalanceChange(ctx, sender, cosmWasmPool, tokenInBalanceBefore, tokenIn.Amount.Sub(response.TokenInAmount)); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.checkSwapBalanceChange(ctx, sender, cosmWasmPool, tokenOutBalanceBefore, tokenOut.Amount); err != nil {
+		return sdk.Int{}, err
+	}
+
+	k.poolListeners.AfterSwap(ctx, sender, pool.GetId(), sdk.NewCoins(sdk.NewCoin(tokenInDenom, response.TokenInAmount)), sdk.NewCoins(tokenOut))
+
+	return response.TokenInAmount, nil
+}
+
+// CalcInAmtGivenOut returns the amount of tokenIn the contract would
+// require for the given tokenOut.
+func (k Keeper) CalcInAmtGivenOut(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+) (tokenIn sdk.Coin, err error) {
+	cosmWasmPool, err := asCosmWasmPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	request := msg.CalcInAmtGivenOutQueryMsg{
+		CalcInAmtGivenOut: msg.CalcInAmtGivenOut{
+			TokenOut:     tokenOut,
+			TokenInDenom: tokenInDenom,
+			SwapFee:      swapFee,
+		},
+	}
+	response, err := msg.Query[msg.CalcInAmtGivenOutQueryMsg, msg.CalcInAmtGivenOutQueryMsgResponse](ctx, k.wasmKeeper, cosmWasmPool.GetContractAddress(), request)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return response.TokenIn, nil
+}
+
+// checkSwapBalanceChange checks that the sender's balance of the denom of balanceBefore
+// changed by exactly expectedChange during a swap through the given pool's contract.
+func (k Keeper) checkSwapBalanceChange(ctx sdk.Context, sender sdk.AccAddress, cosmWasmPool types.CosmWasmExtension, balanceBefore sdk.Coin, expectedChange sdk.Int) error {
+	actualChange := k.bankKeeper.GetBalance(ctx, sender, balanceBefore.Denom).Amount.Sub(balanceBefore.Amount)
+	if !actualChange.Equal(expectedChange) {
+		return types.SwapBalanceChangeMismatchError{
+			ContractAddress: cosmWasmPool.GetContractAddress(),
+			Denom:           balanceBefore.Denom,
+			ExpectedChange:  expectedChange,
+			ActualChange:    actualChange,
+		}
+	}
+	return nil
+}
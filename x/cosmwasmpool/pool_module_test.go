@@ -0,0 +1,217 @@
+package cosmwasmpool_test
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/model"
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// TestInitializePool tests that creating a cosmwasm pool through the poolmanager instantiates
+// the pool contract and stores the pool, only when the code id is whitelisted.
+func (suite *KeeperTestSuite) TestInitializePool() {
+	tests := map[string]struct {
+		codeIdWhitelist []uint64
+		expectedErr     error
+	}{
+		"whitelisted code id": {
+			codeIdWhitelist: []uint64{testPoolCodeId},
+		},
+		"code id not whitelisted": {
+			codeIdWhitelist: []uint64{testPoolCodeId + 1},
+			expectedErr:     types.CodeIdNotWhitelistedError{CodeId: testPoolCodeId},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.App.CosmwasmPoolKeeper.SetParams(suite.Ctx, types.NewParams(tc.codeIdWhitelist))
+
+			instantiateMsg, err := json.Marshal(defaultInstantiateMsg)
+			suite.Require().NoError(err)
+			suite.FundAcc(suite.TestAccs[0], suite.App.PoolManagerKeeper.GetParams(suite.Ctx).PoolCreationFee)
+
+			poolId, err := suite.App.PoolManagerKeeper.CreatePool(suite.Ctx, model.NewMsgCreateCosmWasmPool(testPoolCodeId, suite.TestAccs[0], instantiateMsg))
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			pool, err := suite.App.CosmwasmPoolKeeper.GetPool(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			cosmWasmPool, ok := pool.(types.CosmWasmExtension)
+			suite.Require().True(ok)
+			suite.Require().Equal(testPoolCodeId, cosmWasmPool.GetCodeId())
+			suite.Require().Equal(instantiateMsg, cosmWasmPool.GetInstantiateMsg())
+			suite.Require().Contains(suite.contract.instances, cosmWasmPool.GetContractAddress())
+
+			suite.Require().Equal(poolmanagertypes.CosmWasm, pool.GetType())
+			poolModule, err := suite.App.PoolManagerKeeper.GetPoolModule(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(suite.App.CosmwasmPoolKeeper, poolModule)
+		})
+	}
+}
+
+// TestPoolQueries tests that the pool answers its state dependent methods by querying the contract,
+// and that failing contract queries do not panic.
+func (suite *KeeperTestSuite) TestPoolQueries() {
+	suite.SetupTest()
+	pool := suite.createTestPool()
+	cosmwasmPoolKeeper := suite.App.CosmwasmPoolKeeper
+
+	denoms, err := cosmwasmPoolKeeper.GetPoolDenoms(suite.Ctx, pool.GetId())
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denomA, denomB}, denoms)
+
+	spotPrice, err := cosmwasmPoolKeeper.CalculateSpotPrice(suite.Ctx, pool.GetId(), denomA, denomB)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.OneDec(), spotPrice)
+
+	suite.Require().Equal(defaultPoolSwapFee, pool.GetSwapFee(suite.Ctx))
+	suite.Require().True(pool.IsActive(suite.Ctx))
+	suite.Require().Equal(defaultPoolLiquidity.String(), pool.GetTotalPoolLiquidity(suite.Ctx).String())
+
+	// A pool whose contract queries fail is reported as inactive, with a zero swap fee and no liquidity.
+	suite.contract.failQueries = true
+	suite.Require().NotPanics(func() {
+		suite.Require().Equal(sdk.ZeroDec(), pool.GetSwapFee(suite.Ctx))
+		suite.Require().False(pool.IsActive(suite.Ctx))
+		suite.Require().True(pool.GetTotalPoolLiquidity(suite.Ctx).IsZero())
+	})
+
+	_, err = cosmwasmPoolKeeper.CalculateSpotPrice(suite.Ctx, pool.GetId(), denomA, denomB)
+	suite.Require().Error(err)
+}
+
+// TestSwapExactAmountIn tests swaps of an exact amount in, including that the token out amount
+// reported by the contract is checked against the sender's balance.
+func (suite *KeeperTestSuite) TestSwapExactAmountIn() {
+	tests := map[string]struct {
+		tokenOutMinAmount  sdk.Int
+		overReportTokenOut bool
+		expectedErr        func(pool types.CosmWasmExtension) error
+	}{
+		"valid swap": {
+			tokenOutMinAmount: defaultSwapAmountAfter,
+		},
+		"token out less than min amount": {
+			tokenOutMinAmount: defaultSwapAmountAfter.AddRaw(1),
+			expectedErr: func(pool types.CosmWasmExtension) error {
+				return types.TokenOutLessThanMinError{TokenOut: sdk.NewCoin(denomB, defaultSwapAmountAfter), TokenOutMinAmount: defaultSwapAmountAfter.AddRaw(1)}
+			},
+		},
+		"contract reports more token out than it sent": {
+			tokenOutMinAmount:  sdk.OneInt(),
+			overReportTokenOut: true,
+			expectedErr: func(pool types.CosmWasmExtension) error {
+				return types.SwapBalanceChangeMismatchError{ContractAddress: pool.GetContractAddress(), Denom: denomB, ExpectedChange: defaultSwapAmountAfter.AddRaw(1), ActualChange: defaultSwapAmountAfter}
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			pool := suite.createTestPool()
+			suite.contract.overReportTokenOut = tc.overReportTokenOut
+
+			sender := suite.TestAccs[1]
+			tokenIn := sdk.NewCoin(denomA, defaultSwapAmount)
+			suite.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+			tokenOutAmount, err := suite.App.CosmwasmPoolKeeper.SwapExactAmountIn(suite.Ctx, sender, pool, tokenIn, denomB, tc.tokenOutMinAmount, pool.GetSwapFee(suite.Ctx))
+			if tc.expectedErr != nil {
+				suite.Require().ErrorContains(err, tc.expectedErr(pool).Error())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(defaultSwapAmountAfter, tokenOutAmount)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denomB, defaultSwapAmountAfter)).String(), suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender).String())
+		})
+	}
+}
+
+// TestSwapExactAmountOut tests swaps of an exact amount out, including that the contract must
+// refund the token in it did not use.
+func (suite *KeeperTestSuite) TestSwapExactAmountOut() {
+	tests := map[string]struct {
+		tokenInMaxAmount   sdk.Int
+		underReportTokenIn bool
+		expectedErr        func(pool types.CosmWasmExtension) error
+	}{
+		"valid swap": {
+			tokenInMaxAmount: defaultSwapAmount,
+		},
+		"token in greater than max amount": {
+			tokenInMaxAmount: defaultSwapAmount.SubRaw(1),
+			expectedErr: func(pool types.CosmWasmExtension) error {
+				return types.TokenInGreaterThanMaxError{TokenIn: sdk.NewCoin(denomA, defaultSwapAmount), TokenInMaxAmount: defaultSwapAmount.SubRaw(1)}
+			},
+		},
+		"contract does not refund the token in it did not use": {
+			tokenInMaxAmount:   defaultSwapAmount,
+			underReportTokenIn: true,
+			expectedErr: func(pool types.CosmWasmExtension) error {
+				return types.SwapBalanceChangeMismatchError{ContractAddress: pool.GetContractAddress(), Denom: denomA, ExpectedChange: sdk.OneInt(), ActualChange: sdk.ZeroInt()}
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			pool := suite.createTestPool()
+			suite.contract.underReportTokenIn = tc.underReportTokenIn
+
+			sender := suite.TestAccs[1]
+			suite.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(denomA, defaultSwapAmount)))
+			tokenOut := sdk.NewCoin(denomB, defaultSwapAmountAfter)
+
+			tokenInAmount, err := suite.App.CosmwasmPoolKeeper.SwapExactAmountOut(suite.Ctx, sender, pool, denomA, tc.tokenInMaxAmount, tokenOut, pool.GetSwapFee(suite.Ctx))
+			if tc.expectedErr != nil {
+				suite.Require().ErrorContains(err, tc.expectedErr(pool).Error())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(defaultSwapAmount, tokenInAmount)
+			suite.Require().Equal(sdk.NewCoins(tokenOut).String(), suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender).String())
+		})
+	}
+}
+
+// TestPoolManagerRouting tests that the poolmanager routes swaps and spot price queries to cosmwasm pools.
+func (suite *KeeperTestSuite) TestPoolManagerRouting() {
+	suite.SetupTest()
+	pool := suite.createTestPool()
+	poolManagerKeeper := suite.App.PoolManagerKeeper
+	sender := suite.TestAccs[1]
+
+	spotPrice, err := poolManagerKeeper.RouteCalculateSpotPrice(suite.Ctx, pool.GetId(), denomA, denomB)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.OneDec(), spotPrice)
+
+	tokenIn := sdk.NewCoin(denomA, defaultSwapAmount)
+	suite.FundAcc(sender, sdk.NewCoins(tokenIn))
+	tokenOutAmount, err := poolManagerKeeper.RouteExactAmountIn(suite.Ctx, sender, []poolmanagertypes.SwapAmountInRoute{{PoolId: pool.GetId(), TokenOutDenom: denomB}}, tokenIn, sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(defaultSwapAmountAfter, tokenOutAmount)
+
+	// Swap the token out back into denom a.
+	tokenOut := sdk.NewCoin(denomA, calcTestPoolOutAmtGivenIn(defaultSwapAmountAfter, defaultPoolSwapFee))
+	tokenInAmount, err := poolManagerKeeper.RouteExactAmountOut(suite.Ctx, sender, []poolmanagertypes.SwapAmountOutRoute{{PoolId: pool.GetId(), TokenInDenom: denomB}}, defaultSwapAmountAfter, tokenOut)
+	suite.Require().NoError(err)
+	suite.Require().Equal(defaultSwapAmountAfter, tokenInAmount)
+	suite.Require().Equal(sdk.NewCoins(tokenOut).String(), suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender).String())
+
+	// Swaps through an inactive pool are rejected.
+	suite.contract.failQueries = true
+	suite.FundAcc(sender, sdk.NewCoins(tokenIn))
+	_, err = poolManagerKeeper.RouteExactAmountIn(suite.Ctx, sender, []poolmanagertypes.SwapAmountInRoute{{PoolId: pool.GetId(), TokenOutDenom: denomB}}, tokenIn, sdk.OneInt())
+	suite.Require().Error(err)
+}
@@ -0,0 +1,60 @@
+package cosmwasmpool
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/model"
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// setPool stores the state model of the given pool.
+func (k Keeper) setPool(ctx sdk.Context, pool types.CosmWasmExtension) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyPool(pool.GetId()), pool.GetStoreModel())
+}
+
+// getPoolById returns the cosmwasm pool with the given id, wired with the
+// wasm keeper. Returns error if the pool is not found.
+func (k Keeper) getPoolById(ctx sdk.Context, poolId uint64) (types.CosmWasmExtension, error) {
+	store := ctx.KVStore(k.storeKey)
+	cosmWasmPool := model.CosmWasmPool{}
+	found, err := osmoutils.Get(store, types.KeyPool(poolId), &cosmWasmPool)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.PoolNotFoundError{PoolId: poolId}
+	}
+	return &model.Pool{
+		CosmWasmPool: cosmWasmPool,
+		WasmKeeper:   k.wasmKeeper,
+	}, nil
+}
+
+// getAllPools returns all cosmwasm pools, wired with the wasm keeper.
+func (k Keeper) getAllPools(ctx sdk.Context) ([]poolmanagertypes.PoolI, error) {
+	return osmoutils.GatherValuesFromStorePrefix(
+		ctx.KVStore(k.storeKey), types.PoolsKey, func(value []byte) (poolmanagertypes.PoolI, error) {
+			cosmWasmPool := model.CosmWasmPool{}
+			if err := k.cdc.Unmarshal(value, &cosmWasmPool); err != nil {
+				return nil, err
+			}
+			return &model.Pool{
+				CosmWasmPool: cosmWasmPool,
+				WasmKeeper:   k.wasmKeeper,
+			}, nil
+		},
+	)
+}
+
+// asCosmWasmPool converts the given pool to a cosmwasm pool.
+// Returns error if the pool is not a cosmwasm pool.
+func asCosmWasmPool(pool poolmanagertypes.PoolI) (types.CosmWasmExtension, error) {
+	cosmWasmPool, ok := pool.(types.CosmWasmExtension)
+	if !ok {
+		return nil, types.InvalidPoolTypeError{ActualPool: pool}
+	}
+	return cosmWasmPool, nil
+}
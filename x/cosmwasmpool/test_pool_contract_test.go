@@ -0,0 +1,198 @@
+package cosmwasmpool_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/cosmwasm/msg"
+)
+
+// testPoolInstantiateMsg is the instantiate message of the test pool contract.
+type testPoolInstantiateMsg struct {
+	PoolAssetDenoms []string `json:"pool_asset_denoms"`
+	SwapFee         sdk.Dec  `json:"swap_fee"`
+}
+
+// testPoolBankKeeper is the subset of the bank keeper used by the test pool contract.
+type testPoolBankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// testPoolContract stands in for the contract and wasm keepers of the cosmwasmpool module.
+// It implements a pool contract that swaps its two denoms 1:1 after the swap fee.
+// The liquidity of every instance is the bank balance of its contract address.
+type testPoolContract struct {
+	bankKeeper testPoolBankKeeper
+	instances  map[string]testPoolInstantiateMsg
+
+	// The fields below make the contract misbehave.
+	failQueries        bool
+	overReportTokenOut bool
+	underReportTokenIn bool
+}
+
+func newTestPoolContract(bankKeeper testPoolBankKeeper) *testPoolContract {
+	return &testPoolContract{
+		bankKeeper: bankKeeper,
+		instances:  map[string]testPoolInstantiateMsg{},
+	}
+}
+
+// Instantiate registers a new instance of the test pool contract at an address derived from the label.
+func (c *testPoolContract) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error) {
+	instantiateMsg := testPoolInstantiateMsg{}
+	if err := json.Unmarshal(initMsg, &instantiateMsg); err != nil {
+		return nil, nil, err
+	}
+	if len(instantiateMsg.PoolAssetDenoms) != 2 {
+		return nil, nil, fmt.Errorf("test pool contract requires 2 denoms, got %d", len(instantiateMsg.PoolAssetDenoms))
+	}
+
+	contractAddress := sdk.AccAddress(address.Module("testpoolcontract", []byte(label)))
+	c.instances[contractAddress.String()] = instantiateMsg
+	return contractAddress, nil, nil
+}
+
+// QuerySmart answers the queries of the pool contract interface.
+func (c *testPoolContract) QuerySmart(ctx sdk.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error) {
+	if c.failQueries {
+		return nil, errors.New("test pool contract query failed")
+	}
+	instance, err := c.getInstance(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	name, err := getMsgName(queryMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	var response any
+	switch name {
+	case "get_swap_fee":
+		response = msg.GetSwapFeeQueryMsgResponse{SwapFee: instance.SwapFee}
+	case "is_active":
+		response = msg.IsActiveQueryMsgResponse{IsActive: true}
+	case "get_total_pool_liquidity":
+		response = msg.GetTotalPoolLiquidityQueryMsgResponse{TotalPoolLiquidity: c.bankKeeper.GetAllBalances(ctx, contractAddress)}
+	case "get_pool_denoms":
+		response = msg.GetPoolDenomsQueryMsgResponse{PoolDenoms: instance.PoolAssetDenoms}
+	case "spot_price":
+		response = msg.SpotPriceQueryMsgResponse{SpotPrice: sdk.OneDec()}
+	case "calc_out_amt_given_in":
+		query := msg.CalcOutAmtGivenInQueryMsg{}
+		if err := json.Unmarshal(queryMsg, &query); err != nil {
+			return nil, err
+		}
+		tokenOutAmount := calcTestPoolOutAmtGivenIn(query.CalcOutAmtGivenIn.TokenIn.Amount, query.CalcOutAmtGivenIn.SwapFee)
+		response = msg.CalcOutAmtGivenInQueryMsgResponse{TokenOut: sdk.NewCoin(query.CalcOutAmtGivenIn.TokenOutDenom, tokenOutAmount)}
+	case "calc_in_amt_given_out":
+		query := msg.CalcInAmtGivenOutQueryMsg{}
+		if err := json.Unmarshal(queryMsg, &query); err != nil {
+			return nil, err
+		}
+		tokenInAmount := calcTestPoolInAmtGivenOut(query.CalcInAmtGivenOut.TokenOut.Amount, query.CalcInAmtGivenOut.SwapFee)
+		response = msg.CalcInAmtGivenOutQueryMsgResponse{TokenIn: sdk.NewCoin(query.CalcInAmtGivenOut.TokenInDenom, tokenInAmount)}
+	default:
+		return nil, fmt.Errorf("unknown test pool contract query %s", name)
+	}
+	return json.Marshal(response)
+}
+
+// Sudo executes the swaps of the pool contract interface.
+// The token in has already been sent to the contract by the module.
+func (c *testPoolContract) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, sudoMsg []byte) ([]byte, error) {
+	if _, err := c.getInstance(contractAddress); err != nil {
+		return nil, err
+	}
+	name, err := getMsgName(sudoMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	var response any
+	switch name {
+	case "swap_exact_amount_in":
+		request := msg.SwapExactAmountInSudoMsg{}
+		if err := json.Unmarshal(sudoMsg, &request); err != nil {
+			return nil, err
+		}
+		swap := request.SwapExactAmountIn
+		sender, err := sdk.AccAddressFromBech32(swap.Sender)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenOut := sdk.NewCoin(swap.TokenOutDenom, calcTestPoolOutAmtGivenIn(swap.TokenIn.Amount, swap.SwapFee))
+		if err := c.bankKeeper.SendCoins(ctx, contractAddress, sender, sdk.NewCoins(tokenOut)); err != nil {
+			return nil, err
+		}
+
+		reportedTokenOutAmount := tokenOut.Amount
+		if c.overReportTokenOut {
+			reportedTokenOutAmount = reportedTokenOutAmount.AddRaw(1)
+		}
+		response = msg.SwapExactAmountInSudoMsgResponse{TokenOutAmount: reportedTokenOutAmount}
+	case "swap_exact_amount_out":
+		request := msg.SwapExactAmountOutSudoMsg{}
+		if err := json.Unmarshal(sudoMsg, &request); err != nil {
+			return nil, err
+		}
+		swap := request.SwapExactAmountOut
+		sender, err := sdk.AccAddressFromBech32(swap.Sender)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenInAmount := calcTestPoolInAmtGivenOut(swap.TokenOut.Amount, swap.SwapFee)
+		refund := sdk.NewCoin(swap.TokenInDenom, swap.TokenInMaxAmount.Sub(tokenInAmount))
+		if err := c.bankKeeper.SendCoins(ctx, contractAddress, sender, sdk.NewCoins(swap.TokenOut, refund)); err != nil {
+			return nil, err
+		}
+
+		reportedTokenInAmount := tokenInAmount
+		if c.underReportTokenIn {
+			reportedTokenInAmount = reportedTokenInAmount.SubRaw(1)
+		}
+		response = msg.SwapExactAmountOutSudoMsgResponse{TokenInAmount: reportedTokenInAmount}
+	default:
+		return nil, fmt.Errorf("unknown test pool contract sudo message %s", name)
+	}
+	return json.Marshal(response)
+}
+
+func (c *testPoolContract) getInstance(contractAddress sdk.AccAddress) (testPoolInstantiateMsg, error) {
+	instance, ok := c.instances[contractAddress.String()]
+	if !ok {
+		return testPoolInstantiateMsg{}, fmt.Errorf("no test pool contract at %s", contractAddress)
+	}
+	return instance, nil
+}
+
+// getMsgName returns the key of the json object wrapping a query or sudo message.
+func getMsgName(bz []byte) (string, error) {
+	wrapper := map[string]json.RawMessage{}
+	if err := json.Unmarshal(bz, &wrapper); err != nil {
+		return "", err
+	}
+	if len(wrapper) != 1 {
+		return "", fmt.Errorf("expected a single message, got %d", len(wrapper))
+	}
+	for name := range wrapper {
+		return name, nil
+	}
+	return "", nil
+}
+
+func calcTestPoolOutAmtGivenIn(tokenInAmount sdk.Int, swapFee sdk.Dec) sdk.Int {
+	return tokenInAmount.ToDec().Mul(sdk.OneDec().Sub(swapFee)).TruncateInt()
+}
+
+func calcTestPoolInAmtGivenOut(tokenOutAmount sdk.Int, swapFee sdk.Dec) sdk.Int {
+	return tokenOutAmount.ToDec().Quo(sdk.OneDec().Sub(swapFee)).Ceil().TruncateInt()
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"errors"
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var ErrInvalidPoolType = errors.New("pool is not a cosmwasm pool")
+
+// x/cosmwasmpool module sentinel errors.
+type PoolNotFoundError struct {
+	PoolId uint64
+}
+
+func (e PoolNotFoundError) Error() string {
+	return fmt.Sprintf("pool not found. pool id (%d)", e.PoolId)
+}
+
+type CodeIdNotWhitelistedError struct {
+	CodeId uint64
+}
+
+func (e CodeIdNotWhitelistedError) Error() string {
+	return fmt.Sprintf("cannot create cosmwasm pool with code id (%d) that is not whitelisted", e.CodeId)
+}
+
+type InvalidPoolTypeError struct {
+	ActualPool interface{}
+}
+
+func (e InvalidPoolTypeError) Error() string {
+	return fmt.Sprintf("given pool does not implement CosmWasmExtension, implements %T", e.ActualPool)
+}
+
+type TokenOutLessThanMinError struct {
+	TokenOut          sdk.Coin
+	TokenOutMinAmount sdk.Int
+}
+
+func (e TokenOutLessThanMinError) Error() string {
+	return fmt.Sprintf("token amount calculated (%s) is lesser than min amount (%s)", e.TokenOut, e.TokenOutMinAmount)
+}
+
+type TokenInGreaterThanMaxError struct {
+	TokenIn          sdk.Coin
+	TokenInMaxAmount sdk.Int
+}
+
+func (e TokenInGreaterThanMaxError) Error() string {
+	return fmt.Sprintf("token amount calculated (%s) is greater than max amount (%s)", e.TokenIn, e.TokenInMaxAmount)
+}
+
+type SwapBalanceChangeMismatchError struct {
+	ContractAddress string
+	Denom           string
+	ExpectedChange  sdk.Int
+	ActualChange    sdk.Int
+}
+
+func (e SwapBalanceChangeMismatchError) Error() string {
+	return fmt.Sprintf("swap through pool contract (%s) changed the sender's %s balance by (%s), expected (%s)", e.ContractAddress, e.Denom, e.ActualChange, e.ExpectedChange)
+}
+
+type ContractResponseError struct {
+	ContractAddress string
+	Err             error
+}
+
+func (e ContractResponseError) Error() string {
+	return fmt.Sprintf("failed to parse response from pool contract (%s): %s", e.ContractAddress, e.Err)
+}
+
+func (e ContractResponseError) Unwrap() error {
+	return e.Err
+}
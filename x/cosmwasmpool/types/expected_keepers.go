@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// AccountKeeper defines the account contract that must be fulfilled when
+// creating a x/cosmwasmpool keeper.
+type AccountKeeper interface {
+	GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
+}
+
+// BankKeeper defines the banking contract that must be fulfilled when
+// creating a x/cosmwasmpool keeper.
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// PoolManagerKeeper defines the interface needed to be fulfilled for
+// the poolmanager keeper.
+type PoolManagerKeeper interface {
+	CreatePool(ctx sdk.Context, msg poolmanagertypes.CreatePoolMsg) (uint64, error)
+	GetNextPoolId(ctx sdk.Context) uint64
+}
+
+// ContractKeeper defines the interface needed to be fulfilled for
+// the contract keeper.
+type ContractKeeper interface {
+	Instantiate(
+		ctx sdk.Context,
+		codeID uint64,
+		creator, admin sdk.AccAddress,
+		initMsg []byte,
+		label string,
+		deposit sdk.Coins,
+	) (sdk.AccAddress, []byte, error)
+
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// WasmKeeper defines the interface needed to be fulfilled for
+// the WasmKeeper.
+type WasmKeeper interface {
+	QuerySmart(ctx sdk.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error)
+}
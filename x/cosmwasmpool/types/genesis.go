@@ -0,0 +1,34 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// DefaultGenesis returns the default GenesisState for the cosmwasmpool module.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		Pools:  []*codectypes.Any{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	return gs.Params.Validate()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range gs.Pools {
+		var pool poolmanagertypes.PoolI
+		err := unpacker.UnpackAny(any, &pool)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	ModuleName = "cosmwasmpool"
+	RouterKey  = ModuleName
+
+	StoreKey = ModuleName
+)
+
+// Key prefixes
+var (
+	PoolsKey = []byte{0x01}
+)
+
+// KeyPool returns the key for the pool with the given id.
+func KeyPool(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", PoolsKey, poolId))
+}
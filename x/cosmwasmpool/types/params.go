@@ -0,0 +1,78 @@
+package types
+
+import (
+	fmt "fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeyCodeIdWhitelist = []byte("CodeIdWhitelist")
+
+	_ paramtypes.ParamSet = &Params{}
+)
+
+// ParamTable for cosmwasmpool module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(codeIdWhitelist []uint64) Params {
+	return Params{
+		CodeIdWhitelist: codeIdWhitelist,
+	}
+}
+
+// DefaultParams returns default cosmwasmpool module parameters.
+// No code ids are whitelisted by default so that pool contracts
+// can only be enabled by governance.
+func DefaultParams() Params {
+	return Params{
+		CodeIdWhitelist: []uint64{},
+	}
+}
+
+// Validate params.
+func (p Params) Validate() error {
+	return validateCodeIdWhitelist(p.CodeIdWhitelist)
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyCodeIdWhitelist, &p.CodeIdWhitelist, validateCodeIdWhitelist),
+	}
+}
+
+// IsCodeIdWhitelisted returns true if the given code id is in the whitelist.
+func (p Params) IsCodeIdWhitelisted(codeId uint64) bool {
+	for _, whitelistedCodeId := range p.CodeIdWhitelist {
+		if whitelistedCodeId == codeId {
+			return true
+		}
+	}
+	return false
+}
+
+// validateCodeIdWhitelist validates that the given parameter is a slice of
+// unique, non-zero code ids.
+func validateCodeIdWhitelist(i interface{}) error {
+	codeIds, ok := i.([]uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[uint64]struct{}, len(codeIds))
+	for _, codeId := range codeIds {
+		if codeId == 0 {
+			return fmt.Errorf("code id must be positive")
+		}
+		if _, ok := seen[codeId]; ok {
+			return fmt.Errorf("duplicate code id (%d) in whitelist", codeId)
+		}
+		seen[codeId] = struct{}{}
+	}
+
+	return nil
+}
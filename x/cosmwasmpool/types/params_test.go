@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v15/x/cosmwasmpool/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := map[string]struct {
+		params    types.Params
+		expectErr bool
+	}{
+		"default params": {
+			params: types.DefaultParams(),
+		},
+		"valid whitelist": {
+			params: types.NewParams([]uint64{1, 2, 5}),
+		},
+		"zero code id": {
+			params:    types.NewParams([]uint64{1, 0}),
+			expectErr: true,
+		},
+		"duplicate code id": {
+			params:    types.NewParams([]uint64{1, 2, 1}),
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestIsCodeIdWhitelisted(t *testing.T) {
+	params := types.NewParams([]uint64{1, 3})
+
+	require.True(t, params.IsCodeIdWhitelisted(1))
+	require.True(t, params.IsCodeIdWhitelisted(3))
+	require.False(t, params.IsCodeIdWhitelisted(2))
+	require.False(t, types.DefaultParams().IsCodeIdWhitelisted(1))
+}
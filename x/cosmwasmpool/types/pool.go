@@ -0,0 +1,27 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// CosmWasmExtension defines the cosmwasm pool specific methods in addition
+// to the poolmanager PoolI interface.
+type CosmWasmExtension interface {
+	poolmanagertypes.PoolI
+
+	GetCodeId() uint64
+
+	GetInstantiateMsg() []byte
+
+	GetContractAddress() string
+
+	SetContractAddress(contractAddress string)
+
+	// GetStoreModel returns the proto message that is persisted in state
+	// for the pool.
+	GetStoreModel() proto.Message
+
+	SetWasmKeeper(wasmKeeper WasmKeeper)
+}
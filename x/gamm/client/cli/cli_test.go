@@ -3,6 +3,7 @@ package cli_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/client/cli"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 
@@ -307,6 +309,116 @@ func TestNewExitSwapShareAmountInCmd(t *testing.T) {
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
 
+func TestNewUpdatePoolWeightsCmd(t *testing.T) {
+	desc, _ := cli.NewUpdatePoolWeightsCmd()
+	targetPoolWeights := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("node0token", sdk.ZeroInt())},
+		{Weight: sdk.NewInt(9), Token: sdk.NewCoin("stake", sdk.ZeroInt())},
+	}
+	tcs := map[string]osmocli.TxCliTestCase[*balancer.MsgUpdatePoolWeights]{
+		"update pool weights": {
+			Cmd: "1 1node0token,9stake 72h --from=" + testAddresses[0].String(),
+			ExpectedMsg: &balancer.MsgUpdatePoolWeights{
+				Sender: testAddresses[0].String(),
+				PoolID: 1,
+				SmoothWeightChangeParams: balancer.SmoothWeightChangeParams{
+					Duration:          72 * time.Hour,
+					TargetPoolWeights: targetPoolWeights,
+				},
+			},
+		},
+		"update pool weights with start time": {
+			Cmd: "1 1node0token,9stake 72h --start-time=2023-06-01T00:00:00Z --from=" + testAddresses[0].String(),
+			ExpectedMsg: &balancer.MsgUpdatePoolWeights{
+				Sender: testAddresses[0].String(),
+				PoolID: 1,
+				SmoothWeightChangeParams: balancer.SmoothWeightChangeParams{
+					StartTime:         time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+					Duration:          72 * time.Hour,
+					TargetPoolWeights: targetPoolWeights,
+				},
+			},
+		},
+		"invalid duration": {
+			Cmd:         "1 1node0token,9stake 72 --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewSetScalingFactorRateProviderCmd(t *testing.T) {
+	desc, _ := cli.NewSetScalingFactorRateProviderCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*stableswap.MsgStableSwapSetScalingFactorRateProvider]{
+		"twap rate source": {
+			Cmd: "1 node0token stake day 0.01 3 --twap-pool-id=2 --twap-window=1h --from=" + testAddresses[0].String(),
+			ExpectedMsg: &stableswap.MsgStableSwapSetScalingFactorRateProvider{
+				Sender: testAddresses[0].String(),
+				RateProvider: types.ScalingFactorRateProvider{
+					PoolId:            1,
+					RateDenom:         "node0token",
+					ReferenceDenom:    "stake",
+					EpochIdentifier:   "day",
+					TwapSource:        &types.TwapRateSource{PoolId: 2, Window: time.Hour},
+					MaxChangePerEpoch: sdk.MustNewDecFromStr("0.01"),
+					RampEpochs:        3,
+				},
+			},
+		},
+		"contract rate source": {
+			Cmd: "1 node0token stake day 0.01 3 --contract-address=" + testAddresses[1].String() + " --from=" + testAddresses[0].String(),
+			ExpectedMsg: &stableswap.MsgStableSwapSetScalingFactorRateProvider{
+				Sender: testAddresses[0].String(),
+				RateProvider: types.ScalingFactorRateProvider{
+					PoolId:            1,
+					RateDenom:         "node0token",
+					ReferenceDenom:    "stake",
+					EpochIdentifier:   "day",
+					ContractAddress:   testAddresses[1].String(),
+					MaxChangePerEpoch: sdk.MustNewDecFromStr("0.01"),
+					RampEpochs:        3,
+				},
+			},
+		},
+		"invalid max change per epoch": {
+			Cmd:         "1 node0token stake day abc 3 --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewRemoveScalingFactorRateProviderCmd(t *testing.T) {
+	desc, _ := cli.NewRemoveScalingFactorRateProviderCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*stableswap.MsgStableSwapRemoveScalingFactorRateProvider]{
+		"remove rate provider": {
+			Cmd:         "1 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &stableswap.MsgStableSwapRemoveScalingFactorRateProvider{Sender: testAddresses[0].String(), PoolID: 1},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewRampAmplificationCmd(t *testing.T) {
+	desc, _ := cli.NewRampAmplificationCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*stableswap.MsgStableSwapRampAmplification]{
+		"ramp amplification": {
+			Cmd: "1 200 72h --from=" + testAddresses[0].String(),
+			ExpectedMsg: &stableswap.MsgStableSwapRampAmplification{
+				Sender:              testAddresses[0].String(),
+				PoolID:              1,
+				TargetAmplification: 200,
+				Duration:            72 * time.Hour,
+			},
+		},
+		"invalid duration": {
+			Cmd:         "1 200 72 --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
 func TestGetCmdPools(t *testing.T) {
 	desc, _ := cli.GetCmdPools()
 	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryPoolsRequest]{
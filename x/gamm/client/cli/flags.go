@@ -42,6 +42,16 @@ const (
 	FlagScalingFactors = "scaling-factors"
 
 	FlagMigrationRecords = "migration-records"
+
+	// Will be parsed to time.Time.
+	FlagStartTime = "start-time"
+
+	// Will be parsed to uint64.
+	FlagTwapPoolId = "twap-pool-id"
+	// Will be parsed to time.Duration.
+	FlagTwapWindow = "twap-window"
+	// Will be parsed to string.
+	FlagContractAddress = "contract-address"
 )
 
 type createBalancerPoolInputs struct {
@@ -60,6 +70,7 @@ type createStableswapPoolInputs struct {
 	FutureGovernor          string `json:"future-governor"`
 	ScalingFactorController string `json:"scaling-factor-controller"`
 	ScalingFactors          string `json:"scaling-factors"`
+	Amplification           string `json:"amplification"`
 }
 
 type smoothWeightChangeParamsInputs struct {
@@ -116,3 +127,17 @@ func FlagSetAdjustScalingFactors() *flag.FlagSet {
 	fs.String(FlagScalingFactors, "", "The scaling factors")
 	return fs
 }
+
+func FlagSetUpdatePoolWeights() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagStartTime, "", "The RFC3339 start time of the weight change, defaults to the current block time")
+	return fs
+}
+
+func FlagSetScalingFactorRateProvider() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Uint64(FlagTwapPoolId, 0, "The id of the pool whose arithmetic twap is used as the rate")
+	fs.Duration(FlagTwapWindow, 0, "The window of the arithmetic twap used as the rate")
+	fs.String(FlagContractAddress, "", "The address of the CosmWasm contract queried for the rate")
+	return fs
+}
@@ -33,6 +33,10 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewJoinSwapShareAmountOut)
 	osmocli.AddTxCmd(txCmd, NewExitSwapExternAmountOut)
 	osmocli.AddTxCmd(txCmd, NewExitSwapShareAmountIn)
+	osmocli.AddTxCmd(txCmd, NewUpdatePoolWeightsCmd)
+	osmocli.AddTxCmd(txCmd, NewSetScalingFactorRateProviderCmd)
+	osmocli.AddTxCmd(txCmd, NewRemoveScalingFactorRateProviderCmd)
+	osmocli.AddTxCmd(txCmd, NewRampAmplificationCmd)
 	txCmd.AddCommand(
 		NewCreatePoolCmd().BuildCommandCustomFn(),
 		NewStableSwapAdjustScalingFactorsCmd(),
@@ -64,7 +68,8 @@ For stableswap (demonstrating need for a 1:1000 scaling factor, see doc)
 	"swap-fee": "0.01",
 	"exit-fee": "0.01",
 	"future-governor": "168h",
-	"scaling-factors": "1000,1"
+	"scaling-factors": "1000,1",
+	"amplification": "100"
 }
 `,
 		NumArgs:          0,
@@ -149,8 +154,11 @@ func NewJoinSwapShareAmountOut() (*osmocli.TxCliDesc, *types.MsgJoinSwapShareAmo
 
 func NewExitSwapExternAmountOut() (*osmocli.TxCliDesc, *types.MsgExitSwapExternAmountOut) {
 	return &osmocli.TxCliDesc{
-		Use:                 "exit-swap-extern-amount-out [token-out] [share-in-max-amount]",
-		Short:               "exit swap extern amount out",
+		Use:   "exit-swap-extern-amount-out [token-out] [share-in-max-amount]",
+		Short: "exit swap extern amount out",
+		Long: `Exit a balancer or stableswap pool into an exact amount of one of its assets,
+burning at most share-in-max-amount LP shares.`,
+		Example:             "osmosisd tx gamm exit-swap-extern-amount-out 1000000uusdc 10000000000000000000 --pool-id=1 --from=lp",
 		CustomFlagOverrides: poolIdFlagOverride,
 		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
 	}, &types.MsgExitSwapExternAmountOut{}
@@ -158,8 +166,12 @@ func NewExitSwapExternAmountOut() (*osmocli.TxCliDesc, *types.MsgExitSwapExternA
 
 func NewExitSwapShareAmountIn() (*osmocli.TxCliDesc, *types.MsgExitSwapShareAmountIn) {
 	return &osmocli.TxCliDesc{
-		Use:                 "exit-swap-share-amount-in [token-out-denom] [share-in-amount] [token-out-min-amount]",
-		Short:               "exit swap share amount in",
+		Use:   "exit-swap-share-amount-in [token-out-denom] [share-in-amount] [token-out-min-amount]",
+		Short: "exit swap share amount in",
+		Long: `Exit a pool with an exact amount of LP shares into only one of its assets.
+Stableswap pools exit directly into the asset along the pool's curve. Other pools exit into all of their assets,
+and swap them against the pool into the asset.`,
+		Example:             "osmosisd tx gamm exit-swap-share-amount-in uusdc 10000000000000000000 990000 --pool-id=1 --from=lp",
 		CustomFlagOverrides: poolIdFlagOverride,
 		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
 	}, &types.MsgExitSwapShareAmountIn{}
@@ -181,6 +193,105 @@ func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
 	return cmd
 }
 
+func NewUpdatePoolWeightsCmd() (*osmocli.TxCliDesc, *balancer.MsgUpdatePoolWeights) {
+	return &osmocli.TxCliDesc{
+		Use:   "update-pool-weights [pool-id] [target-pool-weights] [duration]",
+		Short: "schedule a smooth weight change on a balancer pool",
+		Long: `Schedule a smooth weight change on a balancer pool, starting from the pool's current weights.
+Only the pool's future governor may update its weights. If no start time is given, the change starts at the current block time.`,
+		Example:          "osmosisd tx gamm update-pool-weights 1 1uatom,9uosmo 72h --start-time=2023-06-01T00:00:00Z --from=governor",
+		NumArgs:          3,
+		ParseAndBuildMsg: NewUpdatePoolWeightsMsg,
+		Flags:            osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetUpdatePoolWeights()}},
+	}, &balancer.MsgUpdatePoolWeights{}
+}
+
+func NewSetScalingFactorRateProviderCmd() (*osmocli.TxCliDesc, *stableswap.MsgStableSwapSetScalingFactorRateProvider) {
+	return &osmocli.TxCliDesc{
+		Use:   "set-scaling-factor-rate-provider [pool-id] [rate-denom] [reference-denom] [epoch-identifier] [max-change-per-epoch] [ramp-epochs]",
+		Short: "set the rate provider that updates a stableswap pool's scaling factors every epoch",
+		Long: `Set the rate provider that updates a stableswap pool's scaling factors at the end of every epoch.
+The rate of the rate denom in units of the reference denom is read either from the arithmetic twap of a pool
+(--twap-pool-id and --twap-window) or from a CosmWasm contract (--contract-address).
+Only the pool's scaling factor controller may set its rate provider.`,
+		Example:          "osmosisd tx gamm set-scaling-factor-rate-provider 1 stuatom uatom day 0.01 3 --contract-address=osmo1... --from=controller",
+		NumArgs:          6,
+		ParseAndBuildMsg: NewSetScalingFactorRateProviderMsg,
+		Flags:            osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetScalingFactorRateProvider()}},
+	}, &stableswap.MsgStableSwapSetScalingFactorRateProvider{}
+}
+
+func NewRemoveScalingFactorRateProviderCmd() (*osmocli.TxCliDesc, *stableswap.MsgStableSwapRemoveScalingFactorRateProvider) {
+	return &osmocli.TxCliDesc{
+		Use:     "remove-scaling-factor-rate-provider [pool-id]",
+		Short:   "remove the rate provider of a stableswap pool",
+		Example: "osmosisd tx gamm remove-scaling-factor-rate-provider 1 --from=controller",
+	}, &stableswap.MsgStableSwapRemoveScalingFactorRateProvider{}
+}
+
+func NewRampAmplificationCmd() (*osmocli.TxCliDesc, *stableswap.MsgStableSwapRampAmplification) {
+	return &osmocli.TxCliDesc{
+		Use:   "ramp-amplification [pool-id] [target-amplification] [duration]",
+		Short: "linearly change the amplification of a stableswap pool",
+		Long: `Linearly change the amplification of a stableswap pool from its current value to the target amplification
+over the given duration, starting at the current block time. Only the pool's future governor may ramp its amplification.`,
+		Example: "osmosisd tx gamm ramp-amplification 1 200 72h --from=governor",
+	}, &stableswap.MsgStableSwapRampAmplification{}
+}
+
+// NewCmdSubmitUpdatePoolWeightsProposal implements a command handler for update pool weights proposal
+func NewCmdSubmitUpdatePoolWeightsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-pool-weights-proposal [pool-id] [target-pool-weights] [duration] [flags]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to schedule a smooth weight change on a balancer pool",
+		Long: strings.TrimSpace(`Submit a proposal to schedule a smooth weight change on a balancer pool, starting from the pool's current weights.
+Unlike update-pool-weights, the proposal applies to any balancer pool, regardless of its future governor.
+If no start time is given, the change starts at the block time the proposal passes.
+Ex) 1 1uatom,9uosmo 72h --start-time=2023-06-01T00:00:00Z
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			content, err := parseUpdatePoolWeightsArgsToContent(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().AddFlagSet(FlagSetUpdatePoolWeights())
+
+	return cmd
+}
+
 // NewCmdSubmitReplaceMigrationRecordsProposal implements a command handler for replace migration records proposal
 func NewCmdSubmitReplaceMigrationRecordsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -442,6 +553,13 @@ func NewBuildCreateStableswapPoolMsg(clientCtx client.Context, fs *flag.FlagSet)
 		ExitFee: exitFee,
 	}
 
+	if flags.Amplification != "" {
+		poolParams.Amplification, err = strconv.ParseUint(flags.Amplification, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	scalingFactors := []uint64{}
 	trimmedSfString := strings.Trim(flags.ScalingFactors, "[] {}")
 	if len(trimmedSfString) > 0 {
@@ -610,6 +728,64 @@ func NewStableSwapAdjustScalingFactorsMsg(clientCtx client.Context, _args []stri
 	return msg, nil
 }
 
+func NewUpdatePoolWeightsMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	startTimeStr, err := fs.GetString(FlagStartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := parseSmoothWeightChangeParams(args[1], args[2], startTimeStr)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := balancer.NewMsgUpdatePoolWeights(clientCtx.GetFromAddress().String(), poolID, params)
+	return &msg, nil
+}
+
+// parseSmoothWeightChangeParams parses the params of a pool weight update from its target weights,
+// e.g. "1uatom,9uosmo", its duration and its optional RFC3339 start time.
+func parseSmoothWeightChangeParams(targetPoolWeightsStr, durationStr, startTimeStr string) (balancer.SmoothWeightChangeParams, error) {
+	targetPoolWeightCoins, err := sdk.ParseDecCoins(targetPoolWeightsStr)
+	if err != nil {
+		return balancer.SmoothWeightChangeParams{}, err
+	}
+
+	targetPoolWeights := make([]balancer.PoolAsset, len(targetPoolWeightCoins))
+	for i, weightCoin := range targetPoolWeightCoins {
+		// Only the denom of the target weight's token is used.
+		targetPoolWeights[i] = balancer.PoolAsset{
+			Weight: weightCoin.Amount.RoundInt(),
+			Token:  sdk.NewCoin(weightCoin.Denom, sdk.ZeroInt()),
+		}
+	}
+
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return balancer.SmoothWeightChangeParams{}, fmt.Errorf("could not parse duration: %w", err)
+	}
+
+	params := balancer.SmoothWeightChangeParams{
+		Duration:          duration,
+		TargetPoolWeights: targetPoolWeights,
+	}
+
+	if startTimeStr != "" {
+		startTime, err := time.Parse(time.RFC3339, startTimeStr)
+		if err != nil {
+			return balancer.SmoothWeightChangeParams{}, fmt.Errorf("could not parse time: %w", err)
+		}
+		params.StartTime = startTime
+	}
+
+	return params, nil
+}
+
 // ParseCoinsNoSort parses coins from coinsStr but does not sort them.
 // Returns error if parsing fails.
 func ParseCoinsNoSort(coinsStr string) (sdk.Coins, error) {
@@ -682,6 +858,35 @@ func parseReplaceMigrationRecordsArgsToContent(cmd *cobra.Command) (govtypes.Con
 	return content, nil
 }
 
+func parseUpdatePoolWeightsArgsToContent(cmd *cobra.Command, args []string) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	poolId, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	startTimeStr, err := cmd.Flags().GetString(FlagStartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := parseSmoothWeightChangeParams(args[1], args[2], startTimeStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return balancer.NewUpdatePoolWeightsProposal(title, description, poolId, params), nil
+}
+
 func parseUpdateMigrationRecordsArgsToContent(cmd *cobra.Command) (govtypes.Content, error) {
 	title, err := cmd.Flags().GetString(govcli.FlagTitle)
 	if err != nil {
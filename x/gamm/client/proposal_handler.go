@@ -10,4 +10,5 @@ import (
 var (
 	ReplaceMigrationRecordsProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitReplaceMigrationRecordsProposal, rest.ProposalUpdateMigrationRecordsRESTHandler)
 	UpdateMigrationRecordsProposalHandler  = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateMigrationRecordsProposal, rest.ProposalUpdateMigrationRecordsRESTHandler)
+	UpdatePoolWeightsProposalHandler       = govclient.NewProposalHandler(cli.NewCmdSubmitUpdatePoolWeightsProposal, rest.ProposalUpdatePoolWeightsRESTHandler)
 )
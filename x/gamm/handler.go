@@ -6,20 +6,23 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 )
 
-// NewMigrationRecordHandler is a handler for governance proposals on new migration records.
-func NewMigrationRecordHandler(k keeper.Keeper) govtypes.Handler {
+// NewGammProposalHandler is a handler for governance proposals on migration records and balancer pool weights.
+func NewGammProposalHandler(k keeper.Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
 		case *types.UpdateMigrationRecordsProposal:
 			return handleUpdateMigrationRecordsProposal(ctx, k, c)
 		case *types.ReplaceMigrationRecordsProposal:
 			return handleReplaceMigrationRecordsProposal(ctx, k, c)
+		case *balancer.UpdatePoolWeightsProposal:
+			return handleUpdatePoolWeightsProposal(ctx, k, c)
 
 		default:
-			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized migration record proposal content type: %T", c)
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gamm proposal content type: %T", c)
 		}
 	}
 }
@@ -33,3 +36,8 @@ func handleReplaceMigrationRecordsProposal(ctx sdk.Context, k keeper.Keeper, p *
 func handleUpdateMigrationRecordsProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateMigrationRecordsProposal) error {
 	return k.HandleUpdateMigrationRecordsProposal(ctx, p)
 }
+
+// handleUpdatePoolWeightsProposal is a handler for updating balancer pool weights governance proposals
+func handleUpdatePoolWeightsProposal(ctx sdk.Context, k keeper.Keeper, p *balancer.UpdatePoolWeightsProposal) error {
+	return k.HandleUpdatePoolWeightsProposal(ctx, p)
+}
@@ -1,8 +1,12 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
@@ -21,6 +25,18 @@ func (k Keeper) SetStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scal
 	return k.setStableSwapScalingFactors(ctx, poolId, scalingFactors, sender)
 }
 
+func (k Keeper) UpdateBalancerPoolWeights(ctx sdk.Context, poolId uint64, params balancer.SmoothWeightChangeParams, sender string) (balancer.SmoothWeightChangeParams, error) {
+	return k.updateBalancerPoolWeights(ctx, poolId, params, sender)
+}
+
+func (k Keeper) RampStableswapAmplification(ctx sdk.Context, poolId uint64, targetAmplification uint64, duration time.Duration, sender string) (stableswap.AmplificationRamp, error) {
+	return k.rampStableswapAmplification(ctx, poolId, targetAmplification, duration, sender)
+}
+
+func (k Keeper) UpdateScalingFactorsAtEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64, maxUpdates int) {
+	k.updateScalingFactorsAtEpochEnd(ctx, epochIdentifier, epochNumber, maxUpdates)
+}
+
 func ConvertToCFMMPool(pool poolmanagertypes.PoolI) (types.CFMMPoolI, error) {
 	return convertToCFMMPool(pool)
 }
@@ -40,6 +40,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 	} else {
 		k.SetMigrationInfo(ctx, *genState.MigrationRecords)
 	}
+
+	for _, rateProvider := range genState.ScalingFactorRateProviders {
+		k.setScalingFactorRateProvider(ctx, rateProvider)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -57,10 +61,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		}
 		poolAnys = append(poolAnys, any)
 	}
+	rateProviders, err := k.GetAllScalingFactorRateProviders(ctx)
+	if err != nil {
+		panic(err)
+	}
 	return &types.GenesisState{
-		NextPoolNumber:   k.GetNextPoolId(ctx),
-		Pools:            poolAnys,
-		Params:           k.GetParams(ctx),
-		MigrationRecords: &migrationInfo,
+		NextPoolNumber:             k.GetNextPoolId(ctx),
+		Pools:                      poolAnys,
+		Params:                     k.GetParams(ctx),
+		MigrationRecords:           &migrationInfo,
+		ScalingFactorRateProviders: rateProviders,
 	}
 }
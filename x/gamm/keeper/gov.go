@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 )
 
@@ -13,3 +14,20 @@ func (k Keeper) HandleReplaceMigrationRecordsProposal(ctx sdk.Context, p *types.
 func (k Keeper) HandleUpdateMigrationRecordsProposal(ctx sdk.Context, p *types.UpdateMigrationRecordsProposal) error {
 	return k.UpdateMigrationRecords(ctx, p.Records)
 }
+
+// HandleUpdatePoolWeightsProposal schedules the proposal's smooth weight change on a balancer pool.
+// Unlike MsgUpdatePoolWeights, it applies to any balancer pool regardless of its governor.
+func (k Keeper) HandleUpdatePoolWeightsProposal(ctx sdk.Context, p *balancer.UpdatePoolWeightsProposal) error {
+	balancerPool, err := k.getBalancerPoolAndPoke(ctx, p.PoolId)
+	if err != nil {
+		return err
+	}
+
+	params, err := k.scheduleBalancerPoolWeightChange(ctx, balancerPool, p.SmoothWeightChangeParams)
+	if err != nil {
+		return err
+	}
+
+	emitPoolWeightsUpdatedEvent(ctx, p.PoolId, params)
+	return nil
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+// TestHandleUpdatePoolWeightsProposal tests that a governance proposal can schedule a weight change
+// on any balancer pool, including pools whose governor can not send messages.
+func (suite *KeeperTestSuite) TestHandleUpdatePoolWeightsProposal() {
+	duration := time.Hour
+	targetPoolWeights := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("foo", sdk.ZeroInt())},
+		{Weight: sdk.NewInt(9), Token: sdk.NewCoin("bar", sdk.ZeroInt())},
+	}
+
+	tests := map[string]struct {
+		futureGovernor   string
+		poolId           uint64
+		isStableswapPool bool
+		expectedErr      error
+	}{
+		"pool governed by an address": {
+			futureGovernor: suite.TestAccs[0].String(),
+			poolId:         1,
+		},
+		"pool governed by the lockers of its shares": {
+			futureGovernor: "24h",
+			poolId:         1,
+		},
+		"pool without a governor": {
+			poolId: 1,
+		},
+		"error: pool does not exist": {
+			poolId:      2,
+			expectedErr: types.PoolDoesNotExistError{PoolId: 2},
+		},
+		"error: pool is not a balancer pool": {
+			poolId:           1,
+			isStableswapPool: true,
+			expectedErr:      fmt.Errorf("pool id 1 is not of type balancer pool"),
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			if tc.isStableswapPool {
+				suite.prepareCustomStableswapPool(defaultAcctFunds, defaultStableSwapPoolParams, defaultStableSwapPoolAssets, defaultScalingFactor)
+			} else {
+				poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+				pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+				suite.Require().NoError(err)
+				balancerPool := pool.(*balancer.Pool)
+				balancerPool.FuturePoolGovernor = tc.futureGovernor
+				suite.Require().NoError(suite.App.GAMMKeeper.SetPool(suite.Ctx, balancerPool))
+			}
+
+			suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+			proposal := balancer.NewUpdatePoolWeightsProposal("title", "description", tc.poolId, balancer.SmoothWeightChangeParams{
+				Duration:          duration,
+				TargetPoolWeights: targetPoolWeights,
+			})
+			suite.Require().NoError(proposal.ValidateBasic())
+
+			// The proposal is routed through the gamm governance handler.
+			err := gamm.NewGammProposalHandler(*suite.App.GAMMKeeper)(suite.Ctx, proposal)
+			if tc.expectedErr != nil {
+				suite.Require().Error(err)
+				suite.Require().ErrorContains(err, tc.expectedErr.Error())
+				suite.AssertEventEmitted(suite.Ctx, types.TypeEvtPoolWeightsUpdated, 0)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtPoolWeightsUpdated, 1)
+
+			// The weights reach their targets once the weight change is over.
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(duration + time.Second))
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, tc.poolId)
+			suite.Require().NoError(err)
+			balancerPool := pool.(*balancer.Pool)
+			suite.Require().Nil(balancerPool.PoolParams.SmoothWeightChangeParams)
+			suite.Require().Equal(tc.futureGovernor, balancerPool.FuturePoolGovernor)
+
+			fooWeight, err := balancerPool.GetTokenWeight("foo")
+			suite.Require().NoError(err)
+			barWeight, err := balancerPool.GetTokenWeight("bar")
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewInt(9).Mul(fooWeight), barWeight)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,8 +26,9 @@ type Keeper struct {
 	storeKey sdk.StoreKey
 	cdc      codec.BinaryCodec
 
-	paramSpace paramtypes.Subspace
-	hooks      types.GammHooks
+	paramSpace    paramtypes.Subspace
+	hooks         types.GammHooks
+	poolListeners poolmanagertypes.PoolListeners
 
 	// keepers
 	accountKeeper       types.AccountKeeper
@@ -34,6 +36,8 @@ type Keeper struct {
 	communityPoolKeeper types.CommunityPoolKeeper
 	poolManager         types.PoolManager
 	clKeeper            types.CLKeeper
+	twapKeeper          types.TwapKeeper
+	wasmKeeper          types.WasmKeeper
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, communityPoolKeeper types.CommunityPoolKeeper, clKeeper types.CLKeeper) Keeper {
@@ -74,10 +78,31 @@ func (k *Keeper) SetHooks(gh types.GammHooks) *Keeper {
 	return k
 }
 
+// SetPoolListeners sets the listeners notified of swaps and liquidity changes in gamm pools.
+func (k *Keeper) SetPoolListeners(poolListeners poolmanagertypes.PoolListeners) *Keeper {
+	if k.poolListeners != nil {
+		panic("cannot set gamm pool listeners twice")
+	}
+
+	k.poolListeners = poolListeners
+
+	return k
+}
+
 func (k *Keeper) SetPoolManager(poolManager types.PoolManager) {
 	k.poolManager = poolManager
 }
 
+// SetTwapKeeper sets the twap keeper used to read scaling factor rates.
+func (k *Keeper) SetTwapKeeper(twapKeeper types.TwapKeeper) {
+	k.twapKeeper = twapKeeper
+}
+
+// SetWasmKeeper sets the wasm keeper used to query scaling factor rate provider contracts.
+func (k *Keeper) SetWasmKeeper(wasmKeeper types.WasmKeeper) {
+	k.wasmKeeper = wasmKeeper
+}
+
 // GetParams returns the total set params.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
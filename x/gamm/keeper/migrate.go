@@ -16,40 +16,78 @@ import (
 // MigrateFromBalancerToConcentrated migrates unlocked lp tokens from a balancer pool to a concentrated liquidity pool.
 // Fails if the lp tokens are locked (must utilize UnlockAndMigrate function in the superfluid module)
 func (k Keeper) MigrateFromBalancerToConcentrated(ctx sdk.Context, sender sdk.AccAddress, sharesToMigrate sdk.Coin) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, joinTime time.Time, poolIdLeaving, poolIdEntering uint64, err error) {
-	// Get the balancer poolId by parsing the gamm share denom.
-	poolIdLeaving, err = types.GetPoolIdFromShareDenom(sharesToMigrate.Denom)
+	concentratedPool, exitCoins, poolIdLeaving, poolIdEntering, err := k.exitBalancerPoolForMigration(ctx, sender, sharesToMigrate)
 	if err != nil {
 		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, err
 	}
+	// Defense in depth, ensuring we are returning exactly two coins.
+	if len(exitCoins) != 2 {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, fmt.Errorf("Balancer pool must have exactly two tokens")
+	}
 
-	// Find the governance sanctioned link between the balancer pool and a concentrated pool.
-	poolIdEntering, err = k.GetLinkedConcentratedPoolID(ctx, poolIdLeaving)
+	// Create a full range (min to max tick) concentrated liquidity position.
+	positionId, amount0, amount1, liquidity, joinTime, err = k.clKeeper.CreateFullRangePosition(ctx, concentratedPool, sender, exitCoins, 0)
 	if err != nil {
 		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, err
 	}
+	return positionId, amount0, amount1, liquidity, joinTime, poolIdLeaving, poolIdEntering, nil
+}
 
-	// Get the concentrated pool from the message and type cast it to ConcentratedPoolExtension.
-	concentratedPool, err := k.clKeeper.GetPoolFromPoolIdAndConvertToConcentrated(ctx, poolIdEntering)
+// MigrateSharesToConcentratedPosition migrates unlocked lp tokens from a balancer pool to a concentrated liquidity position
+// between lowerTick and upperTick in the linked concentrated liquidity pool, frozen for the given duration.
+// The position is created with as much of the exited tokens as the tick range allows. The exited tokens that are not used
+// by the position stay in the sender's account and are returned as leftoverCoins.
+// Fails if:
+// - the lp tokens are locked (must utilize MigrateSharesToConcentratedPosition in the superfluid module)
+// - the balancer pool is not linked to a concentrated liquidity pool
+// - the position would use less of either concentrated pool token than given by tokenOutMins
+func (k Keeper) MigrateSharesToConcentratedPosition(ctx sdk.Context, sender sdk.AccAddress, sharesToMigrate sdk.Coin, lowerTick, upperTick int64, tokenOutMins sdk.Coins, freezeDuration time.Duration) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, joinTime time.Time, leftoverCoins sdk.Coins, poolIdLeaving, poolIdEntering uint64, err error) {
+	concentratedPool, exitCoins, poolIdLeaving, poolIdEntering, err := k.exitBalancerPoolForMigration(ctx, sender, sharesToMigrate)
 	if err != nil {
-		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, err
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, sdk.Coins{}, 0, 0, err
 	}
 
-	// Exit the balancer pool position.
-	exitCoins, err := k.ExitPool(ctx, sender, poolIdLeaving, sharesToMigrate.Amount, sdk.NewCoins())
+	// Create a concentrated liquidity position in the requested range, using at most the exited tokens.
+	token0, token1 := concentratedPool.GetToken0(), concentratedPool.GetToken1()
+	positionId, amount0, amount1, liquidity, joinTime, err = k.clKeeper.CreatePosition(ctx, poolIdEntering, sender,
+		exitCoins.AmountOf(token0), exitCoins.AmountOf(token1),
+		tokenOutMins.AmountOf(token0), tokenOutMins.AmountOf(token1),
+		lowerTick, upperTick, freezeDuration)
 	if err != nil {
-		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, err
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, sdk.Coins{}, 0, 0, err
 	}
-	// Defense in depth, ensuring we are returning exactly two coins.
-	if len(exitCoins) != 2 {
-		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, fmt.Errorf("Balancer pool must have exactly two tokens")
+
+	leftoverCoins = exitCoins.Sub(sdk.NewCoins(sdk.NewCoin(token0, amount0), sdk.NewCoin(token1, amount1)))
+	return positionId, amount0, amount1, liquidity, joinTime, leftoverCoins, poolIdLeaving, poolIdEntering, nil
+}
+
+// exitBalancerPoolForMigration exits the balancer pool of the given lp tokens on behalf of the sender, and returns the
+// concentrated liquidity pool linked to it along with the exited coins.
+func (k Keeper) exitBalancerPoolForMigration(ctx sdk.Context, sender sdk.AccAddress, sharesToMigrate sdk.Coin) (concentratedPool cltypes.ConcentratedPoolExtension, exitCoins sdk.Coins, poolIdLeaving, poolIdEntering uint64, err error) {
+	// Get the balancer poolId by parsing the gamm share denom.
+	poolIdLeaving, err = types.GetPoolIdFromShareDenom(sharesToMigrate.Denom)
+	if err != nil {
+		return nil, sdk.Coins{}, 0, 0, err
 	}
 
-	// Create a full range (min to max tick) concentrated liquidity position.
-	positionId, amount0, amount1, liquidity, joinTime, err = k.clKeeper.CreateFullRangePosition(ctx, concentratedPool, sender, exitCoins, 0)
+	// Find the governance sanctioned link between the balancer pool and a concentrated pool.
+	poolIdEntering, err = k.GetLinkedConcentratedPoolID(ctx, poolIdLeaving)
 	if err != nil {
-		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, err
+		return nil, sdk.Coins{}, 0, 0, err
 	}
-	return positionId, amount0, amount1, liquidity, joinTime, poolIdLeaving, poolIdEntering, nil
+
+	// Get the concentrated pool from the message and type cast it to ConcentratedPoolExtension.
+	concentratedPool, err = k.clKeeper.GetPoolFromPoolIdAndConvertToConcentrated(ctx, poolIdEntering)
+	if err != nil {
+		return nil, sdk.Coins{}, 0, 0, err
+	}
+
+	// Exit the balancer pool position.
+	exitCoins, err = k.ExitPool(ctx, sender, poolIdLeaving, sharesToMigrate.Amount, sdk.NewCoins())
+	if err != nil {
+		return nil, sdk.Coins{}, 0, 0, err
+	}
+	return concentratedPool, exitCoins, poolIdLeaving, poolIdEntering, nil
 }
 
 // GetMigrationInfo returns the balancer to gamm pool migration info from the store
@@ -8,6 +8,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	cl "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity"
+	cltypes "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 )
 
@@ -214,6 +215,97 @@ func (suite *KeeperTestSuite) TestMigrate() {
 	}
 }
 
+// TestMigrateSharesToConcentratedPosition tests that balancer shares are migrated to a position in the requested
+// tick range, and that the exited tokens the position does not use are left with the sender.
+func (suite *KeeperTestSuite) TestMigrateSharesToConcentratedPosition() {
+	defaultGammShares := sdk.NewCoin("gamm/pool/1", types.InitPoolSharesSupply.QuoRaw(2))
+
+	tests := map[string]struct {
+		lowerTick              int64
+		upperTick              int64
+		tokenOutMins           sdk.Coins
+		setupPoolMigrationLink bool
+		expectOnlyToken0       bool
+		expectedErr            error
+	}{
+		"range around the current price": {
+			lowerTick:              -1000,
+			upperTick:              1000,
+			setupPoolMigrationLink: true,
+		},
+		"range above the current price uses only token0": {
+			lowerTick:              1000,
+			upperTick:              2000,
+			tokenOutMins:           sdk.NewCoins(sdk.NewCoin(ETH, sdk.OneInt())),
+			setupPoolMigrationLink: true,
+			expectOnlyToken0:       true,
+		},
+		"error: no pool migration link": {
+			lowerTick:   -1000,
+			upperTick:   1000,
+			expectedErr: types.PoolMigrationLinkNotFoundError{PoolIdLeaving: 1},
+		},
+		"error: position uses less than token out mins": {
+			lowerTick:              1000,
+			upperTick:              2000,
+			tokenOutMins:           sdk.NewCoins(sdk.NewCoin(USDC, sdk.OneInt())),
+			setupPoolMigrationLink: true,
+			expectedErr:            cltypes.InsufficientLiquidityCreatedError{Actual: sdk.ZeroInt(), Minimum: sdk.OneInt(), IsTokenZero: false},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			keeper := suite.App.GAMMKeeper
+			sender := suite.TestAccs[0]
+
+			// Prepare both balancer and concentrated pools, with the concentrated pool priced at 1 usdc per eth.
+			balancerPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewCoin(ETH, sdk.NewInt(100000000000)), sdk.NewCoin(USDC, sdk.NewInt(100000000000)))
+			clPool := suite.PrepareConcentratedPool()
+			clLiquidity := sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(100000000000)), sdk.NewCoin(USDC, sdk.NewInt(100000000000)))
+			suite.FundAcc(suite.TestAccs[1], clLiquidity)
+			_, _, _, _, _, err := suite.App.ConcentratedLiquidityKeeper.CreateFullRangePosition(suite.Ctx, clPool, suite.TestAccs[1], clLiquidity, 0)
+			suite.Require().NoError(err)
+
+			if tc.setupPoolMigrationLink {
+				record := types.BalancerToConcentratedPoolLink{BalancerPoolId: balancerPoolId, ClPoolId: clPool.GetId()}
+				err = keeper.ReplaceMigrationRecords(suite.Ctx, []types.BalancerToConcentratedPoolLink{record})
+				suite.Require().NoError(err)
+			}
+
+			balancerPool, err := keeper.GetPoolAndPoke(suite.Ctx, balancerPoolId)
+			suite.Require().NoError(err)
+			expectedExitCoins, err := balancerPool.CalcExitPoolCoinsFromShares(suite.Ctx, defaultGammShares.Amount, sdk.ZeroDec())
+			suite.Require().NoError(err)
+			userBalancesBeforeMigration := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+
+			positionId, amount0, amount1, liquidity, joinTime, leftoverCoins, poolIdLeaving, poolIdEntering, err := keeper.MigrateSharesToConcentratedPosition(suite.Ctx, sender, defaultGammShares, tc.lowerTick, tc.upperTick, tc.tokenOutMins, 0)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorContains(err, tc.expectedErr.Error())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(balancerPoolId, poolIdLeaving)
+			suite.Require().Equal(clPool.GetId(), poolIdEntering)
+
+			// The position is created in the requested range.
+			positionLiquidity, err := suite.App.ConcentratedLiquidityKeeper.GetPositionLiquidity(suite.Ctx, clPool.GetId(), sender, tc.lowerTick, tc.upperTick, joinTime, 0, positionId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(liquidity, positionLiquidity)
+			if tc.expectOnlyToken0 {
+				suite.Require().True(amount1.IsZero())
+			}
+
+			// The exited coins not used by the position are left with the sender.
+			expectedLeftoverCoins := expectedExitCoins.Sub(sdk.NewCoins(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1)))
+			suite.Require().Equal(expectedLeftoverCoins.String(), leftoverCoins.String())
+			userBalancesAfterMigration := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			suite.Require().Equal(userBalancesBeforeMigration.Add(leftoverCoins...).Sub(sdk.NewCoins(defaultGammShares)).String(), userBalancesAfterMigration.String())
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestReplaceMigrationRecords() {
 	tests := []struct {
 		name                        string
@@ -65,6 +65,75 @@ func (server msgServer) StableSwapAdjustScalingFactors(goCtx context.Context, ms
 	return &stableswap.MsgStableSwapAdjustScalingFactorsResponse{}, nil
 }
 
+func (server msgServer) StableSwapSetScalingFactorRateProvider(goCtx context.Context, msg *stableswap.MsgStableSwapSetScalingFactorRateProvider) (*stableswap.MsgStableSwapSetScalingFactorRateProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.SetScalingFactorRateProvider(ctx, msg.RateProvider, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStableSwapSetScalingFactorRateProviderResponse{}, nil
+}
+
+func (server msgServer) StableSwapRemoveScalingFactorRateProvider(goCtx context.Context, msg *stableswap.MsgStableSwapRemoveScalingFactorRateProvider) (*stableswap.MsgStableSwapRemoveScalingFactorRateProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.RemoveScalingFactorRateProvider(ctx, msg.PoolID, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStableSwapRemoveScalingFactorRateProviderResponse{}, nil
+}
+
+// StableSwapRampAmplification linearly changes a stableswap pool's amplification to a target value.
+func (server msgServer) StableSwapRampAmplification(goCtx context.Context, msg *stableswap.MsgStableSwapRampAmplification) (*stableswap.MsgStableSwapRampAmplificationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ramp, err := server.keeper.rampStableswapAmplification(ctx, msg.PoolID, msg.TargetAmplification, msg.Duration, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtAmplificationRamped,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolID, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmplification, strconv.FormatUint(ramp.InitialAmplification, 10)),
+			sdk.NewAttribute(types.AttributeKeyTargetAmplification, strconv.FormatUint(ramp.TargetAmplification, 10)),
+			sdk.NewAttribute(types.AttributeKeyStartTime, ramp.StartTime.String()),
+			sdk.NewAttribute(types.AttributeKeyDuration, ramp.Duration.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &stableswap.MsgStableSwapRampAmplificationResponse{}, nil
+}
+
+// UpdatePoolWeights schedules a smooth weight change on a balancer pool, such as a liquidity bootstrapping pool.
+func (server msgServer) UpdatePoolWeights(goCtx context.Context, msg *balancer.MsgUpdatePoolWeights) (*balancer.MsgUpdatePoolWeightsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := server.keeper.updateBalancerPoolWeights(ctx, msg.PoolID, msg.SmoothWeightChangeParams, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	emitPoolWeightsUpdatedEvent(ctx, msg.PoolID, params)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &balancer.MsgUpdatePoolWeightsResponse{}, nil
+}
+
 // CreatePool attempts to create a pool returning the newly created pool ID or an error upon failure.
 // The pool creation fee is used to fund the community pool.
 // It will create a dedicated module account for the pool and sends the initial liquidity to the created module account.
@@ -1,10 +1,14 @@
 package keeper_test
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/keeper"
 	balancer "github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
@@ -476,3 +480,202 @@ func (suite *KeeperTestSuite) TestMsgMigrateShares_Events() {
 		}
 	}
 }
+
+// TestUpdatePoolWeights tests that a pool's governor can schedule a weight change,
+// that the weight change is applied as the pool is poked, and that events are emitted.
+func (suite *KeeperTestSuite) TestUpdatePoolWeights() {
+	governor := suite.TestAccs[0]
+	duration := time.Hour
+	targetPoolWeights := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("foo", sdk.ZeroInt())},
+		{Weight: sdk.NewInt(9), Token: sdk.NewCoin("bar", sdk.ZeroInt())},
+	}
+
+	tests := map[string]struct {
+		sender           sdk.AccAddress
+		poolId           uint64
+		isStableswapPool bool
+		lockGovernor     string
+		expectedErr      error
+	}{
+		"governor updates pool weights": {
+			sender: governor,
+			poolId: 1,
+		},
+		"error: pool is governed by the lockers of its shares": {
+			sender:       governor,
+			poolId:       1,
+			lockGovernor: "24h",
+			expectedErr:  types.LockDurationPoolGovernorError{PoolId: 1, Governor: "24h"},
+		},
+		"error: pool is governed by the lockers of a token": {
+			sender:       governor,
+			poolId:       1,
+			lockGovernor: "gamm/pool/10,24h",
+			expectedErr:  types.LockDurationPoolGovernorError{PoolId: 1, Governor: "gamm/pool/10,24h"},
+		},
+		"error: sender is not the governor": {
+			sender:      suite.TestAccs[1],
+			poolId:      1,
+			expectedErr: types.ErrNotPoolGovernor,
+		},
+		"error: pool does not exist": {
+			sender:      governor,
+			poolId:      2,
+			expectedErr: types.PoolDoesNotExistError{PoolId: 2},
+		},
+		"error: pool is not a balancer pool": {
+			sender:           governor,
+			poolId:           1,
+			isStableswapPool: true,
+			expectedErr:      fmt.Errorf("pool id 1 is not of type balancer pool"),
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			if tc.isStableswapPool {
+				suite.prepareCustomStableswapPool(defaultAcctFunds, defaultStableSwapPoolParams, defaultStableSwapPoolAssets, defaultScalingFactor)
+			} else {
+				poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+				pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+				suite.Require().NoError(err)
+				balancerPool := pool.(*balancer.Pool)
+				balancerPool.FuturePoolGovernor = governor.String()
+				if tc.lockGovernor != "" {
+					balancerPool.FuturePoolGovernor = tc.lockGovernor
+				}
+				suite.Require().NoError(suite.App.GAMMKeeper.SetPool(suite.Ctx, balancerPool))
+			}
+
+			suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+			msgServer := keeper.NewBalancerMsgServerImpl(suite.App.GAMMKeeper)
+			msg := balancer.NewMsgUpdatePoolWeights(tc.sender.String(), tc.poolId, balancer.SmoothWeightChangeParams{
+				Duration:          duration,
+				TargetPoolWeights: targetPoolWeights,
+			})
+
+			_, err := msgServer.UpdatePoolWeights(sdk.WrapSDKContext(suite.Ctx), &msg)
+			if tc.expectedErr != nil {
+				suite.Require().Error(err)
+				suite.Require().ErrorContains(err, tc.expectedErr.Error())
+				suite.AssertEventEmitted(suite.Ctx, types.TypeEvtPoolWeightsUpdated, 0)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtPoolWeightsUpdated, 1)
+			suite.AssertEventEmitted(suite.Ctx, sdk.EventTypeMessage, 1)
+
+			// The weights reach their targets once the weight change is over.
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(duration + time.Second))
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, tc.poolId)
+			suite.Require().NoError(err)
+			balancerPool := pool.(*balancer.Pool)
+			suite.Require().Nil(balancerPool.PoolParams.SmoothWeightChangeParams)
+
+			fooWeight, err := balancerPool.GetTokenWeight("foo")
+			suite.Require().NoError(err)
+			barWeight, err := balancerPool.GetTokenWeight("bar")
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewInt(9).Mul(fooWeight), barWeight)
+		})
+	}
+}
+
+// TestStableSwapRampAmplification tests that a stableswap pool's governor can ramp the pool's
+// amplification, that the amplification is interpolated as the pool is poked, and that events are emitted.
+func (suite *KeeperTestSuite) TestStableSwapRampAmplification() {
+	governor := suite.TestAccs[0]
+	duration := 2 * stableswap.MinAmplificationRampDuration
+
+	tests := map[string]struct {
+		sender              sdk.AccAddress
+		poolId              uint64
+		targetAmplification uint64
+		isBalancerPool      bool
+		expectedErr         error
+	}{
+		"governor ramps amplification up": {
+			sender:              governor,
+			poolId:              1,
+			targetAmplification: 300,
+		},
+		"governor ramps amplification down to the solidly curve": {
+			sender:              governor,
+			poolId:              1,
+			targetAmplification: 0,
+		},
+		"error: sender is not the governor": {
+			sender:              suite.TestAccs[1],
+			poolId:              1,
+			targetAmplification: 300,
+			expectedErr:         types.ErrNotPoolGovernor,
+		},
+		"error: pool does not exist": {
+			sender:              governor,
+			poolId:              2,
+			targetAmplification: 300,
+			expectedErr:         types.PoolDoesNotExistError{PoolId: 2},
+		},
+		"error: pool is not a stableswap pool": {
+			sender:              governor,
+			poolId:              1,
+			targetAmplification: 300,
+			isBalancerPool:      true,
+			expectedErr:         fmt.Errorf("pool id 1 is not of type stableswap pool"),
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			if tc.isBalancerPool {
+				suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+			} else {
+				poolParams := defaultStableSwapPoolParams
+				poolParams.Amplification = 100
+				suite.fundAllAccountsWith(defaultAcctFunds)
+				_, err := suite.App.PoolManagerKeeper.CreatePool(suite.Ctx,
+					stableswap.NewMsgCreateStableswapPool(governor, poolParams, defaultStableSwapPoolAssets, defaultScalingFactor, governor.String()))
+				suite.Require().NoError(err)
+			}
+
+			suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+			msgServer := keeper.NewStableswapMsgServerImpl(suite.App.GAMMKeeper)
+			msg := stableswap.NewMsgStableSwapRampAmplification(tc.sender.String(), tc.poolId, tc.targetAmplification, duration)
+
+			_, err := msgServer.StableSwapRampAmplification(sdk.WrapSDKContext(suite.Ctx), &msg)
+			if tc.expectedErr != nil {
+				suite.Require().Error(err)
+				suite.Require().ErrorContains(err, tc.expectedErr.Error())
+				suite.AssertEventEmitted(suite.Ctx, types.TypeEvtAmplificationRamped, 0)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtAmplificationRamped, 1)
+			suite.AssertEventEmitted(suite.Ctx, sdk.EventTypeMessage, 1)
+
+			assertAmplification := func(expected uint64) *stableswap.Pool {
+				pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, tc.poolId)
+				suite.Require().NoError(err)
+				stableswapPool := pool.(*stableswap.Pool)
+				suite.Require().Equal(expected, stableswapPool.PoolParams.Amplification)
+				return stableswapPool
+			}
+
+			// The amplification is halfway to its target halfway through the ramp,
+			// and swaps price against the interpolated amplification.
+			startTime := suite.Ctx.BlockTime()
+			suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(duration / 2))
+			pool := assertAmplification((100 + tc.targetAmplification) / 2)
+			_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[1], pool, sdk.NewInt64Coin("foo", 1000), "bar", sdk.OneInt(), pool.GetSwapFee(suite.Ctx))
+			suite.Require().NoError(err)
+
+			// The amplification reaches its target once the ramp is over.
+			suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(duration + time.Second))
+			pool = assertAmplification(tc.targetAmplification)
+			suite.Require().Nil(pool.AmplificationRamp)
+		})
+	}
+}
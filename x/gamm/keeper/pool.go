@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
 
@@ -36,7 +38,7 @@ func (k Keeper) GetPools(ctx sdk.Context) ([]poolmanagertypes.PoolI, error) {
 			return nil, err
 		}
 
-		if pokePool, ok := pool.(types.WeightedPoolExtension); ok {
+		if pokePool, ok := pool.(types.PokablePoolExtension); ok {
 			pokePool.PokePool(ctx.BlockTime())
 		}
 
@@ -45,7 +47,8 @@ func (k Keeper) GetPools(ctx sdk.Context) ([]poolmanagertypes.PoolI, error) {
 }
 
 // GetPoolAndPoke returns a PoolI based on it's identifier if one exists. If poolId corresponds
-// to a pool with weights (e.g. balancer), the weights of the pool are updated via PokePool prior to returning.
+// to a pool with time dependent parameters (e.g. balancer weights or stableswap amplification),
+// they are updated via PokePool prior to returning.
 // TODO: Consider rename to GetPool due to downstream API confusion.
 func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.CFMMPoolI, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -61,7 +64,7 @@ func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.CFMMPoolI,
 		return nil, err
 	}
 
-	if pokePool, ok := pool.(types.WeightedPoolExtension); ok {
+	if pokePool, ok := pool.(types.PokablePoolExtension); ok {
 		pokePool.PokePool(ctx.BlockTime())
 	}
 
@@ -81,6 +84,14 @@ func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.CFMMPoolI,
 	return pool, nil
 }
 
+// validatePoolNotPaused returns error if joins are paused on the given pool by the poolmanager.
+func (k Keeper) validatePoolNotPaused(ctx sdk.Context, poolId uint64) error {
+	if k.poolManager.IsPoolPaused(ctx, poolId) {
+		return poolmanagertypes.PoolPausedError{PoolId: poolId}
+	}
+	return nil
+}
+
 func (k Keeper) iterator(ctx sdk.Context, prefix []byte) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
 	return sdk.KVStorePrefixIterator(store, prefix)
@@ -98,7 +109,7 @@ func (k Keeper) GetPoolsAndPoke(ctx sdk.Context) (res []types.CFMMPoolI, err err
 			return nil, err
 		}
 
-		if pokePool, ok := pool.(types.WeightedPoolExtension); ok {
+		if pokePool, ok := pool.(types.PokablePoolExtension); ok {
 			pokePool.PokePool(ctx.BlockTime())
 		}
 		res = append(res, pool)
@@ -305,6 +316,80 @@ func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scal
 	return k.setPool(ctx, stableswapPool)
 }
 
+// updateBalancerPoolWeights schedules a smooth weight change on the given balancer pool
+// and returns the installed weight change params.
+// errors if the pool does not exist, is not a balancer pool, the sender is not the pool's governor,
+// or the params are invalid for the pool.
+func (k Keeper) updateBalancerPoolWeights(ctx sdk.Context, poolId uint64, params balancer.SmoothWeightChangeParams, sender string) (balancer.SmoothWeightChangeParams, error) {
+	balancerPool, err := k.getBalancerPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return balancer.SmoothWeightChangeParams{}, err
+	}
+	if err := balancerPool.ValidatePoolGovernor(sender); err != nil {
+		return balancer.SmoothWeightChangeParams{}, err
+	}
+
+	return k.scheduleBalancerPoolWeightChange(ctx, balancerPool, params)
+}
+
+// scheduleBalancerPoolWeightChange schedules a smooth weight change on the given balancer pool without
+// authorizing it, and returns the installed weight change params.
+// errors if the params are invalid for the pool.
+func (k Keeper) scheduleBalancerPoolWeightChange(ctx sdk.Context, balancerPool *balancer.Pool, params balancer.SmoothWeightChangeParams) (balancer.SmoothWeightChangeParams, error) {
+	if err := balancerPool.ScheduleWeightChange(params, ctx.BlockTime()); err != nil {
+		return balancer.SmoothWeightChangeParams{}, err
+	}
+
+	if err := k.setPool(ctx, balancerPool); err != nil {
+		return balancer.SmoothWeightChangeParams{}, err
+	}
+	return *balancerPool.PoolParams.SmoothWeightChangeParams, nil
+}
+
+// getBalancerPoolAndPoke returns the balancer pool with the given id, poked at the current block time.
+// errors if the pool does not exist or is not a balancer pool.
+func (k Keeper) getBalancerPoolAndPoke(ctx sdk.Context, poolId uint64) (*balancer.Pool, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return nil, fmt.Errorf("pool id %d is not of type balancer pool", poolId)
+	}
+	return balancerPool, nil
+}
+
+// emitPoolWeightsUpdatedEvent emits an event for the smooth weight change installed on the given pool.
+func emitPoolWeightsUpdatedEvent(ctx sdk.Context, poolId uint64, params balancer.SmoothWeightChangeParams) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPoolWeightsUpdated,
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyStartTime, params.StartTime.String()),
+		sdk.NewAttribute(types.AttributeKeyDuration, params.Duration.String()),
+		sdk.NewAttribute(types.AttributeKeyTargetWeights, balancer.PoolAssetsWeightsString(params.TargetPoolWeights)),
+	))
+}
+
+// rampStableswapAmplification starts a ramp of the given stableswap pool's amplification
+// to targetAmplification over duration, and returns the installed ramp.
+// errors if the pool does not exist, is not a stableswap pool, the sender is not the pool's governor,
+// or the ramp is invalid.
+func (k Keeper) rampStableswapAmplification(ctx sdk.Context, poolId uint64, targetAmplification uint64, duration time.Duration, sender string) (stableswap.AmplificationRamp, error) {
+	stableswapPool, err := k.getStableswapPool(ctx, poolId)
+	if err != nil {
+		return stableswap.AmplificationRamp{}, err
+	}
+	if err := stableswapPool.RampAmplification(targetAmplification, duration, sender, ctx.BlockTime()); err != nil {
+		return stableswap.AmplificationRamp{}, err
+	}
+
+	if err := k.setPool(ctx, stableswapPool); err != nil {
+		return stableswap.AmplificationRamp{}, err
+	}
+	return *stableswapPool.AmplificationRamp, nil
+}
+
 // convertToCFMMPool converts PoolI to CFMMPoolI by casting the input.
 // Returns the pool of the CFMMPoolI or error if the given pool does not implement
 // CFMMPoolI.
@@ -123,6 +123,10 @@ func (k Keeper) JoinPoolNoSwap(
 			err = fmt.Errorf("function JoinPoolNoSwap failed due to internal reason: %v", r)
 		}
 	}()
+	if err := k.validatePoolNotPaused(ctx, poolId); err != nil {
+		return nil, sdk.ZeroInt(), err
+	}
+
 	// all pools handled within this method are pointer references, `JoinPool` directly updates the pools
 	pool, err := k.GetPoolAndPoke(ctx, poolId)
 	if err != nil {
@@ -216,6 +220,10 @@ func (k Keeper) JoinSwapExactAmountIn(
 		}
 	}()
 
+	if err := k.validatePoolNotPaused(ctx, poolId); err != nil {
+		return sdk.Int{}, err
+	}
+
 	pool, err := k.getPoolForSwap(ctx, poolId)
 	if err != nil {
 		return sdk.Int{}, err
@@ -260,6 +268,10 @@ func (k Keeper) JoinSwapShareAmountOut(
 		}
 	}()
 
+	if err := k.validatePoolNotPaused(ctx, poolId); err != nil {
+		return sdk.Int{}, err
+	}
+
 	pool, err := k.getPoolForSwap(ctx, poolId)
 	if err != nil {
 		return sdk.Int{}, err
@@ -327,9 +339,12 @@ func (k Keeper) ExitPool(
 	return exitCoins, nil
 }
 
-// ExitSwapShareAmountIn is an Exit Pool transaction, that will exit all of the provided LP shares,
-// and then swap it all against the pool into tokenOutDenom.
+// ExitSwapShareAmountIn is an Exit Pool transaction, that will exit all of the provided LP shares
+// into tokenOutDenom. Pools that support single asset exits exit directly into tokenOutDenom.
+// For other pools, the shares are exited into all of the pool's assets, which are then swapped
+// against the pool into tokenOutDenom.
 // If the amount of tokens gotten out after the swap is less than tokenOutMinAmount, return an error.
+// Returns error if the pool is paused, as single asset exits swap against the pool.
 func (k Keeper) ExitSwapShareAmountIn(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
@@ -338,37 +353,117 @@ func (k Keeper) ExitSwapShareAmountIn(
 	shareInAmount sdk.Int,
 	tokenOutMinAmount sdk.Int,
 ) (tokenOutAmount sdk.Int, err error) {
-	exitCoins, err := k.ExitPool(ctx, sender, poolId, shareInAmount, sdk.Coins{})
+	// defer to catch panics, in case something internal overflows.
+	defer func() {
+		if r := recover(); r != nil {
+			tokenOutAmount = sdk.Int{}
+			err = fmt.Errorf("function ExitSwapShareAmountIn failed due to internal reason: %v", r)
+		}
+	}()
+
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.validatePoolNotPaused(ctx, poolId); err != nil {
+		return sdk.Int{}, err
+	}
+
+	extendedPool, ok := pool.(types.SingleAssetExitPoolExtension)
+	if !ok {
+		tokenOutAmount, err = k.exitPoolAndSwapToSingleAsset(ctx, sender, poolId, tokenOutDenom, shareInAmount)
+	} else {
+		tokenOutAmount, err = k.exitPoolToSingleAsset(ctx, sender, extendedPool, tokenOutDenom, shareInAmount)
+	}
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if tokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMinAmount,
+			"Provided LP shares yield %s tokens out, wanted a minimum of %s for it to work",
+			tokenOutAmount, tokenOutMinAmount)
+	}
+	return tokenOutAmount, nil
+}
+
+// exitPoolToSingleAsset exits shareInAmount LP shares of a pool that supports single asset exits
+// directly into tokenOutDenom. The taker fee is deducted from the exited tokens, on the part of them
+// that the pool implicitly swaps, see calcSingleAssetExitTakerFee.
+func (k Keeper) exitPoolToSingleAsset(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool types.SingleAssetExitPoolExtension,
+	tokenOutDenom string,
+	shareInAmount sdk.Int,
+) (tokenOutAmount sdk.Int, err error) {
+	if shareInAmount.GTE(pool.GetTotalShares()) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "Trying to exit >= the number of shares contained in the pool.")
+	} else if shareInAmount.LTE(sdk.ZeroInt()) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "Trying to exit a negative amount of shares")
+	}
+
+	// The proportional exit is calculated before exiting, as exiting mutates the pool.
+	proportionalExitCoins, err := pool.CalcExitPoolCoinsFromShares(ctx, shareInAmount, pool.GetExitFee(ctx))
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenOutAmount, err = pool.ExitSwapShareAmountIn(ctx, tokenOutDenom, shareInAmount)
 	if err != nil {
 		return sdk.Int{}, err
 	}
+	tokenOut := sdk.NewCoin(tokenOutDenom, tokenOutAmount)
 
-	pool, err := k.GetPool(ctx, poolId)
+	takerFee, err := k.calcSingleAssetExitTakerFee(ctx, proportionalExitCoins, tokenOut)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if err := k.applyExitPoolStateChange(ctx, pool, sender, shareInAmount, sdk.NewCoins(tokenOut)); err != nil {
+		return sdk.Int{}, err
+	}
+
+	if err := k.poolManager.SendTakerFee(ctx, sender, takerFee, tokenOutDenom); err != nil {
+		return sdk.Int{}, err
+	}
+	return tokenOut.Amount.Sub(takerFee.Amount), nil
+}
+
+// exitPoolAndSwapToSingleAsset exits shareInAmount LP shares into all of the pool's assets,
+// and then swaps every exited asset other than tokenOutDenom against the pool into tokenOutDenom.
+// The swaps are routed through the poolmanager so that the taker fee is charged on them.
+func (k Keeper) exitPoolAndSwapToSingleAsset(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenOutDenom string,
+	shareInAmount sdk.Int,
+) (tokenOutAmount sdk.Int, err error) {
+	exitCoins, err := k.ExitPool(ctx, sender, poolId, shareInAmount, sdk.Coins{})
 	if err != nil {
 		return sdk.Int{}, err
 	}
-	swapFee := pool.GetSwapFee(ctx)
 
 	tokenOutAmount = exitCoins.AmountOf(tokenOutDenom)
 	for _, coin := range exitCoins {
 		if coin.Denom == tokenOutDenom {
 			continue
 		}
-		swapOut, err := k.SwapExactAmountIn(ctx, sender, pool, coin, tokenOutDenom, sdk.ZeroInt(), swapFee)
+		swapOut, err := k.poolManager.RouteExactAmountIn(ctx, sender, []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}}, coin, sdk.ZeroInt())
 		if err != nil {
 			return sdk.Int{}, err
 		}
 		tokenOutAmount = tokenOutAmount.Add(swapOut)
 	}
-
-	if tokenOutAmount.LT(tokenOutMinAmount) {
-		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMinAmount,
-			"Provided LP shares yield %s tokens out, wanted a minimum of %s for it to work",
-			tokenOutAmount, tokenOutMinAmount)
-	}
 	return tokenOutAmount, nil
 }
 
+// ExitSwapExactAmountOut is an Exit Pool transaction, that will exit the least number of LP shares,
+// up to shareInMaxAmount, that yield exactly tokenOut with a single asset exit priced by the pool.
+// The taker fee is charged on top of tokenOut, on the part of it that the pool implicitly swaps,
+// see calcSingleAssetExitTakerFee. The LP shares exited also cover the taker fee.
+// Returns error if the pool is paused.
 func (k Keeper) ExitSwapExactAmountOut(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
@@ -376,24 +471,95 @@ func (k Keeper) ExitSwapExactAmountOut(
 	tokenOut sdk.Coin,
 	shareInMaxAmount sdk.Int,
 ) (shareInAmount sdk.Int, err error) {
+	// defer to catch panics, in case something internal overflows.
+	defer func() {
+		if r := recover(); r != nil {
+			shareInAmount = sdk.Int{}
+			err = fmt.Errorf("function ExitSwapExactAmountOut failed due to internal reason: %v", r)
+		}
+	}()
+
 	pool, err := k.getPoolForSwap(ctx, poolId)
 	if err != nil {
 		return sdk.Int{}, err
 	}
+	if err := k.validatePoolNotPaused(ctx, poolId); err != nil {
+		return sdk.Int{}, err
+	}
 
-	extendedPool, ok := pool.(types.PoolAmountOutExtension)
-	if !ok {
-		return sdk.Int{}, fmt.Errorf("pool with id %d does not support this kind of exit", poolId)
+	// Price the exit of tokenOut on a separate copy of the pool, as exiting mutates it,
+	// to get the part of it that the pool implicitly swaps.
+	simulationPool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	simulatedShareInAmount, err := exitSwapExactAmountOut(ctx, simulationPool, tokenOut, shareInMaxAmount)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	proportionalExitCoins, err := pool.CalcExitPoolCoinsFromShares(ctx, simulatedShareInAmount, pool.GetExitFee(ctx))
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	takerFee, err := k.calcSingleAssetExitTakerFee(ctx, proportionalExitCoins, tokenOut)
+	if err != nil {
+		return sdk.Int{}, err
 	}
 
-	shareInAmount, err = extendedPool.ExitSwapExactAmountOut(ctx, tokenOut, shareInMaxAmount)
+	tokenOutWithTakerFee := tokenOut.Add(takerFee)
+	shareInAmount, err = exitSwapExactAmountOut(ctx, pool, tokenOutWithTakerFee, shareInMaxAmount)
 	if err != nil {
 		return sdk.Int{}, err
 	}
 
-	if err := k.applyExitPoolStateChange(ctx, pool, sender, shareInAmount, sdk.Coins{tokenOut}); err != nil {
+	if err := k.applyExitPoolStateChange(ctx, pool, sender, shareInAmount, sdk.Coins{tokenOutWithTakerFee}); err != nil {
+		return sdk.Int{}, err
+	}
+
+	if err := k.poolManager.SendTakerFee(ctx, sender, takerFee, tokenOut.Denom); err != nil {
 		return sdk.Int{}, err
 	}
 
 	return shareInAmount, nil
 }
+
+// exitSwapExactAmountOut exits tokenOut from a pool that prices single asset exits with an exact amount out,
+// returning the LP shares to exit. This mutates the pool, but not state.
+func exitSwapExactAmountOut(ctx sdk.Context, pool types.CFMMPoolI, tokenOut sdk.Coin, shareInMaxAmount sdk.Int) (sdk.Int, error) {
+	switch extendedPool := pool.(type) {
+	case types.SingleAssetExitPoolExtension:
+		return extendedPool.ExitSwapExactAmountOut(ctx, tokenOut, shareInMaxAmount)
+	case types.PoolAmountOutExtension:
+		return extendedPool.ExitSwapExactAmountOut(ctx, tokenOut, shareInMaxAmount)
+	default:
+		return sdk.Int{}, fmt.Errorf("pool with id %d does not support this kind of exit", pool.GetId())
+	}
+}
+
+// calcSingleAssetExitTakerFee returns the taker fee of a single asset exit into tokenOut that is priced
+// by the pool, given the coins that a proportional exit of the same LP shares yields.
+// The part of tokenOut beyond the proportional exit's tokenOut is implicitly swapped from the other
+// exited coins, so the taker fee is charged on it, in tokenOut's denom. Since that part is swapped
+// from several denoms, the highest taker fee between them and tokenOut's denom is used. It is rounded up.
+func (k Keeper) calcSingleAssetExitTakerFee(ctx sdk.Context, proportionalExitCoins sdk.Coins, tokenOut sdk.Coin) (sdk.Coin, error) {
+	swappedAmount := tokenOut.Amount.Sub(proportionalExitCoins.AmountOf(tokenOut.Denom))
+	if !swappedAmount.IsPositive() {
+		return sdk.NewCoin(tokenOut.Denom, sdk.ZeroInt()), nil
+	}
+
+	maxTakerFee := sdk.ZeroDec()
+	for _, coin := range proportionalExitCoins {
+		if coin.Denom == tokenOut.Denom {
+			continue
+		}
+		takerFee, err := k.poolManager.GetTakerFee(ctx, tokenOut.Denom, coin.Denom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		if takerFee.GT(maxTakerFee) {
+			maxTakerFee = takerFee
+		}
+	}
+
+	return sdk.NewCoin(tokenOut.Denom, swappedAmount.ToDec().Mul(maxTakerFee).Ceil().TruncateInt()), nil
+}
@@ -939,3 +939,103 @@ func (suite *KeeperTestSuite) TestGetPoolDenom() {
 		})
 	}
 }
+
+// TestStableswapSingleAssetExit tests that stableswap pools exit directly into a single asset,
+// without swapping any of the exited assets through the pool, and charge the taker fee in the token out.
+func (suite *KeeperTestSuite) TestStableswapSingleAssetExit() {
+	sharesIn := types.InitPoolSharesSupply.QuoRaw(10)
+	tokenOut := sdk.NewCoin("foo", sdk.NewInt(1_000_000))
+
+	tests := map[string]struct {
+		exactAmountOut    bool
+		tokenOutMinAmount sdk.Int
+		shareInMaxAmount  sdk.Int
+		takerFee          sdk.Dec
+		expectedErr       error
+	}{
+		"exit swap share amount in": {
+			tokenOutMinAmount: sdk.ZeroInt(),
+		},
+		"exit swap exact amount out": {
+			exactAmountOut:   true,
+			shareInMaxAmount: sharesIn,
+		},
+		"exit swap share amount in with a taker fee": {
+			tokenOutMinAmount: sdk.ZeroInt(),
+			takerFee:          sdk.MustNewDecFromStr("0.01"),
+		},
+		"exit swap exact amount out with a taker fee": {
+			exactAmountOut:   true,
+			shareInMaxAmount: sharesIn,
+			takerFee:         sdk.MustNewDecFromStr("0.01"),
+		},
+		"error: exit swap share amount in yields less than token out min amount": {
+			tokenOutMinAmount: sdk.NewInt(10_000_000),
+			expectedErr:       types.ErrLimitMinAmount,
+		},
+		"error: exit swap exact amount out needs more than share in max amount": {
+			exactAmountOut:   true,
+			shareInMaxAmount: sharesIn.QuoRaw(100),
+			expectedErr:      types.ErrLimitMaxAmount,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolId := suite.PrepareBasicStableswapPool()
+			gammKeeper := suite.App.GAMMKeeper
+			sender := suite.TestAccs[0]
+			shareDenom := types.GetPoolShareDenom(poolId)
+			balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+			if !tc.takerFee.IsNil() {
+				suite.App.PoolManagerKeeper.SetTakerFeeParams(suite.Ctx, poolmanagertypes.TakerFeeParams{DefaultTakerFee: tc.takerFee})
+			}
+
+			var (
+				tokenOutAmount, shareInAmount sdk.Int
+				err                           error
+			)
+			if tc.exactAmountOut {
+				tokenOutAmount = tokenOut.Amount
+				shareInAmount, err = gammKeeper.ExitSwapExactAmountOut(suite.Ctx, sender, poolId, tokenOut, tc.shareInMaxAmount)
+			} else {
+				shareInAmount = sharesIn
+				tokenOutAmount, err = gammKeeper.ExitSwapShareAmountIn(suite.Ctx, sender, poolId, tokenOut.Denom, sharesIn, tc.tokenOutMinAmount)
+			}
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// Exiting 10% of the shares of an even pool yields more than 10% of the token out's liquidity.
+			suite.Require().True(tokenOutAmount.IsPositive())
+			suite.Require().True(shareInAmount.IsPositive())
+			if tc.exactAmountOut {
+				suite.Require().True(shareInAmount.LT(sharesIn))
+			} else {
+				suite.Require().True(tokenOutAmount.GT(tokenOut.Amount))
+			}
+
+			// Only the token out is sent to the sender, and no swaps go through the pool.
+			balancesAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			suite.Require().Equal(balancesBefore.AmountOf("foo").Add(tokenOutAmount), balancesAfter.AmountOf("foo"))
+			suite.Require().Equal(balancesBefore.AmountOf("bar"), balancesAfter.AmountOf("bar"))
+			suite.Require().Equal(balancesBefore.AmountOf("baz"), balancesAfter.AmountOf("baz"))
+			suite.Require().Equal(balancesBefore.AmountOf(shareDenom).Sub(shareInAmount), balancesAfter.AmountOf(shareDenom))
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtPoolExited, 1)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtTokenSwapped, 0)
+
+			// The taker fee is charged in the token out, on top of the tokens the sender receives.
+			takerFeeAmount := suite.App.PoolManagerKeeper.GetTakerFeeTotals(suite.Ctx).AmountOf("foo")
+			suite.Require().Equal(!tc.takerFee.IsNil(), takerFeeAmount.IsPositive())
+
+			pool, err := gammKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.InitPoolSharesSupply.Sub(shareInAmount), pool.GetTotalShares())
+			suite.Require().Equal(sdk.NewInt(10_000_000).Sub(tokenOutAmount).Sub(takerFeeAmount), pool.GetTotalPoolLiquidity(suite.Ctx).AmountOf("foo"))
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+type epochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = epochHooks{}
+
+// EpochHooks returns the epoch hooks that update stableswap scaling factors from their rate providers.
+func (k Keeper) EpochHooks() epochstypes.EpochHooks {
+	return epochHooks{k}
+}
+
+// BeforeEpochStart is the epoch start hook.
+func (h epochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// AfterEpochEnd is the epoch end hook.
+func (h epochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	h.k.updateScalingFactorsAtEpochEnd(ctx, epochIdentifier, epochNumber, types.MaxRateProviderUpdatesPerEpoch)
+	return nil
+}
+
+// SetScalingFactorRateProvider sets the rate provider that drives the scaling factors of a stableswap pool,
+// replacing any existing one.
+// errors if the rate provider is invalid, the pool is not a stableswap pool containing both of the
+// rate provider's denoms, or the sender is not the pool's scaling factor controller.
+func (k Keeper) SetScalingFactorRateProvider(ctx sdk.Context, rateProvider types.ScalingFactorRateProvider, sender string) error {
+	if err := rateProvider.Validate(); err != nil {
+		return err
+	}
+
+	pool, err := k.getStableswapPool(ctx, rateProvider.PoolId)
+	if err != nil {
+		return err
+	}
+
+	if sender != pool.ScalingFactorController {
+		return types.ErrNotScalingFactorGovernor
+	}
+
+	for _, denom := range []string{rateProvider.RateDenom, rateProvider.ReferenceDenom} {
+		if _, err := getStableswapDenomIndex(pool, denom); err != nil {
+			return err
+		}
+	}
+
+	k.setScalingFactorRateProvider(ctx, rateProvider)
+	return nil
+}
+
+// RemoveScalingFactorRateProvider removes the rate provider of a stableswap pool.
+// errors if the pool has no rate provider or the sender is not the pool's scaling factor controller.
+func (k Keeper) RemoveScalingFactorRateProvider(ctx sdk.Context, poolId uint64, sender string) error {
+	if _, err := k.GetScalingFactorRateProvider(ctx, poolId); err != nil {
+		return err
+	}
+
+	pool, err := k.getStableswapPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	if sender != pool.ScalingFactorController {
+		return types.ErrNotScalingFactorGovernor
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetKeyScalingFactorRateProvider(poolId))
+	return nil
+}
+
+// GetScalingFactorRateProvider returns the rate provider of the given stableswap pool.
+// errors if the pool has no rate provider.
+func (k Keeper) GetScalingFactorRateProvider(ctx sdk.Context, poolId uint64) (types.ScalingFactorRateProvider, error) {
+	store := ctx.KVStore(k.storeKey)
+	rateProvider := types.ScalingFactorRateProvider{}
+	found, err := osmoutils.Get(store, types.GetKeyScalingFactorRateProvider(poolId), &rateProvider)
+	if err != nil {
+		return types.ScalingFactorRateProvider{}, err
+	}
+	if !found {
+		return types.ScalingFactorRateProvider{}, types.ScalingFactorRateProviderNotFoundError{PoolId: poolId}
+	}
+	return rateProvider, nil
+}
+
+// GetAllScalingFactorRateProviders returns all scaling factor rate providers, ordered by pool id.
+func (k Keeper) GetAllScalingFactorRateProviders(ctx sdk.Context) ([]types.ScalingFactorRateProvider, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixScalingFactorRateProviders, parseScalingFactorRateProvider)
+}
+
+func (k Keeper) setScalingFactorRateProvider(ctx sdk.Context, rateProvider types.ScalingFactorRateProvider) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.GetKeyScalingFactorRateProvider(rateProvider.PoolId), &rateProvider)
+}
+
+func parseScalingFactorRateProvider(bz []byte) (types.ScalingFactorRateProvider, error) {
+	rateProvider := types.ScalingFactorRateProvider{}
+	err := rateProvider.Unmarshal(bz)
+	return rateProvider, err
+}
+
+// updateScalingFactorsAtEpochEnd updates the scaling factors of the pools whose rate provider
+// runs at the end of the given epoch. A failure to update one pool is logged and does not
+// affect the other pools.
+// At most maxUpdates rate providers are processed. If more are due, a different window of them
+// is processed every epoch, so that no pool is skipped forever.
+func (k Keeper) updateScalingFactorsAtEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64, maxUpdates int) {
+	rateProviders, err := k.GetAllScalingFactorRateProviders(ctx)
+	if err != nil {
+		ctx.Logger().Error("failed to read scaling factor rate providers", "error", err)
+		return
+	}
+
+	dueRateProviders := []types.ScalingFactorRateProvider{}
+	for _, rateProvider := range rateProviders {
+		if rateProvider.EpochIdentifier == epochIdentifier {
+			dueRateProviders = append(dueRateProviders, rateProvider)
+		}
+	}
+
+	if len(dueRateProviders) > maxUpdates {
+		ctx.Logger().Info("too many scaling factor rate providers to process in one epoch",
+			"due", len(dueRateProviders), "processed", maxUpdates)
+		start := int((epochNumber * int64(maxUpdates)) % int64(len(dueRateProviders)))
+		selectedRateProviders := make([]types.ScalingFactorRateProvider, 0, maxUpdates)
+		for i := 0; i < maxUpdates; i++ {
+			selectedRateProviders = append(selectedRateProviders, dueRateProviders[(start+i)%len(dueRateProviders)])
+		}
+		dueRateProviders = selectedRateProviders
+	}
+
+	for _, rateProvider := range dueRateProviders {
+		rateProvider := rateProvider
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			if err := k.updateScalingFactorsFromRate(cacheCtx, rateProvider); err != nil {
+				return fmt.Errorf("failed to update scaling factors of pool %d from its rate provider: %w", rateProvider.PoolId, err)
+			}
+			return nil
+		})
+	}
+}
+
+// updateScalingFactorsFromRate moves the scaling factor of the rate provider's rate denom towards
+// the reference denom's scaling factor divided by the current rate.
+// The move is spread over the rate provider's ramp epochs and capped at its max change per epoch,
+// so that the pool's curve does not jump. Once the remaining move would round to no change,
+// the scaling factor moves the rest of the way to its target, still subject to the cap.
+func (k Keeper) updateScalingFactorsFromRate(ctx sdk.Context, rateProvider types.ScalingFactorRateProvider) error {
+	pool, err := k.getStableswapPool(ctx, rateProvider.PoolId)
+	if err != nil {
+		return err
+	}
+
+	rate, err := k.getScalingFactorRate(ctx, rateProvider)
+	if err != nil {
+		return err
+	}
+
+	rateIndex, err := getStableswapDenomIndex(pool, rateProvider.RateDenom)
+	if err != nil {
+		return err
+	}
+	referenceIndex, err := getStableswapDenomIndex(pool, rateProvider.ReferenceDenom)
+	if err != nil {
+		return err
+	}
+
+	scalingFactors := make([]uint64, len(pool.ScalingFactors))
+	for i, scalingFactor := range pool.ScalingFactors {
+		scalingFactors[i] = scalingFactor / types.ScalingFactorMultiplier
+	}
+
+	currentScalingFactor := sdk.NewIntFromUint64(scalingFactors[rateIndex])
+	current := currentScalingFactor.ToDec()
+	target := sdk.NewIntFromUint64(scalingFactors[referenceIndex]).ToDec().Quo(rate)
+	maxChange := current.Mul(rateProvider.MaxChangePerEpoch)
+
+	capChange := func(change sdk.Dec) sdk.Dec {
+		if change.Abs().GT(maxChange) {
+			if change.IsNegative() {
+				return maxChange.Neg()
+			}
+			return maxChange
+		}
+		return change
+	}
+
+	remainingChange := target.Sub(current)
+	newScalingFactor := current.Add(capChange(remainingChange.QuoInt(sdk.NewIntFromUint64(rateProvider.RampEpochs)))).RoundInt()
+	if newScalingFactor.Equal(currentScalingFactor) {
+		newScalingFactor = current.Add(capChange(remainingChange)).RoundInt()
+	}
+	if newScalingFactor.Equal(currentScalingFactor) {
+		return nil
+	}
+	if !newScalingFactor.IsPositive() || !newScalingFactor.IsUint64() {
+		return types.ErrInvalidScalingFactors
+	}
+
+	scalingFactors[rateIndex] = newScalingFactor.Uint64()
+	if err := pool.ApplyScalingFactors(scalingFactors); err != nil {
+		return err
+	}
+	if err := k.setPool(ctx, pool); err != nil {
+		return err
+	}
+
+	scalingFactorStrs := make([]string, len(pool.ScalingFactors))
+	for i, scalingFactor := range pool.ScalingFactors {
+		scalingFactorStrs[i] = strconv.FormatUint(scalingFactor, 10)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtScalingFactorsUpdated,
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyRate, rate.String()),
+		sdk.NewAttribute(types.AttributeKeyScalingFactors, strings.Join(scalingFactorStrs, ",")),
+	))
+	return nil
+}
+
+// getScalingFactorRate returns the current rate of the rate provider's rate denom,
+// in units of its reference denom, read from the rate provider's source.
+func (k Keeper) getScalingFactorRate(ctx sdk.Context, rateProvider types.ScalingFactorRateProvider) (sdk.Dec, error) {
+	var rate sdk.Dec
+	if rateProvider.TwapSource != nil {
+		twapSource := rateProvider.TwapSource
+		startTime := ctx.BlockTime().Add(-twapSource.Window)
+		twap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, twapSource.PoolId, rateProvider.RateDenom, rateProvider.ReferenceDenom, startTime)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		rate = twap
+	} else {
+		contractAddress, err := sdk.AccAddressFromBech32(rateProvider.ContractAddress)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+
+		queryBz, err := json.Marshal(types.RedemptionRateQueryMsg{
+			RedemptionRate: types.RedemptionRateQuery{Denom: rateProvider.RateDenom},
+		})
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+
+		responseBz, err := k.queryRateProviderContract(ctx, rateProvider.PoolId, contractAddress, queryBz)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+
+		response := types.RedemptionRateResponse{}
+		if err := json.Unmarshal(responseBz, &response); err != nil {
+			return sdk.Dec{}, err
+		}
+		rate = response.RedemptionRate
+	}
+
+	if rate.IsNil() || !rate.IsPositive() {
+		return sdk.Dec{}, types.NonPositiveRateError{PoolId: rateProvider.PoolId, Rate: rate}
+	}
+	return rate, nil
+}
+
+// queryRateProviderContract queries the rate provider contract of the given pool with a gas meter
+// bounded by RateProviderQueryGasLimit, so that a misbehaving contract cannot halt the epoch hook.
+// errors if the query runs out of gas.
+func (k Keeper) queryRateProviderContract(ctx sdk.Context, poolId uint64, contractAddress sdk.AccAddress, queryBz []byte) (responseBz []byte, err error) {
+	defer func() {
+		if recoveryError := recover(); recoveryError != nil {
+			if isOutOfGas, _ := osmoutils.IsOutOfGasError(recoveryError); !isOutOfGas {
+				panic(recoveryError)
+			}
+			responseBz, err = nil, types.RateProviderOutOfGasError{PoolId: poolId, GasLimit: types.RateProviderQueryGasLimit}
+		}
+	}()
+
+	return k.wasmKeeper.QuerySmart(ctx.WithGasMeter(sdk.NewGasMeter(types.RateProviderQueryGasLimit)), contractAddress, queryBz)
+}
+
+// getStableswapPool returns the stableswap pool with the given id.
+// errors if the pool does not exist or is not a stableswap pool.
+func (k Keeper) getStableswapPool(ctx sdk.Context, poolId uint64) (*stableswap.Pool, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return nil, fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+	}
+	return stableswapPool, nil
+}
+
+// getStableswapDenomIndex returns the index of the given denom in the pool's liquidity,
+// which is also the index of its scaling factor.
+func getStableswapDenomIndex(pool *stableswap.Pool, denom string) (int, error) {
+	for i, coin := range pool.PoolLiquidity {
+		if coin.Denom == denom {
+			return i, nil
+		}
+	}
+	return -1, types.DenomNotInPoolError{PoolId: pool.Id, Denom: denom}
+}
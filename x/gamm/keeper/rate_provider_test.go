@@ -0,0 +1,288 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+const rateProviderEpoch = "day"
+
+// setupRateProviderPools creates a balancer pool with id 1 that prices 1 foo at 2 bar,
+// and a foo/bar stableswap pool with id 2 whose scaling factor controller is TestAccs[0].
+func (suite *KeeperTestSuite) setupRateProviderPools() {
+	suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 2_000_000))
+
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+	suite.FundAcc(suite.TestAccs[0], liquidity)
+	msg := stableswap.NewMsgCreateStableswapPool(suite.TestAccs[0], stableswap.PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec()}, liquidity, []uint64{1000, 1000}, "")
+	msg.ScalingFactorController = suite.TestAccs[0].String()
+	_, err := suite.App.PoolManagerKeeper.CreatePool(suite.Ctx, msg)
+	suite.Require().NoError(err)
+}
+
+func twapRateProvider() types.ScalingFactorRateProvider {
+	return types.ScalingFactorRateProvider{
+		PoolId:            2,
+		RateDenom:         "foo",
+		ReferenceDenom:    "bar",
+		EpochIdentifier:   rateProviderEpoch,
+		TwapSource:        &types.TwapRateSource{PoolId: 1, Window: time.Hour},
+		MaxChangePerEpoch: sdk.NewDecWithPrec(1, 1),
+		RampEpochs:        2,
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetScalingFactorRateProvider() {
+	tests := map[string]struct {
+		rateProvider func() types.ScalingFactorRateProvider
+		sender       func() string
+		expectErr    error
+	}{
+		"valid rate provider": {
+			rateProvider: twapRateProvider,
+		},
+		"error: sender is not the scaling factor controller": {
+			rateProvider: twapRateProvider,
+			sender:       func() string { return suite.TestAccs[1].String() },
+			expectErr:    types.ErrNotScalingFactorGovernor,
+		},
+		"error: denom not in pool": {
+			rateProvider: func() types.ScalingFactorRateProvider {
+				rateProvider := twapRateProvider()
+				rateProvider.RateDenom = "baz"
+				return rateProvider
+			},
+			expectErr: types.DenomNotInPoolError{PoolId: 2, Denom: "baz"},
+		},
+		"error: no rate source": {
+			rateProvider: func() types.ScalingFactorRateProvider {
+				rateProvider := twapRateProvider()
+				rateProvider.TwapSource = nil
+				return rateProvider
+			},
+			expectErr: types.ErrInvalidRateSource,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.setupRateProviderPools()
+			sender := suite.TestAccs[0].String()
+			if tc.sender != nil {
+				sender = tc.sender()
+			}
+
+			err := suite.App.GAMMKeeper.SetScalingFactorRateProvider(suite.Ctx, tc.rateProvider(), sender)
+			if tc.expectErr != nil {
+				suite.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			rateProvider, err := suite.App.GAMMKeeper.GetScalingFactorRateProvider(suite.Ctx, 2)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.rateProvider(), rateProvider)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetScalingFactorRateProvider_NotStableswapPool() {
+	suite.SetupTest()
+	suite.setupRateProviderPools()
+
+	rateProvider := twapRateProvider()
+	rateProvider.PoolId = 1
+	err := suite.App.GAMMKeeper.SetScalingFactorRateProvider(suite.Ctx, rateProvider, suite.TestAccs[0].String())
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestRemoveScalingFactorRateProvider() {
+	suite.SetupTest()
+	suite.setupRateProviderPools()
+	gammKeeper := suite.App.GAMMKeeper
+
+	err := gammKeeper.RemoveScalingFactorRateProvider(suite.Ctx, 2, suite.TestAccs[0].String())
+	suite.Require().ErrorIs(err, types.ScalingFactorRateProviderNotFoundError{PoolId: 2})
+
+	err = gammKeeper.SetScalingFactorRateProvider(suite.Ctx, twapRateProvider(), suite.TestAccs[0].String())
+	suite.Require().NoError(err)
+
+	err = gammKeeper.RemoveScalingFactorRateProvider(suite.Ctx, 2, suite.TestAccs[1].String())
+	suite.Require().ErrorIs(err, types.ErrNotScalingFactorGovernor)
+
+	err = gammKeeper.RemoveScalingFactorRateProvider(suite.Ctx, 2, suite.TestAccs[0].String())
+	suite.Require().NoError(err)
+
+	_, err = gammKeeper.GetScalingFactorRateProvider(suite.Ctx, 2)
+	suite.Require().ErrorIs(err, types.ScalingFactorRateProviderNotFoundError{PoolId: 2})
+}
+
+// TestScalingFactorsUpdatedAtEpochEnd tests that the scaling factor of the rate denom ramps towards
+// the reference scaling factor divided by the twap, capped by the max change per epoch.
+func (suite *KeeperTestSuite) TestScalingFactorsUpdatedAtEpochEnd() {
+	suite.SetupTest()
+	suite.setupRateProviderPools()
+	gammKeeper := suite.App.GAMMKeeper
+
+	err := gammKeeper.SetScalingFactorRateProvider(suite.Ctx, twapRateProvider(), suite.TestAccs[0].String())
+	suite.Require().NoError(err)
+
+	// The twap window must not start before the twap pool was created.
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+
+	assertScalingFactors := func(expected []uint64) {
+		pool, err := gammKeeper.GetPoolAndPoke(suite.Ctx, 2)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expected, pool.(*stableswap.Pool).ScalingFactors)
+	}
+
+	// Epochs other than the rate provider's do not update the scaling factors.
+	err = gammKeeper.EpochHooks().AfterEpochEnd(suite.Ctx, "week", 1)
+	suite.Require().NoError(err)
+	assertScalingFactors([]uint64{1000, 1000})
+
+	// The target foo scaling factor is 1000 / 2 = 500. Half of the remaining change is capped
+	// at 10% of the current scaling factor every epoch. Scaling factors are ordered bar, foo.
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	err = gammKeeper.EpochHooks().AfterEpochEnd(suite.Ctx, rateProviderEpoch, 1)
+	suite.Require().NoError(err)
+	assertScalingFactors([]uint64{1000, 900})
+	suite.AssertEventEmitted(suite.Ctx, types.TypeEvtScalingFactorsUpdated, 1)
+
+	err = gammKeeper.EpochHooks().AfterEpochEnd(suite.Ctx, rateProviderEpoch, 2)
+	suite.Require().NoError(err)
+	assertScalingFactors([]uint64{1000, 810})
+
+	// Once the cap no longer binds, the scaling factor moves half of the remaining change.
+	rateProvider := twapRateProvider()
+	rateProvider.MaxChangePerEpoch = sdk.OneDec()
+	err = gammKeeper.SetScalingFactorRateProvider(suite.Ctx, rateProvider, suite.TestAccs[0].String())
+	suite.Require().NoError(err)
+
+	err = gammKeeper.EpochHooks().AfterEpochEnd(suite.Ctx, rateProviderEpoch, 3)
+	suite.Require().NoError(err)
+	assertScalingFactors([]uint64{1000, 655})
+}
+
+// TestScalingFactorsUpdatedAtEpochEnd_RateSourceError tests that a failing rate source
+// leaves the scaling factors unchanged without failing the epoch hook.
+func (suite *KeeperTestSuite) TestScalingFactorsUpdatedAtEpochEnd_RateSourceError() {
+	suite.SetupTest()
+	suite.setupRateProviderPools()
+	gammKeeper := suite.App.GAMMKeeper
+
+	rateProvider := twapRateProvider()
+	rateProvider.TwapSource = nil
+	rateProvider.ContractAddress = suite.TestAccs[2].String()
+	err := gammKeeper.SetScalingFactorRateProvider(suite.Ctx, rateProvider, suite.TestAccs[0].String())
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	err = gammKeeper.EpochHooks().AfterEpochEnd(suite.Ctx, rateProviderEpoch, 1)
+	suite.Require().NoError(err)
+
+	pool, err := gammKeeper.GetPoolAndPoke(suite.Ctx, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{1000, 1000}, pool.(*stableswap.Pool).ScalingFactors)
+	suite.AssertEventEmitted(suite.Ctx, types.TypeEvtScalingFactorsUpdated, 0)
+}
+
+// mockRateProviderContract is a rate provider contract that consumes the given gas and returns the given rate.
+type mockRateProviderContract struct {
+	rate         string
+	gasToConsume sdk.Gas
+}
+
+func (m mockRateProviderContract) QuerySmart(ctx sdk.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error) {
+	ctx.GasMeter().ConsumeGas(m.gasToConsume, "rate provider query")
+	return []byte(fmt.Sprintf(`{"redemption_rate":"%s"}`, m.rate)), nil
+}
+
+// TestScalingFactorsUpdatedAtEpochEnd_ContractGasLimit tests that rate provider contract queries are
+// bounded by the rate provider query gas limit, and that running out of gas is treated as a failed update.
+func (suite *KeeperTestSuite) TestScalingFactorsUpdatedAtEpochEnd_ContractGasLimit() {
+	tests := map[string]struct {
+		gasToConsume           sdk.Gas
+		expectedScalingFactors []uint64
+	}{
+		"query within gas limit": {
+			gasToConsume:           types.RateProviderQueryGasLimit,
+			expectedScalingFactors: []uint64{1000, 900},
+		},
+		"query out of gas": {
+			gasToConsume:           types.RateProviderQueryGasLimit + 1,
+			expectedScalingFactors: []uint64{1000, 1000},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.setupRateProviderPools()
+			gammKeeper := suite.App.GAMMKeeper
+			gammKeeper.SetWasmKeeper(mockRateProviderContract{rate: "2", gasToConsume: tc.gasToConsume})
+
+			rateProvider := twapRateProvider()
+			rateProvider.TwapSource = nil
+			rateProvider.ContractAddress = suite.TestAccs[2].String()
+			err := gammKeeper.SetScalingFactorRateProvider(suite.Ctx, rateProvider, suite.TestAccs[0].String())
+			suite.Require().NoError(err)
+
+			suite.Require().NotPanics(func() {
+				err = gammKeeper.EpochHooks().AfterEpochEnd(suite.Ctx, rateProviderEpoch, 1)
+			})
+			suite.Require().NoError(err)
+
+			pool, err := gammKeeper.GetPoolAndPoke(suite.Ctx, 2)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedScalingFactors, pool.(*stableswap.Pool).ScalingFactors)
+		})
+	}
+}
+
+// TestScalingFactorsUpdatedAtEpochEnd_MaxUpdates tests that at most the given number of rate providers
+// are processed at the end of an epoch, and that the processed rate providers rotate across epochs.
+func (suite *KeeperTestSuite) TestScalingFactorsUpdatedAtEpochEnd_MaxUpdates() {
+	suite.SetupTest()
+	suite.setupRateProviderPools()
+	gammKeeper := suite.App.GAMMKeeper
+
+	// Create a second foo/bar stableswap pool with id 3.
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+	suite.FundAcc(suite.TestAccs[0], liquidity)
+	msg := stableswap.NewMsgCreateStableswapPool(suite.TestAccs[0], stableswap.PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec()}, liquidity, []uint64{1000, 1000}, "")
+	msg.ScalingFactorController = suite.TestAccs[0].String()
+	_, err := suite.App.PoolManagerKeeper.CreatePool(suite.Ctx, msg)
+	suite.Require().NoError(err)
+
+	for _, poolId := range []uint64{2, 3} {
+		rateProvider := twapRateProvider()
+		rateProvider.PoolId = poolId
+		err := gammKeeper.SetScalingFactorRateProvider(suite.Ctx, rateProvider, suite.TestAccs[0].String())
+		suite.Require().NoError(err)
+	}
+
+	// The twap window must not start before the twap pool was created.
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+
+	assertScalingFactors := func(poolId uint64, expected []uint64) {
+		pool, err := gammKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expected, pool.(*stableswap.Pool).ScalingFactors)
+	}
+
+	// Only one of the two due rate providers is processed every epoch.
+	gammKeeper.UpdateScalingFactorsAtEpochEnd(suite.Ctx, rateProviderEpoch, 1, 1)
+	assertScalingFactors(2, []uint64{1000, 1000})
+	assertScalingFactors(3, []uint64{1000, 900})
+
+	gammKeeper.UpdateScalingFactorsAtEpochEnd(suite.Ctx, rateProviderEpoch, 2, 1)
+	assertScalingFactors(2, []uint64{1000, 900})
+	assertScalingFactors(3, []uint64{1000, 900})
+}
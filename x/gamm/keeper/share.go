@@ -26,6 +26,7 @@ func (k Keeper) applyJoinPoolStateChange(ctx sdk.Context, pool poolmanagertypes.
 
 	events.EmitAddLiquidityEvent(ctx, joiner, pool.GetId(), joinCoins)
 	k.hooks.AfterJoinPool(ctx, joiner, pool.GetId(), joinCoins, numShares)
+	k.poolListeners.AfterLiquidityAdded(ctx, joiner, pool.GetId(), joinCoins)
 	k.RecordTotalLiquidityIncrease(ctx, joinCoins)
 	return nil
 }
@@ -48,6 +49,7 @@ func (k Keeper) applyExitPoolStateChange(ctx sdk.Context, pool poolmanagertypes.
 
 	events.EmitRemoveLiquidityEvent(ctx, exiter, pool.GetId(), exitCoins)
 	k.hooks.AfterExitPool(ctx, exiter, pool.GetId(), numShares, exitCoins)
+	k.poolListeners.AfterLiquidityRemoved(ctx, exiter, pool.GetId(), exitCoins)
 	k.RecordTotalLiquidityDecrease(ctx, exitCoins)
 	return nil
 }
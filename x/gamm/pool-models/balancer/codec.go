@@ -8,6 +8,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	proto "github.com/gogo/protobuf/proto"
 )
 
@@ -18,6 +19,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateBalancerPool{}, "osmosis/gamm/create-balancer-pool", nil)
 	cdc.RegisterConcrete(&PoolParams{}, "osmosis/gamm/BalancerPoolParams", nil)
 	cdc.RegisterConcrete(&MsgMigrateSharesToFullRangeConcentratedPosition{}, "osmosis/gamm/MigratePosition", nil)
+	cdc.RegisterConcrete(&MsgUpdatePoolWeights{}, "osmosis/gamm/update-pool-weights", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -35,11 +37,16 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreateBalancerPool{},
 		&MsgMigrateSharesToFullRangeConcentratedPosition{},
+		&MsgUpdatePoolWeights{},
 	)
 	registry.RegisterImplementations(
 		(*proto.Message)(nil),
 		&PoolParams{},
 	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&UpdatePoolWeightsProposal{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,78 @@
+package balancer
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+)
+
+const (
+	ProposalTypeUpdatePoolWeights = "UpdatePoolWeights"
+)
+
+// Init registers the proposal to update the weights of a balancer pool.
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdatePoolWeights)
+	govtypes.RegisterProposalTypeCodec(&UpdatePoolWeightsProposal{}, "osmosis/UpdatePoolWeightsProposal")
+}
+
+var _ govtypes.Content = &UpdatePoolWeightsProposal{}
+
+// NewUpdatePoolWeightsProposal returns a new instance of an update pool weights proposal struct.
+func NewUpdatePoolWeightsProposal(title, description string, poolId uint64, params SmoothWeightChangeParams) govtypes.Content {
+	return &UpdatePoolWeightsProposal{
+		Title:                    title,
+		Description:              description,
+		PoolId:                   poolId,
+		SmoothWeightChangeParams: params,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *UpdatePoolWeightsProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *UpdatePoolWeightsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *UpdatePoolWeightsProposal) ProposalRoute() string { return types.RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *UpdatePoolWeightsProposal) ProposalType() string {
+	return ProposalTypeUpdatePoolWeights
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *UpdatePoolWeightsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.PoolId == 0 {
+		return fmt.Errorf("pool id must be positive")
+	}
+
+	return validateWeightUpdateParams(p.SmoothWeightChangeParams)
+}
+
+// String returns a string containing the update pool weights proposal.
+func (p UpdatePoolWeightsProposal) String() string {
+	targetWeights := make([]string, len(p.SmoothWeightChangeParams.TargetPoolWeights))
+	for i, target := range p.SmoothWeightChangeParams.TargetPoolWeights {
+		targetWeights[i] = target.Weight.String() + target.Token.Denom
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Pool Weights Proposal:
+  Title:          %s
+  Description:    %s
+  PoolId:         %d
+  StartTime:      %s
+  Duration:       %s
+  TargetWeights:  %s
+`, p.Title, p.Description, p.PoolId, p.SmoothWeightChangeParams.StartTime, p.SmoothWeightChangeParams.Duration, strings.Join(targetWeights, ",")))
+	return b.String()
+}
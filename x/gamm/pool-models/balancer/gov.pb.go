@@ -0,0 +1,446 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/pool-models/balancer/gov.proto
+
+package balancer
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UpdatePoolWeightsProposal is a gov Content type for scheduling a smooth
+// weight change on an existing balancer pool. Unlike MsgUpdatePoolWeights, it
+// is not restricted to the pool's future pool governor, which allows
+// governance to update the weights of any balancer pool, including pools
+// whose governor is a lock duration rather than an address.
+// The initial pool weights are always taken from the pool's current weights.
+type UpdatePoolWeightsProposal struct {
+	Title                    string                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description              string                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolId                   uint64                   `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	SmoothWeightChangeParams SmoothWeightChangeParams `protobuf:"bytes,4,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params" yaml:"smooth_weight_change_params"`
+}
+
+func (m *UpdatePoolWeightsProposal) Reset()      { *m = UpdatePoolWeightsProposal{} }
+func (*UpdatePoolWeightsProposal) ProtoMessage() {}
+func (*UpdatePoolWeightsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_585ff17c5415d74d, []int{0}
+}
+func (m *UpdatePoolWeightsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatePoolWeightsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatePoolWeightsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdatePoolWeightsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePoolWeightsProposal.Merge(m, src)
+}
+func (m *UpdatePoolWeightsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatePoolWeightsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePoolWeightsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePoolWeightsProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*UpdatePoolWeightsProposal)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.UpdatePoolWeightsProposal")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/pool-models/balancer/gov.proto", fileDescriptor_585ff17c5415d74d)
+}
+
+var fileDescriptor_585ff17c5415d74d = []byte{
+	// 364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xbf, 0x4e, 0xe3, 0x40,
+	0x10, 0xc6, 0xbd, 0xb9, 0x5c, 0xee, 0xce, 0x91, 0xae, 0xb0, 0x52, 0xf8, 0x72, 0x92, 0x6d, 0xb9,
+	0xb2, 0x2e, 0xca, 0xae, 0x72, 0x40, 0x93, 0x06, 0x29, 0x54, 0x74, 0x51, 0x10, 0x42, 0xd0, 0x58,
+	0x6b, 0x7b, 0x65, 0x5b, 0xda, 0xcd, 0x58, 0xde, 0x25, 0xc0, 0x1b, 0x50, 0x52, 0x42, 0x97, 0x8a,
+	0x67, 0x49, 0x99, 0x92, 0x2a, 0x42, 0xc9, 0x1b, 0xe4, 0x09, 0x90, 0xff, 0x44, 0x82, 0x22, 0xd0,
+	0xcd, 0xce, 0x7c, 0xbf, 0xfd, 0x3e, 0xcd, 0xe8, 0x3d, 0x90, 0x02, 0x64, 0x2a, 0x49, 0x4c, 0x85,
+	0x20, 0x19, 0x00, 0xef, 0x0b, 0x88, 0x18, 0x97, 0x24, 0xa0, 0x9c, 0x4e, 0x43, 0x96, 0x93, 0x18,
+	0x66, 0x38, 0xcb, 0x41, 0x81, 0xe1, 0xd5, 0x62, 0x5c, 0x88, 0x71, 0x21, 0xae, 0xb4, 0x78, 0xa7,
+	0xc5, 0xb3, 0x41, 0xc0, 0x14, 0x1d, 0x74, 0x3b, 0x31, 0xc4, 0x50, 0x42, 0xa4, 0xa8, 0x2a, 0xbe,
+	0x7b, 0xf8, 0xb5, 0xd9, 0xae, 0x18, 0x03, 0xf0, 0x8a, 0x72, 0x9f, 0x1b, 0xfa, 0x9f, 0xf3, 0x2c,
+	0xa2, 0x8a, 0x15, 0xcd, 0x0b, 0x96, 0xc6, 0x89, 0x92, 0xe3, 0x1c, 0x32, 0x90, 0x94, 0x1b, 0x1d,
+	0xfd, 0xbb, 0x4a, 0x15, 0x67, 0x26, 0x72, 0x90, 0xf7, 0x6b, 0x52, 0x3d, 0x0c, 0x47, 0x6f, 0x47,
+	0x4c, 0x86, 0x79, 0x9a, 0xa9, 0x14, 0xa6, 0x66, 0xa3, 0x9c, 0xbd, 0x6f, 0x19, 0x3d, 0xfd, 0x47,
+	0x11, 0xc0, 0x4f, 0x23, 0xf3, 0x9b, 0x83, 0xbc, 0xe6, 0xc8, 0xd8, 0xae, 0xec, 0xdf, 0x77, 0x54,
+	0xf0, 0xa1, 0x5b, 0x0f, 0xdc, 0x49, 0xab, 0xa8, 0x4e, 0x23, 0xe3, 0x09, 0xe9, 0x7f, 0xa5, 0x00,
+	0x50, 0x89, 0x7f, 0x53, 0xfa, 0xfb, 0x61, 0x42, 0xa7, 0x31, 0xf3, 0x33, 0x9a, 0x53, 0x21, 0xcd,
+	0xa6, 0x83, 0xbc, 0xf6, 0x7f, 0x8c, 0x3f, 0xec, 0xa7, 0xde, 0x05, 0x3e, 0x2b, 0xc1, 0x2a, 0xf7,
+	0x49, 0x89, 0x8d, 0x4b, 0x6a, 0xf4, 0x6f, 0xb1, 0xb2, 0xb5, 0xed, 0xca, 0x76, 0x2b, 0xd7, 0x4f,
+	0x0c, 0xdc, 0x89, 0x29, 0xf7, 0xfc, 0x32, 0xfc, 0x79, 0x3f, 0xb7, 0xb5, 0xc7, 0xb9, 0xad, 0x8d,
+	0x2e, 0x17, 0x6b, 0x0b, 0x2d, 0xd7, 0x16, 0x7a, 0x5d, 0x5b, 0xe8, 0x61, 0x63, 0x69, 0xcb, 0x8d,
+	0xa5, 0xbd, 0x6c, 0x2c, 0xed, 0xea, 0x38, 0x4e, 0x55, 0x72, 0x1d, 0xe0, 0x10, 0x04, 0xa9, 0x33,
+	0xf6, 0x39, 0x0d, 0xe4, 0xee, 0x41, 0x66, 0x83, 0x23, 0x72, 0xbb, 0xff, 0x2c, 0x41, 0xab, 0x3c,
+	0xc5, 0xc1, 0xdb, 0x00, 0x49, 0xe7, 0x53, 0x14, 0x2f, 0x02, 0x00, 0x00,
+}
+
+func (m *UpdatePoolWeightsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePoolWeightsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatePoolWeightsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpdatePoolWeightsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	l = m.SmoothWeightChangeParams.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdatePoolWeightsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdatePoolWeightsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdatePoolWeightsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothWeightChangeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SmoothWeightChangeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,9 @@
 package balancer
 
 import (
+	"errors"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -11,6 +14,7 @@ import (
 const (
 	TypeMsgCreateBalancerPool = "create_balancer_pool"
 	TypeMsgMigrateShares      = "migrate_shares"
+	TypeMsgUpdatePoolWeights  = "update_pool_weights"
 )
 
 var (
@@ -129,3 +133,75 @@ func (msg MsgMigrateSharesToFullRangeConcentratedPosition) GetSigners() []sdk.Ac
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgUpdatePoolWeights{}
+
+func NewMsgUpdatePoolWeights(
+	sender string,
+	poolID uint64,
+	params SmoothWeightChangeParams,
+) MsgUpdatePoolWeights {
+	return MsgUpdatePoolWeights{
+		Sender:                   sender,
+		PoolID:                   poolID,
+		SmoothWeightChangeParams: params,
+	}
+}
+
+func (msg MsgUpdatePoolWeights) Route() string { return types.RouterKey }
+func (msg MsgUpdatePoolWeights) Type() string  { return TypeMsgUpdatePoolWeights }
+func (msg MsgUpdatePoolWeights) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.PoolID == 0 {
+		return fmt.Errorf("pool id must be positive")
+	}
+
+	return validateWeightUpdateParams(msg.SmoothWeightChangeParams)
+}
+
+func (msg MsgUpdatePoolWeights) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdatePoolWeights) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// validateWeightUpdateParams statelessly validates the params of a pool weight update.
+// The target weight denoms are validated against the pool's assets once the pool is known,
+// so only check what can be checked statelessly here. Target token amounts are ignored.
+func validateWeightUpdateParams(params SmoothWeightChangeParams) error {
+	if len(params.TargetPoolWeights) < types.MinNumOfAssetsInPool {
+		return types.ErrTooFewPoolAssets
+	}
+
+	denomExists := map[string]bool{}
+	for _, target := range params.TargetPoolWeights {
+		if err := ValidateUserSpecifiedWeight(target.Weight); err != nil {
+			return err
+		}
+
+		if err := sdk.ValidateDenom(target.Token.Denom); err != nil {
+			return err
+		}
+
+		if denomExists[target.Token.Denom] {
+			return sdkerrors.Wrapf(types.ErrPoolParamsInvalidDenom, "target weight for %s already exists", target.Token.Denom)
+		}
+		denomExists[target.Token.Denom] = true
+	}
+
+	if params.Duration <= 0 {
+		return errors.New("params.SmoothWeightChangeParams must have a positive duration")
+	}
+
+	return nil
+}
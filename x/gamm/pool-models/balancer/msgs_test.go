@@ -2,6 +2,7 @@ package balancer_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -348,3 +349,103 @@ func TestMsgMigrateSharesToFullRangeConcentratedPosition(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgUpdatePoolWeights(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg balancer.MsgUpdatePoolWeights) balancer.MsgUpdatePoolWeights) balancer.MsgUpdatePoolWeights {
+		properMsg := balancer.NewMsgUpdatePoolWeights(addr1, 1, balancer.SmoothWeightChangeParams{
+			Duration: time.Hour,
+			TargetPoolWeights: []balancer.PoolAsset{
+				{Weight: sdk.NewInt(1), Token: sdk.NewCoin("test", sdk.ZeroInt())},
+				{Weight: sdk.NewInt(9), Token: sdk.NewCoin("test2", sdk.ZeroInt())},
+			},
+		})
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg balancer.MsgUpdatePoolWeights) balancer.MsgUpdatePoolWeights {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "update_pool_weights")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        balancer.MsgUpdatePoolWeights
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg balancer.MsgUpdatePoolWeights) balancer.MsgUpdatePoolWeights {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg balancer.MsgUpdatePoolWeights) balancer.MsgUpdatePoolWeights {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero pool id",
+			msg: createMsg(func(msg balancer.MsgUpdatePoolWeights) balancer.MsgUpdatePoolWeights {
+				msg.PoolID = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "too few target weights",
+			msg: createMsg(func(msg balancer.MsgUpdatePoolWeights) balancer.MsgUpdatePoolWeights {
+				msg.SmoothWeightChangeParams.TargetPoolWeights = msg.SmoothWeightChangeParams.TargetPoolWeights[:1]
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero target weight",
+			msg: createMsg(func(msg balancer.MsgUpdatePoolWeights) balancer.MsgUpdatePoolWeights {
+				msg.SmoothWeightChangeParams.TargetPoolWeights[0].Weight = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate target weight denom",
+			msg: createMsg(func(msg balancer.MsgUpdatePoolWeights) balancer.MsgUpdatePoolWeights {
+				msg.SmoothWeightChangeParams.TargetPoolWeights[1].Token.Denom = "test"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero duration",
+			msg: createMsg(func(msg balancer.MsgUpdatePoolWeights) balancer.MsgUpdatePoolWeights {
+				msg.SmoothWeightChangeParams.Duration = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
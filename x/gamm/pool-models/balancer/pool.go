@@ -210,6 +210,46 @@ func (p *Pool) setInitialPoolParams(params PoolParams, sortedAssets []PoolAsset,
 	return nil
 }
 
+// ValidatePoolGovernor returns an error if sender is not the pool's FuturePoolGovernor.
+// Only governors that are addresses can send messages. Pools governed by the lockers of
+// their LP shares for a lock duration, e.g. "24h" or "gamm/pool/1,24h", can only be updated
+// through governance proposals, as votes by lockers are not supported.
+func (p Pool) ValidatePoolGovernor(sender string) error {
+	if p.FuturePoolGovernor == "" {
+		return types.ErrNotPoolGovernor
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.FuturePoolGovernor); err != nil {
+		return types.LockDurationPoolGovernorError{PoolId: p.Id, Governor: p.FuturePoolGovernor}
+	}
+
+	if sender != p.FuturePoolGovernor {
+		return types.ErrNotPoolGovernor
+	}
+	return nil
+}
+
+// ScheduleWeightChange installs new SmoothWeightChangeParams on the pool, which are then applied
+// over time by PokePool. The weight change starts from the pool's current weights, so the pool
+// is expected to have been poked at the current block time. Any weight change in progress is replaced.
+// It does not authorize the change, which is up to the caller.
+func (p *Pool) ScheduleWeightChange(params SmoothWeightChangeParams, blockTime time.Time) error {
+	if params.StartTime.Unix() > 0 && params.StartTime.Before(blockTime) {
+		return types.ErrWeightChangeStartTimeInPast
+	}
+
+	// Copy the target weights, as setInitialPoolParams sorts and scales them in place.
+	params.TargetPoolWeights = append([]PoolAsset{}, params.TargetPoolWeights...)
+
+	poolParams := p.PoolParams
+	poolParams.SmoothWeightChangeParams = &params
+	if err := poolParams.Validate(p.PoolAssets); err != nil {
+		return err
+	}
+
+	return p.setInitialPoolParams(poolParams, p.GetAllPoolAssets(), blockTime)
+}
+
 // GetPoolAssets returns the denom's PoolAsset, If the PoolAsset doesn't exist, will return error.
 // As above, it will search the denom's PoolAsset by using binary search.
 // So, it is important to make sure that the PoolAssets are sorted.
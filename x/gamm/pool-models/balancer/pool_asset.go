@@ -113,3 +113,14 @@ func getPoolAssetByDenom(assets []PoolAsset, denom string) (PoolAsset, bool) {
 	}
 	return PoolAsset{}, false
 }
+
+// PoolAssetsWeightsString returns the user specified weights of the given pool assets
+// as a comma separated list of weight and denom pairs, e.g. "1uatom,2uosmo".
+// The assets' weights are expected to be scaled by GuaranteedWeightPrecision.
+func PoolAssetsWeightsString(assets []PoolAsset) string {
+	weights := make([]string, len(assets))
+	for i, asset := range assets {
+		weights[i] = asset.Weight.QuoRaw(GuaranteedWeightPrecision).String() + asset.Token.Denom
+	}
+	return strings.Join(weights, ",")
+}
@@ -977,6 +977,196 @@ func TestLBPParamsEmptyStartTime(t *testing.T) {
 	require.Equal(t, pacc.PoolParams.SmoothWeightChangeParams.StartTime, defaultCurBlockTime)
 }
 
+func TestBalancerPoolScheduleWeightChange(t *testing.T) {
+	governor := "osmo1fqlr98d45v5ysqgp6h56kpujcj4cvsjnjq9nck"
+	defaultDuration := 100 * time.Second
+
+	initialPoolAssets := []balancer.PoolAsset{
+		{
+			Weight: sdk.NewInt(1),
+			Token:  sdk.NewCoin("asset1", sdk.NewInt(1000)),
+		},
+		{
+			Weight: sdk.NewInt(1),
+			Token:  sdk.NewCoin("asset2", sdk.NewInt(1000)),
+		},
+	}
+
+	targetPoolWeights := func(weight1, weight2 int64) []balancer.PoolAsset {
+		return []balancer.PoolAsset{
+			{
+				Weight: sdk.NewInt(weight2),
+				Token:  sdk.NewCoin("asset2", sdk.NewInt(0)),
+			},
+			{
+				Weight: sdk.NewInt(weight1),
+				Token:  sdk.NewCoin("asset1", sdk.NewInt(0)),
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		params        balancer.SmoothWeightChangeParams
+		expectedStart time.Time
+		expectedErr   error
+	}{
+		"start at block time": {
+			params:        balancer.SmoothWeightChangeParams{Duration: defaultDuration, TargetPoolWeights: targetPoolWeights(1, 9)},
+			expectedStart: defaultCurBlockTime,
+		},
+		"start in the future": {
+			params:        balancer.SmoothWeightChangeParams{StartTime: defaultCurBlockTime.Add(time.Hour), Duration: defaultDuration, TargetPoolWeights: targetPoolWeights(1, 9)},
+			expectedStart: defaultCurBlockTime.Add(time.Hour),
+		},
+		"error: start time in the past": {
+			params:      balancer.SmoothWeightChangeParams{StartTime: defaultCurBlockTime.Add(-time.Second), Duration: defaultDuration, TargetPoolWeights: targetPoolWeights(1, 9)},
+			expectedErr: types.ErrWeightChangeStartTimeInPast,
+		},
+		"error: target weight denom not in pool": {
+			params: balancer.SmoothWeightChangeParams{Duration: defaultDuration, TargetPoolWeights: []balancer.PoolAsset{
+				{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset1", sdk.NewInt(0))},
+				{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset3", sdk.NewInt(0))},
+			}},
+			expectedErr: types.ErrPoolParamsInvalidDenom,
+		},
+		"error: wrong number of target weights": {
+			params: balancer.SmoothWeightChangeParams{Duration: defaultDuration, TargetPoolWeights: []balancer.PoolAsset{
+				{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset1", sdk.NewInt(0))},
+			}},
+			expectedErr: types.ErrPoolParamsInvalidNumDenoms,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pacc, err := balancer.NewBalancerPool(defaultPoolId, balancer.PoolParams{
+				SwapFee: defaultSwapFee,
+				ExitFee: defaultExitFee,
+			}, initialPoolAssets, governor, defaultCurBlockTime)
+			require.NoError(t, err)
+			originalTargetWeights := append([]balancer.PoolAsset{}, tc.params.TargetPoolWeights...)
+
+			err = pacc.ScheduleWeightChange(tc.params, defaultCurBlockTime)
+			require.Equal(t, originalTargetWeights, tc.params.TargetPoolWeights, "input target weights were mutated")
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Nil(t, pacc.PoolParams.SmoothWeightChangeParams)
+				return
+			}
+			require.NoError(t, err)
+
+			params := pacc.PoolParams.SmoothWeightChangeParams
+			require.NotNil(t, params)
+			require.Equal(t, tc.expectedStart, params.StartTime)
+			require.Equal(t, defaultSwapFee, pacc.PoolParams.SwapFee)
+			for i, asset := range pacc.PoolAssets {
+				require.Equal(t, asset.Weight, params.InitialPoolWeights[i].Weight)
+			}
+			require.Equal(t, "1asset1,9asset2", balancer.PoolAssetsWeightsString(params.TargetPoolWeights))
+
+			// Once the weight change has ended, the pool has the target weights.
+			pacc.PokePool(params.StartTime.Add(defaultDuration + time.Second))
+			require.Nil(t, pacc.PoolParams.SmoothWeightChangeParams)
+			require.Equal(t, sdk.NewInt(1).MulRaw(balancer.GuaranteedWeightPrecision), pacc.PoolAssets[0].Weight)
+			require.Equal(t, sdk.NewInt(9).MulRaw(balancer.GuaranteedWeightPrecision), pacc.PoolAssets[1].Weight)
+		})
+	}
+}
+
+func TestBalancerPoolValidatePoolGovernor(t *testing.T) {
+	governor := "osmo1fqlr98d45v5ysqgp6h56kpujcj4cvsjnjq9nck"
+
+	tests := map[string]struct {
+		futureGovernor string
+		sender         string
+		expectedErr    error
+	}{
+		"sender is the governor": {
+			futureGovernor: governor,
+			sender:         governor,
+		},
+		"error: sender is not the governor": {
+			futureGovernor: governor,
+			sender:         "osmo1c584m4lq25h83yp6ag8hh4htjr92d954vklzja",
+			expectedErr:    types.ErrNotPoolGovernor,
+		},
+		"error: pool has no governor": {
+			sender:      "",
+			expectedErr: types.ErrNotPoolGovernor,
+		},
+		"error: pool governed by the lockers of its shares": {
+			futureGovernor: "24h",
+			sender:         "24h",
+			expectedErr:    types.LockDurationPoolGovernorError{PoolId: defaultPoolId, Governor: "24h"},
+		},
+		"error: pool governed by the lockers of a token": {
+			futureGovernor: "gamm/pool/10,24h",
+			sender:         governor,
+			expectedErr:    types.LockDurationPoolGovernorError{PoolId: defaultPoolId, Governor: "gamm/pool/10,24h"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pacc := balancer.Pool{Id: defaultPoolId, FuturePoolGovernor: tc.futureGovernor}
+
+			err := pacc.ValidatePoolGovernor(tc.sender)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// TestBalancerPoolScheduleWeightChangeInProgress tests that scheduling a weight change on a pool
+// with a weight change in progress starts the new change from the pool's current weights.
+func TestBalancerPoolScheduleWeightChangeInProgress(t *testing.T) {
+	governor := "osmo1fqlr98d45v5ysqgp6h56kpujcj4cvsjnjq9nck"
+	defaultDuration := 100 * time.Second
+
+	pacc, err := balancer.NewBalancerPool(defaultPoolId, balancer.PoolParams{
+		SwapFee: defaultSwapFee,
+		ExitFee: defaultExitFee,
+		SmoothWeightChangeParams: &balancer.SmoothWeightChangeParams{
+			Duration: defaultDuration,
+			TargetPoolWeights: []balancer.PoolAsset{
+				{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset1", sdk.NewInt(0))},
+				{Weight: sdk.NewInt(3), Token: sdk.NewCoin("asset2", sdk.NewInt(0))},
+			},
+		},
+	}, []balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset1", sdk.NewInt(1000))},
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset2", sdk.NewInt(1000))},
+	}, governor, defaultCurBlockTime)
+	require.NoError(t, err)
+
+	// Halfway through the weight change, asset2 has a weight of 2.
+	blockTime := defaultCurBlockTime.Add(defaultDuration / 2)
+	pacc.PokePool(blockTime)
+	require.Equal(t, sdk.NewInt(2).MulRaw(balancer.GuaranteedWeightPrecision), pacc.PoolAssets[1].Weight)
+
+	err = pacc.ScheduleWeightChange(balancer.SmoothWeightChangeParams{
+		Duration: defaultDuration,
+		TargetPoolWeights: []balancer.PoolAsset{
+			{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset1", sdk.NewInt(0))},
+			{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset2", sdk.NewInt(0))},
+		},
+	}, blockTime)
+	require.NoError(t, err)
+
+	params := pacc.PoolParams.SmoothWeightChangeParams
+	require.Equal(t, blockTime, params.StartTime)
+	for i, asset := range pacc.PoolAssets {
+		require.Equal(t, asset.Weight, params.InitialPoolWeights[i].Weight)
+	}
+
+	// Halfway through the new weight change, asset2 is back to a weight of 1.5.
+	pacc.PokePool(blockTime.Add(defaultDuration / 2))
+	require.Equal(t, sdk.NewInt(3).MulRaw(balancer.GuaranteedWeightPrecision).QuoRaw(2), pacc.PoolAssets[1].Weight)
+}
+
 func TestBalancerPoolPokeTokenWeights(t *testing.T) {
 	// Set default date
 	defaultStartTime := time.Unix(1618703511, 0)
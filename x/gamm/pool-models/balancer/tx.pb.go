@@ -256,11 +256,113 @@ func (m *MsgMigrateSharesToFullRangeConcentratedPositionResponse) GetJoinTime()
 	return time.Time{}
 }
 
+// ===================== MsgUpdatePoolWeights
+// MsgUpdatePoolWeights schedules a new smooth weight change on an existing
+// balancer pool. It may only be sent by the pool's future pool governor.
+// The initial pool weights are always taken from the pool's current weights.
+type MsgUpdatePoolWeights struct {
+	Sender                   string                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID                   uint64                   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	SmoothWeightChangeParams SmoothWeightChangeParams `protobuf:"bytes,3,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params" yaml:"smooth_weight_change_params"`
+}
+
+func (m *MsgUpdatePoolWeights) Reset()         { *m = MsgUpdatePoolWeights{} }
+func (m *MsgUpdatePoolWeights) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolWeights) ProtoMessage()    {}
+func (*MsgUpdatePoolWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{4}
+}
+func (m *MsgUpdatePoolWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePoolWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolWeights.Merge(m, src)
+}
+func (m *MsgUpdatePoolWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePoolWeights proto.InternalMessageInfo
+
+func (m *MsgUpdatePoolWeights) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUpdatePoolWeights) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgUpdatePoolWeights) GetSmoothWeightChangeParams() SmoothWeightChangeParams {
+	if m != nil {
+		return m.SmoothWeightChangeParams
+	}
+	return SmoothWeightChangeParams{}
+}
+
+type MsgUpdatePoolWeightsResponse struct {
+}
+
+func (m *MsgUpdatePoolWeightsResponse) Reset()         { *m = MsgUpdatePoolWeightsResponse{} }
+func (m *MsgUpdatePoolWeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolWeightsResponse) ProtoMessage()    {}
+func (*MsgUpdatePoolWeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{5}
+}
+func (m *MsgUpdatePoolWeightsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolWeightsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePoolWeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolWeightsResponse.Merge(m, src)
+}
+func (m *MsgUpdatePoolWeightsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolWeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolWeightsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePoolWeightsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateBalancerPool)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPool")
 	proto.RegisterType((*MsgCreateBalancerPoolResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPoolResponse")
 	proto.RegisterType((*MsgMigrateSharesToFullRangeConcentratedPosition)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgMigrateSharesToFullRangeConcentratedPosition")
 	proto.RegisterType((*MsgMigrateSharesToFullRangeConcentratedPositionResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgMigrateSharesToFullRangeConcentratedPositionResponse")
+	proto.RegisterType((*MsgUpdatePoolWeights)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgUpdatePoolWeights")
+	proto.RegisterType((*MsgUpdatePoolWeightsResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgUpdatePoolWeightsResponse")
 }
 
 func init() {
@@ -268,53 +370,59 @@ func init() {
 }
 
 var fileDescriptor_0647ee155de97433 = []byte{
-	// 721 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcf, 0x6e, 0xd3, 0x4a,
-	0x14, 0xc6, 0xe3, 0xa6, 0x4a, 0x6f, 0x27, 0xba, 0xf7, 0xb6, 0x56, 0xef, 0x55, 0x08, 0x10, 0x47,
-	0x46, 0x42, 0x41, 0x22, 0x33, 0x24, 0x80, 0x90, 0xd8, 0x14, 0xdc, 0xaa, 0x55, 0x91, 0x22, 0x15,
-	0x53, 0x16, 0xed, 0x26, 0x9a, 0xc4, 0x53, 0xd7, 0x60, 0x7b, 0x82, 0x67, 0x52, 0xda, 0xb7, 0xe8,
-	0x86, 0x2d, 0xcf, 0xc1, 0x96, 0x05, 0x52, 0x97, 0x5d, 0x22, 0x16, 0x06, 0xa5, 0x5b, 0x56, 0x79,
-	0x02, 0x34, 0x7f, 0xec, 0x16, 0x48, 0x05, 0x51, 0xc5, 0x2a, 0xf6, 0xcc, 0x77, 0x7e, 0xdf, 0x99,
-	0x33, 0xe7, 0x38, 0xa0, 0x49, 0x59, 0x44, 0x59, 0xc0, 0x90, 0x8f, 0xa3, 0x08, 0x0d, 0x28, 0x0d,
-	0x9b, 0x11, 0xf5, 0x48, 0xc8, 0x50, 0x0f, 0x87, 0x38, 0xee, 0x93, 0x04, 0xf1, 0x03, 0xc4, 0x0f,
-	0xe0, 0x20, 0xa1, 0x9c, 0x9a, 0x0d, 0x2d, 0x87, 0x42, 0x0e, 0x85, 0x5c, 0xa9, 0x61, 0xa6, 0x86,
-	0xfb, 0xad, 0x1e, 0xe1, 0xb8, 0x55, 0x5d, 0xf2, 0xa9, 0x4f, 0x65, 0x10, 0x12, 0x4f, 0x2a, 0xbe,
-	0x7a, 0xef, 0xd7, 0x76, 0xd9, 0xc3, 0x26, 0xa5, 0xa1, 0x8e, 0xaa, 0xf5, 0x65, 0x18, 0xea, 0x61,
-	0x46, 0x90, 0x36, 0x40, 0x7d, 0x1a, 0xc4, 0x7a, 0xdf, 0xf2, 0x29, 0xf5, 0x43, 0x82, 0xe4, 0x5b,
-	0x6f, 0xb8, 0x8b, 0x78, 0x10, 0x11, 0xc6, 0x71, 0x34, 0x50, 0x02, 0xfb, 0xdd, 0x0c, 0xf8, 0xaf,
-	0xc3, 0xfc, 0x95, 0x84, 0x60, 0x4e, 0x9c, 0x73, 0x06, 0xe6, 0x2d, 0x50, 0x62, 0x24, 0xf6, 0x48,
-	0x52, 0x31, 0xea, 0x46, 0x63, 0xde, 0x59, 0x1c, 0xa7, 0xd6, 0xdf, 0x87, 0x38, 0x0a, 0x1f, 0xda,
-	0x6a, 0xdd, 0x76, 0xb5, 0xc0, 0xdc, 0x06, 0x65, 0x91, 0x70, 0x77, 0x80, 0x13, 0x1c, 0xb1, 0xca,
-	0x4c, 0xdd, 0x68, 0x94, 0xdb, 0x75, 0xf8, 0x5d, 0x45, 0x74, 0x72, 0x50, 0xb0, 0x37, 0xa5, 0xce,
-	0xf9, 0x7f, 0x9c, 0x5a, 0xa6, 0x22, 0x9e, 0x0b, 0xb7, 0x5d, 0x30, 0xc8, 0x35, 0xe6, 0x9a, 0x46,
-	0x63, 0xc6, 0x08, 0x67, 0x95, 0x62, 0xbd, 0xd8, 0x28, 0xb7, 0xad, 0x8b, 0xd1, 0x8f, 0x85, 0xce,
-	0x99, 0x3d, 0x4e, 0xad, 0x82, 0xe2, 0xc8, 0x05, 0x66, 0x3e, 0x05, 0x4b, 0xbb, 0x43, 0x3e, 0x4c,
-	0x48, 0x57, 0xe2, 0x7c, 0xba, 0x4f, 0x92, 0x98, 0x26, 0x95, 0x59, 0x79, 0x36, 0x6b, 0x9c, 0x5a,
-	0x57, 0x55, 0x26, 0x93, 0x54, 0xb6, 0x6b, 0xaa, 0x65, 0xe1, 0xb0, 0x9e, 0x2d, 0xae, 0x82, 0xeb,
-	0x13, 0x2b, 0xe7, 0x12, 0x36, 0xa0, 0x31, 0x23, 0xe6, 0x0d, 0x30, 0x27, 0x31, 0x81, 0x27, 0x4b,
-	0x38, 0xeb, 0x80, 0x51, 0x6a, 0x95, 0x84, 0x64, 0x63, 0xd5, 0x2d, 0x89, 0xad, 0x0d, 0xcf, 0xfe,
-	0x60, 0x00, 0xd4, 0x61, 0x7e, 0x27, 0xf0, 0x13, 0xcc, 0xc9, 0xb3, 0x3d, 0x9c, 0x10, 0xb6, 0x45,
-	0xd7, 0x86, 0x61, 0xe8, 0xe2, 0xd8, 0x27, 0x2b, 0x34, 0xee, 0x93, 0x98, 0x8b, 0x3d, 0x6f, 0x93,
-	0xb2, 0x80, 0x07, 0x34, 0x9e, 0xe6, 0x6a, 0x7c, 0xb0, 0xc8, 0x24, 0xb3, 0xcb, 0x69, 0x37, 0x52,
-	0x26, 0xfa, 0x82, 0xae, 0x40, 0xd5, 0x3c, 0x50, 0x34, 0x4f, 0x5e, 0xc4, 0x15, 0x1a, 0xc4, 0x4e,
-	0x5d, 0xd4, 0x6f, 0x9c, 0x5a, 0x15, 0x0d, 0xfd, 0x91, 0x60, 0xbb, 0xff, 0x32, 0x9d, 0xa9, 0x4e,
-	0xdc, 0x7e, 0x5f, 0x04, 0x0f, 0xa6, 0x3c, 0x47, 0x5e, 0xa8, 0x1d, 0x30, 0x87, 0x23, 0x3a, 0x8c,
-	0xf9, 0x1d, 0x7d, 0xa0, 0x47, 0xc2, 0xff, 0x53, 0x6a, 0xdd, 0xf4, 0x03, 0xbe, 0x37, 0xec, 0xc1,
-	0x3e, 0x8d, 0x90, 0xee, 0x74, 0xf5, 0xd3, 0x64, 0xde, 0x4b, 0xc4, 0x0f, 0x07, 0x84, 0xc1, 0x8d,
-	0x98, 0x8f, 0x53, 0xeb, 0x1f, 0x95, 0xa9, 0xc6, 0xd8, 0x6e, 0x06, 0x3c, 0x63, 0xb7, 0xe4, 0xb1,
-	0x2f, 0xcd, 0x6e, 0xe5, 0xec, 0x96, 0xf9, 0x1a, 0x2c, 0x86, 0xc1, 0xab, 0x61, 0xe0, 0x05, 0xfc,
-	0xb0, 0xdb, 0x97, 0x8d, 0xe0, 0x55, 0x8a, 0xd2, 0xe5, 0xc9, 0x14, 0x2e, 0xab, 0xa4, 0x7f, 0x56,
-	0xeb, 0x9f, 0x80, 0xb6, 0xbb, 0x90, 0xaf, 0xa9, 0x66, 0xf3, 0xcc, 0xe7, 0x60, 0xfe, 0x05, 0x0d,
-	0xe2, 0xae, 0x98, 0x66, 0xd9, 0xc2, 0xe5, 0x76, 0x15, 0xaa, 0x51, 0x87, 0xd9, 0xa8, 0xc3, 0xad,
-	0x6c, 0xd4, 0x9d, 0x6b, 0xfa, 0x3a, 0x17, 0x94, 0x45, 0x1e, 0x6a, 0x1f, 0x7d, 0xb6, 0x0c, 0xf7,
-	0x2f, 0xf1, 0x2e, 0xc4, 0xed, 0x37, 0x45, 0x50, 0xec, 0x30, 0xdf, 0x7c, 0x6b, 0x00, 0x73, 0xc2,
-	0x17, 0x61, 0x19, 0xfe, 0xee, 0x37, 0x0e, 0x4e, 0x1c, 0x8c, 0xea, 0xfa, 0x25, 0x01, 0x79, 0xc3,
-	0x7c, 0x35, 0xc0, 0xed, 0xa9, 0x26, 0x66, 0x7b, 0x2a, 0xe7, 0x69, 0xd0, 0x55, 0xfc, 0xc7, 0xd0,
-	0xd9, 0x71, 0x9d, 0xed, 0xe3, 0x51, 0xcd, 0x38, 0x19, 0xd5, 0x8c, 0x2f, 0xa3, 0x9a, 0x71, 0x74,
-	0x5a, 0x2b, 0x9c, 0x9c, 0xd6, 0x0a, 0x1f, 0x4f, 0x6b, 0x85, 0x9d, 0xe5, 0x73, 0xed, 0xa5, 0xd3,
-	0x68, 0x86, 0xb8, 0xc7, 0xb2, 0x17, 0xb4, 0xdf, 0xba, 0x8f, 0x0e, 0x2e, 0xfe, 0x4f, 0xe9, 0x95,
-	0x64, 0xbb, 0xdc, 0xfd, 0x16, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x46, 0x01, 0x17, 0xee, 0x06, 0x00,
-	0x00,
+	// 827 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x77, 0xa3, 0x94, 0x4e, 0x04, 0x34, 0x56, 0x40, 0x66, 0x5b, 0xec, 0x95, 0x91, 0x50,
+	0x40, 0xec, 0x0c, 0xbb, 0x80, 0x90, 0x38, 0x50, 0xf0, 0x46, 0xa9, 0x82, 0xb4, 0x52, 0x70, 0x5b,
+	0xa1, 0xf4, 0x62, 0xcd, 0xda, 0x53, 0xaf, 0xc1, 0xf6, 0x18, 0xcf, 0x6c, 0x9a, 0x5c, 0xf9, 0x0b,
+	0x7a, 0x45, 0x48, 0xfc, 0x13, 0x5c, 0xb8, 0x72, 0x40, 0xea, 0xb1, 0x47, 0xc4, 0xc1, 0xa0, 0xcd,
+	0x95, 0xd3, 0xfe, 0x05, 0x68, 0x7e, 0xd8, 0x5d, 0xc8, 0xae, 0xc0, 0x2a, 0x9c, 0x62, 0x8f, 0xbf,
+	0xf7, 0x7d, 0xef, 0xbd, 0xf9, 0xde, 0xcb, 0x82, 0x01, 0x65, 0x19, 0x65, 0x09, 0x43, 0x31, 0xce,
+	0x32, 0x54, 0x50, 0x9a, 0x0e, 0x32, 0x1a, 0x91, 0x94, 0xa1, 0x29, 0x4e, 0x71, 0x1e, 0x92, 0x12,
+	0xf1, 0x73, 0xc4, 0xcf, 0x61, 0x51, 0x52, 0x4e, 0xcd, 0x03, 0x0d, 0x87, 0x02, 0x0e, 0x05, 0x5c,
+	0xa1, 0x61, 0x8d, 0x86, 0x67, 0xc3, 0x29, 0xe1, 0x78, 0xd8, 0xdb, 0x8f, 0x69, 0x4c, 0x65, 0x10,
+	0x12, 0x4f, 0x2a, 0xbe, 0xf7, 0xfe, 0x3f, 0xcb, 0xd5, 0x0f, 0x27, 0x94, 0xa6, 0x3a, 0xca, 0x0e,
+	0x65, 0x18, 0x9a, 0x62, 0x46, 0x90, 0x16, 0x40, 0x21, 0x4d, 0x72, 0xfd, 0xdd, 0x89, 0x29, 0x8d,
+	0x53, 0x82, 0xe4, 0xdb, 0x74, 0xfe, 0x10, 0xf1, 0x24, 0x23, 0x8c, 0xe3, 0xac, 0x50, 0x00, 0xf7,
+	0xc7, 0x0e, 0x78, 0x65, 0xc2, 0xe2, 0x71, 0x49, 0x30, 0x27, 0xde, 0x8a, 0x80, 0xf9, 0x16, 0xd8,
+	0x61, 0x24, 0x8f, 0x48, 0x69, 0x19, 0x7d, 0xe3, 0xe0, 0xba, 0xb7, 0xb7, 0xac, 0x9c, 0x17, 0x2f,
+	0x70, 0x96, 0x7e, 0xe4, 0xaa, 0x73, 0xd7, 0xd7, 0x00, 0xf3, 0x14, 0xec, 0x8a, 0x84, 0x83, 0x02,
+	0x97, 0x38, 0x63, 0x56, 0xa7, 0x6f, 0x1c, 0xec, 0x8e, 0xfa, 0xf0, 0x2f, 0x1d, 0xd1, 0xc9, 0x41,
+	0xc1, 0x7d, 0x22, 0x71, 0xde, 0xab, 0xcb, 0xca, 0x31, 0x15, 0xe3, 0x4a, 0xb8, 0xeb, 0x83, 0xa2,
+	0xc1, 0x98, 0x47, 0x9a, 0x1a, 0x33, 0x46, 0x38, 0xb3, 0xba, 0xfd, 0xee, 0xc1, 0xee, 0xc8, 0xd9,
+	0x4c, 0xfd, 0xa9, 0xc0, 0x79, 0xdb, 0x4f, 0x2a, 0x67, 0x4b, 0xf1, 0xc8, 0x03, 0x66, 0x7e, 0x0e,
+	0xf6, 0x1f, 0xce, 0xf9, 0xbc, 0x24, 0x81, 0xa4, 0x8b, 0xe9, 0x19, 0x29, 0x73, 0x5a, 0x5a, 0xdb,
+	0xb2, 0x36, 0x67, 0x59, 0x39, 0x37, 0x55, 0x26, 0xeb, 0x50, 0xae, 0x6f, 0xaa, 0x63, 0xa1, 0x70,
+	0xa7, 0x3e, 0x3c, 0x04, 0xaf, 0xaf, 0xed, 0x9c, 0x4f, 0x58, 0x41, 0x73, 0x46, 0xcc, 0x37, 0xc0,
+	0x35, 0x49, 0x93, 0x44, 0xb2, 0x85, 0xdb, 0x1e, 0x58, 0x54, 0xce, 0x8e, 0x80, 0x1c, 0x1f, 0xfa,
+	0x3b, 0xe2, 0xd3, 0x71, 0xe4, 0xfe, 0x6c, 0x00, 0x34, 0x61, 0xf1, 0x24, 0x89, 0x4b, 0xcc, 0xc9,
+	0xdd, 0x19, 0x2e, 0x09, 0xbb, 0x47, 0x8f, 0xe6, 0x69, 0xea, 0xe3, 0x3c, 0x26, 0x63, 0x9a, 0x87,
+	0x24, 0xe7, 0xe2, 0x5b, 0x74, 0x42, 0x59, 0xc2, 0x13, 0x9a, 0xb7, 0xb9, 0x9a, 0x18, 0xec, 0x31,
+	0xc9, 0x19, 0x70, 0x1a, 0x64, 0x4a, 0x44, 0x5f, 0xd0, 0x6b, 0x50, 0x99, 0x07, 0x0a, 0xf3, 0x34,
+	0x4d, 0x1c, 0xd3, 0x24, 0xf7, 0xfa, 0xa2, 0x7f, 0xcb, 0xca, 0xb1, 0x34, 0xe9, 0xdf, 0x19, 0x5c,
+	0xff, 0x65, 0xa6, 0x33, 0xd5, 0x89, 0xbb, 0x3f, 0x75, 0xc1, 0x87, 0x2d, 0xeb, 0x68, 0x1a, 0xf5,
+	0x00, 0x5c, 0xc3, 0x19, 0x9d, 0xe7, 0xfc, 0x5d, 0x5d, 0xd0, 0x27, 0x42, 0xff, 0xd7, 0xca, 0x79,
+	0x33, 0x4e, 0xf8, 0x6c, 0x3e, 0x85, 0x21, 0xcd, 0x90, 0x76, 0xba, 0xfa, 0x33, 0x60, 0xd1, 0x57,
+	0x88, 0x5f, 0x14, 0x84, 0xc1, 0xe3, 0x9c, 0x2f, 0x2b, 0xe7, 0x25, 0x95, 0xa9, 0xa6, 0x71, 0xfd,
+	0x9a, 0xf0, 0x19, 0xf7, 0xd0, 0xea, 0xfc, 0x17, 0xdc, 0xc3, 0x86, 0x7b, 0x68, 0x3e, 0x02, 0x7b,
+	0x69, 0xf2, 0xf5, 0x3c, 0x89, 0x12, 0x7e, 0x11, 0x84, 0xd2, 0x08, 0x91, 0xd5, 0x95, 0x2a, 0x9f,
+	0xb5, 0x50, 0x39, 0x24, 0xe1, 0xb3, 0x5e, 0x5f, 0x21, 0x74, 0xfd, 0x1b, 0xcd, 0x99, 0x32, 0x5b,
+	0x64, 0xde, 0x07, 0xd7, 0xbf, 0xa4, 0x49, 0x1e, 0x88, 0x69, 0x96, 0x16, 0xde, 0x1d, 0xf5, 0xa0,
+	0x1a, 0x75, 0x58, 0x8f, 0x3a, 0xbc, 0x57, 0x8f, 0xba, 0x77, 0x4b, 0x5f, 0xe7, 0x0d, 0x25, 0xd1,
+	0x84, 0xba, 0x8f, 0x7f, 0x73, 0x0c, 0xff, 0x05, 0xf1, 0x2e, 0xc0, 0xee, 0x37, 0x1d, 0xb0, 0x3f,
+	0x61, 0xf1, 0xfd, 0x22, 0xc2, 0x5c, 0x7a, 0xfd, 0x0b, 0x92, 0xc4, 0x33, 0xce, 0xda, 0x18, 0x6e,
+	0xc5, 0xf4, 0x9d, 0x4d, 0xa6, 0x37, 0xbf, 0x35, 0xc0, 0x4d, 0x96, 0x51, 0xca, 0x67, 0xc1, 0x23,
+	0x29, 0x11, 0x84, 0x33, 0xe1, 0x92, 0x7a, 0x83, 0x74, 0x65, 0x49, 0x70, 0xfd, 0x98, 0xdf, 0x95,
+	0x81, 0x2a, 0xb5, 0xb1, 0x0c, 0xd3, 0xfb, 0xe4, 0x6d, 0x5d, 0xa6, 0xab, 0x33, 0xdb, 0x2c, 0xe0,
+	0xfa, 0x16, 0xdb, 0xc0, 0xe2, 0xda, 0xe0, 0xd6, 0xba, 0x1e, 0xd4, 0x66, 0x1d, 0xfd, 0xb0, 0x0d,
+	0xba, 0x13, 0x16, 0x9b, 0xdf, 0x1b, 0xc0, 0x5c, 0xb3, 0x36, 0x6f, 0xc3, 0x7f, 0xfb, 0x8f, 0x00,
+	0xae, 0xdd, 0x1e, 0xbd, 0x3b, 0xcf, 0x49, 0xd0, 0x4c, 0xd5, 0x1f, 0x06, 0x78, 0xa7, 0xd5, 0x5a,
+	0x39, 0x6d, 0xa5, 0xdc, 0x86, 0xba, 0x87, 0xff, 0x37, 0xea, 0xa6, 0xdc, 0xef, 0x0c, 0xb0, 0x77,
+	0xd5, 0xb9, 0x1f, 0xb7, 0x12, 0xbe, 0x12, 0xdf, 0x3b, 0x7a, 0xbe, 0xf8, 0x3a, 0x3b, 0xef, 0xf4,
+	0xc9, 0xc2, 0x36, 0x9e, 0x2e, 0x6c, 0xe3, 0xf7, 0x85, 0x6d, 0x3c, 0xbe, 0xb4, 0xb7, 0x9e, 0x5e,
+	0xda, 0x5b, 0xbf, 0x5c, 0xda, 0x5b, 0x0f, 0x6e, 0xaf, 0x6c, 0x08, 0xad, 0x35, 0x48, 0xf1, 0x94,
+	0xd5, 0x2f, 0xe8, 0x6c, 0xf8, 0x01, 0x3a, 0xdf, 0xfc, 0xb3, 0x60, 0xba, 0x23, 0x27, 0xfe, 0xbd,
+	0x3f, 0x07, 0x00, 0xc4, 0xca, 0xb1, 0x63, 0xb1, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -331,6 +439,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateBalancerPool(ctx context.Context, in *MsgCreateBalancerPool, opts ...grpc.CallOption) (*MsgCreateBalancerPoolResponse, error)
 	MigrateSharesToFullRangeConcentratedPosition(ctx context.Context, in *MsgMigrateSharesToFullRangeConcentratedPosition, opts ...grpc.CallOption) (*MsgMigrateSharesToFullRangeConcentratedPositionResponse, error)
+	UpdatePoolWeights(ctx context.Context, in *MsgUpdatePoolWeights, opts ...grpc.CallOption) (*MsgUpdatePoolWeightsResponse, error)
 }
 
 type msgClient struct {
@@ -359,10 +468,20 @@ func (c *msgClient) MigrateSharesToFullRangeConcentratedPosition(ctx context.Con
 	return out, nil
 }
 
+func (c *msgClient) UpdatePoolWeights(ctx context.Context, in *MsgUpdatePoolWeights, opts ...grpc.CallOption) (*MsgUpdatePoolWeightsResponse, error) {
+	out := new(MsgUpdatePoolWeightsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/UpdatePoolWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateBalancerPool(context.Context, *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error)
 	MigrateSharesToFullRangeConcentratedPosition(context.Context, *MsgMigrateSharesToFullRangeConcentratedPosition) (*MsgMigrateSharesToFullRangeConcentratedPositionResponse, error)
+	UpdatePoolWeights(context.Context, *MsgUpdatePoolWeights) (*MsgUpdatePoolWeightsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -375,6 +494,9 @@ func (*UnimplementedMsgServer) CreateBalancerPool(ctx context.Context, req *MsgC
 func (*UnimplementedMsgServer) MigrateSharesToFullRangeConcentratedPosition(ctx context.Context, req *MsgMigrateSharesToFullRangeConcentratedPosition) (*MsgMigrateSharesToFullRangeConcentratedPositionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MigrateSharesToFullRangeConcentratedPosition not implemented")
 }
+func (*UnimplementedMsgServer) UpdatePoolWeights(ctx context.Context, req *MsgUpdatePoolWeights) (*MsgUpdatePoolWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePoolWeights not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -416,6 +538,24 @@ func _Msg_MigrateSharesToFullRangeConcentratedPosition_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdatePoolWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePoolWeights)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePoolWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/UpdatePoolWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePoolWeights(ctx, req.(*MsgUpdatePoolWeights))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.balancer.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -428,6 +568,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MigrateSharesToFullRangeConcentratedPosition",
 			Handler:    _Msg_MigrateSharesToFullRangeConcentratedPosition_Handler,
 		},
+		{
+			MethodName: "UpdatePoolWeights",
+			Handler:    _Msg_UpdatePoolWeights_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/pool-models/balancer/tx/tx.proto",
@@ -625,6 +769,74 @@ func (m *MsgMigrateSharesToFullRangeConcentratedPositionResponse) MarshalToSized
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdatePoolWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoolWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePoolWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoolWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -707,6 +919,33 @@ func (m *MsgMigrateSharesToFullRangeConcentratedPositionResponse) Size() (n int)
 	return n
 }
 
+func (m *MsgUpdatePoolWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	l = m.SmoothWeightChangeParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdatePoolWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1266,6 +1505,190 @@ func (m *MsgMigrateSharesToFullRangeConcentratedPositionResponse) Unmarshal(dAtA
 	}
 	return nil
 }
+func (m *MsgUpdatePoolWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePoolWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePoolWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothWeightChangeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SmoothWeightChangeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePoolWeightsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePoolWeightsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePoolWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/internal/cfmm_common"
@@ -352,6 +353,129 @@ func solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn osmomath.Bi
 	return xOut
 }
 
+// solveCfmmWithAmplification solves the pool's CFMM for the given amplification.
+// An amplification of 0 is the solidly CFMM, solved by solveCfmm.
+func solveCfmmWithAmplification(xReserve, yReserve osmomath.BigDec, remReserves []osmomath.BigDec, yIn osmomath.BigDec, amplification uint64) osmomath.BigDec {
+	if amplification == 0 {
+		return solveCfmm(xReserve, yReserve, remReserves, yIn)
+	}
+	return solveAmplifiedCFMMBinarySearch(xReserve, yReserve, remReserves, yIn, osmomath.NewBigDec(int64(amplification)))
+}
+
+// The amplified CFMM generalizes the multi-asset solidly CFMM the same way curve's stableswap
+// invariant generalizes the constant product invariant.
+// For n assets with reserves x_i, let q(x) = n^(n+1) (prod x_i) (sum x_i^2),
+// which equals D^(n+2) when every reserve is D / n. The invariant D of the pool is defined by
+// A (sum x_i - D) = D^(n+3) / q(x) - D
+// For A = 0 this is the solidly CFMM q(x) = D^(n+2). As A grows, the curve approaches the
+// constant sum curve sum x_i = D around the balanced point, while D^(n+3) / q(x) growing without
+// bound as any reserve goes to 0 still prevents the pool from being drained of any asset.
+
+// amplifiedProductRatio returns D^(n+2) / q(x). It is computed as a product of terms that are
+// close to 1 around the balanced point, to avoid raising the reserves to high powers.
+func amplifiedProductRatio(d osmomath.BigDec, reserves []osmomath.BigDec) osmomath.BigDec {
+	n := int64(len(reserves))
+	ratio := osmomath.OneDec()
+	sumSquares := osmomath.ZeroDec()
+	for _, reserve := range reserves {
+		ratio = ratio.Mul(d).Quo(reserve.MulInt64(n))
+		sumSquares = sumSquares.Add(reserve.Mul(reserve))
+	}
+	return ratio.Mul(d).Mul(d).Quo(sumSquares.MulInt64(n))
+}
+
+// amplifiedCfmmResidual returns A (sum x_i - D) + D - D^(n+3) / q(x).
+// It is 0 for reserves on the curve with invariant D, and increasing in every reserve.
+func amplifiedCfmmResidual(d, amplification osmomath.BigDec, reserves []osmomath.BigDec) osmomath.BigDec {
+	sum := osmomath.ZeroDec()
+	for _, reserve := range reserves {
+		sum = sum.Add(reserve)
+	}
+	return amplification.Mul(sum.Sub(d)).Add(d).Sub(d.Mul(amplifiedProductRatio(d, reserves)))
+}
+
+// solveAmplifiedInvariant returns the invariant D of the amplified CFMM for the given reserves,
+// i.e. the positive root of D^(n+3) / q(x) + (A - 1) D = A sum x_i.
+// The left hand side is increasing in D for A >= 1, and D is at most sum x_i,
+// as q(x) is largest for a given sum of reserves when the reserves are balanced.
+func solveAmplifiedInvariant(reserves []osmomath.BigDec, amplification osmomath.BigDec) osmomath.BigDec {
+	sum := osmomath.ZeroDec()
+	for _, reserve := range reserves {
+		sum = sum.Add(reserve)
+	}
+	amplificationMinusOne := amplification.Sub(one)
+	invariantFn := func(d osmomath.BigDec) osmomath.BigDec {
+		return d.Mul(amplifiedProductRatio(d, reserves)).Add(amplificationMinusOne.Mul(d))
+	}
+
+	errTolerance := osmomath.ErrTolerance{AdditiveTolerance: sdk.Dec{}, MultiplicativeTolerance: sdk.NewDecWithPrec(1, 12)}
+	d, err := osmomath.BinarySearchBigDec(invariantFn, zero, sum, amplification.Mul(sum), errTolerance, 256)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// solveAmplifiedCFMMBinarySearch returns how many units of x leave the pool when yIn units of y
+// are added to it, under the amplified CFMM. A negative yIn removes units of y, and then returns
+// the negative of the units of x that must be added.
+//
+// It finds the pool's invariant D, and then binary searches for the x output that keeps the reserves
+// on the curve with invariant D through the current reserves. The search compares the change of the
+// residual caused by the trade, rather than the residual itself, so that the error tolerance is relative
+// to the size of the trade and small trades, like the ones used for spot prices, stay precise.
+func solveAmplifiedCFMMBinarySearch(xReserve, yReserve osmomath.BigDec, remReserves []osmomath.BigDec, yIn, amplification osmomath.BigDec) osmomath.BigDec {
+	if !xReserve.IsPositive() || !yReserve.IsPositive() || !amplification.IsPositive() {
+		panic("invalid input: reserves and amplification must be positive")
+	} else if yIn.Abs().GTE(yReserve) {
+		panic("cannot input more than pool reserves")
+	}
+	if yIn.IsZero() {
+		return osmomath.ZeroDec()
+	}
+
+	reservesWith := func(x, y osmomath.BigDec) []osmomath.BigDec {
+		return append([]osmomath.BigDec{x, y}, remReserves...)
+	}
+	d := solveAmplifiedInvariant(reservesWith(xReserve, yReserve), amplification)
+
+	yFinal := yReserve.Add(yIn)
+	residualAtXReserve := amplifiedCfmmResidual(d, amplification, reservesWith(xReserve, yFinal))
+	targetResidualChange := residualAtXReserve.Sub(amplifiedCfmmResidual(d, amplification, reservesWith(xReserve, yReserve)))
+	// residualChangeFn is increasing in xOut, and equals targetResidualChange when
+	// the reserves after the trade are on the same curve as the reserves before it.
+	residualChangeFn := func(xOut osmomath.BigDec) osmomath.BigDec {
+		return residualAtXReserve.Sub(amplifiedCfmmResidual(d, amplification, reservesWith(xReserve.Sub(xOut), yFinal)))
+	}
+
+	xOutLowerbound, xOutUpperbound := zero, xReserve
+	if yIn.IsNegative() {
+		// x must be added to the pool. Double the amount until it is enough to find an upperbound on it.
+		xOutLowerbound, xOutUpperbound = xReserve.Neg(), zero
+		for i := 0; residualChangeFn(xOutLowerbound).GT(targetResidualChange); i++ {
+			if i == 256 {
+				panic("could not find a bound on the input amount")
+			}
+			xOutLowerbound = xOutLowerbound.MulInt64(2)
+		}
+	}
+
+	// If yIn is positive, we want to under-estimate the amount of xOut.
+	// If yIn is negative, xOut is negative as well, and we want to over-estimate |xOut|.
+	// Both mean rounding xOut down, and since residualChangeFn is increasing in xOut,
+	// rounding its output down.
+	errTolerance := osmomath.ErrTolerance{AdditiveTolerance: sdk.Dec{}, MultiplicativeTolerance: sdk.NewDecWithPrec(1, 12), RoundingDir: osmomath.RoundDown}
+	xOut, err := osmomath.BinarySearchBigDec(residualChangeFn, xOutLowerbound, xOutUpperbound, targetResidualChange, errTolerance, 256)
+	if err != nil {
+		panic(err)
+	}
+
+	if xOut.Abs().GTE(xReserve) {
+		panic("invalid output: greater than full pool reserves")
+	}
+	return xOut
+}
+
 func (p Pool) spotPrice(quoteDenom, baseDenom string) (spotPrice sdk.Dec, err error) {
 	// Define f_{y -> x}(a) as the function that outputs the amount of tokens X you'd get by
 	// trading "a" units of Y against the pool, assuming 0 swap fee, at the current liquidity.
@@ -394,7 +518,7 @@ func (p Pool) calcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, swapFee
 	ammIn := tokenInDec.Mul(oneMinus(swapFee))
 	// We are solving for the amount of token out, hence x = tokenOutSupply, y = tokenInSupply
 	// fmt.Printf("outSupply %s, inSupply %s, remReservs %s, ammIn %s\n ", tokenOutSupply, tokenInSupply, remReserves, ammIn)
-	cfmmOut := solveCfmm(tokenOutSupply, tokenInSupply, remReserves, ammIn)
+	cfmmOut := solveCfmmWithAmplification(tokenOutSupply, tokenInSupply, remReserves, ammIn, p.PoolParams.Amplification)
 	// fmt.Println("cfmmout ", cfmmOut)
 	outAmt := p.getDescaledPoolAmt(tokenOutDenom, cfmmOut)
 	return outAmt, nil
@@ -415,7 +539,7 @@ func (p *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, swapFee
 
 	// We are solving for the amount of token in, cfmm(x,y) = cfmm(x + x_in, y - y_out)
 	// x = tokenInSupply, y = tokenOutSupply, yIn = -tokenOutAmount
-	cfmmIn := solveCfmm(tokenInSupply, tokenOutSupply, remReserves, tokenOutAmount.Neg())
+	cfmmIn := solveCfmmWithAmplification(tokenInSupply, tokenOutSupply, remReserves, tokenOutAmount.Neg(), p.PoolParams.Amplification)
 	// returned cfmmIn is negative, representing we need to add this many tokens to pool.
 	// We invert that negative here.
 	cfmmIn = cfmmIn.Neg()
@@ -477,6 +601,117 @@ func (p *Pool) singleAssetJoinSwapFeeRatio(tokenInDenom string) (sdk.Dec, error)
 	return nonInternalAssetRatio, nil
 }
 
+// exitSwapShareAmountIn exits the pool with shareInAmount LP shares, and then swaps every exited token
+// other than tokenOutDenom back into the pool for tokenOutDenom (mutates pool state).
+// Thanks to CFMM path-independence, this moves the pool along its curve to the same reserves as exiting
+// directly into tokenOutDenom, with the swap fee only charged on the part of the exit that is not proportional.
+// Every swap rounds its output down, in favor of the pool.
+func (p *Pool) exitSwapShareAmountIn(ctx sdk.Context, tokenOutDenom string, shareInAmount sdk.Int) (tokenOutAmount sdk.Int, err error) {
+	if p.PoolLiquidity.AmountOfNoDenomValidation(tokenOutDenom).IsZero() {
+		return sdk.Int{}, types.DenomNotInPoolError{PoolId: p.Id, Denom: tokenOutDenom}
+	}
+
+	exitedCoins, err := p.ExitPool(ctx, shareInAmount, p.GetExitFee(ctx))
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	swapFee := p.GetSwapFee(ctx)
+	tokenOutAmount = exitedCoins.AmountOfNoDenomValidation(tokenOutDenom)
+	for _, coin := range exitedCoins {
+		if coin.Denom == tokenOutDenom {
+			continue
+		}
+		// The CFMM solvers can not swap in more than the pool's reserves of the token in.
+		if coin.Amount.GTE(p.PoolLiquidity.AmountOf(coin.Denom)) {
+			return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox,
+				"cannot swap exited %s back into the pool, as it exceeds the pool's remaining liquidity", coin)
+		}
+		swapOutAmountDec, err := p.calcOutAmtGivenIn(coin, tokenOutDenom, swapFee)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		swapOutAmount := swapOutAmountDec.TruncateInt()
+		p.updatePoolLiquidityForSwap(sdk.NewCoins(coin), sdk.NewCoins(sdk.NewCoin(tokenOutDenom, swapOutAmount)))
+		tokenOutAmount = tokenOutAmount.Add(swapOutAmount)
+	}
+
+	if err := validatePoolLiquidity(p.PoolLiquidity, p.ScalingFactors); err != nil {
+		return sdk.Int{}, err
+	}
+
+	return tokenOutAmount, nil
+}
+
+// maxExitSharesSearchIterations bounds the binary search of calcSingleAssetExitShares.
+// The search halves the share range every iteration, so this is exact for any upperbound below 2^128 shares.
+const maxExitSharesSearchIterations = 128
+
+// calcSingleAssetExitShares returns the least number of LP shares that yield at least tokenOut
+// when exited into tokenOut.Denom with exitSwapShareAmountIn (non-mutative).
+//
+// Exiting more shares never yields fewer tokens, so we binary search over the number of shares.
+// A proportional exit of upperbound = totalShares * tokenOut / (tokenOutLiquidity * (1 - exit fee))
+// shares already yields tokenOut before swapping any of the other exited tokens, so the search
+// starts from [0, upperbound], with one extra share to account for rounding down the exited tokens.
+//
+// Every simulated exit runs a CFMM solve per swapped asset, so each one consumes
+// StableswapGasFeeForExitSharesSearch gas, and the search stops after maxExitSharesSearchIterations.
+// Stopping early returns the current upperbound, which still yields at least tokenOut.
+func (p *Pool) calcSingleAssetExitShares(ctx sdk.Context, tokenOut sdk.Coin) (sdk.Int, error) {
+	tokenOutLiquidity := p.PoolLiquidity.AmountOfNoDenomValidation(tokenOut.Denom)
+	if tokenOutLiquidity.IsZero() {
+		return sdk.Int{}, types.DenomNotInPoolError{PoolId: p.Id, Denom: tokenOut.Denom}
+	}
+	if !tokenOut.Amount.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrNotPositiveRequireAmount, "token out amount must be positive, got %s", tokenOut.Amount)
+	}
+
+	totalShares := p.GetTotalShares()
+	exitedTokenOutPerShare := tokenOutLiquidity.ToDec().Mul(sdk.OneDec().Sub(p.GetExitFee(ctx))).QuoInt(totalShares)
+	sharesUpperbound := tokenOut.Amount.ToDec().Quo(exitedTokenOutPerShare).Ceil().TruncateInt().AddRaw(1)
+	if sharesUpperbound.GTE(totalShares) {
+		sharesUpperbound = totalShares.SubRaw(1)
+	}
+
+	// yieldsTokenOut returns whether exiting sharesIn into tokenOut.Denom yields at least tokenOut.
+	yieldsTokenOut := func(sharesIn sdk.Int) (bool, error) {
+		ctx.GasMeter().ConsumeGas(types.StableswapGasFeeForExitSharesSearch, "stableswap single asset exit shares search")
+		pCopy := p.Copy()
+		tokenOutAmount, err := pCopy.exitSwapShareAmountIn(ctx, tokenOut.Denom, sharesIn)
+		if err != nil {
+			return false, err
+		}
+		return tokenOutAmount.GTE(tokenOut.Amount), nil
+	}
+
+	ok, err := yieldsTokenOut(sharesUpperbound)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if !ok {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "pool does not have enough liquidity to exit %s", tokenOut)
+	}
+
+	// Invariant: exiting sharesLowerbound shares yields less than tokenOut,
+	// and exiting sharesUpperbound shares yields at least tokenOut.
+	sharesLowerbound := sdk.ZeroInt()
+	for i := 0; i < maxExitSharesSearchIterations && sharesUpperbound.Sub(sharesLowerbound).GT(sdk.OneInt()); i++ {
+		sharesMid := sharesLowerbound.Add(sharesUpperbound).QuoRaw(2)
+		ok, err := yieldsTokenOut(sharesMid)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		if ok {
+			sharesUpperbound = sharesMid
+		} else {
+			sharesLowerbound = sharesMid
+		}
+	}
+
+	return sharesUpperbound, nil
+}
+
 // Route a pool join attempt to either a single-asset join or all-asset join (mutates pool state)
 // Eventually, we intend to switch this to a COW wrapped pa for better performance
 func (p *Pool) joinPoolSharesInternal(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, tokensJoined sdk.Coins, err error) {
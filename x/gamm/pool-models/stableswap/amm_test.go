@@ -523,6 +523,87 @@ func TestCFMMInvariantMultiAssetsBinarySearch(t *testing.T) {
 	}
 }
 
+func TestAmplifiedCFMMInvariant(t *testing.T) {
+	tests := map[string]CFMMTestCase{
+		"small pool small input": {
+			xReserve: osmomath.NewBigDec(100),
+			yReserve: osmomath.NewBigDec(100),
+			yIn:      osmomath.NewBigDec(1),
+		},
+		"small pool large input": {
+			xReserve: osmomath.NewBigDec(100),
+			yReserve: osmomath.NewBigDec(100),
+			yIn:      osmomath.NewBigDec(99),
+		},
+		"large pool tiny input": {
+			xReserve: osmomath.NewBigDec(1_000_000_000_000),
+			yReserve: osmomath.NewBigDec(1_000_000_000_000),
+			yIn:      osmomath.NewBigDec(1),
+		},
+		"uneven pool output": {
+			xReserve: osmomath.NewBigDec(1_000_000),
+			yReserve: osmomath.NewBigDec(3_000_000),
+			yIn:      osmomath.NewBigDec(-500_000),
+		},
+		"three asset pool": {
+			xReserve:    osmomath.NewBigDec(1_000_000),
+			yReserve:    osmomath.NewBigDec(2_000_000),
+			remReserves: []osmomath.BigDec{osmomath.NewBigDec(1_500_000)},
+			yIn:         osmomath.NewBigDec(500_000),
+		},
+		"four asset pool output": {
+			xReserve:    osmomath.NewBigDec(1_000_000),
+			yReserve:    osmomath.NewBigDec(1_000_000),
+			remReserves: []osmomath.BigDec{osmomath.NewBigDec(1_000_000), osmomath.NewBigDec(1_000_000)},
+			yIn:         osmomath.NewBigDec(-50),
+		},
+		"input greater than pool reserves": {
+			xReserve:    osmomath.NewBigDec(100),
+			yReserve:    osmomath.NewBigDec(100),
+			yIn:         osmomath.NewBigDec(101),
+			expectPanic: true,
+		},
+	}
+
+	for name, test := range tests {
+		for _, amplification := range []int64{1, 100, MaxAmplification} {
+			t.Run(fmt.Sprintf("%s, amplification %d", name, amplification), func(t *testing.T) {
+				sut := func() {
+					amplification := osmomath.NewBigDec(amplification)
+					reservesBefore := append([]osmomath.BigDec{test.xReserve, test.yReserve}, test.remReserves...)
+					xOut := solveAmplifiedCFMMBinarySearch(test.xReserve, test.yReserve, test.remReserves, test.yIn, amplification)
+					reservesAfter := append([]osmomath.BigDec{test.xReserve.Sub(xOut), test.yReserve.Add(test.yIn)}, test.remReserves...)
+
+					// x moves opposite to y
+					require.Equal(t, test.yIn.IsPositive(), xOut.IsPositive())
+
+					// the trade keeps the reserves on the same curve
+					d0 := solveAmplifiedInvariant(reservesBefore, amplification)
+					d1 := solveAmplifiedInvariant(reservesAfter, amplification)
+					osmomath.DecApproxEq(t, d0, d1, d0.Mul(osmomath.NewDecWithPrec(1, 9)))
+				}
+
+				osmoassert.ConditionalPanic(t, test.expectPanic, sut)
+			})
+		}
+	}
+}
+
+// TestAmplificationFlattensCurve tests that, around the balanced point, every increase
+// in amplification gives a better price than the solidly curve and lower amplifications.
+func TestAmplificationFlattensCurve(t *testing.T) {
+	reserve := osmomath.NewBigDec(1_000_000)
+	yIn := osmomath.NewBigDec(100_000)
+
+	prevXOut := solveCfmmWithAmplification(reserve, reserve, []osmomath.BigDec{}, yIn, 0)
+	for _, amplification := range []uint64{1, 10, 100, 1000, MaxAmplification} {
+		xOut := solveCfmmWithAmplification(reserve, reserve, []osmomath.BigDec{}, yIn, amplification)
+		require.True(t, xOut.GT(prevXOut), "amplification %d: %s <= %s", amplification, xOut, prevXOut)
+		require.True(t, xOut.LT(yIn), "amplification %d: %s >= %s", amplification, xOut, yIn)
+		prevXOut = xOut
+	}
+}
+
 func (suite *StableSwapTestSuite) Test_StableSwap_CalculateAmountOutAndIn_InverseRelationship() {
 	type testcase struct {
 		denomOut       string
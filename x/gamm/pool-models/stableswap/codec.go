@@ -18,6 +18,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Pool{}, "osmosis/gamm/StableswapPool", nil)
 	cdc.RegisterConcrete(&MsgCreateStableswapPool{}, "osmosis/gamm/create-stableswap-pool", nil)
 	cdc.RegisterConcrete(&MsgStableSwapAdjustScalingFactors{}, "osmosis/gamm/stableswap-adjust-scaling-factors", nil)
+	cdc.RegisterConcrete(&MsgStableSwapSetScalingFactorRateProvider{}, "osmosis/gamm/set-rate-provider", nil)
+	cdc.RegisterConcrete(&MsgStableSwapRemoveScalingFactorRateProvider{}, "osmosis/gamm/remove-rate-provider", nil)
+	cdc.RegisterConcrete(&MsgStableSwapRampAmplification{}, "osmosis/gamm/ramp-amplification", nil)
 	cdc.RegisterConcrete(&PoolParams{}, "osmosis/gamm/StableswapPoolParams", nil)
 }
 
@@ -36,6 +39,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreateStableswapPool{},
 		&MsgStableSwapAdjustScalingFactors{},
+		&MsgStableSwapSetScalingFactorRateProvider{},
+		&MsgStableSwapRemoveScalingFactorRateProvider{},
+		&MsgStableSwapRampAmplification{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -1,6 +1,9 @@
 package stableswap
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -9,8 +12,11 @@ import (
 )
 
 const (
-	TypeMsgCreateStableswapPool           = "create_stableswap_pool"
-	TypeMsgStableSwapAdjustScalingFactors = "stable_swap_adjust_scaling_factors"
+	TypeMsgCreateStableswapPool            = "create_stableswap_pool"
+	TypeMsgStableSwapAdjustScalingFactors  = "stable_swap_adjust_scaling_factors"
+	TypeMsgSetScalingFactorRateProvider    = "stable_swap_set_scaling_factor_rate_provider"
+	TypeMsgRemoveScalingFactorRateProvider = "stable_swap_remove_scaling_factor_rate_provider"
+	TypeMsgRampAmplification               = "stable_swap_ramp_amplification"
 )
 
 var (
@@ -168,3 +174,138 @@ func (msg MsgStableSwapAdjustScalingFactors) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{scalingFactorGovernor}
 }
+
+var _ sdk.Msg = &MsgStableSwapSetScalingFactorRateProvider{}
+
+func NewMsgStableSwapSetScalingFactorRateProvider(
+	sender string,
+	rateProvider types.ScalingFactorRateProvider,
+) MsgStableSwapSetScalingFactorRateProvider {
+	return MsgStableSwapSetScalingFactorRateProvider{
+		Sender:       sender,
+		RateProvider: rateProvider,
+	}
+}
+
+func (msg MsgStableSwapSetScalingFactorRateProvider) Route() string { return types.RouterKey }
+func (msg MsgStableSwapSetScalingFactorRateProvider) Type() string {
+	return TypeMsgSetScalingFactorRateProvider
+}
+
+func (msg MsgStableSwapSetScalingFactorRateProvider) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return msg.RateProvider.Validate()
+}
+
+func (msg MsgStableSwapSetScalingFactorRateProvider) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapSetScalingFactorRateProvider) GetSigners() []sdk.AccAddress {
+	scalingFactorController, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorController}
+}
+
+var _ sdk.Msg = &MsgStableSwapRemoveScalingFactorRateProvider{}
+
+func NewMsgStableSwapRemoveScalingFactorRateProvider(
+	sender string,
+	poolID uint64,
+) MsgStableSwapRemoveScalingFactorRateProvider {
+	return MsgStableSwapRemoveScalingFactorRateProvider{
+		Sender: sender,
+		PoolID: poolID,
+	}
+}
+
+func (msg MsgStableSwapRemoveScalingFactorRateProvider) Route() string { return types.RouterKey }
+func (msg MsgStableSwapRemoveScalingFactorRateProvider) Type() string {
+	return TypeMsgRemoveScalingFactorRateProvider
+}
+
+func (msg MsgStableSwapRemoveScalingFactorRateProvider) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.PoolID == 0 {
+		return fmt.Errorf("pool id must be positive")
+	}
+
+	return nil
+}
+
+func (msg MsgStableSwapRemoveScalingFactorRateProvider) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapRemoveScalingFactorRateProvider) GetSigners() []sdk.AccAddress {
+	scalingFactorController, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorController}
+}
+
+var _ sdk.Msg = &MsgStableSwapRampAmplification{}
+
+func NewMsgStableSwapRampAmplification(
+	sender string,
+	poolID uint64,
+	targetAmplification uint64,
+	duration time.Duration,
+) MsgStableSwapRampAmplification {
+	return MsgStableSwapRampAmplification{
+		Sender:              sender,
+		PoolID:              poolID,
+		TargetAmplification: targetAmplification,
+		Duration:            duration,
+	}
+}
+
+func (msg MsgStableSwapRampAmplification) Route() string { return types.RouterKey }
+func (msg MsgStableSwapRampAmplification) Type() string  { return TypeMsgRampAmplification }
+
+func (msg MsgStableSwapRampAmplification) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.PoolID == 0 {
+		return fmt.Errorf("pool id must be positive")
+	}
+
+	if msg.TargetAmplification > MaxAmplification {
+		return types.ErrTooMuchAmplification
+	}
+
+	if msg.Duration < MinAmplificationRampDuration {
+		return sdkerrors.Wrapf(types.ErrAmplificationRampTooShort, "duration must be at least %s, was %s", MinAmplificationRampDuration, msg.Duration)
+	}
+
+	return nil
+}
+
+func (msg MsgStableSwapRampAmplification) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapRampAmplification) GetSigners() []sdk.AccAddress {
+	poolGovernor, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{poolGovernor}
+}
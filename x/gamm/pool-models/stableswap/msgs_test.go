@@ -2,6 +2,7 @@ package stableswap_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -296,6 +297,177 @@ func TestMsgCreateStableswapPoolValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgStableSwapSetScalingFactorRateProviderValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	contractAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	baseRateProvider := func() types.ScalingFactorRateProvider {
+		return types.ScalingFactorRateProvider{
+			PoolId:            1,
+			RateDenom:         "stuatom",
+			ReferenceDenom:    "uatom",
+			EpochIdentifier:   "day",
+			TwapSource:        &types.TwapRateSource{PoolId: 2, Window: time.Hour},
+			MaxChangePerEpoch: sdk.NewDecWithPrec(1, 2),
+			RampEpochs:        3,
+		}
+	}
+
+	default_msg := stableswap.NewMsgStableSwapSetScalingFactorRateProvider(addr1.String(), baseRateProvider())
+	require.Equal(t, default_msg.Route(), types.RouterKey)
+	require.Equal(t, default_msg.Type(), "stable_swap_set_scaling_factor_rate_provider")
+	signers := default_msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := map[string]struct {
+		update     func(msg *stableswap.MsgStableSwapSetScalingFactorRateProvider)
+		expectPass bool
+	}{
+		"twap rate source": {
+			update:     func(msg *stableswap.MsgStableSwapSetScalingFactorRateProvider) {},
+			expectPass: true,
+		},
+		"contract rate source": {
+			update: func(msg *stableswap.MsgStableSwapSetScalingFactorRateProvider) {
+				msg.RateProvider.TwapSource = nil
+				msg.RateProvider.ContractAddress = contractAddr.String()
+			},
+			expectPass: true,
+		},
+		"invalid sender": {
+			update: func(msg *stableswap.MsgStableSwapSetScalingFactorRateProvider) {
+				msg.Sender = "invalid"
+			},
+		},
+		"zero pool id": {
+			update: func(msg *stableswap.MsgStableSwapSetScalingFactorRateProvider) {
+				msg.RateProvider.PoolId = 0
+			},
+		},
+		"same rate and reference denom": {
+			update: func(msg *stableswap.MsgStableSwapSetScalingFactorRateProvider) {
+				msg.RateProvider.ReferenceDenom = msg.RateProvider.RateDenom
+			},
+		},
+		"empty epoch identifier": {
+			update: func(msg *stableswap.MsgStableSwapSetScalingFactorRateProvider) {
+				msg.RateProvider.EpochIdentifier = ""
+			},
+		},
+		"no rate source": {
+			update: func(msg *stableswap.MsgStableSwapSetScalingFactorRateProvider) {
+				msg.RateProvider.TwapSource = nil
+			},
+		},
+		"both rate sources": {
+			update: func(msg *stableswap.MsgStableSwapSetScalingFactorRateProvider) {
+				msg.RateProvider.ContractAddress = contractAddr.String()
+			},
+		},
+		"zero twap window": {
+			update: func(msg *stableswap.MsgStableSwapSetScalingFactorRateProvider) {
+				msg.RateProvider.TwapSource.Window = 0
+			},
+		},
+		"invalid contract address": {
+			update: func(msg *stableswap.MsgStableSwapSetScalingFactorRateProvider) {
+				msg.RateProvider.TwapSource = nil
+				msg.RateProvider.ContractAddress = "invalid"
+			},
+		},
+		"zero max change per epoch": {
+			update: func(msg *stableswap.MsgStableSwapSetScalingFactorRateProvider) {
+				msg.RateProvider.MaxChangePerEpoch = sdk.ZeroDec()
+			},
+		},
+		"zero ramp epochs": {
+			update: func(msg *stableswap.MsgStableSwapSetScalingFactorRateProvider) {
+				msg.RateProvider.RampEpochs = 0
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg := stableswap.NewMsgStableSwapSetScalingFactorRateProvider(addr1.String(), baseRateProvider())
+			tc.update(&msg)
+			if tc.expectPass {
+				require.NoError(t, msg.ValidateBasic())
+			} else {
+				require.Error(t, msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgStableSwapRampAmplificationValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	default_msg := stableswap.NewMsgStableSwapRampAmplification(addr1.String(), 1, 200, stableswap.MinAmplificationRampDuration)
+	require.Equal(t, default_msg.Route(), types.RouterKey)
+	require.Equal(t, default_msg.Type(), "stable_swap_ramp_amplification")
+	signers := default_msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := map[string]struct {
+		update     func(msg *stableswap.MsgStableSwapRampAmplification)
+		expectPass bool
+	}{
+		"valid ramp": {
+			update:     func(msg *stableswap.MsgStableSwapRampAmplification) {},
+			expectPass: true,
+		},
+		"ramp to solidly curve": {
+			update: func(msg *stableswap.MsgStableSwapRampAmplification) {
+				msg.TargetAmplification = 0
+			},
+			expectPass: true,
+		},
+		"ramp to max amplification": {
+			update: func(msg *stableswap.MsgStableSwapRampAmplification) {
+				msg.TargetAmplification = stableswap.MaxAmplification
+			},
+			expectPass: true,
+		},
+		"invalid sender": {
+			update: func(msg *stableswap.MsgStableSwapRampAmplification) {
+				msg.Sender = "invalid"
+			},
+		},
+		"zero pool id": {
+			update: func(msg *stableswap.MsgStableSwapRampAmplification) {
+				msg.PoolID = 0
+			},
+		},
+		"amplification too large": {
+			update: func(msg *stableswap.MsgStableSwapRampAmplification) {
+				msg.TargetAmplification = stableswap.MaxAmplification + 1
+			},
+		},
+		"duration too short": {
+			update: func(msg *stableswap.MsgStableSwapRampAmplification) {
+				msg.Duration = stableswap.MinAmplificationRampDuration - time.Second
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg := stableswap.NewMsgStableSwapRampAmplification(addr1.String(), 1, 200, stableswap.MinAmplificationRampDuration)
+			tc.update(&msg)
+			if tc.expectPass {
+				require.NoError(t, msg.ValidateBasic())
+			} else {
+				require.Error(t, msg.ValidateBasic())
+			}
+		})
+	}
+}
+
 func (suite *TestSuite) TestMsgCreateStableswapPool() {
 	suite.SetupTest()
 
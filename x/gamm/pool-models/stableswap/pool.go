@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -15,8 +16,10 @@ import (
 )
 
 var (
-	_ poolmanagertypes.PoolI = &Pool{}
-	_ types.CFMMPoolI        = &Pool{}
+	_ poolmanagertypes.PoolI             = &Pool{}
+	_ types.CFMMPoolI                    = &Pool{}
+	_ types.PokablePoolExtension         = &Pool{}
+	_ types.SingleAssetExitPoolExtension = &Pool{}
 )
 
 // NewStableswapPool returns a stableswap pool
@@ -390,6 +393,45 @@ func (p Pool) CalcExitPoolCoinsFromShares(ctx sdk.Context, exitingShares sdk.Int
 	return cfmm_common.CalcExitPool(ctx, &p, exitingShares, exitFee)
 }
 
+// ExitSwapShareAmountIn exits the pool with an exact amount of LP shares (shareInAmount),
+// and returns all of the exited liquidity as a single asset (tokenOutDenom).
+func (p *Pool) ExitSwapShareAmountIn(ctx sdk.Context, tokenOutDenom string, shareInAmount sdk.Int) (tokenOutAmount sdk.Int, err error) {
+	pCopy := p.Copy()
+	tokenOutAmount, err = pCopy.exitSwapShareAmountIn(ctx, tokenOutDenom, shareInAmount)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if !tokenOutAmount.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive, got %v", tokenOutAmount)
+	}
+
+	*p = pCopy
+	return tokenOutAmount, nil
+}
+
+// ExitSwapExactAmountOut exits the pool into an exact amount of a single asset (tokenOut),
+// charging the least number of LP shares that yield at least tokenOut when exited with ExitSwapShareAmountIn.
+// Returns an error if more than shareInMaxAmount LP shares would be charged.
+func (p *Pool) ExitSwapExactAmountOut(ctx sdk.Context, tokenOut sdk.Coin, shareInMaxAmount sdk.Int) (shareInAmount sdk.Int, err error) {
+	shareInAmount, err = p.calcSingleAssetExitShares(ctx, tokenOut)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if shareInAmount.GT(shareInMaxAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "%s resulted shares is larger than the max amount of %s", shareInAmount, shareInMaxAmount)
+	}
+
+	tokensOut := sdk.NewCoins(tokenOut)
+	if err := validatePoolLiquidity(p.PoolLiquidity.Sub(tokensOut), p.ScalingFactors); err != nil {
+		return sdk.Int{}, err
+	}
+
+	p.updatePoolLiquidityForExit(tokensOut, shareInAmount)
+	return shareInAmount, nil
+}
+
 // SetScalingFactors sets scaling factors for pool to the given amount
 // It should only be able to be successfully called by the pool's ScalingFactorGovernor
 // TODO: move commented test for this function from x/gamm/keeper/pool_service_test.go once a pool_test.go file has been created for stableswap
@@ -398,6 +440,12 @@ func (p *Pool) SetScalingFactors(ctx sdk.Context, scalingFactors []uint64, sende
 		return types.ErrNotScalingFactorGovernor
 	}
 
+	return p.ApplyScalingFactors(scalingFactors)
+}
+
+// ApplyScalingFactors validates and sets the pool's scaling factors to the given amount,
+// without checking who requested the change. Callers are responsible for authorizing the update.
+func (p *Pool) ApplyScalingFactors(scalingFactors []uint64) error {
 	scalingFactors, err := applyScalingFactorMultiplier(scalingFactors)
 	if err != nil {
 		return err
@@ -415,6 +463,61 @@ func (p *Pool) SetScalingFactors(ctx sdk.Context, scalingFactors []uint64, sende
 	return nil
 }
 
+// RampAmplification linearly changes the pool's amplification from its current value at blockTime
+// to targetAmplification over the given duration, replacing any ramp in progress.
+// It should only be able to be successfully called by the pool's future governor.
+func (p *Pool) RampAmplification(targetAmplification uint64, duration time.Duration, sender string, blockTime time.Time) error {
+	if p.FuturePoolGovernor == "" || sender != p.FuturePoolGovernor {
+		return types.ErrNotPoolGovernor
+	}
+
+	if targetAmplification > MaxAmplification {
+		return types.ErrTooMuchAmplification
+	}
+
+	if duration < MinAmplificationRampDuration {
+		return sdkerrors.Wrapf(types.ErrAmplificationRampTooShort, "duration must be at least %s, was %s", MinAmplificationRampDuration, duration)
+	}
+
+	// Start the new ramp from the amplification the pool has now.
+	p.PokePool(blockTime)
+	p.AmplificationRamp = &AmplificationRamp{
+		InitialAmplification: p.PoolParams.Amplification,
+		TargetAmplification:  targetAmplification,
+		StartTime:            blockTime,
+		Duration:             duration,
+	}
+	return nil
+}
+
+// PokePool updates the pool's amplification to its value at blockTime, if the amplification is being ramped.
+//
+// The amplification A(t) at time `t` is:
+//   - t <= start_time: A(t) = initial_amplification
+//   - start_time < t < start_time + duration:
+//     A(t) = initial_amplification + (t - start_time) * (target_amplification - initial_amplification) / duration
+//   - t >= start_time + duration: A(t) = target_amplification, and the ramp is removed.
+func (p *Pool) PokePool(blockTime time.Time) {
+	ramp := p.AmplificationRamp
+	if ramp == nil {
+		return
+	}
+
+	elapsed := blockTime.Sub(ramp.StartTime)
+	switch {
+	case elapsed <= 0:
+		p.PoolParams.Amplification = ramp.InitialAmplification
+	case elapsed >= ramp.Duration:
+		p.PoolParams.Amplification = ramp.TargetAmplification
+		p.AmplificationRamp = nil
+	default:
+		initial := sdk.NewIntFromUint64(ramp.InitialAmplification)
+		change := sdk.NewIntFromUint64(ramp.TargetAmplification).Sub(initial)
+		elapsedChange := change.MulRaw(elapsed.Milliseconds()).QuoRaw(ramp.Duration.Milliseconds())
+		p.PoolParams.Amplification = initial.Add(elapsedChange).Uint64()
+	}
+}
+
 func validateScalingFactorController(scalingFactorController string) error {
 	if len(scalingFactorController) == 0 {
 		return nil
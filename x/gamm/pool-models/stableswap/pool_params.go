@@ -1,11 +1,21 @@
 package stableswap
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 )
 
+const (
+	// MaxAmplification is the largest amplification a stableswap pool can have.
+	MaxAmplification = 1_000_000
+	// MinAmplificationRampDuration is the shortest duration a pool's amplification can be ramped over,
+	// so that the pool's curve can not move faster than arbitrage can follow it.
+	MinAmplificationRampDuration = 24 * time.Hour
+)
+
 func (params PoolParams) Validate() error {
 	if params.ExitFee.IsNegative() {
 		return types.ErrNegativeExitFee
@@ -22,5 +32,9 @@ func (params PoolParams) Validate() error {
 	if params.SwapFee.GTE(sdk.OneDec()) {
 		return types.ErrTooMuchSwapFee
 	}
+
+	if params.Amplification > MaxAmplification {
+		return types.ErrTooMuchAmplification
+	}
 	return nil
 }
@@ -3,6 +3,7 @@ package stableswap
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -1052,6 +1053,181 @@ func TestExitPool(t *testing.T) {
 	}
 }
 
+func TestExitSwapShareAmountIn(t *testing.T) {
+	tests := map[string]struct {
+		sharesIn             sdk.Int
+		initialPoolLiquidity sdk.Coins
+		scalingFactors       []uint64
+		amplification        uint64
+		tokenOutDenom        string
+		expectedErr          error
+	}{
+		"two-asset pool exit on even pool": {
+			sharesIn:             types.InitPoolSharesSupply.QuoRaw(10),
+			initialPoolLiquidity: twoEvenStablePoolAssets,
+			scalingFactors:       defaultTwoAssetScalingFactors,
+			tokenOutDenom:        "foo",
+		},
+		"three-asset pool exit on even pool": {
+			sharesIn:             types.InitPoolSharesSupply.QuoRaw(10),
+			initialPoolLiquidity: threeEvenStablePoolAssets,
+			scalingFactors:       defaultThreeAssetScalingFactors,
+			tokenOutDenom:        "asset/b",
+		},
+		"three-asset pool exit on uneven pool into scarcest asset": {
+			sharesIn:             types.InitPoolSharesSupply.QuoRaw(10),
+			initialPoolLiquidity: threeUnevenStablePoolAssets,
+			scalingFactors:       defaultThreeAssetScalingFactors,
+			tokenOutDenom:        "asset/a",
+		},
+		"three-asset pool exit on amplified pool": {
+			sharesIn:             types.InitPoolSharesSupply.QuoRaw(10),
+			initialPoolLiquidity: threeEvenStablePoolAssets,
+			scalingFactors:       defaultThreeAssetScalingFactors,
+			amplification:        100,
+			tokenOutDenom:        "asset/c",
+		},
+		"five-asset pool exit on uneven pool": {
+			sharesIn:             types.InitPoolSharesSupply.QuoRaw(100),
+			initialPoolLiquidity: fiveUnevenStablePoolAssets,
+			scalingFactors:       defaultFiveAssetScalingFactors,
+			tokenOutDenom:        "asset/e",
+		},
+		"token out denom not in pool": {
+			sharesIn:             types.InitPoolSharesSupply.QuoRaw(10),
+			initialPoolLiquidity: threeEvenStablePoolAssets,
+			scalingFactors:       defaultThreeAssetScalingFactors,
+			tokenOutDenom:        "foo",
+			expectedErr:          types.DenomNotInPoolError{PoolId: defaultPoolId, Denom: "foo"},
+		},
+		"exiting all shares": {
+			sharesIn:             types.InitPoolSharesSupply,
+			initialPoolLiquidity: threeEvenStablePoolAssets,
+			scalingFactors:       defaultThreeAssetScalingFactors,
+			tokenOutDenom:        "asset/a",
+			expectedErr:          types.ErrLimitMaxAmount,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}
+			p := poolStructFromAssets(tc.initialPoolLiquidity, tc.scalingFactors)
+			p.PoolParams.Amplification = tc.amplification
+
+			tokenOutAmount, err := p.ExitSwapShareAmountIn(ctx, tc.tokenOutDenom, tc.sharesIn)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Equal(t, tc.initialPoolLiquidity, p.GetTotalPoolLiquidity(ctx))
+				require.Equal(t, types.InitPoolSharesSupply, p.GetTotalShares())
+				return
+			}
+			require.NoError(t, err)
+
+			// Only the token out leaves the pool.
+			expectedPoolLiquidity := tc.initialPoolLiquidity.Sub(sdk.NewCoins(sdk.NewCoin(tc.tokenOutDenom, tokenOutAmount)))
+			require.Equal(t, expectedPoolLiquidity, p.GetTotalPoolLiquidity(ctx))
+			require.Equal(t, types.InitPoolSharesSupply.Sub(tc.sharesIn), p.GetTotalShares())
+
+			// By path-independence, the exit yields as much as exiting into all assets,
+			// and swapping them against the pool into the token out.
+			expectedPool := poolStructFromAssets(tc.initialPoolLiquidity, tc.scalingFactors)
+			expectedPool.PoolParams.Amplification = tc.amplification
+			exitedCoins, err := expectedPool.ExitPool(ctx, tc.sharesIn, defaultExitFee)
+			require.NoError(t, err)
+			expectedTokenOutAmount, err := cfmm_common.SwapAllCoinsToSingleAsset(&expectedPool, ctx, exitedCoins, tc.tokenOutDenom, defaultSwapFee)
+			require.NoError(t, err)
+			require.Equal(t, expectedTokenOutAmount, tokenOutAmount)
+		})
+	}
+}
+
+func TestExitSwapExactAmountOut(t *testing.T) {
+	tests := map[string]struct {
+		tokenOut             sdk.Coin
+		shareInMaxAmount     sdk.Int
+		initialPoolLiquidity sdk.Coins
+		scalingFactors       []uint64
+		amplification        uint64
+		expectedErr          error
+	}{
+		"two-asset pool exit on even pool": {
+			tokenOut:             sdk.NewInt64Coin("foo", 100000000),
+			shareInMaxAmount:     types.InitPoolSharesSupply,
+			initialPoolLiquidity: twoEvenStablePoolAssets,
+			scalingFactors:       defaultTwoAssetScalingFactors,
+		},
+		"three-asset pool exit on uneven pool": {
+			tokenOut:             sdk.NewInt64Coin("asset/c", 500000),
+			shareInMaxAmount:     types.InitPoolSharesSupply,
+			initialPoolLiquidity: threeUnevenStablePoolAssets,
+			scalingFactors:       defaultThreeAssetScalingFactors,
+		},
+		"three-asset pool exit on amplified pool": {
+			tokenOut:             sdk.NewInt64Coin("asset/a", 200000),
+			shareInMaxAmount:     types.InitPoolSharesSupply,
+			initialPoolLiquidity: threeEvenStablePoolAssets,
+			scalingFactors:       defaultThreeAssetScalingFactors,
+			amplification:        100,
+		},
+		"more shares than share in max amount": {
+			tokenOut:             sdk.NewInt64Coin("asset/a", 200000),
+			shareInMaxAmount:     types.InitPoolSharesSupply.QuoRaw(100),
+			initialPoolLiquidity: threeEvenStablePoolAssets,
+			scalingFactors:       defaultThreeAssetScalingFactors,
+			expectedErr:          types.ErrLimitMaxAmount,
+		},
+		"token out exceeds pool liquidity": {
+			tokenOut:             sdk.NewInt64Coin("asset/a", 1000000),
+			shareInMaxAmount:     types.InitPoolSharesSupply,
+			initialPoolLiquidity: threeEvenStablePoolAssets,
+			scalingFactors:       defaultThreeAssetScalingFactors,
+			expectedErr:          types.ErrInvalidMathApprox,
+		},
+		"token out denom not in pool": {
+			tokenOut:             sdk.NewInt64Coin("foo", 1000),
+			shareInMaxAmount:     types.InitPoolSharesSupply,
+			initialPoolLiquidity: threeEvenStablePoolAssets,
+			scalingFactors:       defaultThreeAssetScalingFactors,
+			expectedErr:          types.DenomNotInPoolError{PoolId: defaultPoolId, Denom: "foo"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+			p := poolStructFromAssets(tc.initialPoolLiquidity, tc.scalingFactors)
+			p.PoolParams.Amplification = tc.amplification
+
+			sharesIn, err := p.ExitSwapExactAmountOut(ctx, tc.tokenOut, tc.shareInMaxAmount)
+
+			// The share search charges gas for every simulated exit, and is bounded.
+			require.LessOrEqual(t, ctx.GasMeter().GasConsumed(), uint64(maxExitSharesSearchIterations+1)*types.StableswapGasFeeForExitSharesSearch)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Equal(t, tc.initialPoolLiquidity, p.GetTotalPoolLiquidity(ctx))
+				require.Equal(t, types.InitPoolSharesSupply, p.GetTotalShares())
+				return
+			}
+			require.NoError(t, err)
+			require.Positive(t, ctx.GasMeter().GasConsumed())
+			require.Equal(t, tc.initialPoolLiquidity.Sub(sdk.NewCoins(tc.tokenOut)), p.GetTotalPoolLiquidity(ctx))
+			require.Equal(t, types.InitPoolSharesSupply.Sub(sharesIn), p.GetTotalShares())
+
+			// sharesIn is the least number of shares that yield the token out.
+			exitSwapShareAmountIn := func(sharesIn sdk.Int) sdk.Int {
+				pool := poolStructFromAssets(tc.initialPoolLiquidity, tc.scalingFactors)
+				pool.PoolParams.Amplification = tc.amplification
+				tokenOutAmount, err := pool.ExitSwapShareAmountIn(ctx, tc.tokenOut.Denom, sharesIn)
+				require.NoError(t, err)
+				return tokenOutAmount
+			}
+			require.True(t, exitSwapShareAmountIn(sharesIn).GTE(tc.tokenOut.Amount))
+			require.True(t, exitSwapShareAmountIn(sharesIn.SubRaw(1)).LT(tc.tokenOut.Amount))
+		})
+	}
+}
+
 func TestValidatePoolLiquidity(t *testing.T) {
 	const (
 		a = "aaa"
@@ -1197,6 +1373,149 @@ func TestSetScalingFactors(t *testing.T) {
 	}
 }
 
+func TestRampAmplification(t *testing.T) {
+	governor := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	other := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	startTime := time.Unix(1_000_000, 0).UTC()
+
+	tests := map[string]struct {
+		governor            string
+		sender              string
+		targetAmplification uint64
+		duration            time.Duration
+		expError            error
+	}{
+		"valid ramp": {
+			governor:            governor,
+			sender:              governor,
+			targetAmplification: 200,
+			duration:            MinAmplificationRampDuration,
+		},
+		"valid ramp to solidly curve": {
+			governor:            governor,
+			sender:              governor,
+			targetAmplification: 0,
+			duration:            72 * time.Hour,
+		},
+		"sender is not pool governor": {
+			governor:            governor,
+			sender:              other,
+			targetAmplification: 200,
+			duration:            MinAmplificationRampDuration,
+			expError:            types.ErrNotPoolGovernor,
+		},
+		"pool has no governor": {
+			sender:              governor,
+			targetAmplification: 200,
+			duration:            MinAmplificationRampDuration,
+			expError:            types.ErrNotPoolGovernor,
+		},
+		"amplification too large": {
+			governor:            governor,
+			sender:              governor,
+			targetAmplification: MaxAmplification + 1,
+			duration:            MinAmplificationRampDuration,
+			expError:            types.ErrTooMuchAmplification,
+		},
+		"duration too short": {
+			governor:            governor,
+			sender:              governor,
+			targetAmplification: 200,
+			duration:            MinAmplificationRampDuration - time.Second,
+			expError:            types.ErrAmplificationRampTooShort,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := poolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors)
+			pool.PoolParams.Amplification = 100
+			pool.FuturePoolGovernor = tc.governor
+
+			err := pool.RampAmplification(tc.targetAmplification, tc.duration, tc.sender, startTime)
+			if tc.expError != nil {
+				require.ErrorIs(t, err, tc.expError)
+				require.Nil(t, pool.AmplificationRamp)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, &AmplificationRamp{
+				InitialAmplification: 100,
+				TargetAmplification:  tc.targetAmplification,
+				StartTime:            startTime,
+				Duration:             tc.duration,
+			}, pool.AmplificationRamp)
+		})
+	}
+}
+
+func TestPokePoolAmplification(t *testing.T) {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	duration := 100 * time.Hour
+
+	tests := map[string]struct {
+		initialAmplification  uint64
+		targetAmplification   uint64
+		blockTime             time.Time
+		expectedAmplification uint64
+		expectRampDone        bool
+	}{
+		"at start time": {
+			initialAmplification:  100,
+			targetAmplification:   200,
+			blockTime:             startTime,
+			expectedAmplification: 100,
+		},
+		"increasing ramp, quarter way": {
+			initialAmplification:  100,
+			targetAmplification:   200,
+			blockTime:             startTime.Add(25 * time.Hour),
+			expectedAmplification: 125,
+		},
+		"decreasing ramp, quarter way": {
+			initialAmplification:  200,
+			targetAmplification:   100,
+			blockTime:             startTime.Add(25 * time.Hour),
+			expectedAmplification: 175,
+		},
+		"decreasing ramp rounds towards initial amplification": {
+			initialAmplification:  3,
+			targetAmplification:   0,
+			blockTime:             startTime.Add(50 * time.Hour),
+			expectedAmplification: 2,
+		},
+		"at end time": {
+			initialAmplification:  100,
+			targetAmplification:   200,
+			blockTime:             startTime.Add(duration),
+			expectedAmplification: 200,
+			expectRampDone:        true,
+		},
+		"after end time": {
+			initialAmplification:  100,
+			targetAmplification:   0,
+			blockTime:             startTime.Add(2 * duration),
+			expectedAmplification: 0,
+			expectRampDone:        true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := poolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors)
+			pool.PoolParams.Amplification = tc.initialAmplification
+			pool.AmplificationRamp = &AmplificationRamp{
+				InitialAmplification: tc.initialAmplification,
+				TargetAmplification:  tc.targetAmplification,
+				StartTime:            startTime,
+				Duration:             duration,
+			}
+
+			pool.PokePool(tc.blockTime)
+			require.Equal(t, tc.expectedAmplification, pool.PoolParams.Amplification)
+			require.Equal(t, tc.expectRampDone, pool.AmplificationRamp == nil)
+		})
+	}
+}
+
 func TestStableswapSpotPrice(t *testing.T) {
 	type testcase struct {
 		baseDenom      string
@@ -7,20 +7,23 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,6 +38,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type PoolParams struct {
 	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
 	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
+	// amplification flattens the pool's curve around the balanced point.
+	// 0 is the plain solidly curve, and larger values trade closer to a
+	// constant sum curve, for pegs that are expected to hold tightly.
+	Amplification uint64 `protobuf:"varint,3,opt,name=amplification,proto3" json:"amplification,omitempty" yaml:"amplification"`
 }
 
 func (m *PoolParams) Reset()         { *m = PoolParams{} }
@@ -70,6 +77,84 @@ func (m *PoolParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PoolParams proto.InternalMessageInfo
 
+func (m *PoolParams) GetAmplification() uint64 {
+	if m != nil {
+		return m.Amplification
+	}
+	return 0
+}
+
+// AmplificationRamp defines a linear change of a pool's amplification
+// from initial_amplification to target_amplification, starting at start_time
+// and lasting duration.
+type AmplificationRamp struct {
+	InitialAmplification uint64        `protobuf:"varint,1,opt,name=initial_amplification,json=initialAmplification,proto3" json:"initial_amplification,omitempty" yaml:"initial_amplification"`
+	TargetAmplification  uint64        `protobuf:"varint,2,opt,name=target_amplification,json=targetAmplification,proto3" json:"target_amplification,omitempty" yaml:"target_amplification"`
+	StartTime            time.Time     `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	Duration             time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+}
+
+func (m *AmplificationRamp) Reset()         { *m = AmplificationRamp{} }
+func (m *AmplificationRamp) String() string { return proto.CompactTextString(m) }
+func (*AmplificationRamp) ProtoMessage()    {}
+func (*AmplificationRamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae0f054436f9999a, []int{1}
+}
+func (m *AmplificationRamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AmplificationRamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AmplificationRamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AmplificationRamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AmplificationRamp.Merge(m, src)
+}
+func (m *AmplificationRamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *AmplificationRamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AmplificationRamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AmplificationRamp proto.InternalMessageInfo
+
+func (m *AmplificationRamp) GetInitialAmplification() uint64 {
+	if m != nil {
+		return m.InitialAmplification
+	}
+	return 0
+}
+
+func (m *AmplificationRamp) GetTargetAmplification() uint64 {
+	if m != nil {
+		return m.TargetAmplification
+	}
+	return 0
+}
+
+func (m *AmplificationRamp) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *AmplificationRamp) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
 // Pool is the stableswap Pool struct
 type Pool struct {
 	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
@@ -85,19 +170,22 @@ type Pool struct {
 	// would need to be locked up to count in governance. 0w means no lockup.
 	FuturePoolGovernor string `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
 	// sum of all LP shares
-	TotalShares types.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+	TotalShares types1.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
 	// assets in the pool
 	PoolLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=pool_liquidity,json=poolLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_liquidity"`
 	// for calculation amognst assets with different precisions
 	ScalingFactors []uint64 `protobuf:"varint,7,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factors"`
 	// scaling_factor_controller is the address can adjust pool scaling factors
 	ScalingFactorController string `protobuf:"bytes,8,opt,name=scaling_factor_controller,json=scalingFactorController,proto3" json:"scaling_factor_controller,omitempty" yaml:"scaling_factor_controller"`
+	// amplification_ramp is the in progress change of the pool's amplification,
+	// if any.
+	AmplificationRamp *AmplificationRamp `protobuf:"bytes,9,opt,name=amplification_ramp,json=amplificationRamp,proto3" json:"amplification_ramp,omitempty" yaml:"amplification_ramp"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
 func (*Pool) ProtoMessage() {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae0f054436f9999a, []int{1}
+	return fileDescriptor_ae0f054436f9999a, []int{2}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -128,6 +216,7 @@ var xxx_messageInfo_Pool proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*PoolParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.PoolParams")
+	proto.RegisterType((*AmplificationRamp)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.AmplificationRamp")
 	proto.RegisterType((*Pool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.Pool")
 }
 
@@ -136,48 +225,61 @@ func init() {
 }
 
 var fileDescriptor_ae0f054436f9999a = []byte{
-	// 642 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0xdb, 0xb4, 0x29, 0x5b, 0x48, 0x85, 0xa9, 0x84, 0xdb, 0x0a, 0x6f, 0xb0, 0x28, 0x8a,
-	0x10, 0xb1, 0x29, 0x08, 0x24, 0x7a, 0x6b, 0x8a, 0x8a, 0x90, 0x10, 0x2a, 0xe6, 0xc4, 0x8f, 0x14,
-	0xd6, 0xf6, 0xc6, 0x5d, 0x61, 0x67, 0xcd, 0xee, 0xba, 0xb4, 0x17, 0xce, 0x1c, 0x39, 0x72, 0xec,
-	0x19, 0xae, 0x3c, 0x44, 0xc5, 0xa9, 0x47, 0xc4, 0xc1, 0xa0, 0xf6, 0x0d, 0xf2, 0x04, 0x68, 0xd7,
-	0xeb, 0xfe, 0x04, 0xa8, 0x2a, 0x71, 0xca, 0xce, 0xcc, 0x37, 0xdf, 0xcc, 0x37, 0x33, 0x31, 0xb8,
-	0x4f, 0x79, 0x4a, 0x39, 0xe1, 0x5e, 0x8c, 0xd2, 0xd4, 0xcb, 0x28, 0x4d, 0x3a, 0x29, 0x8d, 0x70,
-	0xc2, 0x3d, 0x2e, 0x50, 0x90, 0x60, 0xfe, 0x0e, 0x65, 0xc7, 0x9e, 0x3d, 0x89, 0x70, 0x33, 0x46,
-	0x05, 0x35, 0x6f, 0xe8, 0x54, 0x57, 0xa6, 0xba, 0x32, 0x50, 0x66, 0xba, 0x47, 0x70, 0x77, 0x73,
-	0x29, 0xc0, 0x02, 0x2d, 0xcd, 0xcf, 0x85, 0x0a, 0xdc, 0x53, 0x99, 0x5e, 0x69, 0x94, 0x34, 0xf3,
-	0xb3, 0x31, 0x8d, 0x69, 0xe9, 0x97, 0x2f, 0xed, 0xb5, 0x63, 0x4a, 0xe3, 0x04, 0x7b, 0xca, 0x0a,
-	0xf2, 0xbe, 0x17, 0xe5, 0x0c, 0x09, 0x42, 0x07, 0x3a, 0x0e, 0x47, 0xe3, 0x82, 0xa4, 0x98, 0x0b,
-	0x94, 0x66, 0x15, 0x41, 0x59, 0xc4, 0x43, 0xb9, 0xd8, 0xf0, 0x74, 0x1b, 0xca, 0x18, 0x89, 0x07,
-	0x88, 0xe3, 0xc3, 0x78, 0x48, 0x89, 0x2e, 0xe0, 0xec, 0x1a, 0x00, 0xac, 0x53, 0x9a, 0xac, 0x23,
-	0x86, 0x52, 0x6e, 0xbe, 0x02, 0x53, 0x4a, 0x7f, 0x1f, 0x63, 0xcb, 0x68, 0x19, 0xed, 0x73, 0xdd,
-	0x95, 0xdd, 0x02, 0xd6, 0x7e, 0x14, 0xf0, 0x7a, 0x4c, 0xc4, 0x46, 0x1e, 0xb8, 0x21, 0x4d, 0xb5,
-	0x30, 0xfd, 0xd3, 0xe1, 0xd1, 0x1b, 0x4f, 0x6c, 0x67, 0x98, 0xbb, 0x0f, 0x70, 0x38, 0x2c, 0xe0,
-	0xcc, 0x36, 0x4a, 0x93, 0x65, 0xa7, 0xe2, 0x71, 0xfc, 0x86, 0x7c, 0xae, 0x61, 0x2c, 0xd9, 0xf1,
-	0x16, 0x11, 0x8a, 0x7d, 0xec, 0xff, 0xd8, 0x2b, 0x1e, 0xc7, 0x6f, 0xc8, 0xe7, 0x1a, 0xc6, 0xce,
-	0x97, 0x09, 0x50, 0x97, 0x52, 0xcc, 0x9b, 0xa0, 0x81, 0xa2, 0x88, 0x61, 0xce, 0xb5, 0x06, 0x73,
-	0x58, 0xc0, 0x66, 0x99, 0xa7, 0x03, 0x8e, 0x5f, 0x41, 0xcc, 0x26, 0x18, 0x23, 0x91, 0x6a, 0xa7,
-	0xee, 0x8f, 0x91, 0xc8, 0x7c, 0x0f, 0xa6, 0xe5, 0x92, 0x7b, 0x99, 0x9a, 0x88, 0x35, 0xde, 0x32,
-	0xda, 0xd3, 0xb7, 0xef, 0xb9, 0x67, 0xbf, 0x02, 0xf7, 0x68, 0x9e, 0xdd, 0x45, 0xa9, 0x6f, 0x58,
-	0xc0, 0x2b, 0x7a, 0x26, 0x27, 0x2f, 0x4c, 0xd7, 0x70, 0x7c, 0x90, 0x1d, 0xad, 0xe0, 0x29, 0x98,
-	0xed, 0xe7, 0x22, 0x67, 0xb8, 0x84, 0xc4, 0x74, 0x13, 0xb3, 0x01, 0x65, 0x56, 0x5d, 0x49, 0x81,
-	0xc3, 0x02, 0x2e, 0x94, 0x64, 0x7f, 0x43, 0x39, 0xbe, 0x59, 0xba, 0x65, 0x0f, 0x0f, 0xb5, 0xd3,
-	0x7c, 0x0e, 0xce, 0x0b, 0x2a, 0x50, 0xd2, 0xe3, 0x1b, 0x88, 0x61, 0x6e, 0x4d, 0x28, 0x4d, 0x73,
-	0xae, 0x3e, 0x50, 0x79, 0x1b, 0x87, 0xcd, 0xaf, 0x52, 0x32, 0xe8, 0x2e, 0xe8, 0xb6, 0x2f, 0x95,
-	0x95, 0x8e, 0x27, 0x3b, 0xfe, 0xb4, 0x32, 0x9f, 0x29, 0xcb, 0x64, 0xa0, 0xa9, 0x1a, 0x48, 0xc8,
-	0xdb, 0x9c, 0x44, 0x44, 0x6c, 0x5b, 0x93, 0xad, 0xf1, 0xd3, 0xc9, 0x6f, 0x49, 0xf2, 0xcf, 0x3f,
-	0x61, 0xfb, 0x0c, 0x3b, 0x97, 0x09, 0xdc, 0xbf, 0x20, 0x4b, 0x3c, 0xae, 0x2a, 0x98, 0x4f, 0xc0,
-	0x0c, 0x0f, 0x51, 0x42, 0x06, 0x71, 0xaf, 0x8f, 0x42, 0x41, 0x19, 0xb7, 0x1a, 0xad, 0xf1, 0x76,
-	0xbd, 0xbb, 0x38, 0x2c, 0xe0, 0xd5, 0x3f, 0x26, 0x3d, 0x82, 0x75, 0xfc, 0xa6, 0xf6, 0xac, 0x95,
-	0x0e, 0xf3, 0x35, 0x98, 0x3b, 0x89, 0xe9, 0x85, 0x74, 0x20, 0x18, 0x4d, 0x12, 0xcc, 0xac, 0x29,
-	0x35, 0xf6, 0x6b, 0xc3, 0x02, 0xb6, 0x34, 0xf3, 0xbf, 0xa0, 0x8e, 0x7f, 0xf9, 0x04, 0xf1, 0xea,
-	0x61, 0x64, 0xf9, 0xe2, 0x87, 0x1d, 0x58, 0xfb, 0xb4, 0x03, 0x6b, 0xdf, 0xbe, 0x76, 0x26, 0xe4,
-	0x6a, 0x1e, 0x75, 0x5f, 0xee, 0xee, 0xdb, 0xc6, 0xde, 0xbe, 0x6d, 0xfc, 0xda, 0xb7, 0x8d, 0x8f,
-	0x07, 0x76, 0x6d, 0xef, 0xc0, 0xae, 0x7d, 0x3f, 0xb0, 0x6b, 0x2f, 0x56, 0x8e, 0xcd, 0x45, 0x5f,
-	0x5d, 0x27, 0x41, 0x01, 0xaf, 0x0c, 0x6f, 0x73, 0xe9, 0xae, 0xb7, 0x75, 0xda, 0x97, 0x2c, 0x98,
-	0x54, 0x7f, 0xee, 0x3b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x5c, 0xc8, 0x15, 0xc4, 0xf7, 0x04,
-	0x00, 0x00,
+	// 853 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0xc7, 0xd7, 0x9b, 0x34, 0x2f, 0x13, 0x9a, 0x2a, 0xd3, 0x45, 0x38, 0x29, 0xd9, 0x59, 0x46,
+	0x14, 0x45, 0xa8, 0xb1, 0x09, 0x08, 0x24, 0x2a, 0x81, 0x14, 0xb7, 0x2a, 0x42, 0x42, 0xa8, 0x0c,
+	0x20, 0xf1, 0x26, 0x99, 0x59, 0x7b, 0xd6, 0x19, 0x61, 0xef, 0x18, 0xcf, 0x6c, 0x68, 0x2e, 0x1c,
+	0x38, 0x55, 0xe2, 0xd2, 0x63, 0x8f, 0x3d, 0x73, 0xe6, 0xc2, 0x37, 0xa8, 0x38, 0xf5, 0x88, 0x38,
+	0xb8, 0x28, 0xb9, 0x71, 0xf4, 0x27, 0x40, 0x33, 0x1e, 0x6f, 0xd6, 0x9b, 0xa5, 0x2a, 0xe2, 0xb4,
+	0x33, 0xcf, 0xf3, 0x7f, 0x7e, 0x33, 0x7e, 0x5e, 0x66, 0xc1, 0xbb, 0x42, 0x66, 0x42, 0x72, 0xe9,
+	0x27, 0x34, 0xcb, 0xfc, 0x5c, 0x88, 0x74, 0x3f, 0x13, 0x31, 0x4b, 0xa5, 0x2f, 0x15, 0x1d, 0xa6,
+	0x4c, 0xfe, 0x40, 0xf3, 0x99, 0x65, 0xa8, 0x15, 0x5e, 0x5e, 0x08, 0x25, 0xe0, 0xeb, 0x36, 0xd4,
+	0xd3, 0xa1, 0x9e, 0x76, 0xd4, 0x91, 0xde, 0xb9, 0xdc, 0x3b, 0x3e, 0x18, 0x32, 0x45, 0x0f, 0x76,
+	0xb6, 0x23, 0x23, 0x0e, 0x4d, 0xa4, 0x5f, 0x6f, 0x6a, 0xcc, 0x4e, 0x2f, 0x11, 0x89, 0xa8, 0xed,
+	0x7a, 0x65, 0xad, 0xfd, 0x44, 0x88, 0x24, 0x65, 0xbe, 0xd9, 0x0d, 0x27, 0x23, 0x3f, 0x9e, 0x14,
+	0x54, 0x71, 0x31, 0xb6, 0x7e, 0x34, 0xef, 0x57, 0x3c, 0x63, 0x52, 0xd1, 0x2c, 0x6f, 0x00, 0xf5,
+	0x21, 0x3e, 0x9d, 0xa8, 0x23, 0xdf, 0x5e, 0xc3, 0x6c, 0xe6, 0xfc, 0x43, 0x2a, 0xd9, 0xd4, 0x1f,
+	0x09, 0x6e, 0x0f, 0xc0, 0xf7, 0xbb, 0x00, 0xdc, 0x15, 0x22, 0xbd, 0x4b, 0x0b, 0x9a, 0x49, 0xf8,
+	0x0d, 0x58, 0x33, 0xdf, 0x3f, 0x62, 0xcc, 0x75, 0x06, 0xce, 0xde, 0x7a, 0x70, 0xf8, 0xb8, 0x44,
+	0x9d, 0x3f, 0x4b, 0xf4, 0x5a, 0xc2, 0xd5, 0xd1, 0x64, 0xe8, 0x45, 0x22, 0xb3, 0x1f, 0x66, 0x7f,
+	0xf6, 0x65, 0xfc, 0x9d, 0xaf, 0x4e, 0x72, 0x26, 0xbd, 0xdb, 0x2c, 0xaa, 0x4a, 0x74, 0xe5, 0x84,
+	0x66, 0xe9, 0x4d, 0xdc, 0x70, 0x30, 0x59, 0xd5, 0xcb, 0x3b, 0x8c, 0x69, 0x3a, 0xbb, 0xc7, 0x95,
+	0xa1, 0x77, 0xff, 0x1f, 0xbd, 0xe1, 0x60, 0xb2, 0xaa, 0x97, 0x9a, 0xfe, 0x3e, 0xb8, 0x4c, 0xb3,
+	0x3c, 0xe5, 0x23, 0x1e, 0x99, 0x14, 0xba, 0x4b, 0x03, 0x67, 0x6f, 0x39, 0x70, 0xab, 0x12, 0xf5,
+	0xea, 0xa0, 0x96, 0x1b, 0x93, 0xb6, 0x1c, 0xff, 0xb4, 0x04, 0xb6, 0x0e, 0x67, 0x2d, 0x84, 0x66,
+	0x39, 0xfc, 0x1c, 0xbc, 0xc8, 0xc7, 0x5c, 0x71, 0x9a, 0x86, 0x6d, 0xba, 0x63, 0xe8, 0x83, 0xaa,
+	0x44, 0x2f, 0xd7, 0xf4, 0x85, 0x32, 0x4c, 0x7a, 0xd6, 0xde, 0x42, 0x43, 0x02, 0x7a, 0x8a, 0x16,
+	0x09, 0x53, 0x73, 0xd4, 0xae, 0xa1, 0xa2, 0xaa, 0x44, 0xd7, 0x6a, 0xea, 0x22, 0x15, 0x26, 0x57,
+	0x6b, 0x73, 0x9b, 0xf9, 0x05, 0x00, 0x52, 0xd1, 0x42, 0x85, 0xba, 0x49, 0xcc, 0xd7, 0x6f, 0xbc,
+	0xb9, 0xe3, 0xd5, 0x1d, 0xe4, 0x35, 0x1d, 0xe4, 0x7d, 0xd6, 0x74, 0x50, 0xb0, 0xab, 0x93, 0x5f,
+	0x95, 0x68, 0xcb, 0x16, 0x6c, 0x1a, 0x8b, 0x1f, 0x3c, 0x45, 0x0e, 0x59, 0x37, 0x06, 0x2d, 0x87,
+	0x47, 0x60, 0xad, 0x69, 0x4c, 0x77, 0xd9, 0x70, 0xb7, 0x2f, 0x70, 0x6f, 0x5b, 0x41, 0x70, 0xa0,
+	0xb1, 0x7f, 0x97, 0x08, 0x36, 0x21, 0x37, 0x44, 0xc6, 0x15, 0xcb, 0x72, 0x75, 0x72, 0x5e, 0xbf,
+	0xc6, 0x87, 0x1f, 0xea, 0xa3, 0xa6, 0x74, 0xfc, 0xdb, 0x0a, 0x58, 0xd6, 0xfd, 0x08, 0x6f, 0x80,
+	0x55, 0x1a, 0xc7, 0x05, 0x93, 0xd2, 0x36, 0x22, 0xac, 0x4a, 0xb4, 0x69, 0xeb, 0x58, 0x3b, 0x30,
+	0x69, 0x24, 0x70, 0x13, 0x74, 0x79, 0x5c, 0x27, 0x8f, 0x74, 0x79, 0x0c, 0x7f, 0x04, 0x1b, 0x7a,
+	0x52, 0xc3, 0xdc, 0xb4, 0xb5, 0xcd, 0xc5, 0x3b, 0xde, 0xf3, 0x8f, 0xb2, 0x77, 0x3e, 0x14, 0xc1,
+	0x75, 0x9b, 0xa7, 0xdd, 0x69, 0x9e, 0x66, 0x9f, 0x09, 0x7b, 0x06, 0x26, 0x20, 0x3f, 0x9f, 0xa3,
+	0x4f, 0x40, 0x6f, 0x34, 0x51, 0x93, 0x82, 0xd5, 0x92, 0x44, 0x1c, 0xb3, 0x62, 0x2c, 0x0a, 0x93,
+	0xbc, 0xf5, 0xd9, 0xf2, 0x2e, 0x52, 0x61, 0x02, 0x6b, 0xb3, 0xbe, 0xc3, 0x07, 0xd6, 0x08, 0xbf,
+	0x04, 0x2f, 0x28, 0xa1, 0x68, 0x1a, 0xca, 0x23, 0x5a, 0x30, 0xe9, 0x5e, 0xb2, 0x75, 0xb0, 0xaf,
+	0x8c, 0x1e, 0xf0, 0xe9, 0xe5, 0x6f, 0x09, 0x3e, 0x0e, 0xae, 0xd9, 0x6b, 0x5f, 0xb5, 0x8d, 0x34,
+	0x13, 0x8c, 0xc9, 0x86, 0xd9, 0x7e, 0x6a, 0x76, 0xb0, 0x00, 0x9b, 0xe6, 0x02, 0x29, 0xff, 0x7e,
+	0xc2, 0x63, 0xae, 0x4e, 0xdc, 0x95, 0xc1, 0xd2, 0xb3, 0xe1, 0x6f, 0x68, 0xf8, 0x2f, 0x4f, 0xd1,
+	0xde, 0x73, 0x0c, 0xae, 0x0e, 0x90, 0xe4, 0xb2, 0x3e, 0xe2, 0xa3, 0xe6, 0x04, 0xf8, 0x31, 0xb8,
+	0x22, 0x23, 0x9a, 0xf2, 0x71, 0x12, 0x8e, 0x68, 0xa4, 0x44, 0x21, 0xdd, 0xd5, 0xc1, 0xd2, 0xde,
+	0x72, 0x70, 0xbd, 0x2a, 0xd1, 0x2b, 0x17, 0x32, 0x3d, 0xa7, 0xc5, 0x64, 0xd3, 0x5a, 0xee, 0xd4,
+	0x06, 0xf8, 0x2d, 0xd8, 0x6e, 0x6b, 0xc2, 0x48, 0x8c, 0x55, 0x21, 0xd2, 0x94, 0x15, 0xee, 0x9a,
+	0x49, 0xfb, 0xab, 0x55, 0x89, 0x06, 0x96, 0xfc, 0x6f, 0x52, 0x4c, 0x5e, 0x6a, 0x81, 0x6f, 0x4d,
+	0x3d, 0xf0, 0x67, 0x07, 0xc0, 0xd6, 0x18, 0x86, 0x05, 0xcd, 0x72, 0x77, 0xdd, 0xd4, 0xe1, 0xbd,
+	0xff, 0xd2, 0x5b, 0x17, 0x5e, 0x99, 0x60, 0xb7, 0x2a, 0xd1, 0xf6, 0x82, 0x47, 0xca, 0x1c, 0x81,
+	0xc9, 0x16, 0x9d, 0x8f, 0xb8, 0xb9, 0x75, 0xff, 0x11, 0xea, 0x3c, 0x7c, 0x84, 0x3a, 0xbf, 0xff,
+	0xba, 0x7f, 0x49, 0x37, 0xca, 0x87, 0xc1, 0xd7, 0x8f, 0x4f, 0xfb, 0xce, 0x93, 0xd3, 0xbe, 0xf3,
+	0xd7, 0x69, 0xdf, 0x79, 0x70, 0xd6, 0xef, 0x3c, 0x39, 0xeb, 0x77, 0xfe, 0x38, 0xeb, 0x77, 0xbe,
+	0x3a, 0x9c, 0xa9, 0x92, 0xbd, 0xe7, 0x7e, 0x4a, 0x87, 0xb2, 0xd9, 0xf8, 0xc7, 0x07, 0x6f, 0xfb,
+	0xf7, 0x9e, 0xf5, 0xe7, 0x38, 0x5c, 0x31, 0x83, 0xfe, 0xd6, 0x3f, 0x03, 0x00, 0xdd, 0xfc, 0x16,
+	0xe3, 0x4a, 0x07, 0x00, 0x00,
 }
 
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
@@ -200,6 +302,11 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Amplification != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.ExitFee.Size()
 		i -= size
@@ -223,6 +330,55 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AmplificationRamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AmplificationRamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AmplificationRamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.TargetAmplification != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.TargetAmplification))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InitialAmplification != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.InitialAmplification))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Pool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -243,6 +399,18 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AmplificationRamp != nil {
+		{
+			size, err := m.AmplificationRamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.ScalingFactorController) > 0 {
 		i -= len(m.ScalingFactorController)
 		copy(dAtA[i:], m.ScalingFactorController)
@@ -251,20 +419,20 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if len(m.ScalingFactors) > 0 {
-		dAtA2 := make([]byte, len(m.ScalingFactors)*10)
-		var j1 int
+		dAtA5 := make([]byte, len(m.ScalingFactors)*10)
+		var j4 int
 		for _, num := range m.ScalingFactors {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j4++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintStableswapPool(dAtA, i, uint64(j1))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -345,6 +513,28 @@ func (m *PoolParams) Size() (n int) {
 	n += 1 + l + sovStableswapPool(uint64(l))
 	l = m.ExitFee.Size()
 	n += 1 + l + sovStableswapPool(uint64(l))
+	if m.Amplification != 0 {
+		n += 1 + sovStableswapPool(uint64(m.Amplification))
+	}
+	return n
+}
+
+func (m *AmplificationRamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InitialAmplification != 0 {
+		n += 1 + sovStableswapPool(uint64(m.InitialAmplification))
+	}
+	if m.TargetAmplification != 0 {
+		n += 1 + sovStableswapPool(uint64(m.TargetAmplification))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovStableswapPool(uint64(l))
 	return n
 }
 
@@ -386,6 +576,10 @@ func (m *Pool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStableswapPool(uint64(l))
 	}
+	if m.AmplificationRamp != nil {
+		l = m.AmplificationRamp.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
 	return n
 }
 
@@ -492,6 +686,179 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AmplificationRamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AmplificationRamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AmplificationRamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmplification", wireType)
+			}
+			m.InitialAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmplification", wireType)
+			}
+			m.TargetAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
@@ -720,7 +1087,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolLiquidity = append(m.PoolLiquidity, types.Coin{})
+			m.PoolLiquidity = append(m.PoolLiquidity, types1.Coin{})
 			if err := m.PoolLiquidity[len(m.PoolLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -833,6 +1200,42 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			}
 			m.ScalingFactorController = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmplificationRamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AmplificationRamp == nil {
+				m.AmplificationRamp = &AmplificationRamp{}
+			}
+			if err := m.AmplificationRamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
@@ -11,18 +11,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types1 "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -262,11 +267,324 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStableSwapAdjustScalingFactorsResponse proto.InternalMessageInfo
 
+// Sender must be the pool's scaling_factor_controller in order for the tx to
+// succeed. Sets the rate provider that drives the pool's scaling factors every
+// epoch, replacing any existing one.
+type MsgStableSwapSetScalingFactorRateProvider struct {
+	Sender       string                           `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	RateProvider types1.ScalingFactorRateProvider `protobuf:"bytes,2,opt,name=rate_provider,json=rateProvider,proto3" json:"rate_provider" yaml:"rate_provider"`
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProvider) Reset() {
+	*m = MsgStableSwapSetScalingFactorRateProvider{}
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgStableSwapSetScalingFactorRateProvider) ProtoMessage() {}
+func (*MsgStableSwapSetScalingFactorRateProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{4}
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapSetScalingFactorRateProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateProvider.Merge(m, src)
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapSetScalingFactorRateProvider proto.InternalMessageInfo
+
+func (m *MsgStableSwapSetScalingFactorRateProvider) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProvider) GetRateProvider() types1.ScalingFactorRateProvider {
+	if m != nil {
+		return m.RateProvider
+	}
+	return types1.ScalingFactorRateProvider{}
+}
+
+type MsgStableSwapSetScalingFactorRateProviderResponse struct {
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) Reset() {
+	*m = MsgStableSwapSetScalingFactorRateProviderResponse{}
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgStableSwapSetScalingFactorRateProviderResponse) ProtoMessage() {}
+func (*MsgStableSwapSetScalingFactorRateProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{5}
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapSetScalingFactorRateProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateProviderResponse.Merge(m, src)
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapSetScalingFactorRateProviderResponse proto.InternalMessageInfo
+
+// Sender must be the pool's scaling_factor_controller in order for the tx to
+// succeed. Removes the pool's rate provider, returning the pool to manual
+// scaling factor adjustments.
+type MsgStableSwapRemoveScalingFactorRateProvider struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *MsgStableSwapRemoveScalingFactorRateProvider) Reset() {
+	*m = MsgStableSwapRemoveScalingFactorRateProvider{}
+}
+func (m *MsgStableSwapRemoveScalingFactorRateProvider) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgStableSwapRemoveScalingFactorRateProvider) ProtoMessage() {}
+func (*MsgStableSwapRemoveScalingFactorRateProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{6}
+}
+func (m *MsgStableSwapRemoveScalingFactorRateProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRemoveScalingFactorRateProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRemoveScalingFactorRateProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRemoveScalingFactorRateProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRemoveScalingFactorRateProvider.Merge(m, src)
+}
+func (m *MsgStableSwapRemoveScalingFactorRateProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRemoveScalingFactorRateProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRemoveScalingFactorRateProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRemoveScalingFactorRateProvider proto.InternalMessageInfo
+
+func (m *MsgStableSwapRemoveScalingFactorRateProvider) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapRemoveScalingFactorRateProvider) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+type MsgStableSwapRemoveScalingFactorRateProviderResponse struct {
+}
+
+func (m *MsgStableSwapRemoveScalingFactorRateProviderResponse) Reset() {
+	*m = MsgStableSwapRemoveScalingFactorRateProviderResponse{}
+}
+func (m *MsgStableSwapRemoveScalingFactorRateProviderResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgStableSwapRemoveScalingFactorRateProviderResponse) ProtoMessage() {}
+func (*MsgStableSwapRemoveScalingFactorRateProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{7}
+}
+func (m *MsgStableSwapRemoveScalingFactorRateProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRemoveScalingFactorRateProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRemoveScalingFactorRateProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRemoveScalingFactorRateProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRemoveScalingFactorRateProviderResponse.Merge(m, src)
+}
+func (m *MsgStableSwapRemoveScalingFactorRateProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRemoveScalingFactorRateProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRemoveScalingFactorRateProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRemoveScalingFactorRateProviderResponse proto.InternalMessageInfo
+
+// Sender must be the pool's future_pool_governor in order for the tx to
+// succeed. Linearly changes the pool's amplification from its current value
+// to target_amplification over duration, replacing any ramp in progress.
+type MsgStableSwapRampAmplification struct {
+	Sender              string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID              uint64        `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	TargetAmplification uint64        `protobuf:"varint,3,opt,name=target_amplification,json=targetAmplification,proto3" json:"target_amplification,omitempty" yaml:"target_amplification"`
+	Duration            time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+}
+
+func (m *MsgStableSwapRampAmplification) Reset()         { *m = MsgStableSwapRampAmplification{} }
+func (m *MsgStableSwapRampAmplification) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampAmplification) ProtoMessage()    {}
+func (*MsgStableSwapRampAmplification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{8}
+}
+func (m *MsgStableSwapRampAmplification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampAmplification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampAmplification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampAmplification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampAmplification.Merge(m, src)
+}
+func (m *MsgStableSwapRampAmplification) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampAmplification) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampAmplification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampAmplification proto.InternalMessageInfo
+
+func (m *MsgStableSwapRampAmplification) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapRampAmplification) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgStableSwapRampAmplification) GetTargetAmplification() uint64 {
+	if m != nil {
+		return m.TargetAmplification
+	}
+	return 0
+}
+
+func (m *MsgStableSwapRampAmplification) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type MsgStableSwapRampAmplificationResponse struct {
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) Reset() {
+	*m = MsgStableSwapRampAmplificationResponse{}
+}
+func (m *MsgStableSwapRampAmplificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampAmplificationResponse) ProtoMessage()    {}
+func (*MsgStableSwapRampAmplificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{9}
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampAmplificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampAmplificationResponse.Merge(m, src)
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampAmplificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampAmplificationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateStableswapPool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPool")
 	proto.RegisterType((*MsgCreateStableswapPoolResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPoolResponse")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactors)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactors")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactorsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactorsResponse")
+	proto.RegisterType((*MsgStableSwapSetScalingFactorRateProvider)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapSetScalingFactorRateProvider")
+	proto.RegisterType((*MsgStableSwapSetScalingFactorRateProviderResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapSetScalingFactorRateProviderResponse")
+	proto.RegisterType((*MsgStableSwapRemoveScalingFactorRateProvider)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRemoveScalingFactorRateProvider")
+	proto.RegisterType((*MsgStableSwapRemoveScalingFactorRateProviderResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRemoveScalingFactorRateProviderResponse")
+	proto.RegisterType((*MsgStableSwapRampAmplification)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampAmplification")
+	proto.RegisterType((*MsgStableSwapRampAmplificationResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampAmplificationResponse")
 }
 
 func init() {
@@ -274,46 +592,64 @@ func init() {
 }
 
 var fileDescriptor_46b7c8a0f24de97c = []byte{
-	// 622 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x6f, 0xd6, 0x52, 0x84, 0x27, 0x40, 0x44, 0xd5, 0x96, 0x15, 0x29, 0x29, 0x81, 0x43, 0x07,
-	0x2c, 0x66, 0x43, 0x20, 0xc1, 0x6d, 0x29, 0x1a, 0x9a, 0xa0, 0xd2, 0xc8, 0xc4, 0x05, 0x0e, 0xc5,
-	0x49, 0xbc, 0x60, 0x48, 0xe2, 0x10, 0xbb, 0xdd, 0x7a, 0xe4, 0x1b, 0xf0, 0x31, 0x10, 0xdf, 0x81,
-	0x0b, 0x07, 0xb4, 0xe3, 0x8e, 0x9c, 0x02, 0x6a, 0xbf, 0x41, 0x6f, 0xdc, 0x50, 0xe2, 0xf4, 0x9f,
-	0xd4, 0x6e, 0x2b, 0xda, 0x29, 0xce, 0xf3, 0xef, 0xfd, 0x7e, 0xef, 0xfd, 0x9e, 0x6d, 0x70, 0x9f,
-	0xb2, 0x80, 0x32, 0xc2, 0xa0, 0x87, 0x82, 0x00, 0x46, 0x94, 0xfa, 0x1b, 0x01, 0x75, 0xb1, 0xcf,
-	0x20, 0xe3, 0xc8, 0xf6, 0x31, 0x3b, 0x44, 0x11, 0xe4, 0x47, 0x46, 0x14, 0x53, 0x4e, 0xe5, 0xbb,
-	0x39, 0xda, 0x48, 0xd1, 0x46, 0x8a, 0x16, 0x60, 0x63, 0x0c, 0x36, 0x3a, 0x9b, 0x36, 0xe6, 0x68,
-	0xb3, 0xaa, 0x3a, 0x19, 0x18, 0xda, 0x88, 0x61, 0x98, 0x07, 0xa1, 0x43, 0x49, 0x28, 0xb8, 0xaa,
-	0x15, 0x8f, 0x7a, 0x34, 0x5b, 0xc2, 0x74, 0x95, 0x47, 0x9f, 0x9c, 0xa7, 0x9e, 0xf1, 0xb2, 0x95,
-	0x22, 0x44, 0xaa, 0xfe, 0xbd, 0x04, 0x56, 0x9b, 0xcc, 0x6b, 0xc4, 0x18, 0x71, 0xbc, 0x3f, 0x82,
-	0xec, 0x51, 0xea, 0xcb, 0xeb, 0xa0, 0xcc, 0x70, 0xe8, 0xe2, 0x58, 0x91, 0x6a, 0x52, 0xfd, 0x8a,
-	0x79, 0x63, 0x90, 0x68, 0x57, 0xbb, 0x28, 0xf0, 0x9f, 0xea, 0x22, 0xae, 0x5b, 0x39, 0x40, 0xa6,
-	0x60, 0x39, 0x25, 0x6d, 0x45, 0x28, 0x46, 0x01, 0x53, 0x96, 0x6a, 0x52, 0x7d, 0x79, 0xeb, 0xb1,
-	0x71, 0xfe, 0xce, 0x8d, 0x54, 0x71, 0x2f, 0xcb, 0x36, 0x57, 0x06, 0x89, 0x26, 0x0b, 0x9d, 0x09,
-	0x52, 0xdd, 0x02, 0xd1, 0x08, 0x23, 0x7f, 0x96, 0xc0, 0x0a, 0x09, 0x09, 0x27, 0xc8, 0xcf, 0xda,
-	0x69, 0xf9, 0xe4, 0x53, 0x9b, 0xb8, 0x84, 0x77, 0x95, 0x62, 0xad, 0x58, 0x5f, 0xde, 0x5a, 0x33,
-	0x84, 0x95, 0x46, 0x6a, 0xe5, 0x48, 0xa5, 0x41, 0x49, 0x68, 0x3e, 0x38, 0x4e, 0xb4, 0xc2, 0xb7,
-	0xdf, 0x5a, 0xdd, 0x23, 0xfc, 0x7d, 0xdb, 0x36, 0x1c, 0x1a, 0xc0, 0xdc, 0x77, 0xf1, 0xd9, 0x60,
-	0xee, 0x47, 0xc8, 0xbb, 0x11, 0x66, 0x59, 0x02, 0xb3, 0x2a, 0xb9, 0x54, 0x5a, 0xe4, 0xcb, 0xa1,
-	0x90, 0xdc, 0x04, 0xd7, 0x99, 0x83, 0x7c, 0x12, 0x7a, 0xad, 0x03, 0xe4, 0x70, 0x1a, 0x33, 0xa5,
-	0x54, 0x2b, 0xd6, 0x4b, 0xe6, 0x9d, 0x41, 0xa2, 0xd5, 0x72, 0xa3, 0xc6, 0xae, 0x4f, 0x63, 0x75,
-	0xeb, 0x5a, 0x1e, 0xd8, 0x11, 0xb9, 0xf2, 0x2b, 0x50, 0x39, 0x68, 0xf3, 0x76, 0x8c, 0x45, 0x43,
-	0x1e, 0xed, 0xe0, 0x38, 0xa4, 0xb1, 0x72, 0x29, 0x33, 0x5f, 0x1b, 0x24, 0xda, 0x4d, 0xc1, 0x39,
-	0x0b, 0xa5, 0x5b, 0xb2, 0x08, 0xa7, 0x25, 0x3e, 0xcf, 0x83, 0xf2, 0x3b, 0xb0, 0x36, 0xad, 0xda,
-	0x72, 0x68, 0xc8, 0x63, 0xea, 0xfb, 0x38, 0x56, 0xca, 0x19, 0xef, 0x64, 0xad, 0xf3, 0xa0, 0xba,
-	0xb5, 0x3a, 0x55, 0x6b, 0x63, 0xbc, 0xb3, 0x03, 0xb4, 0x39, 0xc7, 0xc7, 0xc2, 0x2c, 0xa2, 0x21,
-	0xc3, 0xf2, 0x6d, 0x70, 0x39, 0x2b, 0x95, 0xb8, 0xd9, 0x39, 0x2a, 0x99, 0xa0, 0x97, 0x68, 0xe5,
-	0x14, 0xb2, 0xfb, 0xcc, 0x2a, 0xa7, 0x5b, 0xbb, 0xae, 0xfe, 0x43, 0x02, 0xb7, 0x9a, 0xcc, 0x13,
-	0x14, 0xfb, 0x87, 0x28, 0xda, 0x76, 0x3f, 0xb4, 0x19, 0xdf, 0x9f, 0xb6, 0x68, 0x81, 0x13, 0x39,
-	0xa1, 0xba, 0x34, 0x4f, 0x75, 0xd6, 0x04, 0x8b, 0xff, 0x3f, 0x41, 0xfd, 0x1e, 0x58, 0x3f, 0xb3,
-	0x87, 0xa1, 0x2d, 0x5b, 0x7f, 0x97, 0x40, 0xb1, 0xc9, 0x3c, 0xf9, 0xab, 0x04, 0x2a, 0x33, 0xaf,
-	0x5f, 0x63, 0x91, 0xeb, 0x33, 0x67, 0x08, 0xd5, 0x17, 0x17, 0x40, 0x32, 0x9a, 0xe4, 0x4f, 0x09,
-	0xa8, 0x67, 0x4c, 0xa8, 0xb9, 0xa0, 0xde, 0xe9, 0x74, 0xd5, 0xd7, 0x17, 0x4a, 0x37, 0x6c, 0xc4,
-	0x7c, 0x7b, 0xdc, 0x53, 0xa5, 0x93, 0x9e, 0x2a, 0xfd, 0xe9, 0xa9, 0xd2, 0x97, 0xbe, 0x5a, 0x38,
-	0xe9, 0xab, 0x85, 0x5f, 0x7d, 0xb5, 0xf0, 0x66, 0x7b, 0xe2, 0x4d, 0xc8, 0xa5, 0x37, 0x7c, 0x64,
-	0xb3, 0xe1, 0x0f, 0xec, 0x6c, 0x3e, 0x82, 0x47, 0xa7, 0x3d, 0xb4, 0x76, 0x39, 0x7b, 0x59, 0x1f,
-	0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x71, 0x64, 0x78, 0x89, 0x26, 0x06, 0x00, 0x00,
+	// 909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x5f, 0x6f, 0x42, 0x28, 0xb3, 0x94, 0x0a, 0x13, 0xb5, 0xde, 0x14, 0xd9, 0x61, 0x40, 0x55,
+	0x16, 0x76, 0x6d, 0xb2, 0x85, 0x4a, 0x70, 0x5b, 0x6f, 0x55, 0x54, 0x20, 0xd2, 0xe2, 0x08, 0x09,
+	0x81, 0x50, 0x3a, 0x89, 0x27, 0xee, 0x80, 0xed, 0x31, 0x33, 0x93, 0xb4, 0xb9, 0x20, 0x21, 0xf1,
+	0x01, 0x38, 0xf2, 0x11, 0x10, 0x07, 0xbe, 0x41, 0x2f, 0x1c, 0x50, 0xc5, 0xa9, 0x47, 0x4e, 0x2e,
+	0xca, 0x1e, 0x90, 0x38, 0xe6, 0x13, 0x54, 0xfe, 0x9b, 0xb8, 0x4a, 0xb2, 0x49, 0x94, 0x9e, 0xe2,
+	0x8c, 0x7f, 0xef, 0xf7, 0xfb, 0xbd, 0xf7, 0x66, 0xde, 0x18, 0x1c, 0x52, 0xee, 0x51, 0x4e, 0xb8,
+	0xe1, 0x20, 0xcf, 0x33, 0x02, 0x4a, 0xdd, 0x23, 0x8f, 0xda, 0xd8, 0xe5, 0x06, 0x17, 0xa8, 0xeb,
+	0x62, 0xfe, 0x00, 0x05, 0x86, 0x78, 0xa8, 0x07, 0x8c, 0x0a, 0x2a, 0xbf, 0x9b, 0xa2, 0xf5, 0x08,
+	0xad, 0x47, 0xe8, 0x04, 0xac, 0x4f, 0xc1, 0xfa, 0xb0, 0xd9, 0xc5, 0x02, 0x35, 0x6b, 0x6a, 0x2f,
+	0x06, 0x1b, 0x5d, 0xc4, 0xb1, 0x91, 0x2e, 0x1a, 0x3d, 0x4a, 0xfc, 0x84, 0xab, 0x56, 0x75, 0xa8,
+	0x43, 0xe3, 0x47, 0x23, 0x7a, 0x4a, 0x57, 0x55, 0x87, 0x52, 0xc7, 0xc5, 0x46, 0xfc, 0xaf, 0x3b,
+	0xe8, 0x1b, 0xf6, 0x80, 0x21, 0x41, 0x68, 0x16, 0xf5, 0xd1, 0x2a, 0x7e, 0xa7, 0x8f, 0x9d, 0x08,
+	0x91, 0x86, 0xc2, 0x42, 0x68, 0xe6, 0xc8, 0xc1, 0x3e, 0x8e, 0x32, 0x8a, 0x31, 0xf0, 0x51, 0x19,
+	0x5c, 0x6b, 0x71, 0xe7, 0x94, 0x61, 0x24, 0x70, 0x3b, 0xa7, 0x39, 0xa3, 0xd4, 0x95, 0x0f, 0x40,
+	0x85, 0x63, 0xdf, 0xc6, 0x4c, 0x91, 0xea, 0x52, 0xe3, 0x15, 0xf3, 0xf5, 0x49, 0xa8, 0x5d, 0x1e,
+	0x21, 0xcf, 0xfd, 0x18, 0x26, 0xeb, 0xd0, 0x4a, 0x01, 0x32, 0x05, 0x7b, 0x91, 0x70, 0x27, 0x40,
+	0x0c, 0x79, 0x5c, 0xd9, 0xad, 0x4b, 0x8d, 0xbd, 0xe3, 0x5b, 0xfa, 0xea, 0xd5, 0xd3, 0x23, 0xc5,
+	0xb3, 0x38, 0xda, 0xbc, 0x3a, 0x09, 0x35, 0x39, 0xd1, 0x99, 0x21, 0x85, 0x16, 0x08, 0x72, 0x8c,
+	0xfc, 0x93, 0x04, 0xae, 0x12, 0x9f, 0x08, 0x82, 0xdc, 0x38, 0xe5, 0x8e, 0x4b, 0x7e, 0x18, 0x10,
+	0x9b, 0x88, 0x91, 0x52, 0xaa, 0x97, 0x1a, 0x7b, 0xc7, 0xfb, 0x7a, 0xd2, 0x0e, 0x3d, 0x6a, 0x47,
+	0xae, 0x72, 0x4a, 0x89, 0x6f, 0xbe, 0xff, 0x38, 0xd4, 0x76, 0x7e, 0x7f, 0xaa, 0x35, 0x1c, 0x22,
+	0xee, 0x0f, 0xba, 0x7a, 0x8f, 0x7a, 0x46, 0xda, 0xbb, 0xe4, 0xe7, 0x88, 0xdb, 0xdf, 0x1b, 0x62,
+	0x14, 0x60, 0x1e, 0x07, 0x70, 0xab, 0x9a, 0x4a, 0x45, 0x26, 0x3f, 0xcf, 0x84, 0xe4, 0x16, 0xb8,
+	0xc2, 0x7b, 0xc8, 0x25, 0xbe, 0xd3, 0xe9, 0xa3, 0x9e, 0xa0, 0x8c, 0x2b, 0xe5, 0x7a, 0xa9, 0x51,
+	0x36, 0xdf, 0x99, 0x84, 0x5a, 0x3d, 0x2d, 0xd4, 0xb4, 0x33, 0x45, 0x2c, 0xb4, 0x5e, 0x4b, 0x17,
+	0xee, 0x24, 0xb1, 0xf2, 0x17, 0xa0, 0xda, 0x1f, 0x88, 0x01, 0xc3, 0x49, 0x42, 0x0e, 0x1d, 0x62,
+	0xe6, 0x53, 0xa6, 0xbc, 0x14, 0x17, 0x5f, 0x9b, 0x84, 0xda, 0xf5, 0x84, 0x73, 0x1e, 0x0a, 0x5a,
+	0x72, 0xb2, 0x1c, 0x59, 0xfc, 0x24, 0x5d, 0x94, 0xef, 0x81, 0xfd, 0xa2, 0x6a, 0xa7, 0x47, 0x7d,
+	0xc1, 0xa8, 0xeb, 0x62, 0xa6, 0x54, 0x62, 0xde, 0x59, 0xaf, 0x8b, 0xa0, 0xd0, 0xba, 0x56, 0xf0,
+	0x7a, 0x3a, 0x7d, 0x73, 0x07, 0x68, 0x0b, 0xb6, 0x8f, 0x85, 0x79, 0x40, 0x7d, 0x8e, 0xe5, 0xb7,
+	0xc1, 0xcb, 0xb1, 0x55, 0x62, 0xc7, 0xfb, 0xa8, 0x6c, 0x82, 0x71, 0xa8, 0x55, 0x22, 0xc8, 0xdd,
+	0xdb, 0x56, 0x25, 0x7a, 0x75, 0xd7, 0x86, 0x7f, 0x4a, 0xe0, 0xad, 0x16, 0x77, 0x12, 0x8a, 0xf6,
+	0x03, 0x14, 0x9c, 0xd8, 0xdf, 0x0d, 0xb8, 0x68, 0x17, 0x4b, 0xb4, 0xc6, 0x8e, 0x9c, 0x51, 0xdd,
+	0x5d, 0xa4, 0x3a, 0xaf, 0x83, 0xa5, 0xcd, 0x3b, 0x08, 0xdf, 0x03, 0x07, 0x17, 0xe6, 0x90, 0x95,
+	0x05, 0xfe, 0x2d, 0x3d, 0x87, 0x6e, 0xe3, 0x22, 0xd4, 0x42, 0x02, 0x9f, 0x31, 0x3a, 0x24, 0x51,
+	0x3a, 0x6b, 0x64, 0xce, 0xc0, 0x65, 0x86, 0x04, 0xee, 0x04, 0x69, 0x6c, 0x7a, 0x1a, 0x8d, 0xe2,
+	0x69, 0xcc, 0x4e, 0xc4, 0x42, 0x49, 0xf3, 0xcd, 0xe8, 0x98, 0x4c, 0x42, 0xad, 0x9a, 0xc8, 0x14,
+	0x38, 0xa1, 0xf5, 0x2a, 0x9b, 0xc1, 0xc2, 0x9b, 0xa0, 0xb9, 0x72, 0x2e, 0x79, 0x05, 0x7e, 0x04,
+	0x87, 0x85, 0x20, 0x0b, 0x7b, 0x74, 0x88, 0xb7, 0x52, 0x83, 0x55, 0xba, 0x0f, 0x6f, 0x81, 0x0f,
+	0xd6, 0xd1, 0xcf, 0x7d, 0xff, 0xb1, 0x0b, 0xd4, 0x62, 0x20, 0xf2, 0x82, 0x13, 0x2f, 0x70, 0x49,
+	0x9f, 0xf4, 0xe2, 0xd9, 0xbd, 0xf5, 0x8d, 0x6a, 0x81, 0xaa, 0x40, 0xcc, 0xc1, 0xa2, 0x83, 0x66,
+	0x75, 0x94, 0x52, 0x1c, 0x31, 0x33, 0x1b, 0xe6, 0xa1, 0xa0, 0xf5, 0x46, 0xb2, 0x5c, 0xf4, 0x78,
+	0x1f, 0x5c, 0xca, 0xee, 0x1a, 0xa5, 0x1c, 0x6f, 0x91, 0x7d, 0x3d, 0xb9, 0x8c, 0xf4, 0xec, 0x32,
+	0xd2, 0x6f, 0xa7, 0x00, 0xb3, 0x19, 0x6d, 0x86, 0xff, 0x43, 0x4d, 0xce, 0x42, 0x0e, 0xa9, 0x47,
+	0x04, 0xf6, 0x02, 0x31, 0x9a, 0x84, 0xda, 0x95, 0x44, 0x3c, 0x7b, 0x07, 0x7f, 0x7d, 0xaa, 0x49,
+	0x56, 0xce, 0x0e, 0x1b, 0xe0, 0xc6, 0xf2, 0x7a, 0x65, 0xa5, 0x3d, 0xfe, 0xf9, 0x12, 0x28, 0xb5,
+	0xb8, 0x23, 0xff, 0x26, 0x81, 0xea, 0xdc, 0x3b, 0xe9, 0x74, 0x9d, 0x3b, 0x65, 0xc1, 0x64, 0xaa,
+	0x7d, 0xb6, 0x05, 0x92, 0x7c, 0xbc, 0xfd, 0x25, 0x01, 0xf5, 0x82, 0xb1, 0xd5, 0x5a, 0x53, 0x6f,
+	0x39, 0x5d, 0xed, 0xcb, 0xad, 0xd2, 0xe5, 0x89, 0x84, 0x12, 0xb8, 0xb1, 0xe2, 0x34, 0xda, 0xdc,
+	0xc1, 0x32, 0xda, 0xda, 0xb7, 0x2f, 0x84, 0x36, 0x4f, 0xf0, 0x3f, 0x09, 0x1c, 0xac, 0x3e, 0x6d,
+	0xbe, 0xda, 0xd8, 0xcc, 0x05, 0xcc, 0xb5, 0x7b, 0x2f, 0x8a, 0x39, 0xcf, 0xf4, 0x91, 0x04, 0xae,
+	0x2f, 0x1b, 0x4f, 0x9f, 0x6e, 0xee, 0xe0, 0x79, 0xae, 0x9a, 0xb5, 0x3d, 0xae, 0xcc, 0xbf, 0xf9,
+	0xcd, 0xe3, 0xb1, 0x2a, 0x3d, 0x19, 0xab, 0xd2, 0xbf, 0x63, 0x55, 0xfa, 0xe5, 0x5c, 0xdd, 0x79,
+	0x72, 0xae, 0xee, 0xfc, 0x73, 0xae, 0xee, 0x7c, 0x7d, 0x32, 0xf3, 0xcd, 0x96, 0xea, 0x1e, 0xb9,
+	0xa8, 0xcb, 0xb3, 0x3f, 0xc6, 0xb0, 0xf9, 0xa1, 0xf1, 0x70, 0xd9, 0xc7, 0x72, 0xb7, 0x12, 0x4f,
+	0xb7, 0x9b, 0xcf, 0x06, 0x00, 0x18, 0xf9, 0x00, 0x7c, 0x0a, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -330,6 +666,9 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateStableswapPool(ctx context.Context, in *MsgCreateStableswapPool, opts ...grpc.CallOption) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(ctx context.Context, in *MsgStableSwapAdjustScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapSetScalingFactorRateProvider(ctx context.Context, in *MsgStableSwapSetScalingFactorRateProvider, opts ...grpc.CallOption) (*MsgStableSwapSetScalingFactorRateProviderResponse, error)
+	StableSwapRemoveScalingFactorRateProvider(ctx context.Context, in *MsgStableSwapRemoveScalingFactorRateProvider, opts ...grpc.CallOption) (*MsgStableSwapRemoveScalingFactorRateProviderResponse, error)
+	StableSwapRampAmplification(ctx context.Context, in *MsgStableSwapRampAmplification, opts ...grpc.CallOption) (*MsgStableSwapRampAmplificationResponse, error)
 }
 
 type msgClient struct {
@@ -358,10 +697,40 @@ func (c *msgClient) StableSwapAdjustScalingFactors(ctx context.Context, in *MsgS
 	return out, nil
 }
 
+func (c *msgClient) StableSwapSetScalingFactorRateProvider(ctx context.Context, in *MsgStableSwapSetScalingFactorRateProvider, opts ...grpc.CallOption) (*MsgStableSwapSetScalingFactorRateProviderResponse, error) {
+	out := new(MsgStableSwapSetScalingFactorRateProviderResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapSetScalingFactorRateProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StableSwapRemoveScalingFactorRateProvider(ctx context.Context, in *MsgStableSwapRemoveScalingFactorRateProvider, opts ...grpc.CallOption) (*MsgStableSwapRemoveScalingFactorRateProviderResponse, error) {
+	out := new(MsgStableSwapRemoveScalingFactorRateProviderResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRemoveScalingFactorRateProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StableSwapRampAmplification(ctx context.Context, in *MsgStableSwapRampAmplification, opts ...grpc.CallOption) (*MsgStableSwapRampAmplificationResponse, error) {
+	out := new(MsgStableSwapRampAmplificationResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampAmplification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateStableswapPool(context.Context, *MsgCreateStableswapPool) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(context.Context, *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapSetScalingFactorRateProvider(context.Context, *MsgStableSwapSetScalingFactorRateProvider) (*MsgStableSwapSetScalingFactorRateProviderResponse, error)
+	StableSwapRemoveScalingFactorRateProvider(context.Context, *MsgStableSwapRemoveScalingFactorRateProvider) (*MsgStableSwapRemoveScalingFactorRateProviderResponse, error)
+	StableSwapRampAmplification(context.Context, *MsgStableSwapRampAmplification) (*MsgStableSwapRampAmplificationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -374,6 +743,15 @@ func (*UnimplementedMsgServer) CreateStableswapPool(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) StableSwapAdjustScalingFactors(ctx context.Context, req *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StableSwapAdjustScalingFactors not implemented")
 }
+func (*UnimplementedMsgServer) StableSwapSetScalingFactorRateProvider(ctx context.Context, req *MsgStableSwapSetScalingFactorRateProvider) (*MsgStableSwapSetScalingFactorRateProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapSetScalingFactorRateProvider not implemented")
+}
+func (*UnimplementedMsgServer) StableSwapRemoveScalingFactorRateProvider(ctx context.Context, req *MsgStableSwapRemoveScalingFactorRateProvider) (*MsgStableSwapRemoveScalingFactorRateProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapRemoveScalingFactorRateProvider not implemented")
+}
+func (*UnimplementedMsgServer) StableSwapRampAmplification(ctx context.Context, req *MsgStableSwapRampAmplification) (*MsgStableSwapRampAmplificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapRampAmplification not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -415,6 +793,60 @@ func _Msg_StableSwapAdjustScalingFactors_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StableSwapSetScalingFactorRateProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapSetScalingFactorRateProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapSetScalingFactorRateProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapSetScalingFactorRateProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapSetScalingFactorRateProvider(ctx, req.(*MsgStableSwapSetScalingFactorRateProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StableSwapRemoveScalingFactorRateProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapRemoveScalingFactorRateProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapRemoveScalingFactorRateProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRemoveScalingFactorRateProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapRemoveScalingFactorRateProvider(ctx, req.(*MsgStableSwapRemoveScalingFactorRateProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StableSwapRampAmplification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapRampAmplification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapRampAmplification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampAmplification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapRampAmplification(ctx, req.(*MsgStableSwapRampAmplification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.stableswap.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -427,6 +859,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StableSwapAdjustScalingFactors",
 			Handler:    _Msg_StableSwapAdjustScalingFactors_Handler,
 		},
+		{
+			MethodName: "StableSwapSetScalingFactorRateProvider",
+			Handler:    _Msg_StableSwapSetScalingFactorRateProvider_Handler,
+		},
+		{
+			MethodName: "StableSwapRemoveScalingFactorRateProvider",
+			Handler:    _Msg_StableSwapRemoveScalingFactorRateProvider_Handler,
+		},
+		{
+			MethodName: "StableSwapRampAmplification",
+			Handler:    _Msg_StableSwapRampAmplification_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/pool-models/stableswap/tx.proto",
@@ -624,13 +1068,205 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgStableSwapSetScalingFactorRateProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RateProvider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapRemoveScalingFactorRateProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRemoveScalingFactorRateProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRemoveScalingFactorRateProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapRemoveScalingFactorRateProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRemoveScalingFactorRateProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRemoveScalingFactorRateProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapRampAmplification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRampAmplification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampAmplification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if m.TargetAmplification != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TargetAmplification))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -717,6 +1353,85 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgStableSwapSetScalingFactorRateProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RateProvider.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStableSwapRemoveScalingFactorRateProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	return n
+}
+
+func (m *MsgStableSwapRemoveScalingFactorRateProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStableSwapRampAmplification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if m.TargetAmplification != 0 {
+		n += 1 + sovTx(uint64(m.TargetAmplification))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1311,6 +2026,525 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MsgStableSwapSetScalingFactorRateProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapRemoveScalingFactorRateProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRemoveScalingFactorRateProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRemoveScalingFactorRateProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapRemoveScalingFactorRateProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRemoveScalingFactorRateProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRemoveScalingFactorRateProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapRampAmplification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmplification", wireType)
+			}
+			m.TargetAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapRampAmplificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
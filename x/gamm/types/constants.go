@@ -10,6 +10,9 @@ const (
 	// i.e. SigFigExponent = 8 is 10^8 which is 100000000. This gives 8 significant figures.
 	SigFigsExponent       = 8
 	BalancerGasFeeForSwap = 10_000
+	// StableswapGasFeeForExitSharesSearch is charged for every single asset exit
+	// simulated while searching for the shares to exit in a stableswap ExitSwapExactAmountOut.
+	StableswapGasFeeForExitSharesSearch = 10_000
 
 	StableswapMinScaledAmtPerAsset = 1
 	// We keep this multiplier at 1, but can increase if needed in the unlikely scenario where default scaling factors of 1 cannot accommodate enough assets
@@ -33,6 +33,15 @@ func (e LiquidityAndScalingFactorCountMismatchError) Error() string {
 	return fmt.Sprintf("liquidity count (%d) must match scaling factor count (%d)", e.LiquidityCount, e.ScalingFactorCount)
 }
 
+type LockDurationPoolGovernorError struct {
+	PoolId   uint64
+	Governor string
+}
+
+func (e LockDurationPoolGovernorError) Error() string {
+	return fmt.Sprintf("pool %d is governed by the lockers of its shares (%s), its weights can only be updated by a governance proposal", e.PoolId, e.Governor)
+}
+
 type PoolMigrationLinkNotFoundError struct {
 	PoolIdLeaving uint64
 }
@@ -41,6 +50,41 @@ func (e PoolMigrationLinkNotFoundError) Error() string {
 	return fmt.Sprintf("given poolIdLeaving (%d) does not have a canonical link for any concentrated pool", e.PoolIdLeaving)
 }
 
+type ScalingFactorRateProviderNotFoundError struct {
+	PoolId uint64
+}
+
+func (e ScalingFactorRateProviderNotFoundError) Error() string {
+	return fmt.Sprintf("pool with ID %d does not have a scaling factor rate provider", e.PoolId)
+}
+
+type DenomNotInPoolError struct {
+	PoolId uint64
+	Denom  string
+}
+
+func (e DenomNotInPoolError) Error() string {
+	return fmt.Sprintf("denom %s is not in pool with ID %d", e.Denom, e.PoolId)
+}
+
+type NonPositiveRateError struct {
+	PoolId uint64
+	Rate   sdk.Dec
+}
+
+func (e NonPositiveRateError) Error() string {
+	return fmt.Sprintf("rate provider for pool with ID %d returned a non-positive rate (%s)", e.PoolId, e.Rate)
+}
+
+type RateProviderOutOfGasError struct {
+	PoolId   uint64
+	GasLimit uint64
+}
+
+func (e RateProviderOutOfGasError) Error() string {
+	return fmt.Sprintf("rate provider contract query for pool with ID %d ran out of gas (limit %d)", e.PoolId, e.GasLimit)
+}
+
 // x/gamm module sentinel errors.
 var (
 	ErrPoolNotFound        = sdkerrors.Register(ModuleName, 1, "pool not found")
@@ -81,4 +125,12 @@ var (
 	ErrInvalidScalingFactors      = sdkerrors.Register(ModuleName, 64, "scaling factors cannot be 0 or use more than 63 bits")
 	ErrHitMaxScaledAssets         = sdkerrors.Register(ModuleName, 65, "post-scaled pool assets can not exceed 10^34")
 	ErrHitMinScaledAssets         = sdkerrors.Register(ModuleName, 66, "post-scaled pool assets can not be less than 1")
+
+	ErrNotPoolGovernor             = sdkerrors.Register(ModuleName, 67, "not pool governor")
+	ErrWeightChangeStartTimeInPast = sdkerrors.Register(ModuleName, 68, "smooth weight change start time can not be in the past")
+
+	ErrInvalidRateSource = sdkerrors.Register(ModuleName, 69, "scaling factor rate provider must have exactly one of a twap source or a contract address")
+
+	ErrTooMuchAmplification      = sdkerrors.Register(ModuleName, 70, "stableswap amplification too large")
+	ErrAmplificationRampTooShort = sdkerrors.Register(ModuleName, 71, "stableswap amplification ramp duration too short")
 )
@@ -1,19 +1,29 @@
 package types
 
 const (
-	TypeEvtPoolJoined    = "pool_joined"
-	TypeEvtPoolExited    = "pool_exited"
-	TypeEvtPoolCreated   = "pool_created"
-	TypeEvtTokenSwapped  = "token_swapped"
-	TypeEvtMigrateShares = "migrate_shares"
+	TypeEvtPoolJoined            = "pool_joined"
+	TypeEvtPoolExited            = "pool_exited"
+	TypeEvtPoolCreated           = "pool_created"
+	TypeEvtTokenSwapped          = "token_swapped"
+	TypeEvtMigrateShares         = "migrate_shares"
+	TypeEvtPoolWeightsUpdated    = "pool_weights_updated"
+	TypeEvtScalingFactorsUpdated = "scaling_factors_updated"
+	TypeEvtAmplificationRamped   = "amplification_ramped"
 
-	AttributeValueCategory     = ModuleName
-	AttributeKeyPoolId         = "pool_id"
-	AttributeKeyPoolIdEntering = "pool_id_entering"
-	AttributeKeyPoolIdLeaving  = "pool_id_leaving"
-	AttributeKeySwapFee        = "swap_fee"
-	AttributeKeyTokensIn       = "tokens_in"
-	AttributeKeyTokensOut      = "tokens_out"
+	AttributeValueCategory          = ModuleName
+	AttributeKeyPoolId              = "pool_id"
+	AttributeKeyPoolIdEntering      = "pool_id_entering"
+	AttributeKeyPoolIdLeaving       = "pool_id_leaving"
+	AttributeKeySwapFee             = "swap_fee"
+	AttributeKeyTokensIn            = "tokens_in"
+	AttributeKeyTokensOut           = "tokens_out"
+	AttributeKeyStartTime           = "start_time"
+	AttributeKeyDuration            = "duration"
+	AttributeKeyTargetWeights       = "target_weights"
+	AttributeKeyScalingFactors      = "scaling_factors"
+	AttributeKeyRate                = "rate"
+	AttributeKeyAmplification       = "amplification"
+	AttributeKeyTargetAmplification = "target_amplification"
 
 	AttributeFreezeDuration = "freeze_duration"
 	AttributePositionId     = "position_id"
@@ -51,6 +51,17 @@ type CommunityPoolKeeper interface {
 type CLKeeper interface {
 	GetPoolFromPoolIdAndConvertToConcentrated(ctx sdk.Context, poolId uint64) (cltypes.ConcentratedPoolExtension, error)
 	CreateFullRangePosition(ctx sdk.Context, concentratedPool cltypes.ConcentratedPoolExtension, owner sdk.AccAddress, coins sdk.Coins, freezeDuration time.Duration) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, joinTime time.Time, err error)
+	CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, amount0Desired, amount1Desired, amount0Min, amount1Min sdk.Int, lowerTick, upperTick int64, freezeDuration time.Duration) (uint64, sdk.Int, sdk.Int, sdk.Dec, time.Time, error)
+}
+
+// TwapKeeper defines the contract needed to read rates for stableswap scaling factor rate providers.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (sdk.Dec, error)
+}
+
+// WasmKeeper defines the contract needed to query rate provider contracts.
+type WasmKeeper interface {
+	QuerySmart(ctx sdk.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error)
 }
 
 // PoolManager defines the interface needed to be fulfilled for
@@ -88,4 +99,10 @@ type PoolManager interface {
 	GetPoolModule(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolModuleI, error)
 
 	RoutePool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error)
+
+	IsPoolPaused(ctx sdk.Context, poolId uint64) bool
+
+	GetTakerFee(ctx sdk.Context, denom0, denom1 string) (sdk.Dec, error)
+
+	SendTakerFee(ctx sdk.Context, sender sdk.AccAddress, takerFee sdk.Coin, tokenOutDenom string) error
 }
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
 
@@ -20,5 +22,16 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	rateProviderPoolIds := make(map[uint64]bool, len(gs.ScalingFactorRateProviders))
+	for _, rateProvider := range gs.ScalingFactorRateProviders {
+		if err := rateProvider.Validate(); err != nil {
+			return err
+		}
+		if rateProviderPoolIds[rateProvider.PoolId] {
+			return fmt.Errorf("duplicate scaling factor rate provider for pool id %d", rateProvider.PoolId)
+		}
+		rateProviderPoolIds[rateProvider.PoolId] = true
+	}
 	return nil
 }
@@ -11,15 +11,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -76,9 +80,10 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 type GenesisState struct {
 	Pools []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 	// will be renamed to next_pool_id in an upcoming version
-	NextPoolNumber   uint64            `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
-	Params           Params            `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
-	MigrationRecords *MigrationRecords `protobuf:"bytes,4,opt,name=migration_records,json=migrationRecords,proto3" json:"migration_records,omitempty"`
+	NextPoolNumber             uint64                      `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
+	Params                     Params                      `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	MigrationRecords           *MigrationRecords           `protobuf:"bytes,4,opt,name=migration_records,json=migrationRecords,proto3" json:"migration_records,omitempty"`
+	ScalingFactorRateProviders []ScalingFactorRateProvider `protobuf:"bytes,5,rep,name=scaling_factor_rate_providers,json=scalingFactorRateProviders,proto3" json:"scaling_factor_rate_providers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -142,6 +147,13 @@ func (m *GenesisState) GetMigrationRecords() *MigrationRecords {
 	return nil
 }
 
+func (m *GenesisState) GetScalingFactorRateProviders() []ScalingFactorRateProvider {
+	if m != nil {
+		return m.ScalingFactorRateProviders
+	}
+	return nil
+}
+
 // MigrationRecords contains all the links between balancer and concentrated
 // pools
 type MigrationRecords struct {
@@ -246,11 +258,179 @@ func (m *BalancerToConcentratedPoolLink) GetClPoolId() uint64 {
 	return 0
 }
 
+// ScalingFactorRateProvider automatically updates the scaling factors of a
+// stableswap pool at the end of every epoch from a rate source, so that the
+// pool follows the redemption rate of a rate-bearing asset such as a staking
+// derivative. Exactly one of twap_source and contract_address must be set.
+type ScalingFactorRateProvider struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// rate_denom is the rate-bearing pool asset, e.g. stATOM.
+	RateDenom string `protobuf:"bytes,2,opt,name=rate_denom,json=rateDenom,proto3" json:"rate_denom,omitempty" yaml:"rate_denom"`
+	// reference_denom is the pool asset the rate is denominated in, e.g. ATOM.
+	// Its scaling factor is kept as is, and the scaling factor of rate_denom is
+	// moved towards the reference scaling factor divided by the rate.
+	ReferenceDenom string `protobuf:"bytes,3,opt,name=reference_denom,json=referenceDenom,proto3" json:"reference_denom,omitempty" yaml:"reference_denom"`
+	// epoch_identifier is the epoch at whose end the scaling factors are updated.
+	EpochIdentifier string `protobuf:"bytes,4,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty" yaml:"epoch_identifier"`
+	// twap_source reads the rate as the arithmetic twap of rate_denom quoted in
+	// reference_denom.
+	TwapSource *TwapRateSource `protobuf:"bytes,5,opt,name=twap_source,json=twapSource,proto3" json:"twap_source,omitempty" yaml:"twap_source"`
+	// contract_address reads the rate from a CosmWasm contract, queried with
+	// {"redemption_rate":{"denom":<rate_denom>}} and returning
+	// {"redemption_rate":<decimal>}.
+	ContractAddress string `protobuf:"bytes,6,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// max_change_per_epoch bounds the relative change of the rate_denom
+	// scaling factor in a single epoch.
+	MaxChangePerEpoch github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_change_per_epoch,json=maxChangePerEpoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_per_epoch" yaml:"max_change_per_epoch"`
+	// ramp_epochs is the number of epochs a change in the rate is spread over.
+	// Every epoch, the scaling factor moves 1/ramp_epochs of the way to its
+	// target.
+	RampEpochs uint64 `protobuf:"varint,8,opt,name=ramp_epochs,json=rampEpochs,proto3" json:"ramp_epochs,omitempty" yaml:"ramp_epochs"`
+}
+
+func (m *ScalingFactorRateProvider) Reset()         { *m = ScalingFactorRateProvider{} }
+func (m *ScalingFactorRateProvider) String() string { return proto.CompactTextString(m) }
+func (*ScalingFactorRateProvider) ProtoMessage()    {}
+func (*ScalingFactorRateProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{4}
+}
+func (m *ScalingFactorRateProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScalingFactorRateProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScalingFactorRateProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScalingFactorRateProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalingFactorRateProvider.Merge(m, src)
+}
+func (m *ScalingFactorRateProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScalingFactorRateProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalingFactorRateProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalingFactorRateProvider proto.InternalMessageInfo
+
+func (m *ScalingFactorRateProvider) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *ScalingFactorRateProvider) GetRateDenom() string {
+	if m != nil {
+		return m.RateDenom
+	}
+	return ""
+}
+
+func (m *ScalingFactorRateProvider) GetReferenceDenom() string {
+	if m != nil {
+		return m.ReferenceDenom
+	}
+	return ""
+}
+
+func (m *ScalingFactorRateProvider) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *ScalingFactorRateProvider) GetTwapSource() *TwapRateSource {
+	if m != nil {
+		return m.TwapSource
+	}
+	return nil
+}
+
+func (m *ScalingFactorRateProvider) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ScalingFactorRateProvider) GetRampEpochs() uint64 {
+	if m != nil {
+		return m.RampEpochs
+	}
+	return 0
+}
+
+// TwapRateSource reads a rate from the arithmetic twap of a pool over the
+// given window, ending at the current block time.
+type TwapRateSource struct {
+	PoolId uint64        `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Window time.Duration `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window" yaml:"window"`
+}
+
+func (m *TwapRateSource) Reset()         { *m = TwapRateSource{} }
+func (m *TwapRateSource) String() string { return proto.CompactTextString(m) }
+func (*TwapRateSource) ProtoMessage()    {}
+func (*TwapRateSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{5}
+}
+func (m *TwapRateSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapRateSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapRateSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapRateSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapRateSource.Merge(m, src)
+}
+func (m *TwapRateSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapRateSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapRateSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapRateSource proto.InternalMessageInfo
+
+func (m *TwapRateSource) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TwapRateSource) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.gamm.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.gamm.v1beta1.GenesisState")
 	proto.RegisterType((*MigrationRecords)(nil), "osmosis.gamm.v1beta1.MigrationRecords")
 	proto.RegisterType((*BalancerToConcentratedPoolLink)(nil), "osmosis.gamm.v1beta1.BalancerToConcentratedPoolLink")
+	proto.RegisterType((*ScalingFactorRateProvider)(nil), "osmosis.gamm.v1beta1.ScalingFactorRateProvider")
+	proto.RegisterType((*TwapRateSource)(nil), "osmosis.gamm.v1beta1.TwapRateSource")
 }
 
 func init() {
@@ -258,41 +438,64 @@ func init() {
 }
 
 var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 536 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x73, 0x6d, 0x1a, 0xfd, 0x7e, 0x57, 0x04, 0xa9, 0x95, 0xc1, 0xad, 0x2a, 0x27, 0x32,
-	0x12, 0xca, 0x92, 0x33, 0x29, 0x74, 0xc9, 0x46, 0x22, 0x81, 0x40, 0x05, 0x55, 0x2e, 0x13, 0x8b,
-	0x75, 0x76, 0xae, 0xc6, 0x8a, 0x7d, 0x4f, 0xe4, 0xbb, 0x54, 0xcd, 0xc6, 0xc8, 0x88, 0xc4, 0x0c,
-	0x62, 0x66, 0xe6, 0x45, 0x54, 0x4c, 0x1d, 0x99, 0x0a, 0x4a, 0x16, 0x66, 0x5e, 0x01, 0xba, 0x3f,
-	0x8e, 0xaa, 0x12, 0x75, 0xb2, 0x9f, 0xe7, 0xf9, 0x3c, 0x5f, 0x3f, 0xcf, 0xf7, 0xce, 0xd8, 0x07,
-	0x51, 0x80, 0xc8, 0x44, 0x90, 0xd2, 0xa2, 0x08, 0xce, 0xfa, 0x31, 0x93, 0xb4, 0x1f, 0xa4, 0x8c,
-	0x33, 0x91, 0x09, 0x32, 0x2d, 0x41, 0x82, 0xd3, 0xb2, 0x0c, 0x51, 0x0c, 0xb1, 0xcc, 0x5e, 0x2b,
-	0x85, 0x14, 0x34, 0x10, 0xa8, 0x37, 0xc3, 0xee, 0xed, 0xa6, 0x00, 0x69, 0xce, 0x02, 0x1d, 0xc5,
-	0xb3, 0xd3, 0x80, 0xf2, 0x79, 0x55, 0x4a, 0xb4, 0x4e, 0x64, 0x7a, 0x4c, 0x60, 0x4b, 0x9e, 0x89,
-	0x82, 0x98, 0x0a, 0xb6, 0x1a, 0x22, 0x81, 0x8c, 0x9b, 0xba, 0xff, 0x19, 0xe1, 0xc6, 0x31, 0x2d,
-	0x69, 0x21, 0x9c, 0x8f, 0x08, 0xef, 0x4c, 0x01, 0xf2, 0x28, 0x29, 0x19, 0x95, 0x19, 0xf0, 0xe8,
-	0x94, 0x31, 0x17, 0x75, 0x36, 0xbb, 0xdb, 0x07, 0xbb, 0xc4, 0xaa, 0x2a, 0x9d, 0x6a, 0x50, 0x32,
-	0x82, 0x8c, 0x0f, 0x8f, 0x2e, 0xae, 0xda, 0xb5, 0x3f, 0x57, 0x6d, 0x77, 0x4e, 0x8b, 0x7c, 0xe0,
-	0xff, 0xa3, 0xe0, 0x7f, 0xfd, 0xd9, 0xee, 0xa6, 0x99, 0x7c, 0x3b, 0x8b, 0x49, 0x02, 0x85, 0x1d,
-	0xcf, 0x3e, 0x7a, 0x62, 0x3c, 0x09, 0xe4, 0x7c, 0xca, 0x84, 0x16, 0x13, 0xe1, 0x3d, 0xd5, 0x3f,
-	0xb2, 0xed, 0x4f, 0x19, 0xf3, 0xdf, 0x6d, 0xe0, 0x3b, 0xcf, 0x8c, 0x69, 0x27, 0x92, 0x4a, 0xe6,
-	0x1c, 0xe2, 0x2d, 0xc5, 0x08, 0x3b, 0x59, 0x8b, 0x18, 0x5f, 0x48, 0xe5, 0x0b, 0x79, 0xc2, 0xe7,
-	0xc3, 0xff, 0xbf, 0x7f, 0xeb, 0x6d, 0x1d, 0x03, 0xe4, 0xcf, 0x43, 0x43, 0x3b, 0x5d, 0xdc, 0xe4,
-	0xec, 0x5c, 0x46, 0x7a, 0x3e, 0x3e, 0x2b, 0x62, 0x56, 0xba, 0x1b, 0x1d, 0xd4, 0xad, 0x87, 0x77,
-	0x55, 0x5e, 0xb1, 0xaf, 0x74, 0xd6, 0x19, 0xe0, 0xc6, 0x54, 0x3b, 0xe2, 0x6e, 0x76, 0x50, 0x77,
-	0xfb, 0x60, 0x9f, 0xac, 0x3b, 0x25, 0x62, 0x5c, 0x1b, 0xd6, 0xd5, 0xfa, 0xa1, 0xed, 0x70, 0x4e,
-	0xf0, 0x4e, 0x91, 0xa5, 0xa5, 0x59, 0xbe, 0x64, 0x09, 0x94, 0x63, 0xe1, 0xd6, 0xb5, 0xcc, 0x83,
-	0xf5, 0x32, 0x2f, 0x2b, 0x3c, 0x34, 0x74, 0xd8, 0x2c, 0x6e, 0x64, 0xfc, 0x4f, 0x08, 0x37, 0x6f,
-	0x62, 0xce, 0x7b, 0x84, 0xef, 0xc7, 0x34, 0xa7, 0x3c, 0x61, 0x65, 0x24, 0x21, 0x4a, 0x80, 0x27,
-	0x8c, 0xcb, 0x92, 0x4a, 0x36, 0x36, 0x4b, 0xe6, 0x19, 0x9f, 0x54, 0x2e, 0x3d, 0x5e, 0xff, 0xf1,
-	0xa1, 0x15, 0x78, 0x0d, 0xa3, 0x6b, 0xed, 0xca, 0x8b, 0xa3, 0x8c, 0x4f, 0xec, 0x6e, 0xed, 0xf8,
-	0x56, 0x4a, 0xf8, 0x1c, 0x7b, 0xb7, 0x0b, 0x29, 0xf3, 0x57, 0xb3, 0xea, 0xd9, 0xb2, 0xb1, 0x8b,
-	0x8c, 0xf9, 0x55, 0x5e, 0x1f, 0xd6, 0xd8, 0xd9, 0xc7, 0x38, 0xc9, 0x57, 0x8c, 0x39, 0xa0, 0xff,
-	0x92, 0xdc, 0x54, 0x07, 0xf5, 0xdf, 0x5f, 0xda, 0x68, 0xf8, 0xe2, 0x62, 0xe1, 0xa1, 0xcb, 0x85,
-	0x87, 0x7e, 0x2d, 0x3c, 0xf4, 0x61, 0xe9, 0xd5, 0x2e, 0x97, 0x5e, 0xed, 0xc7, 0xd2, 0xab, 0xbd,
-	0x79, 0x78, 0xed, 0x9e, 0xd9, 0x85, 0x7b, 0x39, 0x8d, 0x45, 0x15, 0x04, 0x67, 0xfd, 0xc3, 0xe0,
-	0xdc, 0xfc, 0x91, 0xfa, 0xd6, 0xc5, 0x0d, 0x7d, 0x6d, 0x1e, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0xe6, 0xf6, 0xdd, 0x82, 0xae, 0x03, 0x00, 0x00,
+	// 901 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x9b, 0xcd, 0xb6, 0x99, 0x40, 0x7e, 0x58, 0xa1, 0x38, 0x69, 0x59, 0x47, 0x06, 0x55,
+	0x2b, 0xa1, 0xd8, 0xa4, 0xb4, 0x42, 0xca, 0xad, 0xde, 0x10, 0x54, 0x94, 0xa2, 0xc8, 0xe9, 0x09,
+	0x09, 0x59, 0xe3, 0xf1, 0xac, 0x33, 0x8a, 0x3d, 0x63, 0xcd, 0x78, 0x93, 0xdd, 0x0b, 0x67, 0x8e,
+	0x20, 0x2e, 0x5c, 0x40, 0x9c, 0x39, 0xf3, 0x47, 0x54, 0x9c, 0x7a, 0x44, 0x1c, 0x5c, 0x94, 0x5c,
+	0xb8, 0xb2, 0x47, 0x4e, 0x68, 0x7e, 0x78, 0xd9, 0x2c, 0xdb, 0x08, 0x4e, 0xeb, 0xf7, 0xe6, 0xfb,
+	0xbe, 0xf7, 0xf9, 0xbd, 0xb7, 0x63, 0xe0, 0x31, 0x51, 0x30, 0x41, 0x44, 0x90, 0xc1, 0xa2, 0x08,
+	0xce, 0xf7, 0x12, 0x5c, 0xc1, 0xbd, 0x20, 0xc3, 0x14, 0x0b, 0x22, 0xfc, 0x92, 0xb3, 0x8a, 0xd9,
+	0x9b, 0x06, 0xe3, 0x4b, 0x8c, 0x6f, 0x30, 0xdb, 0x9b, 0x19, 0xcb, 0x98, 0x02, 0x04, 0xf2, 0x49,
+	0x63, 0xb7, 0xb7, 0x32, 0xc6, 0xb2, 0x1c, 0x07, 0x2a, 0x4a, 0x06, 0xfd, 0x00, 0xd2, 0x91, 0x39,
+	0xea, 0xcc, 0x1e, 0xa5, 0x03, 0x0e, 0x2b, 0xc2, 0x68, 0x43, 0x45, 0xaa, 0x4e, 0xac, 0x35, 0x75,
+	0xd0, 0x50, 0x75, 0x14, 0x24, 0x50, 0xe0, 0x89, 0x49, 0xc4, 0x88, 0xa1, 0x7a, 0x3f, 0x58, 0xa0,
+	0x7d, 0x0c, 0x39, 0x2c, 0x84, 0xfd, 0xad, 0x05, 0x36, 0x4a, 0xc6, 0xf2, 0x18, 0x71, 0xac, 0xd4,
+	0xe3, 0x3e, 0xc6, 0x8e, 0xb5, 0xb3, 0xd8, 0x5d, 0x79, 0xb8, 0xe5, 0x1b, 0x55, 0xa9, 0xd3, 0xbc,
+	0x88, 0xdf, 0x63, 0x84, 0x86, 0x47, 0x2f, 0x6a, 0x77, 0x61, 0x5c, 0xbb, 0xce, 0x08, 0x16, 0xf9,
+	0xbe, 0xf7, 0x2f, 0x05, 0xef, 0xa7, 0x57, 0x6e, 0x37, 0x23, 0xd5, 0xe9, 0x20, 0xf1, 0x11, 0x2b,
+	0x8c, 0x3d, 0xf3, 0xb3, 0x2b, 0xd2, 0xb3, 0xa0, 0x1a, 0x95, 0x58, 0x28, 0x31, 0x11, 0xad, 0x49,
+	0x7e, 0xcf, 0xd0, 0x0f, 0x31, 0xf6, 0xfe, 0xba, 0x05, 0xde, 0xf8, 0x44, 0x37, 0xf5, 0xa4, 0x82,
+	0x15, 0xb6, 0x1f, 0x83, 0x25, 0x89, 0x11, 0xc6, 0xd9, 0xa6, 0xaf, 0x9b, 0xe3, 0x37, 0xcd, 0xf1,
+	0x9f, 0xd0, 0x51, 0xb8, 0xfc, 0xcb, 0xcf, 0xbb, 0x4b, 0xc7, 0x8c, 0xe5, 0x4f, 0x23, 0x8d, 0xb6,
+	0xbb, 0x60, 0x9d, 0xe2, 0x61, 0x15, 0x2b, 0x7f, 0x74, 0x50, 0x24, 0x98, 0x3b, 0xb7, 0x76, 0xac,
+	0x6e, 0x2b, 0x5a, 0x95, 0x79, 0x89, 0xfd, 0x4c, 0x65, 0xed, 0x7d, 0xd0, 0x2e, 0x55, 0x47, 0x9c,
+	0xc5, 0x1d, 0xab, 0xbb, 0xf2, 0xf0, 0xbe, 0x3f, 0x6f, 0x8a, 0xbe, 0xee, 0x5a, 0xd8, 0x92, 0xaf,
+	0x1f, 0x19, 0x86, 0x7d, 0x02, 0x36, 0x0a, 0x92, 0xe9, 0xe1, 0xc4, 0x1c, 0x23, 0xc6, 0x53, 0xe1,
+	0xb4, 0x94, 0xcc, 0x83, 0xf9, 0x32, 0xcf, 0x1a, 0x78, 0xa4, 0xd1, 0xd1, 0x7a, 0x31, 0x93, 0xb1,
+	0x87, 0xe0, 0x1d, 0x81, 0x60, 0x4e, 0x68, 0x16, 0xf7, 0x21, 0xaa, 0x18, 0x8f, 0x39, 0xac, 0xb0,
+	0x9c, 0xf6, 0x39, 0x49, 0x31, 0x17, 0xce, 0x92, 0xea, 0x44, 0x30, 0xbf, 0xc0, 0x89, 0xa6, 0x1e,
+	0x2a, 0x66, 0x04, 0x2b, 0x7c, 0x6c, 0x78, 0xc6, 0xfa, 0xb6, 0x78, 0x1d, 0x40, 0x78, 0xdf, 0x5b,
+	0x60, 0x7d, 0xd6, 0xa0, 0xfd, 0x95, 0x05, 0xde, 0x4d, 0x60, 0x0e, 0x29, 0xc2, 0x3c, 0xae, 0x58,
+	0x8c, 0x18, 0x45, 0x98, 0x56, 0xd2, 0x53, 0xaa, 0xdb, 0x9b, 0x13, 0x7a, 0xd6, 0xcc, 0xe7, 0xd1,
+	0x7c, 0x57, 0xa1, 0x11, 0x78, 0xce, 0x7a, 0x53, 0x74, 0x39, 0x85, 0x23, 0x42, 0xcf, 0x8c, 0x35,
+	0x37, 0xb9, 0x11, 0x25, 0x3c, 0x0a, 0x3a, 0x37, 0x0b, 0xc9, 0xb1, 0x4f, 0xbc, 0x2a, 0x6f, 0x24,
+	0x75, 0x2c, 0x3d, 0xf6, 0x26, 0xaf, 0xd6, 0x24, 0xb5, 0xef, 0x03, 0x80, 0xf2, 0x09, 0x46, 0xaf,
+	0xc6, 0x1d, 0x94, 0xeb, 0xd3, 0xfd, 0xd6, 0x1f, 0x3f, 0xba, 0x96, 0xf7, 0x67, 0x0b, 0x6c, 0xbd,
+	0xb6, 0x9f, 0xf6, 0xfb, 0xe0, 0xf6, 0xb5, 0x12, 0xa1, 0x3d, 0xae, 0xdd, 0xd5, 0xa9, 0xbf, 0x05,
+	0x49, 0xbd, 0xa8, 0x5d, 0xea, 0x72, 0x8f, 0x00, 0x50, 0x53, 0x4c, 0x31, 0x65, 0x85, 0x2a, 0xb7,
+	0x1c, 0xbe, 0x35, 0xae, 0xdd, 0x0d, 0x8d, 0xff, 0xe7, 0xcc, 0x8b, 0x96, 0x65, 0x70, 0x20, 0x9f,
+	0xed, 0x1e, 0x58, 0xe3, 0xb8, 0x8f, 0x39, 0xa6, 0xa8, 0xa1, 0x2e, 0x2a, 0xea, 0xf6, 0xb8, 0x76,
+	0xef, 0x1a, 0xea, 0x75, 0x80, 0x17, 0xad, 0x4e, 0x32, 0x5a, 0xe4, 0x10, 0xac, 0xe3, 0x92, 0xa1,
+	0xd3, 0x98, 0xa4, 0x98, 0x56, 0xa4, 0x4f, 0x30, 0x57, 0x3b, 0xba, 0x1c, 0xde, 0x1b, 0xd7, 0xee,
+	0xdb, 0x5a, 0x65, 0x16, 0xe1, 0x45, 0x6b, 0x2a, 0xf5, 0x74, 0x92, 0xb1, 0xbf, 0x00, 0x2b, 0xd5,
+	0x05, 0x2c, 0x63, 0xc1, 0x06, 0x1c, 0x61, 0x67, 0x49, 0xad, 0xf9, 0x7b, 0xf3, 0xe7, 0xfd, 0xfc,
+	0x02, 0x96, 0xb2, 0x59, 0x27, 0x0a, 0x1b, 0xde, 0x1d, 0xd7, 0xae, 0xad, 0x0b, 0x4d, 0x49, 0x78,
+	0x11, 0x90, 0x91, 0xc6, 0x48, 0x9b, 0x88, 0xc9, 0x79, 0xa2, 0x2a, 0x86, 0x69, 0xca, 0xb1, 0x10,
+	0x4e, 0x7b, 0xd6, 0xe6, 0x2c, 0xc2, 0x8b, 0xd6, 0x9a, 0xd4, 0x13, 0x9d, 0xb1, 0xbf, 0x04, 0x9b,
+	0x05, 0x1c, 0xc6, 0xe8, 0x14, 0xd2, 0x0c, 0xc7, 0x25, 0xe6, 0xb1, 0x7a, 0x11, 0xe7, 0xb6, 0xd2,
+	0x7a, 0x26, 0x37, 0xed, 0xb7, 0xda, 0x7d, 0xf0, 0x1f, 0xae, 0xa8, 0x03, 0x8c, 0xc6, 0xb5, 0x7b,
+	0x4f, 0x57, 0x9e, 0xa7, 0xe9, 0x45, 0x1b, 0x05, 0x1c, 0xf6, 0x54, 0xf6, 0x18, 0xf3, 0x8f, 0x65,
+	0xce, 0xfe, 0x08, 0xac, 0x70, 0x58, 0x94, 0x1a, 0x21, 0x9c, 0x3b, 0x6a, 0x35, 0xa6, 0x1a, 0x30,
+	0x75, 0xe8, 0x45, 0x40, 0x46, 0x8a, 0x27, 0xcc, 0xce, 0x7d, 0x63, 0x81, 0xd5, 0xeb, 0xdd, 0xfb,
+	0x7f, 0x8b, 0x76, 0x04, 0xda, 0x17, 0x84, 0xa6, 0xec, 0x42, 0x2d, 0x99, 0xbc, 0xca, 0x67, 0x2f,
+	0xcc, 0x03, 0xf3, 0x35, 0x09, 0xb7, 0xcc, 0x55, 0xfe, 0xa6, 0x96, 0xd2, 0x34, 0xef, 0xbb, 0x57,
+	0xae, 0x15, 0x19, 0x0d, 0xed, 0x29, 0xfc, 0xf4, 0xc5, 0x65, 0xc7, 0x7a, 0x79, 0xd9, 0xb1, 0x7e,
+	0xbf, 0xec, 0x58, 0x5f, 0x5f, 0x75, 0x16, 0x5e, 0x5e, 0x75, 0x16, 0x7e, 0xbd, 0xea, 0x2c, 0x7c,
+	0xfe, 0xc1, 0x54, 0x1b, 0xcd, 0x22, 0xec, 0xe6, 0x30, 0x11, 0x4d, 0x10, 0x9c, 0xef, 0x3d, 0x0e,
+	0x86, 0xfa, 0x9b, 0xa9, 0x9a, 0x9a, 0xb4, 0x95, 0x8f, 0x0f, 0xff, 0x1e, 0x00, 0xf5, 0x2c, 0xa8,
+	0x78, 0x50, 0x07, 0x00, 0x00,
 }
 
 func (this *BalancerToConcentratedPoolLink) Equal(that interface{}) bool {
@@ -322,6 +525,78 @@ func (this *BalancerToConcentratedPoolLink) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ScalingFactorRateProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScalingFactorRateProvider)
+	if !ok {
+		that2, ok := that.(ScalingFactorRateProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.RateDenom != that1.RateDenom {
+		return false
+	}
+	if this.ReferenceDenom != that1.ReferenceDenom {
+		return false
+	}
+	if this.EpochIdentifier != that1.EpochIdentifier {
+		return false
+	}
+	if !this.TwapSource.Equal(that1.TwapSource) {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if !this.MaxChangePerEpoch.Equal(that1.MaxChangePerEpoch) {
+		return false
+	}
+	if this.RampEpochs != that1.RampEpochs {
+		return false
+	}
+	return true
+}
+func (this *TwapRateSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TwapRateSource)
+	if !ok {
+		that2, ok := that.(TwapRateSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -379,6 +654,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScalingFactorRateProviders) > 0 {
+		for iNdEx := len(m.ScalingFactorRateProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScalingFactorRateProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.MigrationRecords != nil {
 		{
 			size, err := m.MigrationRecords.MarshalToSizedBuffer(dAtA[:i])
@@ -493,6 +782,125 @@ func (m *BalancerToConcentratedPoolLink) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ScalingFactorRateProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingFactorRateProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalingFactorRateProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RampEpochs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RampEpochs))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MaxChangePerEpoch.Size()
+		i -= size
+		if _, err := m.MaxChangePerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TwapSource != nil {
+		{
+			size, err := m.TwapSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReferenceDenom) > 0 {
+		i -= len(m.ReferenceDenom)
+		copy(dAtA[i:], m.ReferenceDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ReferenceDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RateDenom) > 0 {
+		i -= len(m.RateDenom)
+		copy(dAtA[i:], m.RateDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RateDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TwapRateSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapRateSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapRateSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -540,6 +948,12 @@ func (m *GenesisState) Size() (n int) {
 		l = m.MigrationRecords.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.ScalingFactorRateProviders) > 0 {
+		for _, e := range m.ScalingFactorRateProviders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -573,11 +987,62 @@ func (m *BalancerToConcentratedPoolLink) Size() (n int) {
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ScalingFactorRateProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = len(m.RateDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ReferenceDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TwapSource != nil {
+		l = m.TwapSource.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MaxChangePerEpoch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.RampEpochs != 0 {
+		n += 1 + sovGenesis(uint64(m.RampEpochs))
+	}
+	return n
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *TwapRateSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -814,6 +1279,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorRateProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScalingFactorRateProviders = append(m.ScalingFactorRateProviders, ScalingFactorRateProvider{})
+			if err := m.ScalingFactorRateProviders[len(m.ScalingFactorRateProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1007,6 +1506,394 @@ func (m *BalancerToConcentratedPoolLink) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScalingFactorRateProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScalingFactorRateProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScalingFactorRateProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TwapSource == nil {
+				m.TwapSource = &TwapRateSource{}
+			}
+			if err := m.TwapSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangePerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangePerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampEpochs", wireType)
+			}
+			m.RampEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RampEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapRateSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapRateSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapRateSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -26,6 +26,8 @@ var (
 	// KeyTotalLiquidity defines key to store total liquidity.
 	KeyTotalLiquidity = []byte{0x03}
 	KeyMigrationInfo  = []byte{0x04}
+	// KeyPrefixScalingFactorRateProviders defines prefix to store stableswap scaling factor rate providers.
+	KeyPrefixScalingFactorRateProviders = []byte{0x05}
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -57,3 +59,7 @@ func GetPoolShareDenom(poolId uint64) string {
 func GetKeyPrefixPools(poolId uint64) []byte {
 	return append(KeyPrefixPools, sdk.Uint64ToBigEndian(poolId)...)
 }
+
+func GetKeyScalingFactorRateProvider(poolId uint64) []byte {
+	return append(KeyPrefixScalingFactorRateProviders, sdk.Uint64ToBigEndian(poolId)...)
+}
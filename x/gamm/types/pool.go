@@ -95,6 +95,38 @@ type PoolAmountOutExtension interface {
 	IncreaseLiquidity(sharesOut sdk.Int, coinsIn sdk.Coins)
 }
 
+// SingleAssetExitPoolExtension is an extension of the CFMMPoolI interface
+// for pools that exit liquidity into a single one of their assets with their own curve math,
+// rather than exiting into all assets and swapping them against the pool one by one.
+type SingleAssetExitPoolExtension interface {
+	CFMMPoolI
+
+	// ExitSwapShareAmountIn removes liquidity from the pool with an exact amount of LP shares (shareInAmount)
+	// and returns all of it as one of the pool's assets (tokenOutDenom).
+	ExitSwapShareAmountIn(
+		ctx sdk.Context,
+		tokenOutDenom string,
+		shareInAmount sdk.Int,
+	) (tokenOutAmount sdk.Int, err error)
+
+	// ExitSwapExactAmountOut removes liquidity from the pool with a maximum amount of LP shares (shareInMaxAmount)
+	// and returns an exact amount of one of the pool's assets (tokenOut).
+	ExitSwapExactAmountOut(
+		ctx sdk.Context,
+		tokenOut sdk.Coin,
+		shareInMaxAmount sdk.Int,
+	) (shareInAmount sdk.Int, err error)
+}
+
+// PokablePoolExtension is an extension of the CFMMPoolI interface
+// for pools whose parameters change over time, e.g. with a weight or amplification ramp.
+type PokablePoolExtension interface {
+	CFMMPoolI
+
+	// PokePool updates the pool's time dependent parameters to their values at blockTime.
+	PokePool(blockTime time.Time)
+}
+
 // WeightedPoolExtension is an extension of the PoolI interface
 // That defines an additional API for handling the pool's weights.
 type WeightedPoolExtension interface {
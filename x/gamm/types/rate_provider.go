@@ -0,0 +1,85 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RateProviderQueryGasLimit is the gas limit of a single rate provider contract query.
+	// Rate providers are queried at epoch end, where gas is otherwise unmetered.
+	RateProviderQueryGasLimit = 1_000_000
+	// MaxRateProviderUpdatesPerEpoch is the maximum number of rate providers processed
+	// at the end of a single epoch.
+	MaxRateProviderUpdatesPerEpoch = 100
+)
+
+// RedemptionRateQueryMsg is the query sent to a rate provider contract.
+type RedemptionRateQueryMsg struct {
+	RedemptionRate RedemptionRateQuery `json:"redemption_rate"`
+}
+
+type RedemptionRateQuery struct {
+	Denom string `json:"denom"`
+}
+
+// RedemptionRateResponse is the response expected from a rate provider contract.
+type RedemptionRateResponse struct {
+	RedemptionRate sdk.Dec `json:"redemption_rate"`
+}
+
+// Validate performs stateless validation of the scaling factor rate provider.
+func (p ScalingFactorRateProvider) Validate() error {
+	if p.PoolId == 0 {
+		return fmt.Errorf("pool id must be positive")
+	}
+
+	if err := sdk.ValidateDenom(p.RateDenom); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(p.ReferenceDenom); err != nil {
+		return err
+	}
+
+	if p.RateDenom == p.ReferenceDenom {
+		return fmt.Errorf("rate denom and reference denom must differ, both are %s", p.RateDenom)
+	}
+
+	if p.EpochIdentifier == "" {
+		return errors.New("epoch identifier must not be empty")
+	}
+
+	hasTwapSource := p.TwapSource != nil
+	hasContractSource := p.ContractAddress != ""
+	if hasTwapSource == hasContractSource {
+		return ErrInvalidRateSource
+	}
+
+	if hasTwapSource {
+		if p.TwapSource.PoolId == 0 {
+			return fmt.Errorf("twap source pool id must be positive")
+		}
+		if p.TwapSource.Window <= 0 {
+			return fmt.Errorf("twap source window must be positive, was %s", p.TwapSource.Window)
+		}
+	}
+
+	if hasContractSource {
+		if _, err := sdk.AccAddressFromBech32(p.ContractAddress); err != nil {
+			return fmt.Errorf("invalid contract address (%s): %w", p.ContractAddress, err)
+		}
+	}
+
+	if p.MaxChangePerEpoch.IsNil() || !p.MaxChangePerEpoch.IsPositive() {
+		return fmt.Errorf("max change per epoch must be positive, was %s", p.MaxChangePerEpoch)
+	}
+
+	if p.RampEpochs == 0 {
+		return errors.New("ramp epochs must be positive")
+	}
+
+	return nil
+}
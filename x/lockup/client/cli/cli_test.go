@@ -64,6 +64,35 @@ func TestBeginUnlockingByIDCmd(t *testing.T) {
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
 
+func TestSplitLockCmd(t *testing.T) {
+	desc, _ := NewSplitLockCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgSplitLock]{
+		"basic test": {
+			Cmd: "10 5uosmo --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSplitLock{
+				Owner: testAddresses[0].String(),
+				ID:    10,
+				Coins: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 5)),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestMergeLocksCmd(t *testing.T) {
+	desc, _ := NewMergeLocksCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgMergeLocks]{
+		"basic test": {
+			Cmd: "10,2,5 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgMergeLocks{
+				Owner: testAddresses[0].String(),
+				IDs:   []uint64{10, 2, 5},
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
 func TestModuleBalanceCmd(t *testing.T) {
 	desc, _ := GetCmdModuleBalance()
 	tcs := map[string]osmocli.QueryCliTestCase[*types.ModuleBalanceRequest]{
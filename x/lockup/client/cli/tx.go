@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v15/x/lockup/types"
 )
@@ -15,6 +16,8 @@ func GetTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(cmd, NewBeginUnlockingAllCmd)
 	osmocli.AddTxCmd(cmd, NewBeginUnlockByIDCmd)
 	osmocli.AddTxCmd(cmd, NewForceUnlockByIdCmd)
+	osmocli.AddTxCmd(cmd, NewSplitLockCmd)
+	osmocli.AddTxCmd(cmd, NewMergeLocksCmd)
 
 	return cmd
 }
@@ -62,3 +65,32 @@ func NewForceUnlockByIdCmd() (*osmocli.TxCliDesc, *types.MsgForceUnlock) {
 		Flags: osmocli.FlagDesc{OptionalFlags: []*pflag.FlagSet{FlagSetUnlockTokens()}},
 	}, &types.MsgForceUnlock{}
 }
+
+// NewSplitLockCmd splits coins of an individual period lock into a new lock.
+func NewSplitLockCmd() (*osmocli.TxCliDesc, *types.MsgSplitLock) {
+	return &osmocli.TxCliDesc{
+		Use:     "split-lock [id] [coins]",
+		Short:   "split coins of an individual period lock into a new lock with the same duration",
+		Example: "split-lock 1 1000000gamm/pool/1 --from val --chain-id osmosis-1",
+	}, &types.MsgSplitLock{}
+}
+
+// NewMergeLocksCmd merges period locks with the same denom and duration into the first lock given.
+func NewMergeLocksCmd() (*osmocli.TxCliDesc, *types.MsgMergeLocks) {
+	return &osmocli.TxCliDesc{
+		Use:     "merge-locks [ids]",
+		Short:   "merge period locks with the same denom and duration into the first lock given",
+		Example: "merge-locks 1,2,5 --from val --chain-id osmosis-1",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"IDs": parseLockIds,
+		},
+	}, &types.MsgMergeLocks{}
+}
+
+func parseLockIds(arg string, _ *pflag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	lockIds, err := osmoutils.ParseUint64SliceFromString(arg, ",")
+	if err != nil {
+		return nil, osmocli.UsedArg, err
+	}
+	return lockIds, osmocli.UsedArg, nil
+}
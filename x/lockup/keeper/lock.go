@@ -465,6 +465,173 @@ func (k Keeper) ExtendLockup(ctx sdk.Context, lockID uint64, owner sdk.AccAddres
 	return nil
 }
 
+// SplitLock splits the given coins off a lock into a new lock with the same owner and duration.
+// Synthetic lockups of the original lock are copied onto the new lock, so that the split does not
+// change any accumulation store or superfluid state.
+// Splitting a lock fails on either of the following conditions.
+// 1. Only lock owner is able to split the lock.
+// 2. Locks that are unlocking are not allowed to be split.
+// 3. The coins to split must be positive, and less than the lock's coins for every denom.
+func (k Keeper) SplitLock(ctx sdk.Context, owner sdk.AccAddress, lockID uint64, coins sdk.Coins) (types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	if lock.GetOwner() != owner.String() {
+		return types.PeriodLock{}, types.ErrNotLockOwner
+	}
+
+	if lock.IsUnlocking() {
+		return types.PeriodLock{}, fmt.Errorf("cannot split unlocking lock %d", lock.ID)
+	}
+
+	// the original lock must keep a positive amount of each of its denoms,
+	// so that its lock refs stay unchanged.
+	if coins.Empty() || !coins.IsValid() || !lock.Coins.IsAllGT(coins) {
+		return types.PeriodLock{}, fmt.Errorf("split coins (%s) must be positive and less than the lock's coins (%s)", coins, lock.Coins)
+	}
+
+	synthLocks := k.GetAllSyntheticLockupsByLockup(ctx, lock.ID)
+
+	newLock, err := k.splitLock(ctx, *lock, coins, false)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	err = k.addLockRefs(ctx, newLock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	// copy the synthetic lockups onto the new lock.
+	// accumulation stores are left as is, as the sum of both locks' coins is unchanged.
+	for _, synthLock := range synthLocks {
+		synthLock.UnderlyingLockId = newLock.ID
+		err = k.setSyntheticLockAndResetRefs(ctx, newLock, synthLock)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLockSplit(ctx, lock.OwnerAddress(), lock.ID, newLock.ID, coins)
+	}
+
+	return newLock, nil
+}
+
+// MergeLocks merges the given locks into the first of them, and deletes the others.
+// All locks must be owned by the owner, not unlocking, and lock a single coin of the same denom
+// for the same duration. Since the merged locks are moved rather than unlocked, all of them must
+// also have the same synthetic lockups, so that accumulation stores and superfluid state stay unchanged.
+func (k Keeper) MergeLocks(ctx sdk.Context, owner sdk.AccAddress, lockIDs []uint64) (types.PeriodLock, error) {
+	if len(lockIDs) < 2 {
+		return types.PeriodLock{}, fmt.Errorf("at least two locks are required to merge, got %d", len(lockIDs))
+	}
+
+	locks := make([]types.PeriodLock, 0, len(lockIDs))
+	seen := make(map[uint64]bool, len(lockIDs))
+	for _, lockID := range lockIDs {
+		if seen[lockID] {
+			return types.PeriodLock{}, fmt.Errorf("duplicate lock id %d", lockID)
+		}
+		seen[lockID] = true
+
+		lock, err := k.GetLockByID(ctx, lockID)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+
+		if lock.GetOwner() != owner.String() {
+			return types.PeriodLock{}, types.ErrNotLockOwner
+		}
+
+		if lock.IsUnlocking() {
+			return types.PeriodLock{}, fmt.Errorf("cannot merge unlocking lock %d", lock.ID)
+		}
+
+		locks = append(locks, *lock)
+	}
+
+	targetLock := locks[0]
+	targetCoin, err := targetLock.SingleCoin()
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	targetSynthLocks := k.GetAllSyntheticLockupsByLockup(ctx, targetLock.ID)
+
+	for _, lock := range locks[1:] {
+		coin, err := lock.SingleCoin()
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+
+		if coin.Denom != targetCoin.Denom || lock.Duration != targetLock.Duration {
+			return types.PeriodLock{}, fmt.Errorf("lock %d (%s, %s) does not match the denom and duration of lock %d (%s, %s)",
+				lock.ID, coin.Denom, lock.Duration, targetLock.ID, targetCoin.Denom, targetLock.Duration)
+		}
+
+		synthLocks := k.GetAllSyntheticLockupsByLockup(ctx, lock.ID)
+		if !syntheticLocksMatch(targetSynthLocks, synthLocks) {
+			return types.PeriodLock{}, fmt.Errorf("synthetic lockups of lock %d do not match those of lock %d", lock.ID, targetLock.ID)
+		}
+
+		// the merged lock's synthetic lockups are represented by the target lock's ones from now on,
+		// so only their objects and refs are deleted, leaving the accumulation stores as is.
+		for _, synthLock := range synthLocks {
+			k.deleteSyntheticLockupObject(ctx, lock.ID, synthLock.SynthDenom)
+			err = k.deleteSyntheticLockRefs(ctx, lock, synthLock)
+			if err != nil {
+				return types.PeriodLock{}, err
+			}
+		}
+
+		err = k.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), lock)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+		k.deleteLock(ctx, lock.ID)
+
+		targetLock.Coins = targetLock.Coins.Add(lock.Coins...)
+
+		if k.hooks != nil {
+			k.hooks.OnLocksMerged(ctx, targetLock.OwnerAddress(), targetLock.ID, lock.ID, lock.Coins)
+		}
+	}
+
+	err = k.setLock(ctx, targetLock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	return targetLock, nil
+}
+
+// syntheticLocksMatch returns true if both sets of synthetic lockups have the same synth denoms,
+// durations and end times, regardless of their underlying lock.
+func syntheticLocksMatch(synthLocksA, synthLocksB []types.SyntheticLock) bool {
+	if len(synthLocksA) != len(synthLocksB) {
+		return false
+	}
+
+	for _, synthLockA := range synthLocksA {
+		found := false
+		for _, synthLockB := range synthLocksB {
+			if synthLockA.SynthDenom == synthLockB.SynthDenom &&
+				synthLockA.Duration == synthLockB.Duration &&
+				synthLockA.EndTime.Equal(synthLockB.EndTime) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 // InitializeAllLocks takes a set of locks, and initializes state to be storing
 // them all correctly. This utilizes batch optimizations to improve efficiency,
 // as this becomes a bottleneck at chain initialization & upgrades.
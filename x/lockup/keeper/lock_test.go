@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"time"
 
+	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v15/x/lockup/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v15/x/superfluid/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func (suite *KeeperTestSuite) TestBeginUnlocking() { // test for all unlockable coins
@@ -1061,3 +1064,304 @@ func (suite *KeeperTestSuite) TestPartialForceUnlock() {
 		}
 	}
 }
+
+// setupSuperfluidLocks creates a pool share lock for each of the given amounts, with the
+// staking unbonding duration so that the locks can be superfluid delegated.
+func (suite *KeeperTestSuite) setupSuperfluidLocks(owner sdk.AccAddress, amounts []int64) (string, time.Duration, []uint64) {
+	poolId := suite.PrepareBalancerPoolWithCoins(sdk.NewCoin("stake", sdk.NewInt(1000000000000)), sdk.NewCoin("foo", sdk.NewInt(5000)))
+	denom := gammtypes.GetPoolShareDenom(poolId)
+	err := suite.App.SuperfluidKeeper.AddNewSuperfluidAsset(suite.Ctx, superfluidtypes.SuperfluidAsset{
+		Denom:     denom,
+		AssetType: superfluidtypes.SuperfluidAssetTypeLPShare,
+	})
+	suite.Require().NoError(err)
+
+	duration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+	lockIds := []uint64{}
+	for _, amount := range amounts {
+		coins := sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+		suite.FundAcc(owner, coins)
+		lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, owner, coins, duration)
+		suite.Require().NoError(err)
+		lockIds = append(lockIds, lock.ID)
+	}
+	return denom, duration, lockIds
+}
+
+func (suite *KeeperTestSuite) TestSplitLock() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	defaultLockAmount := int64(1000000)
+
+	tests := map[string]struct {
+		owner                sdk.AccAddress
+		amountToSplit        int64
+		splitDenom           string
+		superfluidDelegate   bool
+		superfluidUndelegate bool
+		beginUnlock          bool
+		expectErr            bool
+	}{
+		"split bonded lock": {
+			amountToSplit: 400000,
+		},
+		"split superfluid delegated lock": {
+			amountToSplit:      400000,
+			superfluidDelegate: true,
+		},
+		"split superfluid undelegating lock": {
+			amountToSplit:        400000,
+			superfluidDelegate:   true,
+			superfluidUndelegate: true,
+		},
+		"error: sender is not the lock owner": {
+			owner:         addr2,
+			amountToSplit: 400000,
+			expectErr:     true,
+		},
+		"error: lock is unlocking": {
+			amountToSplit: 400000,
+			beginUnlock:   true,
+			expectErr:     true,
+		},
+		"error: split the entire lock": {
+			amountToSplit: defaultLockAmount,
+			expectErr:     true,
+		},
+		"error: split more than locked": {
+			amountToSplit: defaultLockAmount + 1,
+			expectErr:     true,
+		},
+		"error: split a denom that is not locked": {
+			amountToSplit: 400000,
+			splitDenom:    "foo",
+			expectErr:     true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			lockupKeeper := suite.App.LockupKeeper
+			denom, duration, lockIds := suite.setupSuperfluidLocks(addr1, []int64{defaultLockAmount})
+			lockId := lockIds[0]
+
+			if tc.superfluidDelegate {
+				valAddr := suite.SetupValidator(stakingtypes.Bonded)
+				err := suite.App.SuperfluidKeeper.SuperfluidDelegate(suite.Ctx, addr1.String(), lockId, valAddr.String())
+				suite.Require().NoError(err)
+			}
+			if tc.superfluidUndelegate {
+				err := suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, addr1.String(), lockId)
+				suite.Require().NoError(err)
+			}
+			if tc.beginUnlock {
+				_, err := lockupKeeper.BeginUnlock(suite.Ctx, lockId, nil)
+				suite.Require().NoError(err)
+			}
+
+			owner := addr1
+			if tc.owner != nil {
+				owner = tc.owner
+			}
+			splitDenom := denom
+			if tc.splitDenom != "" {
+				splitDenom = tc.splitDenom
+			}
+			coinsToSplit := sdk.NewCoins(sdk.NewInt64Coin(splitDenom, tc.amountToSplit))
+			synthLocksBefore := lockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, lockId)
+			intermediaryAccBefore := suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, lockId)
+
+			newLock, err := lockupKeeper.SplitLock(suite.Ctx, owner, lockId, coinsToSplit)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				lock, err := lockupKeeper.GetLockByID(suite.Ctx, lockId)
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, defaultLockAmount)), lock.Coins)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the coins are moved to a new lock with the same owner and duration
+			lock, err := lockupKeeper.GetLockByID(suite.Ctx, lockId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, defaultLockAmount-tc.amountToSplit)), lock.Coins)
+			suite.Require().Equal(coinsToSplit, newLock.Coins)
+			suite.Require().Equal(lock.Owner, newLock.Owner)
+			suite.Require().Equal(duration, newLock.Duration)
+			suite.Require().False(newLock.IsUnlocking())
+
+			storedNewLock, err := lockupKeeper.GetLockByID(suite.Ctx, newLock.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(newLock, *storedNewLock)
+			suite.Require().Len(lockupKeeper.GetAccountLockedLongerDurationDenom(suite.Ctx, addr1, denom, duration), 2)
+
+			// accumulation stores are unchanged
+			acc := lockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+				Denom:    denom,
+				Duration: duration,
+			})
+			suite.Require().Equal(defaultLockAmount, acc.Int64())
+
+			// synthetic lockups are copied onto the new lock
+			synthLocks := lockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, newLock.ID)
+			suite.Require().Len(synthLocks, len(synthLocksBefore))
+			for i, synthLock := range synthLocksBefore {
+				suite.Require().Equal(synthLock, lockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, lockId)[i])
+				synthLock.UnderlyingLockId = newLock.ID
+				suite.Require().Equal(synthLock, synthLocks[i])
+
+				acc := lockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+					Denom:    synthLock.SynthDenom,
+					Duration: synthLock.Duration,
+				})
+				suite.Require().Equal(defaultLockAmount, acc.Int64())
+			}
+
+			// the new lock is connected to the same intermediary account
+			suite.Require().Equal(intermediaryAccBefore, suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, newLock.ID))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMergeLocks() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	defaultLockAmounts := []int64{1000000, 2000000, 3000000}
+
+	tests := map[string]struct {
+		owner                    sdk.AccAddress
+		lockIds                  []uint64
+		superfluidDelegatedLocks []uint64
+		superfluidUndelegate     bool
+		extraLockDuration        time.Duration
+		beginUnlock              bool
+		expectErr                bool
+	}{
+		"merge bonded locks": {
+			lockIds: []uint64{2, 1, 3},
+		},
+		"merge superfluid delegated locks": {
+			lockIds:                  []uint64{1, 2, 3},
+			superfluidDelegatedLocks: []uint64{1, 2, 3},
+		},
+		"merge superfluid undelegating locks": {
+			lockIds:                  []uint64{1, 2, 3},
+			superfluidDelegatedLocks: []uint64{1, 2, 3},
+			superfluidUndelegate:     true,
+		},
+		"error: single lock": {
+			lockIds:   []uint64{1},
+			expectErr: true,
+		},
+		"error: duplicate lock": {
+			lockIds:   []uint64{1, 2, 1},
+			expectErr: true,
+		},
+		"error: sender is not the lock owner": {
+			owner:     addr2,
+			lockIds:   []uint64{1, 2},
+			expectErr: true,
+		},
+		"error: lock is unlocking": {
+			lockIds:     []uint64{1, 2},
+			beginUnlock: true,
+			expectErr:   true,
+		},
+		"error: different durations": {
+			lockIds:           []uint64{1, 4},
+			extraLockDuration: time.Hour,
+			expectErr:         true,
+		},
+		"error: only some of the locks are superfluid delegated": {
+			lockIds:                  []uint64{1, 2},
+			superfluidDelegatedLocks: []uint64{1},
+			expectErr:                true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			lockupKeeper := suite.App.LockupKeeper
+			denom, duration, _ := suite.setupSuperfluidLocks(addr1, defaultLockAmounts)
+			totalLocked := int64(6000000)
+
+			if tc.extraLockDuration != 0 {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(denom, defaultLockAmounts[0]))
+				suite.FundAcc(addr1, coins)
+				_, err := lockupKeeper.CreateLock(suite.Ctx, addr1, coins, tc.extraLockDuration)
+				suite.Require().NoError(err)
+			}
+
+			if len(tc.superfluidDelegatedLocks) > 0 {
+				valAddr := suite.SetupValidator(stakingtypes.Bonded)
+				for _, lockId := range tc.superfluidDelegatedLocks {
+					err := suite.App.SuperfluidKeeper.SuperfluidDelegate(suite.Ctx, addr1.String(), lockId, valAddr.String())
+					suite.Require().NoError(err)
+					if tc.superfluidUndelegate {
+						err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, addr1.String(), lockId)
+						suite.Require().NoError(err)
+					}
+				}
+			}
+			if tc.beginUnlock {
+				_, err := lockupKeeper.BeginUnlock(suite.Ctx, tc.lockIds[1], nil)
+				suite.Require().NoError(err)
+			}
+
+			owner := addr1
+			if tc.owner != nil {
+				owner = tc.owner
+			}
+			synthLocksBefore := lockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, tc.lockIds[0])
+			intermediaryAccBefore := suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, tc.lockIds[0])
+
+			lock, err := lockupKeeper.MergeLocks(suite.Ctx, owner, tc.lockIds)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				for _, lockId := range tc.lockIds {
+					_, err := lockupKeeper.GetLockByID(suite.Ctx, lockId)
+					suite.Require().NoError(err)
+				}
+				return
+			}
+			suite.Require().NoError(err)
+
+			// all coins are moved to the first lock, and the other locks are deleted
+			suite.Require().Equal(tc.lockIds[0], lock.ID)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, totalLocked)), lock.Coins)
+			storedLock, err := lockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(lock, *storedLock)
+			for _, lockId := range tc.lockIds[1:] {
+				_, err := lockupKeeper.GetLockByID(suite.Ctx, lockId)
+				suite.Require().Error(err)
+				suite.Require().Empty(lockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, lockId))
+				suite.Require().Empty(suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, lockId))
+			}
+			locks := lockupKeeper.GetAccountLockedLongerDurationDenom(suite.Ctx, addr1, denom, duration)
+			suite.Require().Len(locks, 1)
+			suite.Require().Equal(lock, locks[0])
+
+			// accumulation stores are unchanged
+			acc := lockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+				Denom:    denom,
+				Duration: duration,
+			})
+			suite.Require().Equal(totalLocked, acc.Int64())
+
+			// the merged lock keeps its synthetic lockups and intermediary account
+			suite.Require().Equal(synthLocksBefore, lockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, lock.ID))
+			for _, synthLock := range synthLocksBefore {
+				acc := lockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+					Denom:    synthLock.SynthDenom,
+					Duration: synthLock.Duration,
+				})
+				suite.Require().Equal(totalLocked, acc.Int64())
+				suite.Require().Len(lockupKeeper.GetLocksLongerThanDurationDenom(suite.Ctx, synthLock.SynthDenom, synthLock.Duration), 1)
+			}
+			suite.Require().Equal(intermediaryAccBefore, suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, lock.ID))
+		})
+	}
+}
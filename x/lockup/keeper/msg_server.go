@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v15/x/lockup/types"
@@ -222,3 +223,66 @@ func (server msgServer) ForceUnlock(goCtx context.Context, msg *types.MsgForceUn
 
 	return &types.MsgForceUnlockResponse{Success: true}, nil
 }
+
+// SplitLock splits the given coins off an existing lock into a new lock with the same duration.
+// Synthetic lockups, and thus superfluid delegations, of the lock are carried over to the new lock.
+func (server msgServer) SplitLock(goCtx context.Context, msg *types.MsgSplitLock) (*types.MsgSplitLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	newLock, err := server.keeper.SplitLock(ctx, owner, msg.ID, msg.Coins)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSplitLock,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributeNewPeriodLockID, osmoutils.Uint64ToString(newLock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, newLock.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, newLock.Coins.String()),
+			sdk.NewAttribute(types.AttributePeriodLockDuration, newLock.Duration.String()),
+		),
+	})
+
+	return &types.MsgSplitLockResponse{NewLockID: newLock.ID}, nil
+}
+
+// MergeLocks merges existing locks with the same owner, denom and duration into the first lock given.
+// The other locks are deleted, and their synthetic lockups are represented by the remaining lock's.
+func (server msgServer) MergeLocks(goCtx context.Context, msg *types.MsgMergeLocks) (*types.MsgMergeLocksResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := server.keeper.MergeLocks(ctx, owner, msg.IDs)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	mergedLockIDs := make([]string, 0, len(msg.IDs)-1)
+	for _, id := range msg.IDs[1:] {
+		mergedLockIDs = append(mergedLockIDs, osmoutils.Uint64ToString(id))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtMergeLocks,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(lock.ID)),
+			sdk.NewAttribute(types.AttributeMergedLockIDs, strings.Join(mergedLockIDs, ",")),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, lock.Coins.String()),
+			sdk.NewAttribute(types.AttributePeriodLockDuration, lock.Duration.String()),
+		),
+	})
+
+	return &types.MsgMergeLocksResponse{ID: lock.ID}, nil
+}
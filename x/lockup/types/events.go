@@ -6,6 +6,8 @@ const (
 	TypeEvtAddTokensToLock = "add_tokens_to_lock"
 	TypeEvtBeginUnlockAll  = "begin_unlock_all"
 	TypeEvtBeginUnlock     = "begin_unlock"
+	TypeEvtSplitLock       = "split_lock"
+	TypeEvtMergeLocks      = "merge_locks"
 
 	AttributePeriodLockID         = "period_lock_id"
 	AttributePeriodLockOwner      = "owner"
@@ -13,4 +15,6 @@ const (
 	AttributePeriodLockDuration   = "duration"
 	AttributePeriodLockUnlockTime = "unlock_time"
 	AttributeUnlockedCoins        = "unlocked_coins"
+	AttributeNewPeriodLockID      = "new_period_lock_id"
+	AttributeMergedLockIDs        = "merged_lock_ids"
 )
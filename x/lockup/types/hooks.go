@@ -13,6 +13,8 @@ type LockupHooks interface {
 	OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 	OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration time.Duration, newDuration time.Duration)
+	OnLockSplit(ctx sdk.Context, address sdk.AccAddress, lockID uint64, newLockID uint64, amount sdk.Coins)
+	OnLocksMerged(ctx sdk.Context, address sdk.AccAddress, lockID uint64, mergedLockID uint64, amount sdk.Coins)
 }
 
 var _ LockupHooks = MultiLockupHooks{}
@@ -59,3 +61,15 @@ func (h MultiLockupHooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDur
 		h[i].OnLockupExtend(ctx, lockID, prevDuration, newDuration)
 	}
 }
+
+func (h MultiLockupHooks) OnLockSplit(ctx sdk.Context, address sdk.AccAddress, lockID, newLockID uint64, amount sdk.Coins) {
+	for i := range h {
+		h[i].OnLockSplit(ctx, address, lockID, newLockID, amount)
+	}
+}
+
+func (h MultiLockupHooks) OnLocksMerged(ctx sdk.Context, address sdk.AccAddress, lockID, mergedLockID uint64, amount sdk.Coins) {
+	for i := range h {
+		h[i].OnLocksMerged(ctx, address, lockID, mergedLockID, amount)
+	}
+}
@@ -15,6 +15,8 @@ const (
 	TypeMsgBeginUnlocking    = "begin_unlocking"
 	TypeMsgExtendLockup      = "edit_lockup"
 	TypeForceUnlock          = "force_unlock"
+	TypeMsgSplitLock         = "split_lock"
+	TypeMsgMergeLocks        = "merge_locks"
 )
 
 var _ sdk.Msg = &MsgLockTokens{}
@@ -204,3 +206,85 @@ func (m MsgForceUnlock) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgSplitLock{}
+
+// NewMsgSplitLock creates a message to split coins off an existing lock into a new lock.
+func NewMsgSplitLock(owner sdk.AccAddress, id uint64, coins sdk.Coins) *MsgSplitLock {
+	return &MsgSplitLock{
+		Owner: owner.String(),
+		ID:    id,
+		Coins: coins,
+	}
+}
+
+func (m MsgSplitLock) Route() string { return RouterKey }
+func (m MsgSplitLock) Type() string  { return TypeMsgSplitLock }
+func (m MsgSplitLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+
+	if m.ID == 0 {
+		return fmt.Errorf("id is empty")
+	}
+
+	if m.Coins.Empty() || !m.Coins.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Coins.String())
+	}
+	return nil
+}
+
+func (m MsgSplitLock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSplitLock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgMergeLocks{}
+
+// NewMsgMergeLocks creates a message to merge existing locks into the first of them.
+func NewMsgMergeLocks(owner sdk.AccAddress, ids []uint64) *MsgMergeLocks {
+	return &MsgMergeLocks{
+		Owner: owner.String(),
+		IDs:   ids,
+	}
+}
+
+func (m MsgMergeLocks) Route() string { return RouterKey }
+func (m MsgMergeLocks) Type() string  { return TypeMsgMergeLocks }
+func (m MsgMergeLocks) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+
+	if len(m.IDs) < 2 {
+		return fmt.Errorf("at least two lock ids are required, got %d", len(m.IDs))
+	}
+
+	seen := make(map[uint64]bool, len(m.IDs))
+	for _, id := range m.IDs {
+		if id == 0 {
+			return fmt.Errorf("id is empty")
+		}
+		if seen[id] {
+			return fmt.Errorf("duplicate lock id %d", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+func (m MsgMergeLocks) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgMergeLocks) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
@@ -262,6 +262,136 @@ func TestMsgExtendLockup(t *testing.T) {
 	}
 }
 
+func TestMsgSplitLock(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSplitLock
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgSplitLock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgSplitLock{
+				Owner: invalidAddr,
+				ID:    1,
+				Coins: sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgSplitLock{
+				Owner: addr1,
+				ID:    0,
+				Coins: sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
+			},
+		},
+		{
+			name: "empty coins",
+			msg: types.MsgSplitLock{
+				Owner: addr1,
+				ID:    1,
+			},
+		},
+		{
+			name: "zero coins",
+			msg: types.MsgSplitLock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.Coins{sdk.NewInt64Coin("test", 0)},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "split_lock")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgMergeLocks(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgMergeLocks
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgMergeLocks{
+				Owner: addr1,
+				IDs:   []uint64{1, 2, 3},
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgMergeLocks{
+				Owner: invalidAddr,
+				IDs:   []uint64{1, 2},
+			},
+		},
+		{
+			name: "single lockup ID",
+			msg: types.MsgMergeLocks{
+				Owner: addr1,
+				IDs:   []uint64{1},
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgMergeLocks{
+				Owner: addr1,
+				IDs:   []uint64{1, 0},
+			},
+		},
+		{
+			name: "duplicate lockup ID",
+			msg: types.MsgMergeLocks{
+				Owner: addr1,
+				IDs:   []uint64{1, 2, 1},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "merge_locks")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
 // // Test authz serialize and de-serializes for lockup msg.
 func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
@@ -299,6 +429,21 @@ func TestAuthzMsg(t *testing.T) {
 				Owner: addr1,
 			},
 		},
+		{
+			name: "MsgSplitLock",
+			msg: &types.MsgSplitLock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(coin),
+			},
+		},
+		{
+			name: "MsgMergeLocks",
+			msg: &types.MsgMergeLocks{
+				Owner: addr1,
+				IDs:   []uint64{1, 2},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
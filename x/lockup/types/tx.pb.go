@@ -554,6 +554,211 @@ func (m *MsgForceUnlockResponse) GetSuccess() bool {
 	return false
 }
 
+// MsgSplitLock splits the given coins off a lock that is not unlocking
+// into a new lock with the same owner and duration.
+type MsgSplitLock struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ID    uint64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	// Amount of coins moved to the new lock. Must be less than the lock's coins.
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *MsgSplitLock) Reset()         { *m = MsgSplitLock{} }
+func (m *MsgSplitLock) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitLock) ProtoMessage()    {}
+func (*MsgSplitLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{10}
+}
+func (m *MsgSplitLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitLock.Merge(m, src)
+}
+func (m *MsgSplitLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitLock proto.InternalMessageInfo
+
+func (m *MsgSplitLock) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgSplitLock) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *MsgSplitLock) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+type MsgSplitLockResponse struct {
+	NewLockID uint64 `protobuf:"varint,1,opt,name=newLockID,proto3" json:"newLockID,omitempty"`
+}
+
+func (m *MsgSplitLockResponse) Reset()         { *m = MsgSplitLockResponse{} }
+func (m *MsgSplitLockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitLockResponse) ProtoMessage()    {}
+func (*MsgSplitLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{11}
+}
+func (m *MsgSplitLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitLockResponse.Merge(m, src)
+}
+func (m *MsgSplitLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitLockResponse proto.InternalMessageInfo
+
+func (m *MsgSplitLockResponse) GetNewLockID() uint64 {
+	if m != nil {
+		return m.NewLockID
+	}
+	return 0
+}
+
+// MsgMergeLocks merges locks that are not unlocking and have the same owner,
+// denom and duration into the first lock of the list.
+type MsgMergeLocks struct {
+	Owner string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	IDs   []uint64 `protobuf:"varint,2,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
+}
+
+func (m *MsgMergeLocks) Reset()         { *m = MsgMergeLocks{} }
+func (m *MsgMergeLocks) String() string { return proto.CompactTextString(m) }
+func (*MsgMergeLocks) ProtoMessage()    {}
+func (*MsgMergeLocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{12}
+}
+func (m *MsgMergeLocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMergeLocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMergeLocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMergeLocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMergeLocks.Merge(m, src)
+}
+func (m *MsgMergeLocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMergeLocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMergeLocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMergeLocks proto.InternalMessageInfo
+
+func (m *MsgMergeLocks) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgMergeLocks) GetIDs() []uint64 {
+	if m != nil {
+		return m.IDs
+	}
+	return nil
+}
+
+type MsgMergeLocksResponse struct {
+	ID uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (m *MsgMergeLocksResponse) Reset()         { *m = MsgMergeLocksResponse{} }
+func (m *MsgMergeLocksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMergeLocksResponse) ProtoMessage()    {}
+func (*MsgMergeLocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{13}
+}
+func (m *MsgMergeLocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMergeLocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMergeLocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMergeLocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMergeLocksResponse.Merge(m, src)
+}
+func (m *MsgMergeLocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMergeLocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMergeLocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMergeLocksResponse proto.InternalMessageInfo
+
+func (m *MsgMergeLocksResponse) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgLockTokens)(nil), "osmosis.lockup.MsgLockTokens")
 	proto.RegisterType((*MsgLockTokensResponse)(nil), "osmosis.lockup.MsgLockTokensResponse")
@@ -565,53 +770,62 @@ func init() {
 	proto.RegisterType((*MsgExtendLockupResponse)(nil), "osmosis.lockup.MsgExtendLockupResponse")
 	proto.RegisterType((*MsgForceUnlock)(nil), "osmosis.lockup.MsgForceUnlock")
 	proto.RegisterType((*MsgForceUnlockResponse)(nil), "osmosis.lockup.MsgForceUnlockResponse")
+	proto.RegisterType((*MsgSplitLock)(nil), "osmosis.lockup.MsgSplitLock")
+	proto.RegisterType((*MsgSplitLockResponse)(nil), "osmosis.lockup.MsgSplitLockResponse")
+	proto.RegisterType((*MsgMergeLocks)(nil), "osmosis.lockup.MsgMergeLocks")
+	proto.RegisterType((*MsgMergeLocksResponse)(nil), "osmosis.lockup.MsgMergeLocksResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/tx.proto", fileDescriptor_bcdad5af0d24735f) }
 
 var fileDescriptor_bcdad5af0d24735f = []byte{
-	// 645 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0x8e, 0x93, 0x5f, 0x7f, 0x2d, 0xd3, 0x92, 0x52, 0xab, 0xd0, 0xd4, 0x02, 0xbb, 0x58, 0xd0,
-	0x06, 0xa9, 0xf5, 0x92, 0x14, 0x2e, 0x1c, 0x90, 0x08, 0x05, 0xa9, 0x52, 0x23, 0x21, 0xab, 0x95,
-	0x10, 0x07, 0x90, 0xed, 0x2e, 0x5b, 0x2b, 0x8e, 0xd7, 0xca, 0xda, 0xa5, 0xb9, 0xf3, 0x00, 0x1c,
-	0x79, 0x06, 0x90, 0xb8, 0xf0, 0x12, 0x3d, 0x56, 0xe2, 0xc2, 0x29, 0x45, 0xc9, 0x8d, 0x63, 0x9f,
-	0x00, 0x79, 0x9d, 0xb5, 0x9c, 0x3f, 0x6a, 0x22, 0x24, 0x10, 0x27, 0x67, 0xf7, 0x9b, 0xf9, 0xe6,
-	0x9b, 0xcf, 0x33, 0x31, 0xac, 0x50, 0xd6, 0xa4, 0xcc, 0x65, 0xc8, 0xa3, 0x4e, 0x23, 0x0a, 0x50,
-	0x78, 0x62, 0x04, 0x2d, 0x1a, 0x52, 0xb9, 0xd8, 0x07, 0x8c, 0x04, 0x50, 0x96, 0x09, 0x25, 0x94,
-	0x43, 0x28, 0xfe, 0x95, 0x44, 0x29, 0x2a, 0xa1, 0x94, 0x78, 0x18, 0xf1, 0x93, 0x1d, 0xbd, 0x45,
-	0x87, 0x51, 0xcb, 0x0a, 0x5d, 0xea, 0x0b, 0xdc, 0xe1, 0x34, 0xc8, 0xb6, 0x18, 0x46, 0xc7, 0x15,
-	0x1b, 0x87, 0x56, 0x05, 0x39, 0xd4, 0x15, 0xf8, 0xea, 0x50, 0xf9, 0xf8, 0x91, 0x40, 0xfa, 0xfb,
-	0x3c, 0x5c, 0xad, 0x33, 0xb2, 0x47, 0x9d, 0xc6, 0x3e, 0x6d, 0x60, 0x9f, 0xc9, 0xeb, 0x30, 0x43,
-	0xdf, 0xf9, 0xb8, 0x55, 0x92, 0xd6, 0xa4, 0xf2, 0x95, 0xda, 0xb5, 0x8b, 0x8e, 0xb6, 0xd0, 0xb6,
-	0x9a, 0xde, 0x23, 0x9d, 0x5f, 0xeb, 0x66, 0x02, 0xcb, 0x47, 0x30, 0x27, 0x64, 0x94, 0xf2, 0x6b,
-	0x52, 0x79, 0xbe, 0xba, 0x6a, 0x24, 0x3a, 0x0d, 0xa1, 0xd3, 0xd8, 0xe9, 0x07, 0xd4, 0x2a, 0xa7,
-	0x1d, 0x2d, 0xf7, 0xb3, 0xa3, 0xc9, 0x22, 0x65, 0x93, 0x36, 0xdd, 0x10, 0x37, 0x83, 0xb0, 0x7d,
-	0xd1, 0xd1, 0x16, 0x13, 0x7e, 0x81, 0xe9, 0x1f, 0xcf, 0x35, 0xc9, 0x4c, 0xd9, 0x65, 0x0b, 0x66,
-	0xe2, 0x66, 0x58, 0xa9, 0xb0, 0x56, 0xe0, 0x65, 0x92, 0x76, 0x8d, 0xb8, 0x5d, 0xa3, 0xdf, 0xae,
-	0xf1, 0x94, 0xba, 0x7e, 0xed, 0x7e, 0x5c, 0xe6, 0xd3, 0xb9, 0x56, 0x26, 0x6e, 0x78, 0x14, 0xd9,
-	0x86, 0x43, 0x9b, 0xa8, 0xef, 0x4d, 0xf2, 0xd8, 0x62, 0x87, 0x0d, 0x14, 0xb6, 0x03, 0xcc, 0x78,
-	0x02, 0x33, 0x13, 0x66, 0x7d, 0x03, 0xae, 0x0f, 0xb8, 0x60, 0x62, 0x16, 0x50, 0x9f, 0x61, 0xb9,
-	0x08, 0xf9, 0xdd, 0x1d, 0x6e, 0xc5, 0x7f, 0x66, 0x7e, 0x77, 0x47, 0x7f, 0x0c, 0xcb, 0x75, 0x46,
-	0x6a, 0x98, 0xb8, 0xfe, 0x81, 0x1f, 0xfb, 0xe8, 0xfa, 0xe4, 0x89, 0xe7, 0x4d, 0xeb, 0x9a, 0xbe,
-	0x0f, 0x37, 0xc7, 0xe5, 0xa7, 0xf5, 0x1e, 0xc0, 0x6c, 0xc4, 0xef, 0x59, 0x49, 0xe2, 0xdd, 0x2a,
-	0xc6, 0xe0, 0x88, 0x18, 0x2f, 0x70, 0xcb, 0xa5, 0x87, 0xb1, 0x54, 0x53, 0x84, 0xea, 0x5f, 0x24,
-	0x58, 0x1a, 0xa1, 0x9d, 0xfa, 0x4d, 0x26, 0x3d, 0xe6, 0x45, 0x8f, 0x7f, 0xc3, 0xef, 0x37, 0xb0,
-	0x3a, 0xa2, 0x37, 0xf5, 0xa0, 0x04, 0xb3, 0x2c, 0x72, 0x1c, 0xcc, 0x18, 0x57, 0x3e, 0x67, 0x8a,
-	0xa3, 0x5c, 0x86, 0xc5, 0x48, 0x84, 0xc7, 0x0e, 0xa4, 0xb2, 0x87, 0xaf, 0xf5, 0xaf, 0x12, 0x2c,
-	0xd6, 0x19, 0x79, 0x76, 0x12, 0x62, 0x9f, 0x9b, 0x15, 0x05, 0xbf, 0xed, 0x47, 0x76, 0xd2, 0x0b,
-	0x7f, 0x72, 0xd2, 0xf5, 0x6d, 0x58, 0x19, 0x12, 0x3d, 0xd9, 0x14, 0xfd, 0xb3, 0x04, 0xc5, 0x3a,
-	0x23, 0xcf, 0x69, 0xcb, 0xc1, 0x89, 0x99, 0xff, 0xf2, 0x9b, 0xaf, 0xc2, 0x8d, 0x41, 0xb1, 0x93,
-	0x3b, 0xac, 0x7e, 0x2b, 0x40, 0xa1, 0xce, 0x88, 0x6c, 0x02, 0x64, 0xfe, 0xa8, 0x6e, 0x0d, 0x6f,
-	0xc6, 0xc0, 0x06, 0x2b, 0x77, 0x2f, 0x85, 0xd3, 0xaa, 0x04, 0x96, 0x46, 0xb7, 0xf9, 0xce, 0x98,
-	0xdc, 0x91, 0x28, 0x65, 0x73, 0x9a, 0xa8, 0xb4, 0xd0, 0x6b, 0x28, 0x0e, 0xed, 0xe7, 0xed, 0x89,
-	0xf9, 0xca, 0xbd, 0x89, 0x21, 0x29, 0xff, 0x4b, 0x58, 0x18, 0x98, 0x76, 0x6d, 0x4c, 0x6a, 0x36,
-	0x40, 0xd9, 0x98, 0x10, 0x90, 0x32, 0x1f, 0xc0, 0x7c, 0x76, 0xb8, 0xd4, 0x31, 0x79, 0x19, 0x5c,
-	0x59, 0xbf, 0x1c, 0x17, 0xb4, 0xb5, 0xbd, 0xd3, 0xae, 0x2a, 0x9d, 0x75, 0x55, 0xe9, 0x47, 0x57,
-	0x95, 0x3e, 0xf4, 0xd4, 0xdc, 0x59, 0x4f, 0xcd, 0x7d, 0xef, 0xa9, 0xb9, 0x57, 0xd5, 0xcc, 0x50,
-	0xf5, 0xb9, 0xb6, 0x3c, 0xcb, 0x66, 0xe2, 0x80, 0x8e, 0x2b, 0x0f, 0xd1, 0x49, 0xfa, 0x31, 0x8d,
-	0x87, 0xcc, 0xfe, 0x9f, 0xaf, 0xe2, 0xf6, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xb7, 0x60,
-	0xef, 0x6b, 0x07, 0x00, 0x00,
+	// 736 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x3b, 0x6f, 0xd3, 0x50,
+	0x14, 0x8e, 0x93, 0x96, 0xb6, 0xa7, 0x25, 0x6d, 0xad, 0x42, 0x53, 0xab, 0x38, 0xc1, 0xea, 0x23,
+	0x48, 0xad, 0x4d, 0xd2, 0xb2, 0x30, 0x20, 0x11, 0x02, 0x52, 0xa4, 0x5a, 0x20, 0xd3, 0x4a, 0x88,
+	0x01, 0x94, 0xb8, 0x97, 0x5b, 0x2b, 0x8e, 0xaf, 0x95, 0x6b, 0xf7, 0xb1, 0xf3, 0x03, 0x18, 0xf9,
+	0x0b, 0x80, 0xc4, 0xc2, 0x9f, 0xe8, 0xd8, 0x91, 0x29, 0x45, 0xed, 0xc6, 0xd8, 0x89, 0x11, 0xf9,
+	0x75, 0xe3, 0x3c, 0x48, 0x22, 0x24, 0x50, 0xa7, 0xd8, 0xf7, 0x3b, 0xe7, 0x3b, 0xe7, 0x7c, 0x3e,
+	0xf7, 0x53, 0x60, 0x91, 0xd0, 0x06, 0xa1, 0x06, 0x55, 0x4c, 0xa2, 0xd7, 0x5d, 0x5b, 0x71, 0x8e,
+	0x65, 0xbb, 0x49, 0x1c, 0xc2, 0xa7, 0x43, 0x40, 0x0e, 0x00, 0x61, 0x01, 0x13, 0x4c, 0x7c, 0x48,
+	0xf1, 0x9e, 0x82, 0x28, 0x41, 0xc4, 0x84, 0x60, 0x13, 0x29, 0xfe, 0x5b, 0xcd, 0x7d, 0xa7, 0xec,
+	0xbb, 0xcd, 0xaa, 0x63, 0x10, 0x2b, 0xc2, 0x75, 0x9f, 0x46, 0xa9, 0x55, 0x29, 0x52, 0x0e, 0x0b,
+	0x35, 0xe4, 0x54, 0x0b, 0x8a, 0x4e, 0x8c, 0x08, 0x5f, 0xea, 0x2a, 0xef, 0xfd, 0x04, 0x90, 0xf4,
+	0x3e, 0x09, 0x37, 0x55, 0x8a, 0x77, 0x88, 0x5e, 0xdf, 0x25, 0x75, 0x64, 0x51, 0x7e, 0x0d, 0xc6,
+	0xc9, 0x91, 0x85, 0x9a, 0x19, 0x2e, 0xc7, 0xe5, 0xa7, 0x4a, 0x73, 0x57, 0xad, 0xec, 0xcc, 0x49,
+	0xb5, 0x61, 0x3e, 0x94, 0xfc, 0x63, 0x49, 0x0b, 0x60, 0xfe, 0x00, 0x26, 0xa3, 0x36, 0x32, 0xc9,
+	0x1c, 0x97, 0x9f, 0x2e, 0x2e, 0xc9, 0x41, 0x9f, 0x72, 0xd4, 0xa7, 0x5c, 0x0e, 0x03, 0x4a, 0x85,
+	0xd3, 0x56, 0x36, 0xf1, 0xb3, 0x95, 0xe5, 0xa3, 0x94, 0x0d, 0xd2, 0x30, 0x1c, 0xd4, 0xb0, 0x9d,
+	0x93, 0xab, 0x56, 0x76, 0x36, 0xe0, 0x8f, 0x30, 0xe9, 0xe3, 0x79, 0x96, 0xd3, 0x18, 0x3b, 0x5f,
+	0x85, 0x71, 0x6f, 0x18, 0x9a, 0x49, 0xe5, 0x52, 0x7e, 0x99, 0x60, 0x5c, 0xd9, 0x1b, 0x57, 0x0e,
+	0xc7, 0x95, 0x9f, 0x10, 0xc3, 0x2a, 0xdd, 0xf7, 0xca, 0x7c, 0x3e, 0xcf, 0xe6, 0xb1, 0xe1, 0x1c,
+	0xb8, 0x35, 0x59, 0x27, 0x0d, 0x25, 0xd4, 0x26, 0xf8, 0xd9, 0xa4, 0xfb, 0x75, 0xc5, 0x39, 0xb1,
+	0x11, 0xf5, 0x13, 0xa8, 0x16, 0x30, 0x4b, 0xeb, 0x70, 0xab, 0x43, 0x05, 0x0d, 0x51, 0x9b, 0x58,
+	0x14, 0xf1, 0x69, 0x48, 0x56, 0xca, 0xbe, 0x14, 0x63, 0x5a, 0xb2, 0x52, 0x96, 0x1e, 0xc1, 0x82,
+	0x4a, 0x71, 0x09, 0x61, 0xc3, 0xda, 0xb3, 0x3c, 0x1d, 0x0d, 0x0b, 0x3f, 0x36, 0xcd, 0x51, 0x55,
+	0x93, 0x76, 0x61, 0xb9, 0x5f, 0x3e, 0xab, 0xb7, 0x0d, 0x13, 0xae, 0x7f, 0x4e, 0x33, 0x9c, 0x3f,
+	0xad, 0x20, 0x77, 0xae, 0x88, 0xfc, 0x02, 0x35, 0x0d, 0xb2, 0xef, 0xb5, 0xaa, 0x45, 0xa1, 0xd2,
+	0x57, 0x0e, 0xe6, 0x7b, 0x68, 0x47, 0xfe, 0x92, 0xc1, 0x8c, 0xc9, 0x68, 0xc6, 0xff, 0xa1, 0xf7,
+	0x5b, 0x58, 0xea, 0xe9, 0x97, 0x69, 0x90, 0x81, 0x09, 0xea, 0xea, 0x3a, 0xa2, 0xd4, 0xef, 0x7c,
+	0x52, 0x8b, 0x5e, 0xf9, 0x3c, 0xcc, 0xba, 0x51, 0xb8, 0xa7, 0x00, 0x6b, 0xbb, 0xfb, 0x58, 0xfa,
+	0xc6, 0xc1, 0xac, 0x4a, 0xf1, 0xd3, 0x63, 0x07, 0x59, 0xbe, 0x58, 0xae, 0xfd, 0xd7, 0x7a, 0xc4,
+	0x37, 0x3d, 0xf5, 0x2f, 0x37, 0x5d, 0xda, 0x82, 0xc5, 0xae, 0xa6, 0x87, 0x8b, 0x22, 0x7d, 0xe1,
+	0x20, 0xad, 0x52, 0xfc, 0x8c, 0x34, 0x75, 0x14, 0x88, 0x79, 0x9d, 0xbf, 0x7c, 0x11, 0x6e, 0x77,
+	0x36, 0x3b, 0xc2, 0x84, 0x9f, 0x38, 0x98, 0x51, 0x29, 0x7e, 0x69, 0x9b, 0x86, 0xb3, 0x73, 0xcd,
+	0xe7, 0xdb, 0x86, 0x85, 0x78, 0xab, 0x6c, 0xba, 0x65, 0x98, 0xb2, 0xd0, 0x51, 0xb8, 0xb4, 0x81,
+	0x9f, 0xb4, 0x0f, 0xa4, 0x8a, 0xef, 0xc2, 0x2a, 0x6a, 0x62, 0xe4, 0x9d, 0x8c, 0xee, 0xc2, 0x73,
+	0x90, 0xaa, 0x94, 0x69, 0x26, 0x99, 0x4b, 0xe5, 0xc7, 0x34, 0xef, 0x31, 0xb4, 0xb2, 0x36, 0xd5,
+	0x9f, 0xac, 0xac, 0xf8, 0x6b, 0x0c, 0x52, 0x2a, 0xc5, 0xbc, 0x06, 0x10, 0xb3, 0xff, 0x3b, 0xdd,
+	0x7e, 0xd3, 0xe1, 0x8b, 0xc2, 0xea, 0x40, 0x98, 0xd5, 0xc2, 0x30, 0xdf, 0xeb, 0x91, 0x2b, 0x7d,
+	0x72, 0x7b, 0xa2, 0x84, 0x8d, 0x51, 0xa2, 0x58, 0xa1, 0x37, 0x90, 0xee, 0x04, 0xf9, 0xbb, 0x43,
+	0xf3, 0x85, 0x7b, 0x43, 0x43, 0x18, 0xff, 0x2b, 0x98, 0xe9, 0xf0, 0x90, 0x6c, 0x9f, 0xd4, 0x78,
+	0x80, 0xb0, 0x3e, 0x24, 0x80, 0x31, 0xef, 0xc1, 0x74, 0xfc, 0xca, 0x8a, 0x7d, 0xf2, 0x62, 0xb8,
+	0xb0, 0x36, 0x18, 0x67, 0xb4, 0xcf, 0x61, 0xaa, 0x7d, 0x4f, 0x96, 0xfb, 0x24, 0x31, 0x54, 0x58,
+	0x19, 0x84, 0x32, 0x42, 0x0d, 0x20, 0xb6, 0x97, 0xfd, 0xd6, 0xa3, 0x0d, 0x0b, 0xab, 0x03, 0xe1,
+	0x88, 0xb3, 0xb4, 0x73, 0x7a, 0x21, 0x72, 0x67, 0x17, 0x22, 0xf7, 0xe3, 0x42, 0xe4, 0x3e, 0x5c,
+	0x8a, 0x89, 0xb3, 0x4b, 0x31, 0xf1, 0xfd, 0x52, 0x4c, 0xbc, 0x2e, 0xc6, 0xee, 0x5b, 0x48, 0xb5,
+	0x69, 0x56, 0x6b, 0x34, 0x7a, 0x51, 0x0e, 0x0b, 0x0f, 0x94, 0x63, 0xf6, 0x3f, 0xca, 0xbb, 0x7f,
+	0xb5, 0x1b, 0xbe, 0x0b, 0x6f, 0xfd, 0x1e, 0x00, 0x68, 0x8d, 0x0d, 0x71, 0x66, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -635,6 +849,10 @@ type MsgClient interface {
 	// MsgEditLockup edits the existing lockups by lock ID
 	ExtendLockup(ctx context.Context, in *MsgExtendLockup, opts ...grpc.CallOption) (*MsgExtendLockupResponse, error)
 	ForceUnlock(ctx context.Context, in *MsgForceUnlock, opts ...grpc.CallOption) (*MsgForceUnlockResponse, error)
+	// SplitLock splits coins of an existing lock into a new lock
+	SplitLock(ctx context.Context, in *MsgSplitLock, opts ...grpc.CallOption) (*MsgSplitLockResponse, error)
+	// MergeLocks merges existing locks into a single lock
+	MergeLocks(ctx context.Context, in *MsgMergeLocks, opts ...grpc.CallOption) (*MsgMergeLocksResponse, error)
 }
 
 type msgClient struct {
@@ -690,6 +908,24 @@ func (c *msgClient) ForceUnlock(ctx context.Context, in *MsgForceUnlock, opts ..
 	return out, nil
 }
 
+func (c *msgClient) SplitLock(ctx context.Context, in *MsgSplitLock, opts ...grpc.CallOption) (*MsgSplitLockResponse, error) {
+	out := new(MsgSplitLockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/SplitLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MergeLocks(ctx context.Context, in *MsgMergeLocks, opts ...grpc.CallOption) (*MsgMergeLocksResponse, error) {
+	out := new(MsgMergeLocksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/MergeLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// LockTokens lock tokens
@@ -701,6 +937,10 @@ type MsgServer interface {
 	// MsgEditLockup edits the existing lockups by lock ID
 	ExtendLockup(context.Context, *MsgExtendLockup) (*MsgExtendLockupResponse, error)
 	ForceUnlock(context.Context, *MsgForceUnlock) (*MsgForceUnlockResponse, error)
+	// SplitLock splits coins of an existing lock into a new lock
+	SplitLock(context.Context, *MsgSplitLock) (*MsgSplitLockResponse, error)
+	// MergeLocks merges existing locks into a single lock
+	MergeLocks(context.Context, *MsgMergeLocks) (*MsgMergeLocksResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -722,6 +962,12 @@ func (*UnimplementedMsgServer) ExtendLockup(ctx context.Context, req *MsgExtendL
 func (*UnimplementedMsgServer) ForceUnlock(ctx context.Context, req *MsgForceUnlock) (*MsgForceUnlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnlock not implemented")
 }
+func (*UnimplementedMsgServer) SplitLock(ctx context.Context, req *MsgSplitLock) (*MsgSplitLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitLock not implemented")
+}
+func (*UnimplementedMsgServer) MergeLocks(ctx context.Context, req *MsgMergeLocks) (*MsgMergeLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeLocks not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -817,6 +1063,42 @@ func _Msg_ForceUnlock_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SplitLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSplitLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SplitLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/SplitLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SplitLock(ctx, req.(*MsgSplitLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MergeLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMergeLocks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MergeLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/MergeLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MergeLocks(ctx, req.(*MsgMergeLocks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.lockup.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -841,6 +1123,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnlock",
 			Handler:    _Msg_ForceUnlock_Handler,
 		},
+		{
+			MethodName: "SplitLock",
+			Handler:    _Msg_SplitLock_Handler,
+		},
+		{
+			MethodName: "MergeLocks",
+			Handler:    _Msg_MergeLocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/lockup/tx.proto",
@@ -1238,51 +1528,204 @@ func (m *MsgForceUnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSplitLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgLockTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSplitLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgLockTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSplitLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovTx(uint64(m.ID))
+	if m.NewLockID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewLockID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBeginUnlockingAll) Size() (n int) {
+func (m *MsgMergeLocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMergeLocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMergeLocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IDs) > 0 {
+		dAtA4 := make([]byte, len(m.IDs)*10)
+		var j3 int
+		for _, num := range m.IDs {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMergeLocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMergeLocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMergeLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLockTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgLockTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	return n
+}
+
+func (m *MsgBeginUnlockingAll) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1411,13 +1854,463 @@ func (m *MsgForceUnlockResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSplitLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSplitLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewLockID != 0 {
+		n += 1 + sovTx(uint64(m.NewLockID))
+	}
+	return n
+}
+
+func (m *MsgMergeLocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.IDs) > 0 {
+		l = 0
+		for _, e := range m.IDs {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgMergeLocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocks = append(m.Unlocks, &PeriodLock{})
+			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1440,10 +2333,10 @@ func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLockTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBeginUnlocking: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLockTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBeginUnlocking: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1479,10 +2372,10 @@ func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1492,25 +2385,11 @@ func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
@@ -1566,7 +2445,7 @@ func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1589,17 +2468,17 @@ func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLockTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBeginUnlockingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLockTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBeginUnlockingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			m.ID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1609,7 +2488,27 @@ func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockingLockID", wireType)
+			}
+			m.UnlockingLockID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockingLockID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1635,7 +2534,7 @@ func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
+func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1658,10 +2557,10 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAll: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExtendLockup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAll: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExtendLockup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1696,6 +2595,58 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1717,7 +2668,7 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1740,17 +2691,17 @@ func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExtendLockupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExtendLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1760,26 +2711,12 @@ func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Unlocks = append(m.Unlocks, &PeriodLock{})
-			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1801,7 +2738,7 @@ func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
+func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1824,10 +2761,10 @@ func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlocking: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceUnlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlocking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1936,7 +2873,7 @@ func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1959,10 +2896,10 @@ func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceUnlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1985,25 +2922,6 @@ func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Success = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockingLockID", wireType)
-			}
-			m.UnlockingLockID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnlockingLockID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2025,7 +2943,7 @@ func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2048,10 +2966,10 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendLockup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendLockup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2107,7 +3025,7 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2134,7 +3052,8 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2159,7 +3078,7 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitLockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2182,17 +3101,17 @@ func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendLockupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitLockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewLockID", wireType)
 			}
-			var v int
+			m.NewLockID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2202,12 +3121,11 @@ func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NewLockID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2229,7 +3147,7 @@ func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
+func (m *MsgMergeLocks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2252,10 +3170,10 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceUnlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMergeLocks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMergeLocks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2291,58 +3209,81 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.IDs = append(m.IDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Coins = append(m.Coins, types1.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.IDs) == 0 {
+					m.IDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.IDs = append(m.IDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2364,7 +3305,7 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMergeLocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2387,17 +3328,17 @@ func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceUnlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMergeLocksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMergeLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var v int
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2407,12 +3348,11 @@ func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
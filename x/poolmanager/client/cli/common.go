@@ -1,7 +1,10 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -71,3 +74,88 @@ func swapAmountOutRoutes(fs *flag.FlagSet) ([]types.SwapAmountOutRoute, error) {
 	}
 	return routes, nil
 }
+
+// splitRouteSwapAmountInRoutes reads the split routes of a MsgSplitRouteSwapExactAmountIn
+// from the json file given by the --routes-file flag.
+func splitRouteSwapAmountInRoutes(fs *flag.FlagSet) ([]types.SwapAmountInSplitRoute, error) {
+	contents, err := readSplitRoutesFile(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := []types.SwapAmountInSplitRoute{}
+	if err := json.Unmarshal(contents, &routes); err != nil {
+		return nil, err
+	}
+	return routes, nil
+}
+
+// splitRouteSwapAmountOutRoutes reads the split routes of a MsgSplitRouteSwapExactAmountOut
+// from the json file given by the --routes-file flag.
+func splitRouteSwapAmountOutRoutes(fs *flag.FlagSet) ([]types.SwapAmountOutSplitRoute, error) {
+	contents, err := readSplitRoutesFile(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := []types.SwapAmountOutSplitRoute{}
+	if err := json.Unmarshal(contents, &routes); err != nil {
+		return nil, err
+	}
+	return routes, nil
+}
+
+func readSplitRoutesFile(fs *flag.FlagSet) ([]byte, error) {
+	routesFile, err := fs.GetString(FlagSplitRoutesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if routesFile == "" {
+		return nil, fmt.Errorf("must pass in a routes json using the --%s flag", FlagSplitRoutesFile)
+	}
+
+	return os.ReadFile(routesFile)
+}
+
+// pausedPoolIds parses the comma-separated pool ids given by the pool ids flag.
+func pausedPoolIds(fs *flag.FlagSet) ([]uint64, error) {
+	poolIdsStr, err := fs.GetString(FlagPoolIds)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIds := []uint64{}
+	if poolIdsStr == "" {
+		return poolIds, nil
+	}
+	for _, poolIdStr := range strings.Split(poolIdsStr, ",") {
+		poolId, err := strconv.ParseUint(strings.TrimSpace(poolIdStr), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		poolIds = append(poolIds, poolId)
+	}
+	return poolIds, nil
+}
+
+// pausedPoolTypes parses the comma-separated pool type names given by the pool types flag.
+func pausedPoolTypes(fs *flag.FlagSet) ([]types.PoolType, error) {
+	poolTypesStr, err := fs.GetString(FlagPoolTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	poolTypes := []types.PoolType{}
+	if poolTypesStr == "" {
+		return poolTypes, nil
+	}
+	for _, poolTypeStr := range strings.Split(poolTypesStr, ",") {
+		poolType, ok := types.PoolType_value[strings.TrimSpace(poolTypeStr)]
+		if !ok {
+			return nil, fmt.Errorf("invalid pool type (%s)", poolTypeStr)
+		}
+		poolTypes = append(poolTypes, types.PoolType(poolType))
+	}
+	return poolTypes, nil
+}
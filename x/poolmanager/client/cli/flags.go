@@ -21,6 +21,14 @@ const (
 	FlagSwapRoutePoolIds = "swap-route-pool-ids"
 	// Will be parsed to []string.
 	FlagSwapRouteDenoms = "swap-route-denoms"
+	// Will be parsed to string.
+	FlagSplitRoutesFile = "routes-file"
+	// Will be parsed to []uint64.
+	FlagPoolIds = "pool-ids"
+	// Will be parsed to []types.PoolType.
+	FlagPoolTypes = "pool-types"
+	// Will be parsed to bool.
+	FlagPaused = "paused"
 )
 
 type createBalancerPoolInputs struct {
@@ -54,6 +62,13 @@ func FlagSetMultihopSwapRoutes() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetPausedPools() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagPoolIds, "", "comma-separated ids of the pools to pause")
+	fs.String(FlagPoolTypes, "", "comma-separated pool types to pause on every pool, e.g. Balancer,Concentrated")
+	return fs
+}
+
 func FlagSetQuerySwapRoutes() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -70,6 +85,13 @@ func FlagSetSwapAmountOutRoutes() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetSplitRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagSplitRoutesFile, "", "Split routes json file path")
+	return fs
+}
+
 func FlagSetCreatePool() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
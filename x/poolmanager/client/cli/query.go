@@ -23,7 +23,13 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdNumPools)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSwapExactAmountIn)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSwapExactAmountOut)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateBestRouteSwapExactAmountIn)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdSpotPrice)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTakerFee)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTakerFeeTotals)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPoolVolume)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPoolVolumeInWindow)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPausedPools)
 
 	return cmd
 }
@@ -56,6 +62,16 @@ func GetCmdEstimateSwapExactAmountOut() (*osmocli.QueryDescriptor, *queryproto.E
 	}, &queryproto.EstimateSwapExactAmountOutRequest{}
 }
 
+// GetCmdEstimateBestRouteSwapExactAmountIn returns the route with the highest estimated output for a swap.
+func GetCmdEstimateBestRouteSwapExactAmountIn() (*osmocli.QueryDescriptor, *queryproto.EstimateBestRouteSwapExactAmountInRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-best-route-swap-exact-amount-in [tokenIn] [tokenOutDenom] [maxHops]",
+		Short: "Query the best route for swapping an exact amount in",
+		Long: `{{.Short}}. A maxHops of 0 uses the default.{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-best-route-swap-exact-amount-in 1000stake uosmo 3`,
+	}, &queryproto.EstimateBestRouteSwapExactAmountInRequest{}
+}
+
 // GetCmdNumPools return number of pools available.
 func GetCmdNumPools() (*osmocli.QueryDescriptor, *queryproto.NumPoolsRequest) {
 	return &osmocli.QueryDescriptor{
@@ -83,6 +99,54 @@ func GetCmdSpotPrice() (*osmocli.QueryDescriptor, *queryproto.SpotPriceRequest)
 `}, &queryproto.SpotPriceRequest{}
 }
 
+// GetCmdTakerFee returns the taker fee charged on swaps between two denoms.
+func GetCmdTakerFee() (*osmocli.QueryDescriptor, *queryproto.TakerFeeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "taker-fee [denom0] [denom1]",
+		Short: "Query the taker fee charged on swaps between two denoms",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} taker-fee uosmo stake`,
+	}, &queryproto.TakerFeeRequest{}
+}
+
+// GetCmdTakerFeeTotals returns the taker fees collected since genesis.
+func GetCmdTakerFeeTotals() (*osmocli.QueryDescriptor, *queryproto.TakerFeeTotalsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "taker-fee-totals",
+		Short: "Query the taker fees collected since genesis",
+		Long:  "{{.Short}}",
+	}, &queryproto.TakerFeeTotalsRequest{}
+}
+
+// GetCmdPoolVolume returns the lifetime volume and collected swap fees of a pool.
+func GetCmdPoolVolume() (*osmocli.QueryDescriptor, *queryproto.PoolVolumeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-volume [poolID]",
+		Short: "Query the lifetime volume and collected swap fees of a pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-volume 1`,
+	}, &queryproto.PoolVolumeRequest{}
+}
+
+// GetCmdPoolVolumeInWindow returns the volume and collected swap fees of a pool over a window.
+func GetCmdPoolVolumeInWindow() (*osmocli.QueryDescriptor, *queryproto.PoolVolumeInWindowRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-volume-in-window [poolID] [window]",
+		Short: "Query the volume and collected swap fees of a pool over a window ending now",
+		Long: `{{.Short}}. The window can be at most 168h.{{.ExampleHeader}}
+{{.CommandPrefix}} pool-volume-in-window 1 24h`,
+	}, &queryproto.PoolVolumeInWindowRequest{}
+}
+
+// GetCmdPausedPools returns the ids of the paused pools and the paused pool types.
+func GetCmdPausedPools() (*osmocli.QueryDescriptor, *queryproto.PausedPoolsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "paused-pools",
+		Short: "Query the ids of the paused pools and the paused pool types",
+		Long:  "{{.Short}}",
+	}, &queryproto.PausedPoolsRequest{}
+}
+
 func EstimateSwapExactAmountInParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
 	poolID, err := strconv.Atoi(args[0])
 	if err != nil {
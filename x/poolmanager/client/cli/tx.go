@@ -15,6 +15,8 @@ import (
 	"github.com/spf13/cobra"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
@@ -27,6 +29,9 @@ func NewTxCmd() *cobra.Command {
 
 	osmocli.AddTxCmd(txCmd, NewSwapExactAmountInCmd)
 	osmocli.AddTxCmd(txCmd, NewSwapExactAmountOutCmd)
+	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountInCmd)
+	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountOutCmd)
+	osmocli.AddTxCmd(txCmd, NewEmergencyPausePoolsCmd)
 
 	txCmd.AddCommand(
 		NewCreatePoolCmd(),
@@ -56,6 +61,55 @@ func NewSwapExactAmountOutCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountOu
 		Flags:            osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
 	}, &types.MsgSwapExactAmountOut{}
 }
+
+func NewSplitRouteSwapExactAmountInCmd() (*osmocli.TxCliDesc, *types.MsgSplitRouteSwapExactAmountIn) {
+	return &osmocli.TxCliDesc{
+		Use:   "split-route-swap-exact-amount-in [token-in-denom] [token-out-min-amount]",
+		Short: "split route swap exact amount in",
+		Example: `osmosisd tx poolmanager split-route-swap-exact-amount-in uosmo 1 --routes-file="./routes.json" --from val --chain-id osmosis-1
+- routes.json
+[
+	{"pools": [{"pool_id": 1, "token_out_denom": "uion"}], "token_in_amount": "1000"},
+	{"pools": [{"pool_id": 2, "token_out_denom": "uatom"}, {"pool_id": 3, "token_out_denom": "uion"}], "token_in_amount": "2000"}
+]`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes": osmocli.FlagOnlyParser(splitRouteSwapAmountInRoutes),
+		},
+		Flags: osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetSplitRoutes()}},
+	}, &types.MsgSplitRouteSwapExactAmountIn{}
+}
+
+func NewSplitRouteSwapExactAmountOutCmd() (*osmocli.TxCliDesc, *types.MsgSplitRouteSwapExactAmountOut) {
+	return &osmocli.TxCliDesc{
+		Use:   "split-route-swap-exact-amount-out [token-out-denom] [token-in-max-amount]",
+		Short: "split route swap exact amount out",
+		Example: `osmosisd tx poolmanager split-route-swap-exact-amount-out uion 5000 --routes-file="./routes.json" --from val --chain-id osmosis-1
+- routes.json
+[
+	{"pools": [{"pool_id": 1, "token_in_denom": "uosmo"}], "token_out_amount": "1000"},
+	{"pools": [{"pool_id": 2, "token_in_denom": "uosmo"}, {"pool_id": 3, "token_in_denom": "uatom"}], "token_out_amount": "2000"}
+]`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes": osmocli.FlagOnlyParser(splitRouteSwapAmountOutRoutes),
+		},
+		Flags: osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetSplitRoutes()}},
+	}, &types.MsgSplitRouteSwapExactAmountOut{}
+}
+func NewEmergencyPausePoolsCmd() (*osmocli.TxCliDesc, *types.MsgEmergencyPausePools) {
+	return &osmocli.TxCliDesc{
+		Use:   "emergency-pause-pools",
+		Short: "pause swaps and joins on pools as the emergency pause admin",
+		Long: `Pause swaps and joins on the given pools and on every pool of the given pool types.
+Can only be sent by the emergency pause admin. Unpausing requires a governance proposal.`,
+		Example: "osmosisd tx poolmanager emergency-pause-pools --pool-ids=1,2 --pool-types=Stableswap --from admin --chain-id osmosis-1",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"PoolIds":   osmocli.FlagOnlyParser(pausedPoolIds),
+			"PoolTypes": osmocli.FlagOnlyParser(pausedPoolTypes),
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetPausedPools()}},
+	}, &types.MsgEmergencyPausePools{}
+}
+
 func NewBuildSwapExactAmountInMsg(clientCtx client.Context, tokenInStr, tokenOutMinAmtStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
 	routes, err := swapAmountInRoutes(fs)
 	if err != nil {
@@ -314,3 +368,85 @@ func ParseCoinsNoSort(coinsStr string) (sdk.Coins, error) {
 	}
 	return sdk.NormalizeCoins(decCoins), nil
 }
+
+// NewCmdSubmitSetPoolsPausedProposal implements a command handler for the set pools paused proposal
+func NewCmdSubmitSetPoolsPausedProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pools-paused-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a proposal to pause or unpause swaps and joins on pools",
+		Long: strings.TrimSpace(`Submit a proposal to pause or unpause swaps and joins on the given pools
+and on every pool of the given pool types. Exits are never paused.
+Ex) --pool-ids 1,2 --pool-types Stableswap --paused=true
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			content, err := parseSetPoolsPausedArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().AddFlagSet(FlagSetPausedPools())
+	cmd.Flags().Bool(FlagPaused, true, "whether to pause or unpause the pools")
+
+	return cmd
+}
+
+func parseSetPoolsPausedArgsToContent(cmd *cobra.Command) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIds, err := pausedPoolIds(cmd.Flags())
+	if err != nil {
+		return nil, err
+	}
+
+	poolTypes, err := pausedPoolTypes(cmd.Flags())
+	if err != nil {
+		return nil, err
+	}
+
+	paused, err := cmd.Flags().GetBool(FlagPaused)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewSetPoolsPausedProposal(title, description, poolIds, poolTypes, paused), nil
+}
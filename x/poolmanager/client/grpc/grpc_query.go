@@ -20,6 +20,66 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) PoolVolumeInWindow(grpcCtx context.Context,
+	req *queryproto.PoolVolumeInWindowRequest,
+) (*queryproto.PoolVolumeInWindowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PoolVolumeInWindow(ctx, *req)
+}
+
+func (q Querier) PausedPools(grpcCtx context.Context,
+	req *queryproto.PausedPoolsRequest,
+) (*queryproto.PausedPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PausedPools(ctx, *req)
+}
+
+func (q Querier) PoolVolume(grpcCtx context.Context,
+	req *queryproto.PoolVolumeRequest,
+) (*queryproto.PoolVolumeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PoolVolume(ctx, *req)
+}
+
+func (q Querier) TakerFeeTotals(grpcCtx context.Context,
+	req *queryproto.TakerFeeTotalsRequest,
+) (*queryproto.TakerFeeTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TakerFeeTotals(ctx, *req)
+}
+
+func (q Querier) TakerFee(grpcCtx context.Context,
+	req *queryproto.TakerFeeRequest,
+) (*queryproto.TakerFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TakerFee(ctx, *req)
+}
+
+func (q Querier) EstimateBestRouteSwapExactAmountIn(grpcCtx context.Context,
+	req *queryproto.EstimateBestRouteSwapExactAmountInRequest,
+) (*queryproto.EstimateBestRouteSwapExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateBestRouteSwapExactAmountIn(ctx, *req)
+}
+
 func (q Querier) SpotPrice(grpcCtx context.Context,
 	req *queryproto.SpotPriceRequest,
 ) (*queryproto.SpotPriceResponse, error) {
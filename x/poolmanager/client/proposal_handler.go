@@ -0,0 +1,10 @@
+package client
+
+import (
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/client/cli"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/client/rest"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var SetPoolsPausedProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitSetPoolsPausedProposal, rest.ProposalSetPoolsPausedRESTHandler)
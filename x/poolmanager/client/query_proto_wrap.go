@@ -79,6 +79,32 @@ func (q Querier) EstimateSwapExactAmountOut(ctx sdk.Context, req queryproto.Esti
 	}, nil
 }
 
+// EstimateBestRouteSwapExactAmountIn estimates the route with the highest token output amount for a swap.
+func (q Querier) EstimateBestRouteSwapExactAmountIn(ctx sdk.Context, req queryproto.EstimateBestRouteSwapExactAmountInRequest) (*queryproto.EstimateBestRouteSwapExactAmountInResponse, error) {
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	if req.TokenOutDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token out denom")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	routes, tokenOutAmount, err := q.K.EstimateBestRouteExactAmountIn(ctx, tokenIn, req.TokenOutDenom, req.MaxHops)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.EstimateBestRouteSwapExactAmountInResponse{
+		Routes:         routes,
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
+
 // NumPools returns total number of pools.
 func (q Querier) NumPools(ctx sdk.Context, _ queryproto.NumPoolsRequest) (*queryproto.NumPoolsResponse, error) {
 	return &queryproto.NumPoolsResponse{
@@ -142,3 +168,61 @@ func (q Querier) SpotPrice(ctx sdk.Context, req queryproto.SpotPriceRequest) (*q
 		SpotPrice: sp.String(),
 	}, err
 }
+
+// TakerFee returns the taker fee charged on swaps between the given denoms.
+func (q Querier) TakerFee(ctx sdk.Context, req queryproto.TakerFeeRequest) (*queryproto.TakerFeeResponse, error) {
+	takerFee, err := q.K.GetTakerFee(ctx, req.Denom0, req.Denom1)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &queryproto.TakerFeeResponse{
+		TakerFee: takerFee,
+	}, nil
+}
+
+// TakerFeeTotals returns the taker fees collected since genesis.
+func (q Querier) TakerFeeTotals(ctx sdk.Context, req queryproto.TakerFeeTotalsRequest) (*queryproto.TakerFeeTotalsResponse, error) {
+	return &queryproto.TakerFeeTotalsResponse{
+		TakerFeeTotals: q.K.GetTakerFeeTotals(ctx),
+	}, nil
+}
+
+// PausedPools returns the ids of the paused pools and the paused pool types.
+func (q Querier) PausedPools(ctx sdk.Context, req queryproto.PausedPoolsRequest) (*queryproto.PausedPoolsResponse, error) {
+	poolIds, poolTypes := q.K.GetPausedPools(ctx)
+	return &queryproto.PausedPoolsResponse{
+		PoolIds:   poolIds,
+		PoolTypes: poolTypes,
+	}, nil
+}
+
+// PoolVolume returns the lifetime volume and collected swap fees of a pool.
+func (q Querier) PoolVolume(ctx sdk.Context, req queryproto.PoolVolumeRequest) (*queryproto.PoolVolumeResponse, error) {
+	if _, err := q.K.RoutePool(ctx, req.PoolId); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	poolVolume := q.K.GetPoolVolume(ctx, req.PoolId)
+	return &queryproto.PoolVolumeResponse{
+		Volume:   poolVolume.Volume,
+		SwapFees: poolVolume.SwapFees,
+	}, nil
+}
+
+// PoolVolumeInWindow returns the volume and collected swap fees of a pool over the given window.
+func (q Querier) PoolVolumeInWindow(ctx sdk.Context, req queryproto.PoolVolumeInWindowRequest) (*queryproto.PoolVolumeInWindowResponse, error) {
+	if _, err := q.K.RoutePool(ctx, req.PoolId); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	poolVolume, err := q.K.GetPoolVolumeInWindow(ctx, req.PoolId, req.Window)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &queryproto.PoolVolumeInWindowResponse{
+		Volume:   poolVolume.Volume,
+		SwapFees: poolVolume.SwapFees,
+	}, nil
+}
@@ -7,14 +7,15 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types1 "github.com/cosmos/cosmos-sdk/codec/types"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	types3 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -23,12 +24,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -331,22 +334,31 @@ func (m *EstimateSwapExactAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EstimateSwapExactAmountOutResponse proto.InternalMessageInfo
 
-// =============================== NumPools
-type NumPoolsRequest struct {
+// =============================== EstimateBestRouteSwapExactAmountIn
+type EstimateBestRouteSwapExactAmountInRequest struct {
+	TokenIn       string `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	// max_hops is the maximum number of pools in the returned route.
+	// Defaults to 3 when unset.
+	MaxHops uint64 `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty" yaml:"max_hops"`
 }
 
-func (m *NumPoolsRequest) Reset()         { *m = NumPoolsRequest{} }
-func (m *NumPoolsRequest) String() string { return proto.CompactTextString(m) }
-func (*NumPoolsRequest) ProtoMessage()    {}
-func (*NumPoolsRequest) Descriptor() ([]byte, []int) {
+func (m *EstimateBestRouteSwapExactAmountInRequest) Reset() {
+	*m = EstimateBestRouteSwapExactAmountInRequest{}
+}
+func (m *EstimateBestRouteSwapExactAmountInRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EstimateBestRouteSwapExactAmountInRequest) ProtoMessage() {}
+func (*EstimateBestRouteSwapExactAmountInRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{6}
 }
-func (m *NumPoolsRequest) XXX_Unmarshal(b []byte) error {
+func (m *EstimateBestRouteSwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NumPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EstimateBestRouteSwapExactAmountInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NumPoolsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EstimateBestRouteSwapExactAmountInRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -356,34 +368,60 @@ func (m *NumPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *NumPoolsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NumPoolsRequest.Merge(m, src)
+func (m *EstimateBestRouteSwapExactAmountInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBestRouteSwapExactAmountInRequest.Merge(m, src)
 }
-func (m *NumPoolsRequest) XXX_Size() int {
+func (m *EstimateBestRouteSwapExactAmountInRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *NumPoolsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_NumPoolsRequest.DiscardUnknown(m)
+func (m *EstimateBestRouteSwapExactAmountInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBestRouteSwapExactAmountInRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NumPoolsRequest proto.InternalMessageInfo
+var xxx_messageInfo_EstimateBestRouteSwapExactAmountInRequest proto.InternalMessageInfo
 
-type NumPoolsResponse struct {
-	NumPools uint64 `protobuf:"varint,1,opt,name=num_pools,json=numPools,proto3" json:"num_pools,omitempty" yaml:"num_pools"`
+func (m *EstimateBestRouteSwapExactAmountInRequest) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
+	}
+	return ""
 }
 
-func (m *NumPoolsResponse) Reset()         { *m = NumPoolsResponse{} }
-func (m *NumPoolsResponse) String() string { return proto.CompactTextString(m) }
-func (*NumPoolsResponse) ProtoMessage()    {}
-func (*NumPoolsResponse) Descriptor() ([]byte, []int) {
+func (m *EstimateBestRouteSwapExactAmountInRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *EstimateBestRouteSwapExactAmountInRequest) GetMaxHops() uint64 {
+	if m != nil {
+		return m.MaxHops
+	}
+	return 0
+}
+
+type EstimateBestRouteSwapExactAmountInResponse struct {
+	Routes         []types.SwapAmountInRoute              `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *EstimateBestRouteSwapExactAmountInResponse) Reset() {
+	*m = EstimateBestRouteSwapExactAmountInResponse{}
+}
+func (m *EstimateBestRouteSwapExactAmountInResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EstimateBestRouteSwapExactAmountInResponse) ProtoMessage() {}
+func (*EstimateBestRouteSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{7}
 }
-func (m *NumPoolsResponse) XXX_Unmarshal(b []byte) error {
+func (m *EstimateBestRouteSwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NumPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EstimateBestRouteSwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NumPoolsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EstimateBestRouteSwapExactAmountInResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -393,42 +431,43 @@ func (m *NumPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *NumPoolsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NumPoolsResponse.Merge(m, src)
+func (m *EstimateBestRouteSwapExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBestRouteSwapExactAmountInResponse.Merge(m, src)
 }
-func (m *NumPoolsResponse) XXX_Size() int {
+func (m *EstimateBestRouteSwapExactAmountInResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *NumPoolsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_NumPoolsResponse.DiscardUnknown(m)
+func (m *EstimateBestRouteSwapExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBestRouteSwapExactAmountInResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NumPoolsResponse proto.InternalMessageInfo
+var xxx_messageInfo_EstimateBestRouteSwapExactAmountInResponse proto.InternalMessageInfo
 
-func (m *NumPoolsResponse) GetNumPools() uint64 {
+func (m *EstimateBestRouteSwapExactAmountInResponse) GetRoutes() []types.SwapAmountInRoute {
 	if m != nil {
-		return m.NumPools
+		return m.Routes
 	}
-	return 0
+	return nil
 }
 
-// =============================== Pool
-type PoolRequest struct {
-	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+// =============================== TakerFee
+type TakerFeeRequest struct {
+	Denom0 string `protobuf:"bytes,1,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0"`
+	Denom1 string `protobuf:"bytes,2,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1"`
 }
 
-func (m *PoolRequest) Reset()         { *m = PoolRequest{} }
-func (m *PoolRequest) String() string { return proto.CompactTextString(m) }
-func (*PoolRequest) ProtoMessage()    {}
-func (*PoolRequest) Descriptor() ([]byte, []int) {
+func (m *TakerFeeRequest) Reset()         { *m = TakerFeeRequest{} }
+func (m *TakerFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeRequest) ProtoMessage()    {}
+func (*TakerFeeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{8}
 }
-func (m *PoolRequest) XXX_Unmarshal(b []byte) error {
+func (m *TakerFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TakerFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PoolRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TakerFeeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -438,41 +477,48 @@ func (m *PoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *PoolRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PoolRequest.Merge(m, src)
+func (m *TakerFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeRequest.Merge(m, src)
 }
-func (m *PoolRequest) XXX_Size() int {
+func (m *TakerFeeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PoolRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PoolRequest.DiscardUnknown(m)
+func (m *TakerFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PoolRequest proto.InternalMessageInfo
+var xxx_messageInfo_TakerFeeRequest proto.InternalMessageInfo
 
-func (m *PoolRequest) GetPoolId() uint64 {
+func (m *TakerFeeRequest) GetDenom0() string {
 	if m != nil {
-		return m.PoolId
+		return m.Denom0
 	}
-	return 0
+	return ""
 }
 
-type PoolResponse struct {
-	Pool *types1.Any `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+func (m *TakerFeeRequest) GetDenom1() string {
+	if m != nil {
+		return m.Denom1
+	}
+	return ""
 }
 
-func (m *PoolResponse) Reset()         { *m = PoolResponse{} }
-func (m *PoolResponse) String() string { return proto.CompactTextString(m) }
-func (*PoolResponse) ProtoMessage()    {}
-func (*PoolResponse) Descriptor() ([]byte, []int) {
+type TakerFeeResponse struct {
+	TakerFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=taker_fee,json=takerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee" yaml:"taker_fee"`
+}
+
+func (m *TakerFeeResponse) Reset()         { *m = TakerFeeResponse{} }
+func (m *TakerFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeResponse) ProtoMessage()    {}
+func (*TakerFeeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{9}
 }
-func (m *PoolResponse) XXX_Unmarshal(b []byte) error {
+func (m *TakerFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TakerFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PoolResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TakerFeeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -482,42 +528,116 @@ func (m *PoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *PoolResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PoolResponse.Merge(m, src)
+func (m *TakerFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeResponse.Merge(m, src)
 }
-func (m *PoolResponse) XXX_Size() int {
+func (m *TakerFeeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *PoolResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PoolResponse.DiscardUnknown(m)
+func (m *TakerFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PoolResponse proto.InternalMessageInfo
+var xxx_messageInfo_TakerFeeResponse proto.InternalMessageInfo
+
+// =============================== TakerFeeTotals
+type TakerFeeTotalsRequest struct {
+}
+
+func (m *TakerFeeTotalsRequest) Reset()         { *m = TakerFeeTotalsRequest{} }
+func (m *TakerFeeTotalsRequest) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeTotalsRequest) ProtoMessage()    {}
+func (*TakerFeeTotalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{10}
+}
+func (m *TakerFeeTotalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeTotalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeTotalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeTotalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeTotalsRequest.Merge(m, src)
+}
+func (m *TakerFeeTotalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeTotalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeTotalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeTotalsRequest proto.InternalMessageInfo
+
+type TakerFeeTotalsResponse struct {
+	TakerFeeTotals github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=taker_fee_totals,json=takerFeeTotals,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"taker_fee_totals" yaml:"taker_fee_totals"`
+}
+
+func (m *TakerFeeTotalsResponse) Reset()         { *m = TakerFeeTotalsResponse{} }
+func (m *TakerFeeTotalsResponse) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeTotalsResponse) ProtoMessage()    {}
+func (*TakerFeeTotalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{11}
+}
+func (m *TakerFeeTotalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeTotalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeTotalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeTotalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeTotalsResponse.Merge(m, src)
+}
+func (m *TakerFeeTotalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeTotalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeTotalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeTotalsResponse proto.InternalMessageInfo
 
-func (m *PoolResponse) GetPool() *types1.Any {
+func (m *TakerFeeTotalsResponse) GetTakerFeeTotals() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Pool
+		return m.TakerFeeTotals
 	}
 	return nil
 }
 
-// =============================== AllPools
-type AllPoolsRequest struct {
+// =============================== PoolVolume
+type PoolVolumeRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func (m *AllPoolsRequest) Reset()         { *m = AllPoolsRequest{} }
-func (m *AllPoolsRequest) String() string { return proto.CompactTextString(m) }
-func (*AllPoolsRequest) ProtoMessage()    {}
-func (*AllPoolsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{10}
+func (m *PoolVolumeRequest) Reset()         { *m = PoolVolumeRequest{} }
+func (m *PoolVolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*PoolVolumeRequest) ProtoMessage()    {}
+func (*PoolVolumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{12}
 }
-func (m *AllPoolsRequest) XXX_Unmarshal(b []byte) error {
+func (m *PoolVolumeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AllPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PoolVolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AllPoolsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PoolVolumeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -527,41 +647,42 @@ func (m *AllPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *AllPoolsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AllPoolsRequest.Merge(m, src)
+func (m *PoolVolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolumeRequest.Merge(m, src)
 }
-func (m *AllPoolsRequest) XXX_Size() int {
+func (m *PoolVolumeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AllPoolsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllPoolsRequest.DiscardUnknown(m)
+func (m *PoolVolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolumeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AllPoolsRequest proto.InternalMessageInfo
+var xxx_messageInfo_PoolVolumeRequest proto.InternalMessageInfo
 
-func (m *AllPoolsRequest) GetPoolId() uint64 {
+func (m *PoolVolumeRequest) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-type AllPoolsResponse struct {
-	Pools []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+type PoolVolumeResponse struct {
+	Volume   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume" yaml:"volume"`
+	SwapFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=swap_fees,json=swapFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_fees" yaml:"swap_fees"`
 }
 
-func (m *AllPoolsResponse) Reset()         { *m = AllPoolsResponse{} }
-func (m *AllPoolsResponse) String() string { return proto.CompactTextString(m) }
-func (*AllPoolsResponse) ProtoMessage()    {}
-func (*AllPoolsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{11}
+func (m *PoolVolumeResponse) Reset()         { *m = PoolVolumeResponse{} }
+func (m *PoolVolumeResponse) String() string { return proto.CompactTextString(m) }
+func (*PoolVolumeResponse) ProtoMessage()    {}
+func (*PoolVolumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{13}
 }
-func (m *AllPoolsResponse) XXX_Unmarshal(b []byte) error {
+func (m *PoolVolumeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AllPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PoolVolumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AllPoolsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PoolVolumeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -571,45 +692,50 @@ func (m *AllPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *AllPoolsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AllPoolsResponse.Merge(m, src)
+func (m *PoolVolumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolumeResponse.Merge(m, src)
 }
-func (m *AllPoolsResponse) XXX_Size() int {
+func (m *PoolVolumeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AllPoolsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllPoolsResponse.DiscardUnknown(m)
+func (m *PoolVolumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolumeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AllPoolsResponse proto.InternalMessageInfo
+var xxx_messageInfo_PoolVolumeResponse proto.InternalMessageInfo
 
-func (m *AllPoolsResponse) GetPools() []*types1.Any {
+func (m *PoolVolumeResponse) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Pools
+		return m.Volume
 	}
 	return nil
 }
 
-// SpotPriceRequest defines the gRPC request structure for a SpotPrice
-// query.
-type SpotPriceRequest struct {
-	PoolId          uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
-	BaseAssetDenom  string `protobuf:"bytes,2,opt,name=base_asset_denom,json=baseAssetDenom,proto3" json:"base_asset_denom,omitempty" yaml:"base_asset_denom"`
-	QuoteAssetDenom string `protobuf:"bytes,3,opt,name=quote_asset_denom,json=quoteAssetDenom,proto3" json:"quote_asset_denom,omitempty" yaml:"quote_asset_denom"`
+func (m *PoolVolumeResponse) GetSwapFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SwapFees
+	}
+	return nil
 }
 
-func (m *SpotPriceRequest) Reset()         { *m = SpotPriceRequest{} }
-func (m *SpotPriceRequest) String() string { return proto.CompactTextString(m) }
-func (*SpotPriceRequest) ProtoMessage()    {}
-func (*SpotPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{12}
+// =============================== PoolVolumeInWindow
+type PoolVolumeInWindowRequest struct {
+	PoolId uint64        `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Window time.Duration `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window" yaml:"window"`
 }
-func (m *SpotPriceRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *PoolVolumeInWindowRequest) Reset()         { *m = PoolVolumeInWindowRequest{} }
+func (m *PoolVolumeInWindowRequest) String() string { return proto.CompactTextString(m) }
+func (*PoolVolumeInWindowRequest) ProtoMessage()    {}
+func (*PoolVolumeInWindowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{14}
+}
+func (m *PoolVolumeInWindowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SpotPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PoolVolumeInWindowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SpotPriceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PoolVolumeInWindowRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -619,58 +745,49 @@ func (m *SpotPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *SpotPriceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SpotPriceRequest.Merge(m, src)
+func (m *PoolVolumeInWindowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolumeInWindowRequest.Merge(m, src)
 }
-func (m *SpotPriceRequest) XXX_Size() int {
+func (m *PoolVolumeInWindowRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SpotPriceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SpotPriceRequest.DiscardUnknown(m)
+func (m *PoolVolumeInWindowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolumeInWindowRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SpotPriceRequest proto.InternalMessageInfo
+var xxx_messageInfo_PoolVolumeInWindowRequest proto.InternalMessageInfo
 
-func (m *SpotPriceRequest) GetPoolId() uint64 {
+func (m *PoolVolumeInWindowRequest) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-func (m *SpotPriceRequest) GetBaseAssetDenom() string {
-	if m != nil {
-		return m.BaseAssetDenom
-	}
-	return ""
-}
-
-func (m *SpotPriceRequest) GetQuoteAssetDenom() string {
+func (m *PoolVolumeInWindowRequest) GetWindow() time.Duration {
 	if m != nil {
-		return m.QuoteAssetDenom
+		return m.Window
 	}
-	return ""
+	return 0
 }
 
-// SpotPriceResponse defines the gRPC response structure for a SpotPrice
-// query.
-type SpotPriceResponse struct {
-	// String of the Dec. Ex) 10.203uatom
-	SpotPrice string `protobuf:"bytes,1,opt,name=spot_price,json=spotPrice,proto3" json:"spot_price,omitempty" yaml:"spot_price"`
+type PoolVolumeInWindowResponse struct {
+	Volume   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume" yaml:"volume"`
+	SwapFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=swap_fees,json=swapFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_fees" yaml:"swap_fees"`
 }
 
-func (m *SpotPriceResponse) Reset()         { *m = SpotPriceResponse{} }
-func (m *SpotPriceResponse) String() string { return proto.CompactTextString(m) }
-func (*SpotPriceResponse) ProtoMessage()    {}
-func (*SpotPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{13}
+func (m *PoolVolumeInWindowResponse) Reset()         { *m = PoolVolumeInWindowResponse{} }
+func (m *PoolVolumeInWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*PoolVolumeInWindowResponse) ProtoMessage()    {}
+func (*PoolVolumeInWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{15}
 }
-func (m *SpotPriceResponse) XXX_Unmarshal(b []byte) error {
+func (m *PoolVolumeInWindowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SpotPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PoolVolumeInWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SpotPriceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PoolVolumeInWindowResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -680,1132 +797,3621 @@ func (m *SpotPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *SpotPriceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SpotPriceResponse.Merge(m, src)
+func (m *PoolVolumeInWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolumeInWindowResponse.Merge(m, src)
 }
-func (m *SpotPriceResponse) XXX_Size() int {
+func (m *PoolVolumeInWindowResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *SpotPriceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SpotPriceResponse.DiscardUnknown(m)
+func (m *PoolVolumeInWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolumeInWindowResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SpotPriceResponse proto.InternalMessageInfo
+var xxx_messageInfo_PoolVolumeInWindowResponse proto.InternalMessageInfo
 
-func (m *SpotPriceResponse) GetSpotPrice() string {
+func (m *PoolVolumeInWindowResponse) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.SpotPrice
+		return m.Volume
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*ParamsRequest)(nil), "osmosis.poolmanager.v1beta1.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "osmosis.poolmanager.v1beta1.ParamsResponse")
-	proto.RegisterType((*EstimateSwapExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInRequest")
-	proto.RegisterType((*EstimateSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInResponse")
-	proto.RegisterType((*EstimateSwapExactAmountOutRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutRequest")
-	proto.RegisterType((*EstimateSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutResponse")
-	proto.RegisterType((*NumPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.NumPoolsRequest")
-	proto.RegisterType((*NumPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.NumPoolsResponse")
-	proto.RegisterType((*PoolRequest)(nil), "osmosis.poolmanager.v1beta1.PoolRequest")
-	proto.RegisterType((*PoolResponse)(nil), "osmosis.poolmanager.v1beta1.PoolResponse")
-	proto.RegisterType((*AllPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.AllPoolsRequest")
-	proto.RegisterType((*AllPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.AllPoolsResponse")
-	proto.RegisterType((*SpotPriceRequest)(nil), "osmosis.poolmanager.v1beta1.SpotPriceRequest")
-	proto.RegisterType((*SpotPriceResponse)(nil), "osmosis.poolmanager.v1beta1.SpotPriceResponse")
+func (m *PoolVolumeInWindowResponse) GetSwapFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SwapFees
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("osmosis/poolmanager/v1beta1/query.proto", fileDescriptor_6256a4106f701b7d)
+// =============================== PausedPools
+type PausedPoolsRequest struct {
 }
 
-var fileDescriptor_6256a4106f701b7d = []byte{
-	// 1096 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xce, 0x26, 0x8e, 0x1b, 0x4f, 0x7e, 0x89, 0x9d, 0xf9, 0xa5, 0xe0, 0xba, 0x95, 0x1d, 0xa6,
-	0x50, 0x92, 0x26, 0xde, 0x55, 0x92, 0xf6, 0x52, 0xa9, 0x29, 0x71, 0x09, 0xaa, 0x2f, 0x34, 0x6c,
-	0x6e, 0x48, 0x65, 0x35, 0x71, 0x06, 0xb3, 0xea, 0xee, 0xcc, 0xc6, 0x33, 0xdb, 0xc6, 0x42, 0x5c,
-	0xb8, 0x71, 0xa9, 0x40, 0x48, 0xc0, 0x8d, 0x2f, 0xc1, 0x87, 0xa8, 0x38, 0xa0, 0x48, 0x5c, 0x10,
-	0x07, 0x0b, 0x12, 0xf8, 0x02, 0xfe, 0x04, 0x68, 0xfe, 0xec, 0xda, 0x31, 0x64, 0xe3, 0x06, 0x71,
-	0xf2, 0x7a, 0xde, 0xe7, 0x7d, 0xe7, 0x79, 0x9f, 0x77, 0xf6, 0x99, 0x05, 0x6f, 0x33, 0x1e, 0x32,
-	0xee, 0x73, 0x27, 0x62, 0x2c, 0x08, 0x31, 0xc5, 0x6d, 0xd2, 0x71, 0x9e, 0xad, 0xef, 0x13, 0x81,
-	0xd7, 0x9d, 0xc3, 0x98, 0x74, 0xba, 0x76, 0xd4, 0x61, 0x82, 0xc1, 0xeb, 0x06, 0x68, 0x0f, 0x01,
-	0x6d, 0x03, 0xac, 0x2c, 0xb6, 0x59, 0x9b, 0x29, 0x9c, 0x23, 0x9f, 0x74, 0x4a, 0x65, 0x25, 0xab,
-	0x76, 0x9b, 0x50, 0xa2, 0xca, 0x29, 0xe8, 0x9b, 0x59, 0x50, 0x71, 0x64, 0x50, 0x6b, 0x59, 0x28,
-	0xfe, 0x1c, 0x47, 0x5e, 0x87, 0xc5, 0x82, 0x18, 0x74, 0xb5, 0xa5, 0xe0, 0xce, 0x3e, 0xe6, 0x24,
-	0x45, 0xb5, 0x98, 0x4f, 0x4d, 0xfc, 0xf6, 0x70, 0x5c, 0xb5, 0x9a, 0xa2, 0x22, 0xdc, 0xf6, 0x29,
-	0x16, 0x3e, 0x4b, 0xb0, 0x37, 0xda, 0x8c, 0xb5, 0x03, 0xe2, 0xe0, 0xc8, 0x77, 0x30, 0xa5, 0x4c,
-	0xa8, 0x60, 0xc2, 0xfe, 0x9a, 0x89, 0xaa, 0x7f, 0xfb, 0xf1, 0xc7, 0x0e, 0xa6, 0xdd, 0x24, 0xa4,
-	0x37, 0xf1, 0xb4, 0x38, 0xfa, 0x8f, 0x09, 0xd5, 0x46, 0xb3, 0x84, 0x1f, 0x12, 0x2e, 0x70, 0x18,
-	0x69, 0x00, 0x2a, 0x82, 0xb9, 0x5d, 0xdc, 0xc1, 0x21, 0x77, 0xc9, 0x61, 0x4c, 0xb8, 0x40, 0x7b,
-	0x60, 0x3e, 0x59, 0xe0, 0x11, 0xa3, 0x9c, 0xc0, 0x6d, 0x90, 0x8f, 0xd4, 0x4a, 0xd9, 0x5a, 0xb2,
-	0x96, 0x67, 0x37, 0x6e, 0xda, 0x19, 0x63, 0xb2, 0x75, 0x72, 0x23, 0xf7, 0xb2, 0x57, 0x9b, 0x70,
-	0x4d, 0x22, 0xfa, 0x62, 0x12, 0x2c, 0xed, 0x70, 0xe1, 0x87, 0x58, 0x90, 0xbd, 0xe7, 0x38, 0xda,
-	0x39, 0xc2, 0x2d, 0xb1, 0x1d, 0xb2, 0x98, 0x8a, 0x26, 0x35, 0x3b, 0xc3, 0x15, 0x90, 0xe7, 0x84,
-	0x1e, 0x90, 0x8e, 0xda, 0xa7, 0xd0, 0x58, 0xe8, 0xf7, 0x6a, 0x73, 0x5d, 0x1c, 0x06, 0xf7, 0x90,
-	0x5e, 0x47, 0xae, 0x01, 0xc0, 0x55, 0x70, 0x45, 0xee, 0xed, 0xf9, 0x07, 0xe5, 0xc9, 0x25, 0x6b,
-	0x39, 0xd7, 0x80, 0xfd, 0x5e, 0x6d, 0x5e, 0x63, 0x4d, 0x00, 0xb9, 0x79, 0xf9, 0xd4, 0x3c, 0x80,
-	0x36, 0x98, 0x11, 0xec, 0x29, 0xa1, 0x9e, 0x4f, 0xcb, 0x53, 0xaa, 0xf2, 0xff, 0xfb, 0xbd, 0x5a,
-	0x51, 0xa3, 0x93, 0x08, 0x72, 0xaf, 0xa8, 0xc7, 0x26, 0x85, 0x4f, 0x40, 0x5e, 0x8d, 0x98, 0x97,
-	0x73, 0x4b, 0x53, 0xcb, 0xb3, 0x1b, 0x76, 0x66, 0xbf, 0xb2, 0x9d, 0xb4, 0x13, 0x99, 0xd6, 0xb8,
-	0x2a, 0x5b, 0x1f, 0x70, 0xd7, 0xb5, 0x90, 0x6b, 0x8a, 0xa2, 0xef, 0x2c, 0xf0, 0x46, 0x86, 0x16,
-	0x46, 0x74, 0x0e, 0x4a, 0x9a, 0x1a, 0x8b, 0x85, 0x87, 0x55, 0xd4, 0xc8, 0xd2, 0x94, 0xe5, 0x7f,
-	0xed, 0xd5, 0x6e, 0xb5, 0x7d, 0xf1, 0x49, 0xbc, 0x6f, 0xb7, 0x58, 0x68, 0x66, 0x6e, 0x7e, 0xea,
-	0xfc, 0xe0, 0xa9, 0x23, 0xba, 0x11, 0xe1, 0x76, 0x93, 0x8a, 0x7e, 0xaf, 0xf6, 0xfa, 0x70, 0xab,
-	0x83, 0x7a, 0xc8, 0x9d, 0x57, 0x4b, 0x8f, 0x63, 0xb3, 0x3d, 0x7a, 0x31, 0x79, 0x2e, 0xb5, 0xc7,
-	0xb1, 0xf8, 0xaf, 0xe7, 0xf4, 0x51, 0xaa, 0xfb, 0x94, 0xd2, 0xdd, 0x19, 0x53, 0x77, 0x49, 0x6d,
-	0x0c, 0xe1, 0xe1, 0x3a, 0x28, 0xa4, 0x12, 0x94, 0x73, 0x8a, 0xfa, 0x62, 0xbf, 0x57, 0x2b, 0x8d,
-	0xa8, 0x83, 0xdc, 0x99, 0x44, 0x16, 0xf4, 0x8d, 0x05, 0x50, 0x96, 0x20, 0x66, 0x58, 0x11, 0x28,
-	0x26, 0xe7, 0xe8, 0xec, 0xac, 0x1e, 0xbd, 0xf2, 0xac, 0x5e, 0x3b, 0x7b, 0x2c, 0xd3, 0x51, 0xcd,
-	0x99, 0xd3, 0x69, 0x26, 0xb5, 0x00, 0x8a, 0xef, 0xc7, 0xe1, 0x2e, 0x63, 0x41, 0xfa, 0xe2, 0xee,
-	0x80, 0xd2, 0x60, 0xc9, 0x10, 0x5b, 0x07, 0x05, 0x1a, 0x87, 0x9e, 0xd4, 0x4f, 0xbf, 0xbd, 0xb9,
-	0xe1, 0x96, 0xd3, 0x10, 0x72, 0x67, 0xa8, 0x49, 0x45, 0xf7, 0xc0, 0xac, 0x7c, 0x48, 0x86, 0x3d,
-	0x34, 0x41, 0xeb, 0xa2, 0x09, 0xa2, 0x87, 0xe0, 0x7f, 0x3a, 0xd7, 0x6c, 0xbf, 0x09, 0x72, 0x32,
-	0x62, 0x7c, 0x63, 0xd1, 0xd6, 0x66, 0x64, 0x27, 0x66, 0x64, 0x6f, 0xd3, 0x6e, 0xa3, 0xf0, 0xe3,
-	0x0f, 0xf5, 0x69, 0x99, 0xd5, 0x74, 0x15, 0x18, 0x6d, 0x81, 0xe2, 0x76, 0x10, 0x0c, 0xb7, 0xf6,
-	0x6a, 0x24, 0x9a, 0xa0, 0x34, 0xc8, 0x37, 0x44, 0xee, 0x82, 0xe9, 0x44, 0x83, 0xa9, 0x71, 0x98,
-	0x68, 0x34, 0x3a, 0xb6, 0x40, 0x69, 0x2f, 0x62, 0x62, 0xb7, 0xe3, 0xb7, 0xc8, 0x65, 0xc8, 0xc0,
-	0x1d, 0x50, 0x92, 0xd6, 0xef, 0x61, 0xce, 0x89, 0xf0, 0x0e, 0x08, 0x65, 0xa1, 0x7a, 0x13, 0x0a,
-	0x8d, 0xeb, 0x83, 0x17, 0x73, 0x14, 0x81, 0xdc, 0x79, 0xb9, 0xb4, 0x2d, 0x57, 0xde, 0x95, 0x0b,
-	0xf0, 0x11, 0x58, 0x38, 0x8c, 0x99, 0x38, 0x5b, 0x47, 0x7b, 0xd9, 0x8d, 0x7e, 0xaf, 0x56, 0xd6,
-	0x75, 0xfe, 0x06, 0x41, 0x6e, 0x51, 0xad, 0x0d, 0x2a, 0xa1, 0x26, 0x58, 0x18, 0xea, 0xc8, 0xc8,
-	0x73, 0x07, 0x00, 0x1e, 0x31, 0xe1, 0x45, 0x72, 0xd5, 0x1c, 0xdd, 0xab, 0xfd, 0x5e, 0x6d, 0xc1,
-	0xbc, 0xd5, 0x69, 0x0c, 0xb9, 0x05, 0x9e, 0x64, 0x6f, 0xfc, 0x54, 0x00, 0xd3, 0x1f, 0xc8, 0x2b,
-	0x0d, 0xbe, 0xb0, 0x40, 0x5e, 0xfb, 0x3e, 0xbc, 0x3d, 0xc6, 0xe5, 0x60, 0x94, 0xac, 0xac, 0x8e,
-	0x85, 0xd5, 0x1c, 0xd1, 0xea, 0xe7, 0x3f, 0xff, 0xf1, 0xf5, 0xe4, 0x5b, 0xf0, 0xa6, 0x93, 0x75,
-	0x41, 0x1b, 0x16, 0xbf, 0x5b, 0xe0, 0xda, 0xb9, 0x1e, 0x0b, 0xef, 0x67, 0xee, 0x7b, 0xd1, 0x3d,
-	0x55, 0xd9, 0xba, 0x6c, 0xba, 0xe9, 0x64, 0x47, 0x75, 0xf2, 0x00, 0xde, 0x4f, 0x3b, 0x69, 0xe3,
-	0x30, 0x4c, 0x5b, 0xf8, 0xd4, 0x1c, 0xa2, 0xcf, 0x1c, 0x62, 0x4a, 0xe9, 0xcf, 0x0e, 0x22, 0x8b,
-	0x19, 0x3f, 0xf0, 0x7c, 0x0a, 0xff, 0xb4, 0x40, 0xe5, 0x7c, 0x6f, 0x82, 0x97, 0x62, 0x39, 0x70,
-	0xf9, 0xca, 0x83, 0x4b, 0xe7, 0x9b, 0x36, 0xdf, 0x53, 0x6d, 0xbe, 0x03, 0xb7, 0xfe, 0x45, 0x9b,
-	0x2c, 0x16, 0xf0, 0x5b, 0x0b, 0xcc, 0x24, 0xc6, 0x06, 0xd7, 0x32, 0x59, 0x8d, 0x58, 0x62, 0xa5,
-	0x3e, 0x26, 0xda, 0x30, 0xb6, 0x15, 0xe3, 0x65, 0x78, 0x2b, 0xf3, 0x88, 0xa5, 0xae, 0x09, 0xbf,
-	0xb2, 0x40, 0x4e, 0x56, 0x80, 0xcb, 0xd9, 0x07, 0x79, 0x60, 0xa7, 0x95, 0x95, 0x31, 0x90, 0x86,
-	0xcd, 0x1d, 0xc5, 0xc6, 0x86, 0x6b, 0x99, 0x6c, 0x14, 0x93, 0x81, 0x98, 0x4a, 0xad, 0xc4, 0xfe,
-	0x2e, 0x50, 0x6b, 0xc4, 0x65, 0x2f, 0x50, 0x6b, 0xd4, 0x53, 0xc7, 0x54, 0x0b, 0x07, 0x41, 0x5d,
-	0xab, 0xf5, 0xbd, 0x05, 0x0a, 0xa9, 0xf5, 0xc0, 0xec, 0xcd, 0x46, 0x4d, 0xb7, 0x62, 0x8f, 0x0b,
-	0x37, 0xe4, 0x36, 0x15, 0xb9, 0x3a, 0x5c, 0xfd, 0x47, 0x72, 0x23, 0xa2, 0x39, 0xca, 0xdb, 0x78,
-	0xe3, 0xc9, 0xcb, 0x93, 0xaa, 0x75, 0x7c, 0x52, 0xb5, 0x7e, 0x3b, 0xa9, 0x5a, 0x5f, 0x9e, 0x56,
-	0x27, 0x8e, 0x4f, 0xab, 0x13, 0xbf, 0x9c, 0x56, 0x27, 0x3e, 0x7c, 0x38, 0x74, 0x7f, 0x9b, 0x82,
-	0xf5, 0x00, 0xef, 0xf3, 0xb4, 0xfa, 0xb3, 0xf5, 0xbb, 0xce, 0xd1, 0x99, 0x3d, 0x5a, 0x81, 0x4f,
-	0xa8, 0xd0, 0xdf, 0xfc, 0xfa, 0x9a, 0xc9, 0xab, 0x9f, 0xcd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x31, 0xe5, 0x33, 0xa9, 0x0f, 0x0d, 0x00, 0x00,
+func (m *PausedPoolsRequest) Reset()         { *m = PausedPoolsRequest{} }
+func (m *PausedPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*PausedPoolsRequest) ProtoMessage()    {}
+func (*PausedPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{16}
+}
+func (m *PausedPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PausedPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedPoolsRequest.Merge(m, src)
+}
+func (m *PausedPoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedPoolsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_PausedPoolsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
-	// Estimates swap amount out given in.
-	EstimateSwapExactAmountIn(ctx context.Context, in *EstimateSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error)
-	// Estimates swap amount in given out.
-	EstimateSwapExactAmountOut(ctx context.Context, in *EstimateSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error)
-	// Returns the total number of pools existing in Osmosis.
-	NumPools(ctx context.Context, in *NumPoolsRequest, opts ...grpc.CallOption) (*NumPoolsResponse, error)
-	// Pool returns the Pool specified by the pool id
-	Pool(ctx context.Context, in *PoolRequest, opts ...grpc.CallOption) (*PoolResponse, error)
-	// AllPools returns all pools on the Osmosis chain sorted by IDs.
-	AllPools(ctx context.Context, in *AllPoolsRequest, opts ...grpc.CallOption) (*AllPoolsResponse, error)
-	// SpotPrice defines a gRPC query handler that returns the spot price given
-	// a base denomination and a quote denomination.
-	SpotPrice(ctx context.Context, in *SpotPriceRequest, opts ...grpc.CallOption) (*SpotPriceResponse, error)
+type PausedPoolsResponse struct {
+	PoolIds   []uint64         `protobuf:"varint,1,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+	PoolTypes []types.PoolType `protobuf:"varint,2,rep,packed,name=pool_types,json=poolTypes,proto3,enum=osmosis.poolmanager.v1beta1.PoolType" json:"pool_types,omitempty" yaml:"pool_types"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *PausedPoolsResponse) Reset()         { *m = PausedPoolsResponse{} }
+func (m *PausedPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*PausedPoolsResponse) ProtoMessage()    {}
+func (*PausedPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{17}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *PausedPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PausedPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PausedPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedPoolsResponse.Merge(m, src)
+}
+func (m *PausedPoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedPoolsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) EstimateSwapExactAmountIn(ctx context.Context, in *EstimateSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error) {
-	out := new(EstimateSwapExactAmountInResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PausedPoolsResponse proto.InternalMessageInfo
+
+func (m *PausedPoolsResponse) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *EstimateSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error) {
-	out := new(EstimateSwapExactAmountOutResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PausedPoolsResponse) GetPoolTypes() []types.PoolType {
+	if m != nil {
+		return m.PoolTypes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) NumPools(ctx context.Context, in *NumPoolsRequest, opts ...grpc.CallOption) (*NumPoolsResponse, error) {
-	out := new(NumPoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/NumPools", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// =============================== NumPools
+type NumPoolsRequest struct {
 }
 
-func (c *queryClient) Pool(ctx context.Context, in *PoolRequest, opts ...grpc.CallOption) (*PoolResponse, error) {
-	out := new(PoolResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/Pool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *NumPoolsRequest) Reset()         { *m = NumPoolsRequest{} }
+func (m *NumPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*NumPoolsRequest) ProtoMessage()    {}
+func (*NumPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{18}
+}
+func (m *NumPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NumPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NumPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *NumPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NumPoolsRequest.Merge(m, src)
+}
+func (m *NumPoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NumPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NumPoolsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllPools(ctx context.Context, in *AllPoolsRequest, opts ...grpc.CallOption) (*AllPoolsResponse, error) {
-	out := new(AllPoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllPools", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_NumPoolsRequest proto.InternalMessageInfo
+
+type NumPoolsResponse struct {
+	NumPools uint64 `protobuf:"varint,1,opt,name=num_pools,json=numPools,proto3" json:"num_pools,omitempty" yaml:"num_pools"`
 }
 
-func (c *queryClient) SpotPrice(ctx context.Context, in *SpotPriceRequest, opts ...grpc.CallOption) (*SpotPriceResponse, error) {
-	out := new(SpotPriceResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/SpotPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *NumPoolsResponse) Reset()         { *m = NumPoolsResponse{} }
+func (m *NumPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*NumPoolsResponse) ProtoMessage()    {}
+func (*NumPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{19}
+}
+func (m *NumPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NumPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NumPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *NumPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NumPoolsResponse.Merge(m, src)
+}
+func (m *NumPoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NumPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NumPoolsResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
-	// Estimates swap amount out given in.
-	EstimateSwapExactAmountIn(context.Context, *EstimateSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error)
-	// Estimates swap amount in given out.
-	EstimateSwapExactAmountOut(context.Context, *EstimateSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error)
-	// Returns the total number of pools existing in Osmosis.
-	NumPools(context.Context, *NumPoolsRequest) (*NumPoolsResponse, error)
-	// Pool returns the Pool specified by the pool id
-	Pool(context.Context, *PoolRequest) (*PoolResponse, error)
-	// AllPools returns all pools on the Osmosis chain sorted by IDs.
-	AllPools(context.Context, *AllPoolsRequest) (*AllPoolsResponse, error)
-	// SpotPrice defines a gRPC query handler that returns the spot price given
-	// a base denomination and a quote denomination.
-	SpotPrice(context.Context, *SpotPriceRequest) (*SpotPriceResponse, error)
+var xxx_messageInfo_NumPoolsResponse proto.InternalMessageInfo
+
+func (m *NumPoolsResponse) GetNumPools() uint64 {
+	if m != nil {
+		return m.NumPools
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// =============================== Pool
+type PoolRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *PoolRequest) Reset()         { *m = PoolRequest{} }
+func (m *PoolRequest) String() string { return proto.CompactTextString(m) }
+func (*PoolRequest) ProtoMessage()    {}
+func (*PoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{20}
 }
-func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context, req *EstimateSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountIn not implemented")
+func (m *PoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *EstimateSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
+func (m *PoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) NumPools(ctx context.Context, req *NumPoolsRequest) (*NumPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NumPools not implemented")
+func (m *PoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Pool(ctx context.Context, req *PoolRequest) (*PoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+func (m *PoolRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AllPools(ctx context.Context, req *AllPoolsRequest) (*AllPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllPools not implemented")
+func (m *PoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *SpotPriceRequest) (*SpotPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
+
+var xxx_messageInfo_PoolRequest proto.InternalMessageInfo
+
+func (m *PoolRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type PoolResponse struct {
+	Pool *types3.Any `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Query_EstimateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSwapExactAmountInRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, req.(*EstimateSwapExactAmountInRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *PoolResponse) Reset()         { *m = PoolResponse{} }
+func (m *PoolResponse) String() string { return proto.CompactTextString(m) }
+func (*PoolResponse) ProtoMessage()    {}
+func (*PoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{21}
 }
-
-func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSwapExactAmountOutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, req.(*EstimateSwapExactAmountOutRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *PoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_NumPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NumPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NumPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/NumPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NumPools(ctx, req.(*NumPoolsRequest))
+func (m *PoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/Pool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pool(ctx, req.(*PoolRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *PoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolResponse.Merge(m, src)
 }
-
-func _Query_AllPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllPools(ctx, req.(*AllPoolsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *PoolResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SpotPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SpotPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/SpotPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SpotPrice(ctx, req.(*SpotPriceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *PoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolResponse.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.poolmanager.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "EstimateSwapExactAmountIn",
-			Handler:    _Query_EstimateSwapExactAmountIn_Handler,
-		},
-		{
-			MethodName: "EstimateSwapExactAmountOut",
-			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
-		},
-		{
-			MethodName: "NumPools",
-			Handler:    _Query_NumPools_Handler,
-		},
-		{
-			MethodName: "Pool",
-			Handler:    _Query_Pool_Handler,
-		},
-		{
-			MethodName: "AllPools",
-			Handler:    _Query_AllPools_Handler,
-		},
-		{
-			MethodName: "SpotPrice",
-			Handler:    _Query_SpotPrice_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/poolmanager/v1beta1/query.proto",
-}
+var xxx_messageInfo_PoolResponse proto.InternalMessageInfo
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PoolResponse) GetPool() *types3.Any {
+	if m != nil {
+		return m.Pool
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// =============================== AllPools
+type AllPoolsRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *AllPoolsRequest) Reset()         { *m = AllPoolsRequest{} }
+func (m *AllPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*AllPoolsRequest) ProtoMessage()    {}
+func (*AllPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{22}
 }
-
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AllPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *AllPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *AllPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllPoolsRequest.Merge(m, src)
+}
+func (m *AllPoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllPoolsRequest.DiscardUnknown(m)
 }
 
-func (m *EstimateSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AllPoolsRequest proto.InternalMessageInfo
+
+func (m *AllPoolsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EstimateSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type AllPoolsResponse struct {
+	Pools []*types3.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 }
 
-func (m *EstimateSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *AllPoolsResponse) Reset()         { *m = AllPoolsResponse{} }
+func (m *AllPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*AllPoolsResponse) ProtoMessage()    {}
+func (*AllPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{23}
+}
+func (m *AllPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *AllPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllPoolsResponse.Merge(m, src)
+}
+func (m *AllPoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllPoolsResponse.DiscardUnknown(m)
 }
 
-func (m *EstimateSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AllPoolsResponse proto.InternalMessageInfo
+
+func (m *AllPoolsResponse) GetPools() []*types3.Any {
+	if m != nil {
+		return m.Pools
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EstimateSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SpotPriceRequest defines the gRPC request structure for a SpotPrice
+// query.
+type SpotPriceRequest struct {
+	PoolId          uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	BaseAssetDenom  string `protobuf:"bytes,2,opt,name=base_asset_denom,json=baseAssetDenom,proto3" json:"base_asset_denom,omitempty" yaml:"base_asset_denom"`
+	QuoteAssetDenom string `protobuf:"bytes,3,opt,name=quote_asset_denom,json=quoteAssetDenom,proto3" json:"quote_asset_denom,omitempty" yaml:"quote_asset_denom"`
 }
 
-func (m *EstimateSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TokenOutAmount.Size()
-		i -= size
-		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *SpotPriceRequest) Reset()         { *m = SpotPriceRequest{} }
+func (m *SpotPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*SpotPriceRequest) ProtoMessage()    {}
+func (*SpotPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{24}
+}
+func (m *SpotPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpotPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpotPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *EstimateSwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *SpotPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpotPriceRequest.Merge(m, src)
 }
-
-func (m *EstimateSwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SpotPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpotPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpotPriceRequest.DiscardUnknown(m)
 }
 
-func (m *EstimateSwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenOut) > 0 {
-		i -= len(m.TokenOut)
-		copy(dAtA[i:], m.TokenOut)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_SpotPriceRequest proto.InternalMessageInfo
+
+func (m *SpotPriceRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *SpotPriceRequest) GetBaseAssetDenom() string {
+	if m != nil {
+		return m.BaseAssetDenom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EstimateSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpotPriceRequest) GetQuoteAssetDenom() string {
+	if m != nil {
+		return m.QuoteAssetDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EstimateSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SpotPriceResponse defines the gRPC response structure for a SpotPrice
+// query.
+type SpotPriceResponse struct {
+	// String of the Dec. Ex) 10.203uatom
+	SpotPrice string `protobuf:"bytes,1,opt,name=spot_price,json=spotPrice,proto3" json:"spot_price,omitempty" yaml:"spot_price"`
 }
 
-func (m *EstimateSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TokenInAmount.Size()
-		i -= size
-		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *SpotPriceResponse) Reset()         { *m = SpotPriceResponse{} }
+func (m *SpotPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*SpotPriceResponse) ProtoMessage()    {}
+func (*SpotPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{25}
+}
+func (m *SpotPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpotPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpotPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *SpotPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpotPriceResponse.Merge(m, src)
+}
+func (m *SpotPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpotPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpotPriceResponse.DiscardUnknown(m)
 }
 
-func (m *NumPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SpotPriceResponse proto.InternalMessageInfo
+
+func (m *SpotPriceResponse) GetSpotPrice() string {
+	if m != nil {
+		return m.SpotPrice
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *NumPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*ParamsRequest)(nil), "osmosis.poolmanager.v1beta1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "osmosis.poolmanager.v1beta1.ParamsResponse")
+	proto.RegisterType((*EstimateSwapExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInRequest")
+	proto.RegisterType((*EstimateSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInResponse")
+	proto.RegisterType((*EstimateSwapExactAmountOutRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutRequest")
+	proto.RegisterType((*EstimateSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutResponse")
+	proto.RegisterType((*EstimateBestRouteSwapExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateBestRouteSwapExactAmountInRequest")
+	proto.RegisterType((*EstimateBestRouteSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateBestRouteSwapExactAmountInResponse")
+	proto.RegisterType((*TakerFeeRequest)(nil), "osmosis.poolmanager.v1beta1.TakerFeeRequest")
+	proto.RegisterType((*TakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.TakerFeeResponse")
+	proto.RegisterType((*TakerFeeTotalsRequest)(nil), "osmosis.poolmanager.v1beta1.TakerFeeTotalsRequest")
+	proto.RegisterType((*TakerFeeTotalsResponse)(nil), "osmosis.poolmanager.v1beta1.TakerFeeTotalsResponse")
+	proto.RegisterType((*PoolVolumeRequest)(nil), "osmosis.poolmanager.v1beta1.PoolVolumeRequest")
+	proto.RegisterType((*PoolVolumeResponse)(nil), "osmosis.poolmanager.v1beta1.PoolVolumeResponse")
+	proto.RegisterType((*PoolVolumeInWindowRequest)(nil), "osmosis.poolmanager.v1beta1.PoolVolumeInWindowRequest")
+	proto.RegisterType((*PoolVolumeInWindowResponse)(nil), "osmosis.poolmanager.v1beta1.PoolVolumeInWindowResponse")
+	proto.RegisterType((*PausedPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.PausedPoolsRequest")
+	proto.RegisterType((*PausedPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.PausedPoolsResponse")
+	proto.RegisterType((*NumPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.NumPoolsRequest")
+	proto.RegisterType((*NumPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.NumPoolsResponse")
+	proto.RegisterType((*PoolRequest)(nil), "osmosis.poolmanager.v1beta1.PoolRequest")
+	proto.RegisterType((*PoolResponse)(nil), "osmosis.poolmanager.v1beta1.PoolResponse")
+	proto.RegisterType((*AllPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.AllPoolsRequest")
+	proto.RegisterType((*AllPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.AllPoolsResponse")
+	proto.RegisterType((*SpotPriceRequest)(nil), "osmosis.poolmanager.v1beta1.SpotPriceRequest")
+	proto.RegisterType((*SpotPriceResponse)(nil), "osmosis.poolmanager.v1beta1.SpotPriceResponse")
 }
 
-func (m *NumPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("osmosis/poolmanager/v1beta1/query.proto", fileDescriptor_6256a4106f701b7d)
 }
 
-func (m *NumPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_6256a4106f701b7d = []byte{
+	// 1718 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0xd2, 0xb2, 0x2c, 0x3e, 0xd7, 0x22, 0x35, 0xb1, 0x63, 0x6a, 0x13, 0x88, 0xee, 0xa4,
+	0x49, 0x25, 0xcb, 0xdc, 0x0d, 0xa5, 0xb8, 0x2d, 0x5c, 0xc4, 0xb1, 0x68, 0xcb, 0x31, 0xd1, 0x3f,
+	0x76, 0xd7, 0x46, 0x0b, 0xb4, 0x48, 0x89, 0x95, 0x38, 0x66, 0x08, 0x73, 0x77, 0xd6, 0x9c, 0x5d,
+	0x5b, 0x42, 0x6a, 0xa0, 0x28, 0x7a, 0x68, 0x2f, 0x81, 0x8b, 0x02, 0x69, 0x6e, 0x05, 0xda, 0x53,
+	0xd3, 0x4b, 0x0f, 0xfd, 0x10, 0x41, 0x51, 0xa0, 0x06, 0x0a, 0x14, 0x45, 0x0f, 0x4c, 0x6b, 0xb7,
+	0x5f, 0x40, 0x9f, 0xa0, 0x98, 0x99, 0xb7, 0xcb, 0x25, 0x25, 0x2d, 0x97, 0x0a, 0x7c, 0xca, 0x49,
+	0xab, 0x99, 0xdf, 0x7b, 0xef, 0xf7, 0xde, 0x9b, 0xb7, 0xfb, 0x1b, 0xc2, 0x57, 0xb9, 0xf0, 0xb8,
+	0xe8, 0x0a, 0x3b, 0xe0, 0xbc, 0xe7, 0xb9, 0xbe, 0xdb, 0x61, 0x7d, 0xfb, 0x61, 0x7d, 0x8b, 0x85,
+	0x6e, 0xdd, 0x7e, 0x10, 0xb1, 0xfe, 0xae, 0x15, 0xf4, 0x79, 0xc8, 0xc9, 0x2b, 0x08, 0xb4, 0x52,
+	0x40, 0x0b, 0x81, 0xe6, 0x99, 0x0e, 0xef, 0x70, 0x85, 0xb3, 0xe5, 0x93, 0x36, 0x31, 0x57, 0xb2,
+	0x7c, 0x77, 0x98, 0xcf, 0x94, 0x3b, 0x05, 0xfd, 0x4a, 0x16, 0x34, 0xdc, 0x41, 0xd4, 0xc5, 0x2c,
+	0x94, 0x78, 0xe4, 0x06, 0xad, 0x3e, 0x8f, 0x42, 0x86, 0x68, 0x2b, 0x0b, 0xed, 0xf1, 0x76, 0xd4,
+	0x63, 0x23, 0xf8, 0xa5, 0x6d, 0x65, 0x60, 0x6f, 0xb9, 0x82, 0x25, 0xb8, 0x6d, 0xde, 0xf5, 0x71,
+	0xff, 0x42, 0x7a, 0x5f, 0x95, 0x26, 0x41, 0x05, 0x6e, 0xa7, 0xeb, 0xbb, 0x61, 0x97, 0xc7, 0xd8,
+	0x57, 0x3b, 0x9c, 0x77, 0x7a, 0xcc, 0x76, 0x83, 0xae, 0xed, 0xfa, 0x3e, 0x0f, 0xd5, 0x66, 0x9c,
+	0xed, 0x22, 0xee, 0xaa, 0xff, 0xb6, 0xa2, 0x7b, 0xb6, 0xeb, 0xef, 0xc6, 0x5b, 0x3a, 0x48, 0x4b,
+	0x17, 0x53, 0xff, 0x83, 0x5b, 0xd5, 0x71, 0xab, 0xb0, 0xeb, 0x31, 0x11, 0xba, 0x5e, 0x10, 0x27,
+	0x30, 0x0e, 0x68, 0x47, 0xfd, 0x14, 0x29, 0x5a, 0x82, 0xd3, 0xb7, 0xdd, 0xbe, 0xeb, 0x09, 0x87,
+	0x3d, 0x88, 0x98, 0x08, 0xe9, 0x1d, 0x98, 0x8f, 0x17, 0x44, 0xc0, 0x7d, 0xc1, 0xc8, 0x06, 0xcc,
+	0x06, 0x6a, 0xa5, 0x62, 0x9c, 0x37, 0x96, 0x4f, 0xad, 0xbd, 0x66, 0x65, 0xb4, 0xdd, 0xd2, 0xc6,
+	0x8d, 0x99, 0x4f, 0x07, 0xd5, 0x63, 0x0e, 0x1a, 0xd2, 0x5f, 0x16, 0xe0, 0xfc, 0xa6, 0x08, 0xbb,
+	0x9e, 0x1b, 0xb2, 0x3b, 0x8f, 0xdc, 0x60, 0x73, 0xc7, 0xdd, 0x0e, 0x37, 0x3c, 0x1e, 0xf9, 0x61,
+	0xd3, 0xc7, 0xc8, 0x64, 0x05, 0x66, 0x05, 0xf3, 0xdb, 0xac, 0xaf, 0xe2, 0x14, 0x1b, 0x0b, 0x7b,
+	0x83, 0xea, 0xe9, 0x5d, 0xd7, 0xeb, 0x5d, 0xa6, 0x7a, 0x9d, 0x3a, 0x08, 0x20, 0xab, 0x70, 0x52,
+	0xc6, 0x6e, 0x75, 0xdb, 0x95, 0xc2, 0x79, 0x63, 0x79, 0xa6, 0x41, 0xf6, 0x06, 0xd5, 0x79, 0x8d,
+	0xc5, 0x0d, 0xea, 0xcc, 0xca, 0xa7, 0x66, 0x9b, 0x58, 0x30, 0x17, 0xf2, 0xfb, 0xcc, 0x6f, 0x75,
+	0xfd, 0xca, 0x71, 0xe5, 0xf9, 0xa5, 0xbd, 0x41, 0xb5, 0xa4, 0xd1, 0xf1, 0x0e, 0x75, 0x4e, 0xaa,
+	0xc7, 0xa6, 0x4f, 0xde, 0x83, 0x59, 0x75, 0x04, 0x44, 0x65, 0xe6, 0xfc, 0xf1, 0xe5, 0x53, 0x6b,
+	0x56, 0x66, 0xbe, 0x32, 0x9d, 0x24, 0x13, 0x69, 0xd6, 0x38, 0x2b, 0x53, 0x1f, 0x72, 0xd7, 0xbe,
+	0xa8, 0x83, 0x4e, 0xe9, 0xc7, 0x06, 0x7c, 0x39, 0xa3, 0x16, 0x58, 0x74, 0x01, 0x65, 0x4d, 0x8d,
+	0x47, 0x61, 0xcb, 0x55, 0xbb, 0x58, 0x96, 0xa6, 0x74, 0xff, 0xaf, 0x41, 0xf5, 0x8d, 0x4e, 0x37,
+	0x7c, 0x3f, 0xda, 0xb2, 0xb6, 0xb9, 0x87, 0x67, 0x02, 0xff, 0xd4, 0x44, 0xfb, 0xbe, 0x1d, 0xee,
+	0x06, 0x4c, 0x58, 0x4d, 0x3f, 0xdc, 0x1b, 0x54, 0xcf, 0xa5, 0x53, 0x1d, 0xfa, 0xa3, 0xce, 0xbc,
+	0x5a, 0xba, 0x15, 0x61, 0x78, 0xfa, 0x61, 0xe1, 0x50, 0x6a, 0xb7, 0xa2, 0xf0, 0x45, 0xf7, 0xe9,
+	0xc7, 0x49, 0xdd, 0x8f, 0xab, 0xba, 0xdb, 0x39, 0xeb, 0x2e, 0xa9, 0xe5, 0x28, 0x3c, 0xa9, 0x43,
+	0x31, 0x29, 0x41, 0x65, 0x46, 0x51, 0x3f, 0xb3, 0x37, 0xa8, 0x96, 0xc7, 0xaa, 0x43, 0x9d, 0xb9,
+	0xb8, 0x2c, 0xf4, 0x23, 0x03, 0x68, 0x56, 0x41, 0xb0, 0x59, 0x01, 0x94, 0xe2, 0x73, 0x34, 0xda,
+	0xab, 0x9b, 0x53, 0xf7, 0xea, 0xe5, 0xd1, 0x63, 0x99, 0xb4, 0xea, 0x34, 0x9e, 0x4e, 0xec, 0xd4,
+	0xdf, 0x0c, 0x58, 0x89, 0x89, 0x35, 0x98, 0xd0, 0x05, 0x38, 0x74, 0xb2, 0xd2, 0x13, 0x60, 0xe4,
+	0x98, 0x80, 0x06, 0x94, 0x86, 0x87, 0xa5, 0xcd, 0x7c, 0xee, 0xa9, 0xf6, 0x15, 0x1b, 0xe6, 0x38,
+	0xc3, 0x04, 0x10, 0x33, 0xbc, 0x15, 0x85, 0xd7, 0xe5, 0xff, 0x32, 0xa6, 0xe7, 0xee, 0xb4, 0xde,
+	0xe7, 0x81, 0x50, 0x53, 0x37, 0x93, 0x8e, 0x19, 0xef, 0x50, 0xe7, 0xa4, 0xe7, 0xee, 0xdc, 0x94,
+	0x4f, 0x3f, 0x2d, 0xc0, 0x85, 0x3c, 0x19, 0x61, 0xc9, 0x87, 0x43, 0x6a, 0xbc, 0x80, 0x21, 0x3d,
+	0x70, 0xfc, 0x0a, 0x2f, 0x7a, 0xfc, 0x3a, 0x50, 0xba, 0xeb, 0xde, 0x67, 0xfd, 0x1b, 0x8c, 0xa5,
+	0x66, 0x4d, 0x95, 0xf7, 0xcd, 0xfd, 0xb3, 0xa6, 0xd7, 0xa9, 0x83, 0x80, 0x04, 0x5a, 0xaf, 0x14,
+	0x0e, 0x84, 0xd6, 0x63, 0x68, 0x9d, 0x0a, 0x28, 0x0f, 0x03, 0x61, 0x41, 0x5b, 0x50, 0x0c, 0xe5,
+	0x5a, 0xeb, 0x1e, 0x63, 0x18, 0xac, 0x31, 0x45, 0xaa, 0xd7, 0xd9, 0x76, 0x6a, 0x96, 0x62, 0x47,
+	0x72, 0x96, 0x30, 0x10, 0x3d, 0x07, 0x67, 0xe3, 0xa0, 0x77, 0x79, 0xe8, 0xf6, 0x92, 0x2f, 0xce,
+	0x1f, 0x0d, 0x78, 0x79, 0x7c, 0x07, 0x49, 0x3d, 0x31, 0xa0, 0x9c, 0x38, 0x6b, 0x85, 0x6a, 0x13,
+	0x1b, 0xbe, 0x68, 0xe1, 0x87, 0x50, 0x7e, 0x7a, 0x93, 0x46, 0x5f, 0xe3, 0x5d, 0xbf, 0xf1, 0x2d,
+	0xec, 0xed, 0xb9, 0x31, 0x36, 0xe8, 0x80, 0x7e, 0xf2, 0x59, 0x75, 0x39, 0x47, 0x4a, 0xd2, 0x97,
+	0x70, 0xe6, 0xc3, 0x11, 0x6a, 0xf4, 0x2a, 0x2c, 0xdc, 0xe6, 0xbc, 0xf7, 0x7d, 0xde, 0x8b, 0xbc,
+	0xa4, 0x4d, 0xa9, 0xf7, 0x9c, 0x31, 0xe9, 0x3d, 0x47, 0x7f, 0x51, 0x00, 0x92, 0x76, 0x81, 0xb9,
+	0x86, 0x30, 0xfb, 0x50, 0xad, 0x4c, 0x4e, 0x70, 0x63, 0xf4, 0xf0, 0x6a, 0xb3, 0xe9, 0xd2, 0xc2,
+	0x58, 0xe4, 0x27, 0x50, 0x54, 0x22, 0xe9, 0x1e, 0x63, 0xa2, 0x52, 0x98, 0x14, 0xf8, 0x3a, 0x06,
+	0xc6, 0x3e, 0x27, 0x96, 0xd3, 0xc5, 0x9e, 0x93, 0x76, 0x37, 0xa4, 0xd9, 0x47, 0x06, 0x2c, 0x0e,
+	0x4b, 0xd1, 0xf4, 0x7f, 0xd0, 0xf5, 0xdb, 0xfc, 0xd1, 0x51, 0xaa, 0x4a, 0xbe, 0x0d, 0xb3, 0x8f,
+	0x94, 0xb5, 0x3a, 0xfe, 0x32, 0x0b, 0xad, 0x7c, 0xac, 0x58, 0xf9, 0x58, 0xd7, 0x51, 0xf9, 0x34,
+	0x16, 0x47, 0xcb, 0xa7, 0xcd, 0xe8, 0xc7, 0x9f, 0x55, 0x0d, 0x07, 0x7d, 0xd0, 0x27, 0x05, 0x30,
+	0x0f, 0x22, 0xf6, 0x05, 0xee, 0xd5, 0x19, 0x20, 0xb7, 0xdd, 0x48, 0xb0, 0xb6, 0xac, 0x4b, 0x32,
+	0xbc, 0xbf, 0x33, 0xe0, 0xa5, 0x91, 0x65, 0xac, 0x90, 0x05, 0x73, 0xd8, 0x22, 0x3d, 0xb0, 0x23,
+	0xaf, 0xff, 0x78, 0x87, 0x3a, 0x27, 0x75, 0xf7, 0x04, 0xf9, 0x11, 0x80, 0x5a, 0x55, 0xb1, 0x55,
+	0x72, 0xf3, 0x6b, 0xaf, 0x67, 0x0b, 0x4d, 0xce, 0x7b, 0x77, 0x77, 0x03, 0xd6, 0x38, 0xbb, 0x37,
+	0xa8, 0x2e, 0xa4, 0x1c, 0x2b, 0x17, 0xd4, 0x29, 0x06, 0x08, 0x10, 0x74, 0x01, 0x4a, 0xdf, 0x8d,
+	0xbc, 0x11, 0xde, 0x9b, 0x50, 0x1e, 0x2e, 0x21, 0xe7, 0x3a, 0x14, 0xfd, 0xc8, 0x6b, 0x49, 0x3b,
+	0x81, 0x27, 0x2e, 0x25, 0x10, 0x92, 0x2d, 0xea, 0xcc, 0xf9, 0x68, 0x4a, 0x2f, 0xc3, 0x29, 0xf9,
+	0x70, 0xa4, 0xf7, 0xc0, 0x35, 0xf8, 0x92, 0xb6, 0xc5, 0xf0, 0xeb, 0x30, 0x23, 0x77, 0x50, 0x65,
+	0x9f, 0xd9, 0x77, 0x7e, 0x37, 0xfc, 0xdd, 0x46, 0xf1, 0x2f, 0x7f, 0xae, 0x9d, 0x90, 0x56, 0x4d,
+	0x47, 0x81, 0xe9, 0x15, 0x28, 0x6d, 0xf4, 0x7a, 0xe9, 0xd4, 0xa6, 0x23, 0xd1, 0x84, 0xf2, 0xd0,
+	0x1e, 0x89, 0x5c, 0x82, 0x13, 0x71, 0x0d, 0x8e, 0xe7, 0x61, 0xa2, 0xd1, 0xf4, 0xa9, 0x01, 0xe5,
+	0x3b, 0x01, 0x0f, 0x6f, 0xf7, 0xbb, 0xdb, 0x47, 0x7a, 0x33, 0x92, 0x4d, 0x28, 0xcb, 0x73, 0xdc,
+	0x72, 0x85, 0x60, 0xa3, 0xc2, 0xe3, 0x95, 0xe1, 0xeb, 0x7c, 0x1c, 0x41, 0x9d, 0x79, 0xb9, 0xb4,
+	0x21, 0x57, 0xb4, 0xf4, 0xb8, 0x09, 0x0b, 0x0f, 0x22, 0x1e, 0x8e, 0xfa, 0xd1, 0xca, 0xff, 0xd5,
+	0xbd, 0x41, 0xb5, 0xa2, 0xfd, 0xec, 0x83, 0x50, 0xa7, 0xa4, 0xd6, 0x86, 0x9e, 0x68, 0x13, 0x16,
+	0x52, 0x19, 0x61, 0x79, 0xde, 0x02, 0x10, 0x01, 0x0f, 0x5b, 0x81, 0x5c, 0xc5, 0x4f, 0x65, 0xea,
+	0x0c, 0x0e, 0xf7, 0xa8, 0x53, 0x14, 0xb1, 0xf5, 0xda, 0x3f, 0x08, 0x9c, 0xf8, 0x9e, 0xbc, 0x20,
+	0x92, 0x0f, 0x0d, 0x98, 0xd5, 0xb7, 0x24, 0x72, 0x21, 0xc7, 0x55, 0x0a, 0x2b, 0x69, 0xae, 0xe6,
+	0xc2, 0x6a, 0x8e, 0x74, 0xf5, 0x67, 0x7f, 0xff, 0xef, 0xaf, 0x0b, 0xaf, 0x93, 0xd7, 0xec, 0xac,
+	0x0b, 0x2f, 0xb2, 0xf8, 0x8f, 0x01, 0x8b, 0x87, 0xde, 0x48, 0xc8, 0xdb, 0x99, 0x71, 0x27, 0xdd,
+	0xea, 0xcc, 0x2b, 0x47, 0x35, 0xc7, 0x4c, 0x36, 0x55, 0x26, 0xef, 0x90, 0xb7, 0x93, 0x4c, 0x3a,
+	0xae, 0xe7, 0x25, 0x29, 0x7c, 0x80, 0x87, 0xe8, 0xb1, 0xcd, 0xd0, 0x95, 0xbe, 0xf4, 0x33, 0xe9,
+	0x0c, 0x95, 0x56, 0xab, 0xeb, 0x93, 0xff, 0x19, 0x60, 0x1e, 0xae, 0xe4, 0xc9, 0x91, 0x58, 0x0e,
+	0xef, 0x44, 0xe6, 0x3b, 0x47, 0xb6, 0xc7, 0x34, 0x6f, 0xa8, 0x34, 0xaf, 0x92, 0x2b, 0x9f, 0x23,
+	0x4d, 0x1e, 0x85, 0xe4, 0xe7, 0x05, 0xa0, 0x93, 0x65, 0x34, 0xb9, 0x91, 0x8b, 0xef, 0xc4, 0x9b,
+	0x85, 0xf9, 0xee, 0xe7, 0xf6, 0x83, 0xf9, 0x7f, 0x47, 0xe5, 0xff, 0x2e, 0xd9, 0xcc, 0x3c, 0xb0,
+	0x49, 0xf2, 0x5b, 0x4c, 0x84, 0xfa, 0x87, 0x9a, 0xd6, 0x81, 0xed, 0xfe, 0x83, 0x01, 0x73, 0xb1,
+	0xa6, 0x24, 0x17, 0x33, 0x49, 0x8e, 0x49, 0x6e, 0xb3, 0x96, 0x13, 0x8d, 0xc4, 0xaf, 0x2a, 0xe2,
+	0x97, 0xc9, 0x37, 0x32, 0x89, 0x27, 0x1a, 0xd4, 0xfe, 0x40, 0xcb, 0xf5, 0xc7, 0xf8, 0x50, 0x7f,
+	0x4c, 0xfe, 0x64, 0xc0, 0xfc, 0xa8, 0xfe, 0x25, 0x6b, 0xb9, 0x38, 0x8c, 0xc8, 0x68, 0x73, 0x7d,
+	0x2a, 0x1b, 0x64, 0x7f, 0x49, 0xb1, 0xb7, 0x49, 0x2d, 0x1f, 0x7b, 0x54, 0xd0, 0xe4, 0x13, 0x03,
+	0x60, 0x28, 0x8f, 0x88, 0x35, 0xf1, 0x43, 0x3d, 0x22, 0x97, 0x4d, 0x3b, 0x37, 0x1e, 0x69, 0x7e,
+	0x53, 0xd1, 0xbc, 0x44, 0xd6, 0x33, 0x69, 0xca, 0x35, 0x91, 0x1a, 0x15, 0x94, 0x4d, 0x7f, 0x35,
+	0x80, 0xec, 0xd7, 0x72, 0xe4, 0x6b, 0x39, 0x49, 0x8c, 0xa9, 0x52, 0xf3, 0xeb, 0x53, 0xdb, 0x1d,
+	0xfa, 0x26, 0xcb, 0x9f, 0x84, 0xfc, 0x29, 0x40, 0x4b, 0x53, 0xf2, 0x7b, 0x03, 0x4e, 0xa5, 0x14,
+	0x17, 0x99, 0x50, 0xcc, 0x7d, 0x92, 0xcd, 0x7c, 0x33, 0xbf, 0x01, 0x32, 0xaf, 0x2b, 0xe6, 0xab,
+	0x64, 0x25, 0x9b, 0xb9, 0xb2, 0xd4, 0x1a, 0x89, 0xfc, 0xc6, 0x80, 0xb9, 0x58, 0x60, 0x4d, 0x18,
+	0xc0, 0x31, 0x69, 0x66, 0xd6, 0x72, 0xa2, 0x91, 0x9c, 0xa5, 0xc8, 0x2d, 0x93, 0x37, 0x32, 0xc9,
+	0x25, 0xea, 0x8d, 0xfc, 0xca, 0x80, 0x19, 0xe9, 0x81, 0x2c, 0x4f, 0x6c, 0x64, 0xcc, 0x68, 0x25,
+	0x07, 0x12, 0xd9, 0xbc, 0xa5, 0xd8, 0x58, 0xe4, 0xe2, 0x34, 0x4d, 0x56, 0xd5, 0x8a, 0x65, 0xd8,
+	0x84, 0x6a, 0x8d, 0xa9, 0x3d, 0xb3, 0x96, 0x13, 0x3d, 0x55, 0xb5, 0xdc, 0x5e, 0xaf, 0xa6, 0xab,
+	0xf5, 0x5b, 0x03, 0x8a, 0x89, 0x04, 0x22, 0xd9, 0xc1, 0xc6, 0xc5, 0x9f, 0x69, 0xe5, 0x85, 0x23,
+	0xb9, 0x75, 0x45, 0xae, 0x46, 0x56, 0x0f, 0x24, 0x37, 0x3e, 0x19, 0x4a, 0x63, 0x89, 0xc6, 0x7b,
+	0x9f, 0x3e, 0x5b, 0x32, 0x9e, 0x3e, 0x5b, 0x32, 0xfe, 0xfd, 0x6c, 0xc9, 0x78, 0xf2, 0x7c, 0xe9,
+	0xd8, 0xd3, 0xe7, 0x4b, 0xc7, 0xfe, 0xf9, 0x7c, 0xe9, 0xd8, 0x0f, 0xaf, 0xa5, 0x6e, 0x39, 0xe8,
+	0xb0, 0xd6, 0x73, 0xb7, 0x44, 0xe2, 0xfd, 0x61, 0xfd, 0x92, 0xbd, 0x33, 0x12, 0x63, 0xbb, 0xd7,
+	0x65, 0x7e, 0xa8, 0x7f, 0xc9, 0xd7, 0x72, 0x77, 0x56, 0xfd, 0x59, 0xff, 0xff, 0x00, 0x5c, 0x2e,
+	0x3f, 0x74, 0x15, 0x19, 0x00, 0x00,
 }
 
-func (m *NumPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-func (m *NumPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NumPools != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumPools))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
 
-func (m *PoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// Estimates swap amount out given in.
+	EstimateSwapExactAmountIn(ctx context.Context, in *EstimateSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error)
+	// Estimates swap amount in given out.
+	EstimateSwapExactAmountOut(ctx context.Context, in *EstimateSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error)
+	// EstimateBestRouteSwapExactAmountIn searches all routes of up to max_hops
+	// pools between the given token in and token out denom and returns the one
+	// with the highest estimated amount out.
+	EstimateBestRouteSwapExactAmountIn(ctx context.Context, in *EstimateBestRouteSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateBestRouteSwapExactAmountInResponse, error)
+	// TakerFee returns the taker fee charged on swaps between the given denoms.
+	TakerFee(ctx context.Context, in *TakerFeeRequest, opts ...grpc.CallOption) (*TakerFeeResponse, error)
+	// TakerFeeTotals returns the total amount of taker fees collected per denom.
+	TakerFeeTotals(ctx context.Context, in *TakerFeeTotalsRequest, opts ...grpc.CallOption) (*TakerFeeTotalsResponse, error)
+	// PoolVolume returns the lifetime volume and collected swap fees of a pool.
+	PoolVolume(ctx context.Context, in *PoolVolumeRequest, opts ...grpc.CallOption) (*PoolVolumeResponse, error)
+	// PoolVolumeInWindow returns the volume and collected swap fees of a pool
+	// over the given window ending at the current block time.
+	PoolVolumeInWindow(ctx context.Context, in *PoolVolumeInWindowRequest, opts ...grpc.CallOption) (*PoolVolumeInWindowResponse, error)
+	// PausedPools returns the ids of the paused pools and the paused pool types.
+	PausedPools(ctx context.Context, in *PausedPoolsRequest, opts ...grpc.CallOption) (*PausedPoolsResponse, error)
+	// Returns the total number of pools existing in Osmosis.
+	NumPools(ctx context.Context, in *NumPoolsRequest, opts ...grpc.CallOption) (*NumPoolsResponse, error)
+	// Pool returns the Pool specified by the pool id
+	Pool(ctx context.Context, in *PoolRequest, opts ...grpc.CallOption) (*PoolResponse, error)
+	// AllPools returns all pools on the Osmosis chain sorted by IDs.
+	AllPools(ctx context.Context, in *AllPoolsRequest, opts ...grpc.CallOption) (*AllPoolsResponse, error)
+	// SpotPrice defines a gRPC query handler that returns the spot price given
+	// a base denomination and a quote denomination.
+	SpotPrice(ctx context.Context, in *SpotPriceRequest, opts ...grpc.CallOption) (*SpotPriceResponse, error)
 }
 
-func (m *PoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *PoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *PoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *PoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pool != nil {
-		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) EstimateSwapExactAmountIn(ctx context.Context, in *EstimateSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error) {
+	out := new(EstimateSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AllPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *EstimateSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error) {
+	out := new(EstimateSwapExactAmountOutResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AllPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EstimateBestRouteSwapExactAmountIn(ctx context.Context, in *EstimateBestRouteSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateBestRouteSwapExactAmountInResponse, error) {
+	out := new(EstimateBestRouteSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateBestRouteSwapExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *AllPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) TakerFee(ctx context.Context, in *TakerFeeRequest, opts ...grpc.CallOption) (*TakerFeeResponse, error) {
+	out := new(TakerFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TakerFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AllPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TakerFeeTotals(ctx context.Context, in *TakerFeeTotalsRequest, opts ...grpc.CallOption) (*TakerFeeTotalsResponse, error) {
+	out := new(TakerFeeTotalsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TakerFeeTotals", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AllPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolVolume(ctx context.Context, in *PoolVolumeRequest, opts ...grpc.CallOption) (*PoolVolumeResponse, error) {
+	out := new(PoolVolumeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/PoolVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *AllPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) PoolVolumeInWindow(ctx context.Context, in *PoolVolumeInWindowRequest, opts ...grpc.CallOption) (*PoolVolumeInWindowResponse, error) {
+	out := new(PoolVolumeInWindowResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/PoolVolumeInWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SpotPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PausedPools(ctx context.Context, in *PausedPoolsRequest, opts ...grpc.CallOption) (*PausedPoolsResponse, error) {
+	out := new(PausedPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/PausedPools", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NumPools(ctx context.Context, in *NumPoolsRequest, opts ...grpc.CallOption) (*NumPoolsResponse, error) {
+	out := new(NumPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/NumPools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QuoteAssetDenom) > 0 {
-		i -= len(m.QuoteAssetDenom)
-		copy(dAtA[i:], m.QuoteAssetDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAssetDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BaseAssetDenom) > 0 {
-		i -= len(m.BaseAssetDenom)
-		copy(dAtA[i:], m.BaseAssetDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAssetDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) Pool(ctx context.Context, in *PoolRequest, opts ...grpc.CallOption) (*PoolResponse, error) {
+	out := new(PoolResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/Pool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SpotPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllPools(ctx context.Context, in *AllPoolsRequest, opts ...grpc.CallOption) (*AllPoolsResponse, error) {
+	out := new(AllPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllPools", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SpotPrice(ctx context.Context, in *SpotPriceRequest, opts ...grpc.CallOption) (*SpotPriceResponse, error) {
+	out := new(SpotPriceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/SpotPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpotPrice) > 0 {
-		i -= len(m.SpotPrice)
-		copy(dAtA[i:], m.SpotPrice)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpotPrice)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// Estimates swap amount out given in.
+	EstimateSwapExactAmountIn(context.Context, *EstimateSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error)
+	// Estimates swap amount in given out.
+	EstimateSwapExactAmountOut(context.Context, *EstimateSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error)
+	// EstimateBestRouteSwapExactAmountIn searches all routes of up to max_hops
+	// pools between the given token in and token out denom and returns the one
+	// with the highest estimated amount out.
+	EstimateBestRouteSwapExactAmountIn(context.Context, *EstimateBestRouteSwapExactAmountInRequest) (*EstimateBestRouteSwapExactAmountInResponse, error)
+	// TakerFee returns the taker fee charged on swaps between the given denoms.
+	TakerFee(context.Context, *TakerFeeRequest) (*TakerFeeResponse, error)
+	// TakerFeeTotals returns the total amount of taker fees collected per denom.
+	TakerFeeTotals(context.Context, *TakerFeeTotalsRequest) (*TakerFeeTotalsResponse, error)
+	// PoolVolume returns the lifetime volume and collected swap fees of a pool.
+	PoolVolume(context.Context, *PoolVolumeRequest) (*PoolVolumeResponse, error)
+	// PoolVolumeInWindow returns the volume and collected swap fees of a pool
+	// over the given window ending at the current block time.
+	PoolVolumeInWindow(context.Context, *PoolVolumeInWindowRequest) (*PoolVolumeInWindowResponse, error)
+	// PausedPools returns the ids of the paused pools and the paused pool types.
+	PausedPools(context.Context, *PausedPoolsRequest) (*PausedPoolsResponse, error)
+	// Returns the total number of pools existing in Osmosis.
+	NumPools(context.Context, *NumPoolsRequest) (*NumPoolsResponse, error)
+	// Pool returns the Pool specified by the pool id
+	Pool(context.Context, *PoolRequest) (*PoolResponse, error)
+	// AllPools returns all pools on the Osmosis chain sorted by IDs.
+	AllPools(context.Context, *AllPoolsRequest) (*AllPoolsResponse, error)
+	// SpotPrice defines a gRPC query handler that returns the spot price given
+	// a base denomination and a quote denomination.
+	SpotPrice(context.Context, *SpotPriceRequest) (*SpotPriceResponse, error)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context, req *EstimateSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountIn not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *EstimateSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
+}
+func (*UnimplementedQueryServer) EstimateBestRouteSwapExactAmountIn(ctx context.Context, req *EstimateBestRouteSwapExactAmountInRequest) (*EstimateBestRouteSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateBestRouteSwapExactAmountIn not implemented")
+}
+func (*UnimplementedQueryServer) TakerFee(ctx context.Context, req *TakerFeeRequest) (*TakerFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakerFee not implemented")
+}
+func (*UnimplementedQueryServer) TakerFeeTotals(ctx context.Context, req *TakerFeeTotalsRequest) (*TakerFeeTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakerFeeTotals not implemented")
+}
+func (*UnimplementedQueryServer) PoolVolume(ctx context.Context, req *PoolVolumeRequest) (*PoolVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolVolume not implemented")
+}
+func (*UnimplementedQueryServer) PoolVolumeInWindow(ctx context.Context, req *PoolVolumeInWindowRequest) (*PoolVolumeInWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolVolumeInWindow not implemented")
+}
+func (*UnimplementedQueryServer) PausedPools(ctx context.Context, req *PausedPoolsRequest) (*PausedPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedPools not implemented")
+}
+func (*UnimplementedQueryServer) NumPools(ctx context.Context, req *NumPoolsRequest) (*NumPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NumPools not implemented")
+}
+func (*UnimplementedQueryServer) Pool(ctx context.Context, req *PoolRequest) (*PoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+}
+func (*UnimplementedQueryServer) AllPools(ctx context.Context, req *AllPoolsRequest) (*AllPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllPools not implemented")
+}
+func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *SpotPriceRequest) (*SpotPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *EstimateSwapExactAmountInRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/Params",
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountInResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EstimateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSwapExactAmountInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TokenOutAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, req.(*EstimateSwapExactAmountInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSwapExactAmountOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, in)
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut",
 	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, req.(*EstimateSwapExactAmountOutRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EstimateBestRouteSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateBestRouteSwapExactAmountInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TokenInAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateBestRouteSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateBestRouteSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateBestRouteSwapExactAmountIn(ctx, req.(*EstimateBestRouteSwapExactAmountInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NumPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TakerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakerFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).TakerFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TakerFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TakerFee(ctx, req.(*TakerFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NumPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TakerFeeTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakerFeeTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.NumPools != 0 {
-		n += 1 + sovQuery(uint64(m.NumPools))
+	if interceptor == nil {
+		return srv.(QueryServer).TakerFeeTotals(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TakerFeeTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TakerFeeTotals(ctx, req.(*TakerFeeTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).PoolVolume(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/PoolVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolVolume(ctx, req.(*PoolVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolVolumeInWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolVolumeInWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolVolumeInWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/PoolVolumeInWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolVolumeInWindow(ctx, req.(*PoolVolumeInWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PausedPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PausedPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/PausedPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedPools(ctx, req.(*PausedPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NumPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NumPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NumPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/NumPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NumPools(ctx, req.(*NumPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/Pool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pool(ctx, req.(*PoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllPools(ctx, req.(*AllPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpotPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpotPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/SpotPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpotPrice(ctx, req.(*SpotPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.poolmanager.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountIn",
+			Handler:    _Query_EstimateSwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountOut",
+			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
+		},
+		{
+			MethodName: "EstimateBestRouteSwapExactAmountIn",
+			Handler:    _Query_EstimateBestRouteSwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "TakerFee",
+			Handler:    _Query_TakerFee_Handler,
+		},
+		{
+			MethodName: "TakerFeeTotals",
+			Handler:    _Query_TakerFeeTotals_Handler,
+		},
+		{
+			MethodName: "PoolVolume",
+			Handler:    _Query_PoolVolume_Handler,
+		},
+		{
+			MethodName: "PoolVolumeInWindow",
+			Handler:    _Query_PoolVolumeInWindow_Handler,
+		},
+		{
+			MethodName: "PausedPools",
+			Handler:    _Query_PausedPools_Handler,
+		},
+		{
+			MethodName: "NumPools",
+			Handler:    _Query_NumPools_Handler,
+		},
+		{
+			MethodName: "Pool",
+			Handler:    _Query_Pool_Handler,
+		},
+		{
+			MethodName: "AllPools",
+			Handler:    _Query_AllPools_Handler,
+		},
+		{
+			MethodName: "SpotPrice",
+			Handler:    _Query_SpotPrice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/poolmanager/v1beta1/query.proto",
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateSwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateBestRouteSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateBestRouteSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateBestRouteSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxHops != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHops))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateBestRouteSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateBestRouteSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateBestRouteSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TakerFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom1) > 0 {
+		i -= len(m.Denom1)
+		copy(dAtA[i:], m.Denom1)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom0) > 0 {
+		i -= len(m.Denom0)
+		copy(dAtA[i:], m.Denom0)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom0)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TakerFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TakerFeeTotalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeTotalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeTotalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *TakerFeeTotalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeTotalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeTotalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TakerFeeTotals) > 0 {
+		for iNdEx := len(m.TakerFeeTotals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeeTotals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolVolumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolVolumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVolumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolVolumeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolVolumeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVolumeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SwapFees) > 0 {
+		for iNdEx := len(m.SwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolVolumeInWindowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolVolumeInWindowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVolumeInWindowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolVolumeInWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolVolumeInWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVolumeInWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SwapFees) > 0 {
+		for iNdEx := len(m.SwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PausedPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PausedPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PausedPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PausedPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolTypes) > 0 {
+		dAtA4 := make([]byte, len(m.PoolTypes)*10)
+		var j3 int
+		for _, num := range m.PoolTypes {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintQuery(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolIds) > 0 {
+		dAtA6 := make([]byte, len(m.PoolIds)*10)
+		var j5 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintQuery(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NumPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NumPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NumPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *NumPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NumPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NumPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumPools != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPools))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpotPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteAssetDenom) > 0 {
+		i -= len(m.QuoteAssetDenom)
+		copy(dAtA[i:], m.QuoteAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAssetDenom) > 0 {
+		i -= len(m.BaseAssetDenom)
+		copy(dAtA[i:], m.BaseAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpotPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpotPrice) > 0 {
+		i -= len(m.SpotPrice)
+		copy(dAtA[i:], m.SpotPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpotPrice)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateSwapExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EstimateSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateSwapExactAmountOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateBestRouteSwapExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxHops != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHops))
+	}
+	return n
+}
+
+func (m *EstimateBestRouteSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TakerFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom0)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom1)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TakerFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TakerFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TakerFeeTotalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TakerFeeTotalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TakerFeeTotals) > 0 {
+		for _, e := range m.TakerFeeTotals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolVolumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PoolVolumeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SwapFees) > 0 {
+		for _, e := range m.SwapFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolVolumeInWindowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PoolVolumeInWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SwapFees) > 0 {
+		for _, e := range m.SwapFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PausedPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PausedPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.PoolTypes) > 0 {
+		l = 0
+		for _, e := range m.PoolTypes {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *NumPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *NumPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumPools != 0 {
+		n += 1 + sovQuery(uint64(m.NumPools))
+	}
+	return n
+}
+
+func (m *PoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AllPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *AllPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpotPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types.SwapAmountOutRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateBestRouteSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateBestRouteSwapExactAmountInRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateBestRouteSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
+			}
+			m.MaxHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHops |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateBestRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateBestRouteSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateBestRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *AllPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *AllPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *TakerFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *SpotPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.BaseAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.QuoteAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *SpotPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpotPrice)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeTotalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1828,10 +4434,10 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeTotalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeTotalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1855,7 +4461,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeTotalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1878,15 +4484,15 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeTotalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeTotalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeTotals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1913,10 +4519,80 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TakerFeeTotals = append(m.TakerFeeTotals, types1.Coin{})
+			if err := m.TakerFeeTotals[len(m.TakerFeeTotals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolVolumeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolVolumeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolVolumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1938,7 +4614,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolVolumeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1961,17 +4637,17 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolVolumeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolVolumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1981,78 +4657,29 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Volume = append(m.Volume, types1.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2079,8 +4706,8 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, types.SwapAmountInRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SwapFees = append(m.SwapFees, types1.Coin{})
+			if err := m.SwapFees[len(m.SwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2105,7 +4732,7 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolVolumeInWindowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2128,17 +4755,36 @@ func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolVolumeInWindowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolVolumeInWindowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2148,23 +4794,22 @@ func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2189,7 +4834,7 @@ func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolVolumeInWindowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2212,17 +4857,17 @@ func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolVolumeInWindowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolVolumeInWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2232,46 +4877,29 @@ func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Volume = append(m.Volume, types1.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2298,43 +4926,61 @@ func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, types.SwapAmountOutRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SwapFees = append(m.SwapFees, types1.Coin{})
+			if err := m.SwapFees[len(m.SwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PausedPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOut = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausedPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausedPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2356,7 +5002,7 @@ func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *PausedPoolsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2379,46 +5025,157 @@ func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PausedPoolsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PausedPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 2:
+			if wireType == 0 {
+				var v types.PoolType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= types.PoolType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolTypes = append(m.PoolTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PoolTypes) == 0 {
+					m.PoolTypes = make([]types.PoolType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v types.PoolType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= types.PoolType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolTypes = append(m.PoolTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTypes", wireType)
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2687,7 +5444,7 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pool == nil {
-				m.Pool = &types1.Any{}
+				m.Pool = &types3.Any{}
 			}
 			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2841,7 +5598,7 @@ func (m *AllPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types1.Any{})
+			m.Pools = append(m.Pools, &types3.Any{})
 			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -63,13 +63,18 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg types.CreatePoolMsg) (uint64, er
 
 	k.SetPoolRoute(ctx, poolId, msg.GetPoolType())
 
-	if err := k.validateCreatedPool(ctx, poolId, pool); err != nil {
-		return 0, err
-	}
-
-	// create and save the pool's module account to the account keeper
-	if err := osmoutils.CreateModuleAccount(ctx, k.accountKeeper, pool.GetAddress()); err != nil {
-		return 0, fmt.Errorf("creating pool module account for id %d: %w", poolId, err)
+	// CosmWasm pools are addressed by their contract, which is only known once
+	// the contract is instantiated in InitializePool. Hence, there is no
+	// dedicated module account to create for them.
+	if msg.GetPoolType() != types.CosmWasm {
+		if err := k.validateCreatedPool(ctx, poolId, pool); err != nil {
+			return 0, err
+		}
+
+		// create and save the pool's module account to the account keeper
+		if err := osmoutils.CreateModuleAccount(ctx, k.accountKeeper, pool.GetAddress()); err != nil {
+			return 0, fmt.Errorf("creating pool module account for id %d: %w", poolId, err)
+		}
 	}
 
 	// Run the respective pool type's initialization logic.
@@ -85,6 +90,8 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg types.CreatePoolMsg) (uint64, er
 		return 0, err
 	}
 
+	k.poolListeners.AfterPoolCreated(ctx, sender, poolId)
+
 	emitCreatePoolEvents(ctx, poolId, msg)
 	return pool.GetId(), nil
 }
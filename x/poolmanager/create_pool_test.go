@@ -64,9 +64,7 @@ func (suite *KeeperTestSuite) TestPoolCreationFee() {
 		poolmanagerKeeper := suite.App.PoolManagerKeeper
 
 		// set pool creation fee
-		poolmanagerKeeper.SetParams(suite.Ctx, types.Params{
-			PoolCreationFee: test.poolCreationFee,
-		})
+		poolmanagerKeeper.SetParams(suite.Ctx, types.NewParams(test.poolCreationFee))
 
 		// fund sender test account
 		sender, err := sdk.AccAddressFromBech32(test.msg.Sender)
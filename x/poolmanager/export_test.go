@@ -3,6 +3,7 @@ package poolmanager
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
 
@@ -34,3 +35,13 @@ func (k *Keeper) SetPoolModulesUnsafe(poolModules []types.PoolModuleI) {
 func (k Keeper) GetAllPoolRoutes(ctx sdk.Context) []types.ModuleRoute {
 	return k.getAllPoolRoutes(ctx)
 }
+
+// GetPoolVolumeBuckets returns all volume buckets of the given pool.
+func (k Keeper) GetPoolVolumeBuckets(ctx sdk.Context, poolId uint64) []types.PoolVolume {
+	store := ctx.KVStore(k.storeKey)
+	buckets, err := osmoutils.GatherValuesFromStorePrefix(store, types.FormatPoolVolumeBucketPrefix(poolId), parsePoolVolume)
+	if err != nil {
+		panic(err)
+	}
+	return buckets
+}
@@ -0,0 +1,27 @@
+package poolmanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// NewPoolManagerProposalHandler is a handler for governance proposals on the poolmanager module.
+func NewPoolManagerProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetPoolsPausedProposal:
+			return k.HandleSetPoolsPausedProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized poolmanager proposal content type: %T", c)
+		}
+	}
+}
+
+// HandleSetPoolsPausedProposal is a handler for pausing or unpausing pools.
+func (k Keeper) HandleSetPoolsPausedProposal(ctx sdk.Context, p *types.SetPoolsPausedProposal) error {
+	return k.SetPoolsPaused(ctx, p.PoolIds, p.PoolTypes, p.Paused)
+}
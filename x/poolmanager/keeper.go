@@ -15,12 +15,13 @@ type Keeper struct {
 
 	gammKeeper           types.PoolModuleI
 	concentratedKeeper   types.PoolModuleI
+	cosmwasmpoolKeeper   types.PoolModuleI
 	poolIncentivesKeeper types.PoolIncentivesKeeperI
 	bankKeeper           types.BankI
 	accountKeeper        types.AccountI
 	communityPoolKeeper  types.CommunityPoolI
 
-	poolCreationListeners types.PoolCreationListeners
+	poolListeners types.PoolListeners
 
 	// routes is a map to get the pool module by id.
 	routes map[types.PoolType]types.PoolModuleI
@@ -34,7 +35,7 @@ type Keeper struct {
 	paramSpace paramtypes.Subspace
 }
 
-func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, gammKeeper types.PoolModuleI, concentratedKeeper types.PoolModuleI, bankKeeper types.BankI, accountKeeper types.AccountI, communityPoolKeeper types.CommunityPoolI) *Keeper {
+func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, gammKeeper types.PoolModuleI, concentratedKeeper types.PoolModuleI, cosmwasmpoolKeeper types.PoolModuleI, bankKeeper types.BankI, accountKeeper types.AccountI, communityPoolKeeper types.CommunityPoolI) *Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -44,10 +45,11 @@ func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, gammKeeper
 		types.Balancer:     gammKeeper,
 		types.Stableswap:   gammKeeper,
 		types.Concentrated: concentratedKeeper,
+		types.CosmWasm:     cosmwasmpoolKeeper,
 	}
 
 	routesList := []types.PoolModuleI{
-		gammKeeper, concentratedKeeper,
+		gammKeeper, concentratedKeeper, cosmwasmpoolKeeper,
 	}
 
 	return &Keeper{
@@ -55,6 +57,7 @@ func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, gammKeeper
 		paramSpace:          paramSpace,
 		gammKeeper:          gammKeeper,
 		concentratedKeeper:  concentratedKeeper,
+		cosmwasmpoolKeeper:  cosmwasmpoolKeeper,
 		bankKeeper:          bankKeeper,
 		accountKeeper:       accountKeeper,
 		communityPoolKeeper: communityPoolKeeper,
@@ -87,14 +90,38 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, poolRoute := range genState.PoolRoutes {
 		k.SetPoolRoute(ctx, poolRoute.PoolId, poolRoute.PoolType)
 	}
+
+	for _, takerFeeTotal := range genState.TakerFeeTotals {
+		k.setTakerFeeTotal(ctx, takerFeeTotal)
+	}
+
+	for _, poolVolume := range genState.PoolVolumes {
+		k.setPoolVolume(ctx, poolVolume)
+	}
+
+	for _, bucket := range genState.PoolVolumeBuckets {
+		k.setPoolVolumeBucket(ctx, bucket)
+	}
+
+	if len(genState.PausedPoolIds) == 0 && len(genState.PausedPoolTypes) == 0 {
+		return
+	}
+	if err := k.SetPoolsPaused(ctx, genState.PausedPoolIds, genState.PausedPoolTypes, true); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the poolmanager module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		NextPoolId: k.GetNextPoolId(ctx),
-		PoolRoutes: k.getAllPoolRoutes(ctx),
+		Params:            k.GetParams(ctx),
+		NextPoolId:        k.GetNextPoolId(ctx),
+		PoolRoutes:        k.getAllPoolRoutes(ctx),
+		TakerFeeTotals:    k.GetTakerFeeTotals(ctx),
+		PoolVolumes:       k.getAllPoolVolumes(ctx),
+		PausedPoolIds:     k.getPausedPoolIds(ctx),
+		PausedPoolTypes:   k.getPausedPoolTypes(ctx),
+		PoolVolumeBuckets: k.getAllPoolVolumeBuckets(ctx),
 	}
 }
 
@@ -106,13 +133,13 @@ func (k Keeper) GetNextPoolId(ctx sdk.Context) uint64 {
 	return nextPoolId.Value
 }
 
-// SetPoolCreationListeners sets the pool creation listeners.
-func (k *Keeper) SetPoolCreationListeners(listeners types.PoolCreationListeners) *Keeper {
-	if k.poolCreationListeners != nil {
-		panic("cannot set pool creation listeners twice")
+// SetPoolListeners sets the listeners notified of pool creation.
+func (k *Keeper) SetPoolListeners(listeners types.PoolListeners) *Keeper {
+	if k.poolListeners != nil {
+		panic("cannot set pool listeners twice")
 	}
 
-	k.poolCreationListeners = listeners
+	k.poolListeners = listeners
 
 	return k
 }
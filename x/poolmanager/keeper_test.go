@@ -2,6 +2,7 @@ package poolmanager_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
@@ -29,6 +30,32 @@ var (
 			PoolType: types.Stableswap,
 		},
 	}
+	testTakerFeeParams = types.TakerFeeParams{
+		DefaultTakerFee: sdk.MustNewDecFromStr("0.001"),
+		DenomPairTakerFees: []types.DenomPairTakerFee{
+			{
+				Denom0:   "bar",
+				Denom1:   "foo",
+				TakerFee: sdk.MustNewDecFromStr("0.002"),
+			},
+		},
+		FeeCollector: "",
+	}
+	testTakerFeeTotals = sdk.NewCoins(sdk.NewInt64Coin("bar", 100), sdk.NewInt64Coin("foo", 200))
+	testPoolVolumes    = []types.PoolVolume{
+		{
+			PoolId:   1,
+			Volume:   sdk.NewCoins(sdk.NewInt64Coin("bar", 1000), sdk.NewInt64Coin("foo", 2000)),
+			SwapFees: sdk.NewCoins(sdk.NewInt64Coin("foo", 20)),
+		},
+		{
+			PoolId:   2,
+			Volume:   sdk.NewCoins(sdk.NewInt64Coin("bar", 3000), sdk.NewInt64Coin("baz", 4000)),
+			SwapFees: sdk.NewCoins(sdk.NewInt64Coin("bar", 30)),
+		},
+	}
+	testPausedPoolIds   = []uint64{2}
+	testPausedPoolTypes = []types.PoolType{types.Concentrated}
 )
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -73,29 +100,120 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.App.PoolManagerKeeper.InitGenesis(suite.Ctx, &types.GenesisState{
 		Params: types.Params{
 			PoolCreationFee: testPoolCreationFee,
+			TakerFeeParams:  testTakerFeeParams,
 		},
-		NextPoolId: testExpectedPoolId,
-		PoolRoutes: testPoolRoute,
+		NextPoolId:      testExpectedPoolId,
+		PoolRoutes:      testPoolRoute,
+		TakerFeeTotals:  testTakerFeeTotals,
+		PoolVolumes:     testPoolVolumes,
+		PausedPoolIds:   testPausedPoolIds,
+		PausedPoolTypes: testPausedPoolTypes,
 	})
 
 	suite.Require().Equal(uint64(testExpectedPoolId), suite.App.PoolManagerKeeper.GetNextPoolId(suite.Ctx))
 	suite.Require().Equal(testPoolCreationFee, suite.App.PoolManagerKeeper.GetParams(suite.Ctx).PoolCreationFee)
 	suite.Require().Equal(testPoolRoute, suite.App.PoolManagerKeeper.GetAllPoolRoutes(suite.Ctx))
+	suite.Require().Equal(testTakerFeeParams, suite.App.PoolManagerKeeper.GetParams(suite.Ctx).TakerFeeParams)
+	suite.Require().Equal(testTakerFeeTotals, suite.App.PoolManagerKeeper.GetTakerFeeTotals(suite.Ctx))
+	for _, poolVolume := range testPoolVolumes {
+		suite.Require().Equal(poolVolume, suite.App.PoolManagerKeeper.GetPoolVolume(suite.Ctx, poolVolume.PoolId))
+	}
+	pausedPoolIds, pausedPoolTypes := suite.App.PoolManagerKeeper.GetPausedPools(suite.Ctx)
+	suite.Require().Equal(testPausedPoolIds, pausedPoolIds)
+	suite.Require().Equal(testPausedPoolTypes, pausedPoolTypes)
+	suite.Require().False(suite.App.PoolManagerKeeper.IsPoolPaused(suite.Ctx, 1))
+	suite.Require().True(suite.App.PoolManagerKeeper.IsPoolPaused(suite.Ctx, 2))
 }
 
-func (suite *KeeperTestSuite) TestExportGenesis() {
+// TestInitGenesis_NoPausedPools tests that no pause event is emitted when genesis pauses nothing.
+func (suite *KeeperTestSuite) TestInitGenesis_NoPausedPools() {
 	suite.Setup()
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
 
 	suite.App.PoolManagerKeeper.InitGenesis(suite.Ctx, &types.GenesisState{
 		Params: types.Params{
 			PoolCreationFee: testPoolCreationFee,
+			TakerFeeParams:  testTakerFeeParams,
 		},
 		NextPoolId: testExpectedPoolId,
 		PoolRoutes: testPoolRoute,
 	})
 
+	suite.AssertEventEmitted(suite.Ctx, types.TypeEvtPoolsPaused, 0)
+	pausedPoolIds, pausedPoolTypes := suite.App.PoolManagerKeeper.GetPausedPools(suite.Ctx)
+	suite.Require().Empty(pausedPoolIds)
+	suite.Require().Empty(pausedPoolTypes)
+}
+
+func (suite *KeeperTestSuite) TestExportGenesis() {
+	suite.Setup()
+
+	suite.App.PoolManagerKeeper.InitGenesis(suite.Ctx, &types.GenesisState{
+		Params: types.Params{
+			PoolCreationFee: testPoolCreationFee,
+			TakerFeeParams:  testTakerFeeParams,
+		},
+		NextPoolId:      testExpectedPoolId,
+		PoolRoutes:      testPoolRoute,
+		TakerFeeTotals:  testTakerFeeTotals,
+		PoolVolumes:     testPoolVolumes,
+		PausedPoolIds:   testPausedPoolIds,
+		PausedPoolTypes: testPausedPoolTypes,
+	})
+
 	genesis := suite.App.PoolManagerKeeper.ExportGenesis(suite.Ctx)
 	suite.Require().Equal(uint64(testExpectedPoolId), genesis.NextPoolId)
 	suite.Require().Equal(testPoolCreationFee, genesis.Params.PoolCreationFee)
 	suite.Require().Equal(testPoolRoute, genesis.PoolRoutes)
+	suite.Require().Equal(testTakerFeeParams, genesis.Params.TakerFeeParams)
+	suite.Require().Equal(testTakerFeeTotals, genesis.TakerFeeTotals)
+	suite.Require().Equal(testPoolVolumes, genesis.PoolVolumes)
+	suite.Require().Equal(testPausedPoolIds, genesis.PausedPoolIds)
+	suite.Require().Equal(testPausedPoolTypes, genesis.PausedPoolTypes)
+}
+
+// TestGenesisRoundTrip_PoolVolumeBuckets tests that the volume buckets of every pool are exported and
+// imported, so that the volume over a window is preserved across a genesis export and import.
+func (suite *KeeperTestSuite) TestGenesisRoundTrip_PoolVolumeBuckets() {
+	suite.Setup()
+	suite.createBalancerPoolsFromCoinsWithSwapFee([]sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)),
+	}, []sdk.Dec{defaultPoolSwapFee})
+
+	// Swap in two different buckets.
+	sender := suite.TestAccs[1]
+	for i := 0; i < 2; i++ {
+		suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.VolumeBucketDuration))
+		tokenIn := sdk.NewCoin(foo, sdk.NewInt(100000))
+		suite.FundAcc(sender, sdk.NewCoins(tokenIn))
+		_, err := suite.App.PoolManagerKeeper.RouteExactAmountIn(suite.Ctx, sender, []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}}, tokenIn, sdk.OneInt())
+		suite.Require().NoError(err)
+	}
+
+	blockTime := suite.Ctx.BlockTime()
+	expectedVolume, err := suite.App.PoolManagerKeeper.GetPoolVolumeInWindow(suite.Ctx, 1, types.MaxVolumeWindow)
+	suite.Require().NoError(err)
+	suite.Require().False(expectedVolume.Volume.IsZero())
+
+	genesis := suite.App.PoolManagerKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Len(genesis.PoolVolumeBuckets, 2)
+	suite.Require().NoError(genesis.Validate())
+
+	// Import the genesis into a fresh app.
+	suite.Setup()
+	suite.Ctx = suite.Ctx.WithBlockTime(blockTime)
+	suite.App.PoolManagerKeeper.InitGenesis(suite.Ctx, genesis)
+
+	volume, err := suite.App.PoolManagerKeeper.GetPoolVolumeInWindow(suite.Ctx, 1, types.MaxVolumeWindow)
+	suite.Require().NoError(err)
+	suite.requirePoolVolumeEqual(expectedVolume, volume)
+	suite.Require().Equal(genesis.PoolVolumeBuckets, suite.App.PoolManagerKeeper.ExportGenesis(suite.Ctx).PoolVolumeBuckets)
+
+	// Buckets that do not start at a multiple of the bucket duration are rejected.
+	genesis.PoolVolumeBuckets[0].StartTime = genesis.PoolVolumeBuckets[0].StartTime.Add(time.Minute)
+	suite.Require().Error(genesis.Validate())
+
+	// Duplicate buckets are rejected.
+	genesis.PoolVolumeBuckets[0] = genesis.PoolVolumeBuckets[1]
+	suite.Require().Error(genesis.Validate())
 }
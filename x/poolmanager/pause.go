@@ -0,0 +1,149 @@
+package poolmanager
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// IsPoolPaused returns true if swaps and joins are paused on the given pool,
+// either because the pool itself or because its pool type is paused.
+// Returns false if the pool does not exist.
+func (k Keeper) IsPoolPaused(ctx sdk.Context, poolId uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.FormatPausedPoolKey(poolId)) {
+		return true
+	}
+
+	moduleRoute := &types.ModuleRoute{}
+	found, err := osmoutils.Get(store, types.FormatModuleRouteKey(poolId), moduleRoute)
+	if err != nil || !found {
+		return false
+	}
+
+	return store.Has(types.FormatPausedPoolTypeKey(moduleRoute.PoolType))
+}
+
+// GetPausedPools returns the ids of the paused pools and the paused pool types.
+// A pool whose pool type is paused is not listed in the paused pool ids unless
+// it was paused individually.
+func (k Keeper) GetPausedPools(ctx sdk.Context) (poolIds []uint64, poolTypes []types.PoolType) {
+	return k.getPausedPoolIds(ctx), k.getPausedPoolTypes(ctx)
+}
+
+// SetPoolsPaused pauses or unpauses swaps and joins on the given pools and on
+// every pool of the given pool types. Returns error if any of the pools does not exist.
+func (k Keeper) SetPoolsPaused(ctx sdk.Context, poolIds []uint64, poolTypes []types.PoolType, paused bool) error {
+	if err := types.ValidatePausedPools(poolIds, poolTypes); err != nil {
+		return err
+	}
+
+	for _, poolId := range poolIds {
+		if _, err := k.GetPoolModule(ctx, poolId); err != nil {
+			return err
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, poolId := range poolIds {
+		if paused {
+			store.Set(types.FormatPausedPoolKey(poolId), sdk.Uint64ToBigEndian(poolId))
+		} else {
+			store.Delete(types.FormatPausedPoolKey(poolId))
+		}
+	}
+	for _, poolType := range poolTypes {
+		if paused {
+			store.Set(types.FormatPausedPoolTypeKey(poolType), sdk.Uint64ToBigEndian(uint64(poolType)))
+		} else {
+			store.Delete(types.FormatPausedPoolTypeKey(poolType))
+		}
+	}
+
+	eventType := types.TypeEvtPoolsPaused
+	if !paused {
+		eventType = types.TypeEvtPoolsUnpaused
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolIds, fmt.Sprintf("%v", poolIds)),
+		sdk.NewAttribute(types.AttributeKeyPoolTypes, fmt.Sprintf("%v", poolTypes)),
+	))
+
+	return nil
+}
+
+// EmergencyPausePools pauses swaps and joins on the given pools and pool types
+// on behalf of the emergency pause admin.
+// Returns error if:
+// - the emergency pause admin param is not set.
+// - the sender is not the emergency pause admin.
+// - any of the pools does not exist.
+func (k Keeper) EmergencyPausePools(ctx sdk.Context, sender sdk.AccAddress, poolIds []uint64, poolTypes []types.PoolType) error {
+	admin := k.GetEmergencyPauseAdmin(ctx)
+	if admin == "" {
+		return types.ErrEmergencyPauseDisabled
+	}
+	if sender.String() != admin {
+		return types.UnauthorizedEmergencyPauseAdminError{Sender: sender.String(), Admin: admin}
+	}
+
+	return k.SetPoolsPaused(ctx, poolIds, poolTypes, true)
+}
+
+// GetEmergencyPauseAdmin returns the address allowed to pause pools without a
+// governance proposal, or an empty string if the emergency pause path is disabled.
+func (k Keeper) GetEmergencyPauseAdmin(ctx sdk.Context) (admin string) {
+	k.paramSpace.Get(ctx, types.KeyEmergencyPauseAdmin, &admin)
+	return admin
+}
+
+// SetEmergencyPauseAdmin sets the emergency pause admin.
+func (k Keeper) SetEmergencyPauseAdmin(ctx sdk.Context, admin string) {
+	k.paramSpace.Set(ctx, types.KeyEmergencyPauseAdmin, admin)
+}
+
+// validatePoolCanSwap returns error if the given pool is inactive or paused.
+func (k Keeper) validatePoolCanSwap(ctx sdk.Context, pool types.PoolI) error {
+	if !pool.IsActive(ctx) {
+		return fmt.Errorf("pool %d is not active", pool.GetId())
+	}
+	if k.IsPoolPaused(ctx, pool.GetId()) {
+		return types.PoolPausedError{PoolId: pool.GetId()}
+	}
+	return nil
+}
+
+// getPausedPoolIds returns the ids of the individually paused pools.
+func (k Keeper) getPausedPoolIds(ctx sdk.Context) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	poolIds, err := osmoutils.GatherValuesFromStorePrefix(store, types.PausedPoolsPrefix, parseUint64)
+	if err != nil {
+		panic(err)
+	}
+	return poolIds
+}
+
+// getPausedPoolTypes returns the paused pool types.
+func (k Keeper) getPausedPoolTypes(ctx sdk.Context) []types.PoolType {
+	store := ctx.KVStore(k.storeKey)
+	poolTypes, err := osmoutils.GatherValuesFromStorePrefix(store, types.PausedPoolTypesPrefix, func(bz []byte) (types.PoolType, error) {
+		poolType, err := parseUint64(bz)
+		return types.PoolType(poolType), err
+	})
+	if err != nil {
+		panic(err)
+	}
+	return poolTypes
+}
+
+func parseUint64(bz []byte) (uint64, error) {
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid uint64 length (%d)", len(bz))
+	}
+	return sdk.BigEndianToUint64(bz), nil
+}
@@ -0,0 +1,209 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// setupPausePools creates two balancer pools with ids 1 and 2 and a stableswap pool with id 3.
+func (suite *KeeperTestSuite) setupPausePools() {
+	suite.createBalancerPoolsFromCoinsWithSwapFee([]sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)),
+		sdk.NewCoins(sdk.NewCoin(bar, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)),
+	}, []sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec()})
+	suite.PrepareBasicStableswapPool()
+}
+
+func (suite *KeeperTestSuite) TestSetPoolsPaused() {
+	tests := map[string]struct {
+		poolIds             []uint64
+		poolTypes           []types.PoolType
+		expectedPausedPools []uint64
+		expectError         bool
+	}{
+		"pause pool id": {
+			poolIds:             []uint64{1},
+			expectedPausedPools: []uint64{1},
+		},
+		"pause stableswap pool type": {
+			poolTypes:           []types.PoolType{types.Stableswap},
+			expectedPausedPools: []uint64{3},
+		},
+		"pause pool id and balancer pool type": {
+			poolIds:             []uint64{3},
+			poolTypes:           []types.PoolType{types.Balancer},
+			expectedPausedPools: []uint64{1, 2, 3},
+		},
+		"error: pool does not exist": {
+			poolIds:     []uint64{4},
+			expectError: true,
+		},
+		"error: invalid pool type": {
+			poolTypes:   []types.PoolType{types.PoolType(100)},
+			expectError: true,
+		},
+		"error: duplicate pool id": {
+			poolIds:     []uint64{1, 1},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.setupPausePools()
+			poolmanagerKeeper := suite.App.PoolManagerKeeper
+
+			err := poolmanagerKeeper.SetPoolsPaused(suite.Ctx, tc.poolIds, tc.poolTypes, true)
+			if tc.expectError {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			pausedPoolIds, pausedPoolTypes := poolmanagerKeeper.GetPausedPools(suite.Ctx)
+			suite.Require().ElementsMatch(tc.poolIds, pausedPoolIds)
+			suite.Require().ElementsMatch(tc.poolTypes, pausedPoolTypes)
+
+			for poolId := uint64(1); poolId <= 3; poolId++ {
+				expectedPaused := false
+				for _, pausedPoolId := range tc.expectedPausedPools {
+					if pausedPoolId == poolId {
+						expectedPaused = true
+					}
+				}
+				suite.Require().Equal(expectedPaused, poolmanagerKeeper.IsPoolPaused(suite.Ctx, poolId), "pool %d", poolId)
+			}
+		})
+	}
+}
+
+// TestUnpausePools tests that a pool stays paused until neither the pool nor its pool type is paused.
+func (suite *KeeperTestSuite) TestUnpausePools() {
+	suite.SetupTest()
+	suite.setupPausePools()
+	poolmanagerKeeper := suite.App.PoolManagerKeeper
+
+	err := poolmanagerKeeper.SetPoolsPaused(suite.Ctx, []uint64{1}, []types.PoolType{types.Balancer}, true)
+	suite.Require().NoError(err)
+
+	err = poolmanagerKeeper.SetPoolsPaused(suite.Ctx, []uint64{1}, nil, false)
+	suite.Require().NoError(err)
+	suite.Require().True(poolmanagerKeeper.IsPoolPaused(suite.Ctx, 1))
+
+	err = poolmanagerKeeper.SetPoolsPaused(suite.Ctx, nil, []types.PoolType{types.Balancer}, false)
+	suite.Require().NoError(err)
+	suite.Require().False(poolmanagerKeeper.IsPoolPaused(suite.Ctx, 1))
+
+	pausedPoolIds, pausedPoolTypes := poolmanagerKeeper.GetPausedPools(suite.Ctx)
+	suite.Require().Empty(pausedPoolIds)
+	suite.Require().Empty(pausedPoolTypes)
+}
+
+func (suite *KeeperTestSuite) TestEmergencyPausePools() {
+	tests := map[string]struct {
+		adminIsSet         bool
+		senderIsAdmin      bool
+		expectDisabled     bool
+		expectUnauthorized bool
+	}{
+		"admin pauses pool": {
+			adminIsSet:    true,
+			senderIsAdmin: true,
+		},
+		"error: emergency pause disabled": {
+			expectDisabled: true,
+		},
+		"error: sender is not the admin": {
+			adminIsSet:         true,
+			expectUnauthorized: true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.setupPausePools()
+			poolmanagerKeeper := suite.App.PoolManagerKeeper
+
+			admin := ""
+			if tc.adminIsSet {
+				admin = suite.TestAccs[0].String()
+			}
+			poolmanagerKeeper.SetEmergencyPauseAdmin(suite.Ctx, admin)
+
+			sender := suite.TestAccs[1]
+			if tc.senderIsAdmin {
+				sender = suite.TestAccs[0]
+			}
+
+			err := poolmanagerKeeper.EmergencyPausePools(suite.Ctx, sender, []uint64{1}, nil)
+			switch {
+			case tc.expectDisabled:
+				suite.Require().ErrorIs(err, types.ErrEmergencyPauseDisabled)
+			case tc.expectUnauthorized:
+				suite.Require().ErrorIs(err, types.UnauthorizedEmergencyPauseAdminError{Sender: sender.String(), Admin: admin})
+			default:
+				suite.Require().NoError(err)
+			}
+			suite.Require().Equal(err == nil, poolmanagerKeeper.IsPoolPaused(suite.Ctx, 1))
+		})
+	}
+}
+
+// TestPausedPoolSwapsAndJoins tests that swaps, joins and single asset exits on a paused pool fail while exits succeed,
+// and that swaps succeed again once the pool is unpaused through governance.
+func (suite *KeeperTestSuite) TestPausedPoolSwapsAndJoins() {
+	suite.SetupTest()
+	suite.setupPausePools()
+	poolmanagerKeeper := suite.App.PoolManagerKeeper
+	pausedErr := types.PoolPausedError{PoolId: 1}
+
+	err := poolmanager.NewPoolManagerProposalHandler(*poolmanagerKeeper)(suite.Ctx, types.NewSetPoolsPausedProposal("title", "description", []uint64{1}, nil, true))
+	suite.Require().NoError(err)
+
+	sender := suite.TestAccs[1]
+	suite.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(foo, sdk.NewInt(1000000)), sdk.NewCoin(bar, sdk.NewInt(1000000))))
+	tokenIn := sdk.NewCoin(foo, sdk.NewInt(1000))
+
+	// Swaps through the paused pool fail, including multihop swaps.
+	_, err = poolmanagerKeeper.RouteExactAmountIn(suite.Ctx, sender, []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}}, tokenIn, sdk.OneInt())
+	suite.Require().ErrorIs(err, pausedErr)
+	_, err = poolmanagerKeeper.RouteExactAmountIn(suite.Ctx, sender, []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}, {PoolId: 2, TokenOutDenom: baz}}, tokenIn, sdk.OneInt())
+	suite.Require().ErrorIs(err, pausedErr)
+	_, err = poolmanagerKeeper.RouteExactAmountOut(suite.Ctx, sender, []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: foo}}, sdk.NewInt(1000000), sdk.NewCoin(bar, sdk.NewInt(1000)))
+	suite.Require().ErrorIs(err, pausedErr)
+
+	// Swaps through other pools still succeed.
+	_, err = poolmanagerKeeper.RouteExactAmountIn(suite.Ctx, sender, []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: baz}}, sdk.NewCoin(bar, sdk.NewInt(1000)), sdk.OneInt())
+	suite.Require().NoError(err)
+
+	// Joins fail, exits succeed.
+	_, _, err = suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, sender, 1, gammtypes.OneShare, sdk.Coins{})
+	suite.Require().ErrorIs(err, pausedErr)
+	_, err = suite.App.GAMMKeeper.JoinSwapExactAmountIn(suite.Ctx, sender, 1, sdk.NewCoins(tokenIn), sdk.OneInt())
+	suite.Require().ErrorIs(err, pausedErr)
+	_, err = suite.App.GAMMKeeper.ExitPool(suite.Ctx, suite.TestAccs[0], 1, gammtypes.OneShare, sdk.Coins{})
+	suite.Require().NoError(err)
+
+	// Single asset exits swap against the pool, so they fail as well.
+	_, err = suite.App.GAMMKeeper.ExitSwapShareAmountIn(suite.Ctx, suite.TestAccs[0], 1, foo, gammtypes.OneShare, sdk.ZeroInt())
+	suite.Require().ErrorIs(err, pausedErr)
+	_, err = suite.App.GAMMKeeper.ExitSwapExactAmountOut(suite.Ctx, suite.TestAccs[0], 1, sdk.NewCoin(foo, sdk.NewInt(1000)), gammtypes.OneShare)
+	suite.Require().ErrorIs(err, pausedErr)
+
+	// Including the stableswap single asset exits, which are priced by the pool itself.
+	err = poolmanager.NewPoolManagerProposalHandler(*poolmanagerKeeper)(suite.Ctx, types.NewSetPoolsPausedProposal("title", "description", []uint64{3}, nil, true))
+	suite.Require().NoError(err)
+	_, err = suite.App.GAMMKeeper.ExitSwapShareAmountIn(suite.Ctx, suite.TestAccs[0], 3, foo, gammtypes.OneShare, sdk.ZeroInt())
+	suite.Require().ErrorIs(err, types.PoolPausedError{PoolId: 3})
+
+	// Unpausing allows swaps again.
+	err = poolmanager.NewPoolManagerProposalHandler(*poolmanagerKeeper)(suite.Ctx, types.NewSetPoolsPausedProposal("title", "description", []uint64{1}, nil, false))
+	suite.Require().NoError(err)
+	_, err = poolmanagerKeeper.RouteExactAmountIn(suite.Ctx, sender, []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}}, tokenIn, sdk.OneInt())
+	suite.Require().NoError(err)
+}
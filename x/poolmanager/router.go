@@ -69,9 +69,9 @@ func (k Keeper) RouteExactAmountIn(
 			return sdk.Int{}, poolErr
 		}
 
-		// check if pool is active, if not error
-		if !pool.IsActive(ctx) {
-			return sdk.Int{}, fmt.Errorf("pool %d is not active", pool.GetId())
+		// check if pool is active and not paused, if not error
+		if err := k.validatePoolCanSwap(ctx, pool); err != nil {
+			return sdk.Int{}, err
 		}
 
 		swapFee := pool.GetSwapFee(ctx)
@@ -82,12 +82,20 @@ func (k Keeper) RouteExactAmountIn(
 			swapFee = routeSwapFee.Mul((swapFee.Quo(sumOfSwapFees)))
 		}
 
+		// Charge the taker fee on the input of the current hop and swap the remainder.
+		tokenIn, err = k.chargeTakerFee(ctx, sender, tokenIn, route.TokenOutDenom, true)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
 		tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenIn, route.TokenOutDenom, _outMinAmount, swapFee)
 		if err != nil {
 			ctx.Logger().Error(err.Error())
 			return sdk.Int{}, err
 		}
 
+		k.trackVolume(ctx, pool.GetId(), tokenIn, sdk.NewCoin(route.TokenOutDenom, tokenOutAmount), swapFee)
+
 		// Chain output of current pool as the input for the next routed pool
 		tokenIn = sdk.NewCoin(route.TokenOutDenom, tokenOutAmount)
 	}
@@ -96,6 +104,7 @@ func (k Keeper) RouteExactAmountIn(
 
 // SwapExactAmountIn is an API for swapping an exact amount of tokens
 // as input to a pool to get a minimum amount of the desired token out.
+// The taker fee is deducted from tokenIn before swapping, as in RouteExactAmountIn.
 // The method succeeds when tokenOutAmount is greater than tokenOutMinAmount defined.
 // Errors otherwise. Also, errors if the pool id is invalid, if tokens do not belong to the pool with given
 // id or if sender does not have the swapped-in tokenIn.
@@ -117,18 +126,25 @@ func (k Keeper) SwapExactAmountIn(
 		return sdk.Int{}, poolErr
 	}
 
-	// check if pool is active, if not error
-	if !pool.IsActive(ctx) {
-		return sdk.Int{}, fmt.Errorf("pool %d is not active", pool.GetId())
+	// check if pool is active and not paused, if not error
+	if err := k.validatePoolCanSwap(ctx, pool); err != nil {
+		return sdk.Int{}, err
 	}
 
 	swapFee := pool.GetSwapFee(ctx)
 
+	tokenIn, err = k.chargeTakerFee(ctx, sender, tokenIn, tokenOutDenom, true)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
 	tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, swapFee)
 	if err != nil {
 		return sdk.Int{}, err
 	}
 
+	k.trackVolume(ctx, pool.GetId(), tokenIn, sdk.NewCoin(tokenOutDenom, tokenOutAmount), swapFee)
+
 	return tokenOutAmount, nil
 }
 
@@ -164,6 +180,7 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 		}
 	}
 
+	takerFeeParams := k.GetTakerFeeParams(ctx)
 	for _, route := range routes {
 		swapModule, err := k.GetPoolModule(ctx, route.PoolId)
 		if err != nil {
@@ -184,6 +201,8 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 			swapFee = routeSwapFee.Mul((swapFee.Quo(sumOfSwapFees)))
 		}
 
+		tokenIn, _ = calcTakerFeeExactIn(tokenIn, k.getTakerFee(takerFeeParams, tokenIn.Denom, route.TokenOutDenom))
+
 		tokenOut, err := swapModule.CalcOutAmtGivenIn(ctx, poolI, tokenIn, route.TokenOutDenom, swapFee)
 		if err != nil {
 			return sdk.Int{}, err
@@ -280,9 +299,9 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 			return sdk.Int{}, poolErr
 		}
 
-		// check if pool is active, if not error
-		if !pool.IsActive(ctx) {
-			return sdk.Int{}, fmt.Errorf("pool %d is not active", pool.GetId())
+		// check if pool is active and not paused, if not error
+		if err := k.validatePoolCanSwap(ctx, pool); err != nil {
+			return sdk.Int{}, err
 		}
 
 		swapFee := pool.GetSwapFee(ctx)
@@ -295,6 +314,20 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 			return sdk.Int{}, swapErr
 		}
 
+		k.trackVolume(ctx, pool.GetId(), sdk.NewCoin(route.TokenInDenom, _tokenInAmount), _tokenOut, swapFee)
+
+		// Charge the taker fee on top of the input of the current hop. The expected inputs
+		// include the taker fee, so the previous hop has produced enough tokens to cover it.
+		tokenInWithFee, err := k.chargeTakerFee(ctx, sender, sdk.NewCoin(route.TokenInDenom, _tokenInAmount), _tokenOut.Denom, false)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		_tokenInAmount = tokenInWithFee.Amount
+
+		if i == 0 && _tokenInAmount.GT(tokenInMaxAmount) {
+			return sdk.Int{}, types.TokenInWithTakerFeeGreaterThanMaxError{TokenInAmount: _tokenInAmount, TokenInMaxAmount: tokenInMaxAmount}
+		}
+
 		// Sets the final amount of tokens that need to be input into the first pool. Even though this is the final return value for the
 		// whole method and will not change after the first iteration, we still iterate through the rest of the pools to execute their respective
 		// swaps.
@@ -306,6 +339,74 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	return tokenInAmount, nil
 }
 
+// SplitRouteExactAmountIn routes a swap across multiple multihop routes. Each route swaps its own
+// TokenInAmount of tokenInDenom and the outputs of all routes are summed together.
+// The method succeeds only when the total amount out is greater than or equal to tokenOutMinAmount.
+// Since an error from any route aborts the transaction, either all of the routes are executed or none.
+func (k Keeper) SplitRouteExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+	tokenOutMinAmount sdk.Int,
+) (sdk.Int, error) {
+	if err := types.ValidateSwapAmountInSplitRoute(routes); err != nil {
+		return sdk.Int{}, err
+	}
+
+	totalTokenOutAmount := sdk.ZeroInt()
+	for _, route := range routes {
+		// The minimum amount out is only enforced on the aggregate, so each route
+		// is executed with the lowest possible bound.
+		tokenOutAmount, err := k.RouteExactAmountIn(ctx, sender, route.Pools, sdk.NewCoin(tokenInDenom, route.TokenInAmount), sdk.OneInt())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		totalTokenOutAmount = totalTokenOutAmount.Add(tokenOutAmount)
+	}
+
+	if totalTokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, types.SplitRouteTokenOutLessThanMinError{TokenOutAmount: totalTokenOutAmount, TokenOutMinAmount: tokenOutMinAmount}
+	}
+
+	return totalTokenOutAmount, nil
+}
+
+// SplitRouteExactAmountOut routes a swap across multiple multihop routes. Each route swaps for its own
+// TokenOutAmount of tokenOutDenom and the inputs required by all routes are summed together.
+// The method succeeds only when the total amount in is less than or equal to tokenInMaxAmount.
+// Since an error from any route aborts the transaction, either all of the routes are executed or none.
+func (k Keeper) SplitRouteExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountOutSplitRoute,
+	tokenOutDenom string,
+	tokenInMaxAmount sdk.Int,
+) (sdk.Int, error) {
+	if err := types.ValidateSwapAmountOutSplitRoute(routes); err != nil {
+		return sdk.Int{}, err
+	}
+
+	totalTokenInAmount := sdk.ZeroInt()
+	for _, route := range routes {
+		// The maximum amount in is only enforced on the aggregate, so each route
+		// is bounded by the total maximum.
+		tokenInAmount, err := k.RouteExactAmountOut(ctx, sender, route.Pools, tokenInMaxAmount, sdk.NewCoin(tokenOutDenom, route.TokenOutAmount))
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		totalTokenInAmount = totalTokenInAmount.Add(tokenInAmount)
+	}
+
+	if totalTokenInAmount.GT(tokenInMaxAmount) {
+		return sdk.Int{}, types.SplitRouteTokenInGreaterThanMaxError{TokenInAmount: totalTokenInAmount, TokenInMaxAmount: tokenInMaxAmount}
+	}
+
+	return totalTokenInAmount, nil
+}
+
 func (k Keeper) RouteGetPoolDenoms(
 	ctx sdk.Context,
 	poolId uint64,
@@ -389,6 +490,134 @@ func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 	return insExpected[0], nil
 }
 
+// EstimateBestRouteExactAmountIn searches all routes of at most maxHops pools that swap tokenIn
+// into tokenOutDenom and returns the one with the highest estimated amount out, together with that amount.
+// Only active pools are considered and a route never trades through the same pool or denom twice.
+// If maxHops is zero, types.DefaultBestRouteMaxHops is used. At most types.MaxBestRouteCandidates routes
+// are estimated, in the order they are found by a depth-first search over pools sorted by id.
+func (k Keeper) EstimateBestRouteExactAmountIn(
+	ctx sdk.Context,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops uint64,
+) (bestRoute []types.SwapAmountInRoute, bestTokenOutAmount sdk.Int, err error) {
+	if maxHops == 0 {
+		maxHops = types.DefaultBestRouteMaxHops
+	}
+	if maxHops > types.MaxBestRouteMaxHops {
+		return nil, sdk.Int{}, types.MaxHopsExceededError{MaxHops: maxHops, Limit: types.MaxBestRouteMaxHops}
+	}
+
+	if !tokenIn.IsValid() || !tokenIn.IsPositive() {
+		return nil, sdk.Int{}, fmt.Errorf("token in (%s) must be valid and positive", tokenIn)
+	}
+	if err := sdk.ValidateDenom(tokenOutDenom); err != nil {
+		return nil, sdk.Int{}, err
+	}
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, sdk.Int{}, fmt.Errorf("token in denom (%s) must differ from token out denom", tokenIn.Denom)
+	}
+
+	pools, err := k.AllPools(ctx)
+	if err != nil {
+		return nil, sdk.Int{}, err
+	}
+
+	// Index the active, unpaused pools by the denoms they contain. Since pools are sorted by id,
+	// every slice in the index is sorted by id as well, which keeps the search deterministic.
+	poolsByDenom := make(map[string][]poolDenoms)
+	for _, pool := range pools {
+		if k.validatePoolCanSwap(ctx, pool) != nil {
+			continue
+		}
+
+		denoms, err := k.RouteGetPoolDenoms(ctx, pool.GetId())
+		if err != nil {
+			return nil, sdk.Int{}, err
+		}
+
+		for _, denom := range denoms {
+			poolsByDenom[denom] = append(poolsByDenom[denom], poolDenoms{poolId: pool.GetId(), denoms: denoms})
+		}
+	}
+
+	candidateRoutes := findCandidateRoutes(poolsByDenom, tokenIn.Denom, tokenOutDenom, maxHops)
+
+	bestTokenOutAmount = sdk.ZeroInt()
+	for _, route := range candidateRoutes {
+		// Estimate in a cached context so that no candidate can affect the estimate of another.
+		cacheCtx, _ := ctx.CacheContext()
+		tokenOutAmount, err := k.MultihopEstimateOutGivenExactAmountIn(cacheCtx, route, tokenIn)
+		if err != nil {
+			// The route cannot absorb the swap, e.g. due to insufficient liquidity.
+			continue
+		}
+
+		if tokenOutAmount.GT(bestTokenOutAmount) {
+			bestRoute, bestTokenOutAmount = route, tokenOutAmount
+		}
+	}
+
+	if bestRoute == nil {
+		return nil, sdk.Int{}, types.NoRouteFoundError{TokenInDenom: tokenIn.Denom, TokenOutDenom: tokenOutDenom, MaxHops: maxHops}
+	}
+
+	return bestRoute, bestTokenOutAmount, nil
+}
+
+// poolDenoms is a pool id along with the denoms of the pool.
+type poolDenoms struct {
+	poolId uint64
+	denoms []string
+}
+
+// findCandidateRoutes returns all routes of at most maxHops pools from tokenInDenom to tokenOutDenom
+// that do not reuse a pool or a denom, capped at types.MaxBestRouteCandidates routes.
+func findCandidateRoutes(poolsByDenom map[string][]poolDenoms, tokenInDenom, tokenOutDenom string, maxHops uint64) [][]types.SwapAmountInRoute {
+	candidateRoutes := [][]types.SwapAmountInRoute{}
+	visitedPools := map[uint64]bool{}
+	visitedDenoms := map[string]bool{tokenInDenom: true}
+
+	var search func(currentDenom string, route []types.SwapAmountInRoute)
+	search = func(currentDenom string, route []types.SwapAmountInRoute) {
+		for _, pool := range poolsByDenom[currentDenom] {
+			if visitedPools[pool.poolId] {
+				continue
+			}
+
+			for _, nextDenom := range pool.denoms {
+				if len(candidateRoutes) >= types.MaxBestRouteCandidates {
+					return
+				}
+				if visitedDenoms[nextDenom] {
+					continue
+				}
+
+				// Copy the route so that sibling branches do not share the backing array.
+				nextRoute := make([]types.SwapAmountInRoute, len(route), len(route)+1)
+				copy(nextRoute, route)
+				nextRoute = append(nextRoute, types.SwapAmountInRoute{PoolId: pool.poolId, TokenOutDenom: nextDenom})
+
+				if nextDenom == tokenOutDenom {
+					candidateRoutes = append(candidateRoutes, nextRoute)
+					continue
+				}
+
+				if uint64(len(nextRoute)) < maxHops {
+					visitedPools[pool.poolId] = true
+					visitedDenoms[nextDenom] = true
+					search(nextDenom, nextRoute)
+					visitedPools[pool.poolId] = false
+					visitedDenoms[nextDenom] = false
+				}
+			}
+		}
+	}
+	search(tokenInDenom, []types.SwapAmountInRoute{})
+
+	return candidateRoutes
+}
+
 func (k Keeper) RoutePool(
 	ctx sdk.Context,
 	poolId uint64,
@@ -477,13 +706,15 @@ func (k Keeper) getOsmoRoutedMultihopTotalSwapFee(ctx sdk.Context, route types.M
 // the route of pools the caller is intending to hop through in a fixed-output multihop tx. It estimates the input
 // amount for this last pool and then chains that input as the output of the previous pool in the route, repeating
 // until the first pool is reached. It returns an array of inputs, each of which correspond to a pool ID in the
-// route of pools for the original multihop transaction.
+// route of pools for the original multihop transaction. Each expected input includes the taker fee
+// charged on the corresponding pool.
 // TODO: test this.
 func (k Keeper) createMultihopExpectedSwapOuts(
 	ctx sdk.Context,
 	routes []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
 ) ([]sdk.Int, error) {
+	takerFeeParams := k.GetTakerFeeParams(ctx)
 	insExpected := make([]sdk.Int, len(routes))
 	for i := len(routes) - 1; i >= 0; i-- {
 		route := routes[i]
@@ -503,6 +734,8 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 			return nil, err
 		}
 
+		tokenIn, _ = calcTakerFeeExactOut(tokenIn, k.getTakerFee(takerFeeParams, tokenIn.Denom, tokenOut.Denom))
+
 		insExpected[i] = tokenIn.Amount
 		tokenOut = tokenIn
 	}
@@ -517,6 +750,7 @@ func (k Keeper) createOsmoMultihopExpectedSwapOuts(
 	tokenOut sdk.Coin,
 	cumulativeRouteSwapFee, sumOfSwapFees sdk.Dec,
 ) ([]sdk.Int, error) {
+	takerFeeParams := k.GetTakerFeeParams(ctx)
 	insExpected := make([]sdk.Int, len(routes))
 	for i := len(routes) - 1; i >= 0; i-- {
 		route := routes[i]
@@ -537,6 +771,8 @@ func (k Keeper) createOsmoMultihopExpectedSwapOuts(
 			return nil, err
 		}
 
+		tokenIn, _ = calcTakerFeeExactOut(tokenIn, k.getTakerFee(takerFeeParams, tokenIn.Denom, tokenOut.Denom))
+
 		insExpected[i] = tokenIn.Amount
 		tokenOut = tokenIn
 	}
@@ -518,6 +518,70 @@ func (suite *KeeperTestSuite) TestMultihopSwapExactAmountIn() {
 	}
 }
 
+// TestMultihopSwapExactAmountIn_DiscountedConcentratedHop tests that a concentrated liquidity
+// pool in an osmo multihop route is charged the discounted swap fee computed by the router.
+func (suite *KeeperTestSuite) TestMultihopSwapExactAmountIn_DiscountedConcentratedHop() {
+	suite.SetupTest()
+	poolmanagerKeeper := suite.App.PoolManagerKeeper
+
+	// Pool 1: balancer [foo, uosmo], 1 percent fee.
+	suite.createBalancerPoolsFromCoinsWithSwapFee(
+		[]sdk.Coins{sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(uosmo, defaultInitPoolAmount))},
+		[]sdk.Dec{defaultPoolSwapFee},
+	)
+
+	// Pool 2: concentrated [bar, uosmo], 1 percent fee, with a full range position.
+	clPool := suite.PrepareCustomConcentratedPool(suite.TestAccs[0], bar, uosmo, 1, DefaultExponentAtPriceOne, defaultPoolSwapFee)
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewCoin(bar, defaultInitPoolAmount), sdk.NewCoin(uosmo, defaultInitPoolAmount)))
+	minTick, maxTick := cl.GetMinAndMaxTicksFromExponentAtPriceOne(DefaultExponentAtPriceOne)
+	_, _, _, _, _, err := suite.App.ConcentratedLiquidityKeeper.CreatePosition(suite.Ctx, clPool.GetId(), suite.TestAccs[0], defaultInitPoolAmount, defaultInitPoolAmount, sdk.ZeroInt(), sdk.ZeroInt(), minTick, maxTick, 0)
+	suite.Require().NoError(err)
+
+	// Concentrated pools do not get gauges on creation, so create them here and incentivize both pools.
+	suite.Require().NoError(suite.App.PoolIncentivesKeeper.CreatePoolGauges(suite.Ctx, clPool.GetId()))
+	incentivizedGauges := []uint64{}
+	for _, poolId := range []uint64{1, clPool.GetId()} {
+		for _, duration := range suite.App.PoolIncentivesKeeper.GetLockableDurations(suite.Ctx) {
+			gaugeId, err := suite.App.PoolIncentivesKeeper.GetPoolGaugeId(suite.Ctx, poolId, duration)
+			suite.Require().NoError(err)
+			incentivizedGauges = append(incentivizedGauges, gaugeId)
+		}
+	}
+	suite.makeGaugesIncentivized(incentivizedGauges)
+
+	routes := []poolmanagertypes.SwapAmountInRoute{
+		{PoolId: 1, TokenOutDenom: uosmo},
+		{PoolId: clPool.GetId(), TokenOutDenom: bar},
+	}
+	tokenIn := sdk.NewCoin(foo, sdk.NewInt(100000))
+
+	routeSwapFee, sumOfSwapFees, err := poolmanagerKeeper.GetOsmoRoutedMultihopTotalSwapFee(suite.Ctx, types.SwapAmountInRoutes(routes))
+	suite.Require().NoError(err)
+	discountedSwapFee := routeSwapFee.Mul(defaultPoolSwapFee.Quo(sumOfSwapFees))
+	suite.Require().True(discountedSwapFee.LT(defaultPoolSwapFee))
+
+	// Calculate the expected output by swapping through each pool separately.
+	calcCLHopOut := func(swapFee sdk.Dec) sdk.Int {
+		cacheCtx, _ := suite.Ctx.CacheContext()
+		balancerPool, err := suite.App.GAMMKeeper.GetPoolAndPoke(cacheCtx, 1)
+		suite.Require().NoError(err)
+		osmoOut, err := suite.App.GAMMKeeper.SwapExactAmountIn(cacheCtx, suite.TestAccs[0], balancerPool, tokenIn, uosmo, sdk.OneInt(), discountedSwapFee)
+		suite.Require().NoError(err)
+		concentratedPool, err := suite.App.ConcentratedLiquidityKeeper.GetPool(cacheCtx, clPool.GetId())
+		suite.Require().NoError(err)
+		barOut, err := suite.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(cacheCtx, suite.TestAccs[0], concentratedPool, sdk.NewCoin(uosmo, osmoOut), bar, sdk.OneInt(), swapFee)
+		suite.Require().NoError(err)
+		return barOut
+	}
+	expectedTokenOutAmount := calcCLHopOut(discountedSwapFee)
+	fullFeeTokenOutAmount := calcCLHopOut(defaultPoolSwapFee)
+
+	tokenOutAmount, err := poolmanagerKeeper.RouteExactAmountIn(suite.Ctx, suite.TestAccs[0], routes, tokenIn, sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTokenOutAmount.String(), tokenOutAmount.String())
+	suite.Require().True(tokenOutAmount.GT(fullFeeTokenOutAmount))
+}
+
 // TestMultihopSwapExactAmountOut tests that the swaps are routed correctly.
 // That is:
 // - to the correct module (concentrated-liquidity or gamm)
@@ -1299,6 +1363,281 @@ func (suite *KeeperTestSuite) TestSingleSwapExactAmountIn() {
 	}
 }
 
+// TestSplitRouteExactAmountIn tests that split routes are executed independently,
+// that their outputs are summed and that the aggregate minimum amount out is enforced.
+func (suite *KeeperTestSuite) TestSplitRouteExactAmountIn() {
+	defaultPoolCoins := []sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)), // pool 1.
+		sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)), // pool 2.
+		sdk.NewCoins(sdk.NewCoin(baz, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)), // pool 3.
+	}
+	defaultPoolFees := []sdk.Dec{defaultPoolSwapFee, defaultPoolSwapFee, defaultPoolSwapFee}
+	defaultSplitRoutes := []types.SwapAmountInSplitRoute{
+		{
+			Pools:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}},
+			TokenInAmount: sdk.NewInt(100000),
+		},
+		{
+			Pools:         []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: baz}, {PoolId: 3, TokenOutDenom: bar}},
+			TokenInAmount: sdk.NewInt(50000),
+		},
+	}
+
+	tests := map[string]struct {
+		routes             []types.SwapAmountInSplitRoute
+		tokenOutMinAmount  sdk.Int
+		expectMinAmountErr bool
+		expectedErr        error
+	}{
+		"single route": {
+			routes:            defaultSplitRoutes[:1],
+			tokenOutMinAmount: sdk.OneInt(),
+		},
+		"two routes through different pools": {
+			routes:            defaultSplitRoutes,
+			tokenOutMinAmount: sdk.OneInt(),
+		},
+		"error: aggregate amount out is less than the minimum": {
+			routes:             defaultSplitRoutes,
+			tokenOutMinAmount:  sdk.NewInt(150000),
+			expectMinAmountErr: true,
+		},
+		"error: duplicate routes": {
+			routes:            []types.SwapAmountInSplitRoute{defaultSplitRoutes[0], defaultSplitRoutes[0]},
+			tokenOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrDuplicateRoutesNotAllowed,
+		},
+		"error: routes end in different denoms": {
+			routes: []types.SwapAmountInSplitRoute{
+				defaultSplitRoutes[0],
+				{
+					Pools:         []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: baz}},
+					TokenInAmount: sdk.NewInt(50000),
+				},
+			},
+			tokenOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.InvalidFinalTokenOutError{TokenOutGivenA: bar, TokenOutGivenB: baz},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolmanagerKeeper := suite.App.PoolManagerKeeper
+			sender := suite.TestAccs[1]
+
+			suite.createBalancerPoolsFromCoinsWithSwapFee(defaultPoolCoins, defaultPoolFees)
+			suite.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(foo, sdk.NewInt(1000000))))
+
+			// The valid routes do not share any pools, so each of them can be estimated independently.
+			expectedTokenOutAmount := sdk.ZeroInt()
+			if tc.expectedErr == nil {
+				for _, route := range tc.routes {
+					routeTokenOutAmount, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(suite.Ctx, route.Pools, sdk.NewCoin(foo, route.TokenInAmount))
+					suite.Require().NoError(err)
+					expectedTokenOutAmount = expectedTokenOutAmount.Add(routeTokenOutAmount)
+				}
+			}
+			if tc.expectMinAmountErr {
+				tc.expectedErr = types.SplitRouteTokenOutLessThanMinError{TokenOutAmount: expectedTokenOutAmount, TokenOutMinAmount: tc.tokenOutMinAmount}
+			}
+
+			balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+
+			tokenOutAmount, err := poolmanagerKeeper.SplitRouteExactAmountIn(suite.Ctx, sender, tc.routes, foo, tc.tokenOutMinAmount)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorContains(err, tc.expectedErr.Error())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenOutAmount.String(), tokenOutAmount.String())
+
+			balanceAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			suite.Require().Equal(tokenOutAmount, balanceAfter.AmountOf(bar).Sub(balanceBefore.AmountOf(bar)))
+
+			totalTokenInAmount := sdk.ZeroInt()
+			for _, route := range tc.routes {
+				totalTokenInAmount = totalTokenInAmount.Add(route.TokenInAmount)
+			}
+			suite.Require().Equal(totalTokenInAmount, balanceBefore.AmountOf(foo).Sub(balanceAfter.AmountOf(foo)))
+		})
+	}
+}
+
+// TestSplitRouteExactAmountOut tests that split routes are executed independently,
+// that their required inputs are summed and that the aggregate maximum amount in is enforced.
+func (suite *KeeperTestSuite) TestSplitRouteExactAmountOut() {
+	defaultPoolCoins := []sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)), // pool 1.
+		sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)), // pool 2.
+		sdk.NewCoins(sdk.NewCoin(baz, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)), // pool 3.
+	}
+	defaultPoolFees := []sdk.Dec{defaultPoolSwapFee, defaultPoolSwapFee, defaultPoolSwapFee}
+	defaultSplitRoutes := []types.SwapAmountOutSplitRoute{
+		{
+			Pools:          []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: foo}},
+			TokenOutAmount: sdk.NewInt(100000),
+		},
+		{
+			Pools:          []types.SwapAmountOutRoute{{PoolId: 2, TokenInDenom: foo}, {PoolId: 3, TokenInDenom: baz}},
+			TokenOutAmount: sdk.NewInt(50000),
+		},
+	}
+
+	tests := map[string]struct {
+		routes             []types.SwapAmountOutSplitRoute
+		tokenInMaxAmount   sdk.Int
+		expectMaxAmountErr bool
+		expectedErr        error
+	}{
+		"single route": {
+			routes:           defaultSplitRoutes[:1],
+			tokenInMaxAmount: sdk.NewInt(1000000),
+		},
+		"two routes through different pools": {
+			routes:           defaultSplitRoutes,
+			tokenInMaxAmount: sdk.NewInt(1000000),
+		},
+		"error: aggregate amount in is greater than the maximum": {
+			routes:             defaultSplitRoutes,
+			tokenInMaxAmount:   sdk.NewInt(150000),
+			expectMaxAmountErr: true,
+		},
+		"error: duplicate routes": {
+			routes:           []types.SwapAmountOutSplitRoute{defaultSplitRoutes[1], defaultSplitRoutes[1]},
+			tokenInMaxAmount: sdk.NewInt(1000000),
+			expectedErr:      types.ErrDuplicateRoutesNotAllowed,
+		},
+		"error: routes start from different denoms": {
+			routes: []types.SwapAmountOutSplitRoute{
+				defaultSplitRoutes[0],
+				{
+					Pools:          []types.SwapAmountOutRoute{{PoolId: 3, TokenInDenom: baz}},
+					TokenOutAmount: sdk.NewInt(50000),
+				},
+			},
+			tokenInMaxAmount: sdk.NewInt(1000000),
+			expectedErr:      types.InvalidFirstTokenInError{TokenInGivenA: foo, TokenInGivenB: baz},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolmanagerKeeper := suite.App.PoolManagerKeeper
+			sender := suite.TestAccs[1]
+
+			suite.createBalancerPoolsFromCoinsWithSwapFee(defaultPoolCoins, defaultPoolFees)
+			suite.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(foo, sdk.NewInt(1000000))))
+
+			// The valid routes do not share any pools, so each of them can be estimated independently.
+			expectedTokenInAmount := sdk.ZeroInt()
+			if tc.expectedErr == nil {
+				for _, route := range tc.routes {
+					routeTokenInAmount, err := poolmanagerKeeper.MultihopEstimateInGivenExactAmountOut(suite.Ctx, route.Pools, sdk.NewCoin(bar, route.TokenOutAmount))
+					suite.Require().NoError(err)
+					expectedTokenInAmount = expectedTokenInAmount.Add(routeTokenInAmount)
+				}
+			}
+			if tc.expectMaxAmountErr {
+				tc.expectedErr = types.SplitRouteTokenInGreaterThanMaxError{TokenInAmount: expectedTokenInAmount, TokenInMaxAmount: tc.tokenInMaxAmount}
+			}
+
+			balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+
+			tokenInAmount, err := poolmanagerKeeper.SplitRouteExactAmountOut(suite.Ctx, sender, tc.routes, bar, tc.tokenInMaxAmount)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorContains(err, tc.expectedErr.Error())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenInAmount.String(), tokenInAmount.String())
+
+			balanceAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			suite.Require().Equal(tokenInAmount, balanceBefore.AmountOf(foo).Sub(balanceAfter.AmountOf(foo)))
+
+			totalTokenOutAmount := sdk.ZeroInt()
+			for _, route := range tc.routes {
+				totalTokenOutAmount = totalTokenOutAmount.Add(route.TokenOutAmount)
+			}
+			suite.Require().Equal(totalTokenOutAmount, balanceAfter.AmountOf(bar).Sub(balanceBefore.AmountOf(bar)))
+		})
+	}
+}
+
+// TestEstimateBestRouteExactAmountIn tests that the route with the highest
+// estimated amount out is found among all routes within the given hops.
+func (suite *KeeperTestSuite) TestEstimateBestRouteExactAmountIn() {
+	thinPoolAmount := sdk.NewInt(100000000)
+	poolCoins := []sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(foo, thinPoolAmount), sdk.NewCoin(bar, thinPoolAmount)),               // pool 1.
+		sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)), // pool 2.
+		sdk.NewCoins(sdk.NewCoin(baz, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)), // pool 3.
+	}
+	poolFees := []sdk.Dec{defaultPoolSwapFee, defaultPoolSwapFee, defaultPoolSwapFee}
+	tokenIn := sdk.NewCoin(foo, sdk.NewInt(10000000))
+
+	tests := map[string]struct {
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+		maxHops       uint64
+		expectedRoute []types.SwapAmountInRoute
+		expectedErr   error
+	}{
+		"default max hops: deep two hop route beats the thin direct pool": {
+			tokenIn:       tokenIn,
+			tokenOutDenom: bar,
+			expectedRoute: []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: baz}, {PoolId: 3, TokenOutDenom: bar}},
+		},
+		"one max hop: only the direct pool is considered": {
+			tokenIn:       tokenIn,
+			tokenOutDenom: bar,
+			maxHops:       1,
+			expectedRoute: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}},
+		},
+		"reverse direction": {
+			tokenIn:       sdk.NewCoin(bar, tokenIn.Amount),
+			tokenOutDenom: foo,
+			expectedRoute: []types.SwapAmountInRoute{{PoolId: 3, TokenOutDenom: baz}, {PoolId: 2, TokenOutDenom: foo}},
+		},
+		"error: no pool contains the token out denom": {
+			tokenIn:       tokenIn,
+			tokenOutDenom: uosmo,
+			expectedErr:   types.NoRouteFoundError{TokenInDenom: foo, TokenOutDenom: uosmo, MaxHops: types.DefaultBestRouteMaxHops},
+		},
+		"error: max hops above the limit": {
+			tokenIn:       tokenIn,
+			tokenOutDenom: bar,
+			maxHops:       types.MaxBestRouteMaxHops + 1,
+			expectedErr:   types.MaxHopsExceededError{MaxHops: types.MaxBestRouteMaxHops + 1, Limit: types.MaxBestRouteMaxHops},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolmanagerKeeper := suite.App.PoolManagerKeeper
+
+			suite.createBalancerPoolsFromCoinsWithSwapFee(poolCoins, poolFees)
+
+			routes, tokenOutAmount, err := poolmanagerKeeper.EstimateBestRouteExactAmountIn(suite.Ctx, tc.tokenIn, tc.tokenOutDenom, tc.maxHops)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedRoute, routes)
+
+			expectedTokenOutAmount, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(suite.Ctx, tc.expectedRoute, tc.tokenIn)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenOutAmount.String(), tokenOutAmount.String())
+		})
+	}
+}
+
 type MockPoolModule struct {
 	pools []types.PoolI
 }
@@ -0,0 +1,171 @@
+package poolmanager
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// GetTakerFee returns the taker fee charged on swaps between denom0 and denom1.
+// The order of the denoms does not matter. If no taker fee is set for the pair,
+// the default taker fee is returned.
+func (k Keeper) GetTakerFee(ctx sdk.Context, denom0, denom1 string) (sdk.Dec, error) {
+	if err := sdk.ValidateDenom(denom0); err != nil {
+		return sdk.Dec{}, err
+	}
+	if err := sdk.ValidateDenom(denom1); err != nil {
+		return sdk.Dec{}, err
+	}
+	if denom0 == denom1 {
+		return sdk.Dec{}, fmt.Errorf("denom0 (%s) must differ from denom1", denom0)
+	}
+
+	return k.getTakerFee(k.GetTakerFeeParams(ctx), denom0, denom1), nil
+}
+
+// getTakerFee returns the taker fee for the given denoms from takerFeeParams.
+func (k Keeper) getTakerFee(takerFeeParams types.TakerFeeParams, denom0, denom1 string) sdk.Dec {
+	if denom0 > denom1 {
+		denom0, denom1 = denom1, denom0
+	}
+
+	for _, denomPairTakerFee := range takerFeeParams.DenomPairTakerFees {
+		if denomPairTakerFee.Denom0 == denom0 && denomPairTakerFee.Denom1 == denom1 {
+			return denomPairTakerFee.TakerFee
+		}
+	}
+
+	return takerFeeParams.DefaultTakerFee
+}
+
+// GetTakerFeeParams returns the taker fee params.
+func (k Keeper) GetTakerFeeParams(ctx sdk.Context) (takerFeeParams types.TakerFeeParams) {
+	k.paramSpace.Get(ctx, types.KeyTakerFeeParams, &takerFeeParams)
+	return takerFeeParams
+}
+
+// SetTakerFeeParams sets the taker fee params.
+func (k Keeper) SetTakerFeeParams(ctx sdk.Context, takerFeeParams types.TakerFeeParams) {
+	k.paramSpace.Set(ctx, types.KeyTakerFeeParams, &takerFeeParams)
+}
+
+// GetTakerFeeTotals returns the taker fees collected since genesis, per denom.
+func (k Keeper) GetTakerFeeTotals(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	totals, err := osmoutils.GatherValuesFromStorePrefix(store, types.TakerFeeTotalsPrefix, parseCoin)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.NewCoins(totals...)
+}
+
+// setTakerFeeTotal sets the taker fee collected since genesis for the denom of total.
+func (k Keeper) setTakerFeeTotal(ctx sdk.Context, total sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.FormatTakerFeeTotalKey(total.Denom), &total)
+}
+
+// increaseTakerFeeTotal adds takerFee to the taker fee collected since genesis for its denom.
+func (k Keeper) increaseTakerFeeTotal(ctx sdk.Context, takerFee sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	total := sdk.NewCoin(takerFee.Denom, sdk.ZeroInt())
+	if _, err := osmoutils.Get(store, types.FormatTakerFeeTotalKey(takerFee.Denom), &total); err != nil {
+		panic(err)
+	}
+	k.setTakerFeeTotal(ctx, total.Add(takerFee))
+}
+
+func parseCoin(bz []byte) (sdk.Coin, error) {
+	coin := sdk.Coin{}
+	err := coin.Unmarshal(bz)
+	return coin, err
+}
+
+// calcTakerFeeExactIn returns the part of tokenIn that is swapped after deducting
+// takerFee, along with the deducted taker fee. The taker fee is rounded up.
+func calcTakerFeeExactIn(tokenIn sdk.Coin, takerFee sdk.Dec) (tokenInAfterFee sdk.Coin, takerFeeCoin sdk.Coin) {
+	amountInAfterFee := tokenIn.Amount.ToDec().MulTruncate(sdk.OneDec().Sub(takerFee)).TruncateInt()
+	return sdk.NewCoin(tokenIn.Denom, amountInAfterFee), sdk.NewCoin(tokenIn.Denom, tokenIn.Amount.Sub(amountInAfterFee))
+}
+
+// calcTakerFeeExactOut returns the amount that must be provided so that tokenIn is
+// swapped after deducting takerFee, along with the deducted taker fee.
+// The taker fee is rounded up.
+func calcTakerFeeExactOut(tokenIn sdk.Coin, takerFee sdk.Dec) (tokenInWithFee sdk.Coin, takerFeeCoin sdk.Coin) {
+	amountInWithFee := tokenIn.Amount.ToDec().QuoRoundUp(sdk.OneDec().Sub(takerFee)).Ceil().TruncateInt()
+	return sdk.NewCoin(tokenIn.Denom, amountInWithFee), sdk.NewCoin(tokenIn.Denom, amountInWithFee.Sub(tokenIn.Amount))
+}
+
+// chargeTakerFee charges the taker fee for swapping tokenIn into tokenOutDenom from sender.
+// If exactIn is true, the taker fee is deducted from tokenIn and the remaining amount to swap is returned.
+// Otherwise, tokenIn is the amount swapped and the taker fee is charged on top of it, returning the total
+// amount paid by the sender. Module accounts, such as the txfees module swapping the collected fee tokens,
+// are exempt from the taker fee.
+func (k Keeper) chargeTakerFee(ctx sdk.Context, sender sdk.AccAddress, tokenIn sdk.Coin, tokenOutDenom string, exactIn bool) (sdk.Coin, error) {
+	if k.isModuleAccount(ctx, sender) {
+		return tokenIn, nil
+	}
+
+	takerFeeParams := k.GetTakerFeeParams(ctx)
+	takerFee := k.getTakerFee(takerFeeParams, tokenIn.Denom, tokenOutDenom)
+	if takerFee.IsZero() {
+		return tokenIn, nil
+	}
+
+	var tokenInAfterCharge, takerFeeCoin sdk.Coin
+	if exactIn {
+		tokenInAfterCharge, takerFeeCoin = calcTakerFeeExactIn(tokenIn, takerFee)
+	} else {
+		tokenInAfterCharge, takerFeeCoin = calcTakerFeeExactOut(tokenIn, takerFee)
+	}
+
+	if err := k.SendTakerFee(ctx, sender, takerFeeCoin, tokenOutDenom); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return tokenInAfterCharge, nil
+}
+
+// SendTakerFee sends takerFee, charged on a swap into tokenOutDenom, from sender to the fee collector
+// module account if one is configured and to the community pool otherwise, and adds it to the taker fee totals.
+// It lets pool modules charge the taker fee on swaps that they price within their own messages,
+// such as gamm single asset exits.
+func (k Keeper) SendTakerFee(ctx sdk.Context, sender sdk.AccAddress, takerFee sdk.Coin, tokenOutDenom string) error {
+	if takerFee.IsZero() {
+		return nil
+	}
+
+	takerFeeParams := k.GetTakerFeeParams(ctx)
+	if takerFeeParams.FeeCollector == "" {
+		if err := k.communityPoolKeeper.FundCommunityPool(ctx, sdk.NewCoins(takerFee), sender); err != nil {
+			return err
+		}
+	} else {
+		feeCollector := authtypes.NewModuleAddress(takerFeeParams.FeeCollector)
+		if err := k.bankKeeper.SendCoins(ctx, sender, feeCollector, sdk.NewCoins(takerFee)); err != nil {
+			return err
+		}
+	}
+
+	k.increaseTakerFeeTotal(ctx, takerFee)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtTakerFeeCharged,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyTakerFee, takerFee.String()),
+		sdk.NewAttribute(types.AttributeKeyTokenOutDenom, tokenOutDenom),
+	))
+
+	return nil
+}
+
+// isModuleAccount returns true if addr is the address of a module account.
+func (k Keeper) isModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return ok
+}
@@ -0,0 +1,288 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v15/x/txfees/types"
+)
+
+var (
+	testDefaultTakerFee   = sdk.MustNewDecFromStr("0.01")
+	testDenomPairTakerFee = sdk.MustNewDecFromStr("0.02")
+)
+
+// setTakerFeeParams sets a default taker fee along with a taker fee for the bar/foo pair,
+// sending the taker fee to feeCollector.
+func (suite *KeeperTestSuite) setTakerFeeParams(feeCollector string) {
+	suite.App.PoolManagerKeeper.SetTakerFeeParams(suite.Ctx, types.TakerFeeParams{
+		DefaultTakerFee: testDefaultTakerFee,
+		DenomPairTakerFees: []types.DenomPairTakerFee{
+			{Denom0: bar, Denom1: foo, TakerFee: testDenomPairTakerFee},
+		},
+		FeeCollector: feeCollector,
+	})
+}
+
+func (suite *KeeperTestSuite) TestGetTakerFee() {
+	tests := map[string]struct {
+		denom0           string
+		denom1           string
+		expectedTakerFee sdk.Dec
+		expectError      bool
+	}{
+		"denom pair taker fee": {
+			denom0:           bar,
+			denom1:           foo,
+			expectedTakerFee: testDenomPairTakerFee,
+		},
+		"denom pair taker fee, reversed order": {
+			denom0:           foo,
+			denom1:           bar,
+			expectedTakerFee: testDenomPairTakerFee,
+		},
+		"default taker fee": {
+			denom0:           foo,
+			denom1:           baz,
+			expectedTakerFee: testDefaultTakerFee,
+		},
+		"error: same denoms": {
+			denom0:      foo,
+			denom1:      foo,
+			expectError: true,
+		},
+		"error: invalid denom": {
+			denom0:      "1foo",
+			denom1:      bar,
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.setTakerFeeParams("")
+
+			takerFee, err := suite.App.PoolManagerKeeper.GetTakerFee(suite.Ctx, tc.denom0, tc.denom1)
+			if tc.expectError {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedTakerFee, takerFee)
+		})
+	}
+}
+
+// TestRouteExactAmountInTakerFee tests that the taker fee is deducted from the input of every hop
+// before swapping, that it is sent to the configured recipient and that it is added to the totals.
+func (suite *KeeperTestSuite) TestRouteExactAmountInTakerFee() {
+	tests := map[string]struct {
+		feeCollector string
+	}{
+		"taker fee sent to community pool": {},
+		"taker fee sent to fee collector": {
+			feeCollector: authtypes.FeeCollectorName,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolmanagerKeeper := suite.App.PoolManagerKeeper
+			suite.createBalancerPoolsFromCoinsWithSwapFee([]sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(bar, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)),
+			}, []sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec()})
+			suite.setTakerFeeParams(tc.feeCollector)
+
+			routes := []types.SwapAmountInRoute{
+				{PoolId: 1, TokenOutDenom: bar},
+				{PoolId: 2, TokenOutDenom: baz},
+			}
+			tokenIn := sdk.NewCoin(foo, sdk.NewInt(100000))
+
+			// Estimate the output and the intermediate amount before any state changes.
+			cacheCtx, _ := suite.Ctx.CacheContext()
+			expectedTokenOutAmount, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(cacheCtx, routes, tokenIn)
+			suite.Require().NoError(err)
+			expectedFooFee := sdk.NewInt(2000) // 2% of 100000 for the bar/foo pair
+			cacheCtx, _ = suite.Ctx.CacheContext()
+			barOut, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(cacheCtx, routes[:1], tokenIn)
+			suite.Require().NoError(err)
+			expectedBarFee := barOut.Sub(barOut.ToDec().Mul(sdk.OneDec().Sub(testDefaultTakerFee)).TruncateInt())
+
+			feeCollectorBalanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+			communityPoolBefore := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+
+			sender := suite.TestAccs[1]
+			suite.FundAcc(sender, sdk.NewCoins(tokenIn))
+			tokenOutAmount, err := poolmanagerKeeper.RouteExactAmountIn(suite.Ctx, sender, routes, tokenIn, sdk.OneInt())
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenOutAmount, tokenOutAmount)
+
+			expectedFees := sdk.NewCoins(sdk.NewCoin(foo, expectedFooFee), sdk.NewCoin(bar, expectedBarFee))
+			suite.Require().Equal(expectedFees, poolmanagerKeeper.GetTakerFeeTotals(suite.Ctx))
+
+			feeCollectorBalanceAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+			communityPoolAfter := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+			if tc.feeCollector == "" {
+				suite.Require().Equal(sdk.NewDecCoinsFromCoins(expectedFees...), communityPoolAfter.Sub(communityPoolBefore))
+			} else {
+				suite.Require().Equal(expectedFees, feeCollectorBalanceAfter.Sub(feeCollectorBalanceBefore))
+				suite.Require().Equal(communityPoolBefore, communityPoolAfter)
+			}
+		})
+	}
+}
+
+// TestRouteExactAmountOutTakerFee tests that the taker fee is charged on top of the input of every hop,
+// that the returned input amount includes it and that the maximum input amount accounts for it.
+func (suite *KeeperTestSuite) TestRouteExactAmountOutTakerFee() {
+	tests := map[string]struct {
+		routes           []types.SwapAmountOutRoute
+		tokenOut         sdk.Coin
+		maxAmountInDelta sdk.Int
+		expectMaxError   bool
+	}{
+		"single hop": {
+			routes:           []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: foo}},
+			tokenOut:         sdk.NewCoin(bar, sdk.NewInt(100000)),
+			maxAmountInDelta: sdk.ZeroInt(),
+		},
+		"two hops": {
+			routes: []types.SwapAmountOutRoute{
+				{PoolId: 1, TokenInDenom: foo},
+				{PoolId: 2, TokenInDenom: bar},
+			},
+			tokenOut:         sdk.NewCoin(baz, sdk.NewInt(100000)),
+			maxAmountInDelta: sdk.ZeroInt(),
+		},
+		"error: maximum amount in does not cover the taker fee": {
+			routes:           []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: foo}},
+			tokenOut:         sdk.NewCoin(bar, sdk.NewInt(100000)),
+			maxAmountInDelta: sdk.NewInt(-1),
+			expectMaxError:   true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolmanagerKeeper := suite.App.PoolManagerKeeper
+			suite.createBalancerPoolsFromCoinsWithSwapFee([]sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(bar, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)),
+			}, []sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec()})
+			suite.setTakerFeeParams("")
+
+			cacheCtx, _ := suite.Ctx.CacheContext()
+			expectedTokenInAmount, err := poolmanagerKeeper.MultihopEstimateInGivenExactAmountOut(cacheCtx, tc.routes, tc.tokenOut)
+			suite.Require().NoError(err)
+
+			sender := suite.TestAccs[1]
+			suite.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(foo, expectedTokenInAmount)))
+			senderBalanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, foo)
+
+			tokenInMaxAmount := expectedTokenInAmount.Add(tc.maxAmountInDelta)
+			tokenInAmount, err := poolmanagerKeeper.RouteExactAmountOut(suite.Ctx, sender, tc.routes, tokenInMaxAmount, tc.tokenOut)
+			if tc.expectMaxError {
+				suite.Require().ErrorContains(err, types.TokenInWithTakerFeeGreaterThanMaxError{TokenInAmount: expectedTokenInAmount, TokenInMaxAmount: tokenInMaxAmount}.Error())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenInAmount, tokenInAmount)
+
+			// The sender pays exactly the returned amount, including the taker fee.
+			senderBalanceAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, foo)
+			suite.Require().Equal(tokenInAmount, senderBalanceBefore.Sub(senderBalanceAfter).Amount)
+			suite.Require().Equal(tc.tokenOut, suite.App.BankKeeper.GetBalance(suite.Ctx, sender, tc.tokenOut.Denom))
+
+			// A taker fee is charged on every hop.
+			takerFeeTotals := poolmanagerKeeper.GetTakerFeeTotals(suite.Ctx)
+			for _, route := range tc.routes {
+				suite.Require().True(takerFeeTotals.AmountOf(route.TokenInDenom).IsPositive())
+			}
+		})
+	}
+}
+
+// TestSwapExactAmountInTakerFee tests that the single pool SwapExactAmountIn charges the taker fee
+// in the same way as a single hop RouteExactAmountIn.
+func (suite *KeeperTestSuite) TestSwapExactAmountInTakerFee() {
+	suite.SetupTest()
+	poolmanagerKeeper := suite.App.PoolManagerKeeper
+	suite.createBalancerPoolsFromCoinsWithSwapFee([]sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)),
+	}, []sdk.Dec{sdk.ZeroDec()})
+	suite.setTakerFeeParams("")
+
+	tokenIn := sdk.NewCoin(foo, sdk.NewInt(100000))
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	expectedTokenOutAmount, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(cacheCtx, []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}}, tokenIn)
+	suite.Require().NoError(err)
+
+	sender := suite.TestAccs[1]
+	suite.FundAcc(sender, sdk.NewCoins(tokenIn))
+	tokenOutAmount, err := poolmanagerKeeper.SwapExactAmountIn(suite.Ctx, sender, 1, tokenIn, bar, sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTokenOutAmount, tokenOutAmount)
+
+	expectedFees := sdk.NewCoins(sdk.NewCoin(foo, sdk.NewInt(2000))) // 2% of 100000 for the bar/foo pair
+	suite.Require().Equal(expectedFees, poolmanagerKeeper.GetTakerFeeTotals(suite.Ctx))
+}
+
+// TestSingleAssetJoinExitTakerFee tests that single asset exits that swap the exited assets are charged
+// the taker fee, while single asset joins, which are priced by the pool itself, are exempt from it.
+func (suite *KeeperTestSuite) TestSingleAssetJoinExitTakerFee() {
+	suite.SetupTest()
+	poolmanagerKeeper := suite.App.PoolManagerKeeper
+	gammKeeper := suite.App.GAMMKeeper
+	suite.createBalancerPoolsFromCoinsWithSwapFee([]sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)),
+	}, []sdk.Dec{sdk.ZeroDec()})
+	suite.setTakerFeeParams("")
+
+	sender := suite.TestAccs[1]
+	tokenIn := sdk.NewCoin(foo, sdk.NewInt(100000))
+	suite.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+	// Single asset joins are exempt from the taker fee.
+	shares, err := gammKeeper.JoinSwapExactAmountIn(suite.Ctx, sender, 1, sdk.NewCoins(tokenIn), sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().True(poolmanagerKeeper.GetTakerFeeTotals(suite.Ctx).IsZero())
+
+	// Exiting into foo swaps the exited bar into foo, charging the taker fee on the bar.
+	_, err = gammKeeper.ExitSwapShareAmountIn(suite.Ctx, sender, 1, foo, shares.QuoRaw(2), sdk.ZeroInt())
+	suite.Require().NoError(err)
+	takerFeeTotals := poolmanagerKeeper.GetTakerFeeTotals(suite.Ctx)
+	suite.Require().True(takerFeeTotals.AmountOf(bar).IsPositive())
+	suite.Require().True(takerFeeTotals.AmountOf(foo).IsZero())
+
+	// Exiting an exact amount of foo charges the taker fee in foo, on top of the exited foo.
+	tokenOut := sdk.NewCoin(foo, sdk.NewInt(10000))
+	balanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, foo)
+	_, err = gammKeeper.ExitSwapExactAmountOut(suite.Ctx, sender, 1, tokenOut, shares.QuoRaw(2))
+	suite.Require().NoError(err)
+	suite.Require().Equal(balanceBefore.Add(tokenOut), suite.App.BankKeeper.GetBalance(suite.Ctx, sender, foo))
+	suite.Require().True(poolmanagerKeeper.GetTakerFeeTotals(suite.Ctx).AmountOf(foo).IsPositive())
+}
+
+// TestModuleAccountTakerFeeExemption tests that swaps by module accounts are exempt from the taker fee.
+func (suite *KeeperTestSuite) TestModuleAccountTakerFeeExemption() {
+	suite.SetupTest()
+	poolmanagerKeeper := suite.App.PoolManagerKeeper
+	suite.createBalancerPoolsFromCoinsWithSwapFee([]sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)),
+	}, []sdk.Dec{sdk.ZeroDec()})
+	suite.setTakerFeeParams("")
+
+	tokenIn := sdk.NewCoin(foo, sdk.NewInt(100000))
+	sender := suite.App.AccountKeeper.GetModuleAccount(suite.Ctx, txfeestypes.NonNativeFeeCollectorName).GetAddress()
+	suite.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+	_, err := poolmanagerKeeper.SwapExactAmountIn(suite.Ctx, sender, 1, tokenIn, bar, sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().True(poolmanagerKeeper.GetTakerFeeTotals(suite.Ctx).IsZero())
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/gamm interfaces and concrete types
@@ -13,6 +14,9 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSwapExactAmountIn{}, "osmosis/poolmanager/swap-exact-amount-in", nil)
 	cdc.RegisterConcrete(&MsgSwapExactAmountOut{}, "osmosis/poolmanager/swap-exact-amount-out", nil)
+	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountIn{}, "osmosis/poolmanager/split-amount-in", nil)
+	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountOut{}, "osmosis/poolmanager/split-amount-out", nil)
+	cdc.RegisterConcrete(&MsgEmergencyPausePools{}, "osmosis/poolmanager/emergency-pause", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -20,6 +24,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgSwapExactAmountIn{},
 		&MsgSwapExactAmountOut{},
+		&MsgSplitRouteSwapExactAmountIn{},
+		&MsgSplitRouteSwapExactAmountOut{},
+		&MsgEmergencyPausePools{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&SetPoolsPausedProposal{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -3,13 +3,19 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
-	ErrEmptyRoutes       = errors.New("provided empty routes")
-	ErrInvalidPool       = errors.New("attempting to create an invalid pool")
-	ErrTooFewPoolAssets  = errors.New("pool should have at least 2 assets, as they must be swapping between at least two assets")
-	ErrTooManyPoolAssets = errors.New("pool has too many assets (currently capped at 8 assets per pool)")
+	ErrEmptyRoutes               = errors.New("provided empty routes")
+	ErrInvalidPool               = errors.New("attempting to create an invalid pool")
+	ErrTooFewPoolAssets          = errors.New("pool should have at least 2 assets, as they must be swapping between at least two assets")
+	ErrTooManyPoolAssets         = errors.New("pool has too many assets (currently capped at 8 assets per pool)")
+	ErrDuplicateRoutesNotAllowed = errors.New("duplicate multihop routes are not allowed")
+	ErrEmergencyPauseDisabled    = errors.New("emergency pause admin is not set")
+	ErrNoPoolsToPause            = errors.New("at least one pool id or pool type must be given")
 )
 
 type nonPositiveAmountError struct {
@@ -20,6 +26,69 @@ func (e nonPositiveAmountError) Error() string {
 	return fmt.Sprintf("min out amount or max in amount should be positive, was (%s)", e.Amount)
 }
 
+type nonPositiveSplitRouteAmountError struct {
+	Amount string
+}
+
+func (e nonPositiveSplitRouteAmountError) Error() string {
+	return fmt.Sprintf("split route amount should be positive, was (%s)", e.Amount)
+}
+
+type InvalidFinalTokenOutError struct {
+	TokenOutGivenA string
+	TokenOutGivenB string
+}
+
+func (e InvalidFinalTokenOutError) Error() string {
+	return fmt.Sprintf("invalid final token out, each path must end with the same token out, had (%s) and (%s) mismatch", e.TokenOutGivenA, e.TokenOutGivenB)
+}
+
+type InvalidFirstTokenInError struct {
+	TokenInGivenA string
+	TokenInGivenB string
+}
+
+func (e InvalidFirstTokenInError) Error() string {
+	return fmt.Sprintf("invalid first token in, each path must start with the same token in, had (%s) and (%s) mismatch", e.TokenInGivenA, e.TokenInGivenB)
+}
+
+type SplitRouteTokenOutLessThanMinError struct {
+	TokenOutAmount    sdk.Int
+	TokenOutMinAmount sdk.Int
+}
+
+func (e SplitRouteTokenOutLessThanMinError) Error() string {
+	return fmt.Sprintf("total token out amount (%s) from split routes is less than the minimum (%s)", e.TokenOutAmount, e.TokenOutMinAmount)
+}
+
+type SplitRouteTokenInGreaterThanMaxError struct {
+	TokenInAmount    sdk.Int
+	TokenInMaxAmount sdk.Int
+}
+
+func (e SplitRouteTokenInGreaterThanMaxError) Error() string {
+	return fmt.Sprintf("total token in amount (%s) from split routes is greater than the maximum (%s)", e.TokenInAmount, e.TokenInMaxAmount)
+}
+
+type MaxHopsExceededError struct {
+	MaxHops uint64
+	Limit   uint64
+}
+
+func (e MaxHopsExceededError) Error() string {
+	return fmt.Sprintf("max hops (%d) exceeds the limit (%d)", e.MaxHops, e.Limit)
+}
+
+type NoRouteFoundError struct {
+	TokenInDenom  string
+	TokenOutDenom string
+	MaxHops       uint64
+}
+
+func (e NoRouteFoundError) Error() string {
+	return fmt.Sprintf("no route found from (%s) to (%s) within (%d) hops", e.TokenInDenom, e.TokenOutDenom, e.MaxHops)
+}
+
 type FailedToFindRouteError struct {
 	PoolId uint64
 }
@@ -36,3 +105,72 @@ type UndefinedRouteError struct {
 func (e UndefinedRouteError) Error() string {
 	return fmt.Sprintf("route is not defined for the given pool type (%s) and pool id (%d)", e.PoolType, e.PoolId)
 }
+
+type InvalidTakerFeeError struct {
+	TakerFee sdk.Dec
+}
+
+func (e InvalidTakerFeeError) Error() string {
+	return fmt.Sprintf("taker fee (%s) must be non-negative and less than one", e.TakerFee)
+}
+
+type UnorderedDenomPairError struct {
+	Denom0 string
+	Denom1 string
+}
+
+func (e UnorderedDenomPairError) Error() string {
+	return fmt.Sprintf("denom0 (%s) must be lexicographically smaller than denom1 (%s)", e.Denom0, e.Denom1)
+}
+
+type DuplicateDenomPairTakerFeeError struct {
+	Denom0 string
+	Denom1 string
+}
+
+func (e DuplicateDenomPairTakerFeeError) Error() string {
+	return fmt.Sprintf("taker fee for denom pair (%s, %s) is set more than once", e.Denom0, e.Denom1)
+}
+
+type TokenInWithTakerFeeGreaterThanMaxError struct {
+	TokenInAmount    sdk.Int
+	TokenInMaxAmount sdk.Int
+}
+
+func (e TokenInWithTakerFeeGreaterThanMaxError) Error() string {
+	return fmt.Sprintf("token in amount including the taker fee (%s) is greater than the maximum (%s)", e.TokenInAmount, e.TokenInMaxAmount)
+}
+
+type InvalidVolumeWindowError struct {
+	Window    time.Duration
+	MaxWindow time.Duration
+}
+
+func (e InvalidVolumeWindowError) Error() string {
+	return fmt.Sprintf("volume window (%s) must be positive and at most (%s)", e.Window, e.MaxWindow)
+}
+
+type PoolPausedError struct {
+	PoolId uint64
+}
+
+func (e PoolPausedError) Error() string {
+	return fmt.Sprintf("swaps and joins are paused on pool (%d)", e.PoolId)
+}
+
+type UnauthorizedEmergencyPauseAdminError struct {
+	Sender string
+	Admin  string
+}
+
+func (e UnauthorizedEmergencyPauseAdminError) Error() string {
+	return fmt.Sprintf("sender (%s) is not the emergency pause admin (%s)", e.Sender, e.Admin)
+}
+
+type InvalidPoolTypeError struct {
+	PoolType PoolType
+}
+
+func (e InvalidPoolTypeError) Error() string {
+	return fmt.Sprintf("invalid pool type (%d)", e.PoolType)
+}
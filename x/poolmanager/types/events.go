@@ -1,5 +1,14 @@
 package types
 
 const (
+	TypeEvtTakerFeeCharged = "taker_fee_charged"
+	TypeEvtPoolsPaused     = "pools_paused"
+	TypeEvtPoolsUnpaused   = "pools_unpaused"
+
 	AttributeValueCategory = ModuleName
+
+	AttributeKeyTakerFee      = "taker_fee"
+	AttributeKeyTokenOutDenom = "token_out_denom"
+	AttributeKeyPoolIds       = "pool_ids"
+	AttributeKeyPoolTypes     = "pool_types"
 )
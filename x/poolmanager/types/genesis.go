@@ -1,6 +1,9 @@
 package types
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // DefaultGenesis returns the default poolmanager genesis state.
 func DefaultGenesis() *GenesisState {
@@ -19,5 +22,53 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	if err := gs.TakerFeeTotals.Validate(); err != nil {
+		return err
+	}
+
+	seenPoolIds := make(map[uint64]struct{}, len(gs.PoolVolumes))
+	for _, poolVolume := range gs.PoolVolumes {
+		if _, ok := seenPoolIds[poolVolume.PoolId]; ok {
+			return fmt.Errorf("duplicate volume for pool id (%d)", poolVolume.PoolId)
+		}
+		seenPoolIds[poolVolume.PoolId] = struct{}{}
+
+		if err := poolVolume.Volume.Validate(); err != nil {
+			return err
+		}
+		if err := poolVolume.SwapFees.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := validatePoolVolumeBuckets(gs.PoolVolumeBuckets); err != nil {
+		return err
+	}
+
+	return ValidatePausedPools(gs.PausedPoolIds, gs.PausedPoolTypes)
+}
+
+// validatePoolVolumeBuckets validates that every bucket starts at a multiple of VolumeBucketDuration,
+// that no pool has two buckets with the same start time and that the bucket volumes are valid.
+func validatePoolVolumeBuckets(buckets []PoolVolumeBucket) error {
+	seenBuckets := make(map[string]struct{}, len(buckets))
+	for _, bucket := range buckets {
+		if !bucket.StartTime.Equal(bucket.StartTime.Truncate(VolumeBucketDuration)) {
+			return fmt.Errorf("volume bucket start time (%s) of pool id (%d) is not a multiple of %s", bucket.StartTime, bucket.PoolVolume.PoolId, VolumeBucketDuration)
+		}
+
+		bucketKey := string(FormatPoolVolumeBucketKey(bucket.PoolVolume.PoolId, bucket.StartTime))
+		if _, ok := seenBuckets[bucketKey]; ok {
+			return fmt.Errorf("duplicate volume bucket starting at (%s) for pool id (%d)", bucket.StartTime, bucket.PoolVolume.PoolId)
+		}
+		seenBuckets[bucketKey] = struct{}{}
+
+		if err := bucket.PoolVolume.Volume.Validate(); err != nil {
+			return err
+		}
+		if err := bucket.PoolVolume.SwapFees.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
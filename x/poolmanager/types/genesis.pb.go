@@ -12,15 +12,18 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,6 +34,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params holds parameters for the poolmanager module
 type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	// taker_fee_params is the container of taker fee parameters.
+	TakerFeeParams TakerFeeParams `protobuf:"bytes,2,opt,name=taker_fee_params,json=takerFeeParams,proto3" json:"taker_fee_params" yaml:"taker_fee_params"`
+	// emergency_pause_admin is the address allowed to pause pools without a
+	// governance proposal. Pools paused by it can only be unpaused through
+	// governance. If empty, the emergency pause path is disabled.
+	EmergencyPauseAdmin string `protobuf:"bytes,3,opt,name=emergency_pause_admin,json=emergencyPauseAdmin,proto3" json:"emergency_pause_admin,omitempty" yaml:"emergency_pause_admin"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -73,6 +82,136 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Params) GetTakerFeeParams() TakerFeeParams {
+	if m != nil {
+		return m.TakerFeeParams
+	}
+	return TakerFeeParams{}
+}
+
+func (m *Params) GetEmergencyPauseAdmin() string {
+	if m != nil {
+		return m.EmergencyPauseAdmin
+	}
+	return ""
+}
+
+// TakerFeeParams holds the parameters of the protocol taker fee charged on the
+// token in of every hop of swaps routed through the poolmanager.
+type TakerFeeParams struct {
+	// default_taker_fee is the taker fee charged when no override exists for the
+	// denom pair being swapped.
+	DefaultTakerFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=default_taker_fee,json=defaultTakerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"default_taker_fee" yaml:"default_taker_fee"`
+	// denom_pair_taker_fees overrides the default taker fee for specific denom
+	// pairs, regardless of the swap direction.
+	DenomPairTakerFees []DenomPairTakerFee `protobuf:"bytes,2,rep,name=denom_pair_taker_fees,json=denomPairTakerFees,proto3" json:"denom_pair_taker_fees" yaml:"denom_pair_taker_fees"`
+	// fee_collector is the name of the module account taker fees are sent to.
+	// If empty, taker fees fund the community pool.
+	FeeCollector string `protobuf:"bytes,3,opt,name=fee_collector,json=feeCollector,proto3" json:"fee_collector,omitempty" yaml:"fee_collector"`
+}
+
+func (m *TakerFeeParams) Reset()         { *m = TakerFeeParams{} }
+func (m *TakerFeeParams) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeParams) ProtoMessage()    {}
+func (*TakerFeeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{1}
+}
+func (m *TakerFeeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeParams.Merge(m, src)
+}
+func (m *TakerFeeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeParams proto.InternalMessageInfo
+
+func (m *TakerFeeParams) GetDenomPairTakerFees() []DenomPairTakerFee {
+	if m != nil {
+		return m.DenomPairTakerFees
+	}
+	return nil
+}
+
+func (m *TakerFeeParams) GetFeeCollector() string {
+	if m != nil {
+		return m.FeeCollector
+	}
+	return ""
+}
+
+// DenomPairTakerFee is the taker fee of a denom pair. denom0 must be
+// lexicographically smaller than denom1.
+type DenomPairTakerFee struct {
+	Denom0   string                                 `protobuf:"bytes,1,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0"`
+	Denom1   string                                 `protobuf:"bytes,2,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1"`
+	TakerFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=taker_fee,json=takerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee" yaml:"taker_fee"`
+}
+
+func (m *DenomPairTakerFee) Reset()         { *m = DenomPairTakerFee{} }
+func (m *DenomPairTakerFee) String() string { return proto.CompactTextString(m) }
+func (*DenomPairTakerFee) ProtoMessage()    {}
+func (*DenomPairTakerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{2}
+}
+func (m *DenomPairTakerFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPairTakerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPairTakerFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPairTakerFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPairTakerFee.Merge(m, src)
+}
+func (m *DenomPairTakerFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPairTakerFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPairTakerFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPairTakerFee proto.InternalMessageInfo
+
+func (m *DenomPairTakerFee) GetDenom0() string {
+	if m != nil {
+		return m.Denom0
+	}
+	return ""
+}
+
+func (m *DenomPairTakerFee) GetDenom1() string {
+	if m != nil {
+		return m.Denom1
+	}
+	return ""
+}
+
 // GenesisState defines the poolmanager module's genesis state.
 type GenesisState struct {
 	// the next_pool_id
@@ -81,13 +220,27 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// pool_routes is the container of the mappings from pool id to pool type.
 	PoolRoutes []ModuleRoute `protobuf:"bytes,3,rep,name=pool_routes,json=poolRoutes,proto3" json:"pool_routes"`
+	// taker_fee_totals is the total amount of taker fees collected per denom.
+	TakerFeeTotals github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=taker_fee_totals,json=takerFeeTotals,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"taker_fee_totals"`
+	// pool_volumes is the lifetime volume and collected swap fees of every pool
+	// that has been swapped through.
+	PoolVolumes []PoolVolume `protobuf:"bytes,5,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes"`
+	// paused_pool_ids are the ids of the pools on which swaps and joins are
+	// paused.
+	PausedPoolIds []uint64 `protobuf:"varint,6,rep,packed,name=paused_pool_ids,json=pausedPoolIds,proto3" json:"paused_pool_ids,omitempty"`
+	// paused_pool_types are the pool types on which swaps and joins are paused
+	// for every pool.
+	PausedPoolTypes []PoolType `protobuf:"varint,7,rep,packed,name=paused_pool_types,json=pausedPoolTypes,proto3,enum=osmosis.poolmanager.v1beta1.PoolType" json:"paused_pool_types,omitempty"`
+	// pool_volume_buckets are the volume and collected swap fees of every pool
+	// in the time buckets within the longest volume window.
+	PoolVolumeBuckets []PoolVolumeBucket `protobuf:"bytes,8,rep,name=pool_volume_buckets,json=poolVolumeBuckets,proto3" json:"pool_volume_buckets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{1}
+	return fileDescriptor_aa099d9fbdf68b35, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,9 +290,164 @@ func (m *GenesisState) GetPoolRoutes() []ModuleRoute {
 	return nil
 }
 
+func (m *GenesisState) GetTakerFeeTotals() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TakerFeeTotals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolVolumes() []PoolVolume {
+	if m != nil {
+		return m.PoolVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedPoolIds() []uint64 {
+	if m != nil {
+		return m.PausedPoolIds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedPoolTypes() []PoolType {
+	if m != nil {
+		return m.PausedPoolTypes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolVolumeBuckets() []PoolVolumeBucket {
+	if m != nil {
+		return m.PoolVolumeBuckets
+	}
+	return nil
+}
+
+// PoolVolume is the volume and the collected swap fees of a pool, per denom.
+// The volume of a denom is the amount of it swapped into and out of the pool.
+type PoolVolume struct {
+	PoolId   uint64                                   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Volume   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume" yaml:"volume"`
+	SwapFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=swap_fees,json=swapFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_fees" yaml:"swap_fees"`
+}
+
+func (m *PoolVolume) Reset()         { *m = PoolVolume{} }
+func (m *PoolVolume) String() string { return proto.CompactTextString(m) }
+func (*PoolVolume) ProtoMessage()    {}
+func (*PoolVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{4}
+}
+func (m *PoolVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolume.Merge(m, src)
+}
+func (m *PoolVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolVolume proto.InternalMessageInfo
+
+func (m *PoolVolume) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolVolume) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *PoolVolume) GetSwapFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SwapFees
+	}
+	return nil
+}
+
+// PoolVolumeBucket is the volume and the collected swap fees of a pool in the
+// time bucket starting at start_time.
+type PoolVolumeBucket struct {
+	StartTime  time.Time  `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	PoolVolume PoolVolume `protobuf:"bytes,2,opt,name=pool_volume,json=poolVolume,proto3" json:"pool_volume" yaml:"pool_volume"`
+}
+
+func (m *PoolVolumeBucket) Reset()         { *m = PoolVolumeBucket{} }
+func (m *PoolVolumeBucket) String() string { return proto.CompactTextString(m) }
+func (*PoolVolumeBucket) ProtoMessage()    {}
+func (*PoolVolumeBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{5}
+}
+func (m *PoolVolumeBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVolumeBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVolumeBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVolumeBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolumeBucket.Merge(m, src)
+}
+func (m *PoolVolumeBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVolumeBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolumeBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolVolumeBucket proto.InternalMessageInfo
+
+func (m *PoolVolumeBucket) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *PoolVolumeBucket) GetPoolVolume() PoolVolume {
+	if m != nil {
+		return m.PoolVolume
+	}
+	return PoolVolume{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.poolmanager.v1beta1.Params")
+	proto.RegisterType((*TakerFeeParams)(nil), "osmosis.poolmanager.v1beta1.TakerFeeParams")
+	proto.RegisterType((*DenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.DenomPairTakerFee")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.poolmanager.v1beta1.GenesisState")
+	proto.RegisterType((*PoolVolume)(nil), "osmosis.poolmanager.v1beta1.PoolVolume")
+	proto.RegisterType((*PoolVolumeBucket)(nil), "osmosis.poolmanager.v1beta1.PoolVolumeBucket")
 }
 
 func init() {
@@ -147,34 +455,68 @@ func init() {
 }
 
 var fileDescriptor_aa099d9fbdf68b35 = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xc1, 0x8a, 0xd4, 0x40,
-	0x10, 0x4d, 0xbb, 0xcb, 0x1c, 0x7a, 0x16, 0xc4, 0xe0, 0x21, 0xbb, 0x42, 0x26, 0xc4, 0x4b, 0x3c,
-	0x6c, 0x37, 0xb3, 0x22, 0x82, 0x37, 0x67, 0x41, 0x11, 0x14, 0xd7, 0x78, 0xf3, 0x12, 0x3a, 0x49,
-	0x6d, 0x0c, 0x26, 0xa9, 0x90, 0xee, 0x2c, 0x3b, 0x7f, 0x21, 0x78, 0xf7, 0x03, 0xfc, 0x0e, 0x0f,
-	0x7b, 0x9c, 0xa3, 0xa7, 0x51, 0x66, 0xfe, 0xc0, 0x2f, 0x90, 0xee, 0x74, 0x64, 0x74, 0x61, 0x4e,
-	0x49, 0x75, 0xbd, 0xf7, 0xaa, 0xde, 0x2b, 0xfa, 0x08, 0x65, 0x8d, 0xb2, 0x94, 0xbc, 0x45, 0xac,
-	0x6a, 0xd1, 0x88, 0x02, 0x3a, 0x7e, 0x35, 0x4f, 0x41, 0x89, 0x39, 0x2f, 0xa0, 0x01, 0x59, 0x4a,
-	0xd6, 0x76, 0xa8, 0xd0, 0x7d, 0x60, 0xa1, 0x6c, 0x07, 0xca, 0x2c, 0xf4, 0xe4, 0x7e, 0x81, 0x05,
-	0x1a, 0x1c, 0xd7, 0x7f, 0x03, 0xe5, 0xe4, 0xb8, 0x40, 0x2c, 0x2a, 0xe0, 0xa6, 0x4a, 0xfb, 0x4b,
-	0x2e, 0x9a, 0xe5, 0xd8, 0xca, 0x8c, 0x5c, 0x32, 0x70, 0x86, 0xc2, 0xb6, 0xfc, 0xff, 0x59, 0x79,
-	0xdf, 0x09, 0x55, 0x62, 0x33, 0xf6, 0x07, 0x34, 0x4f, 0x85, 0x84, 0xbf, 0xbb, 0x66, 0x58, 0x8e,
-	0x7d, 0xb6, 0xcf, 0x53, 0x8d, 0x79, 0x5f, 0x41, 0xd2, 0x61, 0xaf, 0x60, 0xc0, 0x87, 0x5f, 0x09,
-	0x9d, 0x5c, 0x88, 0x4e, 0xd4, 0xd2, 0xfd, 0x42, 0xe8, 0x3d, 0xcd, 0x4a, 0xb2, 0x0e, 0xcc, 0xc8,
-	0xe4, 0x12, 0xc0, 0x23, 0xc1, 0x41, 0x34, 0x3d, 0x3b, 0x66, 0x76, 0x4b, 0x3d, 0x77, 0x34, 0xce,
-	0xce, 0xb1, 0x6c, 0x16, 0xaf, 0x6f, 0xd6, 0x33, 0xe7, 0xf7, 0x7a, 0xe6, 0x2d, 0x45, 0x5d, 0x3d,
-	0x0b, 0x6f, 0x29, 0x84, 0xdf, 0x7e, 0xce, 0xa2, 0xa2, 0x54, 0x1f, 0xfb, 0x94, 0x65, 0x58, 0x5b,
-	0xbb, 0xf6, 0x73, 0x2a, 0xf3, 0x4f, 0x5c, 0x2d, 0x5b, 0x90, 0x46, 0x4c, 0xc6, 0x77, 0x35, 0xff,
-	0xdc, 0xd2, 0x5f, 0x00, 0x84, 0xdf, 0x09, 0x3d, 0x7a, 0x39, 0xdc, 0xe2, 0xbd, 0x12, 0x0a, 0xdc,
-	0x80, 0x1e, 0x35, 0x70, 0xad, 0x12, 0x33, 0xa8, 0xcc, 0x3d, 0x12, 0x90, 0xe8, 0x30, 0xa6, 0xfa,
-	0xed, 0x02, 0xb1, 0x7a, 0x95, 0xbb, 0xcf, 0xe9, 0xa4, 0x35, 0x96, 0xbc, 0x3b, 0x01, 0x89, 0xa6,
-	0x67, 0x0f, 0xd9, 0x9e, 0xeb, 0xb1, 0xc1, 0xfd, 0xe2, 0x50, 0xdb, 0x88, 0x2d, 0xd1, 0x7d, 0x4b,
-	0xa7, 0x46, 0xdf, 0x44, 0x25, 0xbd, 0x03, 0x13, 0x42, 0xb4, 0x57, 0xe7, 0x8d, 0x09, 0x37, 0xd6,
-	0x04, 0x2b, 0x46, 0x35, 0xcc, 0x3c, 0xc8, 0xc5, 0xbb, 0x9b, 0x8d, 0x4f, 0x56, 0x1b, 0x9f, 0xfc,
-	0xda, 0xf8, 0xe4, 0xf3, 0xd6, 0x77, 0x56, 0x5b, 0xdf, 0xf9, 0xb1, 0xf5, 0x9d, 0x0f, 0x4f, 0x77,
-	0xb2, 0xb1, 0xfa, 0xa7, 0x95, 0x48, 0xe5, 0x58, 0xf0, 0xab, 0xf9, 0x13, 0x7e, 0xfd, 0xcf, 0x3d,
-	0x4d, 0x60, 0xe9, 0xc4, 0x5c, 0xf0, 0xf1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x65, 0x9f, 0xa5,
-	0xd6, 0xc7, 0x02, 0x00, 0x00,
+	// 964 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xae, 0x93, 0x90, 0x6d, 0x27, 0xfd, 0xca, 0xec, 0xae, 0xf0, 0x96, 0x25, 0x8e, 0x0c, 0x2c,
+	0x59, 0xad, 0x6a, 0x37, 0x45, 0x08, 0x09, 0x89, 0x43, 0xd3, 0x6a, 0x11, 0x08, 0x44, 0xd6, 0x44,
+	0x08, 0x71, 0xb1, 0x26, 0xf6, 0x34, 0x58, 0xb5, 0x3d, 0x96, 0x67, 0x1c, 0x36, 0x12, 0x77, 0xae,
+	0x2b, 0xf1, 0x2f, 0x90, 0xf8, 0x1f, 0x2b, 0x4e, 0x0b, 0x27, 0xc4, 0x21, 0x8b, 0xda, 0x2b, 0x5c,
+	0xf2, 0x0b, 0x56, 0xf3, 0x61, 0xe7, 0xa3, 0x55, 0xd2, 0x9c, 0x5a, 0xbf, 0xf3, 0x3c, 0xcf, 0xfb,
+	0x35, 0xef, 0x3b, 0x01, 0x8f, 0x09, 0x8d, 0x08, 0x0d, 0xa8, 0x9d, 0x10, 0x12, 0x46, 0x28, 0x46,
+	0x03, 0x9c, 0xda, 0xc3, 0x76, 0x1f, 0x33, 0xd4, 0xb6, 0x07, 0x38, 0xc6, 0x34, 0xa0, 0x56, 0x92,
+	0x12, 0x46, 0xe0, 0x3b, 0x0a, 0x6a, 0xcd, 0x40, 0x2d, 0x05, 0x3d, 0xb8, 0x37, 0x20, 0x03, 0x22,
+	0x70, 0x36, 0xff, 0x4f, 0x52, 0x0e, 0x1e, 0x0c, 0x08, 0x19, 0x84, 0xd8, 0x16, 0x5f, 0xfd, 0xec,
+	0xdc, 0x46, 0xf1, 0x28, 0x3f, 0xf2, 0x84, 0x9c, 0x2b, 0x39, 0xf2, 0x43, 0x1d, 0x35, 0x16, 0x59,
+	0x7e, 0x96, 0x22, 0x16, 0x90, 0x58, 0x9d, 0x1b, 0x8b, 0xe7, 0x2c, 0x88, 0x30, 0x65, 0x28, 0x4a,
+	0x72, 0x01, 0x29, 0x67, 0xf7, 0x11, 0xc5, 0x45, 0x32, 0x1e, 0x09, 0x72, 0x01, 0x6b, 0x59, 0xd2,
+	0x11, 0xf1, 0xb3, 0x10, 0xbb, 0x29, 0xc9, 0x18, 0x96, 0x78, 0xf3, 0xbf, 0x12, 0xa8, 0x76, 0x51,
+	0x8a, 0x22, 0x0a, 0x7f, 0xd5, 0x40, 0x9d, 0xb3, 0x5c, 0x2f, 0xc5, 0x22, 0x26, 0xf7, 0x1c, 0x63,
+	0x5d, 0x6b, 0x96, 0x5b, 0xb5, 0xe3, 0x07, 0x96, 0x4a, 0x83, 0xfb, 0xcd, 0x2b, 0x63, 0x9d, 0x92,
+	0x20, 0xee, 0x7c, 0xf5, 0x72, 0x6c, 0x6c, 0x4c, 0xc6, 0x86, 0x3e, 0x42, 0x51, 0xf8, 0xa9, 0x79,
+	0x4d, 0xc1, 0xfc, 0xed, 0xb5, 0xd1, 0x1a, 0x04, 0xec, 0xc7, 0xac, 0x6f, 0x79, 0x24, 0x52, 0xf5,
+	0x50, 0x7f, 0x0e, 0xa9, 0x7f, 0x61, 0xb3, 0x51, 0x82, 0xa9, 0x10, 0xa3, 0xce, 0x1e, 0xe7, 0x9f,
+	0x2a, 0xfa, 0x53, 0x8c, 0xe1, 0x10, 0xec, 0x33, 0x74, 0x81, 0x53, 0x2e, 0xe5, 0x26, 0x22, 0x52,
+	0xbd, 0xd4, 0xd4, 0x5a, 0xb5, 0xe3, 0x27, 0xd6, 0x92, 0xae, 0x59, 0x3d, 0x4e, 0x7a, 0x8a, 0xb1,
+	0x4c, 0xae, 0x63, 0xa8, 0x28, 0xdf, 0x96, 0x51, 0x2e, 0x4a, 0x9a, 0xce, 0x2e, 0x9b, 0x23, 0xc0,
+	0x1e, 0xb8, 0x8f, 0x23, 0x9c, 0x0e, 0x70, 0xec, 0x8d, 0xdc, 0x04, 0x65, 0x14, 0xbb, 0xc8, 0x8f,
+	0x82, 0x58, 0x2f, 0x37, 0xb5, 0xd6, 0x56, 0xa7, 0x39, 0x19, 0x1b, 0x0f, 0xa5, 0xd6, 0x8d, 0x30,
+	0xd3, 0xb9, 0x5b, 0xd8, 0xbb, 0xdc, 0x7c, 0x22, 0xac, 0x7f, 0x96, 0xc0, 0xee, 0x7c, 0x64, 0x70,
+	0x08, 0xea, 0x3e, 0x3e, 0x47, 0x59, 0xc8, 0xdc, 0x22, 0x2a, 0x5d, 0x13, 0x4e, 0xbe, 0xe4, 0x41,
+	0xff, 0x33, 0x36, 0x1e, 0xdd, 0xa2, 0x7c, 0x67, 0xd8, 0x9b, 0x36, 0xe1, 0x9a, 0xa0, 0xe9, 0xec,
+	0x29, 0x5b, 0xee, 0x1d, 0xfe, 0xa2, 0x81, 0xfb, 0x3e, 0x8e, 0x49, 0xe4, 0x26, 0x28, 0x48, 0xa7,
+	0x50, 0x5e, 0x5e, 0xde, 0x72, 0x6b, 0x69, 0x79, 0xcf, 0x38, 0xb3, 0x8b, 0x82, 0x34, 0xd7, 0xeb,
+	0xbc, 0xaf, 0x2a, 0xfc, 0x30, 0x0f, 0xe1, 0x06, 0x69, 0xd3, 0x81, 0xfe, 0x22, 0x91, 0xc2, 0xcf,
+	0xc0, 0x0e, 0xef, 0x84, 0x47, 0xc2, 0x10, 0x7b, 0x8c, 0xa4, 0xaa, 0xc4, 0xfa, 0x64, 0x6c, 0xdc,
+	0x93, 0x62, 0x73, 0xc7, 0xa6, 0xb3, 0x7d, 0x8e, 0xf1, 0x69, 0xf1, 0xf9, 0x87, 0x06, 0xea, 0xd7,
+	0xc2, 0x81, 0x8f, 0x41, 0x55, 0xb8, 0x3a, 0x52, 0xb5, 0xac, 0x4f, 0xc6, 0xc6, 0xce, 0x4c, 0x68,
+	0x47, 0xa6, 0xa3, 0x00, 0x05, 0xb4, 0xad, 0x97, 0x6e, 0x84, 0xb6, 0x73, 0x68, 0x1b, 0xba, 0x60,
+	0x6b, 0xda, 0x24, 0x19, 0x66, 0x67, 0xed, 0x26, 0xed, 0x2f, 0xdc, 0x41, 0xd3, 0xd9, 0xcc, 0x2f,
+	0x9f, 0xf9, 0x7f, 0x05, 0x6c, 0x7f, 0x2e, 0x77, 0xd3, 0xb7, 0x0c, 0x31, 0x0c, 0x9b, 0x60, 0x3b,
+	0xc6, 0xcf, 0x99, 0x2b, 0xe6, 0x2a, 0xf0, 0x45, 0x36, 0x15, 0x07, 0x70, 0x5b, 0x97, 0x90, 0xf0,
+	0x0b, 0x1f, 0x9e, 0x80, 0xea, 0xdc, 0x5c, 0xbc, 0xb7, 0xb4, 0x71, 0x6a, 0x1e, 0x2a, 0x3c, 0x6a,
+	0x47, 0x11, 0xe1, 0x37, 0xa0, 0x26, 0xf4, 0xc5, 0x66, 0xa0, 0x7a, 0x59, 0x5c, 0x80, 0xd6, 0x52,
+	0x9d, 0xaf, 0xc5, 0x2e, 0x71, 0x38, 0x41, 0x89, 0x01, 0x0e, 0x13, 0x06, 0x0a, 0xb3, 0xd9, 0xa9,
+	0x65, 0x84, 0xa1, 0x90, 0xea, 0x95, 0x55, 0x9b, 0xe4, 0x88, 0xcb, 0xac, 0xb5, 0x2d, 0x8a, 0xa1,
+	0xed, 0x09, 0x17, 0xb0, 0x0b, 0xb6, 0x45, 0x1e, 0x43, 0x12, 0x66, 0x11, 0xa6, 0xfa, 0x5b, 0xc2,
+	0xe5, 0x87, 0xcb, 0x0b, 0x42, 0x48, 0xf8, 0x9d, 0xc0, 0xab, 0x3c, 0x6a, 0x49, 0x61, 0xa1, 0xf0,
+	0x11, 0xd8, 0x13, 0x53, 0xed, 0xe7, 0x0d, 0xa0, 0x7a, 0xb5, 0x59, 0x6e, 0x55, 0x9c, 0x1d, 0x69,
+	0x96, 0x3d, 0xa0, 0xf0, 0x19, 0xa8, 0xcf, 0xe2, 0x44, 0x90, 0xfa, 0x9d, 0x66, 0xb9, 0xb5, 0x7b,
+	0xfc, 0xc1, 0x4a, 0xf7, 0xbd, 0x51, 0x82, 0x9d, 0xbd, 0xa9, 0x20, 0xff, 0xa6, 0xd0, 0x03, 0x77,
+	0x67, 0x92, 0x71, 0xfb, 0x99, 0x77, 0x81, 0x19, 0xd5, 0x37, 0x45, 0x4e, 0x87, 0xb7, 0xcd, 0x49,
+	0xb0, 0x54, 0x66, 0xf5, 0x64, 0xc1, 0x4e, 0xcd, 0xdf, 0x4b, 0x00, 0x4c, 0xd1, 0xf0, 0x09, 0xb8,
+	0x33, 0x77, 0xd1, 0x3a, 0x70, 0x32, 0x36, 0x76, 0x67, 0x36, 0x7b, 0xe0, 0x9b, 0x4e, 0x35, 0x91,
+	0x17, 0x8f, 0x81, 0xaa, 0x8c, 0x4d, 0x2f, 0xad, 0x6a, 0xed, 0x89, 0x5a, 0x0e, 0x6a, 0xac, 0x24,
+	0x6d, 0xbd, 0x97, 0x41, 0xf9, 0x82, 0x3f, 0x83, 0x2d, 0xfa, 0x13, 0x4a, 0xe4, 0xaa, 0x2a, 0xaf,
+	0x72, 0x7c, 0xa6, 0x1c, 0xab, 0x99, 0x2b, 0x98, 0xeb, 0xf9, 0xde, 0xe4, 0x3c, 0xbe, 0xab, 0xcc,
+	0xbf, 0x34, 0xb0, 0xbf, 0x58, 0x5d, 0xf8, 0x3d, 0x00, 0x94, 0xa1, 0x94, 0xb9, 0xfc, 0xb5, 0x16,
+	0x85, 0xab, 0x1d, 0x1f, 0x58, 0xf2, 0x29, 0xb7, 0xf2, 0xa7, 0xdc, 0xea, 0xe5, 0x4f, 0x79, 0xe7,
+	0x5d, 0x15, 0x54, 0x5d, 0x05, 0x55, 0x70, 0xcd, 0x17, 0xaf, 0x0d, 0xcd, 0xd9, 0x12, 0x06, 0x0e,
+	0x87, 0xbe, 0x1a, 0xcc, 0xa2, 0xce, 0xda, 0x3a, 0xf7, 0xf9, 0x40, 0xf9, 0x81, 0x33, 0x0d, 0x54,
+	0xa5, 0x97, 0xd3, 0xaa, 0x70, 0xcf, 0x5e, 0x5e, 0x36, 0xb4, 0x57, 0x97, 0x0d, 0xed, 0xdf, 0xcb,
+	0x86, 0xf6, 0xe2, 0xaa, 0xb1, 0xf1, 0xea, 0xaa, 0xb1, 0xf1, 0xf7, 0x55, 0x63, 0xe3, 0x87, 0x4f,
+	0x66, 0x4a, 0xa4, 0x9c, 0x1e, 0x86, 0xa8, 0x4f, 0xf3, 0x0f, 0x7b, 0xd8, 0xfe, 0xd8, 0x7e, 0x3e,
+	0xf7, 0x63, 0x43, 0xd4, 0xad, 0x5f, 0x15, 0x69, 0x7f, 0xf4, 0x66, 0x00, 0x5e, 0xbd, 0xd5, 0x93,
+	0x85, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -197,6 +539,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EmergencyPauseAdmin) > 0 {
+		i -= len(m.EmergencyPauseAdmin)
+		copy(dAtA[i:], m.EmergencyPauseAdmin)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EmergencyPauseAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.TakerFeeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.PoolCreationFee) > 0 {
 		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -204,121 +563,1342 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TakerFeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeCollector) > 0 {
+		i -= len(m.FeeCollector)
+		copy(dAtA[i:], m.FeeCollector)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FeeCollector)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DenomPairTakerFees) > 0 {
+		for iNdEx := len(m.DenomPairTakerFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPairTakerFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.DefaultTakerFee.Size()
+		i -= size
+		if _, err := m.DefaultTakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom1) > 0 {
+		i -= len(m.Denom1)
+		copy(dAtA[i:], m.Denom1)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom0) > 0 {
+		i -= len(m.Denom0)
+		copy(dAtA[i:], m.Denom0)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom0)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolVolumeBuckets) > 0 {
+		for iNdEx := len(m.PoolVolumeBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolVolumeBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PausedPoolTypes) > 0 {
+		dAtA3 := make([]byte, len(m.PausedPoolTypes)*10)
+		var j2 int
+		for _, num := range m.PausedPoolTypes {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintGenesis(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PausedPoolIds) > 0 {
+		dAtA5 := make([]byte, len(m.PausedPoolIds)*10)
+		var j4 int
+		for _, num := range m.PausedPoolIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintGenesis(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PoolVolumes) > 0 {
+		for iNdEx := len(m.PoolVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TakerFeeTotals) > 0 {
+		for iNdEx := len(m.TakerFeeTotals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeeTotals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PoolRoutes) > 0 {
+		for iNdEx := len(m.PoolRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.NextPoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SwapFees) > 0 {
+		for iNdEx := len(m.SwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolVolumeBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolVolumeBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVolumeBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PoolVolume.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintGenesis(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolCreationFee) > 0 {
+		for _, e := range m.PoolCreationFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.TakerFeeParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.EmergencyPauseAdmin)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TakerFeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DefaultTakerFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DenomPairTakerFees) > 0 {
+		for _, e := range m.DenomPairTakerFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.FeeCollector)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomPairTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom0)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom1)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextPoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPoolId))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PoolRoutes) > 0 {
+		for _, e := range m.PoolRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TakerFeeTotals) > 0 {
+		for _, e := range m.TakerFeeTotals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolVolumes) > 0 {
+		for _, e := range m.PoolVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedPoolIds) > 0 {
+		l = 0
+		for _, e := range m.PausedPoolIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.PausedPoolTypes) > 0 {
+		l = 0
+		for _, e := range m.PausedPoolTypes {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.PoolVolumeBuckets) > 0 {
+		for _, e := range m.PoolVolumeBuckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SwapFees) > 0 {
+		for _, e := range m.SwapFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolVolumeBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.PoolVolume.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
+			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyPauseAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyPauseAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultTakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairTakerFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPairTakerFees = append(m.DenomPairTakerFees, DenomPairTakerFee{})
+			if err := m.DenomPairTakerFees[len(m.DenomPairTakerFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPairTakerFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPairTakerFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPairTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPoolId", wireType)
+			}
+			m.NextPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolRoutes = append(m.PoolRoutes, ModuleRoute{})
+			if err := m.PoolRoutes[len(m.PoolRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeeTotals = append(m.TakerFeeTotals, types.Coin{})
+			if err := m.TakerFeeTotals[len(m.TakerFeeTotals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolVolumes = append(m.PoolVolumes, PoolVolume{})
+			if err := m.PoolVolumes[len(m.PoolVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PausedPoolIds = append(m.PausedPoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PausedPoolIds) == 0 {
+					m.PausedPoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PausedPoolIds = append(m.PausedPoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedPoolIds", wireType)
 			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PoolRoutes) > 0 {
-		for iNdEx := len(m.PoolRoutes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PoolRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+		case 7:
+			if wireType == 0 {
+				var v PoolType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PoolType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				m.PausedPoolTypes = append(m.PausedPoolTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PausedPoolTypes) == 0 {
+					m.PausedPoolTypes = make([]PoolType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PoolType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PoolType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PausedPoolTypes = append(m.PausedPoolTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedPoolTypes", wireType)
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.NextPoolId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PoolCreationFee) > 0 {
-		for _, e := range m.PoolCreationFee {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolumeBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolVolumeBuckets = append(m.PoolVolumeBuckets, PoolVolumeBucket{})
+			if err := m.PoolVolumeBuckets[len(m.PoolVolumeBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NextPoolId != 0 {
-		n += 1 + sovGenesis(uint64(m.NextPoolId))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.PoolRoutes) > 0 {
-		for _, e := range m.PoolRoutes {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PoolVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -341,15 +1921,34 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -376,8 +1975,42 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
-			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapFees = append(m.SwapFees, types.Coin{})
+			if err := m.SwapFees[len(m.SwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -402,7 +2035,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *PoolVolumeBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -425,34 +2058,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolVolumeBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolVolumeBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPoolId", wireType)
-			}
-			m.NextPoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextPoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -479,13 +2093,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolRoutes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolume", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -512,8 +2126,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolRoutes = append(m.PoolRoutes, ModuleRoute{})
-			if err := m.PoolRoutes[len(m.PoolRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoolVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeSetPoolsPaused = "SetPoolsPaused"
+)
+
+// Init registers the proposal to pause or unpause pools.
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetPoolsPaused)
+	govtypes.RegisterProposalTypeCodec(&SetPoolsPausedProposal{}, "osmosis/SetPoolsPausedProposal")
+}
+
+var _ govtypes.Content = &SetPoolsPausedProposal{}
+
+// NewSetPoolsPausedProposal returns a new instance of a set pools paused proposal struct.
+func NewSetPoolsPausedProposal(title, description string, poolIds []uint64, poolTypes []PoolType, paused bool) govtypes.Content {
+	return &SetPoolsPausedProposal{
+		Title:       title,
+		Description: description,
+		PoolIds:     poolIds,
+		PoolTypes:   poolTypes,
+		Paused:      paused,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *SetPoolsPausedProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetPoolsPausedProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetPoolsPausedProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetPoolsPausedProposal) ProposalType() string {
+	return ProposalTypeSetPoolsPaused
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *SetPoolsPausedProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.PoolIds) == 0 && len(p.PoolTypes) == 0 {
+		return ErrNoPoolsToPause
+	}
+
+	return ValidatePausedPools(p.PoolIds, p.PoolTypes)
+}
+
+// String returns a string containing the set pools paused proposal.
+func (p SetPoolsPausedProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Pools Paused Proposal:
+  Title:       %s
+  Description: %s
+  PoolIds:     %v
+  PoolTypes:   %v
+  Paused:      %t
+`, p.Title, p.Description, p.PoolIds, p.PoolTypes, p.Paused))
+	return b.String()
+}
@@ -0,0 +1,646 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/poolmanager/v1beta1/gov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SetPoolsPausedProposal is a gov Content type for pausing or unpausing swaps
+// and joins on the given pools and on every pool of the given pool types.
+// Exits and withdrawals are never paused.
+type SetPoolsPausedProposal struct {
+	Title       string     `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolIds     []uint64   `protobuf:"varint,3,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+	PoolTypes   []PoolType `protobuf:"varint,4,rep,packed,name=pool_types,json=poolTypes,proto3,enum=osmosis.poolmanager.v1beta1.PoolType" json:"pool_types,omitempty" yaml:"pool_types"`
+	Paused      bool       `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+}
+
+func (m *SetPoolsPausedProposal) Reset()      { *m = SetPoolsPausedProposal{} }
+func (*SetPoolsPausedProposal) ProtoMessage() {}
+func (*SetPoolsPausedProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c95b3c1cda2a8632, []int{0}
+}
+func (m *SetPoolsPausedProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPoolsPausedProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPoolsPausedProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPoolsPausedProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPoolsPausedProposal.Merge(m, src)
+}
+func (m *SetPoolsPausedProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPoolsPausedProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPoolsPausedProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPoolsPausedProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SetPoolsPausedProposal)(nil), "osmosis.poolmanager.v1beta1.SetPoolsPausedProposal")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/poolmanager/v1beta1/gov.proto", fileDescriptor_c95b3c1cda2a8632)
+}
+
+var fileDescriptor_c95b3c1cda2a8632 = []byte{
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xbf, 0x4a, 0xc3, 0x50,
+	0x14, 0xc6, 0x93, 0xfe, 0xb3, 0xbd, 0xfe, 0xa3, 0xb1, 0x4a, 0xa8, 0x90, 0x84, 0x40, 0x21, 0x0e,
+	0xde, 0x50, 0x45, 0x84, 0x8e, 0xd9, 0xdc, 0x6a, 0x74, 0xd2, 0xa1, 0x24, 0xcd, 0x25, 0x06, 0x92,
+	0x9e, 0x4b, 0xee, 0x4d, 0xb1, 0x6f, 0xe0, 0xe8, 0x24, 0x8e, 0x7d, 0x1c, 0xc7, 0x8e, 0x4e, 0x45,
+	0xda, 0xc5, 0xb9, 0x4f, 0x20, 0xb9, 0x4d, 0xa1, 0x2e, 0xdd, 0xce, 0xf9, 0xce, 0xef, 0x7c, 0xf9,
+	0x4e, 0x2e, 0xea, 0x00, 0x4b, 0x80, 0x45, 0xcc, 0xa6, 0x00, 0x71, 0xe2, 0x8d, 0xbc, 0x90, 0xa4,
+	0xf6, 0xb8, 0xeb, 0x13, 0xee, 0x75, 0xed, 0x10, 0xc6, 0x98, 0xa6, 0xc0, 0x41, 0x39, 0x2f, 0x30,
+	0xbc, 0x85, 0xe1, 0x02, 0x6b, 0xb7, 0x42, 0x08, 0x41, 0x70, 0x76, 0x5e, 0xad, 0x57, 0xda, 0x78,
+	0x97, 0x73, 0x02, 0x41, 0x16, 0x93, 0x41, 0x0a, 0x19, 0x27, 0x6b, 0xde, 0xfc, 0x28, 0xa1, 0xb3,
+	0x07, 0xc2, 0xfb, 0x00, 0x31, 0xeb, 0x7b, 0x19, 0x23, 0x41, 0x3f, 0x05, 0x0a, 0xcc, 0x8b, 0x95,
+	0x16, 0xaa, 0xf2, 0x88, 0xc7, 0x44, 0x95, 0x0d, 0xd9, 0x6a, 0xb8, 0xeb, 0x46, 0x31, 0xd0, 0x7e,
+	0x40, 0xd8, 0x30, 0x8d, 0x28, 0x8f, 0x60, 0xa4, 0x96, 0xc4, 0x6c, 0x5b, 0x52, 0x30, 0xaa, 0xe7,
+	0x1f, 0x1f, 0x44, 0x01, 0x53, 0xcb, 0x46, 0xd9, 0xaa, 0x38, 0x27, 0xab, 0xb9, 0x7e, 0x3c, 0xf1,
+	0x92, 0xb8, 0x67, 0x6e, 0x26, 0xa6, 0xbb, 0x97, 0x97, 0x77, 0x01, 0x53, 0x9e, 0x11, 0x12, 0x2a,
+	0x9f, 0x50, 0xc2, 0xd4, 0x8a, 0x51, 0xb6, 0x8e, 0xae, 0x3a, 0x78, 0xc7, 0xe9, 0x38, 0x4f, 0xfb,
+	0x38, 0xa1, 0xc4, 0x39, 0x5d, 0xcd, 0xf5, 0xe6, 0x96, 0xb1, 0xb0, 0x30, 0xdd, 0x06, 0x2d, 0x00,
+	0xa6, 0x5c, 0xa0, 0x1a, 0x15, 0x67, 0xa9, 0x55, 0x43, 0xb6, 0xea, 0x4e, 0x73, 0x35, 0xd7, 0x0f,
+	0x8b, 0x0d, 0xa1, 0x9b, 0x6e, 0x01, 0xf4, 0x0e, 0xde, 0xa6, 0xba, 0xf4, 0x39, 0xd5, 0xa5, 0xdf,
+	0xa9, 0x2e, 0x3b, 0xf7, 0x5f, 0x0b, 0x4d, 0x9e, 0x2d, 0x34, 0xf9, 0x67, 0xa1, 0xc9, 0xef, 0x4b,
+	0x4d, 0x9a, 0x2d, 0x35, 0xe9, 0x7b, 0xa9, 0x49, 0x4f, 0xb7, 0x61, 0xc4, 0x5f, 0x32, 0x1f, 0x0f,
+	0x21, 0xb1, 0x8b, 0x94, 0x97, 0xb1, 0xe7, 0xb3, 0x4d, 0x63, 0x8f, 0xbb, 0x37, 0xf6, 0xeb, 0xbf,
+	0x07, 0x10, 0xb9, 0xfc, 0x9a, 0xf8, 0xe5, 0xd7, 0x7f, 0x03, 0x00, 0xe5, 0xb3, 0xaf, 0x14, 0xfe,
+	0x01, 0x00, 0x00,
+}
+
+func (this *SetPoolsPausedProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetPoolsPausedProposal)
+	if !ok {
+		that2, ok := that.(SetPoolsPausedProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.PoolIds) != len(that1.PoolIds) {
+		return false
+	}
+	for i := range this.PoolIds {
+		if this.PoolIds[i] != that1.PoolIds[i] {
+			return false
+		}
+	}
+	if len(this.PoolTypes) != len(that1.PoolTypes) {
+		return false
+	}
+	for i := range this.PoolTypes {
+		if this.PoolTypes[i] != that1.PoolTypes[i] {
+			return false
+		}
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	return true
+}
+func (m *SetPoolsPausedProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPoolsPausedProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPoolsPausedProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PoolTypes) > 0 {
+		dAtA2 := make([]byte, len(m.PoolTypes)*10)
+		var j1 int
+		for _, num := range m.PoolTypes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGov(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PoolIds) > 0 {
+		dAtA4 := make([]byte, len(m.PoolIds)*10)
+		var j3 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintGov(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SetPoolsPausedProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
+	if len(m.PoolTypes) > 0 {
+		l = 0
+		for _, e := range m.PoolTypes {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SetPoolsPausedProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPoolsPausedProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPoolsPausedProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v PoolType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PoolType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolTypes = append(m.PoolTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PoolTypes) == 0 {
+					m.PoolTypes = make([]PoolType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PoolType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PoolType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolTypes = append(m.PoolTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTypes", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/proto"
 )
 
@@ -12,6 +14,16 @@ const (
 	StoreKey = ModuleName
 
 	RouterKey = ModuleName
+
+	KeySeparator = "|"
+
+	// VolumeBucketDuration is the length of the time buckets in which the volume
+	// of a pool is tracked for rolling windows.
+	VolumeBucketDuration = time.Hour
+
+	// MaxVolumeWindow is the longest window the volume of a pool can be queried over.
+	// Volume buckets older than this are pruned.
+	MaxVolumeWindow = 7 * 24 * time.Hour
 )
 
 var (
@@ -20,6 +32,21 @@ var (
 
 	// SwapModuleRouterPrefix defines prefix to store pool id to swap module mappings.
 	SwapModuleRouterPrefix = []byte{0x02}
+
+	// TakerFeeTotalsPrefix defines prefix to store the accumulated taker fee per denom.
+	TakerFeeTotalsPrefix = []byte{0x03}
+
+	// PoolVolumePrefix defines prefix to store the lifetime volume and swap fees per pool.
+	PoolVolumePrefix = []byte{0x04}
+
+	// PoolVolumeBucketPrefix defines prefix to store the volume and swap fees per pool and time bucket.
+	PoolVolumeBucketPrefix = []byte{0x05}
+
+	// PausedPoolsPrefix defines prefix to store the ids of the paused pools.
+	PausedPoolsPrefix = []byte{0x06}
+
+	// PausedPoolTypesPrefix defines prefix to store the paused pool types.
+	PausedPoolTypesPrefix = []byte{0x07}
 )
 
 // ModuleRouteToBytes serializes moduleRoute to bytes.
@@ -27,6 +54,37 @@ func FormatModuleRouteKey(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%d", SwapModuleRouterPrefix, poolId))
 }
 
+// FormatTakerFeeTotalKey returns the key storing the accumulated taker fee for denom.
+func FormatTakerFeeTotalKey(denom string) []byte {
+	return []byte(fmt.Sprintf("%s%s", TakerFeeTotalsPrefix, denom))
+}
+
+// FormatPoolVolumeKey returns the key storing the lifetime volume of the given pool.
+func FormatPoolVolumeKey(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", PoolVolumePrefix, poolId))
+}
+
+// FormatPoolVolumeBucketPrefix returns the prefix of all volume buckets of the given pool.
+func FormatPoolVolumeBucketPrefix(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d%s", PoolVolumeBucketPrefix, poolId, KeySeparator))
+}
+
+// FormatPoolVolumeBucketKey returns the key storing the volume of the given pool in the bucket
+// starting at bucketStart. Buckets of a pool are ordered by their start time.
+func FormatPoolVolumeBucketKey(poolId uint64, bucketStart time.Time) []byte {
+	return append(FormatPoolVolumeBucketPrefix(poolId), sdk.Uint64ToBigEndian(uint64(bucketStart.Unix()))...)
+}
+
+// FormatPausedPoolKey returns the key marking the given pool as paused.
+func FormatPausedPoolKey(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", PausedPoolsPrefix, poolId))
+}
+
+// FormatPausedPoolTypeKey returns the key marking the given pool type as paused.
+func FormatPausedPoolTypeKey(poolType PoolType) []byte {
+	return []byte(fmt.Sprintf("%s%d", PausedPoolTypesPrefix, poolType))
+}
+
 // ParseModuleRouteFromBz parses the raw bytes into ModuleRoute.
 // Returns error if fails to parse or if the bytes are empty.
 func ParseModuleRouteFromBz(bz []byte) (ModuleRoute, error) {
@@ -2,20 +2,54 @@ package types
 
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
-type PoolCreationListener interface {
-	// AfterPoolCreated is called after CreatePool
+// PoolListener defines an interface for modules that need to be notified of the creation of,
+// swaps in and liquidity changes in pools of any type. The poolmanager and every pool module
+// invoke the same listeners, so a module only has to subscribe once to observe all pools.
+type PoolListener interface {
+	// AfterPoolCreated is called after a pool of any type is created.
 	AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64)
+
+	// AfterSwap is called after a swap in a pool, with the tokens sent to and received from the pool.
+	AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins)
+
+	// AfterLiquidityAdded is called after liquidity is added to a pool, with the tokens sent to the pool.
+	AfterLiquidityAdded(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, liquidity sdk.Coins)
+
+	// AfterLiquidityRemoved is called after liquidity is removed from a pool, with the tokens sent from the pool.
+	AfterLiquidityRemoved(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, liquidity sdk.Coins)
 }
 
-type PoolCreationListeners []PoolCreationListener
+var _ PoolListener = PoolListeners{}
 
-func (l PoolCreationListeners) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+// PoolListeners combines multiple pool listeners.
+// All listener functions are run in array sequence.
+type PoolListeners []PoolListener
+
+// NewPoolListeners creates listeners that are invoked by every pool module.
+func NewPoolListeners(listeners ...PoolListener) PoolListeners {
+	return listeners
+}
+
+func (l PoolListeners) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
 	for i := range l {
 		l[i].AfterPoolCreated(ctx, sender, poolId)
 	}
 }
 
-// Creates hooks for the Gamm Module.
-func NewPoolCreationListeners(listeners ...PoolCreationListener) PoolCreationListeners {
-	return listeners
+func (l PoolListeners) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	for i := range l {
+		l[i].AfterSwap(ctx, sender, poolId, input, output)
+	}
+}
+
+func (l PoolListeners) AfterLiquidityAdded(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, liquidity sdk.Coins) {
+	for i := range l {
+		l[i].AfterLiquidityAdded(ctx, sender, poolId, liquidity)
+	}
+}
+
+func (l PoolListeners) AfterLiquidityRemoved(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, liquidity sdk.Coins) {
+	for i := range l {
+		l[i].AfterLiquidityRemoved(ctx, sender, poolId, liquidity)
+	}
 }
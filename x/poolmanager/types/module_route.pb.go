@@ -35,18 +35,23 @@ const (
 	// Concentrated is the pool model specific to concentrated liquidity. It is
 	// defined in x/concentrated-liquidity.
 	Concentrated PoolType = 2
+	// CosmWasm is the pool model specific to CosmWasm. It is defined in
+	// x/cosmwasmpool.
+	CosmWasm PoolType = 3
 )
 
 var PoolType_name = map[int32]string{
 	0: "Balancer",
 	1: "Stableswap",
 	2: "Concentrated",
+	3: "CosmWasm",
 }
 
 var PoolType_value = map[string]int32{
 	"Balancer":     0,
 	"Stableswap":   1,
 	"Concentrated": 2,
+	"CosmWasm":     3,
 }
 
 func (x PoolType) String() string {
@@ -124,27 +129,27 @@ func init() {
 }
 
 var fileDescriptor_96bfcc7b6d387cee = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcb, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc8, 0xcf, 0xcf, 0xc9, 0x4d, 0xcc, 0x4b, 0x4c, 0x4f, 0x2d, 0xd2,
-	0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x8d, 0x2f,
-	0xca, 0x2f, 0x2d, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x86, 0xaa, 0xd7, 0x43,
-	0x52, 0xaf, 0x07, 0x55, 0x2f, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa7, 0x0f, 0x62, 0x41,
-	0xb4, 0x28, 0x35, 0x31, 0x72, 0x71, 0xfb, 0x82, 0x4d, 0x0a, 0x02, 0x19, 0x24, 0xe4, 0xc4, 0xc5,
-	0x09, 0xd2, 0x1c, 0x5f, 0x52, 0x59, 0x90, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x67, 0xa4, 0xaa,
-	0x87, 0xc7, 0x58, 0xbd, 0x80, 0xfc, 0xfc, 0x9c, 0x90, 0xca, 0x82, 0xd4, 0x20, 0x8e, 0x02, 0x28,
-	0x4b, 0x48, 0x9f, 0x8b, 0x1d, 0x6c, 0x46, 0x66, 0x8a, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x8b, 0x93,
-	0xd8, 0x89, 0x7b, 0xf2, 0x8c, 0x9f, 0xee, 0xc9, 0xf3, 0x55, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0x41,
-	0x25, 0x95, 0x82, 0xd8, 0x40, 0x2c, 0xcf, 0x14, 0x2d, 0x07, 0x2e, 0x0e, 0x98, 0x31, 0x42, 0x3c,
-	0x5c, 0x1c, 0x4e, 0x89, 0x39, 0x89, 0x79, 0xc9, 0xa9, 0x45, 0x02, 0x0c, 0x42, 0x7c, 0x5c, 0x5c,
-	0xc1, 0x25, 0x89, 0x49, 0x39, 0xa9, 0xc5, 0xe5, 0x89, 0x05, 0x02, 0x8c, 0x42, 0x02, 0x5c, 0x3c,
-	0xce, 0xf9, 0x79, 0xc9, 0xa9, 0x79, 0x25, 0x45, 0x89, 0x25, 0xa9, 0x29, 0x02, 0x4c, 0x52, 0x2c,
-	0x1d, 0x8b, 0xe5, 0x18, 0x9c, 0x02, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1,
-	0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21,
-	0xca, 0x3c, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xea, 0x0f, 0xdd,
-	0x9c, 0xc4, 0xa4, 0x62, 0x18, 0x47, 0xbf, 0xcc, 0xd0, 0x54, 0xbf, 0x02, 0x25, 0x84, 0x41, 0x7e,
-	0x2f, 0x4e, 0x62, 0x03, 0x07, 0x90, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x4b, 0x53, 0xff,
-	0x85, 0x01, 0x00, 0x00,
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xc1, 0x4a, 0x2b, 0x31,
+	0x18, 0x85, 0x27, 0xbd, 0xa5, 0xb7, 0xc6, 0x52, 0x86, 0x41, 0xa4, 0x54, 0x48, 0x4b, 0x41, 0x28,
+	0x82, 0x09, 0x55, 0x44, 0x70, 0x39, 0x5d, 0xb9, 0x50, 0xb4, 0x0a, 0x82, 0x9b, 0x92, 0xe9, 0x84,
+	0xb1, 0x90, 0xcc, 0x1f, 0x26, 0x69, 0xb5, 0x5b, 0x57, 0x2e, 0x7d, 0x07, 0x5f, 0xa6, 0xcb, 0x2e,
+	0x5d, 0x15, 0x69, 0xdf, 0xc0, 0x27, 0x90, 0x8c, 0x53, 0xd0, 0x8d, 0xbb, 0x13, 0xf2, 0x9d, 0x0f,
+	0xfe, 0x83, 0x29, 0x18, 0x05, 0x66, 0x6c, 0x98, 0x06, 0x90, 0x8a, 0xa7, 0x3c, 0x11, 0x19, 0x9b,
+	0xf6, 0x22, 0x61, 0x79, 0x8f, 0x29, 0x88, 0x27, 0x52, 0x0c, 0x33, 0x98, 0x58, 0x41, 0x75, 0x06,
+	0x16, 0x82, 0xbd, 0x82, 0xa7, 0x3f, 0x78, 0x5a, 0xf0, 0xcd, 0x9d, 0x04, 0x12, 0xc8, 0x39, 0xe6,
+	0xd2, 0x77, 0xa5, 0xf3, 0x8c, 0xf0, 0xf6, 0x45, 0x6e, 0x1a, 0x38, 0x51, 0x10, 0xe2, 0x2d, 0x57,
+	0x1e, 0xda, 0x99, 0x16, 0x0d, 0xd4, 0x46, 0xdd, 0xfa, 0xd1, 0x3e, 0xfd, 0x43, 0x4b, 0xaf, 0x00,
+	0xe4, 0xed, 0x4c, 0x8b, 0x41, 0x55, 0x17, 0x29, 0x60, 0xf8, 0x7f, 0xee, 0x18, 0xc7, 0x8d, 0x52,
+	0x1b, 0x75, 0xcb, 0xe1, 0xee, 0x7c, 0xd9, 0x42, 0x9f, 0xcb, 0x56, 0x7d, 0xc6, 0x95, 0x3c, 0xeb,
+	0x14, 0x9f, 0x9d, 0x41, 0xc5, 0xa5, 0xf3, 0xf8, 0xe0, 0x12, 0x57, 0x37, 0x9a, 0xa0, 0x86, 0xab,
+	0x21, 0x97, 0x3c, 0x1d, 0x89, 0xcc, 0xf7, 0x82, 0x3a, 0xc6, 0x37, 0x96, 0x47, 0x52, 0x98, 0x47,
+	0xae, 0x7d, 0x14, 0xf8, 0xb8, 0xd6, 0x87, 0x74, 0x24, 0x52, 0x9b, 0x71, 0x2b, 0x62, 0xbf, 0xe4,
+	0xf8, 0x3e, 0x18, 0x75, 0xc7, 0x8d, 0xf2, 0xff, 0x35, 0xcb, 0x2f, 0x6f, 0xc4, 0x0b, 0xaf, 0xe7,
+	0x2b, 0x82, 0x16, 0x2b, 0x82, 0x3e, 0x56, 0x04, 0xbd, 0xae, 0x89, 0xb7, 0x58, 0x13, 0xef, 0x7d,
+	0x4d, 0xbc, 0xfb, 0xd3, 0x64, 0x6c, 0x1f, 0x26, 0x11, 0x1d, 0x81, 0x62, 0xc5, 0x55, 0x87, 0x92,
+	0x47, 0x66, 0xf3, 0x60, 0xd3, 0xde, 0x09, 0x7b, 0xfa, 0xb5, 0xb7, 0x5b, 0xc2, 0x44, 0x95, 0x7c,
+	0xae, 0xe3, 0xaf, 0x01, 0x00, 0x92, 0x27, 0x7b, 0x9c, 0x93, 0x01, 0x00, 0x00,
 }
 
 func (m *ModuleRoute) Marshal() (dAtA []byte, err error) {
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -9,6 +11,11 @@ import (
 const (
 	TypeMsgSwapExactAmountIn  = "swap_exact_amount_in"
 	TypeMsgSwapExactAmountOut = "swap_exact_amount_out"
+
+	TypeMsgSplitRouteSwapExactAmountIn  = "split_route_swap_exact_amount_in"
+	TypeMsgSplitRouteSwapExactAmountOut = "split_route_swap_exact_amount_out"
+
+	TypeMsgEmergencyPausePools = "emergency_pause_pools"
 )
 
 var _ sdk.Msg = &MsgSwapExactAmountIn{}
@@ -87,3 +94,134 @@ func (msg MsgSwapExactAmountOut) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSplitRouteSwapExactAmountIn{}
+
+func (msg MsgSplitRouteSwapExactAmountIn) Route() string { return RouterKey }
+func (msg MsgSplitRouteSwapExactAmountIn) Type() string  { return TypeMsgSplitRouteSwapExactAmountIn }
+func (msg MsgSplitRouteSwapExactAmountIn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenInDenom); err != nil {
+		return err
+	}
+
+	if err := ValidateSwapAmountInSplitRoute(msg.Routes); err != nil {
+		return err
+	}
+
+	if !msg.TokenOutMinAmount.IsPositive() {
+		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgSplitRouteSwapExactAmountIn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSplitRouteSwapExactAmountIn) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSplitRouteSwapExactAmountOut{}
+
+func (msg MsgSplitRouteSwapExactAmountOut) Route() string { return RouterKey }
+func (msg MsgSplitRouteSwapExactAmountOut) Type() string  { return TypeMsgSplitRouteSwapExactAmountOut }
+func (msg MsgSplitRouteSwapExactAmountOut) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenOutDenom); err != nil {
+		return err
+	}
+
+	if err := ValidateSwapAmountOutSplitRoute(msg.Routes); err != nil {
+		return err
+	}
+
+	if !msg.TokenInMaxAmount.IsPositive() {
+		return nonPositiveAmountError{msg.TokenInMaxAmount.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgSplitRouteSwapExactAmountOut) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSplitRouteSwapExactAmountOut) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgEmergencyPausePools{}
+
+func (msg MsgEmergencyPausePools) Route() string { return RouterKey }
+func (msg MsgEmergencyPausePools) Type() string  { return TypeMsgEmergencyPausePools }
+func (msg MsgEmergencyPausePools) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if len(msg.PoolIds) == 0 && len(msg.PoolTypes) == 0 {
+		return ErrNoPoolsToPause
+	}
+
+	return ValidatePausedPools(msg.PoolIds, msg.PoolTypes)
+}
+
+func (msg MsgEmergencyPausePools) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgEmergencyPausePools) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// ValidatePausedPools validates that the given pool ids are positive, that
+// the given pool types are defined and that neither contains duplicates.
+func ValidatePausedPools(poolIds []uint64, poolTypes []PoolType) error {
+	seenPoolIds := make(map[uint64]struct{}, len(poolIds))
+	for _, poolId := range poolIds {
+		if poolId == 0 {
+			return fmt.Errorf("pool id must be positive")
+		}
+		if _, ok := seenPoolIds[poolId]; ok {
+			return fmt.Errorf("duplicate pool id (%d)", poolId)
+		}
+		seenPoolIds[poolId] = struct{}{}
+	}
+
+	seenPoolTypes := make(map[PoolType]struct{}, len(poolTypes))
+	for _, poolType := range poolTypes {
+		if _, ok := PoolType_name[int32(poolType)]; !ok {
+			return InvalidPoolTypeError{PoolType: poolType}
+		}
+		if _, ok := seenPoolTypes[poolType]; ok {
+			return fmt.Errorf("duplicate pool type (%s)", poolType)
+		}
+		seenPoolTypes[poolType] = struct{}{}
+	}
+
+	return nil
+}
@@ -273,6 +273,364 @@ func TestMsgSwapExactAmountOut(t *testing.T) {
 	}
 }
 
+func TestMsgSplitRouteSwapExactAmountIn(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+		properMsg := types.MsgSplitRouteSwapExactAmountIn{
+			Sender: addr1,
+			Routes: []types.SwapAmountInSplitRoute{
+				{
+					Pools: []types.SwapAmountInRoute{{
+						PoolId:        1,
+						TokenOutDenom: "test2",
+					}},
+					TokenInAmount: sdk.NewInt(100),
+				},
+				{
+					Pools: []types.SwapAmountInRoute{{
+						PoolId:        2,
+						TokenOutDenom: "test3",
+					}, {
+						PoolId:        3,
+						TokenOutDenom: "test2",
+					}},
+					TokenInAmount: sdk.NewInt(50),
+				},
+			},
+			TokenInDenom:      "test",
+			TokenOutMinAmount: sdk.NewInt(200),
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "split_route_swap_exact_amount_in")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgSplitRouteSwapExactAmountIn
+		expectedErr error
+		expectPass  bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+		},
+		{
+			name: "invalid token in denom",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.TokenInDenom = "1"
+				return msg
+			}),
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes = nil
+				return msg
+			}),
+			expectedErr: types.ErrEmptyRoutes,
+		},
+		{
+			name: "empty pools in a route",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools = nil
+				return msg
+			}),
+			expectedErr: types.ErrEmptyRoutes,
+		},
+		{
+			name: "zero route amount",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[0].TokenInAmount = sdk.ZeroInt()
+				return msg
+			}),
+		},
+		{
+			name: "duplicate routes",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1] = msg.Routes[0]
+				return msg
+			}),
+			expectedErr: types.ErrDuplicateRoutesNotAllowed,
+		},
+		{
+			name: "mismatched final token out",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools[1].TokenOutDenom = "test4"
+				return msg
+			}),
+			expectedErr: types.InvalidFinalTokenOutError{TokenOutGivenA: "test2", TokenOutGivenB: "test4"},
+		},
+		{
+			name: "zero amount criteria",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.TokenOutMinAmount = sdk.NewInt(0)
+				return msg
+			}),
+		},
+	}
+
+	for _, test := range tests {
+		err := test.msg.ValidateBasic()
+		if test.expectPass {
+			require.NoError(t, err, "test: %v", test.name)
+		} else if test.expectedErr != nil {
+			require.ErrorIs(t, err, test.expectedErr, "test: %v", test.name)
+		} else {
+			require.Error(t, err, "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSplitRouteSwapExactAmountOut(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+		properMsg := types.MsgSplitRouteSwapExactAmountOut{
+			Sender: addr1,
+			Routes: []types.SwapAmountOutSplitRoute{
+				{
+					Pools: []types.SwapAmountOutRoute{{
+						PoolId:       1,
+						TokenInDenom: "test",
+					}},
+					TokenOutAmount: sdk.NewInt(100),
+				},
+				{
+					Pools: []types.SwapAmountOutRoute{{
+						PoolId:       2,
+						TokenInDenom: "test",
+					}, {
+						PoolId:       3,
+						TokenInDenom: "test3",
+					}},
+					TokenOutAmount: sdk.NewInt(50),
+				},
+			},
+			TokenOutDenom:    "test2",
+			TokenInMaxAmount: sdk.NewInt(200),
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "split_route_swap_exact_amount_out")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgSplitRouteSwapExactAmountOut
+		expectedErr error
+		expectPass  bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+		},
+		{
+			name: "invalid token out denom",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.TokenOutDenom = "1"
+				return msg
+			}),
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Routes = []types.SwapAmountOutSplitRoute{}
+				return msg
+			}),
+			expectedErr: types.ErrEmptyRoutes,
+		},
+		{
+			name: "negative route amount",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Routes[1].TokenOutAmount = sdk.NewInt(-10)
+				return msg
+			}),
+		},
+		{
+			name: "duplicate routes",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Routes[0] = msg.Routes[1]
+				return msg
+			}),
+			expectedErr: types.ErrDuplicateRoutesNotAllowed,
+		},
+		{
+			name: "mismatched first token in",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Routes[1].Pools[0].TokenInDenom = "test4"
+				return msg
+			}),
+			expectedErr: types.InvalidFirstTokenInError{TokenInGivenA: "test", TokenInGivenB: "test4"},
+		},
+		{
+			name: "zero amount criteria",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.TokenInMaxAmount = sdk.NewInt(0)
+				return msg
+			}),
+		},
+	}
+
+	for _, test := range tests {
+		err := test.msg.ValidateBasic()
+		if test.expectPass {
+			require.NoError(t, err, "test: %v", test.name)
+		} else if test.expectedErr != nil {
+			require.ErrorIs(t, err, test.expectedErr, "test: %v", test.name)
+		} else {
+			require.Error(t, err, "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgEmergencyPausePools(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+
+	createMsg := func(after func(msg types.MsgEmergencyPausePools) types.MsgEmergencyPausePools) types.MsgEmergencyPausePools {
+		properMsg := types.MsgEmergencyPausePools{
+			Sender:    addr1,
+			PoolIds:   []uint64{1, 2},
+			PoolTypes: []types.PoolType{types.Stableswap},
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgEmergencyPausePools) types.MsgEmergencyPausePools {
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "emergency_pause_pools")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgEmergencyPausePools
+		expectPass  bool
+		expectedErr error
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgEmergencyPausePools) types.MsgEmergencyPausePools {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "only pool types",
+			msg: createMsg(func(msg types.MsgEmergencyPausePools) types.MsgEmergencyPausePools {
+				msg.PoolIds = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg types.MsgEmergencyPausePools) types.MsgEmergencyPausePools {
+				msg.Sender = "invalid"
+				return msg
+			}),
+		},
+		{
+			name: "no pools",
+			msg: createMsg(func(msg types.MsgEmergencyPausePools) types.MsgEmergencyPausePools {
+				msg.PoolIds = nil
+				msg.PoolTypes = nil
+				return msg
+			}),
+			expectedErr: types.ErrNoPoolsToPause,
+		},
+		{
+			name: "zero pool id",
+			msg: createMsg(func(msg types.MsgEmergencyPausePools) types.MsgEmergencyPausePools {
+				msg.PoolIds = []uint64{0}
+				return msg
+			}),
+		},
+		{
+			name: "duplicate pool id",
+			msg: createMsg(func(msg types.MsgEmergencyPausePools) types.MsgEmergencyPausePools {
+				msg.PoolIds = []uint64{1, 1}
+				return msg
+			}),
+		},
+		{
+			name: "invalid pool type",
+			msg: createMsg(func(msg types.MsgEmergencyPausePools) types.MsgEmergencyPausePools {
+				msg.PoolTypes = []types.PoolType{types.PoolType(100)}
+				return msg
+			}),
+			expectedErr: types.InvalidPoolTypeError{PoolType: types.PoolType(100)},
+		},
+	}
+
+	for _, test := range tests {
+		err := test.msg.ValidateBasic()
+		if test.expectPass {
+			require.NoError(t, err, "test: %v", test.name)
+		} else if test.expectedErr != nil {
+			require.ErrorIs(t, err, test.expectedErr, "test: %v", test.name)
+		} else {
+			require.Error(t, err, "test: %v", test.name)
+		}
+	}
+}
+
 // Test authz serialize and de-serializes for poolmanager msg.
 func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
@@ -313,6 +671,44 @@ func TestAuthzMsg(t *testing.T) {
 				TokenInMaxAmount: sdk.NewInt(1),
 			},
 		},
+		{
+			name: "MsgSplitRouteSwapExactAmountIn",
+			msg: &types.MsgSplitRouteSwapExactAmountIn{
+				Sender: addr1,
+				Routes: []types.SwapAmountInSplitRoute{{
+					Pools: []types.SwapAmountInRoute{{
+						PoolId:        1,
+						TokenOutDenom: "test",
+					}},
+					TokenInAmount: sdk.NewInt(1),
+				}},
+				TokenInDenom:      coin.Denom,
+				TokenOutMinAmount: sdk.NewInt(1),
+			},
+		},
+		{
+			name: "MsgSplitRouteSwapExactAmountOut",
+			msg: &types.MsgSplitRouteSwapExactAmountOut{
+				Sender: addr1,
+				Routes: []types.SwapAmountOutSplitRoute{{
+					Pools: []types.SwapAmountOutRoute{{
+						PoolId:       1,
+						TokenInDenom: "test",
+					}},
+					TokenOutAmount: sdk.NewInt(1),
+				}},
+				TokenOutDenom:    coin.Denom,
+				TokenInMaxAmount: sdk.NewInt(1),
+			},
+		},
+		{
+			name: "MsgEmergencyPausePools",
+			msg: &types.MsgEmergencyPausePools{
+				Sender:    addr1,
+				PoolIds:   []uint64{1},
+				PoolTypes: []types.PoolType{types.Stableswap},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
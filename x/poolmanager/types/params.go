@@ -12,6 +12,9 @@ import (
 // Parameter store keys.
 var (
 	KeyPoolCreationFee = []byte("PoolCreationFee")
+	KeyTakerFeeParams  = []byte("TakerFeeParams")
+
+	KeyEmergencyPauseAdmin = []byte("EmergencyPauseAdmin")
 )
 
 // ParamTable for gamm module.
@@ -19,9 +22,22 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// NewParams returns poolmanager params with the given pool creation fee
+// and the default taker fee params.
 func NewParams(poolCreationFee sdk.Coins) Params {
 	return Params{
 		PoolCreationFee: poolCreationFee,
+		TakerFeeParams:  DefaultTakerFeeParams(),
+	}
+}
+
+// DefaultTakerFeeParams returns taker fee params that charge no taker fee
+// and send any taker fee to the community pool.
+func DefaultTakerFeeParams() TakerFeeParams {
+	return TakerFeeParams{
+		DefaultTakerFee:    sdk.ZeroDec(),
+		DenomPairTakerFees: []DenomPairTakerFee{},
+		FeeCollector:       "",
 	}
 }
 
@@ -29,6 +45,7 @@ func NewParams(poolCreationFee sdk.Coins) Params {
 func DefaultParams() Params {
 	return Params{
 		PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		TakerFeeParams:  DefaultTakerFeeParams(),
 	}
 }
 
@@ -38,6 +55,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateTakerFeeParams(p.TakerFeeParams); err != nil {
+		return err
+	}
+
+	if err := validateEmergencyPauseAdmin(p.EmergencyPauseAdmin); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -45,6 +70,8 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyTakerFeeParams, &p.TakerFeeParams, validateTakerFeeParams),
+		paramtypes.NewParamSetPair(KeyEmergencyPauseAdmin, &p.EmergencyPauseAdmin, validateEmergencyPauseAdmin),
 	}
 }
 
@@ -60,3 +87,67 @@ func validatePoolCreationFee(i interface{}) error {
 
 	return nil
 }
+
+// validateTakerFeeParams validates that the default and denom pair taker fees
+// are in [0, 1) and that each denom pair is ordered, valid and unique.
+func validateTakerFeeParams(i interface{}) error {
+	takerFeeParams, ok := i.(TakerFeeParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := validateTakerFee(takerFeeParams.DefaultTakerFee); err != nil {
+		return err
+	}
+
+	seenPairs := make(map[string]struct{}, len(takerFeeParams.DenomPairTakerFees))
+	for _, denomPairTakerFee := range takerFeeParams.DenomPairTakerFees {
+		if err := sdk.ValidateDenom(denomPairTakerFee.Denom0); err != nil {
+			return err
+		}
+		if err := sdk.ValidateDenom(denomPairTakerFee.Denom1); err != nil {
+			return err
+		}
+		if denomPairTakerFee.Denom0 >= denomPairTakerFee.Denom1 {
+			return UnorderedDenomPairError{Denom0: denomPairTakerFee.Denom0, Denom1: denomPairTakerFee.Denom1}
+		}
+
+		pairKey := denomPairTakerFee.Denom0 + "/" + denomPairTakerFee.Denom1
+		if _, ok := seenPairs[pairKey]; ok {
+			return DuplicateDenomPairTakerFeeError{Denom0: denomPairTakerFee.Denom0, Denom1: denomPairTakerFee.Denom1}
+		}
+		seenPairs[pairKey] = struct{}{}
+
+		if err := validateTakerFee(denomPairTakerFee.TakerFee); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateTakerFee(takerFee sdk.Dec) error {
+	if takerFee.IsNil() || takerFee.IsNegative() || takerFee.GTE(sdk.OneDec()) {
+		return InvalidTakerFeeError{TakerFee: takerFee}
+	}
+	return nil
+}
+
+// validateEmergencyPauseAdmin validates that the emergency pause admin is
+// either empty, disabling the emergency pause path, or a valid address.
+func validateEmergencyPauseAdmin(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid emergency pause admin (%s): %w", v, err)
+	}
+
+	return nil
+}
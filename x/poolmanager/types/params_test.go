@@ -0,0 +1,116 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+func TestParamsValidateTakerFeeParams(t *testing.T) {
+	validPairTakerFee := types.DenomPairTakerFee{Denom0: "bar", Denom1: "foo", TakerFee: sdk.MustNewDecFromStr("0.002")}
+
+	tests := map[string]struct {
+		takerFeeParams types.TakerFeeParams
+		expectError    bool
+	}{
+		"default taker fee params": {
+			takerFeeParams: types.DefaultTakerFeeParams(),
+		},
+		"denom pair taker fees and fee collector": {
+			takerFeeParams: types.TakerFeeParams{
+				DefaultTakerFee:    sdk.MustNewDecFromStr("0.001"),
+				DenomPairTakerFees: []types.DenomPairTakerFee{validPairTakerFee, {Denom0: "bar", Denom1: "baz", TakerFee: sdk.ZeroDec()}},
+				FeeCollector:       "fee_collector",
+			},
+		},
+		"error: nil default taker fee": {
+			takerFeeParams: types.TakerFeeParams{},
+			expectError:    true,
+		},
+		"error: negative default taker fee": {
+			takerFeeParams: types.TakerFeeParams{DefaultTakerFee: sdk.MustNewDecFromStr("-0.001")},
+			expectError:    true,
+		},
+		"error: default taker fee of one": {
+			takerFeeParams: types.TakerFeeParams{DefaultTakerFee: sdk.OneDec()},
+			expectError:    true,
+		},
+		"error: unordered denom pair": {
+			takerFeeParams: types.TakerFeeParams{
+				DefaultTakerFee:    sdk.ZeroDec(),
+				DenomPairTakerFees: []types.DenomPairTakerFee{{Denom0: "foo", Denom1: "bar", TakerFee: sdk.ZeroDec()}},
+			},
+			expectError: true,
+		},
+		"error: same denoms": {
+			takerFeeParams: types.TakerFeeParams{
+				DefaultTakerFee:    sdk.ZeroDec(),
+				DenomPairTakerFees: []types.DenomPairTakerFee{{Denom0: "foo", Denom1: "foo", TakerFee: sdk.ZeroDec()}},
+			},
+			expectError: true,
+		},
+		"error: duplicate denom pair": {
+			takerFeeParams: types.TakerFeeParams{
+				DefaultTakerFee:    sdk.ZeroDec(),
+				DenomPairTakerFees: []types.DenomPairTakerFee{validPairTakerFee, validPairTakerFee},
+			},
+			expectError: true,
+		},
+		"error: invalid denom pair taker fee": {
+			takerFeeParams: types.TakerFeeParams{
+				DefaultTakerFee:    sdk.ZeroDec(),
+				DenomPairTakerFees: []types.DenomPairTakerFee{{Denom0: "bar", Denom1: "foo", TakerFee: sdk.NewDec(2)}},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.TakerFeeParams = tc.takerFeeParams
+
+			err := params.Validate()
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParamsValidateEmergencyPauseAdmin(t *testing.T) {
+	tests := map[string]struct {
+		emergencyPauseAdmin string
+		expectError         bool
+	}{
+		"empty admin disables the emergency pause": {
+			emergencyPauseAdmin: "",
+		},
+		"valid admin": {
+			emergencyPauseAdmin: sdk.AccAddress([]byte("admin_______________")).String(),
+		},
+		"error: invalid admin": {
+			emergencyPauseAdmin: "invalid",
+			expectError:         true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.EmergencyPauseAdmin = tc.emergencyPauseAdmin
+
+			err := params.Validate()
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,12 +1,26 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
+const (
+	// DefaultBestRouteMaxHops is the maximum number of pools in a route
+	// searched by the best route estimation when none is specified.
+	DefaultBestRouteMaxHops uint64 = 3
+	// MaxBestRouteMaxHops is the upper limit on the number of pools in a route
+	// that can be requested from the best route estimation.
+	MaxBestRouteMaxHops uint64 = 4
+	// MaxBestRouteCandidates is the maximum number of routes estimated
+	// by a single best route estimation.
+	MaxBestRouteCandidates = 500
+)
+
 // AccountI defines the account contract that must be fulfilled when
 // creating a x/gamm keeper.
 type AccountI interface {
@@ -174,3 +188,77 @@ func (routes SwapAmountOutRoutes) PoolIds() []uint64 {
 func (routes SwapAmountOutRoutes) Length() int {
 	return len(routes)
 }
+
+// ValidateSwapAmountInSplitRoute validates that the given split routes are non-empty,
+// that every route is valid and swaps a positive amount, that no route is repeated
+// and that all routes terminate in the same token out denom.
+func ValidateSwapAmountInSplitRoute(splitRoutes []SwapAmountInSplitRoute) error {
+	if len(splitRoutes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	uniqueRoutes := make(map[string]struct{}, len(splitRoutes))
+	finalTokenOutDenom := ""
+	for _, splitRoute := range splitRoutes {
+		multihopRoute := SwapAmountInRoutes(splitRoute.Pools)
+		if err := multihopRoute.Validate(); err != nil {
+			return err
+		}
+
+		if splitRoute.TokenInAmount.IsNil() || !splitRoute.TokenInAmount.IsPositive() {
+			return nonPositiveSplitRouteAmountError{Amount: splitRoute.TokenInAmount.String()}
+		}
+
+		routeKey := fmt.Sprint(multihopRoute.PoolIds(), multihopRoute.IntermediateDenoms())
+		if _, ok := uniqueRoutes[routeKey]; ok {
+			return ErrDuplicateRoutesNotAllowed
+		}
+		uniqueRoutes[routeKey] = struct{}{}
+
+		lastTokenOutDenom := splitRoute.Pools[len(splitRoute.Pools)-1].TokenOutDenom
+		if finalTokenOutDenom == "" {
+			finalTokenOutDenom = lastTokenOutDenom
+		} else if finalTokenOutDenom != lastTokenOutDenom {
+			return InvalidFinalTokenOutError{TokenOutGivenA: finalTokenOutDenom, TokenOutGivenB: lastTokenOutDenom}
+		}
+	}
+
+	return nil
+}
+
+// ValidateSwapAmountOutSplitRoute validates that the given split routes are non-empty,
+// that every route is valid and swaps a positive amount, that no route is repeated
+// and that all routes start from the same token in denom.
+func ValidateSwapAmountOutSplitRoute(splitRoutes []SwapAmountOutSplitRoute) error {
+	if len(splitRoutes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	uniqueRoutes := make(map[string]struct{}, len(splitRoutes))
+	firstTokenInDenom := ""
+	for _, splitRoute := range splitRoutes {
+		multihopRoute := SwapAmountOutRoutes(splitRoute.Pools)
+		if err := multihopRoute.Validate(); err != nil {
+			return err
+		}
+
+		if splitRoute.TokenOutAmount.IsNil() || !splitRoute.TokenOutAmount.IsPositive() {
+			return nonPositiveSplitRouteAmountError{Amount: splitRoute.TokenOutAmount.String()}
+		}
+
+		routeKey := fmt.Sprint(multihopRoute.PoolIds(), multihopRoute.IntermediateDenoms())
+		if _, ok := uniqueRoutes[routeKey]; ok {
+			return ErrDuplicateRoutesNotAllowed
+		}
+		uniqueRoutes[routeKey] = struct{}{}
+
+		tokenInDenom := splitRoute.Pools[0].TokenInDenom
+		if firstTokenInDenom == "" {
+			firstTokenInDenom = tokenInDenom
+		} else if firstTokenInDenom != tokenInDenom {
+			return InvalidFirstTokenInError{TokenInGivenA: firstTokenInDenom, TokenInGivenB: tokenInDenom}
+		}
+	}
+
+	return nil
+}
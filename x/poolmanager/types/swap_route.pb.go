@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -127,9 +128,101 @@ func (m *SwapAmountOutRoute) GetTokenInDenom() string {
 	return ""
 }
 
+type SwapAmountInSplitRoute struct {
+	Pools         []SwapAmountInRoute                    `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools" yaml:"pools"`
+	TokenInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_amount" yaml:"token_in_amount"`
+}
+
+func (m *SwapAmountInSplitRoute) Reset()         { *m = SwapAmountInSplitRoute{} }
+func (m *SwapAmountInSplitRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapAmountInSplitRoute) ProtoMessage()    {}
+func (*SwapAmountInSplitRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cddd97a9a05492a8, []int{2}
+}
+func (m *SwapAmountInSplitRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapAmountInSplitRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapAmountInSplitRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapAmountInSplitRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapAmountInSplitRoute.Merge(m, src)
+}
+func (m *SwapAmountInSplitRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapAmountInSplitRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapAmountInSplitRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapAmountInSplitRoute proto.InternalMessageInfo
+
+func (m *SwapAmountInSplitRoute) GetPools() []SwapAmountInRoute {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+type SwapAmountOutSplitRoute struct {
+	Pools          []SwapAmountOutRoute                   `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools" yaml:"pools"`
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *SwapAmountOutSplitRoute) Reset()         { *m = SwapAmountOutSplitRoute{} }
+func (m *SwapAmountOutSplitRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapAmountOutSplitRoute) ProtoMessage()    {}
+func (*SwapAmountOutSplitRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cddd97a9a05492a8, []int{3}
+}
+func (m *SwapAmountOutSplitRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapAmountOutSplitRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapAmountOutSplitRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapAmountOutSplitRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapAmountOutSplitRoute.Merge(m, src)
+}
+func (m *SwapAmountOutSplitRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapAmountOutSplitRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapAmountOutSplitRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapAmountOutSplitRoute proto.InternalMessageInfo
+
+func (m *SwapAmountOutSplitRoute) GetPools() []SwapAmountOutRoute {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SwapAmountInRoute)(nil), "osmosis.poolmanager.v1beta1.SwapAmountInRoute")
 	proto.RegisterType((*SwapAmountOutRoute)(nil), "osmosis.poolmanager.v1beta1.SwapAmountOutRoute")
+	proto.RegisterType((*SwapAmountInSplitRoute)(nil), "osmosis.poolmanager.v1beta1.SwapAmountInSplitRoute")
+	proto.RegisterType((*SwapAmountOutSplitRoute)(nil), "osmosis.poolmanager.v1beta1.SwapAmountOutSplitRoute")
 }
 
 func init() {
@@ -137,26 +230,35 @@ func init() {
 }
 
 var fileDescriptor_cddd97a9a05492a8 = []byte{
-	// 292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc8, 0xcf, 0xcf, 0xc9, 0x4d, 0xcc, 0x4b, 0x4c, 0x4f, 0x2d, 0xd2,
-	0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x2e, 0x4f, 0x2c, 0x88, 0x2f, 0xca, 0x2f,
-	0x2d, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x86, 0xaa, 0xd6, 0x43, 0x52, 0xad,
-	0x07, 0x55, 0x2d, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa7, 0x0f, 0x62, 0x41, 0xb4, 0x28,
-	0xb5, 0x30, 0x72, 0x09, 0x06, 0x97, 0x27, 0x16, 0x38, 0xe6, 0xe6, 0x97, 0xe6, 0x95, 0x78, 0xe6,
-	0x05, 0x81, 0x8c, 0x13, 0xd2, 0xe6, 0x62, 0x07, 0x19, 0x11, 0x9f, 0x99, 0x22, 0xc1, 0xa8, 0xc0,
-	0xa8, 0xc1, 0xe2, 0x24, 0xf4, 0xe9, 0x9e, 0x3c, 0x5f, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x54,
-	0x42, 0x29, 0x88, 0x0d, 0xc4, 0xf2, 0x4c, 0x11, 0x72, 0xe2, 0xe2, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd,
-	0x8b, 0xcf, 0x2f, 0x2d, 0x89, 0x4f, 0x49, 0xcd, 0xcb, 0xcf, 0x95, 0x60, 0x52, 0x60, 0xd4, 0xe0,
-	0x74, 0x92, 0xfa, 0x74, 0x4f, 0x5e, 0x0c, 0xa2, 0x09, 0x4d, 0x81, 0x52, 0x10, 0x2f, 0x58, 0xc4,
-	0xbf, 0xb4, 0xc4, 0x05, 0xcc, 0x6f, 0x66, 0xe4, 0x12, 0x42, 0x38, 0xc3, 0xbf, 0xb4, 0x84, 0x0c,
-	0x77, 0x38, 0x70, 0xf1, 0x41, 0xac, 0xc9, 0xcc, 0x23, 0xda, 0x19, 0x3c, 0x60, 0x11, 0xcf, 0x3c,
-	0xb0, 0x2b, 0x9c, 0x02, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x3c,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x1a, 0xc8, 0xba, 0x39, 0x89,
-	0x49, 0xc5, 0x30, 0x8e, 0x7e, 0x99, 0xa1, 0xa9, 0x7e, 0x05, 0x4a, 0x2c, 0x95, 0x54, 0x16, 0xa4,
-	0x16, 0x27, 0xb1, 0x81, 0x83, 0xd9, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x01, 0x52, 0x52,
-	0xc9, 0x01, 0x00, 0x00,
+	// 434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4d, 0x8b, 0xd3, 0x40,
+	0x18, 0xc7, 0x33, 0xbe, 0xac, 0x38, 0xae, 0x55, 0xc3, 0xb2, 0x5b, 0x2a, 0x24, 0x25, 0x07, 0x29,
+	0x68, 0x67, 0xa8, 0x22, 0x82, 0x27, 0x0d, 0x1e, 0xcc, 0xa9, 0x98, 0xde, 0xea, 0x21, 0x4c, 0x9a,
+	0x10, 0x43, 0x93, 0x99, 0xd0, 0x99, 0xb4, 0xf6, 0xac, 0x1f, 0xc0, 0x8f, 0xd5, 0x63, 0x8f, 0xe2,
+	0x21, 0x48, 0x0b, 0x5e, 0xbc, 0xf5, 0x13, 0x48, 0x26, 0x89, 0x4d, 0x2a, 0x94, 0xba, 0xa7, 0xcc,
+	0x4c, 0x9e, 0x97, 0xdf, 0xff, 0xff, 0xcc, 0xc0, 0x67, 0x8c, 0xc7, 0x8c, 0x87, 0x1c, 0x27, 0x8c,
+	0x45, 0x31, 0xa1, 0x24, 0xf0, 0x67, 0x78, 0x3e, 0x70, 0x7d, 0x41, 0x06, 0x98, 0x2f, 0x48, 0xe2,
+	0xcc, 0x58, 0x2a, 0x7c, 0x94, 0xcc, 0x98, 0x60, 0xea, 0xe3, 0x32, 0x1a, 0xd5, 0xa2, 0x51, 0x19,
+	0xdd, 0xb9, 0x08, 0x58, 0xc0, 0x64, 0x1c, 0xce, 0x57, 0x45, 0x8a, 0xf1, 0x15, 0xc0, 0x47, 0xa3,
+	0x05, 0x49, 0xde, 0xc6, 0x2c, 0xa5, 0xc2, 0xa2, 0x76, 0x5e, 0x4e, 0x7d, 0x0a, 0xef, 0xe4, 0x25,
+	0x9c, 0xd0, 0x6b, 0x83, 0x2e, 0xe8, 0xdd, 0x32, 0xd5, 0x5d, 0xa6, 0xb7, 0x96, 0x24, 0x8e, 0x5e,
+	0x1b, 0xe5, 0x0f, 0xc3, 0x3e, 0xcb, 0x57, 0x96, 0xa7, 0x9a, 0xf0, 0x81, 0x60, 0x53, 0x9f, 0x3a,
+	0x2c, 0x15, 0x8e, 0xe7, 0x53, 0x16, 0xb7, 0x6f, 0x74, 0x41, 0xef, 0xae, 0xd9, 0xd9, 0x65, 0xfa,
+	0x65, 0x91, 0x74, 0x10, 0x60, 0xd8, 0xf7, 0xe5, 0xc9, 0x30, 0x15, 0xef, 0xe4, 0xfe, 0x0b, 0x80,
+	0xea, 0x1e, 0x63, 0x98, 0x8a, 0x6b, 0x70, 0xbc, 0x81, 0xad, 0xa2, 0x4d, 0x48, 0x4f, 0xc6, 0x38,
+	0x97, 0x27, 0x16, 0x2d, 0x28, 0x7e, 0x01, 0x78, 0x59, 0x37, 0x63, 0x94, 0x44, 0x61, 0x49, 0x32,
+	0x86, 0xb7, 0xf3, 0x36, 0xbc, 0x0d, 0xba, 0x37, 0x7b, 0xf7, 0x9e, 0x23, 0x74, 0xc4, 0x6a, 0xf4,
+	0x8f, 0xa1, 0xe6, 0xc5, 0x2a, 0xd3, 0x95, 0x5d, 0xa6, 0x9f, 0xef, 0xd9, 0xb9, 0x61, 0x17, 0x25,
+	0xd5, 0xa4, 0x32, 0x30, 0xa4, 0x0e, 0x91, 0x69, 0x25, 0xf9, 0xfb, 0x3c, 0xeb, 0x47, 0xa6, 0x3f,
+	0x09, 0x42, 0xf1, 0x29, 0x75, 0xd1, 0x84, 0xc5, 0x78, 0x22, 0x1b, 0x97, 0x9f, 0x3e, 0xf7, 0xa6,
+	0x58, 0x2c, 0x13, 0x9f, 0x23, 0x8b, 0x8a, 0x43, 0x9d, 0x7f, 0xcb, 0x55, 0x76, 0x5b, 0xb4, 0xa0,
+	0x32, 0x7e, 0x03, 0x78, 0xd5, 0xb0, 0xbb, 0xa6, 0xf4, 0x63, 0x53, 0x29, 0x3e, 0x51, 0x69, 0x35,
+	0xb3, 0xe3, 0x52, 0x39, 0x7c, 0xb8, 0x9f, 0x41, 0x43, 0xab, 0xf5, 0xdf, 0x5a, 0xaf, 0x0e, 0x67,
+	0x5a, 0x89, 0x6d, 0x55, 0x77, 0xab, 0x20, 0x33, 0x3f, 0xac, 0x36, 0x1a, 0x58, 0x6f, 0x34, 0xf0,
+	0x73, 0xa3, 0x81, 0x6f, 0x5b, 0x4d, 0x59, 0x6f, 0x35, 0xe5, 0xfb, 0x56, 0x53, 0xc6, 0xaf, 0x6a,
+	0xcd, 0x4a, 0x99, 0xfd, 0x88, 0xb8, 0xbc, 0xda, 0xe0, 0xf9, 0xe0, 0x25, 0xfe, 0xdc, 0x78, 0x7c,
+	0x92, 0xc0, 0x3d, 0x93, 0xaf, 0xe7, 0xc5, 0x9f, 0x01, 0x00, 0x7a, 0xc2, 0x54, 0xc3, 0xa0, 0x03,
+	0x00, 0x00,
 }
 
 func (m *SwapAmountInRoute) Marshal() (dAtA []byte, err error) {
@@ -229,6 +331,100 @@ func (m *SwapAmountOutRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SwapAmountInSplitRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapAmountInSplitRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapAmountInSplitRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwapRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwapRoute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapAmountOutSplitRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapAmountOutSplitRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapAmountOutSplitRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwapRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwapRoute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSwapRoute(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSwapRoute(v)
 	base := offset
@@ -272,6 +468,40 @@ func (m *SwapAmountOutRoute) Size() (n int) {
 	return n
 }
 
+func (m *SwapAmountInSplitRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovSwapRoute(uint64(l))
+		}
+	}
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovSwapRoute(uint64(l))
+	return n
+}
+
+func (m *SwapAmountOutSplitRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovSwapRoute(uint64(l))
+		}
+	}
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovSwapRoute(uint64(l))
+	return n
+}
+
 func sovSwapRoute(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -480,6 +710,242 @@ func (m *SwapAmountOutRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SwapAmountInSplitRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwapRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapAmountInSplitRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapAmountInSplitRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, SwapAmountInRoute{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwapRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapAmountOutSplitRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwapRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapAmountOutSplitRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapAmountOutSplitRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, SwapAmountOutRoute{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwapRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSwapRoute(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -228,11 +228,319 @@ func (m *MsgSwapExactAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSwapExactAmountOutResponse proto.InternalMessageInfo
 
+// ===================== MsgSplitRouteSwapExactAmountIn
+type MsgSplitRouteSwapExactAmountIn struct {
+	Sender            string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Routes            []SwapAmountInSplitRoute               `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+	TokenInDenom      string                                 `protobuf:"bytes,3,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	TokenOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) Reset()         { *m = MsgSplitRouteSwapExactAmountIn{} }
+func (m *MsgSplitRouteSwapExactAmountIn) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitRouteSwapExactAmountIn) ProtoMessage()    {}
+func (*MsgSplitRouteSwapExactAmountIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{4}
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitRouteSwapExactAmountIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountIn.Merge(m, src)
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitRouteSwapExactAmountIn proto.InternalMessageInfo
+
+func (m *MsgSplitRouteSwapExactAmountIn) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) GetRoutes() []SwapAmountInSplitRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+type MsgSplitRouteSwapExactAmountInResponse struct {
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *MsgSplitRouteSwapExactAmountInResponse) Reset() {
+	*m = MsgSplitRouteSwapExactAmountInResponse{}
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitRouteSwapExactAmountInResponse) ProtoMessage()    {}
+func (*MsgSplitRouteSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{5}
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse.Merge(m, src)
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse proto.InternalMessageInfo
+
+// ===================== MsgSplitRouteSwapExactAmountOut
+type MsgSplitRouteSwapExactAmountOut struct {
+	Sender           string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Routes           []SwapAmountOutSplitRoute              `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+	TokenOutDenom    string                                 `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	TokenInMaxAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=token_in_max_amount,json=tokenInMaxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_max_amount" yaml:"token_in_max_amount"`
+}
+
+func (m *MsgSplitRouteSwapExactAmountOut) Reset()         { *m = MsgSplitRouteSwapExactAmountOut{} }
+func (m *MsgSplitRouteSwapExactAmountOut) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitRouteSwapExactAmountOut) ProtoMessage()    {}
+func (*MsgSplitRouteSwapExactAmountOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{6}
+}
+func (m *MsgSplitRouteSwapExactAmountOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitRouteSwapExactAmountOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitRouteSwapExactAmountOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitRouteSwapExactAmountOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountOut.Merge(m, src)
+}
+func (m *MsgSplitRouteSwapExactAmountOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitRouteSwapExactAmountOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountOut.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitRouteSwapExactAmountOut proto.InternalMessageInfo
+
+func (m *MsgSplitRouteSwapExactAmountOut) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSplitRouteSwapExactAmountOut) GetRoutes() []SwapAmountOutSplitRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountOut) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+type MsgSplitRouteSwapExactAmountOutResponse struct {
+	TokenInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_amount" yaml:"token_in_amount"`
+}
+
+func (m *MsgSplitRouteSwapExactAmountOutResponse) Reset() {
+	*m = MsgSplitRouteSwapExactAmountOutResponse{}
+}
+func (m *MsgSplitRouteSwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitRouteSwapExactAmountOutResponse) ProtoMessage()    {}
+func (*MsgSplitRouteSwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{7}
+}
+func (m *MsgSplitRouteSwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitRouteSwapExactAmountOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitRouteSwapExactAmountOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitRouteSwapExactAmountOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountOutResponse.Merge(m, src)
+}
+func (m *MsgSplitRouteSwapExactAmountOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitRouteSwapExactAmountOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountOutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitRouteSwapExactAmountOutResponse proto.InternalMessageInfo
+
+// ===================== MsgEmergencyPausePools
+// MsgEmergencyPausePools pauses swaps and joins on the given pools and on every
+// pool of the given pool types. It may only be sent by the emergency pause
+// admin. Unpausing requires a governance proposal.
+type MsgEmergencyPausePools struct {
+	Sender    string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolIds   []uint64   `protobuf:"varint,2,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+	PoolTypes []PoolType `protobuf:"varint,3,rep,packed,name=pool_types,json=poolTypes,proto3,enum=osmosis.poolmanager.v1beta1.PoolType" json:"pool_types,omitempty" yaml:"pool_types"`
+}
+
+func (m *MsgEmergencyPausePools) Reset()         { *m = MsgEmergencyPausePools{} }
+func (m *MsgEmergencyPausePools) String() string { return proto.CompactTextString(m) }
+func (*MsgEmergencyPausePools) ProtoMessage()    {}
+func (*MsgEmergencyPausePools) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{8}
+}
+func (m *MsgEmergencyPausePools) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEmergencyPausePools) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEmergencyPausePools.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEmergencyPausePools) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEmergencyPausePools.Merge(m, src)
+}
+func (m *MsgEmergencyPausePools) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEmergencyPausePools) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEmergencyPausePools.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEmergencyPausePools proto.InternalMessageInfo
+
+func (m *MsgEmergencyPausePools) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgEmergencyPausePools) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
+func (m *MsgEmergencyPausePools) GetPoolTypes() []PoolType {
+	if m != nil {
+		return m.PoolTypes
+	}
+	return nil
+}
+
+type MsgEmergencyPausePoolsResponse struct {
+}
+
+func (m *MsgEmergencyPausePoolsResponse) Reset()         { *m = MsgEmergencyPausePoolsResponse{} }
+func (m *MsgEmergencyPausePoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEmergencyPausePoolsResponse) ProtoMessage()    {}
+func (*MsgEmergencyPausePoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{9}
+}
+func (m *MsgEmergencyPausePoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEmergencyPausePoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEmergencyPausePoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEmergencyPausePoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEmergencyPausePoolsResponse.Merge(m, src)
+}
+func (m *MsgEmergencyPausePoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEmergencyPausePoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEmergencyPausePoolsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEmergencyPausePoolsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSwapExactAmountIn)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn")
 	proto.RegisterType((*MsgSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountInResponse")
 	proto.RegisterType((*MsgSwapExactAmountOut)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountOut")
 	proto.RegisterType((*MsgSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountOutResponse")
+	proto.RegisterType((*MsgSplitRouteSwapExactAmountIn)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountIn")
+	proto.RegisterType((*MsgSplitRouteSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountInResponse")
+	proto.RegisterType((*MsgSplitRouteSwapExactAmountOut)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountOut")
+	proto.RegisterType((*MsgSplitRouteSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountOutResponse")
+	proto.RegisterType((*MsgEmergencyPausePools)(nil), "osmosis.poolmanager.v1beta1.MsgEmergencyPausePools")
+	proto.RegisterType((*MsgEmergencyPausePoolsResponse)(nil), "osmosis.poolmanager.v1beta1.MsgEmergencyPausePoolsResponse")
 }
 
 func init() {
@@ -240,44 +548,62 @@ func init() {
 }
 
 var fileDescriptor_acd130b4825d67dc = []byte{
-	// 586 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6a, 0x13, 0x41,
-	0x14, 0xce, 0x26, 0xa5, 0xb6, 0x53, 0x6a, 0x93, 0x35, 0xda, 0x98, 0xea, 0x26, 0x2c, 0x22, 0x11,
-	0xec, 0x2e, 0x89, 0x88, 0xd8, 0x3b, 0x23, 0x82, 0x81, 0x2e, 0xd1, 0xf5, 0xce, 0x9b, 0x30, 0x49,
-	0x87, 0x75, 0x69, 0x76, 0x66, 0xc9, 0xcc, 0xb6, 0x29, 0x82, 0x20, 0xf8, 0x00, 0x15, 0x5f, 0xaa,
-	0x97, 0xbd, 0x14, 0x2f, 0x96, 0x92, 0xbc, 0x41, 0x9e, 0x40, 0x76, 0x7e, 0xd6, 0x24, 0x0d, 0xc1,
-	0xbd, 0xca, 0x64, 0x72, 0xce, 0x77, 0xbe, 0x9f, 0x93, 0x01, 0x4f, 0x08, 0x0d, 0x08, 0xf5, 0xa9,
-	0x1d, 0x12, 0x32, 0x0c, 0x20, 0x86, 0x1e, 0x1a, 0xd9, 0x67, 0xcd, 0x3e, 0x62, 0xb0, 0x69, 0xb3,
-	0xb1, 0x15, 0x8e, 0x08, 0x23, 0xfa, 0x81, 0xac, 0xb2, 0xe6, 0xaa, 0x2c, 0x59, 0x55, 0x2d, 0x7b,
-	0xc4, 0x23, 0xbc, 0xce, 0x4e, 0x4e, 0xa2, 0xa5, 0x6a, 0x0c, 0x78, 0x8f, 0xdd, 0x87, 0x14, 0xa5,
-	0x80, 0x03, 0xe2, 0x63, 0xf9, 0xfb, 0xf3, 0x75, 0x83, 0xe9, 0x39, 0x0c, 0x7b, 0x23, 0x12, 0x31,
-	0x24, 0xaa, 0xcd, 0x38, 0x0f, 0xca, 0x0e, 0xf5, 0x3e, 0x9d, 0xc3, 0xf0, 0xdd, 0x18, 0x0e, 0xd8,
-	0x9b, 0x80, 0x44, 0x98, 0x75, 0xb0, 0xfe, 0x0c, 0x6c, 0x52, 0x84, 0x4f, 0xd0, 0xa8, 0xa2, 0xd5,
-	0xb5, 0xc6, 0x76, 0xbb, 0x34, 0x8b, 0x6b, 0xbb, 0x17, 0x30, 0x18, 0x1e, 0x99, 0xe2, 0xde, 0x74,
-	0x65, 0x81, 0x7e, 0x0c, 0x36, 0x39, 0x24, 0xad, 0xe4, 0xeb, 0x85, 0xc6, 0x4e, 0xcb, 0xb2, 0xd6,
-	0xa8, 0xb2, 0x92, 0x51, 0x6a, 0x8a, 0x9b, 0xb4, 0xb5, 0x37, 0xae, 0xe2, 0x5a, 0xce, 0x95, 0x18,
-	0xba, 0x03, 0xb6, 0x18, 0x39, 0x45, 0xb8, 0xe7, 0xe3, 0x4a, 0xa1, 0xae, 0x35, 0x76, 0x5a, 0x0f,
-	0x2d, 0x21, 0xd9, 0x4a, 0x24, 0xa7, 0x38, 0x6f, 0x89, 0x8f, 0xdb, 0xfb, 0x49, 0xeb, 0x2c, 0xae,
-	0xed, 0x09, 0x66, 0xaa, 0xd1, 0x74, 0xef, 0xf0, 0x63, 0x07, 0xeb, 0xdf, 0x40, 0x59, 0xdc, 0x92,
-	0x88, 0xf5, 0x02, 0x1f, 0xf7, 0x20, 0x9f, 0x5d, 0xd9, 0xe0, 0xaa, 0x9c, 0xa4, 0xff, 0x4f, 0x5c,
-	0x7b, 0xea, 0xf9, 0xec, 0x4b, 0xd4, 0xb7, 0x06, 0x24, 0xb0, 0xa5, 0xbf, 0xe2, 0xe3, 0x90, 0x9e,
-	0x9c, 0xda, 0xec, 0x22, 0x44, 0xd4, 0xea, 0x60, 0x36, 0x8b, 0x6b, 0x07, 0xf3, 0x93, 0x16, 0x31,
-	0x4d, 0xb7, 0xc4, 0xaf, 0xbb, 0x11, 0x73, 0x7c, 0x2c, 0x34, 0x9a, 0xbf, 0x34, 0xf0, 0x68, 0x95,
-	0xc1, 0x2e, 0xa2, 0x21, 0xc1, 0x14, 0xe9, 0x14, 0x14, 0xff, 0x81, 0x49, 0x72, 0xc2, 0xf2, 0x4e,
-	0x66, 0x72, 0xfb, 0xcb, 0xe4, 0x14, 0xb1, 0xbb, 0x8a, 0x98, 0x64, 0x75, 0x93, 0x07, 0xf7, 0x6f,
-	0xb3, 0xea, 0x46, 0x2c, 0x4b, 0xee, 0xce, 0x52, 0xee, 0xf6, 0x7f, 0xe6, 0xde, 0x8d, 0xd8, 0xaa,
-	0xe0, 0xbf, 0x82, 0x7b, 0x2a, 0xbf, 0x5e, 0x00, 0xc7, 0xca, 0x8b, 0x02, 0xa7, 0x71, 0x9c, 0xd9,
-	0x8b, 0xea, 0xe2, 0x4a, 0xcc, 0x41, 0x9a, 0x6e, 0x51, 0x6e, 0x87, 0x03, 0xc7, 0x82, 0x92, 0xfe,
-	0x01, 0x6c, 0xa7, 0xae, 0xf1, 0xdd, 0x58, 0xbb, 0x76, 0x15, 0xb9, 0x76, 0xc5, 0x25, 0xbf, 0x4d,
-	0x77, 0x4b, 0x19, 0x6d, 0xfe, 0xd4, 0xc0, 0xe3, 0x95, 0x16, 0xa7, 0xc9, 0x87, 0x60, 0x2f, 0x65,
-	0xb7, 0x10, 0xfc, 0xfb, 0xcc, 0x62, 0x1f, 0x2c, 0x89, 0x55, 0x42, 0x77, 0xa5, 0x50, 0x31, 0xbc,
-	0x75, 0x99, 0x07, 0x05, 0x87, 0x7a, 0xfa, 0x77, 0x0d, 0x94, 0x6e, 0xff, 0xe5, 0x9b, 0x6b, 0xf3,
-	0x5b, 0xb5, 0xc4, 0xd5, 0xd7, 0x99, 0x5b, 0x52, 0xf5, 0x3f, 0x34, 0xa0, 0xaf, 0xd8, 0xbf, 0x56,
-	0x46, 0xc4, 0x6e, 0xc4, 0xaa, 0x47, 0xd9, 0x7b, 0x14, 0x8d, 0xf6, 0xc7, 0xab, 0x89, 0xa1, 0x5d,
-	0x4f, 0x0c, 0xed, 0x66, 0x62, 0x68, 0x97, 0x53, 0x23, 0x77, 0x3d, 0x35, 0x72, 0xbf, 0xa7, 0x46,
-	0xee, 0xf3, 0xab, 0x39, 0xf7, 0x25, 0xfe, 0xe1, 0x10, 0xf6, 0xa9, 0xfa, 0x62, 0x9f, 0x35, 0x5f,
-	0xda, 0xe3, 0x85, 0x67, 0x96, 0x47, 0xd2, 0xdf, 0xe4, 0x4f, 0xeb, 0x8b, 0xbf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x1b, 0x86, 0x73, 0xb8, 0x03, 0x06, 0x00, 0x00,
+	// 876 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0xcf, 0x4d, 0x42, 0xd7, 0xde, 0xb1, 0xb6, 0x71, 0xdb, 0x2d, 0x73, 0x87, 0x1d, 0x59, 0x30,
+	0x82, 0xc4, 0x6c, 0x25, 0x05, 0x21, 0x36, 0x24, 0x44, 0xb6, 0x49, 0x44, 0x9a, 0x95, 0xce, 0xf0,
+	0x04, 0x0f, 0x91, 0x93, 0x5c, 0x19, 0x6b, 0xb1, 0xaf, 0x95, 0x7b, 0xdd, 0x26, 0x42, 0x42, 0x42,
+	0x42, 0xe2, 0x15, 0xc4, 0x23, 0x42, 0x48, 0x7c, 0x9a, 0x3e, 0xf6, 0x11, 0xf1, 0x60, 0xfa, 0xe7,
+	0x1b, 0xe4, 0x13, 0x20, 0xdb, 0xd7, 0x4e, 0xe2, 0xba, 0x4e, 0xdd, 0x4a, 0xf4, 0x29, 0xce, 0xf5,
+	0xf9, 0xf3, 0x3b, 0xbf, 0xdf, 0x39, 0xe7, 0xca, 0xf0, 0x5d, 0x4c, 0x2c, 0x4c, 0x4c, 0xa2, 0x38,
+	0x18, 0x0f, 0x2d, 0xdd, 0xd6, 0x0d, 0x34, 0x52, 0x0e, 0x1a, 0x3d, 0x44, 0xf5, 0x86, 0x42, 0xc7,
+	0xb2, 0x33, 0xc2, 0x14, 0x73, 0xbb, 0xcc, 0x4a, 0x9e, 0xb3, 0x92, 0x99, 0x15, 0xbf, 0x6d, 0x60,
+	0x03, 0x07, 0x76, 0x8a, 0xff, 0x14, 0xba, 0xf0, 0x42, 0x3f, 0xf0, 0x51, 0x7a, 0x3a, 0x41, 0x71,
+	0xc0, 0x3e, 0x36, 0x6d, 0xf6, 0xfe, 0xc3, 0xac, 0xc4, 0xe4, 0x50, 0x77, 0xba, 0x23, 0xec, 0x52,
+	0xc4, 0xac, 0xe5, 0x2c, 0x6b, 0x0b, 0x0f, 0xdc, 0x21, 0x9a, 0xb7, 0x97, 0xbc, 0x22, 0xdc, 0x56,
+	0x89, 0xf1, 0xd5, 0xa1, 0xee, 0xbc, 0x1c, 0xeb, 0x7d, 0xfa, 0x85, 0x85, 0x5d, 0x9b, 0xb6, 0x6d,
+	0xee, 0x03, 0xb8, 0x42, 0x90, 0x3d, 0x40, 0xa3, 0x2a, 0xa8, 0x81, 0xfa, 0x5a, 0xab, 0x32, 0xf5,
+	0xc4, 0x7b, 0x13, 0xdd, 0x1a, 0x3e, 0x95, 0xc2, 0x73, 0x49, 0x63, 0x06, 0xdc, 0x2b, 0xb8, 0x12,
+	0x84, 0x24, 0xd5, 0x62, 0xad, 0x54, 0xbf, 0xdb, 0x94, 0xe5, 0x0c, 0x16, 0x64, 0x3f, 0x55, 0x94,
+	0x45, 0xf3, 0xdd, 0x5a, 0xe5, 0x23, 0x4f, 0x2c, 0x68, 0x2c, 0x06, 0xa7, 0xc2, 0x55, 0x8a, 0xdf,
+	0x20, 0xbb, 0x6b, 0xda, 0xd5, 0x52, 0x0d, 0xd4, 0xef, 0x36, 0x1f, 0xca, 0x21, 0x45, 0xb2, 0x4f,
+	0x51, 0x1c, 0xe7, 0x39, 0x36, 0xed, 0xd6, 0x03, 0xdf, 0x75, 0xea, 0x89, 0x1b, 0x21, 0xb2, 0xc8,
+	0x51, 0xd2, 0xee, 0x04, 0x8f, 0x6d, 0x9b, 0xfb, 0x01, 0x6e, 0x87, 0xa7, 0xd8, 0xa5, 0x5d, 0xcb,
+	0xb4, 0xbb, 0x7a, 0x90, 0xbb, 0x5a, 0x0e, 0xaa, 0x52, 0x7d, 0xff, 0x7f, 0x3c, 0xf1, 0xb1, 0x61,
+	0xd2, 0xef, 0xdc, 0x9e, 0xdc, 0xc7, 0x96, 0xc2, 0xf4, 0x08, 0x7f, 0x9e, 0x90, 0xc1, 0x1b, 0x85,
+	0x4e, 0x1c, 0x44, 0xe4, 0xb6, 0x4d, 0xa7, 0x9e, 0xb8, 0x3b, 0x9f, 0x69, 0x31, 0xa6, 0xa4, 0x55,
+	0x82, 0xe3, 0x8e, 0x4b, 0x55, 0xd3, 0x0e, 0x6b, 0x94, 0x7e, 0x03, 0xf0, 0x51, 0x1a, 0xc1, 0x1a,
+	0x22, 0x0e, 0xb6, 0x09, 0xe2, 0x08, 0xdc, 0x9c, 0x05, 0x63, 0xe0, 0x42, 0xca, 0xdb, 0xb9, 0xc1,
+	0x3d, 0x48, 0x82, 0x8b, 0x80, 0xad, 0x47, 0xc0, 0x18, 0xaa, 0x93, 0x22, 0xdc, 0xb9, 0x88, 0xaa,
+	0xe3, 0xd2, 0x3c, 0xba, 0xab, 0x09, 0xdd, 0x95, 0x2b, 0xea, 0xde, 0x71, 0x69, 0x9a, 0xf0, 0xdf,
+	0xc3, 0xad, 0x48, 0xbf, 0xae, 0xa5, 0x8f, 0x23, 0x2e, 0x4a, 0x01, 0x8c, 0x57, 0xb9, 0xb9, 0xe0,
+	0x17, 0x5b, 0x62, 0x2e, 0xa4, 0xa4, 0x6d, 0xb2, 0xee, 0x50, 0xf5, 0x71, 0x08, 0x89, 0xdb, 0x87,
+	0x6b, 0x31, 0x6b, 0xd5, 0xf2, 0xb2, 0xb6, 0xab, 0xb2, 0xb6, 0xdb, 0x4c, 0xf0, 0x2d, 0x69, 0xab,
+	0x11, 0xd1, 0xd2, 0xaf, 0x00, 0xbe, 0x93, 0x4a, 0x71, 0xac, 0xbc, 0x03, 0x37, 0x62, 0x74, 0x0b,
+	0xc2, 0x7f, 0x99, 0xbb, 0xd8, 0xfb, 0x89, 0x62, 0xa3, 0x42, 0xef, 0xb1, 0x42, 0x99, 0xec, 0xff,
+	0x16, 0xa1, 0xe0, 0x63, 0x72, 0x86, 0x66, 0x28, 0xc1, 0x8d, 0xe6, 0xfe, 0x75, 0x42, 0xff, 0xbd,
+	0x2b, 0xcf, 0xfd, 0x0c, 0x40, 0xa2, 0x07, 0x3e, 0x87, 0xeb, 0x71, 0x0d, 0x03, 0x64, 0x63, 0x8b,
+	0xc9, 0xff, 0x70, 0xea, 0x89, 0x3b, 0x89, 0x1a, 0x83, 0xf7, 0x92, 0xf6, 0x36, 0x2b, 0xf1, 0x85,
+	0xff, 0xf7, 0xd6, 0xc7, 0xfd, 0x0f, 0x00, 0x1f, 0x67, 0x33, 0x7c, 0xbb, 0x83, 0x7f, 0x5a, 0x84,
+	0x62, 0x16, 0xbe, 0x9c, 0x2b, 0x40, 0x4b, 0xb4, 0xc0, 0x47, 0x57, 0x5f, 0x01, 0x97, 0xf6, 0x40,
+	0x0b, 0x6e, 0xcc, 0xea, 0x98, 0x6f, 0x02, 0x3e, 0xd9, 0xe8, 0xb1, 0x41, 0xd4, 0xe8, 0x1d, 0x97,
+	0x86, 0x6d, 0x70, 0xc9, 0x2e, 0x29, 0xff, 0x1f, 0xbb, 0x44, 0xfa, 0x1d, 0xc0, 0xf7, 0x97, 0x70,
+	0x7c, 0x8b, 0x3b, 0xe0, 0x18, 0xc0, 0xfb, 0x2a, 0x31, 0x5e, 0x5a, 0x68, 0x64, 0x20, 0xbb, 0x3f,
+	0xd9, 0xd7, 0x5d, 0x82, 0xf6, 0x31, 0x1e, 0x92, 0x3c, 0xc2, 0xcb, 0x70, 0xd5, 0x57, 0xb8, 0x6b,
+	0x0e, 0x42, 0xe9, 0xcb, 0xad, 0xad, 0xd9, 0x35, 0x1c, 0xbd, 0x91, 0xb4, 0x3b, 0xfe, 0x63, 0x7b,
+	0x40, 0xb8, 0x6f, 0x21, 0x0c, 0x4e, 0x03, 0xc0, 0xd5, 0x52, 0xad, 0x54, 0x5f, 0x6f, 0xbe, 0x97,
+	0xd9, 0x2c, 0x3e, 0xa4, 0xaf, 0x27, 0x0e, 0x6a, 0xed, 0x4c, 0x3d, 0xb1, 0x32, 0x17, 0x38, 0x08,
+	0x21, 0x69, 0x6b, 0x0e, 0x33, 0x20, 0x52, 0x0d, 0x0a, 0xe9, 0x15, 0x45, 0x34, 0x37, 0x4f, 0xdf,
+	0x82, 0x25, 0x95, 0x18, 0xdc, 0x8f, 0x00, 0x56, 0x2e, 0xee, 0xbc, 0x46, 0x26, 0x90, 0xb4, 0xdb,
+	0x9b, 0xff, 0x34, 0xb7, 0x4b, 0x2c, 0xf9, 0x4f, 0x00, 0x72, 0x29, 0x53, 0xd7, 0xcc, 0x19, 0xb1,
+	0xe3, 0x52, 0xfe, 0x69, 0x7e, 0x9f, 0x18, 0xc6, 0x9f, 0x00, 0xee, 0x66, 0x5d, 0x04, 0xcf, 0x96,
+	0xc6, 0xbe, 0xdc, 0x99, 0x7f, 0x7e, 0x03, 0xe7, 0x18, 0xe1, 0x5f, 0x00, 0x3e, 0xca, 0x5c, 0x54,
+	0x9f, 0x5d, 0x3b, 0x8b, 0x4f, 0xde, 0x8b, 0x9b, 0x78, 0xc7, 0x20, 0x7f, 0x06, 0x70, 0x2b, 0x6d,
+	0x96, 0xf6, 0x96, 0x45, 0x4f, 0x71, 0xe2, 0x9f, 0x5d, 0xc3, 0x29, 0x42, 0xd2, 0x7a, 0x7d, 0x74,
+	0x26, 0x80, 0xe3, 0x33, 0x01, 0x9c, 0x9c, 0x09, 0xe0, 0x97, 0x73, 0xa1, 0x70, 0x7c, 0x2e, 0x14,
+	0xfe, 0x3e, 0x17, 0x0a, 0xdf, 0x7c, 0x32, 0xb7, 0x43, 0x58, 0x82, 0x27, 0x43, 0xbd, 0x47, 0xa2,
+	0x3f, 0xca, 0x41, 0xe3, 0x63, 0x65, 0xbc, 0xf0, 0xc9, 0x10, 0x0c, 0x59, 0x6f, 0x25, 0xf8, 0x48,
+	0xd8, 0xfb, 0x6f, 0x00, 0x60, 0xab, 0x50, 0x30, 0xfd, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -294,6 +620,9 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	SwapExactAmountIn(ctx context.Context, in *MsgSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSwapExactAmountInResponse, error)
 	SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSwapExactAmountOutResponse, error)
+	SplitRouteSwapExactAmountIn(ctx context.Context, in *MsgSplitRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInResponse, error)
+	SplitRouteSwapExactAmountOut(ctx context.Context, in *MsgSplitRouteSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountOutResponse, error)
+	EmergencyPausePools(ctx context.Context, in *MsgEmergencyPausePools, opts ...grpc.CallOption) (*MsgEmergencyPausePoolsResponse, error)
 }
 
 type msgClient struct {
@@ -322,10 +651,40 @@ func (c *msgClient) SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmou
 	return out, nil
 }
 
+func (c *msgClient) SplitRouteSwapExactAmountIn(ctx context.Context, in *MsgSplitRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInResponse, error) {
+	out := new(MsgSplitRouteSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SplitRouteSwapExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SplitRouteSwapExactAmountOut(ctx context.Context, in *MsgSplitRouteSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountOutResponse, error) {
+	out := new(MsgSplitRouteSwapExactAmountOutResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SplitRouteSwapExactAmountOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EmergencyPausePools(ctx context.Context, in *MsgEmergencyPausePools, opts ...grpc.CallOption) (*MsgEmergencyPausePoolsResponse, error) {
+	out := new(MsgEmergencyPausePoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/EmergencyPausePools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	SwapExactAmountIn(context.Context, *MsgSwapExactAmountIn) (*MsgSwapExactAmountInResponse, error)
 	SwapExactAmountOut(context.Context, *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error)
+	SplitRouteSwapExactAmountIn(context.Context, *MsgSplitRouteSwapExactAmountIn) (*MsgSplitRouteSwapExactAmountInResponse, error)
+	SplitRouteSwapExactAmountOut(context.Context, *MsgSplitRouteSwapExactAmountOut) (*MsgSplitRouteSwapExactAmountOutResponse, error)
+	EmergencyPausePools(context.Context, *MsgEmergencyPausePools) (*MsgEmergencyPausePoolsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -338,6 +697,15 @@ func (*UnimplementedMsgServer) SwapExactAmountIn(ctx context.Context, req *MsgSw
 func (*UnimplementedMsgServer) SwapExactAmountOut(ctx context.Context, req *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapExactAmountOut not implemented")
 }
+func (*UnimplementedMsgServer) SplitRouteSwapExactAmountIn(ctx context.Context, req *MsgSplitRouteSwapExactAmountIn) (*MsgSplitRouteSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitRouteSwapExactAmountIn not implemented")
+}
+func (*UnimplementedMsgServer) SplitRouteSwapExactAmountOut(ctx context.Context, req *MsgSplitRouteSwapExactAmountOut) (*MsgSplitRouteSwapExactAmountOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitRouteSwapExactAmountOut not implemented")
+}
+func (*UnimplementedMsgServer) EmergencyPausePools(ctx context.Context, req *MsgEmergencyPausePools) (*MsgEmergencyPausePoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmergencyPausePools not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -379,6 +747,60 @@ func _Msg_SwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SplitRouteSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSplitRouteSwapExactAmountIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SplitRouteSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/SplitRouteSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SplitRouteSwapExactAmountIn(ctx, req.(*MsgSplitRouteSwapExactAmountIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SplitRouteSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSplitRouteSwapExactAmountOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SplitRouteSwapExactAmountOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/SplitRouteSwapExactAmountOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SplitRouteSwapExactAmountOut(ctx, req.(*MsgSplitRouteSwapExactAmountOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EmergencyPausePools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEmergencyPausePools)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EmergencyPausePools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/EmergencyPausePools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EmergencyPausePools(ctx, req.(*MsgEmergencyPausePools))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.poolmanager.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -391,6 +813,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SwapExactAmountOut",
 			Handler:    _Msg_SwapExactAmountOut_Handler,
 		},
+		{
+			MethodName: "SplitRouteSwapExactAmountIn",
+			Handler:    _Msg_SplitRouteSwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "SplitRouteSwapExactAmountOut",
+			Handler:    _Msg_SplitRouteSwapExactAmountOut_Handler,
+		},
+		{
+			MethodName: "EmergencyPausePools",
+			Handler:    _Msg_EmergencyPausePools_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/poolmanager/v1beta1/tx.proto",
@@ -590,13 +1024,290 @@ func (m *MsgSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSplitRouteSwapExactAmountIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitRouteSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitRouteSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenInMaxAmount.Size()
+		i -= size
+		if _, err := m.TokenInMaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitRouteSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEmergencyPausePools) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEmergencyPausePools) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEmergencyPausePools) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolTypes) > 0 {
+		dAtA4 := make([]byte, len(m.PoolTypes)*10)
+		var j3 int
+		for _, num := range m.PoolTypes {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PoolIds) > 0 {
+		dAtA6 := make([]byte, len(m.PoolIds)*10)
+		var j5 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEmergencyPausePoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEmergencyPausePoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEmergencyPausePoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -651,31 +1362,673 @@ func (m *MsgSwapExactAmountOut) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = m.TokenInMaxAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TokenOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.TokenInMaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenInMaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgEmergencyPausePools) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.PoolTypes) > 0 {
+		l = 0
+		for _, e := range m.PoolTypes {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgEmergencyPausePoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountOutRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSwapExactAmountOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.TokenInAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -698,10 +2051,10 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -765,16 +2118,16 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -784,24 +2137,23 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -858,7 +2210,7 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -881,10 +2233,10 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -942,7 +2294,7 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -965,10 +2317,10 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1032,14 +2384,14 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutRoute{})
+			m.Routes = append(m.Routes, SwapAmountOutSplitRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1067,15 +2419,13 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1085,22 +2435,23 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1125,7 +2476,7 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1148,10 +2499,10 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1209,6 +2560,283 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgEmergencyPausePools) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEmergencyPausePools: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEmergencyPausePools: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v PoolType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PoolType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolTypes = append(m.PoolTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PoolTypes) == 0 {
+					m.PoolTypes = make([]PoolType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PoolType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PoolType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolTypes = append(m.PoolTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTypes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEmergencyPausePoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEmergencyPausePoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEmergencyPausePoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,149 @@
+package poolmanager
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// GetPoolVolume returns the lifetime volume and collected swap fees of the given pool.
+// The volume of a denom is the amount of it swapped into and out of the pool through the router.
+func (k Keeper) GetPoolVolume(ctx sdk.Context, poolId uint64) types.PoolVolume {
+	store := ctx.KVStore(k.storeKey)
+	poolVolume := types.PoolVolume{}
+	found, err := osmoutils.Get(store, types.FormatPoolVolumeKey(poolId), &poolVolume)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return newPoolVolume(poolId)
+	}
+	return poolVolume
+}
+
+// GetPoolVolumeInWindow returns the volume and collected swap fees of the given pool over the window
+// ending at the current block time. Volume is tracked in buckets of types.VolumeBucketDuration, so the
+// window is extended to the start of the bucket it begins in.
+// Returns error if the window is not positive or is longer than types.MaxVolumeWindow.
+func (k Keeper) GetPoolVolumeInWindow(ctx sdk.Context, poolId uint64, window time.Duration) (types.PoolVolume, error) {
+	if window <= 0 || window > types.MaxVolumeWindow {
+		return types.PoolVolume{}, types.InvalidVolumeWindowError{Window: window, MaxWindow: types.MaxVolumeWindow}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	windowStart := ctx.BlockTime().Add(-window).Truncate(types.VolumeBucketDuration)
+	bucketsEnd := sdk.PrefixEndBytes(types.FormatPoolVolumeBucketPrefix(poolId))
+	buckets, err := osmoutils.GatherValuesFromStore(store, types.FormatPoolVolumeBucketKey(poolId, windowStart), bucketsEnd, parsePoolVolume)
+	if err != nil {
+		return types.PoolVolume{}, err
+	}
+
+	poolVolume := newPoolVolume(poolId)
+	for _, bucket := range buckets {
+		poolVolume.Volume = poolVolume.Volume.Add(bucket.Volume...)
+		poolVolume.SwapFees = poolVolume.SwapFees.Add(bucket.SwapFees...)
+	}
+	return poolVolume, nil
+}
+
+// getAllPoolVolumes returns the lifetime volume of every pool that has been swapped through.
+func (k Keeper) getAllPoolVolumes(ctx sdk.Context) []types.PoolVolume {
+	store := ctx.KVStore(k.storeKey)
+	poolVolumes, err := osmoutils.GatherValuesFromStorePrefix(store, types.PoolVolumePrefix, parsePoolVolume)
+	if err != nil {
+		panic(err)
+	}
+	return poolVolumes
+}
+
+// setPoolVolume sets the lifetime volume of the pool.
+func (k Keeper) setPoolVolume(ctx sdk.Context, poolVolume types.PoolVolume) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.FormatPoolVolumeKey(poolVolume.PoolId), &poolVolume)
+}
+
+// getAllPoolVolumeBuckets returns the volume buckets of every pool, ordered by pool and start time.
+func (k Keeper) getAllPoolVolumeBuckets(ctx sdk.Context) []types.PoolVolumeBucket {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PoolVolumeBucketPrefix)
+	defer iter.Close()
+
+	buckets := []types.PoolVolumeBucket{}
+	for ; iter.Valid(); iter.Next() {
+		poolVolume, err := parsePoolVolume(iter.Value())
+		if err != nil {
+			panic(err)
+		}
+		// The key ends with the big endian unix time of the bucket start.
+		key := iter.Key()
+		startTime := time.Unix(int64(sdk.BigEndianToUint64(key[len(key)-8:])), 0).UTC()
+		buckets = append(buckets, types.PoolVolumeBucket{StartTime: startTime, PoolVolume: poolVolume})
+	}
+	return buckets
+}
+
+// setPoolVolumeBucket sets the volume of the pool in the bucket starting at bucket.StartTime.
+func (k Keeper) setPoolVolumeBucket(ctx sdk.Context, bucket types.PoolVolumeBucket) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.FormatPoolVolumeBucketKey(bucket.PoolVolume.PoolId, bucket.StartTime), &bucket.PoolVolume)
+}
+
+// trackVolume adds tokenIn and tokenOut to the lifetime volume of the pool and to the volume of the
+// current bucket. The swap fee charged on tokenIn is added to the collected swap fees. Buckets older
+// than types.MaxVolumeWindow are pruned.
+func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, tokenIn, tokenOut sdk.Coin, swapFee sdk.Dec) {
+	volume := sdk.NewCoins(tokenIn, tokenOut)
+	swapFees := sdk.NewCoins(sdk.NewCoin(tokenIn.Denom, tokenIn.Amount.ToDec().MulTruncate(swapFee).TruncateInt()))
+
+	poolVolume := k.GetPoolVolume(ctx, poolId)
+	poolVolume.Volume = poolVolume.Volume.Add(volume...)
+	poolVolume.SwapFees = poolVolume.SwapFees.Add(swapFees...)
+	k.setPoolVolume(ctx, poolVolume)
+
+	store := ctx.KVStore(k.storeKey)
+	bucketKey := types.FormatPoolVolumeBucketKey(poolId, ctx.BlockTime().Truncate(types.VolumeBucketDuration))
+	bucket := newPoolVolume(poolId)
+	if _, err := osmoutils.Get(store, bucketKey, &bucket); err != nil {
+		panic(err)
+	}
+	bucket.Volume = bucket.Volume.Add(volume...)
+	bucket.SwapFees = bucket.SwapFees.Add(swapFees...)
+	osmoutils.MustSet(store, bucketKey, &bucket)
+
+	k.pruneVolumeBuckets(ctx, poolId)
+}
+
+// pruneVolumeBuckets deletes the volume buckets of the pool that can no longer be part of any window.
+func (k Keeper) pruneVolumeBuckets(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	pruneBefore := ctx.BlockTime().Add(-types.MaxVolumeWindow).Truncate(types.VolumeBucketDuration)
+
+	// Keys are collected first, since the store must not be mutated while iterating.
+	keysToDelete := [][]byte{}
+	iter := store.Iterator(types.FormatPoolVolumeBucketPrefix(poolId), types.FormatPoolVolumeBucketKey(poolId, pruneBefore))
+	for ; iter.Valid(); iter.Next() {
+		keysToDelete = append(keysToDelete, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+}
+
+func newPoolVolume(poolId uint64) types.PoolVolume {
+	return types.PoolVolume{
+		PoolId:   poolId,
+		Volume:   sdk.NewCoins(),
+		SwapFees: sdk.NewCoins(),
+	}
+}
+
+func parsePoolVolume(bz []byte) (types.PoolVolume, error) {
+	poolVolume := types.PoolVolume{}
+	err := poolVolume.Unmarshal(bz)
+	return poolVolume, err
+}
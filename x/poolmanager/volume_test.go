@@ -0,0 +1,117 @@
+package poolmanager_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+// TestTrackVolume tests that swaps routed through the poolmanager add to the lifetime volume
+// and collected swap fees of every pool they trade through.
+func (suite *KeeperTestSuite) TestTrackVolume() {
+	suite.SetupTest()
+	poolmanagerKeeper := suite.App.PoolManagerKeeper
+	suite.createBalancerPoolsFromCoinsWithSwapFee([]sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)),
+		sdk.NewCoins(sdk.NewCoin(bar, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)),
+	}, []sdk.Dec{defaultPoolSwapFee, sdk.ZeroDec()})
+
+	sender := suite.TestAccs[1]
+	tokenIn := sdk.NewCoin(foo, sdk.NewInt(100000))
+	suite.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+	// Swap through both pools with an exact amount in.
+	routes := []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}, {PoolId: 2, TokenOutDenom: baz}}
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	barOut, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(cacheCtx, routes[:1], tokenIn)
+	suite.Require().NoError(err)
+	bazOut, err := poolmanagerKeeper.RouteExactAmountIn(suite.Ctx, sender, routes, tokenIn, sdk.OneInt())
+	suite.Require().NoError(err)
+
+	expectedPool1Volume := types.PoolVolume{
+		PoolId:   1,
+		Volume:   sdk.NewCoins(tokenIn, sdk.NewCoin(bar, barOut)),
+		SwapFees: sdk.NewCoins(sdk.NewCoin(foo, sdk.NewInt(1000))), // 1% of 100000
+	}
+	expectedPool2Volume := types.PoolVolume{
+		PoolId:   2,
+		Volume:   sdk.NewCoins(sdk.NewCoin(bar, barOut), sdk.NewCoin(baz, bazOut)),
+		SwapFees: sdk.NewCoins(),
+	}
+	suite.requirePoolVolumeEqual(expectedPool1Volume, poolmanagerKeeper.GetPoolVolume(suite.Ctx, 1))
+	suite.requirePoolVolumeEqual(expectedPool2Volume, poolmanagerKeeper.GetPoolVolume(suite.Ctx, 2))
+
+	// Swap back through the first pool with an exact amount out.
+	tokenOut := sdk.NewCoin(foo, sdk.NewInt(50000))
+	suite.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(bar, barOut)))
+	barIn, err := poolmanagerKeeper.RouteExactAmountOut(suite.Ctx, sender, []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: bar}}, barOut, tokenOut)
+	suite.Require().NoError(err)
+
+	expectedPool1Volume.Volume = expectedPool1Volume.Volume.Add(tokenOut, sdk.NewCoin(bar, barIn))
+	expectedPool1Volume.SwapFees = expectedPool1Volume.SwapFees.Add(sdk.NewCoin(bar, barIn.ToDec().Mul(defaultPoolSwapFee).TruncateInt()))
+	suite.requirePoolVolumeEqual(expectedPool1Volume, poolmanagerKeeper.GetPoolVolume(suite.Ctx, 1))
+
+	// A pool that has not been swapped through has no volume.
+	suite.requirePoolVolumeEqual(types.PoolVolume{PoolId: 3}, poolmanagerKeeper.GetPoolVolume(suite.Ctx, 3))
+}
+
+func (suite *KeeperTestSuite) requirePoolVolumeEqual(expected, actual types.PoolVolume) {
+	suite.Require().Equal(expected.PoolId, actual.PoolId)
+	suite.Require().Equal(expected.Volume.String(), actual.Volume.String())
+	suite.Require().Equal(expected.SwapFees.String(), actual.SwapFees.String())
+}
+
+// TestGetPoolVolumeInWindow tests that only the volume of the buckets within the window is returned
+// and that buckets older than the maximum window are pruned.
+func (suite *KeeperTestSuite) TestGetPoolVolumeInWindow() {
+	suite.SetupTest()
+	poolmanagerKeeper := suite.App.PoolManagerKeeper
+	suite.createBalancerPoolsFromCoinsWithSwapFee([]sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)),
+	}, []sdk.Dec{sdk.ZeroDec()})
+
+	sender := suite.TestAccs[1]
+	startTime := suite.Ctx.BlockTime().Truncate(types.VolumeBucketDuration)
+
+	// swapAt swaps tokenIn at the given time and returns the tracked volume.
+	swapAt := func(blockTime time.Time, tokenIn sdk.Coin) sdk.Coins {
+		suite.Ctx = suite.Ctx.WithBlockTime(blockTime)
+		suite.FundAcc(sender, sdk.NewCoins(tokenIn))
+		tokenOutAmount, err := poolmanagerKeeper.SwapExactAmountIn(suite.Ctx, sender, 1, tokenIn, bar, sdk.OneInt())
+		suite.Require().NoError(err)
+		return sdk.NewCoins(tokenIn, sdk.NewCoin(bar, tokenOutAmount))
+	}
+
+	firstVolume := swapAt(startTime, sdk.NewCoin(foo, sdk.NewInt(1000)))
+	secondVolume := swapAt(startTime.Add(2*time.Hour), sdk.NewCoin(foo, sdk.NewInt(2000)))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(2*time.Hour + 30*time.Minute))
+
+	// The window starts in the bucket of the second swap.
+	poolVolume, err := poolmanagerKeeper.GetPoolVolumeInWindow(suite.Ctx, 1, time.Hour)
+	suite.Require().NoError(err)
+	suite.Require().Equal(secondVolume.String(), poolVolume.Volume.String())
+
+	// The window starts in the bucket of the first swap.
+	poolVolume, err = poolmanagerKeeper.GetPoolVolumeInWindow(suite.Ctx, 1, 2*time.Hour+30*time.Minute)
+	suite.Require().NoError(err)
+	suite.Require().Equal(firstVolume.Add(secondVolume...).String(), poolVolume.Volume.String())
+
+	// Invalid windows.
+	_, err = poolmanagerKeeper.GetPoolVolumeInWindow(suite.Ctx, 1, 0)
+	suite.Require().ErrorIs(err, types.InvalidVolumeWindowError{Window: 0, MaxWindow: types.MaxVolumeWindow})
+	_, err = poolmanagerKeeper.GetPoolVolumeInWindow(suite.Ctx, 1, types.MaxVolumeWindow+time.Nanosecond)
+	suite.Require().ErrorIs(err, types.InvalidVolumeWindowError{Window: types.MaxVolumeWindow + time.Nanosecond, MaxWindow: types.MaxVolumeWindow})
+
+	// A swap after the maximum window prunes the buckets of the earlier swaps.
+	thirdVolume := swapAt(startTime.Add(types.MaxVolumeWindow+3*time.Hour), sdk.NewCoin(foo, sdk.NewInt(3000)))
+	poolVolume, err = poolmanagerKeeper.GetPoolVolumeInWindow(suite.Ctx, 1, types.MaxVolumeWindow)
+	suite.Require().NoError(err)
+	suite.Require().Equal(thirdVolume.String(), poolVolume.Volume.String())
+	suite.Require().Len(poolmanagerKeeper.GetPoolVolumeBuckets(suite.Ctx, 1), 1)
+
+	// The lifetime volume is not affected by pruning.
+	suite.Require().Equal(firstVolume.Add(secondVolume...).Add(thirdVolume...).String(), poolmanagerKeeper.GetPoolVolume(suite.Ctx, 1).Volume.String())
+}
@@ -149,6 +149,14 @@ func ExtractSwappedPools(tx sdk.Tx) []SwapToBackrun {
 			swappedPools = append(swappedPools, extractSwapInPools(msg.Routes, msg.TokenIn.Denom)...)
 		case *poolmanagertypes.MsgSwapExactAmountOut:
 			swappedPools = append(swappedPools, extractSwapOutPools(msg.Routes, msg.TokenOut.Denom)...)
+		case *poolmanagertypes.MsgSplitRouteSwapExactAmountIn:
+			for _, route := range msg.Routes {
+				swappedPools = append(swappedPools, extractSwapInPools(route.Pools, msg.TokenInDenom)...)
+			}
+		case *poolmanagertypes.MsgSplitRouteSwapExactAmountOut:
+			for _, route := range msg.Routes {
+				swappedPools = append(swappedPools, extractSwapOutPools(route.Pools, msg.TokenOutDenom)...)
+			}
 		case *gammtypes.MsgSwapExactAmountIn:
 			swappedPools = append(swappedPools, extractSwapInPools(msg.Routes, msg.TokenIn.Denom)...)
 		case *gammtypes.MsgSwapExactAmountOut:
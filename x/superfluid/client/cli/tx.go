@@ -30,6 +30,7 @@ func GetTxCmd() *cobra.Command {
 		// NewSuperfluidRedelegateCmd(),
 		NewCmdLockAndSuperfluidDelegate(),
 		NewCmdUnPoolWhitelistedPool(),
+		NewCmdMigrateSharesToConcentratedPosition(),
 	)
 
 	return cmd
@@ -295,6 +296,17 @@ func NewCmdUnPoolWhitelistedPool() *cobra.Command {
 	})
 }
 
+func NewCmdMigrateSharesToConcentratedPosition() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgMigrateSharesToConcentratedPosition](&osmocli.TxCliDesc{
+		Use:   "migrate-to-concentrated-position [lock_id] [shares_to_migrate] [lower_tick] [upper_tick] [token_out_mins] [flags]",
+		Short: "migrate balancer pool shares to a concentrated liquidity position in the given tick range",
+		Long: `Migrate balancer pool shares to a concentrated liquidity position between the lower and upper tick in the linked concentrated pool.
+If lock_id is 0, the shares are migrated from the sender's balance. Otherwise, they are unlocked from the given lock, superfluid undelegating it if needed.
+Exited tokens that are not used by the position stay in the sender's balance.`,
+		Example: "migrate-to-concentrated-position 1 1000000gamm/pool/1 [-69082] 69082 1000uosmo,1000uion --from val --chain-id osmosis-1",
+	})
+}
+
 // NewCmdUpdateUnpoolWhitelistProposal defines the command to create a new update unpool whitelist proposal command.
 func NewCmdUpdateUnpoolWhitelistProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -63,6 +63,22 @@ func (h Hooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 func (h Hooks) OnLockupExtend(ctx sdk.Context, lockID uint64, oldDuration, newDuration time.Duration) {
 }
 
+// OnLockSplit connects the new lock to the intermediary account of the lock it was split from.
+// The delegation of the intermediary account is unchanged, as the split does not change the total
+// amount of tokens superfluid delegated through it.
+func (h Hooks) OnLockSplit(ctx sdk.Context, address sdk.AccAddress, lockID, newLockID uint64, amount sdk.Coins) {
+	intermediaryAcc, found := h.k.GetIntermediaryAccountFromLockId(ctx, lockID)
+	if found {
+		h.k.SetLockIdIntermediaryAccountConnection(ctx, newLockID, intermediaryAcc)
+	}
+}
+
+// OnLocksMerged deletes the intermediary account connection of the lock merged away.
+// The lockup module only merges locks with the same synthetic lockups, thus the same intermediary account.
+func (h Hooks) OnLocksMerged(ctx sdk.Context, address sdk.AccAddress, lockID, mergedLockID uint64, amount sdk.Coins) {
+	h.k.DeleteLockIdIntermediaryAccountConnection(ctx, mergedLockID)
+}
+
 // staking hooks.
 func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)   {}
 func (h Hooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) {}
@@ -364,3 +364,48 @@ func (suite *KeeperTestSuite) TestAfterAddTokensToLock_Event() {
 		suite.AssertEventEmitted(suite.Ctx, types.TypeEvtSuperfluidIncreaseDelegation, 1)
 	}
 }
+
+// TestOnLockSplitAndMerged tests that splitting and merging superfluid delegated locks
+// keeps the intermediary account connections and delegations consistent.
+func (suite *KeeperTestSuite) TestOnLockSplitAndMerged() {
+	suite.SetupTest()
+
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+
+	// setup superfluid delegations
+	_, intermediaryAccs, locks := suite.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+	suite.checkIntermediaryAccountDelegations(intermediaryAccs)
+	lock := locks[0]
+	owner := lock.OwnerAddress()
+	intermediaryAcc := intermediaryAccs[0]
+	delegationBefore, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, intermediaryAcc.GetAccAddress(), valAddrs[0])
+	suite.Require().True(found)
+
+	lockupMsgServer := lockupkeeper.NewMsgServerImpl(suite.App.LockupKeeper)
+	c := sdk.WrapSDKContext(suite.Ctx)
+
+	// the split lock is connected to the same intermediary account, without changing its delegation
+	splitResp, err := lockupMsgServer.SplitLock(c, lockuptypes.NewMsgSplitLock(owner, lock.ID, sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 400000))))
+	suite.Require().NoError(err)
+	suite.Require().Equal(intermediaryAcc.GetAccAddress(), suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, splitResp.NewLockID))
+	suite.Require().Equal(intermediaryAcc.GetAccAddress(), suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, lock.ID))
+	delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, intermediaryAcc.GetAccAddress(), valAddrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(delegationBefore, delegation)
+
+	// the merged lock's connection is deleted, without changing the delegation
+	mergeResp, err := lockupMsgServer.MergeLocks(c, lockuptypes.NewMsgMergeLocks(owner, []uint64{lock.ID, splitResp.NewLockID}))
+	suite.Require().NoError(err)
+	suite.Require().Equal(lock.ID, mergeResp.ID)
+	suite.Require().Empty(suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, splitResp.NewLockID))
+	suite.Require().Equal(intermediaryAcc.GetAccAddress(), suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, lock.ID))
+	delegation, found = suite.App.StakingKeeper.GetDelegation(suite.Ctx, intermediaryAcc.GetAccAddress(), valAddrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(delegationBefore, delegation)
+
+	// the merged lock can be superfluid undelegated as a whole
+	err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, owner.String(), lock.ID)
+	suite.Require().NoError(err)
+}
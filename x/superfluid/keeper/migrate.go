@@ -28,30 +28,92 @@ func (k Keeper) UnlockAndMigrate(ctx sdk.Context, sender sdk.AccAddress, lockId
 		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, 0, 0, err
 	}
 
+	// Unlock the shares to migrate, re-locking any remaining shares.
+	sharesToMigrate, newLockId, freezeDuration, err = k.unlockSharesForMigration(ctx, sender, poolIdLeaving, lockId, sharesToMigrate)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, 0, 0, err
+	}
+
+	// Exit the balancer pool position.
+	exitCoins, err := k.gk.ExitPool(ctx, sender, poolIdLeaving, sharesToMigrate.Amount, sdk.NewCoins())
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, 0, 0, err
+	}
+	// Defense in depth, ensuring we are returning exactly two coins.
+	if len(exitCoins) != 2 {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, 0, 0, fmt.Errorf("Balancer pool must have exactly two tokens")
+	}
+
+	// Create a full range (min to max tick) concentrated liquidity position.
+	positionId, amount0, amount1, liquidity, joinTime, err = k.clk.CreateFullRangePosition(ctx, concentratedPool, sender, exitCoins, freezeDuration)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, 0, 0, err
+	}
+
+	return positionId, amount0, amount1, liquidity, joinTime, poolIdLeaving, poolIdEntering, newLockId, freezeDuration, nil
+}
+
+// MigrateSharesToConcentratedPosition migrates balancer pool shares to a concentrated liquidity position between lowerTick
+// and upperTick in the concentrated liquidity pool linked to the balancer pool.
+// If lockId is zero, the shares are migrated from the sender's balance. Otherwise, the lock is unlocked, superfluid undelegating
+// it if it is superfluid delegated, and the position is frozen for the time remaining on the lock.
+// The exited tokens that are not used by the position are left in the sender's account and returned as leftoverCoins.
+// Errors if the lock is not a balancer pool lock owned by the sender, or if the position would use less of either
+// concentrated pool token than given by tokenOutMins.
+func (k Keeper) MigrateSharesToConcentratedPosition(ctx sdk.Context, sender sdk.AccAddress, lockId uint64, sharesToMigrate sdk.Coin, lowerTick, upperTick int64, tokenOutMins sdk.Coins) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, joinTime time.Time, leftoverCoins sdk.Coins, poolIdLeaving, poolIdEntering, newLockId uint64, freezeDuration time.Duration, err error) {
+	if lockId != 0 {
+		// Get the balancer poolId by parsing the gamm share denom.
+		poolIdLeaving, err = gammtypes.GetPoolIdFromShareDenom(sharesToMigrate.Denom)
+		if err != nil {
+			return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, sdk.Coins{}, 0, 0, 0, 0, err
+		}
+
+		// Unlock the shares to migrate, re-locking any remaining shares.
+		sharesToMigrate, newLockId, freezeDuration, err = k.unlockSharesForMigration(ctx, sender, poolIdLeaving, lockId, sharesToMigrate)
+		if err != nil {
+			return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, sdk.Coins{}, 0, 0, 0, 0, err
+		}
+	}
+
+	positionId, amount0, amount1, liquidity, joinTime, leftoverCoins, poolIdLeaving, poolIdEntering, err = k.gk.MigrateSharesToConcentratedPosition(ctx, sender, sharesToMigrate, lowerTick, upperTick, tokenOutMins, freezeDuration)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, sdk.Coins{}, 0, 0, 0, 0, err
+	}
+
+	return positionId, amount0, amount1, liquidity, joinTime, leftoverCoins, poolIdLeaving, poolIdEntering, newLockId, freezeDuration, nil
+}
+
+// unlockSharesForMigration unlocks the given balancer pool lock, superfluid undelegating it if it is superfluid delegated,
+// so that the shares to migrate end up in the sender's balance. If shares to migrate is zero, all shares in the lock are migrated.
+// Shares in the lock that are not migrated are re-locked into a new lock, which keeps unlocking if the original lock was unlocking.
+// Returns the shares to migrate, the id of the new lock if any and the time that was remaining on the lock.
+// Errors if the lock is not found, if the lock is not a balancer pool lock, if the lock is not owned by the sender,
+// or if the shares to migrate exceed the shares in the lock.
+func (k Keeper) unlockSharesForMigration(ctx sdk.Context, sender sdk.AccAddress, poolIdLeaving, lockId uint64, sharesToMigrate sdk.Coin) (sdk.Coin, uint64, time.Duration, error) {
 	// Check that lockID corresponds to sender, and contains correct denomination of LP shares.
 	lock, err := k.validateLockForUnpool(ctx, sender, poolIdLeaving, lockId)
 	if err != nil {
-		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, 0, 0, err
+		return sdk.Coin{}, 0, 0, err
 	}
 	gammSharesInLock := lock.Coins[0]
 	preUnlockLock := *lock
 
 	// Before we break the lock, we must note the time remaining on the lock.
 	// We will be freezing the concentrated liquidity position for this duration.
-	freezeDuration = k.getExistingLockRemainingDuration(ctx, lock)
+	freezeDuration := k.getExistingLockRemainingDuration(ctx, lock)
 
 	// If superfluid delegated, superfluid undelegate
 	// This also burns the underlying synthetic osmo
 	err = k.unbondSuperfluidIfExists(ctx, sender, lockId)
 	if err != nil {
-		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, 0, 0, err
+		return sdk.Coin{}, 0, 0, err
 	}
 
 	// Finish unlocking directly for locked locks
 	// this also unlocks locks that were in the unlocking queue
 	err = k.lk.ForceUnlock(ctx, *lock)
 	if err != nil {
-		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, 0, 0, err
+		return sdk.Coin{}, 0, 0, err
 	}
 
 	// If shares to migrate is not specified, we migrate all shares.
@@ -61,41 +123,26 @@ func (k Keeper) UnlockAndMigrate(ctx sdk.Context, sender sdk.AccAddress, lockId
 
 	// Otherwise, we must ensure that the shares to migrate is less than or equal to the shares in the lock.
 	if sharesToMigrate.Amount.GT(gammSharesInLock.Amount) {
-		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, 0, 0, fmt.Errorf("shares to migrate must be less than or equal to shares in lock")
-	}
-
-	// Exit the balancer pool position.
-	exitCoins, err := k.gk.ExitPool(ctx, sender, poolIdLeaving, sharesToMigrate.Amount, sdk.NewCoins())
-	if err != nil {
-		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, 0, 0, err
-	}
-	// Defense in depth, ensuring we are returning exactly two coins.
-	if len(exitCoins) != 2 {
-		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, 0, 0, fmt.Errorf("Balancer pool must have exactly two tokens")
-	}
-
-	// Create a full range (min to max tick) concentrated liquidity position.
-	positionId, amount0, amount1, liquidity, joinTime, err = k.clk.CreateFullRangePosition(ctx, concentratedPool, sender, exitCoins, freezeDuration)
-	if err != nil {
-		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, 0, 0, err
+		return sdk.Coin{}, 0, 0, fmt.Errorf("shares to migrate must be less than or equal to shares in lock")
 	}
 
 	// If there are remaining gamm shares, we must re-lock them.
+	newLockId := uint64(0)
 	remainingGammShares := gammSharesInLock.Sub(sharesToMigrate)
 	if !remainingGammShares.IsZero() {
 		newLock, err := k.lk.CreateLock(ctx, sender, sdk.NewCoins(remainingGammShares), freezeDuration)
-		newLockId = newLock.ID
 		if err != nil {
-			return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, 0, 0, err
+			return sdk.Coin{}, 0, 0, err
 		}
+		newLockId = newLock.ID
 		// If the lock was unlocking, we begin the unlock from where it left off.
 		if preUnlockLock.IsUnlocking() {
 			_, err := k.lk.BeginForceUnlock(ctx, newLock.ID, newLock.Coins)
 			if err != nil {
-				return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, 0, 0, 0, 0, err
+				return sdk.Coin{}, 0, 0, err
 			}
 		}
 	}
 
-	return positionId, amount0, amount1, liquidity, joinTime, poolIdLeaving, poolIdEntering, newLockId, freezeDuration, nil
+	return sharesToMigrate, newLockId, freezeDuration, nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
 	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v15/x/lockup/types"
 	"github.com/osmosis-labs/osmosis/v15/x/superfluid/keeper"
 	"github.com/osmosis-labs/osmosis/v15/x/superfluid/types"
 )
@@ -254,3 +255,92 @@ func (suite *KeeperTestSuite) TestUnlockAndMigrate() {
 		})
 	}
 }
+
+// TestMigrateSharesToConcentratedPosition tests that unlocked, locked and superfluid delegated balancer shares
+// are all migrated to a concentrated liquidity position by the same call.
+func (suite *KeeperTestSuite) TestMigrateSharesToConcentratedPosition() {
+	sharesAmount := int64(9000000000000000000)
+	testCases := map[string]struct {
+		locked              bool
+		superfluidDelegated bool
+		lockOwnedByOther    bool
+		expectedErr         error
+	}{
+		"unlocked shares": {},
+		"locked shares": {
+			locked: true,
+		},
+		"superfluid delegated shares": {
+			locked:              true,
+			superfluidDelegated: true,
+		},
+		"error: lock not owned by sender": {
+			locked:           true,
+			lockOwnedByOther: true,
+			expectedErr:      lockuptypes.ErrNotLockOwner,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			superfluidKeeper := suite.App.SuperfluidKeeper
+			unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+
+			// Set a balancer pool whose shares are a superfluid asset, linked to a concentrated pool with the same denoms.
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, poolIds := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+			clPool := suite.PrepareCustomConcentratedPool(suite.TestAccs[0], "stake", "token0", 1, sdk.NewInt(-6), sdk.ZeroDec())
+			suite.App.GAMMKeeper.SetMigrationInfo(suite.Ctx, gammtypes.MigrationRecords{BalancerToConcentratedPoolLinks: []gammtypes.BalancerToConcentratedPoolLink{
+				{BalancerPoolId: poolIds[0], ClPoolId: clPool.GetId()},
+			}})
+
+			sender := CreateRandomAccounts(1)[0]
+			shares := sdk.NewInt64Coin(denoms[0], sharesAmount)
+			lockId := uint64(0)
+			switch {
+			case tc.superfluidDelegated:
+				lockId = suite.setupSuperfluidDelegate(sender, valAddrs[0], denoms[0], sharesAmount).ID
+			case tc.locked:
+				lockOwner := sender
+				if tc.lockOwnedByOther {
+					lockOwner = suite.TestAccs[1]
+				}
+				lockId = suite.LockTokens(lockOwner, sdk.NewCoins(shares), unbondingDuration)
+			default:
+				suite.FundAcc(sender, sdk.NewCoins(shares))
+			}
+
+			minTick, maxTick := cl.GetMinAndMaxTicksFromExponentAtPriceOne(clPool.GetPrecisionFactorAtPriceOne())
+			positionId, _, _, liquidity, joinTime, leftoverCoins, poolIdLeaving, poolIdEntering, newLockId, freezeDuration, err := superfluidKeeper.MigrateSharesToConcentratedPosition(suite.Ctx, sender, lockId, shares, minTick, maxTick, sdk.Coins{})
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(poolIds[0], poolIdLeaving)
+			suite.Require().Equal(clPool.GetId(), poolIdEntering)
+			suite.Require().Zero(newLockId)
+
+			// Migrated locks are deleted, and the position is frozen for the time that was remaining on the lock.
+			if tc.locked {
+				suite.Require().Equal(unbondingDuration, freezeDuration)
+				_, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockId)
+				suite.Require().Error(err)
+			} else {
+				suite.Require().Zero(freezeDuration)
+			}
+			if tc.superfluidDelegated {
+				suite.Require().Equal("", superfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, lockId).String())
+			}
+
+			positionLiquidity, err := suite.App.ConcentratedLiquidityKeeper.GetPositionLiquidity(suite.Ctx, clPool.GetId(), sender, minTick, maxTick, joinTime, freezeDuration, positionId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(liquidity, positionLiquidity)
+
+			// The sender is left with only the exited tokens that the position did not use.
+			suite.Require().Equal(leftoverCoins.String(), suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender).String())
+		})
+	}
+}
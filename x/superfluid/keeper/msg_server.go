@@ -196,3 +196,47 @@ func (server msgServer) UnlockAndMigrateSharesToFullRangeConcentratedPosition(go
 
 	return &types.MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse{Amount0: amount0, Amount1: amount1, LiquidityCreated: liquidity}, err
 }
+
+func (server msgServer) MigrateSharesToConcentratedPosition(goCtx context.Context, msg *types.MsgMigrateSharesToConcentratedPosition) (*types.MsgMigrateSharesToConcentratedPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	positionId, amount0, amount1, liquidity, joinTime, leftoverCoins, poolIdLeaving, poolIdEntering, newLockId, freezeDuration, err := server.keeper.MigrateSharesToConcentratedPosition(ctx, sender, msg.LockId, msg.SharesToMigrate, msg.LowerTick, msg.UpperTick, msg.TokenOutMins)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtMigrateSharesToConcentratedPosition,
+			sdk.NewAttribute(types.AttributeKeyPoolIdEntering, strconv.FormatUint(poolIdEntering, 10)),
+			sdk.NewAttribute(types.AttributeKeyPoolIdLeaving, strconv.FormatUint(poolIdLeaving, 10)),
+			sdk.NewAttribute(types.AttributeLockId, strconv.FormatUint(msg.LockId, 10)),
+			sdk.NewAttribute(types.AttributeNewLockId, strconv.FormatUint(newLockId, 10)),
+			sdk.NewAttribute(types.AttributeFreezeDuration, freezeDuration.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributePositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(msg.LowerTick, 10)),
+			sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(msg.UpperTick, 10)),
+			sdk.NewAttribute(types.AttributeAmount0, amount0.String()),
+			sdk.NewAttribute(types.AttributeAmount1, amount1.String()),
+			sdk.NewAttribute(types.AttributeLiquidity, liquidity.String()),
+			sdk.NewAttribute(types.AttributeJoinTime, joinTime.String()),
+			sdk.NewAttribute(types.AttributeLeftoverCoins, leftoverCoins.String()),
+		),
+	})
+
+	return &types.MsgMigrateSharesToConcentratedPositionResponse{
+		PositionId:       positionId,
+		Amount0:          amount0,
+		Amount1:          amount1,
+		LiquidityCreated: liquidity,
+		JoinTime:         joinTime,
+		LeftoverCoins:    leftoverCoins,
+		NewLockId:        newLockId,
+	}, nil
+}
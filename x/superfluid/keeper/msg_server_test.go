@@ -9,6 +9,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	v8constants "github.com/osmosis-labs/osmosis/v15/app/upgrades/v8/constants"
+	cl "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity"
 	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 	lockupkeeper "github.com/osmosis-labs/osmosis/v15/x/lockup/keeper"
 	lockuptypes "github.com/osmosis-labs/osmosis/v15/x/lockup/types"
@@ -419,3 +420,34 @@ func (suite *KeeperTestSuite) TestUnlockAndMigrateSharesToFullRangeConcentratedP
 	// Asset event emitted
 	suite.AssertEventEmitted(suite.Ctx, types.TypeEvtUnlockAndMigrateShares, 1)
 }
+
+func (suite *KeeperTestSuite) TestMigrateSharesToConcentratedPosition_Event() {
+	suite.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(suite.App.SuperfluidKeeper)
+
+	// Set validators
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+
+	// Set balancer pool and make its respective gamm share an authorized superfluid asset
+	denoms, poolIds := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+
+	// Set concentrated pool with the same denoms as the balancer pool, linked to the balancer pool
+	clPool := suite.PrepareCustomConcentratedPool(suite.TestAccs[0], "stake", "token0", 1, sdk.NewInt(-6), sdk.ZeroDec())
+	migrationRecord := gammtypes.MigrationRecords{BalancerToConcentratedPoolLinks: []gammtypes.BalancerToConcentratedPoolLink{
+		{BalancerPoolId: poolIds[0], ClPoolId: clPool.GetId()},
+	}}
+	suite.App.GAMMKeeper.SetMigrationInfo(suite.Ctx, migrationRecord)
+
+	// Superfluid delegate the balancer pool shares
+	_, _, locks := suite.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 9000000000000000000}}, denoms)
+
+	// Execute MigrateSharesToConcentratedPosition message
+	sender, _ := sdk.AccAddressFromBech32(locks[0].Owner)
+	minTick, maxTick := cl.GetMinAndMaxTicksFromExponentAtPriceOne(clPool.GetPrecisionFactorAtPriceOne())
+	_, err := msgServer.MigrateSharesToConcentratedPosition(sdk.WrapSDKContext(suite.Ctx),
+		types.NewMsgMigrateSharesToConcentratedPosition(sender, locks[0].ID, locks[0].Coins[0], minTick, maxTick, sdk.Coins{}))
+	suite.Require().NoError(err)
+
+	// Asset event emitted
+	suite.AssertEventEmitted(suite.Ctx, types.TypeEvtMigrateSharesToConcentratedPosition, 1)
+}
@@ -25,6 +25,11 @@ const (
 	AttributeLiquidity            = "liquidity"
 	AttributeJoinTime             = "join_time"
 
+	TypeEvtMigrateSharesToConcentratedPosition = "migrate_shares_to_concentrated_position"
+	AttributeLowerTick                         = "lower_tick"
+	AttributeUpperTick                         = "upper_tick"
+	AttributeLeftoverCoins                     = "leftover_coins"
+
 	AttributeDenom               = "denom"
 	AttributeSuperfluidAssetType = "superfluid_asset_type"
 	AttributeLockId              = "lock_id"
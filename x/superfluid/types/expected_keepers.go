@@ -50,6 +50,7 @@ type GammKeeper interface {
 	ExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, tokenOutMins sdk.Coins) (exitCoins sdk.Coins, err error)
 	GetMigrationInfo(ctx sdk.Context) gammtypes.MigrationRecords
 	GetLinkedConcentratedPoolID(ctx sdk.Context, poolIdLeaving uint64) (poolIdEntering uint64, err error)
+	MigrateSharesToConcentratedPosition(ctx sdk.Context, sender sdk.AccAddress, sharesToMigrate sdk.Coin, lowerTick, upperTick int64, tokenOutMins sdk.Coins, freezeDuration time.Duration) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, joinTime time.Time, leftoverCoins sdk.Coins, poolIdLeaving, poolIdEntering uint64, err error)
 }
 
 type BankKeeper interface {
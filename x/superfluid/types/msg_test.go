@@ -71,6 +71,17 @@ func TestAuthzMsg(t *testing.T) {
 				PoolId: 1,
 			},
 		},
+		{
+			name: "MsgMigrateSharesToConcentratedPosition",
+			msg: &types.MsgMigrateSharesToConcentratedPosition{
+				Sender:          addr1,
+				LockId:          1,
+				SharesToMigrate: sdk.NewCoin("gamm/pool/1", sdk.NewInt(1)),
+				LowerTick:       -1,
+				UpperTick:       1,
+				TokenOutMins:    sdk.NewCoins(coin),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
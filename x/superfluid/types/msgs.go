@@ -17,6 +17,7 @@ const (
 	TypeMsgLockAndSuperfluidDelegate          = "lock_and_superfluid_delegate"
 	TypeMsgUnPoolWhitelistedPool              = "unpool_whitelisted_pool"
 	TypeMsgUnlockAndMigrateShares             = "unlock_and_migrate_shares"
+	TypeMsgMigrateSharesToConcentrated        = "migrate_shares_to_concentrated_position"
 )
 
 var _ sdk.Msg = &MsgSuperfluidDelegate{}
@@ -301,3 +302,53 @@ func (msg MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) GetSigners()
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgMigrateSharesToConcentratedPosition{}
+
+func NewMsgMigrateSharesToConcentratedPosition(sender sdk.AccAddress, lockId uint64, sharesToMigrate sdk.Coin, lowerTick, upperTick int64, tokenOutMins sdk.Coins) *MsgMigrateSharesToConcentratedPosition {
+	return &MsgMigrateSharesToConcentratedPosition{
+		Sender:          sender.String(),
+		LockId:          lockId,
+		SharesToMigrate: sharesToMigrate,
+		LowerTick:       lowerTick,
+		UpperTick:       upperTick,
+		TokenOutMins:    tokenOutMins,
+	}
+}
+
+func (msg MsgMigrateSharesToConcentratedPosition) Route() string { return RouterKey }
+func (msg MsgMigrateSharesToConcentratedPosition) Type() string {
+	return TypeMsgMigrateSharesToConcentrated
+}
+func (msg MsgMigrateSharesToConcentratedPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	if err := msg.SharesToMigrate.Validate(); err != nil {
+		return fmt.Errorf("Invalid shares to migrate (%s): %w", msg.SharesToMigrate, err)
+	}
+	// Only migrations from a lock may leave the shares unspecified, to migrate all shares in the lock.
+	if msg.LockId == 0 && !msg.SharesToMigrate.IsPositive() {
+		return fmt.Errorf("Shares to migrate must be positive when not migrating from a lock (%s)", msg.SharesToMigrate)
+	}
+	if msg.LowerTick >= msg.UpperTick {
+		return fmt.Errorf("Lower tick (%d) must be less than upper tick (%d)", msg.LowerTick, msg.UpperTick)
+	}
+	if err := msg.TokenOutMins.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgMigrateSharesToConcentratedPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgMigrateSharesToConcentratedPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -735,6 +735,176 @@ func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) GetJo
 	return time.Time{}
 }
 
+// =====================
+// MsgMigrateSharesToConcentratedPosition
+// Exits the balancer pool with the given shares and creates a concentrated
+// liquidity position between lower_tick and upper_tick in the linked
+// concentrated pool. If lock_id is zero, the shares are taken from the sender's
+// balance. Otherwise, the shares are unlocked from the given lock, superfluid
+// undelegating it if needed, and the position is frozen for the time remaining
+// on the lock. Exited tokens that are not used by the position are left in the
+// sender's balance.
+type MsgMigrateSharesToConcentratedPosition struct {
+	Sender          string                                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId          uint64                                   `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
+	SharesToMigrate types.Coin                               `protobuf:"bytes,3,opt,name=shares_to_migrate,json=sharesToMigrate,proto3" json:"shares_to_migrate" yaml:"shares_to_migrate"`
+	LowerTick       int64                                    `protobuf:"varint,4,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick       int64                                    `protobuf:"varint,5,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	TokenOutMins    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=token_out_mins,json=tokenOutMins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"token_out_mins" yaml:"token_out_mins"`
+}
+
+func (m *MsgMigrateSharesToConcentratedPosition) Reset() {
+	*m = MsgMigrateSharesToConcentratedPosition{}
+}
+func (m *MsgMigrateSharesToConcentratedPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateSharesToConcentratedPosition) ProtoMessage()    {}
+func (*MsgMigrateSharesToConcentratedPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{14}
+}
+func (m *MsgMigrateSharesToConcentratedPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateSharesToConcentratedPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateSharesToConcentratedPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateSharesToConcentratedPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateSharesToConcentratedPosition.Merge(m, src)
+}
+func (m *MsgMigrateSharesToConcentratedPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateSharesToConcentratedPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateSharesToConcentratedPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateSharesToConcentratedPosition proto.InternalMessageInfo
+
+func (m *MsgMigrateSharesToConcentratedPosition) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgMigrateSharesToConcentratedPosition) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgMigrateSharesToConcentratedPosition) GetSharesToMigrate() types.Coin {
+	if m != nil {
+		return m.SharesToMigrate
+	}
+	return types.Coin{}
+}
+
+func (m *MsgMigrateSharesToConcentratedPosition) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *MsgMigrateSharesToConcentratedPosition) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *MsgMigrateSharesToConcentratedPosition) GetTokenOutMins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokenOutMins
+	}
+	return nil
+}
+
+type MsgMigrateSharesToConcentratedPositionResponse struct {
+	PositionId       uint64                                   `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	Amount0          github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,2,opt,name=amount0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount0" yaml:"amount0"`
+	Amount1          github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,3,opt,name=amount1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount1" yaml:"amount1"`
+	LiquidityCreated github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,4,opt,name=liquidity_created,json=liquidityCreated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_created" yaml:"liquidity_created"`
+	JoinTime         time.Time                                `protobuf:"bytes,5,opt,name=join_time,json=joinTime,proto3,stdtime" json:"join_time" yaml:"join_time"`
+	LeftoverCoins    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=leftover_coins,json=leftoverCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"leftover_coins" yaml:"leftover_coins"`
+	NewLockId        uint64                                   `protobuf:"varint,7,opt,name=new_lock_id,json=newLockId,proto3" json:"new_lock_id,omitempty" yaml:"new_lock_id"`
+}
+
+func (m *MsgMigrateSharesToConcentratedPositionResponse) Reset() {
+	*m = MsgMigrateSharesToConcentratedPositionResponse{}
+}
+func (m *MsgMigrateSharesToConcentratedPositionResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgMigrateSharesToConcentratedPositionResponse) ProtoMessage() {}
+func (*MsgMigrateSharesToConcentratedPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{15}
+}
+func (m *MsgMigrateSharesToConcentratedPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateSharesToConcentratedPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateSharesToConcentratedPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateSharesToConcentratedPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateSharesToConcentratedPositionResponse.Merge(m, src)
+}
+func (m *MsgMigrateSharesToConcentratedPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateSharesToConcentratedPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateSharesToConcentratedPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateSharesToConcentratedPositionResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateSharesToConcentratedPositionResponse) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *MsgMigrateSharesToConcentratedPositionResponse) GetJoinTime() time.Time {
+	if m != nil {
+		return m.JoinTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgMigrateSharesToConcentratedPositionResponse) GetLeftoverCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LeftoverCoins
+	}
+	return nil
+}
+
+func (m *MsgMigrateSharesToConcentratedPositionResponse) GetNewLockId() uint64 {
+	if m != nil {
+		return m.NewLockId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgSuperfluidDelegate)(nil), "osmosis.superfluid.MsgSuperfluidDelegate")
 	proto.RegisterType((*MsgSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidDelegateResponse")
@@ -750,70 +920,86 @@ func init() {
 	proto.RegisterType((*MsgUnPoolWhitelistedPoolResponse)(nil), "osmosis.superfluid.MsgUnPoolWhitelistedPoolResponse")
 	proto.RegisterType((*MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition)(nil), "osmosis.superfluid.MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition")
 	proto.RegisterType((*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse)(nil), "osmosis.superfluid.MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse")
+	proto.RegisterType((*MsgMigrateSharesToConcentratedPosition)(nil), "osmosis.superfluid.MsgMigrateSharesToConcentratedPosition")
+	proto.RegisterType((*MsgMigrateSharesToConcentratedPositionResponse)(nil), "osmosis.superfluid.MsgMigrateSharesToConcentratedPositionResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/superfluid/tx.proto", fileDescriptor_55b645f187d22814) }
 
 var fileDescriptor_55b645f187d22814 = []byte{
-	// 926 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0xdb, 0x36,
-	0x14, 0x8f, 0x62, 0xcf, 0x6e, 0x59, 0xf4, 0x4f, 0xb4, 0x16, 0x55, 0xb4, 0xce, 0x72, 0xb9, 0xa1,
-	0xf0, 0xd0, 0x56, 0x8a, 0xdb, 0xb5, 0x08, 0x76, 0x4a, 0x1c, 0x63, 0x80, 0x8b, 0x18, 0x08, 0xd4,
-	0x04, 0x03, 0x0a, 0x0c, 0x86, 0x2c, 0xb2, 0x0a, 0x17, 0x59, 0xf4, 0x44, 0xca, 0x73, 0xb0, 0x0f,
-	0xb0, 0x6b, 0xbf, 0xc1, 0xee, 0x3b, 0xec, 0x6b, 0xac, 0xd8, 0xa9, 0xc7, 0x61, 0x03, 0xdc, 0x21,
-	0xf9, 0x06, 0xc1, 0x6e, 0xbb, 0x0c, 0x94, 0x28, 0xb9, 0x4e, 0xad, 0xc4, 0x76, 0xb3, 0x53, 0x44,
-	0xbe, 0xf7, 0x7e, 0xef, 0xf7, 0x23, 0x7f, 0xe6, 0x43, 0xc0, 0x27, 0x94, 0xf5, 0x28, 0x23, 0xcc,
-	0x62, 0x51, 0x1f, 0x87, 0x2f, 0xfd, 0x88, 0x20, 0x8b, 0x0f, 0xcd, 0x7e, 0x48, 0x39, 0x55, 0x55,
-	0x19, 0x34, 0xc7, 0x41, 0xfd, 0xa6, 0x47, 0x3d, 0x1a, 0x87, 0x2d, 0xf1, 0x95, 0x64, 0xea, 0x15,
-	0x8f, 0x52, 0xcf, 0xc7, 0x56, 0xbc, 0xea, 0x46, 0x2f, 0x2d, 0x14, 0x85, 0x0e, 0x27, 0x34, 0x48,
-	0xe3, 0x6e, 0x0c, 0x65, 0x75, 0x1d, 0x86, 0xad, 0x41, 0xbd, 0x8b, 0xb9, 0x53, 0xb7, 0x5c, 0x4a,
-	0xd2, 0xb8, 0x71, 0xba, 0x9e, 0x93, 0x1e, 0x66, 0xdc, 0xe9, 0xf5, 0x65, 0xc2, 0x67, 0x53, 0x78,
-	0x8e, 0x3f, 0x93, 0x24, 0x38, 0x00, 0xb7, 0xda, 0xcc, 0x7b, 0x9e, 0x6d, 0x37, 0xb1, 0x8f, 0x3d,
-	0x87, 0x63, 0xf5, 0x0b, 0x50, 0x62, 0x38, 0x40, 0x38, 0xd4, 0x94, 0xaa, 0x52, 0xbb, 0xdc, 0x58,
-	0x39, 0x19, 0x19, 0x57, 0x0f, 0x9d, 0x9e, 0xff, 0x15, 0x4c, 0xf6, 0xa1, 0x2d, 0x13, 0xd4, 0xdb,
-	0xa0, 0xec, 0x53, 0xf7, 0xa0, 0x43, 0x90, 0xb6, 0x5c, 0x55, 0x6a, 0x45, 0xbb, 0x24, 0x96, 0x2d,
-	0xa4, 0xae, 0x82, 0x4b, 0x03, 0xc7, 0xef, 0x38, 0x08, 0x85, 0x5a, 0x41, 0xa0, 0xd8, 0xe5, 0x81,
-	0xe3, 0x6f, 0x22, 0x14, 0x42, 0x03, 0x7c, 0x3a, 0xb5, 0xaf, 0x8d, 0x59, 0x9f, 0x06, 0x0c, 0xc3,
-	0x6f, 0xc1, 0xed, 0x89, 0x84, 0xbd, 0x00, 0x5d, 0x20, 0x35, 0x78, 0x17, 0x18, 0x39, 0xf0, 0x67,
-	0x30, 0xe8, 0xd2, 0x00, 0x6d, 0x53, 0xf7, 0xe0, 0x7f, 0x62, 0x90, 0xc2, 0x67, 0x0c, 0x7e, 0x55,
-	0xc0, 0xe7, 0x39, 0x2c, 0x37, 0x83, 0x0b, 0xe6, 0xa3, 0x36, 0x40, 0x51, 0xb8, 0x2b, 0xbe, 0xa8,
-	0x2b, 0x8f, 0x56, 0xcd, 0xc4, 0x7e, 0xa6, 0xb0, 0x9f, 0x29, 0xed, 0x67, 0x6e, 0x51, 0x12, 0x34,
-	0x3e, 0x7e, 0x3d, 0x32, 0x96, 0x4e, 0x46, 0xc6, 0x95, 0xa4, 0x81, 0x28, 0x82, 0x76, 0x5c, 0x0b,
-	0x4d, 0xf0, 0x60, 0x16, 0xbe, 0x99, 0xc0, 0xdf, 0x14, 0x70, 0xa7, 0xcd, 0x3c, 0xb1, 0xb7, 0x19,
-	0xa0, 0x0f, 0x73, 0xa1, 0x03, 0x3e, 0x12, 0x1c, 0x98, 0xb6, 0x5c, 0x2d, 0x9c, 0x2d, 0x60, 0x4d,
-	0x08, 0xf8, 0xe5, 0xad, 0x51, 0xf3, 0x08, 0xdf, 0x8f, 0xba, 0xa6, 0x4b, 0x7b, 0x96, 0xfc, 0xb1,
-	0x25, 0x7f, 0x1e, 0x32, 0x74, 0x60, 0xf1, 0xc3, 0x3e, 0x66, 0x71, 0x01, 0xb3, 0x13, 0xe4, 0xb3,
-	0xfc, 0xfc, 0x34, 0xbe, 0xa9, 0x5c, 0x21, 0xa9, 0x62, 0xf5, 0x1a, 0x58, 0x6e, 0x35, 0x63, 0x31,
-	0x45, 0x7b, 0xb9, 0xd5, 0x84, 0x21, 0xd0, 0xda, 0xcc, 0xdb, 0x0b, 0x76, 0x28, 0xf5, 0xbf, 0xd9,
-	0x27, 0x1c, 0xfb, 0x84, 0x71, 0x8c, 0xc4, 0x72, 0x1e, 0xf1, 0xf7, 0x41, 0xb9, 0x4f, 0xa9, 0x9f,
-	0xdd, 0x6a, 0x43, 0x3d, 0x19, 0x19, 0xd7, 0x92, 0x5c, 0x19, 0x80, 0x76, 0x49, 0x7c, 0xb5, 0x10,
-	0x7c, 0x06, 0xaa, 0x79, 0x3d, 0x33, 0x9e, 0xf7, 0xc0, 0x75, 0x3c, 0x24, 0x1c, 0xa3, 0x8e, 0x74,
-	0x0b, 0xd3, 0x94, 0x6a, 0xa1, 0x56, 0xb4, 0xaf, 0x26, 0xdb, 0xdb, 0xb1, 0x69, 0x18, 0xfc, 0x57,
-	0x01, 0xeb, 0x31, 0x98, 0x9f, 0x48, 0x6f, 0x13, 0x2f, 0x74, 0x38, 0x7e, 0xbe, 0xef, 0x84, 0x98,
-	0xed, 0xd2, 0xaf, 0x23, 0xdf, 0xb7, 0x9d, 0xc0, 0xc3, 0x5b, 0x34, 0x70, 0x71, 0xc0, 0x45, 0x0c,
-	0xed, 0x50, 0x46, 0xc4, 0x43, 0x37, 0xa7, 0xc0, 0x09, 0xdb, 0xbe, 0x2b, 0x50, 0x06, 0x60, 0x66,
-	0x65, 0x0f, 0xac, 0xb0, 0x98, 0x40, 0x87, 0xd3, 0x4e, 0x2f, 0x61, 0x74, 0xbe, 0xaf, 0xab, 0xd2,
-	0xd7, 0x9a, 0x64, 0x70, 0x1a, 0x01, 0xda, 0xd7, 0x99, 0x94, 0x25, 0x55, 0xc2, 0xdf, 0x0b, 0x60,
-	0x63, 0x51, 0xf5, 0xd9, 0x51, 0xbf, 0x00, 0x65, 0xa7, 0x47, 0xa3, 0x80, 0xaf, 0xc9, 0x63, 0xd8,
-	0x10, 0x44, 0xfe, 0x1c, 0x19, 0xf7, 0x66, 0xf0, 0x67, 0x2b, 0xe0, 0xe3, 0x83, 0x90, 0x30, 0xd0,
-	0x4e, 0x01, 0xc7, 0xd8, 0xf5, 0xf8, 0xd8, 0x3e, 0x18, 0xbb, 0x9e, 0x61, 0xd7, 0xd5, 0x1f, 0xc0,
-	0x8a, 0x4f, 0xbe, 0x8f, 0x08, 0x22, 0xfc, 0xb0, 0xe3, 0x86, 0x58, 0x88, 0x4b, 0x7e, 0x16, 0x8d,
-	0x67, 0x73, 0x74, 0x69, 0x62, 0x77, 0x7c, 0xe8, 0xef, 0x01, 0x42, 0xfb, 0x46, 0xb6, 0xb7, 0x95,
-	0x6c, 0xa9, 0x7b, 0xe0, 0xf2, 0x77, 0x94, 0x04, 0x1d, 0x31, 0xf0, 0xb4, 0x62, 0x7c, 0xad, 0xba,
-	0x99, 0x4c, 0x43, 0x33, 0x9d, 0x86, 0xe6, 0x6e, 0x3a, 0x0d, 0x1b, 0x77, 0xe4, 0xbd, 0xde, 0x48,
-	0x5a, 0x64, 0xa5, 0xf0, 0xd5, 0x5b, 0x43, 0xb1, 0x2f, 0x89, 0xb5, 0x48, 0x7e, 0xf4, 0x4f, 0x19,
-	0x14, 0xda, 0xcc, 0x53, 0x43, 0xa0, 0x4e, 0x7b, 0x89, 0xcc, 0xf7, 0x27, 0xbb, 0x39, 0x75, 0x84,
-	0xe9, 0xf5, 0x99, 0x53, 0x33, 0x0f, 0x0c, 0xc1, 0xcd, 0xa9, 0xa3, 0xee, 0xfe, 0xb9, 0x50, 0xe3,
-	0x64, 0xfd, 0xf1, 0x1c, 0xc9, 0x79, 0x9d, 0xb3, 0x91, 0x32, 0x4b, 0xe7, 0x34, 0x79, 0xa6, 0xce,
-	0xa7, 0x1f, 0x7f, 0xf5, 0x67, 0x05, 0xdc, 0x3d, 0x7f, 0xb4, 0xad, 0xcf, 0x21, 0x6a, 0xa2, 0x52,
-	0xdf, 0x58, 0xb4, 0x32, 0x63, 0xf8, 0x93, 0x02, 0x56, 0xf3, 0x67, 0xd3, 0x5a, 0x0e, 0x7e, 0x6e,
-	0x85, 0xbe, 0x3e, 0x6f, 0x45, 0xc6, 0xe4, 0x47, 0x70, 0x6b, 0xfa, 0x8c, 0x78, 0x90, 0x03, 0x39,
-	0x35, 0x5b, 0xff, 0x72, 0x9e, 0xec, 0xac, 0xf9, 0x5f, 0x0a, 0x78, 0xb2, 0xd8, 0x03, 0xbf, 0x9d,
-	0xdb, 0x6f, 0x01, 0x34, 0x7d, 0xf7, 0x22, 0xd1, 0x52, 0x75, 0x8d, 0x9d, 0xd7, 0x47, 0x15, 0xe5,
-	0xcd, 0x51, 0x45, 0xf9, 0xfb, 0xa8, 0xa2, 0xbc, 0x3a, 0xae, 0x2c, 0xbd, 0x39, 0xae, 0x2c, 0xfd,
-	0x71, 0x5c, 0x59, 0x7a, 0xf1, 0xf4, 0x9d, 0xd7, 0x4b, 0x76, 0x7e, 0xe8, 0x3b, 0x5d, 0x96, 0x2e,
-	0xac, 0x41, 0xfd, 0x89, 0x35, 0x9c, 0xf8, 0x37, 0x40, 0xbc, 0x68, 0xdd, 0x52, 0xfc, 0x08, 0x3d,
-	0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x99, 0x74, 0xc7, 0x1f, 0x29, 0x0c, 0x00, 0x00,
+	// 1147 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x4f, 0x1b, 0xc7,
+	0x17, 0x67, 0x6d, 0x03, 0xf1, 0xe3, 0x0b, 0x09, 0xfb, 0x0d, 0x8d, 0x71, 0x53, 0xaf, 0x33, 0x89,
+	0x10, 0x55, 0x92, 0x5d, 0x1c, 0x12, 0x8a, 0x72, 0x02, 0x83, 0x2a, 0x39, 0xc2, 0x2a, 0xda, 0x80,
+	0x2a, 0x45, 0xaa, 0x56, 0xeb, 0xdd, 0x61, 0xd9, 0x7a, 0xbd, 0xe3, 0xee, 0xcc, 0x1a, 0x50, 0xff,
+	0x80, 0x4a, 0xed, 0x25, 0x7f, 0x40, 0xa5, 0x9e, 0x7a, 0xe9, 0xa1, 0xd7, 0xfe, 0x09, 0x8d, 0x7a,
+	0xca, 0xb1, 0x6a, 0x25, 0xa7, 0x82, 0xff, 0x80, 0x6b, 0x2f, 0xd5, 0xec, 0x2f, 0x63, 0x62, 0x83,
+	0xed, 0x92, 0x1c, 0x7a, 0x62, 0x67, 0xde, 0xfb, 0x7c, 0xde, 0xe7, 0xcd, 0xbc, 0x99, 0x79, 0x18,
+	0x3e, 0x24, 0xb4, 0x41, 0xa8, 0x4d, 0x15, 0xea, 0x37, 0xb1, 0xb7, 0xe7, 0xf8, 0xb6, 0xa9, 0xb0,
+	0x43, 0xb9, 0xe9, 0x11, 0x46, 0x44, 0x31, 0x32, 0xca, 0x1d, 0x63, 0xfe, 0xa6, 0x45, 0x2c, 0x12,
+	0x98, 0x15, 0xfe, 0x15, 0x7a, 0xe6, 0x0b, 0x16, 0x21, 0x96, 0x83, 0x95, 0x60, 0x54, 0xf3, 0xf7,
+	0x14, 0xd3, 0xf7, 0x74, 0x66, 0x13, 0x37, 0xb6, 0x1b, 0x01, 0x95, 0x52, 0xd3, 0x29, 0x56, 0x5a,
+	0xa5, 0x1a, 0x66, 0x7a, 0x49, 0x31, 0x88, 0x1d, 0xdb, 0xa5, 0xf3, 0x78, 0x66, 0x37, 0x30, 0x65,
+	0x7a, 0xa3, 0x19, 0x39, 0xdc, 0xed, 0xa1, 0xb3, 0xf3, 0x19, 0x3a, 0xa1, 0x16, 0xcc, 0x55, 0xa9,
+	0xf5, 0x3c, 0x99, 0xde, 0xc4, 0x0e, 0xb6, 0x74, 0x86, 0xc5, 0x8f, 0x61, 0x82, 0x62, 0xd7, 0xc4,
+	0x5e, 0x4e, 0x28, 0x0a, 0x8b, 0xd9, 0xf2, 0xec, 0x69, 0x5b, 0x9a, 0x3e, 0xd2, 0x1b, 0xce, 0x53,
+	0x14, 0xce, 0x23, 0x35, 0x72, 0x10, 0x6f, 0xc1, 0xa4, 0x43, 0x8c, 0xba, 0x66, 0x9b, 0xb9, 0x54,
+	0x51, 0x58, 0xcc, 0xa8, 0x13, 0x7c, 0x58, 0x31, 0xc5, 0x79, 0xb8, 0xd6, 0xd2, 0x1d, 0x4d, 0x37,
+	0x4d, 0x2f, 0x97, 0xe6, 0x2c, 0xea, 0x64, 0x4b, 0x77, 0xd6, 0x4d, 0xd3, 0x43, 0x12, 0x7c, 0xd4,
+	0x33, 0xae, 0x8a, 0x69, 0x93, 0xb8, 0x14, 0xa3, 0x2f, 0xe0, 0x56, 0x97, 0xc3, 0xae, 0x6b, 0x5e,
+	0xa1, 0x34, 0x74, 0x07, 0xa4, 0x3e, 0xf4, 0x17, 0x28, 0xa8, 0x11, 0xd7, 0xdc, 0x22, 0x46, 0xfd,
+	0x1d, 0x29, 0x88, 0xe9, 0x13, 0x05, 0x3f, 0x0b, 0x70, 0xaf, 0x8f, 0xca, 0x75, 0xf7, 0x8a, 0xf5,
+	0x88, 0x65, 0xc8, 0xf0, 0xea, 0x0a, 0x36, 0x6a, 0xea, 0xd1, 0xbc, 0x1c, 0x96, 0x9f, 0xcc, 0xcb,
+	0x4f, 0x8e, 0xca, 0x4f, 0xde, 0x20, 0xb6, 0x5b, 0xfe, 0xff, 0xab, 0xb6, 0x34, 0x76, 0xda, 0x96,
+	0xa6, 0xc2, 0x00, 0x1c, 0x84, 0xd4, 0x00, 0x8b, 0x64, 0x78, 0x30, 0x88, 0xde, 0x24, 0xc1, 0x5f,
+	0x05, 0xb8, 0x5d, 0xa5, 0x16, 0x9f, 0x5b, 0x77, 0xcd, 0x7f, 0x57, 0x85, 0x3a, 0x8c, 0x73, 0x0d,
+	0x34, 0x97, 0x2a, 0xa6, 0x2f, 0x4e, 0x60, 0x89, 0x27, 0xf0, 0xd3, 0x1b, 0x69, 0xd1, 0xb2, 0xd9,
+	0xbe, 0x5f, 0x93, 0x0d, 0xd2, 0x50, 0xa2, 0xc3, 0x16, 0xfe, 0x79, 0x48, 0xcd, 0xba, 0xc2, 0x8e,
+	0x9a, 0x98, 0x06, 0x00, 0xaa, 0x86, 0xcc, 0x17, 0xd5, 0xf3, 0x0a, 0xdc, 0xbb, 0x28, 0x91, 0x38,
+	0x63, 0x71, 0x06, 0x52, 0x95, 0xcd, 0x20, 0x99, 0x8c, 0x9a, 0xaa, 0x6c, 0x22, 0x0f, 0x72, 0x55,
+	0x6a, 0xed, 0xba, 0xdb, 0x84, 0x38, 0x9f, 0xef, 0xdb, 0x0c, 0x3b, 0x36, 0x65, 0xd8, 0xe4, 0xc3,
+	0x61, 0x92, 0xbf, 0x0f, 0x93, 0x4d, 0x42, 0x9c, 0x64, 0x57, 0xcb, 0xe2, 0x69, 0x5b, 0x9a, 0x09,
+	0x7d, 0x23, 0x03, 0x52, 0x27, 0xf8, 0x57, 0xc5, 0x44, 0xcf, 0xa0, 0xd8, 0x2f, 0x66, 0xa2, 0x73,
+	0x01, 0xae, 0xe3, 0x43, 0x9b, 0x61, 0x53, 0x8b, 0xaa, 0x85, 0xe6, 0x84, 0x62, 0x7a, 0x31, 0xa3,
+	0x4e, 0x87, 0xd3, 0x5b, 0x41, 0xd1, 0x50, 0xf4, 0xb7, 0x00, 0xab, 0x01, 0x99, 0x13, 0xa6, 0x5e,
+	0xb5, 0x2d, 0x4f, 0x67, 0xf8, 0xf9, 0xbe, 0xee, 0x61, 0xba, 0x43, 0x3e, 0xf5, 0x1d, 0x47, 0xd5,
+	0x5d, 0x0b, 0x6f, 0x10, 0xd7, 0xc0, 0x2e, 0xe3, 0x36, 0x73, 0x9b, 0x50, 0x9b, 0x5f, 0x74, 0x43,
+	0x26, 0xd8, 0x55, 0xb6, 0x67, 0x13, 0x8c, 0x0c, 0x28, 0x29, 0x65, 0x0b, 0x66, 0x69, 0x20, 0x40,
+	0x63, 0x44, 0x6b, 0x84, 0x8a, 0x2e, 0xaf, 0xeb, 0x62, 0x54, 0xd7, 0xb9, 0x48, 0xc1, 0x79, 0x06,
+	0xa4, 0x5e, 0xa7, 0x51, 0x5a, 0x51, 0x96, 0xe8, 0xb7, 0x34, 0xac, 0x8d, 0x9a, 0x7d, 0xb2, 0xd4,
+	0x2f, 0x60, 0x52, 0x6f, 0x10, 0xdf, 0x65, 0x4b, 0xd1, 0x32, 0xac, 0x71, 0x21, 0x7f, 0xb4, 0xa5,
+	0x85, 0x01, 0xea, 0xb3, 0xe2, 0xb2, 0xce, 0x42, 0x44, 0x34, 0x48, 0x8d, 0x09, 0x3b, 0xdc, 0xa5,
+	0x5c, 0xea, 0x2a, 0xb8, 0x4b, 0x09, 0x77, 0x49, 0x3c, 0x80, 0x59, 0xc7, 0xfe, 0xca, 0xb7, 0x4d,
+	0x9b, 0x1d, 0x69, 0x86, 0x87, 0x79, 0x72, 0xe1, 0xb1, 0x28, 0x3f, 0x1b, 0x22, 0xca, 0x26, 0x36,
+	0x3a, 0x8b, 0xfe, 0x16, 0x21, 0x52, 0x6f, 0x24, 0x73, 0x1b, 0xe1, 0x94, 0xb8, 0x0b, 0xd9, 0x2f,
+	0x89, 0xed, 0x6a, 0xfc, 0xc1, 0xcb, 0x65, 0x82, 0x6d, 0xcd, 0xcb, 0xe1, 0x6b, 0x28, 0xc7, 0xaf,
+	0xa1, 0xbc, 0x13, 0xbf, 0x86, 0xe5, 0xdb, 0xd1, 0xbe, 0xde, 0x08, 0x43, 0x24, 0x50, 0xf4, 0xf2,
+	0x8d, 0x24, 0xa8, 0xd7, 0xf8, 0x98, 0x3b, 0xa3, 0xe3, 0x34, 0x2c, 0x54, 0xa9, 0x75, 0x6e, 0x0f,
+	0xff, 0x93, 0x85, 0x2b, 0x3e, 0x06, 0x70, 0xc8, 0x01, 0xf6, 0x34, 0x66, 0x1b, 0xf5, 0x60, 0x0d,
+	0xd3, 0xe5, 0xb9, 0xd3, 0xb6, 0x34, 0x1b, 0x0b, 0x8b, 0x6d, 0x48, 0xcd, 0x06, 0x83, 0x1d, 0xdb,
+	0xa8, 0x73, 0x94, 0xdf, 0x6c, 0xc6, 0xa8, 0xf1, 0xf3, 0xa8, 0x8e, 0x0d, 0xa9, 0xd9, 0x60, 0x10,
+	0xa0, 0xbe, 0x15, 0x60, 0x86, 0x91, 0x3a, 0x76, 0x35, 0xe2, 0x33, 0xad, 0xc1, 0xaf, 0xe8, 0x89,
+	0xcb, 0xae, 0xe8, 0x4a, 0x94, 0xd2, 0x5c, 0xc8, 0xdc, 0x0d, 0x47, 0x43, 0xdd, 0xdd, 0xff, 0x0b,
+	0xc0, 0x9f, 0xf9, 0xac, 0xca, 0xa1, 0xbf, 0x8c, 0x83, 0x3c, 0xd8, 0x26, 0x27, 0xe7, 0xf3, 0x13,
+	0x98, 0x6a, 0x46, 0x73, 0x7c, 0x17, 0x83, 0xbb, 0xbb, 0xfc, 0xc1, 0x69, 0x5b, 0x12, 0xe3, 0xfb,
+	0x35, 0x31, 0x22, 0x15, 0xe2, 0x51, 0xc5, 0x3c, 0x7b, 0xb0, 0x53, 0xef, 0xf0, 0x60, 0xa7, 0xdf,
+	0xcb, 0xc1, 0xce, 0xbc, 0xef, 0x83, 0x3d, 0x7e, 0x55, 0x07, 0x5b, 0xfc, 0x4e, 0x80, 0x19, 0x07,
+	0xef, 0x31, 0xd2, 0xc2, 0x9e, 0x66, 0x90, 0x51, 0x0a, 0xb0, 0x1b, 0x3e, 0x5c, 0x01, 0x4e, 0xc7,
+	0xe0, 0x60, 0x28, 0xae, 0xc0, 0x94, 0x8b, 0x0f, 0xe2, 0x67, 0x35, 0x37, 0x79, 0xbe, 0x9c, 0xce,
+	0x18, 0x91, 0x9a, 0x75, 0xf1, 0x41, 0xf8, 0xd4, 0x3e, 0xfa, 0x3e, 0x0b, 0xe9, 0x2a, 0xb5, 0x44,
+	0x0f, 0xc4, 0x5e, 0x8d, 0x92, 0xfc, 0xf6, 0x3f, 0x1e, 0x72, 0xcf, 0x0e, 0x3b, 0x5f, 0x1a, 0xd8,
+	0x35, 0x39, 0x02, 0x87, 0x70, 0xb3, 0x67, 0x27, 0x7e, 0xff, 0x52, 0xaa, 0x8e, 0x73, 0x7e, 0x79,
+	0x08, 0xe7, 0x7e, 0x91, 0x93, 0x8e, 0x77, 0x90, 0xc8, 0xb1, 0x73, 0x7e, 0x79, 0x08, 0xe7, 0x24,
+	0xf2, 0x0f, 0x02, 0xdc, 0xb9, 0xbc, 0xf3, 0x5e, 0x1d, 0x22, 0xa9, 0x2e, 0x64, 0x7e, 0x6d, 0x54,
+	0x64, 0xa2, 0xf0, 0x1b, 0x01, 0xe6, 0xfb, 0xb7, 0xce, 0x4b, 0x7d, 0xf8, 0xfb, 0x22, 0xf2, 0xab,
+	0xc3, 0x22, 0x12, 0x25, 0x5f, 0xc3, 0x5c, 0xef, 0x16, 0xf6, 0x41, 0x1f, 0xca, 0x9e, 0xde, 0xf9,
+	0xc7, 0xc3, 0x78, 0x27, 0xc1, 0xff, 0x14, 0xe0, 0xc9, 0x68, 0xfd, 0xe7, 0x56, 0xdf, 0x78, 0x23,
+	0xb0, 0xe5, 0x77, 0xae, 0x92, 0x2d, 0xc9, 0xee, 0x47, 0x01, 0xee, 0x0e, 0xd2, 0x92, 0x3c, 0xed,
+	0x13, 0x7d, 0x00, 0x6c, 0xbe, 0x3c, 0x3a, 0x36, 0xd6, 0x59, 0xde, 0x7e, 0x75, 0x5c, 0x10, 0x5e,
+	0x1f, 0x17, 0x84, 0xbf, 0x8e, 0x0b, 0xc2, 0xcb, 0x93, 0xc2, 0xd8, 0xeb, 0x93, 0xc2, 0xd8, 0xef,
+	0x27, 0x85, 0xb1, 0x17, 0x2b, 0x67, 0x6e, 0xca, 0x28, 0xce, 0x43, 0x47, 0xaf, 0xd1, 0x78, 0xa0,
+	0xb4, 0x4a, 0x4f, 0x94, 0xc3, 0xae, 0x5f, 0x53, 0xf8, 0xed, 0x59, 0x9b, 0x08, 0xae, 0xfc, 0xe5,
+	0x7f, 0x06, 0x00, 0xff, 0x73, 0xf3, 0x39, 0x70, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -841,6 +1027,9 @@ type MsgClient interface {
 	LockAndSuperfluidDelegate(ctx context.Context, in *MsgLockAndSuperfluidDelegate, opts ...grpc.CallOption) (*MsgLockAndSuperfluidDelegateResponse, error)
 	UnPoolWhitelistedPool(ctx context.Context, in *MsgUnPoolWhitelistedPool, opts ...grpc.CallOption) (*MsgUnPoolWhitelistedPoolResponse, error)
 	UnlockAndMigrateSharesToFullRangeConcentratedPosition(ctx context.Context, in *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition, opts ...grpc.CallOption) (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse, error)
+	// Migrate balancer pool shares, either unlocked or held in a (superfluid)
+	// lock, to a concentrated liquidity position in the given tick range
+	MigrateSharesToConcentratedPosition(ctx context.Context, in *MsgMigrateSharesToConcentratedPosition, opts ...grpc.CallOption) (*MsgMigrateSharesToConcentratedPositionResponse, error)
 }
 
 type msgClient struct {
@@ -914,6 +1103,15 @@ func (c *msgClient) UnlockAndMigrateSharesToFullRangeConcentratedPosition(ctx co
 	return out, nil
 }
 
+func (c *msgClient) MigrateSharesToConcentratedPosition(ctx context.Context, in *MsgMigrateSharesToConcentratedPosition, opts ...grpc.CallOption) (*MsgMigrateSharesToConcentratedPositionResponse, error) {
+	out := new(MsgMigrateSharesToConcentratedPositionResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/MigrateSharesToConcentratedPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Execute superfluid delegation for a lockup
@@ -929,6 +1127,9 @@ type MsgServer interface {
 	LockAndSuperfluidDelegate(context.Context, *MsgLockAndSuperfluidDelegate) (*MsgLockAndSuperfluidDelegateResponse, error)
 	UnPoolWhitelistedPool(context.Context, *MsgUnPoolWhitelistedPool) (*MsgUnPoolWhitelistedPoolResponse, error)
 	UnlockAndMigrateSharesToFullRangeConcentratedPosition(context.Context, *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse, error)
+	// Migrate balancer pool shares, either unlocked or held in a (superfluid)
+	// lock, to a concentrated liquidity position in the given tick range
+	MigrateSharesToConcentratedPosition(context.Context, *MsgMigrateSharesToConcentratedPosition) (*MsgMigrateSharesToConcentratedPositionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -956,6 +1157,9 @@ func (*UnimplementedMsgServer) UnPoolWhitelistedPool(ctx context.Context, req *M
 func (*UnimplementedMsgServer) UnlockAndMigrateSharesToFullRangeConcentratedPosition(ctx context.Context, req *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlockAndMigrateSharesToFullRangeConcentratedPosition not implemented")
 }
+func (*UnimplementedMsgServer) MigrateSharesToConcentratedPosition(ctx context.Context, req *MsgMigrateSharesToConcentratedPosition) (*MsgMigrateSharesToConcentratedPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateSharesToConcentratedPosition not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1087,6 +1291,24 @@ func _Msg_UnlockAndMigrateSharesToFullRangeConcentratedPosition_Handler(srv inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MigrateSharesToConcentratedPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateSharesToConcentratedPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateSharesToConcentratedPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/MigrateSharesToConcentratedPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateSharesToConcentratedPosition(ctx, req.(*MsgMigrateSharesToConcentratedPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.superfluid.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1119,6 +1341,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnlockAndMigrateSharesToFullRangeConcentratedPosition",
 			Handler:    _Msg_UnlockAndMigrateSharesToFullRangeConcentratedPosition_Handler,
 		},
+		{
+			MethodName: "MigrateSharesToConcentratedPosition",
+			Handler:    _Msg_MigrateSharesToConcentratedPosition_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/superfluid/tx.proto",
@@ -1634,6 +1860,160 @@ func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Marsh
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgMigrateSharesToConcentratedPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateSharesToConcentratedPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateSharesToConcentratedPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutMins) > 0 {
+		for iNdEx := len(m.TokenOutMins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenOutMins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.UpperTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.SharesToMigrate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateSharesToConcentratedPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateSharesToConcentratedPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateSharesToConcentratedPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewLockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewLockId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.LeftoverCoins) > 0 {
+		for iNdEx := len(m.LeftoverCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LeftoverCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JoinTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LiquidityCreated.Size()
+		i -= size
+		if _, err := m.LiquidityCreated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount1.Size()
+		i -= size
+		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount0.Size()
+		i -= size
+		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1853,20 +2233,79 @@ func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Size(
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+func (m *MsgMigrateSharesToConcentratedPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = m.SharesToMigrate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LowerTick != 0 {
+		n += 1 + sovTx(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovTx(uint64(m.UpperTick))
+	}
+	if len(m.TokenOutMins) > 0 {
+		for _, e := range m.TokenOutMins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMigrateSharesToConcentratedPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = m.Amount0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LiquidityCreated.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.LeftoverCoins) > 0 {
+		for _, e := range m.LeftoverCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.NewLockId != 0 {
+		n += 1 + sovTx(uint64(m.NewLockId))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
 			if iNdEx >= l {
@@ -3291,6 +3730,469 @@ func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Unmar
 	}
 	return nil
 }
+func (m *MsgMigrateSharesToConcentratedPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateSharesToConcentratedPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateSharesToConcentratedPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesToMigrate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesToMigrate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutMins = append(m.TokenOutMins, types.Coin{})
+			if err := m.TokenOutMins[len(m.TokenOutMins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateSharesToConcentratedPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateSharesToConcentratedPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateSharesToConcentratedPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCreated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityCreated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JoinTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftoverCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeftoverCoins = append(m.LeftoverCoins, types.Coin{})
+			if err := m.LeftoverCoins[len(m.LeftoverCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewLockId", wireType)
+			}
+			m.NewLockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewLockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
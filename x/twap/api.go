@@ -78,8 +78,156 @@ func (k Keeper) GetGeometricTwapToNow(
 	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetGeometricStrategy())
 }
 
+// GetHarmonicTwap returns the harmonic mean TWAP between start and end time for quote and base
+// assets in a given pool.
+// Records created before the harmonic accumulator was introduced start it at zero, so the upgrade that
+// introduced it marks them as errored. Windows starting before the upgrade return the spot price error.
+func (k Keeper) GetHarmonicTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, k.GetHarmonicStrategy())
+}
+
+// GetHarmonicTwapToNow returns the harmonic mean TWAP from start time until the current block time for quote and base
+// assets in a given pool.
+// As with GetHarmonicTwap, windows starting before the harmonic accumulator was introduced return the spot price error.
+func (k Keeper) GetHarmonicTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetHarmonicStrategy())
+}
+
+// GetVolatility returns the realized volatility of the base asset price in units of the quote asset
+// from (startTime, endTime), as determined by prices from AMM pool `poolId`.
+// Volatility is the time weighted standard deviation of the natural logarithm of the spot price,
+// computed from every TWAP record stored within the window. Since records are only written at the
+// end of a block, and each spot price is weighted by how long it was in effect, prices within
+// a single block do not affect the result.
+//
+// This function has the same time constraints and error cases as GetArithmeticTwap.
+func (k Keeper) GetVolatility(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	return k.getOverRecords(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, k.GetVolatilityStrategy())
+}
+
+// GetMinSpotPrice returns the minimum spot price of the base asset in units of the quote asset
+// observed at the end of any block from (startTime, endTime), as determined by prices from AMM pool `poolId`.
+//
+// This function has the same time constraints and error cases as GetArithmeticTwap.
+func (k Keeper) GetMinSpotPrice(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	return k.getOverRecords(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, k.GetMinSpotPriceStrategy())
+}
+
+// GetMaxSpotPrice returns the maximum spot price of the base asset in units of the quote asset
+// observed at the end of any block from (startTime, endTime), as determined by prices from AMM pool `poolId`.
+//
+// This function has the same time constraints and error cases as GetArithmeticTwap.
+func (k Keeper) GetMaxSpotPrice(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	return k.getOverRecords(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, k.GetMaxSpotPriceStrategy())
+}
+
+// GetMultihopArithmeticTwap returns the arithmetic TWAP of the base asset in units of the quote asset
+// of the last route, from (startTime, endTime), as determined by prices from the AMM pools of the routes.
+// The base asset of every route is the quote asset of the previous route, and the composed TWAP
+// is the product of the arithmetic TWAPs of every route over the same time window.
+//
+// Every route has the same time constraints and error cases as GetArithmeticTwap.
+// Additionally, it errors if no routes are given.
+// If a route has a spot price error, the composed TWAP is still returned together with the error.
+// On any other error of a route, the product of the TWAPs of the routes before it is returned with the error.
+func (k Keeper) GetMultihopArithmeticTwap(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	routes []types.TwapRoute,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	return k.getMultihopTwap(ctx, baseAssetDenom, routes, startTime, endTime, k.GetArithmeticStrategy())
+}
+
+// GetMultihopGeometricTwap returns the geometric TWAP of the base asset in units of the quote asset
+// of the last route, from (startTime, endTime), as determined by prices from the AMM pools of the routes.
+// Since the geometric mean of a product is the product of the geometric means, the composed TWAP
+// is the product of the geometric TWAPs of every route over the same time window.
+//
+// It has the same time constraints and error cases as GetMultihopArithmeticTwap.
+func (k Keeper) GetMultihopGeometricTwap(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	routes []types.TwapRoute,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	return k.getMultihopTwap(ctx, baseAssetDenom, routes, startTime, endTime, k.GetGeometricStrategy())
+}
+
+// getMultihopTwap computes the twap of every route from the start time until the end time,
+// and returns their product. The type of twap computed depends on the strategy given.
+// If the twap of a route errors due to a spot price error, the product is still computed
+// and returned together with the first such error. On any other error, the product of the
+// twaps of the routes before it is returned together with the error.
+func (k Keeper) getMultihopTwap(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	routes []types.TwapRoute,
+	startTime time.Time,
+	endTime time.Time,
+	strategy twapStrategy,
+) (sdk.Dec, error) {
+	if len(routes) == 0 {
+		return sdk.Dec{}, types.EmptyTwapRouteError{}
+	}
+
+	result := sdk.OneDec()
+	routeBaseAssetDenom := baseAssetDenom
+	var spotPriceErr error
+	for _, route := range routes {
+		twap, err := k.getTwap(ctx, route.PoolId, routeBaseAssetDenom, route.QuoteAsset, startTime, endTime, strategy)
+		if err != nil && twap.IsNil() {
+			// The product of the twaps of the previous routes is returned with the error.
+			return result, err
+		}
+		// As with a single route, a spot price error still returns the twap.
+		if err != nil && spotPriceErr == nil {
+			spotPriceErr = err
+		}
+		result = result.Mul(twap)
+		routeBaseAssetDenom = route.QuoteAsset
+	}
+	return result, spotPriceErr
+}
+
 // getTwap computes and returns twap from the start time until the end time. The type
-// of twap returned depends on the strategy given and can be either arithmetic or geometric.
+// of twap returned depends on the strategy given and can be arithmetic, geometric or harmonic.
 func (k Keeper) getTwap(
 	ctx sdk.Context,
 	poolId uint64,
@@ -110,7 +258,7 @@ func (k Keeper) getTwap(
 }
 
 // getTwapToNow computes and returns twap from the start time until the current block time. The type
-// of twap returned depends on the strategy given and can be either arithmetic or geometric.
+// of twap returned depends on the strategy given and can be arithmetic, geometric or harmonic.
 func (k Keeper) getTwapToNow(
 	ctx sdk.Context,
 	poolId uint64,
@@ -135,6 +283,25 @@ func (k Keeper) getTwapToNow(
 	return computeTwap(startRecord, endRecord, quoteAssetDenom, strategy)
 }
 
+// getOverRecords computes and returns a statistic over all records from the start time until the end time.
+// The type of statistic returned depends on the strategy given.
+func (k Keeper) getOverRecords(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+	strategy twapRecordsStrategy,
+) (sdk.Dec, error) {
+	records, err := k.getRecordsInWindow(ctx, poolId, startTime, endTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return computeOverRecords(records, quoteAssetDenom, strategy)
+}
+
 // GetBeginBlockAccumulatorRecord returns a TwapRecord struct corresponding to the state of pool `poolId`
 // as of the beginning of the block this is called on.
 func (k Keeper) GetBeginBlockAccumulatorRecord(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
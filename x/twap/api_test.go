@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
 	sdkrand "github.com/osmosis-labs/osmosis/v15/simulation/simtypes/random"
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v15/x/twap"
@@ -913,3 +914,301 @@ func (s *TestSuite) TestGeometricTwapToNow_BalancerPool_Randomized() {
 		})
 	}
 }
+
+// TestGetVolatilityAndMinMaxSpotPrice tests that volatility, min and max spot price are computed
+// over every record within the window, including records interpolated to the window bounds.
+func (s *TestSuite) TestGetVolatilityAndMinMaxSpotPrice() {
+	// sp0 = 2 for 10s, then sp0 = 8 for 10s, then sp0 = 4.
+	// Spot prices are powers of 2, so that the logarithms are exact.
+	records := []types.TwapRecord{
+		newTwoAssetPoolTwapRecordWithDefaults(baseTime, sdk.NewDec(2), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(10*time.Second), sdk.NewDec(8), sdk.ZeroDec(), sdk.ZeroDec(), OneSec.MulInt64(10)),
+		newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(20*time.Second), sdk.NewDec(4), sdk.ZeroDec(), sdk.ZeroDec(), OneSec.MulInt64(10+3*10)),
+	}
+	ln2 := sdk.MustNewDecFromStr("0.693147180559945309")
+
+	tests := map[string]struct {
+		ctxTime       time.Time
+		input         getTwapInput
+		expVolatility sdk.Dec
+		expMin        sdk.Dec
+		expMax        sdk.Dec
+		expectedError error
+	}{
+		"window spans all records": {
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteBA),
+			expVolatility: ln2,
+			expMin:        sdk.NewDec(2),
+			expMax:        sdk.NewDec(8),
+		},
+		"window spans all records, use sp1": {
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteAB),
+			expVolatility: ln2,
+			expMin:        sdk.OneDec().QuoInt64(8),
+			expMax:        sdk.OneDec().QuoInt64(2),
+		},
+		"end time = block time": {
+			ctxTime:       baseTime.Add(20 * time.Second),
+			input:         makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteBA),
+			expVolatility: ln2,
+			expMin:        sdk.NewDec(2),
+			expMax:        sdk.NewDec(8),
+		},
+		"start and end time interpolated between records": {
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime.Add(5*time.Second), baseTime.Add(15*time.Second), baseQuoteBA),
+			expVolatility: ln2,
+			expMin:        sdk.NewDec(2),
+			expMax:        sdk.NewDec(8),
+		},
+		"constant price in window": {
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime.Add(12*time.Second), baseTime.Add(18*time.Second), baseQuoteBA),
+			expVolatility: sdk.ZeroDec(),
+			expMin:        sdk.NewDec(8),
+			expMax:        sdk.NewDec(8),
+		},
+		"start time = end time": {
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime.Add(20*time.Second), baseTime.Add(20*time.Second), baseQuoteBA),
+			expVolatility: sdk.ZeroDec(),
+			expMin:        sdk.NewDec(4),
+			expMax:        sdk.NewDec(4),
+		},
+
+		// error catching
+		"end time in the future": {
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime, tPlusOneMin.Add(time.Second), baseQuoteBA),
+			expectedError: types.EndTimeInFutureError{EndTime: tPlusOneMin.Add(time.Second), BlockTime: tPlusOneMin},
+		},
+		"start time after end time": {
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime.Add(10*time.Second), baseTime, baseQuoteBA),
+			expectedError: types.StartTimeAfterEndTimeError{StartTime: baseTime.Add(10 * time.Second), EndTime: baseTime},
+		},
+		"start time too old": {
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime.Add(-time.Hour), baseTime, baseQuoteBA),
+			expectedError: twap.TimeTooOldError{Time: baseTime.Add(-time.Hour)},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(records)
+			s.Ctx = s.Ctx.WithBlockTime(test.ctxTime)
+
+			volatility, err := s.twapkeeper.GetVolatility(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime, test.input.endTime)
+			if test.expectedError != nil {
+				s.Require().Equal(test.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+			osmoassert.DecApproxEq(s.T(), test.expVolatility, volatility, osmomath.GetPowPrecision())
+
+			minSpotPrice, err := s.twapkeeper.GetMinSpotPrice(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime, test.input.endTime)
+			s.Require().NoError(err)
+			s.Require().Equal(test.expMin, minSpotPrice)
+
+			maxSpotPrice, err := s.twapkeeper.GetMaxSpotPrice(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime, test.input.endTime)
+			s.Require().NoError(err)
+			s.Require().Equal(test.expMax, maxSpotPrice)
+		})
+	}
+}
+
+func (s *TestSuite) TestGetHarmonicTwap() {
+	// sp0 = 2 for 10s, then sp0 = 8 for 10s, then sp0 = 4.
+	records := []types.TwapRecord{
+		newTwoAssetPoolTwapRecordWithDefaults(baseTime, sdk.NewDec(2), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(10*time.Second), sdk.NewDec(8), OneSec.MulInt64(2*10), OneSec.MulInt64(10).QuoInt64(2), sdk.ZeroDec()),
+		newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(20*time.Second), sdk.NewDec(4), OneSec.MulInt64(2*10+8*10), OneSec.MulInt64(10).QuoInt64(2).Add(OneSec.MulInt64(10).QuoInt64(8)), sdk.ZeroDec()),
+	}
+
+	tests := map[string]struct {
+		ctxTime       time.Time
+		input         getTwapInput
+		expTwap       sdk.Dec
+		expectedError error
+	}{
+		"window spans all records": {
+			ctxTime: tPlusOneMin,
+			input:   makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteBA),
+			// 20s / (10s / 2 + 10s / 8) = 3.2
+			expTwap: sdk.NewDecWithPrec(32, 1),
+		},
+		"window spans all records, use sp1": {
+			ctxTime: tPlusOneMin,
+			input:   makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteAB),
+			// 20s / (10s * 2 + 10s * 8) = 0.2
+			expTwap: sdk.NewDecWithPrec(2, 1),
+		},
+		"end time interpolated between records": {
+			ctxTime: tPlusOneMin,
+			input:   makeSimpleTwapInput(baseTime, baseTime.Add(15*time.Second), baseQuoteBA),
+			// 15s / (10s / 2 + 5s / 8) = 8 / 3
+			expTwap: sdk.NewDec(8).QuoInt64(3),
+		},
+		"end time = block time": {
+			ctxTime: baseTime.Add(20 * time.Second),
+			input:   makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteBA),
+			expTwap: sdk.NewDecWithPrec(32, 1),
+		},
+		"start time too old": {
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime.Add(-time.Hour), baseTime, baseQuoteBA),
+			expectedError: twap.TimeTooOldError{Time: baseTime.Add(-time.Hour)},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(records)
+			s.Ctx = s.Ctx.WithBlockTime(test.ctxTime)
+
+			harmonicTwap, err := s.twapkeeper.GetHarmonicTwap(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime, test.input.endTime)
+			if test.expectedError != nil {
+				s.Require().Equal(test.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expTwap, harmonicTwap)
+
+			if test.input.endTime.Equal(test.ctxTime) {
+				harmonicTwapToNow, err := s.twapkeeper.GetHarmonicTwapToNow(s.Ctx, test.input.poolId,
+					test.input.baseAssetDenom, test.input.quoteAssetDenom,
+					test.input.startTime)
+				s.Require().NoError(err)
+				s.Require().Equal(harmonicTwap, harmonicTwapToNow)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestGetMultihopTwap() {
+	records := []types.TwapRecord{
+		baseRecord, tPlus10sp5Record,
+		threeAssetRecordAB, threeAssetRecordAC, threeAssetRecordBC,
+		tPlus10sp5ThreeAssetRecordAB, tPlus10sp5ThreeAssetRecordAC, tPlus10sp5ThreeAssetRecordBC,
+	}
+	// denom1 -> denom0 in pool 1, then denom0 -> denom2 in pool 2.
+	twoHopRoutes := []types.TwapRoute{
+		{PoolId: baseRecord.PoolId, QuoteAsset: denom0},
+		{PoolId: threeAssetRecordAC.PoolId, QuoteAsset: denom2},
+	}
+
+	tests := map[string]struct {
+		recordsToSet  []types.TwapRecord
+		routes        []types.TwapRoute
+		startTime     time.Time
+		endTime       time.Time
+		expTwap       sdk.Dec
+		expectedError error
+	}{
+		"single route": {
+			routes:    twoHopRoutes[:1],
+			startTime: baseTime,
+			endTime:   baseTime.Add(20 * time.Second),
+			// A 10 for 10s, 5 for 10s = 150/20 = 7.5
+			expTwap: sdk.NewDecWithPrec(75, 1),
+		},
+		"two routes": {
+			routes:    twoHopRoutes,
+			startTime: baseTime,
+			endTime:   baseTime.Add(20 * time.Second),
+			// A 10 for 10s, 5 for 10s = 150/20 = 7.5
+			// C 20 for 10s, 10 for 10s = 300/20 = 15
+			expTwap: sdk.NewDecWithPrec(1125, 1),
+		},
+		"two routes, interpolated window": {
+			routes:    twoHopRoutes,
+			startTime: baseTime.Add(5 * time.Second),
+			endTime:   baseTime.Add(15 * time.Second),
+			// A 10 for 5s, 5 for 5s = 75/10 = 7.5
+			// C 20 for 5s, 10 for 5s = 150/10 = 15
+			expTwap: sdk.NewDecWithPrec(1125, 1),
+		},
+		"two routes, spot price error in first route": {
+			recordsToSet: []types.TwapRecord{
+				baseRecord, withLastErrTime(tPlus10sp5Record, tPlus10sp5Record.Time),
+				threeAssetRecordAB, threeAssetRecordAC, threeAssetRecordBC,
+				tPlus10sp5ThreeAssetRecordAB, tPlus10sp5ThreeAssetRecordAC, tPlus10sp5ThreeAssetRecordBC,
+			},
+			routes:    twoHopRoutes,
+			startTime: baseTime,
+			endTime:   baseTime.Add(20 * time.Second),
+			// the product is still computed over every route.
+			expTwap:       sdk.NewDecWithPrec(1125, 1),
+			expectedError: spotPriceError,
+		},
+		"two routes, quote asset of second route not in pool": {
+			routes:    []types.TwapRoute{twoHopRoutes[0], {PoolId: baseRecord.PoolId, QuoteAsset: denom2}},
+			startTime: baseTime,
+			endTime:   baseTime.Add(20 * time.Second),
+			// the twap of the first route is returned.
+			expTwap:       sdk.NewDecWithPrec(75, 1),
+			expectedError: fmt.Errorf("getTwapRecord: querying for assets %s %s that are not in pool id %d", denom0, denom2, baseRecord.PoolId),
+		},
+		"no routes": {
+			routes:        []types.TwapRoute{},
+			startTime:     baseTime,
+			endTime:       baseTime.Add(20 * time.Second),
+			expectedError: types.EmptyTwapRouteError{},
+		},
+		"start time too old": {
+			routes:        twoHopRoutes,
+			startTime:     baseTime.Add(-time.Hour),
+			endTime:       baseTime.Add(20 * time.Second),
+			expectedError: twap.TimeTooOldError{Time: baseTime.Add(-time.Hour)},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			if test.recordsToSet == nil {
+				test.recordsToSet = records
+			}
+			s.preSetRecords(test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin)
+
+			arithmeticTwap, err := s.twapkeeper.GetMultihopArithmeticTwap(s.Ctx, denom1, test.routes, test.startTime, test.endTime)
+			if test.expectedError != nil {
+				s.Require().Equal(test.expectedError, err)
+				if !test.expTwap.IsNil() {
+					s.Require().Equal(test.expTwap, arithmeticTwap)
+				}
+
+				_, err = s.twapkeeper.GetMultihopGeometricTwap(s.Ctx, denom1, test.routes, test.startTime, test.endTime)
+				s.Require().Equal(test.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expTwap, arithmeticTwap)
+
+			// the geometric twap is the product of the geometric twaps of every route.
+			expGeometricTwap := sdk.OneDec()
+			routeBaseAsset := denom1
+			for _, route := range test.routes {
+				routeTwap, err := s.twapkeeper.GetGeometricTwap(s.Ctx, route.PoolId, routeBaseAsset, route.QuoteAsset, test.startTime, test.endTime)
+				s.Require().NoError(err)
+				expGeometricTwap = expGeometricTwap.Mul(routeTwap)
+				routeBaseAsset = route.QuoteAsset
+			}
+
+			geometricTwap, err := s.twapkeeper.GetMultihopGeometricTwap(s.Ctx, denom1, test.routes, test.startTime, test.endTime)
+			s.Require().NoError(err)
+			s.Require().Equal(expGeometricTwap, geometricTwap)
+		})
+	}
+}
@@ -21,6 +21,11 @@ func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	cmd.AddCommand(GetQueryArithmeticCommand())
 	cmd.AddCommand(GetQueryGeometricCommand())
+	cmd.AddCommand(GetQueryHarmonicCommand())
+	cmd.AddCommand(GetQueryMultihopArithmeticCommand())
+	cmd.AddCommand(GetQueryMultihopGeometricCommand())
+	cmd.AddCommand(GetQueryVolatilityCommand())
+	cmd.AddCommand(GetQueryMinMaxSpotPriceCommand())
 
 	return cmd
 }
@@ -127,6 +132,246 @@ Example:
 	return cmd
 }
 
+// GetQueryHarmonicCommand returns a harmonic twap query command.
+func GetQueryHarmonicCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "harmonic [poolid] [base denom] [start time] [end time]",
+		Short: "Query harmonic twap",
+		Long: osmocli.FormatLongDescDirect(`Query harmonic twap for pool. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} harmonic 1 uosmo 1667088000 24h
+{{.CommandPrefix}} harmonic 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			poolId, baseDenom, startTime, endTime, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, poolId, baseDenom)
+			if err != nil {
+				return err
+			}
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HarmonicTwap(cmd.Context(), &queryproto.HarmonicTwapRequest{
+				PoolId:     poolId,
+				BaseAsset:  baseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  startTime,
+				EndTime:    &endTime,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryMultihopArithmeticCommand returns a multihop arithmetic twap query command.
+func GetQueryMultihopArithmeticCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multihop-arithmetic [base denom] [pool ids] [quote denoms] [start time] [end time]",
+		Short: "Query arithmetic twap across a route of pools",
+		Long: osmocli.FormatLongDescDirect(`Query arithmetic twap of the base denom in units of the last quote denom, composed across a route of pools.
+Pool ids and quote denoms are comma separated, with the quote denom of every pool being the base denom of the next pool.
+Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} multihop-arithmetic uatom 1,678 uosmo,uusdc 1667088000 24h
+{{.CommandPrefix}} multihop-arithmetic uatom 1,678 uosmo,uusdc 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			baseDenom, routes, startTime, endTime, err := multihopTwapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := queryproto.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MultihopArithmeticTwap(cmd.Context(), &queryproto.MultihopArithmeticTwapRequest{
+				BaseAsset: baseDenom,
+				Routes:    routes,
+				StartTime: startTime,
+				EndTime:   &endTime,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryMultihopGeometricCommand returns a multihop geometric twap query command.
+func GetQueryMultihopGeometricCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multihop-geometric [base denom] [pool ids] [quote denoms] [start time] [end time]",
+		Short: "Query geometric twap across a route of pools",
+		Long: osmocli.FormatLongDescDirect(`Query geometric twap of the base denom in units of the last quote denom, composed across a route of pools.
+Pool ids and quote denoms are comma separated, with the quote denom of every pool being the base denom of the next pool.
+Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} multihop-geometric uatom 1,678 uosmo,uusdc 1667088000 24h
+{{.CommandPrefix}} multihop-geometric uatom 1,678 uosmo,uusdc 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			baseDenom, routes, startTime, endTime, err := multihopTwapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := queryproto.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MultihopGeometricTwap(cmd.Context(), &queryproto.MultihopGeometricTwapRequest{
+				BaseAsset: baseDenom,
+				Routes:    routes,
+				StartTime: startTime,
+				EndTime:   &endTime,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryVolatilityCommand returns a volatility query command.
+func GetQueryVolatilityCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "volatility [poolid] [base denom] [start time] [end time]",
+		Short: "Query realized volatility of the spot price",
+		Long: osmocli.FormatLongDescDirect(`Query realized volatility of the spot price for pool, computed from the twap records. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} volatility 1 uosmo 1667088000 24h
+{{.CommandPrefix}} volatility 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			poolId, baseDenom, startTime, endTime, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, poolId, baseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.Volatility(cmd.Context(), &queryproto.VolatilityRequest{
+				PoolId:     poolId,
+				BaseAsset:  baseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  startTime,
+				EndTime:    &endTime,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryMinMaxSpotPriceCommand returns a min and max spot price query command.
+func GetQueryMinMaxSpotPriceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "min-max-spot-price [poolid] [base denom] [start time] [end time]",
+		Short: "Query min and max observed spot price",
+		Long: osmocli.FormatLongDescDirect(`Query min and max spot price for pool observed in the twap records. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} min-max-spot-price 1 uosmo 1667088000 24h
+{{.CommandPrefix}} min-max-spot-price 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			poolId, baseDenom, startTime, endTime, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, poolId, baseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.MinMaxSpotPrice(cmd.Context(), &queryproto.MinMaxSpotPriceRequest{
+				PoolId:     poolId,
+				BaseAsset:  baseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  startTime,
+				EndTime:    &endTime,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // getQuoteDenomFromLiquidity gets the quote liquidity denom from the pool. In addition, validates that base denom
 // exists in the pool. Fails if not.
 func getQuoteDenomFromLiquidity(ctx context.Context, clientCtx client.Context, poolId uint64, baseDenom string) (string, error) {
@@ -162,24 +407,58 @@ func twapQueryParseArgs(args []string) (poolId uint64, baseDenom string, startTi
 	// <DENOM PARSE>
 	baseDenom = strings.TrimSpace(args[1])
 
+	startTime, endTime, err = twapQueryParseTimes(args[2], args[3])
+	if err != nil {
+		return
+	}
+	return poolId, baseDenom, startTime, endTime, nil
+}
+
+func twapQueryParseTimes(startTimeArg string, endTimeArg string) (startTime time.Time, endTime time.Time, err error) {
 	// <UNIX TIME PARSE>
-	startTime, err = osmocli.ParseUnixTime(args[2], "start time")
+	startTime, err = osmocli.ParseUnixTime(startTimeArg, "start time")
 	if err != nil {
 		return
 	}
 
 	// END TIME PARSE: ONEOF {<UNIX TIME PARSE>, <DURATION>}
 	// try parsing in unix time, if failed try parsing in duration
-	endTime, err = osmocli.ParseUnixTime(args[3], "end time")
+	endTime, err = osmocli.ParseUnixTime(endTimeArg, "end time")
 	if err != nil {
 		// TODO if we don't use protoreflect:
 		// make better error combiner, rather than just returning last error
-		duration, err2 := time.ParseDuration(args[3])
+		duration, err2 := time.ParseDuration(endTimeArg)
 		if err2 != nil {
 			err = err2
 			return
 		}
 		endTime = startTime.Add(duration)
 	}
-	return poolId, baseDenom, startTime, endTime, nil
+	return startTime, endTime, nil
+}
+
+func multihopTwapQueryParseArgs(args []string) (baseDenom string, routes []types.TwapRoute, startTime time.Time, endTime time.Time, err error) {
+	// <DENOM PARSE>
+	baseDenom = strings.TrimSpace(args[0])
+
+	// <ROUTES PARSE>
+	poolIds := strings.Split(args[1], ",")
+	quoteDenoms := strings.Split(args[2], ",")
+	if len(poolIds) != len(quoteDenoms) {
+		err = fmt.Errorf("the number of pool ids (%d) and quote denoms (%d) must be equal", len(poolIds), len(quoteDenoms))
+		return
+	}
+	for i, poolIdStr := range poolIds {
+		poolId, err := osmocli.ParseUint(poolIdStr, "poolId")
+		if err != nil {
+			return "", nil, time.Time{}, time.Time{}, err
+		}
+		routes = append(routes, types.TwapRoute{PoolId: poolId, QuoteAsset: strings.TrimSpace(quoteDenoms[i])})
+	}
+
+	startTime, endTime, err = twapQueryParseTimes(args[3], args[4])
+	if err != nil {
+		return
+	}
+	return baseDenom, routes, startTime, endTime, nil
 }
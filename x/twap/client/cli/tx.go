@@ -0,0 +1,90 @@
+package twapcli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/x/twap/types"
+)
+
+// NewCmdSubmitSetPoolRecordHistoryKeepPeriodsProposal implements a command handler for the set pool record history keep periods proposal
+func NewCmdSubmitSetPoolRecordHistoryKeepPeriodsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pool-record-history-keep-periods [poolIds] [keepPeriods]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to set per-pool twap record history keep periods",
+		Long: strings.TrimSpace(`Submit a proposal to override the twap record history keep period of the given pools.
+A keep period of 0s removes the override of that pool.
+Ex) set-pool-record-history-keep-periods 1,2 168h,0s
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolIds, err := osmoutils.ParseUint64SliceFromString(args[0], ",")
+			if err != nil {
+				return err
+			}
+
+			keepPeriodStrs := strings.Split(args[1], ",")
+			if len(poolIds) != len(keepPeriodStrs) {
+				return fmt.Errorf("the length of pool ids and keep periods not matched")
+			}
+
+			var records []types.PoolRecordHistoryKeepPeriod
+			for i, poolId := range poolIds {
+				keepPeriod, err := time.ParseDuration(strings.TrimSpace(keepPeriodStrs[i]))
+				if err != nil {
+					return err
+				}
+				records = append(records, types.PoolRecordHistoryKeepPeriod{
+					PoolId:                  poolId,
+					RecordHistoryKeepPeriod: keepPeriod,
+				})
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			proposal, err := osmoutils.ParseProposalFlags(cmd.Flags())
+			if err != nil {
+				return fmt.Errorf("failed to parse proposal: %w", err)
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetPoolRecordHistoryKeepPeriodsProposal(proposal.Title, proposal.Description, records)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().String(govcli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+
+	return cmd
+}
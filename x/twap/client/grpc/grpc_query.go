@@ -20,6 +20,46 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) MultihopArithmeticTwap(grpcCtx context.Context,
+	req *queryproto.MultihopArithmeticTwapRequest,
+) (*queryproto.MultihopArithmeticTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.MultihopArithmeticTwap(ctx, *req)
+}
+
+func (q Querier) MultihopGeometricTwap(grpcCtx context.Context,
+	req *queryproto.MultihopGeometricTwapRequest,
+) (*queryproto.MultihopGeometricTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.MultihopGeometricTwap(ctx, *req)
+}
+
+func (q Querier) Volatility(grpcCtx context.Context,
+	req *queryproto.VolatilityRequest,
+) (*queryproto.VolatilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Volatility(ctx, *req)
+}
+
+func (q Querier) MinMaxSpotPrice(grpcCtx context.Context,
+	req *queryproto.MinMaxSpotPriceRequest,
+) (*queryproto.MinMaxSpotPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.MinMaxSpotPrice(ctx, *req)
+}
+
 func (q Querier) Params(grpcCtx context.Context,
 	req *queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -30,6 +70,26 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) HarmonicTwapToNow(grpcCtx context.Context,
+	req *queryproto.HarmonicTwapToNowRequest,
+) (*queryproto.HarmonicTwapToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.HarmonicTwapToNow(ctx, *req)
+}
+
+func (q Querier) HarmonicTwap(grpcCtx context.Context,
+	req *queryproto.HarmonicTwapRequest,
+) (*queryproto.HarmonicTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.HarmonicTwap(ctx, *req)
+}
+
 func (q Querier) GeometricTwapToNow(grpcCtx context.Context,
 	req *queryproto.GeometricTwapToNowRequest,
 ) (*queryproto.GeometricTwapToNowResponse, error) {
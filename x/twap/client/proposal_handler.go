@@ -0,0 +1,10 @@
+package client
+
+import (
+	twapcli "github.com/osmosis-labs/osmosis/v15/x/twap/client/cli"
+	"github.com/osmosis-labs/osmosis/v15/x/twap/client/rest"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var SetPoolRecordHistoryKeepPeriodsProposalHandler = govclient.NewProposalHandler(twapcli.NewCmdSubmitSetPoolRecordHistoryKeepPeriodsProposal, rest.ProposalSetPoolRecordHistoryKeepPeriodsRESTHandler)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v15/x/twap"
 	"github.com/osmosis-labs/osmosis/v15/x/twap/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v15/x/twap/types"
 )
 
 // This file should evolve to being code gen'd, off of `proto/twap/v1beta/query.yml`
@@ -61,6 +62,99 @@ func (q Querier) GeometricTwapToNow(ctx sdk.Context,
 	return &queryproto.GeometricTwapToNowResponse{GeometricTwap: twap}, err
 }
 
+func (q Querier) HarmonicTwap(ctx sdk.Context,
+	req queryproto.HarmonicTwapRequest,
+) (*queryproto.HarmonicTwapResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetHarmonicTwap(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.HarmonicTwapResponse{HarmonicTwap: twap}, err
+}
+
+func (q Querier) HarmonicTwapToNow(ctx sdk.Context,
+	req queryproto.HarmonicTwapToNowRequest,
+) (*queryproto.HarmonicTwapToNowResponse, error) {
+	twap, err := q.K.GetHarmonicTwapToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+
+	return &queryproto.HarmonicTwapToNowResponse{HarmonicTwap: twap}, err
+}
+
+func (q Querier) MultihopArithmeticTwap(ctx sdk.Context,
+	req queryproto.MultihopArithmeticTwapRequest,
+) (*queryproto.MultihopArithmeticTwapResponse, error) {
+	if len(req.Routes) > types.MaxTwapRoutes {
+		return nil, types.MaxTwapRoutesExceededError{Routes: len(req.Routes), Limit: types.MaxTwapRoutes}
+	}
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetMultihopArithmeticTwap(ctx, req.BaseAsset, req.Routes, req.StartTime, *req.EndTime)
+
+	return &queryproto.MultihopArithmeticTwapResponse{ArithmeticTwap: twap}, err
+}
+
+func (q Querier) MultihopGeometricTwap(ctx sdk.Context,
+	req queryproto.MultihopGeometricTwapRequest,
+) (*queryproto.MultihopGeometricTwapResponse, error) {
+	if len(req.Routes) > types.MaxTwapRoutes {
+		return nil, types.MaxTwapRoutesExceededError{Routes: len(req.Routes), Limit: types.MaxTwapRoutes}
+	}
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetMultihopGeometricTwap(ctx, req.BaseAsset, req.Routes, req.StartTime, *req.EndTime)
+
+	return &queryproto.MultihopGeometricTwapResponse{GeometricTwap: twap}, err
+}
+
+func (q Querier) Volatility(ctx sdk.Context,
+	req queryproto.VolatilityRequest,
+) (*queryproto.VolatilityResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	volatility, err := q.K.GetVolatility(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.VolatilityResponse{Volatility: volatility}, err
+}
+
+func (q Querier) MinMaxSpotPrice(ctx sdk.Context,
+	req queryproto.MinMaxSpotPriceRequest,
+) (*queryproto.MinMaxSpotPriceResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	minSpotPrice, err := q.K.GetMinSpotPrice(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	maxSpotPrice, err := q.K.GetMaxSpotPrice(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.MinMaxSpotPriceResponse{MinSpotPrice: minSpotPrice, MaxSpotPrice: maxSpotPrice}, err
+}
+
 func (q Querier) Params(ctx sdk.Context,
 	req queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -12,6 +12,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v15/x/twap/client"
 	"github.com/osmosis-labs/osmosis/v15/x/twap/client/queryproto"
+	twaptypes "github.com/osmosis-labs/osmosis/v15/x/twap/types"
 )
 
 type QueryTestSuite struct {
@@ -237,3 +238,91 @@ func (suite *QueryTestSuite) TestQueryTwap() {
 		})
 	}
 }
+
+func (suite *QueryTestSuite) TestQueryMultihopTwap() {
+	suite.SetupTest()
+
+	var (
+		poolID = suite.PrepareBalancerPoolWithCoins(
+			sdk.NewInt64Coin("tokenA", 1000),
+			sdk.NewInt64Coin("tokenB", 2000),
+		)
+		startTime    = suite.Ctx.BlockTime()
+		newBlockTime = startTime.Add(time.Hour)
+
+		ctx = suite.Ctx.WithBlockTime(newBlockTime)
+	)
+
+	// routes returns numRoutes routes alternating between tokenB and tokenA as quote asset,
+	// starting with tokenA as base asset.
+	routes := func(numRoutes int) []twaptypes.TwapRoute {
+		routes := make([]twaptypes.TwapRoute, numRoutes)
+		for i := range routes {
+			routes[i] = twaptypes.TwapRoute{PoolId: poolID, QuoteAsset: "tokenB"}
+			if i%2 == 1 {
+				routes[i].QuoteAsset = "tokenA"
+			}
+		}
+		return routes
+	}
+
+	testCases := []struct {
+		name        string
+		routes      []twaptypes.TwapRoute
+		expectedErr error
+		result      string
+	}{
+		{
+			name:   "single route",
+			routes: routes(1),
+			result: sdk.NewDec(2).String(),
+		},
+		{
+			name:   "max routes",
+			routes: routes(twaptypes.MaxTwapRoutes),
+			result: sdk.NewDec(2).String(),
+		},
+		{
+			name:        "error: more than max routes",
+			routes:      routes(twaptypes.MaxTwapRoutes + 1),
+			expectedErr: twaptypes.MaxTwapRoutesExceededError{Routes: twaptypes.MaxTwapRoutes + 1, Limit: twaptypes.MaxTwapRoutes},
+		},
+		{
+			name:        "error: no routes",
+			routes:      routes(0),
+			expectedErr: twaptypes.EmptyTwapRouteError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			client := client.Querier{K: *suite.App.TwapKeeper}
+
+			arithmeticResult, err := client.MultihopArithmeticTwap(ctx, queryproto.MultihopArithmeticTwapRequest{
+				BaseAsset: "tokenA",
+				Routes:    tc.routes,
+				StartTime: startTime,
+			})
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr, "expected error - MultihopArithmeticTwap")
+			} else {
+				suite.Require().NoError(err, "unexpected error - MultihopArithmeticTwap")
+				suite.Require().Equal(tc.result, arithmeticResult.ArithmeticTwap.String())
+			}
+
+			// The geometric twap is not checked exactly, as it is computed through logarithms.
+			_, err = client.MultihopGeometricTwap(ctx, queryproto.MultihopGeometricTwapRequest{
+				BaseAsset: "tokenA",
+				Routes:    tc.routes,
+				StartTime: startTime,
+			})
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr, "expected error - MultihopGeometricTwap")
+			} else {
+				suite.Require().NoError(err, "unexpected error - MultihopGeometricTwap")
+			}
+		})
+	}
+}
@@ -14,6 +14,11 @@ type (
 	TwapStrategy           = twapStrategy
 	ArithmeticTwapStrategy = arithmetic
 	GeometricTwapStrategy  = geometric
+	HarmonicTwapStrategy   = harmonic
+	TwapRecordsStrategy    = twapRecordsStrategy
+	VolatilityStrategy     = volatility
+	MinSpotPriceStrategy   = minSpotPrice
+	MaxSpotPriceStrategy   = maxSpotPrice
 )
 
 func (k Keeper) StoreNewRecord(ctx sdk.Context, record types.TwapRecord) {
@@ -57,7 +62,19 @@ func (k Keeper) UpdateRecords(ctx sdk.Context, poolId uint64) error {
 }
 
 func (k Keeper) PruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time) error {
-	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime)
+	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime, nil)
+}
+
+func (k Keeper) StoreCheckpoint(ctx sdk.Context, record types.TwapRecord) {
+	k.storeCheckpoint(ctx, record)
+}
+
+func (k Keeper) GetAllCheckpoints(ctx sdk.Context) ([]types.TwapRecord, error) {
+	return k.getAllCheckpoints(ctx)
+}
+
+func (k Keeper) GetCheckpointAtOrBeforeTime(ctx sdk.Context, poolId uint64, time time.Time, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
+	return k.getCheckpointAtOrBeforeTime(ctx, poolId, time, asset0Denom, asset1Denom)
 }
 
 func (k Keeper) PruneRecords(ctx sdk.Context) error {
@@ -72,6 +89,14 @@ func ComputeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quote
 	return computeTwap(startRecord, endRecord, quoteAsset, strategy)
 }
 
+func ComputeOverRecords(records []types.TwapRecord, quoteAsset string, strategy twapRecordsStrategy) (sdk.Dec, error) {
+	return computeOverRecords(records, quoteAsset, strategy)
+}
+
+func (k Keeper) GetRecordsInWindow(ctx sdk.Context, poolId uint64, startTime time.Time, endTime time.Time, assetA, assetB string) ([]types.TwapRecord, error) {
+	return k.getRecordsInWindow(ctx, poolId, startTime, endTime, assetA, assetB)
+}
+
 func (as arithmetic) ComputeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
 	return as.computeTwap(startRecord, endRecord, quoteAsset)
 }
@@ -80,6 +105,10 @@ func (gs geometric) ComputeTwap(startRecord types.TwapRecord, endRecord types.Tw
 	return gs.computeTwap(startRecord, endRecord, quoteAsset)
 }
 
+func (hs harmonic) ComputeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
+	return hs.computeTwap(startRecord, endRecord, quoteAsset)
+}
+
 func RecordWithUpdatedAccumulators(record types.TwapRecord, t time.Time) types.TwapRecord {
 	return recordWithUpdatedAccumulators(record, t)
 }
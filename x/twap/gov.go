@@ -0,0 +1,36 @@
+package twap
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/twap/types"
+)
+
+// NewTwapProposalHandler is a handler for governance proposals on the twap module.
+func NewTwapProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetPoolRecordHistoryKeepPeriodsProposal:
+			return k.HandleSetPoolRecordHistoryKeepPeriodsProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized twap proposal content type: %T", c)
+		}
+	}
+}
+
+// HandleSetPoolRecordHistoryKeepPeriodsProposal is a handler for setting per-pool record history keep periods.
+// Returns error if any of the pools does not exist.
+func (k Keeper) HandleSetPoolRecordHistoryKeepPeriodsProposal(ctx sdk.Context, p *types.SetPoolRecordHistoryKeepPeriodsProposal) error {
+	for _, record := range p.Records {
+		if _, err := k.poolmanagerKeeper.RouteGetPoolDenoms(ctx, record.PoolId); err != nil {
+			return err
+		}
+	}
+	for _, record := range p.Records {
+		k.SetPoolRecordHistoryKeepPeriod(ctx, record.PoolId, record.RecordHistoryKeepPeriod)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package twap_test
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v15/x/twap"
+	"github.com/osmosis-labs/osmosis/v15/x/twap/types"
+)
+
+// TestSetPoolRecordHistoryKeepPeriodsProposal tests that pool record history keep period
+// overrides are set and removed through governance, and only for existing pools.
+func (s *TestSuite) TestSetPoolRecordHistoryKeepPeriodsProposal() {
+	s.SetupTest()
+	poolId, _, _ := s.setupDefaultPool()
+	handler := twap.NewTwapProposalHandler(*s.twapkeeper)
+	recordHistoryKeepPeriod := s.twapkeeper.RecordHistoryKeepPeriod(s.Ctx)
+	weekKeepPeriod := 7 * 24 * time.Hour
+
+	// pools without an override use the param.
+	s.Require().Equal(recordHistoryKeepPeriod, s.twapkeeper.GetPoolRecordHistoryKeepPeriod(s.Ctx, poolId))
+
+	err := handler(s.Ctx, types.NewSetPoolRecordHistoryKeepPeriodsProposal("title", "description", []types.PoolRecordHistoryKeepPeriod{
+		{PoolId: poolId, RecordHistoryKeepPeriod: weekKeepPeriod},
+	}))
+	s.Require().NoError(err)
+	s.Require().Equal(weekKeepPeriod, s.twapkeeper.GetPoolRecordHistoryKeepPeriod(s.Ctx, poolId))
+	s.Require().Equal([]types.PoolRecordHistoryKeepPeriod{{PoolId: poolId, RecordHistoryKeepPeriod: weekKeepPeriod}}, s.twapkeeper.GetAllPoolRecordHistoryKeepPeriods(s.Ctx))
+
+	// a zero keep period removes the override.
+	err = handler(s.Ctx, types.NewSetPoolRecordHistoryKeepPeriodsProposal("title", "description", []types.PoolRecordHistoryKeepPeriod{
+		{PoolId: poolId, RecordHistoryKeepPeriod: 0},
+	}))
+	s.Require().NoError(err)
+	s.Require().Equal(recordHistoryKeepPeriod, s.twapkeeper.GetPoolRecordHistoryKeepPeriod(s.Ctx, poolId))
+	s.Require().Empty(s.twapkeeper.GetAllPoolRecordHistoryKeepPeriods(s.Ctx))
+
+	// no override is set if any of the pools does not exist.
+	err = handler(s.Ctx, types.NewSetPoolRecordHistoryKeepPeriodsProposal("title", "description", []types.PoolRecordHistoryKeepPeriod{
+		{PoolId: poolId, RecordHistoryKeepPeriod: weekKeepPeriod},
+		{PoolId: poolId + 1, RecordHistoryKeepPeriod: weekKeepPeriod},
+	}))
+	s.Require().Error(err)
+	s.Require().Empty(s.twapkeeper.GetAllPoolRecordHistoryKeepPeriods(s.Ctx))
+}
@@ -48,6 +48,20 @@ func (k *Keeper) RecordHistoryKeepPeriod(ctx sdk.Context) time.Duration {
 	return k.GetParams(ctx).RecordHistoryKeepPeriod
 }
 
+func (k *Keeper) CheckpointInterval(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).CheckpointInterval
+}
+
+func (k *Keeper) CheckpointKeepPeriod(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).CheckpointKeepPeriod
+}
+
+// SetCheckpointParams sets the checkpoint interval and checkpoint keep period params.
+func (k Keeper) SetCheckpointParams(ctx sdk.Context, checkpointInterval, checkpointKeepPeriod time.Duration) {
+	k.paramSpace.Set(ctx, types.KeyCheckpointInterval, checkpointInterval)
+	k.paramSpace.Set(ctx, types.KeyCheckpointKeepPeriod, checkpointKeepPeriod)
+}
+
 // InitGenesis initializes the twap module's state from a provided genesis
 // state.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
@@ -67,6 +81,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, twap := range genState.Twaps {
 		k.storeNewRecord(ctx, twap)
 	}
+
+	for _, record := range genState.PoolRecordHistoryKeepPeriods {
+		k.SetPoolRecordHistoryKeepPeriod(ctx, record.PoolId, record.RecordHistoryKeepPeriod)
+	}
+
+	for _, checkpoint := range genState.Checkpoints {
+		k.storeCheckpoint(ctx, checkpoint)
+	}
 }
 
 // ExportGenesis returns the twap module's exported genesis.
@@ -78,9 +100,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	checkpoints, err := k.getAllCheckpoints(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
-		Twaps:  twapRecords,
+		Params:                       k.GetParams(ctx),
+		Twaps:                        twapRecords,
+		PoolRecordHistoryKeepPeriods: k.GetAllPoolRecordHistoryKeepPeriods(ctx),
+		Checkpoints:                  checkpoints,
 	}
 }
 
@@ -89,7 +118,27 @@ func (k Keeper) GetGeometricStrategy() *geometric {
 	return &geometric{k}
 }
 
+// GetHarmonicStrategy gets harmonic TWAP keeper.
+func (k Keeper) GetHarmonicStrategy() *harmonic {
+	return &harmonic{k}
+}
+
 // GetArithmeticStrategy gets arithmetic TWAP keeper.
 func (k Keeper) GetArithmeticStrategy() *arithmetic {
 	return &arithmetic{k}
 }
+
+// GetVolatilityStrategy gets volatility TWAP keeper.
+func (k Keeper) GetVolatilityStrategy() *volatility {
+	return &volatility{k}
+}
+
+// GetMinSpotPriceStrategy gets min spot price TWAP keeper.
+func (k Keeper) GetMinSpotPriceStrategy() *minSpotPrice {
+	return &minSpotPrice{k}
+}
+
+// GetMaxSpotPriceStrategy gets max spot price TWAP keeper.
+func (k Keeper) GetMaxSpotPriceStrategy() *maxSpotPrice {
+	return &maxSpotPrice{k}
+}
@@ -45,7 +45,7 @@ func (s *TestSuite) SetupTest() {
 }
 
 var (
-	basicParams = types.NewParams("week", 48*time.Hour)
+	basicParams = types.NewParams("week", 48*time.Hour, time.Hour, 30*24*time.Hour)
 
 	mostRecentRecordPoolOne = types.TwapRecord{
 		PoolId:                      basePoolId,
@@ -58,6 +58,7 @@ var (
 		P0ArithmeticTwapAccumulator: sdk.OneDec(),
 		P1ArithmeticTwapAccumulator: sdk.OneDec(),
 		GeometricTwapAccumulator:    sdk.OneDec(),
+		HarmonicTwapAccumulator:     sdk.OneDec(),
 	}
 
 	basicCustomGenesis = types.NewGenesisState(
@@ -80,6 +81,7 @@ var (
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
 				GeometricTwapAccumulator:    sdk.OneDec(),
+				HarmonicTwapAccumulator:     sdk.OneDec(),
 			},
 			{
 				PoolId:                      basePoolId,
@@ -92,6 +94,7 @@ var (
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
 				GeometricTwapAccumulator:    sdk.OneDec(),
+				HarmonicTwapAccumulator:     sdk.OneDec(),
 			},
 			mostRecentRecordPoolOne,
 		})
@@ -107,6 +110,7 @@ var (
 		P0ArithmeticTwapAccumulator: sdk.OneDec(),
 		P1ArithmeticTwapAccumulator: sdk.OneDec(),
 		GeometricTwapAccumulator:    sdk.OneDec(),
+		HarmonicTwapAccumulator:     sdk.OneDec(),
 	}
 
 	decreasingOrderByTimeRecordsPoolTwo = types.NewGenesisState(
@@ -124,6 +128,7 @@ var (
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
 				GeometricTwapAccumulator:    sdk.OneDec(),
+				HarmonicTwapAccumulator:     sdk.OneDec(),
 			},
 			{
 				PoolId:                      basePoolId,
@@ -136,6 +141,7 @@ var (
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
 				GeometricTwapAccumulator:    sdk.OneDec(),
+				HarmonicTwapAccumulator:     sdk.OneDec(),
 			},
 		})
 
@@ -165,6 +171,11 @@ func withSp1(twap types.TwapRecord, sp sdk.Dec) types.TwapRecord {
 	return twap
 }
 
+func withHarmonicAccum(twap types.TwapRecord, accum sdk.Dec) types.TwapRecord {
+	twap.HarmonicTwapAccumulator = accum
+	return twap
+}
+
 // TestTWAPInitGenesis tests that genesis is initialized correctly
 // with different parameters and state.
 // Asserts that the most recent records are set correctly.
@@ -209,7 +220,7 @@ func (suite *TestSuite) TestTwapInitGenesis() {
 		},
 		"custom invalid genesis - error": {
 			twapGenesis: types.NewGenesisState(
-				types.NewParams("week", 48*time.Hour),
+				types.NewParams("week", 48*time.Hour, time.Hour, 30*24*time.Hour),
 				[]types.TwapRecord{
 					{
 						PoolId:                      0, // invalid
@@ -222,6 +233,7 @@ func (suite *TestSuite) TestTwapInitGenesis() {
 						P0ArithmeticTwapAccumulator: sdk.OneDec(),
 						P1ArithmeticTwapAccumulator: sdk.OneDec(),
 						GeometricTwapAccumulator:    sdk.OneDec(),
+						HarmonicTwapAccumulator:     sdk.OneDec(),
 					},
 				}),
 
@@ -419,6 +431,8 @@ func newTwoAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accum0, accum1, geo
 		P0ArithmeticTwapAccumulator: accum0,
 		P1ArithmeticTwapAccumulator: accum1,
 		GeometricTwapAccumulator:    geomAccum,
+		// the p1 spot price is the inverse of the p0 spot price.
+		HarmonicTwapAccumulator: accum1,
 	}
 }
 
@@ -440,6 +454,8 @@ func newThreeAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accumA, accumB, a
 		P0ArithmeticTwapAccumulator: accumA,
 		P1ArithmeticTwapAccumulator: accumB,
 		GeometricTwapAccumulator:    geomAccumAB,
+		// spB is the inverse of spA.
+		HarmonicTwapAccumulator: accumB,
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -451,6 +467,7 @@ func newThreeAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accumA, accumB, a
 	twapBC.P0LastSpotPrice = spB
 	twapBC.P0ArithmeticTwapAccumulator = accumB
 	twapBC.GeometricTwapAccumulator = geomAccumBC
+	twapBC.HarmonicTwapAccumulator = accumA
 
 	return twapAB, twapAC, twapBC
 }
@@ -467,6 +484,7 @@ func newEmptyPriceRecord(poolId uint64, t time.Time, asset0 string, asset1 strin
 		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
 		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
 		GeometricTwapAccumulator:    sdk.ZeroDec(),
+		HarmonicTwapAccumulator:     sdk.ZeroDec(),
 	}
 }
 
@@ -497,6 +515,8 @@ func newRecord(poolId uint64, t time.Time, sp0, accum0, accum1, geomAccum sdk.De
 		P0ArithmeticTwapAccumulator: accum0.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accum1.Add(sdk.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccum.Add(sdk.ZeroDec()),
+		// the p1 spot price is the inverse of the p0 spot price.
+		HarmonicTwapAccumulator: accum1.Add(sdk.ZeroDec()),
 	}
 }
 
@@ -509,6 +529,8 @@ func newExpRecord(accum0, accum1, geomAccum sdk.Dec) types.TwapRecord {
 		P0ArithmeticTwapAccumulator: accum0.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accum1.Add(sdk.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccum.Add(sdk.ZeroDec()),
+		// the p1 spot price is the inverse of the p0 spot price.
+		HarmonicTwapAccumulator: accum1.Add(sdk.ZeroDec()),
 	}
 }
 
@@ -527,6 +549,8 @@ func newThreeAssetRecord(poolId uint64, t time.Time, sp0, accumA, accumB, accumC
 		P0ArithmeticTwapAccumulator: accumA.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accumB.Add(sdk.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccumAB.Add(sdk.ZeroDec()),
+		// spB is the inverse of spA.
+		HarmonicTwapAccumulator: accumB.Add(sdk.ZeroDec()),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -538,6 +562,7 @@ func newThreeAssetRecord(poolId uint64, t time.Time, sp0, accumA, accumB, accumC
 	twapBC.P0LastSpotPrice = spB
 	twapBC.P0ArithmeticTwapAccumulator = accumB
 	twapBC.GeometricTwapAccumulator = geomAccumBC.Add(sdk.ZeroDec())
+	twapBC.HarmonicTwapAccumulator = accumA.Add(sdk.ZeroDec())
 	return []types.TwapRecord{twapAB, twapAC, twapBC}
 }
 
@@ -551,6 +576,8 @@ func newThreeAssetExpRecord(poolId uint64, accumA, accumB, accumC, geomAccumAB,
 		P0ArithmeticTwapAccumulator: accumA.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accumB.Add(sdk.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccumAB.Add(sdk.ZeroDec()),
+		// spB is the inverse of spA.
+		HarmonicTwapAccumulator: accumB.Add(sdk.ZeroDec()),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -560,6 +587,7 @@ func newThreeAssetExpRecord(poolId uint64, accumA, accumB, accumC, geomAccumAB,
 	twapBC.Asset0Denom = denom1
 	twapBC.P0ArithmeticTwapAccumulator = accumB
 	twapBC.GeometricTwapAccumulator = geomAccumBC.Add(sdk.ZeroDec())
+	twapBC.HarmonicTwapAccumulator = accumA.Add(sdk.ZeroDec())
 	return []types.TwapRecord{twapAB, twapAC, twapBC}
 }
 
@@ -582,6 +610,14 @@ func newOneSidedGeometricRecord(time time.Time, accum sdk.Dec) types.TwapRecord
 	return record
 }
 
+func newHarmonicRecord(time time.Time, p0ArithmeticAccum, harmonicAccum sdk.Dec) types.TwapRecord {
+	record := types.TwapRecord{Time: time, Asset0Denom: denom0, Asset1Denom: denom1}
+	record.P0ArithmeticTwapAccumulator = p0ArithmeticAccum
+	record.HarmonicTwapAccumulator = harmonicAccum
+	record.P0LastSpotPrice = sdk.NewDec(10)
+	return record
+}
+
 func newThreeAssetOneSidedRecord(time time.Time, accum sdk.Dec, useP0 bool) []types.TwapRecord {
 	record := types.TwapRecord{Time: time, Asset0Denom: denom0, Asset1Denom: denom1}
 	if useP0 {
@@ -603,6 +639,8 @@ func recordWithUpdatedAccum(record types.TwapRecord, accum0 sdk.Dec, accum1, geo
 	record.P0ArithmeticTwapAccumulator = accum0
 	record.P1ArithmeticTwapAccumulator = accum1
 	record.GeometricTwapAccumulator = geomAccum
+	// the p1 spot price is the inverse of the p0 spot price.
+	record.HarmonicTwapAccumulator = accum1
 	return record
 }
 
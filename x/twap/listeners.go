@@ -3,13 +3,13 @@ package twap
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/osmosis-labs/osmosis/v15/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
 var (
-	_ types.GammHooks       = &gammhook{}
-	_ epochtypes.EpochHooks = &epochhook{}
+	_ epochtypes.EpochHooks         = &epochhook{}
+	_ poolmanagertypes.PoolListener = &poolListener{}
 )
 
 type epochhook struct {
@@ -33,31 +33,44 @@ func (hook *epochhook) BeforeEpochStart(ctx sdk.Context, epochIdentifier string,
 	return nil
 }
 
-type gammhook struct {
+type poolListener struct {
 	k Keeper
 }
 
-func (k Keeper) GammHooks() types.GammHooks {
-	return &gammhook{k}
+func (k Keeper) PoolListener() poolmanagertypes.PoolListener {
+	return &poolListener{k}
 }
 
-// AfterPoolCreated is called after CreatePool
-func (hook *gammhook) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
-	err := hook.k.afterCreatePool(ctx, poolId)
+// AfterPoolCreated creates the twap records of a new pool.
+// Concentrated liquidity pools have no spot price before their first position,
+// so their records are created once liquidity is first added to them.
+func (l *poolListener) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	pool, err := l.k.poolmanagerKeeper.RoutePool(ctx, poolId)
+	if err != nil {
+		panic(err)
+	}
+	if pool.GetType() == poolmanagertypes.Concentrated {
+		return
+	}
+	err = l.k.afterCreatePool(ctx, poolId)
 	// Will halt pool creation
 	if err != nil {
 		panic(err)
 	}
 }
 
-func (hook *gammhook) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
-	hook.k.trackChangedPool(ctx, poolId)
+func (l *poolListener) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	l.k.trackChangedPool(ctx, poolId)
 }
 
-func (hook *gammhook) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
-	hook.k.trackChangedPool(ctx, poolId)
+func (l *poolListener) AfterLiquidityAdded(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, liquidity sdk.Coins) {
+	err := l.k.afterLiquidityAdded(ctx, poolId)
+	// Will halt the liquidity change
+	if err != nil {
+		panic(err)
+	}
 }
 
-func (hook *gammhook) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
-	hook.k.trackChangedPool(ctx, poolId)
+func (l *poolListener) AfterLiquidityRemoved(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, liquidity sdk.Coins) {
+	l.k.trackChangedPool(ctx, poolId)
 }
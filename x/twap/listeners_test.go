@@ -6,6 +6,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/app/apptesting"
+	cl "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity"
+	cltypes "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v15/x/twap"
 	"github.com/osmosis-labs/osmosis/v15/x/twap/types"
 )
@@ -329,6 +332,89 @@ func (s *TestSuite) TestPoolStateChange() {
 	}
 }
 
+// TestPoolListener_ConcentratedPool tests that twap records are created for a concentrated liquidity pool
+// once its first position is created, and that subsequent liquidity changes and swaps track the pool.
+func (s *TestSuite) TestPoolListener_ConcentratedPool() {
+	tests := map[string]struct {
+		secondPosition bool
+		swap           bool
+	}{
+		"first position creates records": {},
+		"second position triggers track changed pools": {
+			secondPosition: true,
+		},
+		"swap triggers track changed pools": {
+			swap: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.SetupTest()
+		s.Run(name, func() {
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			pool := s.PrepareConcentratedPool()
+			poolId := pool.GetId()
+
+			// The pool has no spot price before its first position, so no records are created on pool creation.
+			records, err := s.twapkeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Empty(records)
+			s.Require().Empty(s.twapkeeper.GetChangedPools(s.Ctx))
+
+			s.createConcentratedPosition(poolId)
+
+			expectedRecord, err := twap.NewTwapRecord(s.App.PoolManagerKeeper, s.Ctx, poolId, apptesting.ETH, apptesting.USDC)
+			s.Require().NoError(err)
+			records, err = s.twapkeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal([]types.TwapRecord{expectedRecord}, records)
+			s.Require().Equal([]uint64{poolId}, s.twapkeeper.GetChangedPools(s.Ctx))
+
+			if !tc.secondPosition && !tc.swap {
+				return
+			}
+
+			s.EndBlock()
+			s.Commit()
+			s.Require().Empty(s.twapkeeper.GetChangedPools(s.Ctx))
+
+			if tc.secondPosition {
+				s.createConcentratedPosition(poolId)
+			}
+
+			if tc.swap {
+				swapTokenIn := sdk.NewCoin(apptesting.USDC, sdk.NewInt(1000000))
+				s.FundAcc(s.TestAccs[1], sdk.NewCoins(swapTokenIn))
+				concentratedPool, err := clKeeper.GetPool(s.Ctx, poolId)
+				s.Require().NoError(err)
+				_, err = clKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[1], concentratedPool, swapTokenIn, apptesting.ETH, sdk.OneInt(), sdk.ZeroDec())
+				s.Require().NoError(err)
+			}
+
+			s.Require().Equal([]uint64{poolId}, s.twapkeeper.GetChangedPools(s.Ctx))
+		})
+	}
+}
+
+// createConcentratedPosition creates a position around the current price of an eth/usdc concentrated liquidity pool.
+func (s *TestSuite) createConcentratedPosition(poolId uint64) {
+	tokens := sdk.NewCoins(sdk.NewCoin(apptesting.ETH, sdk.NewInt(1000000)), sdk.NewCoin(apptesting.USDC, sdk.NewInt(5000000000)))
+	s.FundAcc(s.TestAccs[0], tokens)
+
+	msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+	_, err := msgServer.CreatePosition(sdk.WrapSDKContext(s.Ctx), &cltypes.MsgCreatePosition{
+		PoolId:          poolId,
+		Sender:          s.TestAccs[0].String(),
+		LowerTick:       305450,
+		UpperTick:       315000,
+		TokenDesired0:   tokens[0],
+		TokenDesired1:   tokens[1],
+		TokenMinAmount0: sdk.ZeroInt(),
+		TokenMinAmount1: sdk.ZeroInt(),
+	})
+	s.Require().NoError(err)
+}
+
 // This test should create multiple mock pools, test one pool's spot price returning an error,
 // and ensure end blocks still work safely.
 // func (s *TestSuite) TestSafetyWithPoolThatHasSpotPriceError() {
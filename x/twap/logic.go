@@ -29,6 +29,7 @@ func newTwapRecord(k types.PoolManagerInterface, ctx sdk.Context, poolId uint64,
 		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
 		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
 		GeometricTwapAccumulator:    sdk.ZeroDec(),
+		HarmonicTwapAccumulator:     sdk.ZeroDec(),
 		LastErrorTime:               lastErrorTime,
 	}, nil
 }
@@ -91,6 +92,21 @@ func (k Keeper) afterCreatePool(ctx sdk.Context, poolId uint64) error {
 	return err
 }
 
+// afterLiquidityAdded creates new twap records for a pool that has none yet, which is the case
+// for a concentrated liquidity pool until its first position is created.
+// Otherwise, the pool is tracked so that its records are updated in EndBlock.
+func (k Keeper) afterLiquidityAdded(ctx sdk.Context, poolId uint64) error {
+	records, err := k.getAllMostRecentRecordsForPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return k.afterCreatePool(ctx, poolId)
+	}
+	k.trackChangedPool(ctx, poolId)
+	return nil
+}
+
 func (k Keeper) EndBlock(ctx sdk.Context) {
 	// get changed pools grabs all altered pool ids from the transient store.
 	// 'altered pool ids' gets automatically cleared on commit by being a transient store
@@ -135,9 +151,16 @@ func (k Keeper) updateRecords(ctx sdk.Context, poolId uint64) error {
 		return types.InvalidRecordCountError{Expected: expectedRecordsLength, Actual: len(records)}
 	}
 
+	checkpointInterval := k.CheckpointInterval(ctx)
 	for _, record := range records {
 		newRecord := k.updateRecord(ctx, record)
 		k.storeNewRecord(ctx, newRecord)
+
+		// The previous record is the last record of its checkpoint interval
+		// if the new record falls into a later interval.
+		if !record.Time.Truncate(checkpointInterval).Equal(newRecord.Time.Truncate(checkpointInterval)) {
+			k.storeCheckpoint(ctx, record)
+		}
 	}
 	return nil
 }
@@ -162,13 +185,25 @@ func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) types.Twa
 // pruneRecords prunes twap records that happened earlier than recordHistoryKeepPeriod
 // before current block time while preserving the most recent record before the threshold.
 // Such record is preserved for each pool.
+// Pools with a record history keep period override use their own threshold instead.
+// Checkpoints that happened earlier than checkpointKeepPeriod before current block time
+// are pruned in the same way.
 // See TWAP keeper's `pruneRecordsBeforeTimeButNewest(...)` for more details about the reasons for
 // keeping this record.
 func (k Keeper) pruneRecords(ctx sdk.Context) error {
-	recordHistoryKeepPeriod := k.RecordHistoryKeepPeriod(ctx)
+	params := k.GetParams(ctx)
 
-	lastKeptTime := ctx.BlockTime().Add(-recordHistoryKeepPeriod)
-	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime)
+	lastKeptTime := ctx.BlockTime().Add(-params.RecordHistoryKeepPeriod)
+	poolLastKeptTimes := make(map[uint64]time.Time)
+	for _, override := range k.GetAllPoolRecordHistoryKeepPeriods(ctx) {
+		poolLastKeptTimes[override.PoolId] = ctx.BlockTime().Add(-override.RecordHistoryKeepPeriod)
+	}
+	if err := k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime, poolLastKeptTimes); err != nil {
+		return err
+	}
+
+	lastKeptCheckpointTime := ctx.BlockTime().Add(-params.CheckpointKeepPeriod)
+	return k.pruneCheckpointsBeforeTimeButNewest(ctx, lastKeptCheckpointTime)
 }
 
 // recordWithUpdatedAccumulators returns a record, with updated accumulator values and time for provided newTime,
@@ -194,8 +229,8 @@ func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) t
 	p1NewAccum := types.SpotPriceMulDuration(record.P1LastSpotPrice, timeDelta)
 	newRecord.P1ArithmeticTwapAccumulator = newRecord.P1ArithmeticTwapAccumulator.Add(p1NewAccum)
 
-	// If the last spot price is zero, then the logarithm is undefined.
-	// As a result, we cannot update the geometric accumulator.
+	// If the last spot price is zero, then the logarithm and the inverse are undefined.
+	// As a result, we cannot update the geometric and harmonic accumulators.
 	// We set the last error time to be the new time, and return the record.
 	if record.P0LastSpotPrice.IsZero() {
 		newRecord.LastErrorTime = newTime
@@ -208,15 +243,25 @@ func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) t
 	p0NewGeomAccum := types.SpotPriceMulDuration(logP0SpotPrice, timeDelta)
 	newRecord.GeometricTwapAccumulator = newRecord.GeometricTwapAccumulator.Add(p0NewGeomAccum)
 
+	// p0NewHarmonicAccum = (1 / P_0) * timeDelta
+	p0NewHarmonicAccum := types.SpotPriceMulDuration(sdk.OneDec().Quo(record.P0LastSpotPrice), timeDelta)
+	newRecord.HarmonicTwapAccumulator = newRecord.HarmonicTwapAccumulator.Add(p0NewHarmonicAccum)
+
 	return newRecord
 }
 
 // getInterpolatedRecord returns a record for this pool, representing its accumulator state at time `t`.
 // This is achieved by getting the record `r` that is at, or immediately preceding in state time `t`.
 // To be clear: the record r s.t. `t - r.Time` is minimized AND `t >= r.Time`
+// If `t` is older than all historical records, the checkpoint at, or immediately preceding `t` is used instead.
+// Since checkpoints only keep the last record of every checkpoint interval, the interpolated record
+// is then accurate up to the price changes within one checkpoint interval.
 // If for the record obtained, r.Time == r.LastErrorTime, this will also hold for the interpolated record.
 func (k Keeper) getInterpolatedRecord(ctx sdk.Context, poolId uint64, t time.Time, assetA, assetB string) (types.TwapRecord, error) {
 	record, err := k.getRecordAtOrBeforeTime(ctx, poolId, t, assetA, assetB)
+	if errors.As(err, &timeTooOldError{}) {
+		record, err = k.getCheckpointAtOrBeforeTime(ctx, poolId, t, assetA, assetB)
+	}
 	if err != nil {
 		return types.TwapRecord{}, err
 	}
@@ -238,7 +283,7 @@ func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, assetA, asse
 }
 
 // computeTwap computes and returns a TWAP of a given
-// type - arithmetic or geometric.
+// type - arithmetic, geometric or harmonic.
 // Between two records given the quote asset.
 // precondition: endRecord.Time >= startRecord.Time
 // if (endRecord.LastErrorTime >= startRecord.Time) returns an error at end + result
@@ -266,6 +311,62 @@ func computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quote
 	return strategy.computeTwap(startRecord, endRecord, quoteAsset), err
 }
 
+// getRecordsInWindow returns the records describing the pool's accumulator state over (startTime, endTime),
+// ordered by time. The first and last records are interpolated to startTime and endTime respectively,
+// and all historical records strictly in between are returned unmodified.
+// If endTime is the current block time, the last record is the most recent record updated to block time.
+func (k Keeper) getRecordsInWindow(ctx sdk.Context, poolId uint64, startTime time.Time, endTime time.Time, assetA, assetB string) ([]types.TwapRecord, error) {
+	if startTime.After(endTime) {
+		return nil, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return nil, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	}
+
+	startRecord, err := k.getInterpolatedRecord(ctx, poolId, startTime, assetA, assetB)
+	if err != nil {
+		return nil, err
+	}
+	records, err := k.getHistoricalRecordsBetweenTimes(ctx, poolId, startTime, endTime, assetA, assetB)
+	if err != nil {
+		return nil, err
+	}
+
+	var endRecord types.TwapRecord
+	if endTime.Equal(ctx.BlockTime()) {
+		endRecord, err = k.getMostRecentRecord(ctx, poolId, assetA, assetB)
+	} else {
+		endRecord, err = k.getInterpolatedRecord(ctx, poolId, endTime, assetA, assetB)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	records = append([]types.TwapRecord{startRecord}, records...)
+	return append(records, endRecord), nil
+}
+
+// computeOverRecords computes and returns a statistic of a given
+// type - volatility, min or max spot price - over the given records.
+// precondition: records are ordered by time and len(records) >= 2
+// Follows the same error semantics as computeTwap, based on the first and last records.
+func computeOverRecords(records []types.TwapRecord, quoteAsset string, strategy twapRecordsStrategy) (sdk.Dec, error) {
+	startRecord, endRecord := records[0], records[len(records)-1]
+	// see if we need to return an error, due to spot price issues
+	var err error = nil
+	if endRecord.LastErrorTime.After(startRecord.Time) ||
+		endRecord.LastErrorTime.Equal(startRecord.Time) ||
+		startRecord.LastErrorTime.Equal(startRecord.Time) {
+		err = errors.New("twap: error in pool spot price occurred between start and end time, result may be faulty")
+	}
+
+	result, computeErr := strategy.computeOverRecords(records, quoteAsset)
+	if computeErr != nil {
+		return sdk.Dec{}, computeErr
+	}
+	return result, err
+}
+
 // twapLog returns the logarithm of the given spot price, base 2.
 // Panics if zero is given.
 func twapLog(price sdk.Dec) sdk.Dec {
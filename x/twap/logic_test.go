@@ -159,6 +159,7 @@ func (s *TestSuite) TestNewTwapRecord() {
 					s.Require().Equal(sdk.ZeroDec(), twapRecord.P0ArithmeticTwapAccumulator)
 					s.Require().Equal(sdk.ZeroDec(), twapRecord.P1ArithmeticTwapAccumulator)
 					s.Require().Equal(sdk.ZeroDec(), twapRecord.GeometricTwapAccumulator)
+					s.Require().Equal(sdk.ZeroDec(), twapRecord.HarmonicTwapAccumulator)
 				}
 			})
 		}
@@ -280,15 +281,15 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 			newTime:   time.Unix(1, 0),
 			expRecord: newExpRecord(oneDec, twoDec, pointFiveDec),
 		},
-		"sp0 - zero spot price - accum0 unchanged, accum1 updated, geom accum unchanged, last err time set": {
+		"sp0 - zero spot price - accum0 unchanged, accum1 updated, geom and harmonic accum unchanged, last err time set": {
 			record:    withPrice0Set(defaultRecord, sdk.ZeroDec()),
 			newTime:   defaultRecord.Time.Add(time.Second),
-			expRecord: withLastErrTime(newExpRecord(oneDec, twoDec.Add(sdk.NewDecWithPrec(1, 1).Mul(OneSec)), pointFiveDec), defaultRecord.Time.Add(time.Second)),
+			expRecord: withLastErrTime(withHarmonicAccum(newExpRecord(oneDec, twoDec.Add(sdk.NewDecWithPrec(1, 1).Mul(OneSec)), pointFiveDec), twoDec), defaultRecord.Time.Add(time.Second)),
 		},
-		"sp1 - zero spot price - accum0 updated, accum1 unchanged, geom accum updated correctly": {
+		"sp1 - zero spot price - accum0 updated, accum1 unchanged, geom and harmonic accum updated correctly": {
 			record:    withPrice1Set(defaultRecord, sdk.ZeroDec()),
 			newTime:   defaultRecord.Time.Add(time.Second),
-			expRecord: newExpRecord(tenSecAccum.Add(oneDec), twoDec, pointFiveDec.Add(geometricTenSecAccum)),
+			expRecord: withHarmonicAccum(newExpRecord(tenSecAccum.Add(oneDec), twoDec, pointFiveDec.Add(geometricTenSecAccum)), twoDec.Add(OneSec.QuoInt64(10))),
 		},
 		"both sp - zero spot price - accum0 unchange, accum1 unchanged, geom accum unchanged": {
 			record:    withPrice1Set(withPrice0Set(defaultRecord, sdk.ZeroDec()), sdk.ZeroDec()),
@@ -628,6 +629,124 @@ func (s *TestSuite) TestPruneRecords() {
 	s.validateExpectedRecords(expectedKeptRecords)
 }
 
+// TestPruneRecords_PoolRecordHistoryKeepPeriod tests that pools with a record history
+// keep period override are pruned using their own keep period instead of the param.
+func (s *TestSuite) TestPruneRecords_PoolRecordHistoryKeepPeriod() {
+	s.SetupTest()
+	recordHistoryKeepPeriod := s.twapkeeper.RecordHistoryKeepPeriod(s.Ctx)
+
+	// pool 1 uses the param, pool 2 keeps records twice as long and pool 3 half as long.
+	s.twapkeeper.SetPoolRecordHistoryKeepPeriod(s.Ctx, 2, 2*recordHistoryKeepPeriod)
+	s.twapkeeper.SetPoolRecordHistoryKeepPeriod(s.Ctx, 3, recordHistoryKeepPeriod/2)
+
+	recordsAt := func(t time.Time) (types.TwapRecord, types.TwapRecord, types.TwapRecord) {
+		return newEmptyPriceRecord(1, t, denom0, denom1), newEmptyPriceRecord(2, t, denom0, denom1), newEmptyPriceRecord(3, t, denom0, denom1)
+	}
+	pool1Min3Periods, pool2Min3Periods, pool3Min3Periods := recordsAt(baseTime.Add(-3 * recordHistoryKeepPeriod))
+	pool1Min3HalfPeriods, pool2Min3HalfPeriods, pool3Min3HalfPeriods := recordsAt(baseTime.Add(-3 * recordHistoryKeepPeriod / 2))
+	pool1Min3QuarterPeriods, pool2Min3QuarterPeriods, pool3Min3QuarterPeriods := recordsAt(baseTime.Add(-3 * recordHistoryKeepPeriod / 4))
+	pool1Min1QuarterPeriod, pool2Min1QuarterPeriod, pool3Min1QuarterPeriod := recordsAt(baseTime.Add(-recordHistoryKeepPeriod / 4))
+
+	s.preSetRecords([]types.TwapRecord{
+		pool1Min3Periods, pool2Min3Periods, pool3Min3Periods,
+		pool1Min3HalfPeriods, pool2Min3HalfPeriods, pool3Min3HalfPeriods,
+		pool1Min3QuarterPeriods, pool2Min3QuarterPeriods, pool3Min3QuarterPeriods,
+		pool1Min1QuarterPeriod, pool2Min1QuarterPeriod, pool3Min1QuarterPeriod,
+	})
+
+	// ordered by time, then by pool id.
+	expectedKeptRecords := []types.TwapRecord{
+		pool2Min3Periods,                           // kept as newest under pool 2 keep period
+		pool1Min3HalfPeriods, pool2Min3HalfPeriods, // pool 1 kept as newest under keep period
+		pool1Min3QuarterPeriods, pool2Min3QuarterPeriods, pool3Min3QuarterPeriods, // pool 3 kept as newest under pool 3 keep period
+		pool1Min1QuarterPeriod, pool2Min1QuarterPeriod, pool3Min1QuarterPeriod,
+	}
+
+	err := s.twapkeeper.PruneRecords(s.Ctx)
+	s.Require().NoError(err)
+
+	s.validateExpectedRecords(expectedKeptRecords)
+}
+
+// TestPruneRecords_Checkpoints tests that checkpoints earlier than
+// current block time - CheckpointKeepPeriod are pruned from the store
+// while keeping the newest checkpoint before the above time threshold.
+func (s *TestSuite) TestPruneRecords_Checkpoints() {
+	s.SetupTest()
+	checkpointKeepPeriod := s.twapkeeper.CheckpointKeepPeriod(s.Ctx)
+
+	mostRecentRecord := newEmptyPriceRecord(basePoolId, baseTime, denom0, denom1)
+	olderCheckpoint := newEmptyPriceRecord(basePoolId, baseTime.Add(-checkpointKeepPeriod-2*time.Hour), denom0, denom1)     // deleted
+	newestOlderCheckpoint := newEmptyPriceRecord(basePoolId, baseTime.Add(-checkpointKeepPeriod-time.Hour), denom0, denom1) // kept as newest under keep period
+	keptCheckpoint := newEmptyPriceRecord(basePoolId, baseTime.Add(-checkpointKeepPeriod+time.Hour), denom0, denom1)        // kept
+
+	s.preSetRecords([]types.TwapRecord{mostRecentRecord})
+	for _, checkpoint := range []types.TwapRecord{keptCheckpoint, olderCheckpoint, newestOlderCheckpoint} {
+		s.twapkeeper.StoreCheckpoint(s.Ctx, checkpoint)
+	}
+
+	err := s.twapkeeper.PruneRecords(s.Ctx)
+	s.Require().NoError(err)
+
+	checkpoints, err := s.twapkeeper.GetAllCheckpoints(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.TwapRecord{newestOlderCheckpoint, keptCheckpoint}, checkpoints)
+}
+
+// TestUpdateRecords_Checkpoints tests that the last record of every
+// checkpoint interval is stored as a checkpoint once a later record is created.
+func (s *TestSuite) TestUpdateRecords_Checkpoints() {
+	s.SetupTest()
+	checkpointInterval := s.twapkeeper.CheckpointInterval(s.Ctx)
+	// N.B.: baseTime is aligned to the checkpoint interval.
+	poolId, denomA, denomB := s.setupDefaultPool()
+
+	// The new record is in the same interval as the pool creation record.
+	ctx := s.Ctx.WithBlockTime(baseTime.Add(checkpointInterval / 2))
+	err := s.twapkeeper.UpdateRecords(ctx, poolId)
+	s.Require().NoError(err)
+
+	checkpoints, err := s.twapkeeper.GetAllCheckpoints(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(checkpoints)
+
+	// The new record is in the next interval, so the previous record becomes a checkpoint.
+	lastRecordInInterval, err := s.twapkeeper.GetMostRecentRecordStoreRepresentation(ctx, poolId, denomA, denomB)
+	s.Require().NoError(err)
+
+	ctx = ctx.WithBlockTime(baseTime.Add(checkpointInterval * 3 / 2))
+	err = s.twapkeeper.UpdateRecords(ctx, poolId)
+	s.Require().NoError(err)
+
+	checkpoints, err = s.twapkeeper.GetAllCheckpoints(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.TwapRecord{lastRecordInInterval}, checkpoints)
+}
+
+// TestGetInterpolatedRecord_Checkpoint tests that checkpoints are used to interpolate
+// records at times older than all historical records.
+func (s *TestSuite) TestGetInterpolatedRecord_Checkpoint() {
+	s.SetupTest()
+	checkpoint := withTime(baseRecord, baseTime.Add(-100*time.Hour))
+	s.preSetRecords([]types.TwapRecord{baseRecord})
+	s.twapkeeper.StoreCheckpoint(s.Ctx, checkpoint)
+	s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin)
+
+	// historical records are used when available.
+	record, err := s.twapkeeper.GetInterpolatedRecord(s.Ctx, baseRecord.PoolId, denom0, denom1, tPlusOne)
+	s.Require().NoError(err)
+	s.Require().Equal(twap.RecordWithUpdatedAccumulators(baseRecord, tPlusOne), record)
+
+	// checkpoints are used for times older than all historical records.
+	record, err = s.twapkeeper.GetInterpolatedRecord(s.Ctx, baseRecord.PoolId, denom0, denom1, baseTime.Add(-50*time.Hour))
+	s.Require().NoError(err)
+	s.Require().Equal(twap.RecordWithUpdatedAccumulators(checkpoint, baseTime.Add(-50*time.Hour)), record)
+
+	// times older than all checkpoints error.
+	_, err = s.twapkeeper.GetInterpolatedRecord(s.Ctx, baseRecord.PoolId, denom0, denom1, baseTime.Add(-200*time.Hour))
+	s.Require().Equal(twap.TimeTooOldError{Time: baseTime.Add(-200 * time.Hour)}, err)
+}
+
 // TestUpdateRecords tests that the records are updated correctly.
 // It tests the following:
 // - two-asset pools
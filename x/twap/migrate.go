@@ -1,7 +1,11 @@
 package twap
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v15/x/twap/types"
 )
 
 // MigrateExistingPools iterates through all pools and creates state entry for the twap module.
@@ -14,3 +18,45 @@ func (k Keeper) MigrateExistingPools(ctx sdk.Context, latestPoolId uint64) error
 	}
 	return nil
 }
+
+// SetLastErrorTimeForRecordsBefore sets the last error time of every twap record stored before t,
+// i.e. the most recent records, historical records and checkpoints, to t.
+// The twap of any window starting at or before t then returns the spot price error along with its result.
+// It is used on the upgrade that introduces a new accumulator, such as the harmonic accumulator,
+// which records stored before the upgrade start at zero, so that twaps over them are not silently wrong.
+func (k Keeper) SetLastErrorTimeForRecordsBefore(ctx sdk.Context, t time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+	mostRecentRecords, err := types.GetAllMostRecentTwaps(store)
+	if err != nil {
+		return err
+	}
+	for _, record := range mostRecentRecords {
+		if record.Time.Before(t) {
+			record.LastErrorTime = t
+			k.storeNewRecord(ctx, record)
+		}
+	}
+
+	historicalRecords, err := k.getAllHistoricalTimeIndexedTWAPs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, record := range historicalRecords {
+		if record.Time.Before(t) {
+			record.LastErrorTime = t
+			k.storeHistoricalTWAP(ctx, record)
+		}
+	}
+
+	checkpoints, err := k.getAllCheckpoints(ctx)
+	if err != nil {
+		return err
+	}
+	for _, record := range checkpoints {
+		if record.Time.Before(t) {
+			record.LastErrorTime = t
+			k.storeCheckpoint(ctx, record)
+		}
+	}
+	return nil
+}
@@ -68,6 +68,39 @@ func (s *TestSuite) TestMigrateExistingPoolsError() {
 	s.Require().Error(err)
 }
 
+// TestSetLastErrorTimeForRecordsBefore tests that twaps of windows starting before the upgrade time
+// return the spot price error, while twaps of windows starting after it do not.
+func (s *TestSuite) TestSetLastErrorTimeForRecordsBefore() {
+	poolId, denomA, denomB := s.setupDefaultPool()
+	poolCreationTime := s.Ctx.BlockTime()
+
+	// suppose upgrade happened 10 seconds after the pool creation
+	upgradeTime := poolCreationTime.Add(10 * time.Second)
+	s.Ctx = s.Ctx.WithBlockTime(upgradeTime)
+	err := s.twapkeeper.SetLastErrorTimeForRecordsBefore(s.Ctx, upgradeTime)
+	s.Require().NoError(err)
+
+	mostRecentRecords, err := s.twapkeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	for _, record := range mostRecentRecords {
+		s.Require().Equal(upgradeTime, record.LastErrorTime)
+	}
+	for _, record := range s.getAllHistoricalRecordsForPool(poolId) {
+		s.Require().Equal(upgradeTime, record.LastErrorTime)
+	}
+
+	s.Ctx = s.Ctx.WithBlockTime(upgradeTime.Add(10 * time.Second))
+
+	_, err = s.twapkeeper.GetHarmonicTwapToNow(s.Ctx, poolId, denomA, denomB, poolCreationTime)
+	s.Require().Error(err)
+	_, err = s.twapkeeper.GetArithmeticTwapToNow(s.Ctx, poolId, denomA, denomB, upgradeTime)
+	s.Require().Error(err)
+
+	harmonicTwap, err := s.twapkeeper.GetHarmonicTwapToNow(s.Ctx, poolId, denomA, denomB, upgradeTime.Add(time.Second))
+	s.Require().NoError(err)
+	s.Require().True(harmonicTwap.IsPositive())
+}
+
 // TestTwapRecord_GeometricTwap_MarshalUnmarshal this test proves that migrations
 // to initialize geometric twap accumulators are not required.
 // This is because proto marshalling will initialize the field to the zero value.
@@ -93,3 +126,26 @@ func (suite *TestSuite) TestTwapRecord_GeometricTwap_MarshalUnmarshal() {
 	suite.Require().False(originalRecord.GeometricTwapAccumulator.IsNil())
 	suite.Require().Equal(sdk.ZeroDec(), originalRecord.GeometricTwapAccumulator)
 }
+
+// TestTwapRecord_HarmonicTwap_MarshalUnmarshal this test proves that migrations
+// to initialize harmonic twap accumulators are not required, for the same reason
+// as for the geometric twap accumulators.
+func (suite *TestSuite) TestTwapRecord_HarmonicTwap_MarshalUnmarshal() {
+	originalRecord := types.TwapRecord{
+		Asset0Denom: "uatom",
+		Asset1Denom: "uusd",
+	}
+
+	suite.Require().True(originalRecord.HarmonicTwapAccumulator.IsNil())
+
+	bz, err := proto.Marshal(&originalRecord)
+	suite.Require().NoError(err)
+
+	var deserialized types.TwapRecord
+	err = proto.Unmarshal(bz, &deserialized)
+	suite.Require().NoError(err)
+
+	suite.Require().False(deserialized.HarmonicTwapAccumulator.IsNil())
+	suite.Require().Equal(sdk.ZeroDec(), deserialized.HarmonicTwapAccumulator)
+	suite.Require().Equal(originalRecord.String(), deserialized.String())
+}
@@ -3,6 +3,7 @@ package twap
 import (
 	"encoding/binary"
 	"fmt"
+	"sort"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -73,7 +74,11 @@ func (k Keeper) storeHistoricalTWAP(ctx sdk.Context, twap types.TwapRecord) {
 // So, in order to have correct behavior for the desired guarantee,
 // we keep the newest record that is older than the pruning time.
 // This is why we would keep the -50 hour and -1hour twaps despite a 48hr pruning period
-func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time) error {
+//
+// Pools in poolLastKeptTimes are pruned before their own last kept time instead of lastKeptTime.
+// Pools whose last kept time is after lastKeptTime are pruned separately over their own pool indexed records,
+// so that a short keep period override does not make every prune iterate over the records of every pool.
+func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time, poolLastKeptTimes map[uint64]time.Time) error {
 	store := ctx.KVStore(k.storeKey)
 
 	// Reverse iterator guarantees that we iterate through the newest per pool first.
@@ -99,6 +104,15 @@ func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime ti
 			return err
 		}
 
+		poolLastKeptTime, ok := poolLastKeptTimes[twapToRemove.PoolId]
+		if ok && poolLastKeptTime.After(lastKeptTime) {
+			// Pruned over the pool's own records below.
+			continue
+		}
+		if ok && !twapToRemove.Time.Before(poolLastKeptTime) {
+			continue
+		}
+
 		poolKey := uniqueTriplet{
 			poolId: twapToRemove.PoolId,
 			asset0: twapToRemove.Asset0Denom,
@@ -112,6 +126,48 @@ func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime ti
 
 		k.deleteHistoricalRecord(ctx, twapToRemove)
 	}
+
+	// Pools are pruned in order of pool id, as map iteration is non-deterministic.
+	shortKeepPeriodPoolIds := make([]uint64, 0, len(poolLastKeptTimes))
+	for poolId, poolLastKeptTime := range poolLastKeptTimes {
+		if poolLastKeptTime.After(lastKeptTime) {
+			shortKeepPeriodPoolIds = append(shortKeepPeriodPoolIds, poolId)
+		}
+	}
+	sort.Slice(shortKeepPeriodPoolIds, func(i, j int) bool { return shortKeepPeriodPoolIds[i] < shortKeepPeriodPoolIds[j] })
+	for _, poolId := range shortKeepPeriodPoolIds {
+		if err := k.prunePoolRecordsBeforeTimeButNewest(ctx, poolId, poolLastKeptTimes[poolId]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prunePoolRecordsBeforeTimeButNewest prunes the records of the given pool before the given time but the newest,
+// for each of its (asset 0, asset 1) pairs. It only iterates over the records of the pool before the given time.
+func (k Keeper) prunePoolRecordsBeforeTimeButNewest(ctx sdk.Context, poolId uint64, lastKeptTime time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+
+	// Every pair with records has a most recent record.
+	mostRecentRecords, err := types.GetAllMostRecentTwapsForPool(store, poolId)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range mostRecentRecords {
+		records, err := osmoutils.GatherValuesFromStore(store,
+			types.FormatHistoricalPoolIndexTimePrefix(poolId, record.Asset0Denom, record.Asset1Denom),
+			types.FormatHistoricalPoolIndexTWAPKey(poolId, record.Asset0Denom, record.Asset1Denom, lastKeptTime),
+			types.ParseTwapFromBz)
+		if err != nil {
+			return err
+		}
+
+		// Keep the newest record before lastKeptTime.
+		for i := 0; i < len(records)-1; i++ {
+			k.deleteHistoricalRecord(ctx, records[i])
+		}
+	}
 	return nil
 }
 
@@ -211,3 +267,118 @@ func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.T
 
 	return twap, nil
 }
+
+// getHistoricalRecordsBetweenTimes returns all historical records in state for (id, asset0, asset1)
+// with a time t' such that startTime < t' < endTime, ordered by time.
+func (k Keeper) getHistoricalRecordsBetweenTimes(ctx sdk.Context, poolId uint64, startTime time.Time, endTime time.Time, asset0Denom string, asset1Denom string) ([]types.TwapRecord, error) {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(asset0Denom, asset1Denom)
+	if err != nil {
+		return nil, err
+	}
+	if !startTime.Before(endTime) {
+		return []types.TwapRecord{}, nil
+	}
+	store := ctx.KVStore(k.storeKey)
+	// The start key excludes the record at startTime, the end key is exclusive.
+	startKey := types.FormatHistoricalPoolIndexTimeSuffix(poolId, asset0Denom, asset1Denom, startTime)
+	endKey := types.FormatHistoricalPoolIndexTWAPKey(poolId, asset0Denom, asset1Denom, endTime)
+	return osmoutils.GatherValuesFromStore(store, startKey, endKey, types.ParseTwapFromBz)
+}
+
+// storeCheckpoint writes a twap record to the checkpoint store.
+func (k Keeper) storeCheckpoint(ctx sdk.Context, twap types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FormatCheckpointTWAPKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom, twap.Time)
+	osmoutils.MustSet(store, key, &twap)
+}
+
+// getAllCheckpoints returns all twap checkpoint records.
+func (k Keeper) getAllCheckpoints(ctx sdk.Context) ([]types.TwapRecord, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), []byte(types.CheckpointTWAPPoolIndexPrefix), types.ParseTwapFromBz)
+}
+
+// getCheckpointAtOrBeforeTime on a given input (id, t, asset0, asset1)
+// returns the checkpoint from state for (id, t', asset0, asset1),
+// where t' is the latest checkpoint time such that t' <= t.
+//
+// This returns a timeTooOldError if there is no checkpoint in state at or before t.
+func (k Keeper) getCheckpointAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.Time, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(asset0Denom, asset1Denom)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	startKey := types.FormatCheckpointTimePrefix(poolId, asset0Denom, asset1Denom)
+	endKey := types.FormatCheckpointTimeSuffix(poolId, asset0Denom, asset1Denom, t)
+	reverseIterate := true
+
+	twap, err := osmoutils.GetFirstValueInRange(store, startKey, endKey, reverseIterate, types.ParseTwapFromBz)
+	if err != nil {
+		return types.TwapRecord{}, timeTooOldError{Time: t}
+	}
+	return twap, nil
+}
+
+// pruneCheckpointsBeforeTimeButNewest prunes all checkpoints for each (pool id, asset 0, asset 1) triplet
+// before the given time but the newest, for the same reasons as pruneRecordsBeforeTimeButNewest.
+func (k Keeper) pruneCheckpointsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+
+	// Every triplet with checkpoints has a most recent record.
+	mostRecentRecords, err := types.GetAllMostRecentTwaps(store)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range mostRecentRecords {
+		checkpoints, err := osmoutils.GatherValuesFromStore(store,
+			types.FormatCheckpointTimePrefix(record.PoolId, record.Asset0Denom, record.Asset1Denom),
+			types.FormatCheckpointTWAPKey(record.PoolId, record.Asset0Denom, record.Asset1Denom, lastKeptTime),
+			types.ParseTwapFromBz)
+		if err != nil {
+			return err
+		}
+
+		// Keep the newest checkpoint before lastKeptTime.
+		for i := 0; i < len(checkpoints)-1; i++ {
+			store.Delete(types.FormatCheckpointTWAPKey(checkpoints[i].PoolId, checkpoints[i].Asset0Denom, checkpoints[i].Asset1Denom, checkpoints[i].Time))
+		}
+	}
+	return nil
+}
+
+// GetPoolRecordHistoryKeepPeriod returns the record history keep period of the given pool.
+// This is the pool's override if one is set, and the record history keep period param otherwise.
+func (k Keeper) GetPoolRecordHistoryKeepPeriod(ctx sdk.Context, poolId uint64) time.Duration {
+	store := ctx.KVStore(k.storeKey)
+	record := types.PoolRecordHistoryKeepPeriod{}
+	found, err := osmoutils.Get(store, types.FormatPoolRecordHistoryKeepPeriodKey(poolId), &record)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return k.RecordHistoryKeepPeriod(ctx)
+	}
+	return record.RecordHistoryKeepPeriod
+}
+
+// SetPoolRecordHistoryKeepPeriod sets the record history keep period override of the given pool.
+// A zero keep period removes the override, so that the pool uses the record history keep period param.
+func (k Keeper) SetPoolRecordHistoryKeepPeriod(ctx sdk.Context, poolId uint64, keepPeriod time.Duration) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FormatPoolRecordHistoryKeepPeriodKey(poolId)
+	if keepPeriod == 0 {
+		store.Delete(key)
+		return
+	}
+	osmoutils.MustSet(store, key, &types.PoolRecordHistoryKeepPeriod{PoolId: poolId, RecordHistoryKeepPeriod: keepPeriod})
+}
+
+// GetAllPoolRecordHistoryKeepPeriods returns all record history keep period overrides, ordered by pool id.
+func (k Keeper) GetAllPoolRecordHistoryKeepPeriods(ctx sdk.Context) []types.PoolRecordHistoryKeepPeriod {
+	records, err := osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), []byte(types.PoolRecordHistoryKeepPeriodPrefix), types.ParsePoolRecordHistoryKeepPeriodFromBz)
+	if err != nil {
+		panic(err)
+	}
+	return records
+}
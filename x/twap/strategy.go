@@ -10,7 +10,7 @@ import (
 )
 
 // twapStrategy is an interface for computing TWAPs.
-// We have two strategies implementing the interface - arithmetic and geometric.
+// We have three strategies implementing the interface - arithmetic, geometric and harmonic.
 // We expose a common TWAP API to reduce duplication and avoid complexity.
 type twapStrategy interface {
 	// computeTwap calculates the TWAP with specific startRecord and endRecord.
@@ -25,6 +25,10 @@ type geometric struct {
 	TwapKeeper Keeper
 }
 
+type harmonic struct {
+	TwapKeeper Keeper
+}
+
 // computeTwap computes and returns an arithmetic TWAP between
 // two records given the quote asset.
 func (s *arithmetic) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
@@ -72,3 +76,116 @@ func (s *geometric) computeTwap(startRecord types.TwapRecord, endRecord types.Tw
 	// by the underlying spot price function.
 	return osmomath.SigFigRound(result.SDKDec(), gammtypes.SpotPriceSigFigs)
 }
+
+// computeTwap computes and returns a harmonic TWAP between
+// two records given the quote asset.
+// The harmonic mean is the time delta divided by the accumulated inverse spot prices.
+func (s *harmonic) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
+	var accumDiff sdk.Dec
+	if quoteAsset == startRecord.Asset0Denom {
+		accumDiff = endRecord.HarmonicTwapAccumulator.Sub(startRecord.HarmonicTwapAccumulator)
+	} else {
+		// The inverse of the asset 1 spot price is the asset 0 spot price.
+		// As a result, the arithmetic accumulator of asset 0 is the harmonic accumulator of asset 1.
+		accumDiff = endRecord.P0ArithmeticTwapAccumulator.Sub(startRecord.P0ArithmeticTwapAccumulator)
+	}
+
+	if accumDiff.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	timeDelta := types.CanonicalTimeMs(endRecord.Time) - types.CanonicalTimeMs(startRecord.Time)
+	return sdk.NewDec(timeDelta).Quo(accumDiff)
+}
+
+// ln2 is the natural logarithm of 2, used to convert log base 2 values
+// tracked by the geometric accumulator into natural logarithms.
+var ln2 = sdk.MustNewDecFromStr("0.693147180559945309")
+
+// twapRecordsStrategy is an interface for computing statistics over every record
+// observed within a time window, as opposed to twapStrategy that only relies on
+// the records at the window boundaries.
+// We have three strategies implementing the interface - volatility, min spot price and max spot price.
+type twapRecordsStrategy interface {
+	// computeOverRecords calculates the statistic over the given records.
+	// The records must be ordered by time, with the first and last records
+	// being the records interpolated to the window start and end times.
+	computeOverRecords(records []types.TwapRecord, quoteAsset string) (sdk.Dec, error)
+}
+
+type volatility struct {
+	TwapKeeper Keeper
+}
+
+type minSpotPrice struct {
+	TwapKeeper Keeper
+}
+
+type maxSpotPrice struct {
+	TwapKeeper Keeper
+}
+
+// computeOverRecords computes and returns the realized volatility over the records,
+// defined as the time weighted standard deviation of the natural logarithm of the spot price.
+// The mean log price between every two consecutive records is derived from the geometric
+// accumulator difference, so each price is weighted by the time it was in effect.
+// Since ln(1/p) = -ln(p), the result does not depend on the quote asset.
+func (s *volatility) computeOverRecords(records []types.TwapRecord, quoteAsset string) (sdk.Dec, error) {
+	startRecord, endRecord := records[0], records[len(records)-1]
+	totalTimeDelta := types.CanonicalTimeMs(endRecord.Time) - types.CanonicalTimeMs(startRecord.Time)
+	if totalTimeDelta == 0 {
+		return sdk.ZeroDec(), nil
+	}
+
+	totalAccumDiff := endRecord.GeometricTwapAccumulator.Sub(startRecord.GeometricTwapAccumulator)
+	meanLogPrice := types.AccumDiffDivDuration(totalAccumDiff, totalTimeDelta)
+
+	// weightedSquaredDeviations = sum over intervals of timeDelta * (log_{2}{P_0} - mean)^2
+	weightedSquaredDeviations := sdk.ZeroDec()
+	for i := 1; i < len(records); i++ {
+		timeDelta := types.CanonicalTimeMs(records[i].Time) - types.CanonicalTimeMs(records[i-1].Time)
+		if timeDelta == 0 {
+			continue
+		}
+		accumDiff := records[i].GeometricTwapAccumulator.Sub(records[i-1].GeometricTwapAccumulator)
+		deviation := types.AccumDiffDivDuration(accumDiff, timeDelta).Sub(meanLogPrice)
+		weightedSquaredDeviations = weightedSquaredDeviations.Add(types.SpotPriceMulDuration(deviation.Mul(deviation), timeDelta))
+	}
+
+	variance := types.AccumDiffDivDuration(weightedSquaredDeviations, totalTimeDelta)
+	stdDev, err := variance.ApproxSqrt()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// convert the standard deviation of log_{2}{P_0} into that of ln{P_0}.
+	return stdDev.Mul(ln2), nil
+}
+
+// computeOverRecords computes and returns the minimum spot price
+// observed across the records given the quote asset.
+func (s *minSpotPrice) computeOverRecords(records []types.TwapRecord, quoteAsset string) (sdk.Dec, error) {
+	minPrice := lastSpotPrice(records[0], quoteAsset)
+	for _, record := range records[1:] {
+		minPrice = sdk.MinDec(minPrice, lastSpotPrice(record, quoteAsset))
+	}
+	return minPrice, nil
+}
+
+// computeOverRecords computes and returns the maximum spot price
+// observed across the records given the quote asset.
+func (s *maxSpotPrice) computeOverRecords(records []types.TwapRecord, quoteAsset string) (sdk.Dec, error) {
+	maxPrice := lastSpotPrice(records[0], quoteAsset)
+	for _, record := range records[1:] {
+		maxPrice = sdk.MaxDec(maxPrice, lastSpotPrice(record, quoteAsset))
+	}
+	return maxPrice, nil
+}
+
+// lastSpotPrice returns the last spot price of the record given the quote asset.
+func lastSpotPrice(record types.TwapRecord, quoteAsset string) sdk.Dec {
+	if quoteAsset == record.Asset0Denom {
+		return record.P0LastSpotPrice
+	}
+	return record.P1LastSpotPrice
+}
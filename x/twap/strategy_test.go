@@ -35,6 +35,10 @@ func (s *TestSuite) TestComputeTwap() {
 		TwapKeeper: *s.App.TwapKeeper,
 	}
 
+	harmonicStrategy := &twap.HarmonicTwapStrategy{
+		TwapKeeper: *s.App.TwapKeeper,
+	}
+
 	tests := map[string]computeTwapTestCase{
 		"arithmetic only, basic: spot price = 1 for one second, 0 init accumulator": {
 			startRecord: newOneSidedRecord(baseTime, sdk.ZeroDec(), true),
@@ -63,6 +67,7 @@ func (s *TestSuite) TestComputeTwap() {
 			twapStrategies: []twap.TwapStrategy{
 				arithStrategy,
 				geomStrategy,
+				harmonicStrategy,
 			},
 			expTwap: sdk.ZeroDec(),
 		},
@@ -73,6 +78,7 @@ func (s *TestSuite) TestComputeTwap() {
 			twapStrategies: []twap.TwapStrategy{
 				arithStrategy,
 				geomStrategy,
+				harmonicStrategy,
 			},
 			expTwap: sdk.OneDec(),
 		},
@@ -311,6 +317,67 @@ func (s *TestSuite) TestComputeGeometricStrategyTwap() {
 	}
 }
 
+// TestComputeHarmonicStrategyTwap tests harmonic strategy's computeTwap
+func (s *TestSuite) TestComputeHarmonicStrategyTwap() {
+	// sp0 = 2 for 10s, then sp0 = 8 for 10s.
+	p0ArithmeticAccumDiff := OneSec.MulInt64(2*10 + 8*10)
+	harmonicAccumDiff := OneSec.MulInt64(10).QuoInt64(2).Add(OneSec.MulInt64(10).QuoInt64(8))
+
+	tests := map[string]computeTwapTestCase{
+		"spot price = 10 for one second, 0 init accumulators": {
+			startRecord: newHarmonicRecord(baseTime, sdk.ZeroDec(), sdk.ZeroDec()),
+			endRecord:   newHarmonicRecord(tPlusOne, tenSecAccum, OneSec.QuoInt64(10)),
+			quoteAsset:  denom0,
+			expTwap:     sdk.NewDec(10),
+		},
+		"spot price = 10 for one second, 0 init accumulators (asset 1)": {
+			startRecord: newHarmonicRecord(baseTime, sdk.ZeroDec(), sdk.ZeroDec()),
+			endRecord:   newHarmonicRecord(tPlusOne, tenSecAccum, OneSec.QuoInt64(10)),
+			quoteAsset:  denom1,
+			expTwap:     sdk.NewDecWithPrec(1, 1),
+		},
+		// 20s / (10s / 2 + 10s / 8) = 3.2
+		"spot price = 2 then 8 for ten seconds each": {
+			startRecord: newHarmonicRecord(baseTime, sdk.ZeroDec(), sdk.ZeroDec()),
+			endRecord:   newHarmonicRecord(baseTime.Add(20*time.Second), p0ArithmeticAccumDiff, harmonicAccumDiff),
+			quoteAsset:  denom0,
+			expTwap:     sdk.NewDecWithPrec(32, 1),
+		},
+		// 20s / (10s * 2 + 10s * 8) = 0.2
+		"spot price = 2 then 8 for ten seconds each (asset 1)": {
+			startRecord: newHarmonicRecord(baseTime, sdk.ZeroDec(), sdk.ZeroDec()),
+			endRecord:   newHarmonicRecord(baseTime.Add(20*time.Second), p0ArithmeticAccumDiff, harmonicAccumDiff),
+			quoteAsset:  denom1,
+			expTwap:     sdk.NewDecWithPrec(2, 1),
+		},
+		"base accumulators have no impact": {
+			startRecord: newHarmonicRecord(baseTime, tenSecAccum, tenSecAccum),
+			endRecord:   newHarmonicRecord(baseTime.Add(20*time.Second), tenSecAccum.Add(p0ArithmeticAccumDiff), tenSecAccum.Add(harmonicAccumDiff)),
+			quoteAsset:  denom0,
+			expTwap:     sdk.NewDecWithPrec(32, 1),
+		},
+		"zero accumulator difference": {
+			startRecord: newHarmonicRecord(baseTime, sdk.ZeroDec(), sdk.ZeroDec()),
+			endRecord:   newHarmonicRecord(tPlusOne, sdk.ZeroDec(), sdk.ZeroDec()),
+			quoteAsset:  denom0,
+			expTwap:     sdk.ZeroDec(),
+		},
+		"start record time with nanoseconds does not change result": {
+			startRecord: newHarmonicRecord(baseTime.Add(oneHundredNanoseconds), sdk.ZeroDec(), sdk.ZeroDec()),
+			endRecord:   newHarmonicRecord(tPlusOne, tenSecAccum, OneSec.QuoInt64(10)),
+			quoteAsset:  denom0,
+			expTwap:     sdk.NewDec(10),
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			harmonicStrategy := &twap.HarmonicTwapStrategy{TwapKeeper: *s.App.TwapKeeper}
+			actualTwap := harmonicStrategy.ComputeTwap(test.startRecord, test.endRecord, test.quoteAsset)
+			s.Require().Equal(test.expTwap, actualTwap)
+		})
+	}
+}
+
 func (s *TestSuite) TestComputeArithmeticStrategyTwap_ThreeAsset() {
 	tenSecAccum := OneSec.MulInt64(10)
 	pointOneAccum := OneSec.QuoInt64(10)
@@ -378,3 +445,89 @@ func (s *TestSuite) TestComputeGeometricStrategyTwap_ThreeAsset() {
 		})
 	}
 }
+
+// TestComputeOverRecords tests the volatility, min and max spot price strategies
+// on records with spot prices that are powers of 2, so that the logarithms are exact.
+func (s *TestSuite) TestComputeOverRecords() {
+	volatilityStrategy := &twap.VolatilityStrategy{TwapKeeper: *s.App.TwapKeeper}
+	minStrategy := &twap.MinSpotPriceStrategy{TwapKeeper: *s.App.TwapKeeper}
+	maxStrategy := &twap.MaxSpotPriceStrategy{TwapKeeper: *s.App.TwapKeeper}
+
+	// sp0 = 2 for 10s, then sp0 = 8 for 10s, ending with sp0 = 4.
+	// log_{2}{sp0} is 1 and 3 over the two intervals, so the mean is 2 and the variance is 1.
+	varyingPriceRecords := []types.TwapRecord{
+		newTwoAssetPoolTwapRecordWithDefaults(baseTime, sdk.NewDec(2), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(10*time.Second), sdk.NewDec(8), sdk.ZeroDec(), sdk.ZeroDec(), OneSec.MulInt64(10)),
+		newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(20*time.Second), sdk.NewDec(4), sdk.ZeroDec(), sdk.ZeroDec(), OneSec.MulInt64(10+3*10)),
+	}
+	// sp0 = 4 for 20s.
+	constantPriceRecords := []types.TwapRecord{
+		newTwoAssetPoolTwapRecordWithDefaults(baseTime, sdk.NewDec(4), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(10*time.Second), sdk.NewDec(4), sdk.ZeroDec(), sdk.ZeroDec(), OneSec.MulInt64(2*10)),
+		newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(20*time.Second), sdk.NewDec(4), sdk.ZeroDec(), sdk.ZeroDec(), OneSec.MulInt64(2*20)),
+	}
+	sameTimeRecords := []types.TwapRecord{varyingPriceRecords[1], varyingPriceRecords[1]}
+
+	tests := map[string]struct {
+		records    []types.TwapRecord
+		quoteAsset string
+		strategy   twap.TwapRecordsStrategy
+		expResult  sdk.Dec
+	}{
+		"volatility: varying price": {
+			records:    varyingPriceRecords,
+			quoteAsset: denom0,
+			strategy:   volatilityStrategy,
+			expResult:  sdk.MustNewDecFromStr("0.693147180559945309"),
+		},
+		"volatility: varying price, quote asset does not change the result": {
+			records:    varyingPriceRecords,
+			quoteAsset: denom1,
+			strategy:   volatilityStrategy,
+			expResult:  sdk.MustNewDecFromStr("0.693147180559945309"),
+		},
+		"volatility: constant price": {
+			records:    constantPriceRecords,
+			quoteAsset: denom0,
+			strategy:   volatilityStrategy,
+			expResult:  sdk.ZeroDec(),
+		},
+		"volatility: same time records": {
+			records:    sameTimeRecords,
+			quoteAsset: denom0,
+			strategy:   volatilityStrategy,
+			expResult:  sdk.ZeroDec(),
+		},
+		"min spot price: asset 0": {
+			records:    varyingPriceRecords,
+			quoteAsset: denom0,
+			strategy:   minStrategy,
+			expResult:  sdk.NewDec(2),
+		},
+		"min spot price: asset 1": {
+			records:    varyingPriceRecords,
+			quoteAsset: denom1,
+			strategy:   minStrategy,
+			expResult:  sdk.OneDec().QuoInt64(8),
+		},
+		"max spot price: asset 0": {
+			records:    varyingPriceRecords,
+			quoteAsset: denom0,
+			strategy:   maxStrategy,
+			expResult:  sdk.NewDec(8),
+		},
+		"max spot price: asset 1": {
+			records:    varyingPriceRecords,
+			quoteAsset: denom1,
+			strategy:   maxStrategy,
+			expResult:  sdk.OneDec().QuoInt64(2),
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			actualResult, err := twap.ComputeOverRecords(test.records, test.quoteAsset, test.strategy)
+			s.Require().NoError(err)
+			osmoassert.DecApproxEq(s.T(), test.expResult, actualResult, osmomath.GetPowPrecision())
+		})
+	}
+}
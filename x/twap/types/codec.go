@@ -0,0 +1,13 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&SetPoolRecordHistoryKeepPeriodsProposal{},
+	)
+}
@@ -62,3 +62,18 @@ type InvalidRecordCountError struct {
 func (e InvalidRecordCountError) Error() string {
 	return fmt.Sprintf("The number of records do not match, expected: %d\n got: %d", e.Expected, e.Actual)
 }
+
+type EmptyTwapRouteError struct{}
+
+func (e EmptyTwapRouteError) Error() string {
+	return "multihop twap routes cannot be empty"
+}
+
+type MaxTwapRoutesExceededError struct {
+	Routes int
+	Limit  int
+}
+
+func (e MaxTwapRoutesExceededError) Error() string {
+	return fmt.Sprintf("multihop twap routes (%d) exceed the limit (%d)", e.Routes, e.Limit)
+}
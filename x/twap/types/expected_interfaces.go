@@ -1,9 +1,14 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
 
 // AmmInterface is the functionality needed from a given pool ID, in order to maintain records and serve TWAPs.
 type PoolManagerInterface interface {
+	RoutePool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error)
 	RouteGetPoolDenoms(ctx sdk.Context, poolId uint64) (denoms []string, err error)
 	// CalculateSpotPrice returns the spot price of the quote asset in terms of the base asset,
 	// using the specified pool.
@@ -29,6 +29,41 @@ func (g *GenesisState) Validate() error {
 			return err
 		}
 	}
+
+	if err := validatePoolRecordHistoryKeepPeriods(g.PoolRecordHistoryKeepPeriods); err != nil {
+		return err
+	}
+	for _, record := range g.PoolRecordHistoryKeepPeriods {
+		if record.RecordHistoryKeepPeriod == 0 {
+			return fmt.Errorf("pool %d record history keep period must be positive", record.PoolId)
+		}
+	}
+
+	for _, checkpoint := range g.Checkpoints {
+		if err := checkpoint.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePoolRecordHistoryKeepPeriods validates that the records have
+// unique non-zero pool ids and non-negative record history keep periods.
+func validatePoolRecordHistoryKeepPeriods(records []PoolRecordHistoryKeepPeriod) error {
+	seenPoolIds := make(map[uint64]struct{}, len(records))
+	for _, record := range records {
+		if record.PoolId == 0 {
+			return errors.New("pool id cannot be 0")
+		}
+		if _, ok := seenPoolIds[record.PoolId]; ok {
+			return fmt.Errorf("duplicate record history keep period for pool %d", record.PoolId)
+		}
+		seenPoolIds[record.PoolId] = struct{}{}
+
+		if record.RecordHistoryKeepPeriod < 0 {
+			return fmt.Errorf("pool %d record history keep period cannot be negative, was (%s)", record.PoolId, record.RecordHistoryKeepPeriod)
+		}
+	}
 	return nil
 }
 
@@ -85,5 +120,13 @@ func (t TwapRecord) validate() error {
 	if t.GeometricTwapAccumulator.IsNil() {
 		return fmt.Errorf("twap record geometric accumulator cannot be nil, was (%s)", t.GeometricTwapAccumulator)
 	}
+
+	if t.HarmonicTwapAccumulator.IsNil() {
+		return fmt.Errorf("twap record harmonic accumulator cannot be nil")
+	}
+
+	if t.HarmonicTwapAccumulator.IsNegative() {
+		return fmt.Errorf("twap record harmonic accumulator cannot be negative, was (%s)", t.HarmonicTwapAccumulator)
+	}
 	return nil
 }
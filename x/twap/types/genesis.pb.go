@@ -33,6 +33,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	PruneEpochIdentifier    string        `protobuf:"bytes,1,opt,name=prune_epoch_identifier,json=pruneEpochIdentifier,proto3" json:"prune_epoch_identifier,omitempty"`
 	RecordHistoryKeepPeriod time.Duration `protobuf:"bytes,2,opt,name=record_history_keep_period,json=recordHistoryKeepPeriod,proto3,stdduration" json:"record_history_keep_period" yaml:"record_history_keep_period"`
+	// checkpoint_interval is the interval at which twap records are
+	// additionally kept as checkpoints. The last record of every interval
+	// becomes a checkpoint.
+	CheckpointInterval time.Duration `protobuf:"bytes,3,opt,name=checkpoint_interval,json=checkpointInterval,proto3,stdduration" json:"checkpoint_interval" yaml:"checkpoint_interval"`
+	// checkpoint_keep_period is how long checkpoints are kept for.
+	CheckpointKeepPeriod time.Duration `protobuf:"bytes,4,opt,name=checkpoint_keep_period,json=checkpointKeepPeriod,proto3,stdduration" json:"checkpoint_keep_period" yaml:"checkpoint_keep_period"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -82,19 +88,92 @@ func (m *Params) GetRecordHistoryKeepPeriod() time.Duration {
 	return 0
 }
 
+func (m *Params) GetCheckpointInterval() time.Duration {
+	if m != nil {
+		return m.CheckpointInterval
+	}
+	return 0
+}
+
+func (m *Params) GetCheckpointKeepPeriod() time.Duration {
+	if m != nil {
+		return m.CheckpointKeepPeriod
+	}
+	return 0
+}
+
+// PoolRecordHistoryKeepPeriod overrides the record history keep period
+// param for a single pool.
+type PoolRecordHistoryKeepPeriod struct {
+	PoolId                  uint64        `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	RecordHistoryKeepPeriod time.Duration `protobuf:"bytes,2,opt,name=record_history_keep_period,json=recordHistoryKeepPeriod,proto3,stdduration" json:"record_history_keep_period" yaml:"record_history_keep_period"`
+}
+
+func (m *PoolRecordHistoryKeepPeriod) Reset()         { *m = PoolRecordHistoryKeepPeriod{} }
+func (m *PoolRecordHistoryKeepPeriod) String() string { return proto.CompactTextString(m) }
+func (*PoolRecordHistoryKeepPeriod) ProtoMessage()    {}
+func (*PoolRecordHistoryKeepPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolRecordHistoryKeepPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRecordHistoryKeepPeriod.Merge(m, src)
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRecordHistoryKeepPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolRecordHistoryKeepPeriod proto.InternalMessageInfo
+
+func (m *PoolRecordHistoryKeepPeriod) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolRecordHistoryKeepPeriod) GetRecordHistoryKeepPeriod() time.Duration {
+	if m != nil {
+		return m.RecordHistoryKeepPeriod
+	}
+	return 0
+}
+
 // GenesisState defines the twap module's genesis state.
 type GenesisState struct {
 	// twaps is the collection of all twap records.
 	Twaps []TwapRecord `protobuf:"bytes,1,rep,name=twaps,proto3" json:"twaps"`
 	// params is the container of twap parameters.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// pool_record_history_keep_periods are the per-pool overrides of the
+	// record history keep period.
+	PoolRecordHistoryKeepPeriods []PoolRecordHistoryKeepPeriod `protobuf:"bytes,3,rep,name=pool_record_history_keep_periods,json=poolRecordHistoryKeepPeriods,proto3" json:"pool_record_history_keep_periods"`
+	// checkpoints is the collection of all twap checkpoint records.
+	Checkpoints []TwapRecord `protobuf:"bytes,4,rep,name=checkpoints,proto3" json:"checkpoints"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+	return fileDescriptor_3f4bdf49b69bd63c, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,8 +216,23 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPoolRecordHistoryKeepPeriods() []PoolRecordHistoryKeepPeriod {
+	if m != nil {
+		return m.PoolRecordHistoryKeepPeriods
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCheckpoints() []TwapRecord {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.twap.v1beta1.Params")
+	proto.RegisterType((*PoolRecordHistoryKeepPeriod)(nil), "osmosis.twap.v1beta1.PoolRecordHistoryKeepPeriod")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.twap.v1beta1.GenesisState")
 }
 
@@ -147,34 +241,71 @@ func init() {
 }
 
 var fileDescriptor_3f4bdf49b69bd63c = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbd, 0xee, 0xd3, 0x30,
-	0x14, 0xc5, 0x63, 0x3e, 0x2a, 0x91, 0x3f, 0x53, 0x54, 0x41, 0x5b, 0xa1, 0x34, 0x64, 0x40, 0x5d,
-	0x6a, 0xd3, 0x02, 0x4b, 0xc5, 0x54, 0x81, 0xf8, 0x5a, 0xaa, 0xc0, 0xc4, 0x12, 0x39, 0xc9, 0x6d,
-	0x6a, 0xd1, 0xc4, 0x96, 0xed, 0xb4, 0xe4, 0x01, 0x90, 0x18, 0x19, 0x79, 0x22, 0xd4, 0xb1, 0x23,
-	0x53, 0x41, 0xed, 0x1b, 0xf0, 0x04, 0x28, 0xb1, 0xcb, 0x80, 0xfa, 0xdf, 0x72, 0xf5, 0x3b, 0xe7,
-	0xe8, 0xe4, 0x5e, 0xbb, 0x21, 0x57, 0x05, 0x57, 0x4c, 0x11, 0xbd, 0xa5, 0x82, 0x6c, 0x26, 0x09,
-	0x68, 0x3a, 0x21, 0x39, 0x94, 0xa0, 0x98, 0xc2, 0x42, 0x72, 0xcd, 0xbd, 0xae, 0xd5, 0xe0, 0x46,
-	0x83, 0xad, 0x66, 0xd0, 0xcd, 0x79, 0xce, 0x5b, 0x01, 0x69, 0xbe, 0x8c, 0x76, 0xf0, 0xe8, 0x62,
-	0x5e, 0x33, 0xc4, 0x12, 0x52, 0x2e, 0x33, 0xab, 0xeb, 0xe7, 0x9c, 0xe7, 0x6b, 0x20, 0xed, 0x94,
-	0x54, 0x4b, 0x42, 0xcb, 0xfa, 0x8c, 0xd2, 0x36, 0x23, 0x36, 0xd9, 0x66, 0xb0, 0xc8, 0xff, 0xdf,
-	0x95, 0x55, 0x92, 0x6a, 0xc6, 0x4b, 0xc3, 0xc3, 0x1f, 0xc8, 0xed, 0x2c, 0xa8, 0xa4, 0x85, 0xf2,
-	0x9e, 0xba, 0xf7, 0x84, 0xac, 0x4a, 0x88, 0x41, 0xf0, 0x74, 0x15, 0xb3, 0x0c, 0x4a, 0xcd, 0x96,
-	0x0c, 0x64, 0x0f, 0x05, 0x68, 0x74, 0x27, 0xea, 0xb6, 0xf4, 0x65, 0x03, 0xdf, 0xfc, 0x63, 0xde,
-	0x17, 0xe4, 0x0e, 0x4c, 0xcf, 0x78, 0xc5, 0x94, 0xe6, 0xb2, 0x8e, 0x3f, 0x01, 0x88, 0x58, 0x80,
-	0x64, 0x3c, 0xeb, 0xdd, 0x08, 0xd0, 0xe8, 0x6a, 0xda, 0xc7, 0xa6, 0x06, 0x3e, 0xd7, 0xc0, 0x2f,
-	0x6c, 0x8d, 0xf9, 0x78, 0x77, 0x18, 0x3a, 0x7f, 0x0e, 0xc3, 0x87, 0x35, 0x2d, 0xd6, 0xb3, 0xf0,
-	0xfa, 0xa8, 0xf0, 0xfb, 0xaf, 0x21, 0x8a, 0xee, 0x1b, 0xc1, 0x6b, 0xc3, 0xdf, 0x01, 0x88, 0x85,
-	0xa1, 0x5f, 0x91, 0x7b, 0xf7, 0x95, 0x39, 0xc2, 0x7b, 0x4d, 0x35, 0x78, 0xcf, 0xdd, 0xdb, 0xcd,
-	0x12, 0x55, 0x0f, 0x05, 0x37, 0x47, 0x57, 0xd3, 0x00, 0x5f, 0xba, 0x09, 0xfe, 0xb0, 0xa5, 0x22,
-	0x6a, 0x23, 0xe7, 0xb7, 0x9a, 0x26, 0x91, 0x31, 0x79, 0x33, 0xb7, 0x23, 0xda, 0xb5, 0xd8, 0x3f,
-	0x78, 0x70, 0xd9, 0x6e, 0x56, 0x67, 0xad, 0xd6, 0x31, 0x7f, 0xbb, 0x3b, 0xfa, 0x68, 0x7f, 0xf4,
-	0xd1, 0xef, 0xa3, 0x8f, 0xbe, 0x9d, 0x7c, 0x67, 0x7f, 0xf2, 0x9d, 0x9f, 0x27, 0xdf, 0xf9, 0xf8,
-	0x38, 0x67, 0x7a, 0x55, 0x25, 0x38, 0xe5, 0x05, 0xb1, 0x79, 0xe3, 0x35, 0x4d, 0xd4, 0x79, 0x20,
-	0x9b, 0xc9, 0x33, 0xf2, 0xd9, 0xbc, 0x04, 0x5d, 0x0b, 0x50, 0x49, 0xa7, 0xdd, 0xd8, 0x93, 0xbf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x74, 0xe0, 0x28, 0x50, 0x76, 0x02, 0x00, 0x00,
+	// 548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x35, 0x21, 0x88, 0x0b, 0x62, 0x38, 0xa2, 0xe2, 0x86, 0xe2, 0x04, 0x0f, 0x55, 0x10,
+	0xaa, 0x4d, 0x0a, 0x2c, 0x11, 0x53, 0x04, 0xa2, 0x81, 0x25, 0x0a, 0x4c, 0x2c, 0xd6, 0xc5, 0xbe,
+	0x3a, 0xa7, 0x3a, 0xbe, 0xd3, 0xdd, 0x25, 0x25, 0x2c, 0x4c, 0xec, 0x8c, 0x8c, 0xfc, 0x15, 0xfc,
+	0x0d, 0x1d, 0x2b, 0xb1, 0x74, 0x0a, 0x28, 0x59, 0x98, 0xfb, 0x17, 0x20, 0xfb, 0x2e, 0x24, 0xa2,
+	0xb1, 0x2a, 0x36, 0x36, 0x3f, 0x7d, 0xdf, 0xfb, 0xbe, 0xf7, 0xe3, 0x9e, 0xa1, 0xc3, 0xe4, 0x88,
+	0x49, 0x2a, 0x3d, 0x75, 0x82, 0xb9, 0x37, 0x69, 0x0d, 0x88, 0xc2, 0x2d, 0x2f, 0x22, 0x09, 0x91,
+	0x54, 0xba, 0x5c, 0x30, 0xc5, 0x50, 0xd5, 0x70, 0xdc, 0x94, 0xe3, 0x1a, 0x4e, 0xad, 0x1a, 0xb1,
+	0x88, 0x65, 0x04, 0x2f, 0xfd, 0xd2, 0xdc, 0xda, 0xde, 0x46, 0xbd, 0x34, 0xf0, 0x05, 0x09, 0x98,
+	0x08, 0x0d, 0x6f, 0x27, 0x62, 0x2c, 0x8a, 0x89, 0x97, 0x45, 0x83, 0xf1, 0x91, 0x87, 0x93, 0xe9,
+	0x12, 0x0a, 0x32, 0x0d, 0x5f, 0x6b, 0xeb, 0xc0, 0x40, 0xf6, 0xdf, 0x59, 0xe1, 0x58, 0x60, 0x45,
+	0x59, 0xa2, 0x71, 0xe7, 0x5b, 0x11, 0x96, 0x7b, 0x58, 0xe0, 0x91, 0x44, 0x4f, 0xe0, 0x36, 0x17,
+	0xe3, 0x84, 0xf8, 0x84, 0xb3, 0x60, 0xe8, 0xd3, 0x90, 0x24, 0x8a, 0x1e, 0x51, 0x22, 0x2c, 0xd0,
+	0x00, 0xcd, 0x1b, 0xfd, 0x6a, 0x86, 0xbe, 0x48, 0xc1, 0xee, 0x1f, 0x0c, 0x7d, 0x02, 0xb0, 0xa6,
+	0xeb, 0xf4, 0x87, 0x54, 0x2a, 0x26, 0xa6, 0xfe, 0x31, 0x21, 0xdc, 0xe7, 0x44, 0x50, 0x16, 0x5a,
+	0x5b, 0x0d, 0xd0, 0xac, 0x1c, 0xec, 0xb8, 0xba, 0x0c, 0x77, 0x59, 0x86, 0xfb, 0xdc, 0x94, 0xd1,
+	0xd9, 0x3f, 0x9d, 0xd5, 0x0b, 0x17, 0xb3, 0xfa, 0xfd, 0x29, 0x1e, 0xc5, 0x6d, 0x27, 0x5f, 0xca,
+	0xf9, 0xf2, 0xa3, 0x0e, 0xfa, 0x77, 0x34, 0xe1, 0x50, 0xe3, 0xaf, 0x09, 0xe1, 0xbd, 0x0c, 0x45,
+	0x02, 0xde, 0x0e, 0x86, 0x24, 0x38, 0xe6, 0x8c, 0x26, 0xca, 0xa7, 0x89, 0x22, 0x62, 0x82, 0x63,
+	0xab, 0x78, 0x95, 0xff, 0x9e, 0xf1, 0xaf, 0x69, 0xff, 0x0d, 0x1a, 0xda, 0x18, 0xad, 0x90, 0xae,
+	0x01, 0xd0, 0x07, 0xb8, 0xbd, 0xc6, 0x5f, 0x6f, 0xbb, 0x74, 0x95, 0xed, 0x03, 0x63, 0x7b, 0xef,
+	0x92, 0xed, 0xa5, 0x96, 0xab, 0x2b, 0x70, 0xd5, 0xaf, 0x73, 0x0e, 0xe0, 0xdd, 0x1e, 0x63, 0x71,
+	0x3f, 0x67, 0x1e, 0x0f, 0xe1, 0x75, 0xce, 0x58, 0xec, 0xd3, 0x30, 0x5b, 0x5f, 0xa9, 0x83, 0x2e,
+	0x66, 0xf5, 0x5b, 0xda, 0xcd, 0x00, 0x4e, 0xbf, 0x9c, 0x7e, 0x75, 0xc3, 0xff, 0x65, 0x89, 0xed,
+	0xd2, 0xaf, 0xaf, 0x75, 0xe0, 0x7c, 0xdf, 0x82, 0x37, 0x5f, 0xea, 0x7b, 0x7a, 0xa3, 0xb0, 0x22,
+	0xe8, 0x19, 0xbc, 0x96, 0xde, 0x83, 0xb4, 0x40, 0xa3, 0xd8, 0xac, 0x1c, 0x34, 0xdc, 0x4d, 0xe7,
+	0xe5, 0xbe, 0x3d, 0xc1, 0x5c, 0x4f, 0xa3, 0x53, 0x4a, 0xeb, 0xe9, 0xeb, 0x24, 0xd4, 0x86, 0x65,
+	0x9e, 0xbd, 0x70, 0xd3, 0xc7, 0xee, 0xe6, 0x74, 0x7d, 0x05, 0x26, 0xd5, 0x64, 0xa0, 0x8f, 0xb0,
+	0x91, 0x0d, 0x2b, 0xbf, 0x23, 0x69, 0x15, 0xb3, 0xa2, 0x5a, 0x39, 0xaa, 0xf9, 0x2b, 0x32, 0x56,
+	0xbb, 0x3c, 0x9f, 0x22, 0xd1, 0x21, 0xac, 0xac, 0xd6, 0x2f, 0xad, 0xd2, 0x3f, 0x0d, 0x60, 0x3d,
+	0xb5, 0xf3, 0xea, 0x74, 0x6e, 0x83, 0xb3, 0xb9, 0x0d, 0x7e, 0xce, 0x6d, 0xf0, 0x79, 0x61, 0x17,
+	0xce, 0x16, 0x76, 0xe1, 0x7c, 0x61, 0x17, 0xde, 0x3d, 0x8a, 0xa8, 0x1a, 0x8e, 0x07, 0x6e, 0xc0,
+	0x46, 0x9e, 0x11, 0xde, 0x8f, 0xf1, 0x40, 0x2e, 0x03, 0x6f, 0xd2, 0x7a, 0xea, 0xbd, 0xd7, 0xff,
+	0x27, 0x35, 0xe5, 0x44, 0x0e, 0xca, 0xd9, 0x13, 0x78, 0xfc, 0x7b, 0x00, 0xa0, 0xe1, 0xbb, 0x26,
+	0x0c, 0x05, 0x00, 0x00,
 }
 
+func (this *PoolRecordHistoryKeepPeriod) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolRecordHistoryKeepPeriod)
+	if !ok {
+		that2, ok := that.(PoolRecordHistoryKeepPeriod)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.RecordHistoryKeepPeriod != that1.RecordHistoryKeepPeriod {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -195,13 +326,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RecordHistoryKeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod):])
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CheckpointKeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CheckpointKeepPeriod):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintGenesis(dAtA, i, uint64(n1))
 	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CheckpointInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CheckpointInterval):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RecordHistoryKeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x12
 	if len(m.PruneEpochIdentifier) > 0 {
 		i -= len(m.PruneEpochIdentifier)
@@ -213,6 +360,42 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolRecordHistoryKeepPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolRecordHistoryKeepPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolRecordHistoryKeepPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RecordHistoryKeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -233,6 +416,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PoolRecordHistoryKeepPeriods) > 0 {
+		for iNdEx := len(m.PoolRecordHistoryKeepPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolRecordHistoryKeepPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -283,6 +494,24 @@ func (m *Params) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod)
 	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CheckpointInterval)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CheckpointKeepPeriod)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PoolRecordHistoryKeepPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -300,6 +529,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PoolRecordHistoryKeepPeriods) > 0 {
+		for _, e := range m.PoolRecordHistoryKeepPeriods {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -403,6 +644,174 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CheckpointInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointKeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CheckpointKeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRecordHistoryKeepPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRecordHistoryKeepPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRecordHistoryKeepPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordHistoryKeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RecordHistoryKeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -520,6 +929,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRecordHistoryKeepPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolRecordHistoryKeepPeriods = append(m.PoolRecordHistoryKeepPeriods, PoolRecordHistoryKeepPeriod{})
+			if err := m.PoolRecordHistoryKeepPeriods[len(m.PoolRecordHistoryKeepPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, TwapRecord{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -29,12 +29,13 @@ var (
 		P0ArithmeticTwapAccumulator: sdk.OneDec(),
 		P1ArithmeticTwapAccumulator: sdk.OneDec(),
 		GeometricTwapAccumulator:    sdk.OneDec(),
+		HarmonicTwapAccumulator:     sdk.OneDec(),
 	}
 )
 
 func TestGenesisState_Validate(t *testing.T) {
 	var (
-		basicParams = NewParams("week", 48*time.Hour)
+		basicParams = NewParams("week", 48*time.Hour, time.Hour, 30*24*time.Hour)
 
 		basicCustomGenesis = NewGenesisState(
 			basicParams,
@@ -57,6 +58,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					P0ArithmeticTwapAccumulator: sdk.OneDec(),
 					P1ArithmeticTwapAccumulator: sdk.OneDec(),
 					GeometricTwapAccumulator:    sdk.OneDec(),
+					HarmonicTwapAccumulator:     sdk.OneDec(),
 				},
 				{
 					PoolId:                      basePoolId,
@@ -69,6 +71,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					P0ArithmeticTwapAccumulator: sdk.OneDec(),
 					P1ArithmeticTwapAccumulator: sdk.OneDec(),
 					GeometricTwapAccumulator:    sdk.OneDec(),
+					HarmonicTwapAccumulator:     sdk.OneDec(),
 				},
 			})
 	)
@@ -78,6 +81,18 @@ func TestGenesisState_Validate(t *testing.T) {
 		return record
 	}
 
+	withPoolRecordHistoryKeepPeriods := func(genesis *GenesisState, records ...PoolRecordHistoryKeepPeriod) *GenesisState {
+		genesisCopy := *genesis
+		genesisCopy.PoolRecordHistoryKeepPeriods = records
+		return &genesisCopy
+	}
+
+	withCheckpoints := func(genesis *GenesisState, checkpoints ...TwapRecord) *GenesisState {
+		genesisCopy := *genesis
+		genesisCopy.Checkpoints = checkpoints
+		return &genesisCopy
+	}
+
 	testCases := map[string]struct {
 		twapGenesis *GenesisState
 
@@ -104,7 +119,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		"invalid genesis - pool ID doesn't exist": {
 			twapGenesis: NewGenesisState(
-				NewParams("week", 48*time.Hour),
+				NewParams("week", 48*time.Hour, time.Hour, 30*24*time.Hour),
 				[]TwapRecord{
 					{
 						PoolId:                      0, // invalid
@@ -123,7 +138,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		"invalid pruneEpochIdentifier - error": {
 			twapGenesis: NewGenesisState(
-				NewParams("", 48*time.Hour), // invalid empty string
+				NewParams("", 48*time.Hour, time.Hour, 30*24*time.Hour), // invalid empty string
 				[]TwapRecord{
 					baseRecord,
 				}),
@@ -132,13 +147,51 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		"invalid recordHistoryKeepPeriod - error": {
 			twapGenesis: NewGenesisState(
-				NewParams("week", -1*time.Hour), // invalid duration
+				NewParams("week", -1*time.Hour, time.Hour, 30*24*time.Hour), // invalid duration
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"invalid checkpointInterval - error": {
+			twapGenesis: NewGenesisState(
+				NewParams("week", 48*time.Hour, 0, 30*24*time.Hour), // invalid duration
 				[]TwapRecord{
 					baseRecord,
 				}),
 
 			expectedErr: true,
 		},
+		"valid pool record history keep periods and checkpoints": {
+			twapGenesis: withCheckpoints(withPoolRecordHistoryKeepPeriods(basicCustomGenesis,
+				PoolRecordHistoryKeepPeriod{PoolId: 1, RecordHistoryKeepPeriod: time.Hour},
+				PoolRecordHistoryKeepPeriod{PoolId: 2, RecordHistoryKeepPeriod: 7 * 24 * time.Hour},
+			), baseRecord),
+		},
+		"invalid pool record history keep period - zero pool id": {
+			twapGenesis: withPoolRecordHistoryKeepPeriods(basicCustomGenesis,
+				PoolRecordHistoryKeepPeriod{PoolId: 0, RecordHistoryKeepPeriod: time.Hour},
+			),
+			expectedErr: true,
+		},
+		"invalid pool record history keep period - zero keep period": {
+			twapGenesis: withPoolRecordHistoryKeepPeriods(basicCustomGenesis,
+				PoolRecordHistoryKeepPeriod{PoolId: 1, RecordHistoryKeepPeriod: 0},
+			),
+			expectedErr: true,
+		},
+		"invalid pool record history keep period - duplicate pool id": {
+			twapGenesis: withPoolRecordHistoryKeepPeriods(basicCustomGenesis,
+				PoolRecordHistoryKeepPeriod{PoolId: 1, RecordHistoryKeepPeriod: time.Hour},
+				PoolRecordHistoryKeepPeriod{PoolId: 1, RecordHistoryKeepPeriod: 2 * time.Hour},
+			),
+			expectedErr: true,
+		},
+		"invalid checkpoint": {
+			twapGenesis: withCheckpoints(basicCustomGenesis, withGeometricAcc(baseRecord, sdk.Dec{})),
+			expectedErr: true,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -326,6 +379,22 @@ func TestTWAPRecord_Validate(t *testing.T) {
 			}(),
 			expectedErr: true,
 		},
+		"invalid harmonic accumulator: nil": {
+			twapRecord: func() TwapRecord {
+				r := baseRecord
+				r.HarmonicTwapAccumulator = sdk.Dec{}
+				return r
+			}(),
+			expectedErr: true,
+		},
+		"invalid harmonic accumulator: negative": {
+			twapRecord: func() TwapRecord {
+				r := baseRecord
+				r.HarmonicTwapAccumulator = sdk.OneDec().Neg()
+				return r
+			}(),
+			expectedErr: true,
+		},
 	}
 	// make test cases symmetric
 	testCasesSym := map[string]testcase{}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeSetPoolRecordHistoryKeepPeriods = "SetPoolRecordHistoryKeepPeriods"
+)
+
+// Init registers the proposal to set per-pool record history keep periods.
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetPoolRecordHistoryKeepPeriods)
+	govtypes.RegisterProposalTypeCodec(&SetPoolRecordHistoryKeepPeriodsProposal{}, "osmosis/SetPoolRecordHistoryKeepPeriodsProposal")
+}
+
+var _ govtypes.Content = &SetPoolRecordHistoryKeepPeriodsProposal{}
+
+// NewSetPoolRecordHistoryKeepPeriodsProposal returns a new instance of a set pool record history keep periods proposal struct.
+func NewSetPoolRecordHistoryKeepPeriodsProposal(title, description string, records []PoolRecordHistoryKeepPeriod) govtypes.Content {
+	return &SetPoolRecordHistoryKeepPeriodsProposal{
+		Title:       title,
+		Description: description,
+		Records:     records,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *SetPoolRecordHistoryKeepPeriodsProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetPoolRecordHistoryKeepPeriodsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetPoolRecordHistoryKeepPeriodsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetPoolRecordHistoryKeepPeriodsProposal) ProposalType() string {
+	return ProposalTypeSetPoolRecordHistoryKeepPeriods
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *SetPoolRecordHistoryKeepPeriodsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.Records) == 0 {
+		return errors.New("proposal has no records")
+	}
+
+	return validatePoolRecordHistoryKeepPeriods(p.Records)
+}
+
+// String returns a string containing the set pool record history keep periods proposal.
+func (p SetPoolRecordHistoryKeepPeriodsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Pool Record History Keep Periods Proposal:
+  Title:       %s
+  Description: %s
+  Records:
+`, p.Title, p.Description))
+	for _, record := range p.Records {
+		b.WriteString(fmt.Sprintf("    PoolId: %d, RecordHistoryKeepPeriod: %s\n", record.PoolId, record.RecordHistoryKeepPeriod))
+	}
+	return b.String()
+}
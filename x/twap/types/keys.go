@@ -24,9 +24,11 @@ const (
 )
 
 var (
-	mostRecentTWAPsNoSeparator         = "recent_twap"
-	historicalTWAPTimeIndexNoSeparator = "historical_time_index"
-	historicalTWAPPoolIndexNoSeparator = "historical_pool_index"
+	mostRecentTWAPsNoSeparator             = "recent_twap"
+	historicalTWAPTimeIndexNoSeparator     = "historical_time_index"
+	historicalTWAPPoolIndexNoSeparator     = "historical_pool_index"
+	checkpointTWAPPoolIndexNoSeparator     = "checkpoint_pool_index"
+	poolRecordHistoryKeepPeriodNoSeparator = "pool_record_history_keep_period"
 
 	// We do key management to let us easily meet the goals of (AKA minimal iteration):
 	// * Get most recent twap for a (pool id, asset 1, asset 2) with no iteration
@@ -42,6 +44,12 @@ var (
 	// format is pool id | denom1 | denom2 | time
 	// made for efficiently getting records given (pool id, denom1, denom2) and time bounds
 	HistoricalTWAPPoolIndexPrefix = historicalTWAPPoolIndexNoSeparator + KeySeparator
+	// format is pool id | denom1 | denom2 | time
+	// made for getting checkpoints given (pool id, denom1, denom2) and time bounds
+	CheckpointTWAPPoolIndexPrefix = checkpointTWAPPoolIndexNoSeparator + KeySeparator
+	// format is pool id
+	// made for getting the record history keep period override of a pool
+	PoolRecordHistoryKeepPeriodPrefix = poolRecordHistoryKeepPeriodNoSeparator + KeySeparator
 )
 
 // TODO: make utility command to automatically interlace separators
@@ -72,6 +80,30 @@ func FormatHistoricalPoolIndexTimeSuffix(poolId uint64, denom1, denom2 string, a
 	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%s.", HistoricalTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS))
 }
 
+func FormatCheckpointTWAPKey(poolId uint64, denom1, denom2 string, accumulatorWriteTime time.Time) []byte {
+	timeS := osmoutils.FormatTimeString(accumulatorWriteTime)
+	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%s", CheckpointTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS))
+}
+
+func FormatCheckpointTimePrefix(poolId uint64, denom1, denom2 string) []byte {
+	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s", CheckpointTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator))
+}
+
+func FormatCheckpointTimeSuffix(poolId uint64, denom1, denom2 string, accumulatorWriteTime time.Time) []byte {
+	timeS := osmoutils.FormatTimeString(accumulatorWriteTime)
+	// . acts as a suffix for lexicographical orderings
+	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%s.", CheckpointTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS))
+}
+
+func FormatPoolRecordHistoryKeepPeriodKey(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s", PoolRecordHistoryKeepPeriodPrefix, osmoutils.FormatFixedLengthU64(poolId)))
+}
+
+// GetAllMostRecentTwaps returns the most recent twap records for all pools.
+func GetAllMostRecentTwaps(store sdk.KVStore) ([]TwapRecord, error) {
+	return osmoutils.GatherValuesFromStorePrefix(store, []byte(mostRecentTWAPsPrefix), ParseTwapFromBz)
+}
+
 // GetAllMostRecentTwapsForPool returns all of the most recent twap records for a pool id.
 // if the pool id doesn't exist, then this returns a blank list.
 func GetAllMostRecentTwapsForPool(store sdk.KVStore, poolId uint64) ([]TwapRecord, error) {
@@ -90,5 +122,16 @@ func ParseTwapFromBz(bz []byte) (twap TwapRecord, err error) {
 	if twap.GeometricTwapAccumulator.IsNil() {
 		twap.GeometricTwapAccumulator = sdk.ZeroDec()
 	}
+	if twap.HarmonicTwapAccumulator.IsNil() {
+		twap.HarmonicTwapAccumulator = sdk.ZeroDec()
+	}
 	return twap, err
 }
+
+func ParsePoolRecordHistoryKeepPeriodFromBz(bz []byte) (record PoolRecordHistoryKeepPeriod, err error) {
+	if len(bz) == 0 {
+		return PoolRecordHistoryKeepPeriod{}, errors.New("pool record history keep period not found")
+	}
+	err = proto.Unmarshal(bz, &record)
+	return record, err
+}
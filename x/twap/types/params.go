@@ -13,6 +13,8 @@ import (
 var (
 	KeyPruneEpochIdentifier    = []byte("PruneEpochIdentifier")
 	KeyRecordHistoryKeepPeriod = []byte("RecordHistoryKeepPeriod")
+	KeyCheckpointInterval      = []byte("CheckpointInterval")
+	KeyCheckpointKeepPeriod    = []byte("CheckpointKeepPeriod")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -20,6 +22,8 @@ var (
 const (
 	defaultPruneEpochIdentifier    = "day"
 	defaultRecordHistoryKeepPeriod = 48 * time.Hour
+	defaultCheckpointInterval      = time.Hour
+	defaultCheckpointKeepPeriod    = 30 * 24 * time.Hour
 )
 
 // ParamTable for twap module.
@@ -27,10 +31,12 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(pruneEpochIdentifier string, recordHistoryKeepPeriod time.Duration) Params {
+func NewParams(pruneEpochIdentifier string, recordHistoryKeepPeriod, checkpointInterval, checkpointKeepPeriod time.Duration) Params {
 	return Params{
 		PruneEpochIdentifier:    pruneEpochIdentifier,
 		RecordHistoryKeepPeriod: recordHistoryKeepPeriod,
+		CheckpointInterval:      checkpointInterval,
+		CheckpointKeepPeriod:    checkpointKeepPeriod,
 	}
 }
 
@@ -39,6 +45,8 @@ func DefaultParams() Params {
 	return Params{
 		PruneEpochIdentifier:    defaultPruneEpochIdentifier,
 		RecordHistoryKeepPeriod: defaultRecordHistoryKeepPeriod,
+		CheckpointInterval:      defaultCheckpointInterval,
+		CheckpointKeepPeriod:    defaultCheckpointKeepPeriod,
 	}
 }
 
@@ -52,6 +60,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePeriod(p.CheckpointInterval); err != nil {
+		return err
+	}
+
+	if err := validatePeriod(p.CheckpointKeepPeriod); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -60,6 +76,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPruneEpochIdentifier, &p.PruneEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
 		paramtypes.NewParamSetPair(KeyRecordHistoryKeepPeriod, &p.RecordHistoryKeepPeriod, validatePeriod),
+		paramtypes.NewParamSetPair(KeyCheckpointInterval, &p.CheckpointInterval, validatePeriod),
+		paramtypes.NewParamSetPair(KeyCheckpointKeepPeriod, &p.CheckpointKeepPeriod, validatePeriod),
 	}
 }
 
@@ -60,6 +60,9 @@ type TwapRecord struct {
 	// It is used to alert the caller if they are getting a potentially erroneous
 	// TWAP, due to an unforeseen underlying error.
 	LastErrorTime time.Time `protobuf:"bytes,11,opt,name=last_error_time,json=lastErrorTime,proto3,stdtime" json:"last_error_time" yaml:"last_error_time"`
+	// This field accumulates the inverse of the asset 0 spot price over time,
+	// and is used to compute the harmonic mean TWAP.
+	HarmonicTwapAccumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=harmonic_twap_accumulator,json=harmonicTwapAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"harmonic_twap_accumulator"`
 }
 
 func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
@@ -137,8 +140,64 @@ func (m *TwapRecord) GetLastErrorTime() time.Time {
 	return time.Time{}
 }
 
+// TwapRoute is a single hop of a multihop TWAP. It prices the base asset of
+// the hop, which is the quote asset of the previous hop, in terms of
+// quote_asset using the records of pool pool_id.
+type TwapRoute struct {
+	PoolId     uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	QuoteAsset string `protobuf:"bytes,2,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty" yaml:"quote_asset"`
+}
+
+func (m *TwapRoute) Reset()         { *m = TwapRoute{} }
+func (m *TwapRoute) String() string { return proto.CompactTextString(m) }
+func (*TwapRoute) ProtoMessage()    {}
+func (*TwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf5c78678e601aa, []int{1}
+}
+func (m *TwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapRoute.Merge(m, src)
+}
+func (m *TwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapRoute proto.InternalMessageInfo
+
+func (m *TwapRoute) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TwapRoute) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TwapRecord)(nil), "osmosis.twap.v1beta1.TwapRecord")
+	proto.RegisterType((*TwapRoute)(nil), "osmosis.twap.v1beta1.TwapRoute")
 }
 
 func init() {
@@ -146,41 +205,45 @@ func init() {
 }
 
 var fileDescriptor_dbf5c78678e601aa = []byte{
-	// 540 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x1a, 0x52, 0x7a, 0xa1, 0xaa, 0x64, 0x45, 0x60, 0x82, 0x64, 0x87, 0x0c, 0x55,
-	0x18, 0xea, 0x1f, 0x20, 0x16, 0xb6, 0x44, 0x65, 0x00, 0x21, 0x84, 0x4c, 0x27, 0x18, 0x4e, 0x67,
-	0xfb, 0xea, 0x58, 0xd8, 0xb9, 0xd3, 0xdd, 0xa5, 0x25, 0xff, 0x45, 0xff, 0xac, 0x8e, 0x1d, 0x11,
-	0x83, 0x41, 0xc9, 0xc6, 0xd8, 0x89, 0x11, 0xdd, 0x8f, 0x84, 0x26, 0xfc, 0x92, 0x32, 0x25, 0xef,
-	0xbd, 0xef, 0xfb, 0x7c, 0xdf, 0xb3, 0x9f, 0x0c, 0x0e, 0x09, 0xaf, 0x08, 0x2f, 0x78, 0x20, 0xce,
-	0x11, 0x0d, 0xce, 0xa2, 0x04, 0x0b, 0x14, 0xa9, 0x00, 0x32, 0x9c, 0x12, 0x96, 0xf9, 0x94, 0x11,
-	0x41, 0xec, 0x8e, 0xd1, 0xf9, 0xb2, 0xe4, 0x1b, 0x5d, 0xb7, 0x93, 0x93, 0x9c, 0x28, 0x41, 0x20,
-	0xff, 0x69, 0x6d, 0xf7, 0x41, 0x4e, 0x48, 0x5e, 0xe2, 0x40, 0x45, 0xc9, 0xf4, 0x34, 0x40, 0x93,
-	0xd9, 0xb2, 0x94, 0x2a, 0x0e, 0xd4, 0x3d, 0x3a, 0x30, 0x25, 0x57, 0x47, 0x41, 0x82, 0x38, 0x5e,
-	0x0d, 0x92, 0x92, 0x62, 0x62, 0xea, 0xde, 0x26, 0x55, 0x14, 0x15, 0xe6, 0x02, 0x55, 0x54, 0x0b,
-	0xfa, 0x3f, 0x5a, 0x00, 0x9c, 0x9c, 0x23, 0x1a, 0xab, 0xb9, 0xed, 0xfb, 0x60, 0x97, 0x12, 0x52,
-	0xc2, 0x22, 0x73, 0xac, 0x9e, 0x35, 0x68, 0xc6, 0x2d, 0x19, 0xbe, 0xcc, 0xec, 0x47, 0xe0, 0x2e,
-	0xe2, 0x1c, 0x8b, 0x10, 0x66, 0x78, 0x42, 0x2a, 0xe7, 0x56, 0xcf, 0x1a, 0xec, 0xc5, 0x6d, 0x9d,
-	0x3b, 0x96, 0xa9, 0x95, 0x24, 0x32, 0x92, 0x9d, 0x1b, 0x92, 0x48, 0x4b, 0x86, 0xa0, 0x35, 0xc6,
-	0x45, 0x3e, 0x16, 0x4e, 0xb3, 0x67, 0x0d, 0x76, 0x46, 0x8f, 0xbf, 0xd7, 0xde, 0xbe, 0x7e, 0x64,
-	0x50, 0x17, 0xae, 0x6b, 0xaf, 0x33, 0x43, 0x55, 0xf9, 0xbc, 0xbf, 0x96, 0xee, 0xc7, 0xa6, 0xd1,
-	0x7e, 0x03, 0x9a, 0x72, 0x07, 0xe7, 0x76, 0xcf, 0x1a, 0xb4, 0x9f, 0x74, 0x7d, 0xbd, 0xa0, 0xbf,
-	0x5c, 0xd0, 0x3f, 0x59, 0x2e, 0x38, 0x72, 0x2f, 0x6b, 0xaf, 0x71, 0x5d, 0x7b, 0xf6, 0x1a, 0x4f,
-	0x36, 0xf7, 0x2f, 0xbe, 0x7a, 0x56, 0xac, 0x38, 0xf6, 0x07, 0x60, 0xd3, 0x10, 0x96, 0x88, 0x0b,
-	0xc8, 0x29, 0x11, 0x90, 0xb2, 0x22, 0xc5, 0x4e, 0x4b, 0xce, 0x3e, 0xf2, 0x25, 0xe1, 0x4b, 0xed,
-	0x1d, 0xe6, 0x85, 0x18, 0x4f, 0x13, 0x3f, 0x25, 0x95, 0x79, 0xfc, 0xe6, 0xe7, 0x88, 0x67, 0x1f,
-	0x03, 0x31, 0xa3, 0x98, 0xfb, 0xc7, 0x38, 0x8d, 0x0f, 0x68, 0xf8, 0x1a, 0x71, 0xf1, 0x8e, 0x12,
-	0xf1, 0x56, 0x62, 0x14, 0x3c, 0xfa, 0x0d, 0xbe, 0xbb, 0x25, 0x3c, 0x5a, 0x87, 0x73, 0xe0, 0xd2,
-	0x10, 0x22, 0x56, 0x88, 0x71, 0x85, 0x45, 0x91, 0x42, 0x75, 0x80, 0x28, 0x4d, 0xa7, 0xd5, 0xb4,
-	0x44, 0x82, 0x30, 0xe7, 0xce, 0x56, 0x46, 0x0f, 0x69, 0x38, 0x5c, 0x41, 0xe5, 0x6d, 0x0c, 0x7f,
-	0x21, 0x95, 0x69, 0xf4, 0x4f, 0xd3, 0xbd, 0x2d, 0x4d, 0xa3, 0xbf, 0x9b, 0x96, 0xa0, 0x9b, 0x63,
-	0x52, 0x61, 0xc1, 0xfe, 0x64, 0x08, 0xb6, 0x32, 0x74, 0x56, 0xc4, 0x4d, 0xb7, 0x53, 0x70, 0xa0,
-	0xde, 0x18, 0x66, 0x8c, 0x30, 0x75, 0x2f, 0x4e, 0xfb, 0xbf, 0xc7, 0xd6, 0x37, 0xc7, 0x76, 0x4f,
-	0x1f, 0xdb, 0x06, 0x40, 0x1f, 0xdc, 0xbe, 0xcc, 0xbe, 0x90, 0x49, 0xd9, 0x37, 0x7a, 0x75, 0x39,
-	0x77, 0xad, 0xab, 0xb9, 0x6b, 0x7d, 0x9b, 0xbb, 0xd6, 0xc5, 0xc2, 0x6d, 0x5c, 0x2d, 0xdc, 0xc6,
-	0xe7, 0x85, 0xdb, 0x78, 0x1f, 0xde, 0xd8, 0xc1, 0x7c, 0x42, 0x8e, 0x4a, 0x94, 0xf0, 0x65, 0x10,
-	0x9c, 0x45, 0xcf, 0x82, 0x4f, 0xfa, 0xeb, 0xa3, 0x36, 0x4a, 0x5a, 0x6a, 0xa4, 0xa7, 0x3f, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x24, 0x49, 0x15, 0xbd, 0x9a, 0x04, 0x00, 0x00,
+	// 606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0x69, 0x49, 0xc9, 0xa6, 0xa5, 0x92, 0x15, 0xb5, 0x6e, 0x90, 0xec, 0xe0, 0x43, 0x15,
+	0x84, 0xea, 0x0f, 0x10, 0x42, 0xe2, 0x16, 0xab, 0x1c, 0x40, 0x08, 0x21, 0xd3, 0x13, 0x1c, 0xac,
+	0xb5, 0xb3, 0x75, 0x0c, 0x76, 0x76, 0xeb, 0x5d, 0xb7, 0xe4, 0x5f, 0xf4, 0x67, 0xf5, 0xd8, 0x23,
+	0xe2, 0x60, 0x50, 0xc2, 0x89, 0x63, 0x7e, 0x01, 0xda, 0x5d, 0x27, 0x4d, 0x42, 0x01, 0x29, 0xa7,
+	0x64, 0x66, 0xde, 0xbe, 0x37, 0x6f, 0x3d, 0xb3, 0xe0, 0x10, 0xd3, 0x0c, 0xd3, 0x84, 0xda, 0xec,
+	0x02, 0x12, 0xfb, 0xdc, 0x0d, 0x11, 0x83, 0xae, 0x08, 0x82, 0x1c, 0x45, 0x38, 0xef, 0x5b, 0x24,
+	0xc7, 0x0c, 0xab, 0xad, 0x0a, 0x67, 0xf1, 0x92, 0x55, 0xe1, 0xda, 0xad, 0x18, 0xc7, 0x58, 0x00,
+	0x6c, 0xfe, 0x4f, 0x62, 0xdb, 0x07, 0x31, 0xc6, 0x71, 0x8a, 0x6c, 0x11, 0x85, 0xc5, 0xa9, 0x0d,
+	0x87, 0xa3, 0x59, 0x29, 0x12, 0x3c, 0x81, 0x3c, 0x23, 0x83, 0xaa, 0xa4, 0xcb, 0xc8, 0x0e, 0x21,
+	0x45, 0xf3, 0x46, 0x22, 0x9c, 0x0c, 0xab, 0xba, 0xb1, 0xca, 0xca, 0x92, 0x0c, 0x51, 0x06, 0x33,
+	0x22, 0x01, 0xe6, 0xcf, 0x2d, 0x00, 0x4e, 0x2e, 0x20, 0xf1, 0x45, 0xdf, 0xea, 0x3e, 0xd8, 0x22,
+	0x18, 0xa7, 0x41, 0xd2, 0xd7, 0x94, 0x8e, 0xd2, 0xdd, 0xf4, 0xeb, 0x3c, 0x7c, 0xd5, 0x57, 0x1f,
+	0x82, 0x6d, 0x48, 0x29, 0x62, 0x4e, 0xd0, 0x47, 0x43, 0x9c, 0x69, 0x77, 0x3a, 0x4a, 0xb7, 0xe1,
+	0x37, 0x65, 0xee, 0x98, 0xa7, 0xe6, 0x10, 0xb7, 0x82, 0x6c, 0x2c, 0x40, 0x5c, 0x09, 0xe9, 0x81,
+	0xfa, 0x00, 0x25, 0xf1, 0x80, 0x69, 0x9b, 0x1d, 0xa5, 0xbb, 0xe1, 0x3d, 0xfa, 0x55, 0x1a, 0x3b,
+	0xf2, 0xca, 0x02, 0x59, 0x98, 0x96, 0x46, 0x6b, 0x04, 0xb3, 0xf4, 0x85, 0xb9, 0x94, 0x36, 0xfd,
+	0xea, 0xa0, 0xfa, 0x16, 0x6c, 0x72, 0x0f, 0xda, 0xdd, 0x8e, 0xd2, 0x6d, 0x3e, 0x69, 0x5b, 0xd2,
+	0xa0, 0x35, 0x33, 0x68, 0x9d, 0xcc, 0x0c, 0x7a, 0xfa, 0x55, 0x69, 0xd4, 0xa6, 0xa5, 0xa1, 0x2e,
+	0xf1, 0xf1, 0xc3, 0xe6, 0xe5, 0x77, 0x43, 0xf1, 0x05, 0x8f, 0xfa, 0x11, 0xa8, 0xc4, 0x09, 0x52,
+	0x48, 0x59, 0x40, 0x09, 0x66, 0x01, 0xc9, 0x93, 0x08, 0x69, 0x75, 0xde, 0xbb, 0x67, 0x71, 0x86,
+	0x6f, 0xa5, 0x71, 0x18, 0x27, 0x6c, 0x50, 0x84, 0x56, 0x84, 0xb3, 0xea, 0xfa, 0xab, 0x9f, 0x23,
+	0xda, 0xff, 0x6c, 0xb3, 0x11, 0x41, 0xd4, 0x3a, 0x46, 0x91, 0xbf, 0x4b, 0x9c, 0x37, 0x90, 0xb2,
+	0xf7, 0x04, 0xb3, 0x77, 0x9c, 0x46, 0x90, 0xbb, 0x7f, 0x90, 0x6f, 0xad, 0x49, 0xee, 0x2e, 0x93,
+	0x53, 0xa0, 0x13, 0x27, 0x80, 0x79, 0xc2, 0x06, 0x19, 0x62, 0x49, 0x14, 0x88, 0x01, 0x84, 0x51,
+	0x54, 0x64, 0x45, 0x0a, 0x19, 0xce, 0xb5, 0x7b, 0x6b, 0x09, 0x3d, 0x20, 0x4e, 0x6f, 0x4e, 0xca,
+	0x67, 0xa3, 0x77, 0x43, 0x29, 0x44, 0xdd, 0x7f, 0x8a, 0x36, 0xd6, 0x14, 0x75, 0xff, 0x2e, 0x9a,
+	0x82, 0x76, 0x8c, 0x70, 0x86, 0x58, 0x7e, 0x9b, 0x20, 0x58, 0x4b, 0x50, 0x9b, 0x33, 0xae, 0xaa,
+	0x9d, 0x82, 0x5d, 0xf1, 0xc5, 0x50, 0x9e, 0xe3, 0x5c, 0xcc, 0x8b, 0xd6, 0xfc, 0xef, 0xb0, 0x99,
+	0xd5, 0xb0, 0xed, 0xc9, 0x61, 0x5b, 0x21, 0x90, 0x03, 0xb7, 0xc3, 0xb3, 0x2f, 0x79, 0x92, 0x9f,
+	0x53, 0x3f, 0x81, 0x83, 0x01, 0xcc, 0x33, 0x3c, 0xbc, 0xcd, 0xd4, 0xf6, 0x5a, 0xa6, 0xf6, 0x67,
+	0x84, 0x2b, 0x9e, 0xcc, 0x33, 0xd0, 0x10, 0x5b, 0x8e, 0x0b, 0x86, 0xd4, 0xc7, 0x2b, 0x4b, 0xee,
+	0xa9, 0xd3, 0xd2, 0xb8, 0x2f, 0x1b, 0xaf, 0x0a, 0xe6, 0x7c, 0xf1, 0x9f, 0x83, 0xe6, 0x59, 0x81,
+	0x19, 0x0a, 0xc4, 0x1e, 0xcb, 0xbd, 0xf7, 0xf6, 0x6e, 0xd6, 0x6a, 0xa1, 0x68, 0xfa, 0x40, 0x44,
+	0x3d, 0x1e, 0x78, 0xaf, 0xaf, 0xc6, 0xba, 0x72, 0x3d, 0xd6, 0x95, 0x1f, 0x63, 0x5d, 0xb9, 0x9c,
+	0xe8, 0xb5, 0xeb, 0x89, 0x5e, 0xfb, 0x3a, 0xd1, 0x6b, 0x1f, 0x9c, 0x05, 0x37, 0xd5, 0x0b, 0x79,
+	0x94, 0xc2, 0x90, 0xce, 0x02, 0xfb, 0xdc, 0x7d, 0x66, 0x7f, 0x91, 0x8f, 0xab, 0xf0, 0x16, 0xd6,
+	0xc5, 0x8d, 0x3f, 0xfd, 0x3d, 0x00, 0x1e, 0xd7, 0xd3, 0x81, 0x79, 0x05, 0x00, 0x00,
 }
 
 func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
@@ -203,6 +266,16 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.HarmonicTwapAccumulator.Size()
+		i -= size
+		if _, err := m.HarmonicTwapAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastErrorTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastErrorTime):])
 	if err1 != nil {
 		return 0, err1
@@ -296,6 +369,41 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintTwapRecord(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTwapRecord(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTwapRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTwapRecord(v)
 	base := offset
@@ -341,6 +449,24 @@ func (m *TwapRecord) Size() (n int) {
 	n += 1 + l + sovTwapRecord(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastErrorTime)
 	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.HarmonicTwapAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	return n
+}
+
+func (m *TwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTwapRecord(uint64(m.PoolId))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovTwapRecord(uint64(l))
+	}
 	return n
 }
 
@@ -717,6 +843,141 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HarmonicTwapAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HarmonicTwapAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwapRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwapRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTwapRecord(dAtA[iNdEx:])
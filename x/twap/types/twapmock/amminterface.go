@@ -3,6 +3,7 @@ package twapmock
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v15/x/twap/types"
 )
 
@@ -58,6 +59,10 @@ func (p *ProgrammedPoolManagerInterface) ProgramPoolSpotPriceOverride(poolId uin
 	p.programmedSpotPrice[input] = SpotPriceResult{overrideSp, overrideErr}
 }
 
+func (p *ProgrammedPoolManagerInterface) RoutePool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error) {
+	return p.underlyingKeeper.RoutePool(ctx, poolId)
+}
+
 func (p *ProgrammedPoolManagerInterface) RouteGetPoolDenoms(ctx sdk.Context, poolId uint64) (denoms []string, err error) {
 	if res, ok := p.programmedPoolDenoms[poolId]; ok {
 		result := make([]string, 0, len(res.poolDenoms))
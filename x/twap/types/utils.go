@@ -10,6 +10,10 @@ import (
 
 var MaxSpotPrice = sdk.NewDec(2).Power(128).Sub(sdk.OneDec())
 
+// MaxTwapRoutes is the maximum number of routes of a multihop twap query,
+// which bounds the number of twaps computed by a single query.
+const MaxTwapRoutes = 5
+
 // GetAllUniqueDenomPairs returns all unique pairs of denoms, where for every pair
 // (X, Y), X < Y.
 // The pair (X,Y) should only appear once in the list. Denoms are lexicographically sorted.
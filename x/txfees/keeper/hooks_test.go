@@ -30,6 +30,9 @@ func (suite *KeeperTestSuite) TestTxFeesAfterEpochEnd() {
 	suite.SetupTest(false)
 	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
 
+	// The swaps of the fee tokens by the txfees module account are exempt from the taker fee.
+	suite.App.PoolManagerKeeper.SetTakerFeeParams(suite.Ctx, poolmanagertypes.TakerFeeParams{DefaultTakerFee: sdk.MustNewDecFromStr("0.01")})
+
 	// create pools for three separate fee tokens
 	uion := "uion"
 	_, uionPool := suite.preparePool(uion)